@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	backupURL    string
+	backupToken  string
+	backupKey    string
+	backupOutput string
+)
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Download an encrypted backup of a running FishyKeys server",
+	Long: `Download an archive of the secrets, access rules, users, roles and settings of a running server, with the
+token of an admin. The archive is encrypted under the master key, or under the backup key when one is given.
+It is restored into an empty database with the restore command.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		serverURL := backupURL
+		if serverURL == "" {
+			serverURL = fmt.Sprintf("http://%s:%s", viper.GetString("server.address"), viper.GetString("server.port"))
+		}
+		token := getConfigValue("backup.token", backupToken)
+		if token == "" {
+			log.Fatalf("the token of an admin must be given with --token")
+		}
+
+		payload := map[string]string{}
+		if key := getConfigValue("backup.key", backupKey); key != "" {
+			payload["backup_key"] = key
+		}
+		body, err := json.Marshal(payload)
+		if err != nil {
+			log.Fatalf("failed to encode request: %v", err)
+		}
+
+		req, err := http.NewRequestWithContext(cmd.Context(), http.MethodPost, strings.TrimSuffix(serverURL, "/")+"/backup", bytes.NewReader(body))
+		if err != nil {
+			log.Fatalf("failed to create request: %v", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			log.Fatalf("failed to request backup: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			var serviceError struct {
+				Name    string `json:"name"`
+				Message string `json:"message"`
+			}
+			message, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+			if json.Unmarshal(message, &serviceError) == nil && serviceError.Message != "" {
+				log.Fatalf("backup failed: %s: %s", serviceError.Name, serviceError.Message)
+			}
+			log.Fatalf("backup failed: %s: %s", resp.Status, strings.TrimSpace(string(message)))
+		}
+
+		output := backupOutput
+		if output == "" {
+			// The file is written to the current directory whatever the path the server names it with
+			_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition"))
+			if err == nil {
+				output = filepath.Base(params["filename"])
+			}
+			if output == "" || output == "." || output == ".." || output == string(filepath.Separator) {
+				log.Fatalf("the server did not name the backup, give its path with --output")
+			}
+		}
+
+		// Never overwrite an existing backup
+		file, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			log.Fatalf("failed to create backup file: %v", err)
+		}
+		if _, err := io.Copy(file, resp.Body); err != nil {
+			file.Close()
+			os.Remove(output)
+			log.Fatalf("failed to download backup: %v", err)
+		}
+		if err := file.Close(); err != nil {
+			log.Fatalf("failed to write backup file: %v", err)
+		}
+		log.Printf("Backup written to %s", output)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupCmd)
+
+	backupCmd.Flags().StringVar(&backupURL, "url", "", "URL of the server, built from the server configuration by default")
+	backupCmd.Flags().StringVar(&backupToken, "token", "", "JWT token of an admin")
+	backupCmd.Flags().StringVar(&backupKey, "backup-key", "", "Passphrase the backup is encrypted under instead of the master key, of at least 12 characters")
+	backupCmd.Flags().StringVarP(&backupOutput, "output", "o", "", "Path of the backup file, named after the time of the backup by default")
+}
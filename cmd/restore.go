@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/Vidalee/FishyKeys/internal/db"
+	"github.com/Vidalee/FishyKeys/internal/migration"
+	"github.com/Vidalee/FishyKeys/repository"
+	"github.com/Vidalee/FishyKeys/service"
+	"github.com/spf13/cobra"
+)
+
+var (
+	restoreBackupKey  string
+	restoreSharesFile string
+	restoreCheck      bool
+)
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <backup file>",
+	Short: "Restore an encrypted backup into an empty database",
+	Long: `Check a backup made with the backup command and replay it into an empty database, migrated to the schema
+version of the backup and then to the latest one. Backups encrypted under the master key are opened with enough of
+its shares, given one per line in the --shares-file file or on the standard input with --shares-file -. Backups
+encrypted under a backup key are opened with that key. The server must not be running during the restore.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			log.Fatalf("failed to read backup: %v", err)
+		}
+		shares, err := readShares(getConfigValue("backup.shares_file", restoreSharesFile))
+		if err != nil {
+			log.Fatalf("failed to read shares: %v", err)
+		}
+		archive, err := service.OpenBackup(data, getConfigValue("backup.key", restoreBackupKey), shares)
+		if err != nil {
+			log.Fatalf("failed to open backup: %v", err)
+		}
+		log.Printf("Backup made at %s with schema version %d holds %d secrets, %d users and %d roles",
+			archive.CreatedAt.Format("2006-01-02 15:04:05 MST"), archive.SchemaVersion,
+			archive.RowCount("secrets"), archive.RowCount("users"), archive.RowCount("roles"))
+		if restoreCheck {
+			return
+		}
+
+		ctx := context.Background()
+		if err := db.Init(ctx, databaseDSN()); err != nil {
+			log.Fatalf("failed to init db: %v", err)
+		}
+		defer db.Pool().Close()
+
+		// The database is checked before being migrated to the version of the backup, which may migrate it down
+		if err := migration.RunMigrations(db.Pool()); err != nil {
+			log.Fatalf("failed to run migrations: %v", err)
+		}
+		backupRepository := repository.NewBackupRepository(db.Pool())
+		empty, err := backupRepository.IsEmpty(ctx)
+		if err != nil {
+			log.Fatalf("failed to check the database: %v", err)
+		}
+		if !empty {
+			log.Fatalf("failed to restore backup: %v", repository.ErrDatabaseNotEmpty)
+		}
+
+		if err := migration.MigrateTo(db.Pool(), archive.SchemaVersion); err != nil {
+			log.Fatalf("failed to migrate to the version of the backup: %v", err)
+		}
+		if err := backupRepository.Restore(ctx, archive); err != nil {
+			log.Fatalf("failed to restore backup: %v", err)
+		}
+		if err := migration.RunMigrations(db.Pool()); err != nil {
+			log.Fatalf("failed to run migrations: %v", err)
+		}
+		log.Println("Backup restored, the master key must be unlocked with its shares once the server is started")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().StringVar(&restoreBackupKey, "backup-key", "", "Passphrase the backup is encrypted under, if not under the master key")
+	restoreCmd.Flags().StringVar(&restoreSharesFile, "shares-file", "", "File holding the shares of the master key the backup is encrypted under, one per line, or - for the standard input")
+	restoreCmd.Flags().BoolVar(&restoreCheck, "check", false, "Only check that the backup can be opened, without restoring it")
+}
+
+// readShares reads the shares of the master key from the file, or from the standard input when path is "-". Shares
+// are never taken as arguments, which other users can list.
+func readShares(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	var content []byte
+	var err error
+	if path == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var shares []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if share := strings.TrimSpace(line); share != "" {
+			shares = append(shares, share)
+		}
+	}
+	return shares, nil
+}
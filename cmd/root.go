@@ -26,6 +26,10 @@ var (
 	pgDatabase string
 	serverAddr string
 	serverPort int
+
+	secretsMaxSize   int64
+	rotationInterval time.Duration
+	eventsRetention  time.Duration
 )
 
 var rootCmd = &cobra.Command{
@@ -34,14 +38,7 @@ var rootCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
 
-		user := getConfigValue("pg.user", pgUser)
-		pass := getConfigValue("pg.pass", pgPass)
-		addr := getConfigValue("pg.address", pgAddress)
-		dbName := getConfigValue("pg.database", pgDatabase)
-
-		dsn := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, pass, addr, dbName)
-
-		if err := db.Init(ctx, dsn); err != nil {
+		if err := db.Init(ctx, databaseDSN()); err != nil {
 			log.Fatalf("failed to init db: %v", err)
 		}
 		defer db.Pool().Close()
@@ -53,7 +50,22 @@ var rootCmd = &cobra.Command{
 		serverAddress := getConfigValue("server.address", serverAddr)
 		serverPort := getConfigPort("server.port", serverPort)
 
-		goaServer, grpcServer := server.NewServers(db.Pool())
+		maxSecretSize := secretsMaxSize
+		if maxSecretSize == 0 {
+			maxSecretSize = viper.GetInt64("secrets.max_size")
+		}
+
+		interval := rotationInterval
+		if interval == 0 {
+			interval = viper.GetDuration("rotation.interval")
+		}
+
+		retention := eventsRetention
+		if retention == 0 {
+			retention = viper.GetDuration("events.retention")
+		}
+
+		goaServer, grpcServer, workers := server.NewServers(db.Pool(), maxSecretSize, interval, retention)
 
 		httpServer := &http.Server{
 			Addr:    fmt.Sprintf("%s:%s", serverAddress, serverPort),
@@ -80,10 +92,18 @@ var rootCmd = &cobra.Command{
 			}
 		}()
 
+		// Start the secret rotation scheduler and the secret events listener
+		workersCtx, stopWorkers := context.WithCancel(ctx)
+		defer stopWorkers()
+		for _, worker := range workers {
+			go worker.Run(workersCtx)
+		}
+
 		sigCh := make(chan os.Signal, 1)
 		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
 		<-sigCh
 		log.Println("Shutdown signal received")
+		stopWorkers()
 
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -98,6 +118,16 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// databaseDSN builds the connection string of the database from the flags and the configuration
+func databaseDSN() string {
+	user := getConfigValue("pg.user", pgUser)
+	pass := getConfigValue("pg.pass", pgPass)
+	addr := getConfigValue("pg.address", pgAddress)
+	dbName := getConfigValue("pg.database", pgDatabase)
+
+	return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=disable", user, pass, addr, dbName)
+}
+
 func getConfigValue(key string, flagValue string) string {
 	if flagValue != "" {
 		return flagValue
@@ -126,6 +156,9 @@ func init() {
 	viper.AddConfigPath("./config")
 	viper.AutomaticEnv()
 	viper.SetEnvPrefix("FISHYKEYS")
+	viper.SetDefault("secrets.max_size", 1<<20)
+	viper.SetDefault("rotation.interval", 30*time.Second)
+	viper.SetDefault("events.retention", 24*time.Hour)
 
 	if err := viper.ReadInConfig(); err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
@@ -134,11 +167,15 @@ func init() {
 		}
 	}
 
-	rootCmd.Flags().StringVar(&pgUser, "pg-user", "", "PostgreSQL username")
-	rootCmd.Flags().StringVar(&pgPass, "pg-pass", "", "PostgreSQL password")
-	rootCmd.Flags().StringVar(&pgAddress, "pg-address", "", "PostgreSQL address")
-	rootCmd.Flags().StringVar(&pgDatabase, "pg-database", "", "PostgreSQL database name")
+	rootCmd.PersistentFlags().StringVar(&pgUser, "pg-user", "", "PostgreSQL username")
+	rootCmd.PersistentFlags().StringVar(&pgPass, "pg-pass", "", "PostgreSQL password")
+	rootCmd.PersistentFlags().StringVar(&pgAddress, "pg-address", "", "PostgreSQL address")
+	rootCmd.PersistentFlags().StringVar(&pgDatabase, "pg-database", "", "PostgreSQL database name")
 
 	rootCmd.Flags().StringVar(&serverAddr, "server-address", "", "Server address to listen on")
 	rootCmd.Flags().IntVar(&serverPort, "server-port", 0, "Server port to listen on")
+
+	rootCmd.Flags().Int64Var(&secretsMaxSize, "secrets-max-size", 0, "Maximum size of a secret in bytes")
+	rootCmd.Flags().DurationVar(&rotationInterval, "rotation-interval", 0, "Interval between two checks for secrets to rotate")
+	rootCmd.Flags().DurationVar(&eventsRetention, "events-retention", 0, "How long secret events are kept for watches to resume from")
 }
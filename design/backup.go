@@ -0,0 +1,48 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var _ = Service("backup", func() {
+	Description("Backup service writes encrypted archives of the database, restored with the restore command")
+
+	Error("invalid_parameters", ErrorResult, "Invalid input")
+	Error("unauthorized", ErrorResult, "Unauthorized access")
+	Error("forbidden", ErrorResult, "Forbidden access")
+	Error("internal_error", ErrorResult, "Internal server error")
+
+	Method("create backup", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Download an archive of the secrets, access rules, users, roles and settings, encrypted under the master key or under the backup key when one is given. Secret values stay encrypted under the master key either way")
+		Payload(func() {
+			Attribute("backup_key", String, "Passphrase the archive is encrypted under instead of the master key", func() {
+				Example("correct horse battery staple")
+				MinLength(12)
+			})
+		})
+		Result(func() {
+			Attribute("content_disposition", String, "Attachment disposition holding the filename", func() {
+				Example("attachment; filename=fishykeys-20251019T120000Z.fkbackup")
+			})
+			Attribute("content_length", Int64, "Size of the archive in bytes", func() {
+				Example(65536)
+			})
+			Required("content_disposition", "content_length")
+		})
+		HTTP(func() {
+			POST("/backup")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("content_disposition:Content-Disposition")
+				Header("content_length:Content-Length")
+				ContentType("application/octet-stream")
+			})
+			Response("invalid_parameters", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("forbidden", StatusForbidden)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+})
@@ -0,0 +1,149 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var GenerationParametersType = Type("GenerationParameters", func() {
+	Attribute("kind", String, "Kind of value generated by the policy", func() {
+		Enum("password", "passphrase", "bytes", "uuid", "rsa", "ed25519")
+	})
+	Attribute("length", Int, "Number of characters of a password, or number of random bytes", func() {
+		Example(32)
+	})
+	Attribute("lowercase", Boolean, "Whether passwords contain lowercase letters")
+	Attribute("uppercase", Boolean, "Whether passwords contain uppercase letters")
+	Attribute("digits", Boolean, "Whether passwords contain digits")
+	Attribute("symbols", Boolean, "Whether passwords contain symbols")
+	Attribute("words", Int, "Number of words of a passphrase", func() {
+		Example(6)
+	})
+	Attribute("separator", String, "Separator between the words of a passphrase, defaults to '-'", func() {
+		Example("-")
+	})
+	Attribute("encoding", String, "Encoding of random bytes", func() {
+		Enum("hex", "base64")
+	})
+	Attribute("bits", Int, "Size of RSA keys", func() {
+		Enum(2048, 3072, 4096)
+	})
+
+	Required("kind")
+})
+
+var GenerationPolicyType = Type("GenerationPolicy", func() {
+	Attribute("id", Int, "Unique identifier for the generation policy", func() {
+		Example(1)
+	})
+	Attribute("name", String, "Name of the generation policy", func() {
+		Example("strong-password")
+	})
+	Extend(GenerationParametersType)
+	Attribute("created_at", String, "Generation policy creation timestamp", func() {
+		Example("2025-06-30T12:00:00Z")
+	})
+	Attribute("updated_at", String, "Generation policy last update timestamp", func() {
+		Example("2025-06-30T15:00:00Z")
+	})
+
+	Required("id", "name", "kind", "created_at", "updated_at")
+})
+
+var _ = Service("generation", func() {
+	Description("Generation service manages the policies used to generate secret values server-side")
+
+	Error("invalid_parameters", ErrorResult, "Invalid input")
+	Error("unauthorized", ErrorResult, "Unauthorized access")
+	Error("forbidden", ErrorResult, "Forbidden access")
+	Error("internal_error", ErrorResult, "Internal server error")
+
+	Method("list generation policies", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("List all generation policies")
+		Result(ArrayOf(GenerationPolicyType))
+		HTTP(func() {
+			GET("/generation-policies")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("create generation policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Create a generation policy")
+		Payload(func() {
+			Attribute("name", String, "Name of the generation policy", func() {
+				Example("strong-password")
+				MinLength(1)
+			})
+			Extend(GenerationParametersType)
+			Required("name", "kind")
+		})
+		Result(GenerationPolicyType)
+		Error("generation_policy_taken", ErrorResult, "Generation policy name already exists")
+		HTTP(func() {
+			POST("/generation-policies")
+			Response(StatusCreated)
+			Response("generation_policy_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("update generation policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Replace the name and parameters of a generation policy")
+		Payload(func() {
+			Attribute("id", Int, "ID of the generation policy", func() {
+				Example(1)
+			})
+			Attribute("name", String, "Name of the generation policy", func() {
+				Example("strong-password")
+				MinLength(1)
+			})
+			Extend(GenerationParametersType)
+			Required("id", "name", "kind")
+		})
+		Result(GenerationPolicyType)
+		Error("generation_policy_not_found", ErrorResult, "Generation policy not found")
+		Error("generation_policy_taken", ErrorResult, "Generation policy name already exists")
+		HTTP(func() {
+			PUT("/generation-policies/{id}")
+			Response(StatusOK)
+			Response("generation_policy_not_found", StatusNotFound)
+			Response("generation_policy_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("delete generation policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Delete a generation policy, the secrets generated with it are kept")
+		Payload(func() {
+			Attribute("id", Int, "ID of the generation policy", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Error("generation_policy_not_found", ErrorResult, "Generation policy not found")
+		HTTP(func() {
+			DELETE("/generation-policies/{id}")
+			Response(StatusOK)
+			Response("generation_policy_not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+})
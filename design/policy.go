@@ -0,0 +1,286 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var PolicyRuleType = Type("PolicyRule", func() {
+	Attribute("path", String, "Glob of the secret paths the rule applies to", func() {
+		Example("/apps/*/db/*")
+	})
+	Attribute("effect", String, "Whether the rule allows or denies the capabilities", func() {
+		Enum("allow", "deny")
+	})
+	Attribute("capabilities", ArrayOf(String), "Capabilities allowed or denied by the rule", func() {
+		Example([]string{"read", "list"})
+	})
+
+	Required("path", "effect", "capabilities")
+})
+
+var PolicyType = Type("Policy", func() {
+	Attribute("id", Int, "Unique identifier for the policy", func() {
+		Example(1)
+	})
+	Attribute("name", String, "Name of the policy", func() {
+		Example("apps-db-readers")
+	})
+	Attribute("format", String, "Format of the policy document", func() {
+		Enum("hcl", "yaml")
+	})
+	Attribute("document", String, "The policy document", func() {
+		Example("path \"/apps/*/db/*\" {\n  capabilities = [\"read\", \"list\"]\n}\n")
+	})
+	Attribute("rules", ArrayOf(PolicyRuleType), "Rules parsed from the policy document")
+	Attribute("users", ArrayOf(Int), "IDs of the users the policy is attached to")
+	Attribute("roles", ArrayOf(Int), "IDs of the roles the policy is attached to")
+	Attribute("created_at", String, "Policy creation timestamp", func() {
+		Example("2025-06-30T12:00:00Z")
+	})
+	Attribute("updated_at", String, "Policy last update timestamp", func() {
+		Example("2025-06-30T15:00:00Z")
+	})
+
+	Required("id", "name", "format", "document", "rules", "users", "roles", "created_at", "updated_at")
+})
+
+var ExplainMatchType = Type("ExplainMatch", func() {
+	Attribute("source", String, "What the match comes from", func() {
+		Enum("owner", "grant", "folder", "policy")
+	})
+	Attribute("effect", String, "Whether the match allows or denies the capability", func() {
+		Enum("allow", "deny")
+	})
+	Attribute("path", String, "The secret path, folder prefix or policy path glob that matched", func() {
+		Example("/apps/*/db/*")
+	})
+	Attribute("policy", String, "Name of the policy the rule belongs to, for policy matches", func() {
+		Example("apps-db-readers")
+	})
+
+	Required("source", "effect", "path")
+})
+
+var _ = Service("policies", func() {
+	Description("Policies service manages path-pattern policy documents attached to users and roles")
+
+	Error("invalid_parameters", ErrorResult, "Invalid input")
+	Error("unauthorized", ErrorResult, "Unauthorized access")
+	Error("forbidden", ErrorResult, "Forbidden access")
+	Error("internal_error", ErrorResult, "Internal server error")
+
+	Method("list policies", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("List all policies")
+		Result(ArrayOf(PolicyType))
+		HTTP(func() {
+			GET("/policies")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("get policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Get a policy by id")
+		Payload(func() {
+			Attribute("id", Int, "ID of the policy", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Result(PolicyType)
+		Error("policy_not_found", ErrorResult, "Policy not found")
+		HTTP(func() {
+			GET("/policies/{id}")
+			Response(StatusOK)
+			Response("policy_not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("create policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Create a policy from a HCL or YAML document")
+		Payload(func() {
+			Attribute("name", String, "Name of the policy", func() {
+				Example("apps-db-readers")
+				MinLength(1)
+			})
+			Attribute("format", String, "Format of the policy document", func() {
+				Enum("hcl", "yaml")
+			})
+			Attribute("document", String, "The policy document", func() {
+				Example("path \"/apps/*/db/*\" {\n  capabilities = [\"read\", \"list\"]\n}\n")
+				MinLength(1)
+			})
+			Required("name", "format", "document")
+		})
+		Result(PolicyType)
+		Error("policy_taken", ErrorResult, "Policy name already exists")
+		HTTP(func() {
+			POST("/policies")
+			Response(StatusCreated)
+			Response("policy_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("update policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Replace the name and document of a policy")
+		Payload(func() {
+			Attribute("id", Int, "ID of the policy", func() {
+				Example(1)
+			})
+			Attribute("name", String, "Name of the policy", func() {
+				Example("apps-db-readers")
+				MinLength(1)
+			})
+			Attribute("format", String, "Format of the policy document", func() {
+				Enum("hcl", "yaml")
+			})
+			Attribute("document", String, "The policy document", func() {
+				Example("path \"/apps/*/db/*\" {\n  capabilities = [\"read\", \"list\"]\n}\n")
+				MinLength(1)
+			})
+			Required("id", "name", "format", "document")
+		})
+		Result(PolicyType)
+		Error("policy_not_found", ErrorResult, "Policy not found")
+		Error("policy_taken", ErrorResult, "Policy name already exists")
+		HTTP(func() {
+			PUT("/policies/{id}")
+			Response(StatusOK)
+			Response("policy_not_found", StatusNotFound)
+			Response("policy_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("delete policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Delete a policy by id")
+		Payload(func() {
+			Attribute("id", Int, "ID of the policy", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Error("policy_not_found", ErrorResult, "Policy not found")
+		HTTP(func() {
+			DELETE("/policies/{id}")
+			Response(StatusOK)
+			Response("policy_not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("attach policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Attach a policy to a user or a role")
+		Payload(func() {
+			Attribute("id", Int, "ID of the policy", func() {
+				Example(1)
+			})
+			Attribute("user_id", Int, "ID of the user to attach the policy to", func() {
+				Example(2)
+			})
+			Attribute("role_id", Int, "ID of the role to attach the policy to", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Error("policy_not_found", ErrorResult, "Policy not found")
+		HTTP(func() {
+			POST("/policies/{id}/attach")
+			Response(StatusOK)
+			Response("policy_not_found", StatusNotFound)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("detach policy", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Detach a policy from a user or a role")
+		Payload(func() {
+			Attribute("id", Int, "ID of the policy", func() {
+				Example(1)
+			})
+			Attribute("user_id", Int, "ID of the user to detach the policy from", func() {
+				Example(2)
+			})
+			Attribute("role_id", Int, "ID of the role to detach the policy from", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Error("policy_not_found", ErrorResult, "Policy not found")
+		HTTP(func() {
+			POST("/policies/{id}/detach")
+			Response(StatusOK)
+			Response("policy_not_found", StatusNotFound)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("explain", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Dry-run an access check and show which rules allowed or denied it")
+		Payload(func() {
+			Attribute("user_id", Int, "ID of the user performing the request", func() {
+				Example(2)
+			})
+			Attribute("path", String, "Base64 encoded secret's path, the secret does not need to exist", func() {
+				Example("L2FwcHMvcGF5bWVudHMvZGIvcGFzc3dvcmQ=")
+				MinLength(2)
+			})
+			Attribute("capability", String, "The capability to check", func() {
+				Enum("read", "list", "update", "delete", "manage_access")
+			})
+			Required("user_id", "path", "capability")
+		})
+		Result(func() {
+			Attribute("allowed", Boolean, "Whether the request would be allowed")
+			Attribute("decision", String, "Explanation of the decision", func() {
+				Example("denied by rule /apps/prod/** of policy prod-lockdown")
+			})
+			Attribute("matches", ArrayOf(ExplainMatchType), "Every grant and rule giving or denying the capability on the path")
+			Required("allowed", "decision", "matches")
+		})
+		HTTP(func() {
+			POST("/policies/explain")
+			Response(StatusOK)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+})
@@ -85,17 +85,24 @@ var _ = Service("users", func() {
 				Example("alice")
 				MinLength(3)
 			})
+			Attribute("reassign_to", String, "Username of the user the secrets owned by the deleted user are given to. Required when the user owns secrets", func() {
+				Example("bob")
+				MinLength(3)
+			})
 			Required("username")
 		})
 		Error("user_not_found", ErrorResult, "User not found")
+		Error("user_owns_secrets", ErrorResult, "The user owns secrets and no user to reassign them to was given")
 		Error("invalid_parameters", ErrorResult, "Invalid input")
 		Error("internal_error", ErrorResult, "Internal server error")
 		Error("forbidden", ErrorResult, "Forbidden access")
 		Error("unauthorized", ErrorResult, "Unauthorized access")
 		HTTP(func() {
 			DELETE("/users/{username}")
+			Param("reassign_to")
 			Response(StatusOK)
 			Response("user_not_found", StatusNotFound)
+			Response("user_owns_secrets", StatusConflict)
 			Response("internal_error", StatusInternalServerError)
 			Response("invalid_parameters", StatusBadRequest)
 			Response("forbidden", StatusForbidden)
@@ -0,0 +1,239 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+var WebhookParametersType = Type("WebhookParameters", func() {
+	Attribute("name", String, "Name of the webhook", func() {
+		Example("restart-api")
+		MinLength(1)
+		MaxLength(255)
+	})
+	Attribute("url", String, "HTTP or HTTPS URL the events are posted to", func() {
+		Example("https://hooks.example.com/fishykeys")
+	})
+	Attribute("events", ArrayOf(String), "Event types delivered, a category such as 'secret.*', or '*' for every event", func() {
+		Example([]string{"secret.updated", "secret.rotated"})
+		MinLength(1)
+	})
+	Attribute("path_prefix", String, "Only deliver the events about a path under this prefix", func() {
+		Example("/customers/")
+	})
+	Attribute("enabled", Boolean, "Whether events are delivered. Disabled webhooks get no new deliveries and their pending ones wait", func() {
+		Default(true)
+	})
+
+	Required("name", "url", "events")
+})
+
+var WebhookType = Type("Webhook", func() {
+	Attribute("id", Int, "Unique identifier for the webhook", func() {
+		Example(1)
+	})
+	Extend(WebhookParametersType)
+	Attribute("created_at", String, "Webhook creation timestamp", func() {
+		Example("2025-06-30T12:00:00Z")
+	})
+	Attribute("updated_at", String, "Webhook last update timestamp", func() {
+		Example("2025-06-30T15:00:00Z")
+	})
+
+	Required("id", "name", "url", "events", "enabled", "created_at", "updated_at")
+})
+
+var WebhookDeliveryType = Type("WebhookDelivery", func() {
+	Attribute("id", Int, "Unique identifier for the delivery", func() {
+		Example(12)
+	})
+	Attribute("event_id", String, "Identifier of the delivered event, shared by its deliveries to every webhook", func() {
+		Example("3f2b8c1d9e0a4b5c6d7e8f9a0b1c2d3e")
+	})
+	Attribute("event_type", String, "Type of the delivered event", func() {
+		Example("secret.updated")
+	})
+	Attribute("payload", String, "JSON body posted to the webhook", func() {
+		Example(`{"id":"3f2b8c1d9e0a4b5c6d7e8f9a0b1c2d3e","type":"secret.updated","data":{"path":"/customers/google/api_key"}}`)
+	})
+	Attribute("status", String, "Status of the delivery", func() {
+		Enum("pending", "succeeded", "failed")
+	})
+	Attribute("attempts", Int, "Number of attempts made", func() {
+		Example(3)
+	})
+	Attribute("next_attempt_at", String, "Time of the next attempt of a pending delivery", func() {
+		Example("2025-06-30T12:02:00Z")
+	})
+	Attribute("last_attempt_at", String, "Time of the last attempt", func() {
+		Example("2025-06-30T12:01:00Z")
+	})
+	Attribute("response_status", Int, "HTTP status returned by the webhook on the last attempt", func() {
+		Example(503)
+	})
+	Attribute("error", String, "Why the last attempt failed", func() {
+		Example("unexpected status 503")
+	})
+	Attribute("replay_of", Int, "ID of the delivery this one replays", func() {
+		Example(7)
+	})
+	Attribute("created_at", String, "Delivery creation timestamp", func() {
+		Example("2025-06-30T12:00:00Z")
+	})
+
+	Required("id", "event_id", "event_type", "payload", "status", "attempts", "created_at")
+})
+
+var _ = Service("webhooks", func() {
+	Description("Webhooks service manages the endpoints notified of the events of the server")
+
+	Error("invalid_parameters", ErrorResult, "Invalid input")
+	Error("unauthorized", ErrorResult, "Unauthorized access")
+	Error("forbidden", ErrorResult, "Forbidden access")
+	Error("internal_error", ErrorResult, "Internal server error")
+
+	Method("list webhooks", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("List all webhooks")
+		Result(ArrayOf(WebhookType))
+		HTTP(func() {
+			GET("/webhooks")
+			Response(StatusOK)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("create webhook", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Register a webhook. The returned secret signs its deliveries and is not shown again")
+		Payload(WebhookParametersType)
+		Result(func() {
+			Extend(WebhookType)
+			Attribute("secret", String, "Secret signing the deliveries with HMAC-SHA256", func() {
+				Example("4b1f0e9c8d7a6b5c4d3e2f1a0b9c8d7e6f5a4b3c2d1e0f9a8b7c6d5e4f3a2b1c")
+			})
+			Required("id", "name", "url", "events", "enabled", "created_at", "updated_at", "secret")
+		})
+		Error("webhook_taken", ErrorResult, "Webhook name already exists")
+		HTTP(func() {
+			POST("/webhooks")
+			Response(StatusCreated)
+			Response("webhook_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("update webhook", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Replace the parameters of a webhook, its secret is kept")
+		Payload(func() {
+			Attribute("id", Int, "ID of the webhook", func() {
+				Example(1)
+			})
+			Extend(WebhookParametersType)
+			Required("id", "name", "url", "events")
+		})
+		Result(WebhookType)
+		Error("webhook_not_found", ErrorResult, "Webhook not found")
+		Error("webhook_taken", ErrorResult, "Webhook name already exists")
+		HTTP(func() {
+			PUT("/webhooks/{id}")
+			Response(StatusOK)
+			Response("webhook_not_found", StatusNotFound)
+			Response("webhook_taken", StatusConflict)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("delete webhook", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Delete a webhook along with its deliveries")
+		Payload(func() {
+			Attribute("id", Int, "ID of the webhook", func() {
+				Example(1)
+			})
+			Required("id")
+		})
+		Error("webhook_not_found", ErrorResult, "Webhook not found")
+		HTTP(func() {
+			DELETE("/webhooks/{id}")
+			Response(StatusOK)
+			Response("webhook_not_found", StatusNotFound)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("list webhook deliveries", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("List the latest deliveries of a webhook")
+		Payload(func() {
+			Attribute("id", Int, "ID of the webhook", func() {
+				Example(1)
+			})
+			Attribute("status", String, "Only list the deliveries with this status", func() {
+				Enum("pending", "succeeded", "failed")
+			})
+			Attribute("limit", Int, "Maximum number of deliveries returned", func() {
+				Default(50)
+				Minimum(1)
+				Maximum(500)
+			})
+			Required("id")
+		})
+		Result(ArrayOf(WebhookDeliveryType))
+		Error("webhook_not_found", ErrorResult, "Webhook not found")
+		HTTP(func() {
+			GET("/webhooks/{id}/deliveries")
+			Param("status")
+			Param("limit")
+			Response(StatusOK)
+			Response("webhook_not_found", StatusNotFound)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("replay webhook delivery", func() {
+		ServerInterceptor(IsAdmin)
+
+		Description("Deliver the event of a failed delivery again, as a new delivery")
+		Payload(func() {
+			Attribute("id", Int, "ID of the webhook", func() {
+				Example(1)
+			})
+			Attribute("delivery_id", Int, "ID of the failed delivery", func() {
+				Example(12)
+			})
+			Required("id", "delivery_id")
+		})
+		Result(WebhookDeliveryType)
+		Error("webhook_not_found", ErrorResult, "Webhook not found")
+		Error("webhook_delivery_not_found", ErrorResult, "Webhook delivery not found")
+		HTTP(func() {
+			POST("/webhooks/{id}/deliveries/{delivery_id}/replay")
+			Response(StatusCreated)
+			Response("webhook_not_found", StatusNotFound)
+			Response("webhook_delivery_not_found", StatusNotFound)
+			Response("invalid_parameters", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+			Response("forbidden", StatusForbidden)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+})
@@ -0,0 +1,44 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup client
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "backup" service client.
+type Client struct {
+	CreateBackupEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "backup" service client given the endpoints.
+func NewClient(createBackup goa.Endpoint) *Client {
+	return &Client{
+		CreateBackupEndpoint: createBackup,
+	}
+}
+
+// CreateBackup calls the "create backup" endpoint of the "backup" service.
+// CreateBackup may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreateBackup(ctx context.Context, p *CreateBackupPayload) (res *CreateBackupResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.CreateBackupEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*CreateBackupResponseData)
+	return o.Result, o.Body, nil
+}
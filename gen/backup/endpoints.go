@@ -0,0 +1,56 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup endpoints
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "backup" service endpoints.
+type Endpoints struct {
+	CreateBackup goa.Endpoint
+}
+
+// CreateBackupResponseData holds both the result and the HTTP response body
+// reader of the "create backup" method.
+type CreateBackupResponseData struct {
+	// Result is the method result.
+	Result *CreateBackupResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// NewEndpoints wraps the methods of the "backup" service with endpoints.
+func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
+	endpoints := &Endpoints{
+		CreateBackup: NewCreateBackupEndpoint(s),
+	}
+	endpoints.CreateBackup = WrapCreateBackupEndpoint(endpoints.CreateBackup, si)
+	return endpoints
+}
+
+// Use applies the given middleware to all the "backup" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.CreateBackup = m(e.CreateBackup)
+}
+
+// NewCreateBackupEndpoint returns an endpoint function that calls the method
+// "create backup" of service "backup".
+func NewCreateBackupEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateBackupPayload)
+		res, body, err := s.CreateBackup(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &CreateBackupResponseData{Result: res, Body: body}, nil
+	}
+}
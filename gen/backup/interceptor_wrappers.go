@@ -0,0 +1,27 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// Interceptor wrappers
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package backup
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// wrapIsAdminCreateBackup applies the IsAdmin server interceptor to endpoints.
+func wrapCreateBackupIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "backup",
+			method:     "CreateBackup",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
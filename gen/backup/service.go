@@ -0,0 +1,80 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup service
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Backup service writes encrypted archives of the database, restored with the
+// restore command
+type Service interface {
+	// Download an archive of the secrets, access rules, users, roles and settings,
+	// encrypted under the master key or under the backup key when one is given.
+	// Secret values stay encrypted under the master key either way
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	CreateBackup(context.Context, *CreateBackupPayload) (res *CreateBackupResult, body io.ReadCloser, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "fishykeys"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "1.0"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "backup"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [1]string{"create backup"}
+
+// CreateBackupPayload is the payload type of the backup service create backup
+// method.
+type CreateBackupPayload struct {
+	// Passphrase the archive is encrypted under instead of the master key
+	BackupKey *string
+}
+
+// CreateBackupResult is the result type of the backup service create backup
+// method.
+type CreateBackupResult struct {
+	// Attachment disposition holding the filename
+	ContentDisposition string
+	// Size of the archive in bytes
+	ContentLength int64
+}
+
+// MakeInvalidParameters builds a goa.ServiceError from an error.
+func MakeInvalidParameters(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_parameters", false, false, false)
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeInternalError builds a goa.ServiceError from an error.
+func MakeInternalError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "internal_error", false, false, false)
+}
@@ -0,0 +1,100 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation client
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package generation
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "generation" service client.
+type Client struct {
+	ListGenerationPoliciesEndpoint goa.Endpoint
+	CreateGenerationPolicyEndpoint goa.Endpoint
+	UpdateGenerationPolicyEndpoint goa.Endpoint
+	DeleteGenerationPolicyEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "generation" service client given the endpoints.
+func NewClient(listGenerationPolicies, createGenerationPolicy, updateGenerationPolicy, deleteGenerationPolicy goa.Endpoint) *Client {
+	return &Client{
+		ListGenerationPoliciesEndpoint: listGenerationPolicies,
+		CreateGenerationPolicyEndpoint: createGenerationPolicy,
+		UpdateGenerationPolicyEndpoint: updateGenerationPolicy,
+		DeleteGenerationPolicyEndpoint: deleteGenerationPolicy,
+	}
+}
+
+// ListGenerationPolicies calls the "list generation policies" endpoint of the
+// "generation" service.
+// ListGenerationPolicies may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListGenerationPolicies(ctx context.Context) (res []*GenerationPolicy, err error) {
+	var ires any
+	ires, err = c.ListGenerationPoliciesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*GenerationPolicy), nil
+}
+
+// CreateGenerationPolicy calls the "create generation policy" endpoint of the
+// "generation" service.
+// CreateGenerationPolicy may return the following errors:
+//   - "generation_policy_taken" (type *goa.ServiceError): Generation policy name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreateGenerationPolicy(ctx context.Context, p *CreateGenerationPolicyPayload) (res *GenerationPolicy, err error) {
+	var ires any
+	ires, err = c.CreateGenerationPolicyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GenerationPolicy), nil
+}
+
+// UpdateGenerationPolicy calls the "update generation policy" endpoint of the
+// "generation" service.
+// UpdateGenerationPolicy may return the following errors:
+//   - "generation_policy_not_found" (type *goa.ServiceError): Generation policy not found
+//   - "generation_policy_taken" (type *goa.ServiceError): Generation policy name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) UpdateGenerationPolicy(ctx context.Context, p *UpdateGenerationPolicyPayload) (res *GenerationPolicy, err error) {
+	var ires any
+	ires, err = c.UpdateGenerationPolicyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*GenerationPolicy), nil
+}
+
+// DeleteGenerationPolicy calls the "delete generation policy" endpoint of the
+// "generation" service.
+// DeleteGenerationPolicy may return the following errors:
+//   - "generation_policy_not_found" (type *goa.ServiceError): Generation policy not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeleteGenerationPolicy(ctx context.Context, p *DeleteGenerationPolicyPayload) (err error) {
+	_, err = c.DeleteGenerationPolicyEndpoint(ctx, p)
+	return
+}
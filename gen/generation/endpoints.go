@@ -0,0 +1,80 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation endpoints
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package generation
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "generation" service endpoints.
+type Endpoints struct {
+	ListGenerationPolicies goa.Endpoint
+	CreateGenerationPolicy goa.Endpoint
+	UpdateGenerationPolicy goa.Endpoint
+	DeleteGenerationPolicy goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "generation" service with endpoints.
+func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
+	endpoints := &Endpoints{
+		ListGenerationPolicies: NewListGenerationPoliciesEndpoint(s),
+		CreateGenerationPolicy: NewCreateGenerationPolicyEndpoint(s),
+		UpdateGenerationPolicy: NewUpdateGenerationPolicyEndpoint(s),
+		DeleteGenerationPolicy: NewDeleteGenerationPolicyEndpoint(s),
+	}
+	endpoints.ListGenerationPolicies = WrapListGenerationPoliciesEndpoint(endpoints.ListGenerationPolicies, si)
+	endpoints.CreateGenerationPolicy = WrapCreateGenerationPolicyEndpoint(endpoints.CreateGenerationPolicy, si)
+	endpoints.UpdateGenerationPolicy = WrapUpdateGenerationPolicyEndpoint(endpoints.UpdateGenerationPolicy, si)
+	endpoints.DeleteGenerationPolicy = WrapDeleteGenerationPolicyEndpoint(endpoints.DeleteGenerationPolicy, si)
+	return endpoints
+}
+
+// Use applies the given middleware to all the "generation" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.ListGenerationPolicies = m(e.ListGenerationPolicies)
+	e.CreateGenerationPolicy = m(e.CreateGenerationPolicy)
+	e.UpdateGenerationPolicy = m(e.UpdateGenerationPolicy)
+	e.DeleteGenerationPolicy = m(e.DeleteGenerationPolicy)
+}
+
+// NewListGenerationPoliciesEndpoint returns an endpoint function that calls
+// the method "list generation policies" of service "generation".
+func NewListGenerationPoliciesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListGenerationPolicies(ctx)
+	}
+}
+
+// NewCreateGenerationPolicyEndpoint returns an endpoint function that calls
+// the method "create generation policy" of service "generation".
+func NewCreateGenerationPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateGenerationPolicyPayload)
+		return s.CreateGenerationPolicy(ctx, p)
+	}
+}
+
+// NewUpdateGenerationPolicyEndpoint returns an endpoint function that calls
+// the method "update generation policy" of service "generation".
+func NewUpdateGenerationPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateGenerationPolicyPayload)
+		return s.UpdateGenerationPolicy(ctx, p)
+	}
+}
+
+// NewDeleteGenerationPolicyEndpoint returns an endpoint function that calls
+// the method "delete generation policy" of service "generation".
+func NewDeleteGenerationPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteGenerationPolicyPayload)
+		return nil, s.DeleteGenerationPolicy(ctx, p)
+	}
+}
@@ -0,0 +1,70 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// Interceptor wrappers
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package generation
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// wrapIsAdminListGenerationPolicies applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListGenerationPoliciesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "generation",
+			method:     "ListGenerationPolicies",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminCreateGenerationPolicy applies the IsAdmin server interceptor to
+// endpoints.
+func wrapCreateGenerationPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "generation",
+			method:     "CreateGenerationPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminUpdateGenerationPolicy applies the IsAdmin server interceptor to
+// endpoints.
+func wrapUpdateGenerationPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "generation",
+			method:     "UpdateGenerationPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDeleteGenerationPolicy applies the IsAdmin server interceptor to
+// endpoints.
+func wrapDeleteGenerationPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "generation",
+			method:     "DeleteGenerationPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
@@ -0,0 +1,169 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation service
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package generation
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Generation service manages the policies used to generate secret values
+// server-side
+type Service interface {
+	// List all generation policies
+	ListGenerationPolicies(context.Context) (res []*GenerationPolicy, err error)
+	// Create a generation policy
+	CreateGenerationPolicy(context.Context, *CreateGenerationPolicyPayload) (res *GenerationPolicy, err error)
+	// Replace the name and parameters of a generation policy
+	UpdateGenerationPolicy(context.Context, *UpdateGenerationPolicyPayload) (res *GenerationPolicy, err error)
+	// Delete a generation policy, the secrets generated with it are kept
+	DeleteGenerationPolicy(context.Context, *DeleteGenerationPolicyPayload) (err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "fishykeys"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "1.0"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "generation"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [4]string{"list generation policies", "create generation policy", "update generation policy", "delete generation policy"}
+
+// CreateGenerationPolicyPayload is the payload type of the generation service
+// create generation policy method.
+type CreateGenerationPolicyPayload struct {
+	// Name of the generation policy
+	Name string
+	// Kind of value generated by the policy
+	Kind string
+	// Number of characters of a password, or number of random bytes
+	Length *int
+	// Whether passwords contain lowercase letters
+	Lowercase *bool
+	// Whether passwords contain uppercase letters
+	Uppercase *bool
+	// Whether passwords contain digits
+	Digits *bool
+	// Whether passwords contain symbols
+	Symbols *bool
+	// Number of words of a passphrase
+	Words *int
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string
+	// Encoding of random bytes
+	Encoding *string
+	// Size of RSA keys
+	Bits *int
+}
+
+// DeleteGenerationPolicyPayload is the payload type of the generation service
+// delete generation policy method.
+type DeleteGenerationPolicyPayload struct {
+	// ID of the generation policy
+	ID int
+}
+
+// GenerationPolicy is the result type of the generation service create
+// generation policy method.
+type GenerationPolicy struct {
+	// Unique identifier for the generation policy
+	ID int
+	// Name of the generation policy
+	Name string
+	// Generation policy creation timestamp
+	CreatedAt string
+	// Generation policy last update timestamp
+	UpdatedAt string
+	// Kind of value generated by the policy
+	Kind string
+	// Number of characters of a password, or number of random bytes
+	Length *int
+	// Whether passwords contain lowercase letters
+	Lowercase *bool
+	// Whether passwords contain uppercase letters
+	Uppercase *bool
+	// Whether passwords contain digits
+	Digits *bool
+	// Whether passwords contain symbols
+	Symbols *bool
+	// Number of words of a passphrase
+	Words *int
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string
+	// Encoding of random bytes
+	Encoding *string
+	// Size of RSA keys
+	Bits *int
+}
+
+// UpdateGenerationPolicyPayload is the payload type of the generation service
+// update generation policy method.
+type UpdateGenerationPolicyPayload struct {
+	// ID of the generation policy
+	ID int
+	// Name of the generation policy
+	Name string
+	// Kind of value generated by the policy
+	Kind string
+	// Number of characters of a password, or number of random bytes
+	Length *int
+	// Whether passwords contain lowercase letters
+	Lowercase *bool
+	// Whether passwords contain uppercase letters
+	Uppercase *bool
+	// Whether passwords contain digits
+	Digits *bool
+	// Whether passwords contain symbols
+	Symbols *bool
+	// Number of words of a passphrase
+	Words *int
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string
+	// Encoding of random bytes
+	Encoding *string
+	// Size of RSA keys
+	Bits *int
+}
+
+// MakeInvalidParameters builds a goa.ServiceError from an error.
+func MakeInvalidParameters(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_parameters", false, false, false)
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeInternalError builds a goa.ServiceError from an error.
+func MakeInternalError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "internal_error", false, false, false)
+}
+
+// MakeGenerationPolicyTaken builds a goa.ServiceError from an error.
+func MakeGenerationPolicyTaken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "generation_policy_taken", false, false, false)
+}
+
+// MakeGenerationPolicyNotFound builds a goa.ServiceError from an error.
+func MakeGenerationPolicyNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "generation_policy_not_found", false, false, false)
+}
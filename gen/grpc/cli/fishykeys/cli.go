@@ -21,14 +21,17 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `secrets operator-get-secret-value
+	return `secrets (browse-secrets|operator-get-secret-value|batch-get-secret-values|render-template|watch-secrets)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` secrets operator-get-secret-value --message '{
-      "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+	return os.Args[0] + ` secrets browse-secrets --message '{
+      "cursor": "Maiores exercitationem aliquam fugit similique.",
+      "limit": 939,
+      "prefix": "L2N1c3RvbWVycy8=",
+      "recursive": false
    }'` + "\n" +
 		""
 }
@@ -42,11 +45,27 @@ func ParseEndpoint(
 	var (
 		secretsFlags = flag.NewFlagSet("secrets", flag.ContinueOnError)
 
+		secretsBrowseSecretsFlags       = flag.NewFlagSet("browse-secrets", flag.ExitOnError)
+		secretsBrowseSecretsMessageFlag = secretsBrowseSecretsFlags.String("message", "", "")
+
 		secretsOperatorGetSecretValueFlags       = flag.NewFlagSet("operator-get-secret-value", flag.ExitOnError)
 		secretsOperatorGetSecretValueMessageFlag = secretsOperatorGetSecretValueFlags.String("message", "", "")
+
+		secretsBatchGetSecretValuesFlags       = flag.NewFlagSet("batch-get-secret-values", flag.ExitOnError)
+		secretsBatchGetSecretValuesMessageFlag = secretsBatchGetSecretValuesFlags.String("message", "", "")
+
+		secretsRenderTemplateFlags       = flag.NewFlagSet("render-template", flag.ExitOnError)
+		secretsRenderTemplateMessageFlag = secretsRenderTemplateFlags.String("message", "", "")
+
+		secretsWatchSecretsFlags       = flag.NewFlagSet("watch-secrets", flag.ExitOnError)
+		secretsWatchSecretsMessageFlag = secretsWatchSecretsFlags.String("message", "", "")
 	)
 	secretsFlags.Usage = secretsUsage
+	secretsBrowseSecretsFlags.Usage = secretsBrowseSecretsUsage
 	secretsOperatorGetSecretValueFlags.Usage = secretsOperatorGetSecretValueUsage
+	secretsBatchGetSecretValuesFlags.Usage = secretsBatchGetSecretValuesUsage
+	secretsRenderTemplateFlags.Usage = secretsRenderTemplateUsage
+	secretsWatchSecretsFlags.Usage = secretsWatchSecretsUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -82,9 +101,21 @@ func ParseEndpoint(
 		switch svcn {
 		case "secrets":
 			switch epn {
+			case "browse-secrets":
+				epf = secretsBrowseSecretsFlags
+
 			case "operator-get-secret-value":
 				epf = secretsOperatorGetSecretValueFlags
 
+			case "batch-get-secret-values":
+				epf = secretsBatchGetSecretValuesFlags
+
+			case "render-template":
+				epf = secretsRenderTemplateFlags
+
+			case "watch-secrets":
+				epf = secretsWatchSecretsFlags
+
 			}
 
 		}
@@ -110,9 +141,21 @@ func ParseEndpoint(
 		case "secrets":
 			c := secretsc.NewClient(cc, opts...)
 			switch epn {
+			case "browse-secrets":
+				endpoint = c.BrowseSecrets()
+				data, err = secretsc.BuildBrowseSecretsPayload(*secretsBrowseSecretsMessageFlag)
 			case "operator-get-secret-value":
 				endpoint = c.OperatorGetSecretValue()
 				data, err = secretsc.BuildOperatorGetSecretValuePayload(*secretsOperatorGetSecretValueMessageFlag)
+			case "batch-get-secret-values":
+				endpoint = c.BatchGetSecretValues()
+				data, err = secretsc.BuildBatchGetSecretValuesPayload(*secretsBatchGetSecretValuesMessageFlag)
+			case "render-template":
+				endpoint = c.RenderTemplate()
+				data, err = secretsc.BuildRenderTemplatePayload(*secretsRenderTemplateMessageFlag)
+			case "watch-secrets":
+				endpoint = c.WatchSecrets()
+				data, err = secretsc.BuildWatchSecretsPayload(*secretsWatchSecretsMessageFlag)
 			}
 		}
 	}
@@ -130,21 +173,99 @@ Usage:
     %[1]s [globalflags] secrets COMMAND [flags]
 
 COMMAND:
-    operator-get-secret-value: Retrieve a secret value using GRPC
+    browse-secrets: List the folders and secrets you have access to under a path prefix
+    operator-get-secret-value: Retrieve a secret value using GRPC, or the fields of a structured secret
+    batch-get-secret-values: Retrieve the values of several secrets at once. Each secret is returned with its value or the error preventing its read, in the order of the request
+    render-template: Render a Go text/template document reading secrets, given inline or stored in a secret. Templates read secrets with secret "/path", field "/path" "name" and fields "/path", and format values with b64enc, b64dec, json and env "NAME". You need read access to the template and to every secret it reads
+    watch-secrets: Stream the changes of the secrets at the given paths or under the given prefix which you can list. Reconnecting with the resume token of the last event received delivers every event since
 
 Additional help:
     %[1]s secrets COMMAND --help
 `, os.Args[0])
 }
+func secretsBrowseSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets browse-secrets -message JSON
+
+List the folders and secrets you have access to under a path prefix
+    -message JSON: 
+
+Example:
+    %[1]s secrets browse-secrets --message '{
+      "cursor": "Maiores exercitationem aliquam fugit similique.",
+      "limit": 939,
+      "prefix": "L2N1c3RvbWVycy8=",
+      "recursive": false
+   }'
+`, os.Args[0])
+}
+
 func secretsOperatorGetSecretValueUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets operator-get-secret-value -message JSON
 
-Retrieve a secret value using GRPC
+Retrieve a secret value using GRPC, or the fields of a structured secret
     -message JSON: 
 
 Example:
     %[1]s secrets operator-get-secret-value --message '{
+      "field": "password",
       "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
    }'
 `, os.Args[0])
 }
+
+func secretsBatchGetSecretValuesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets batch-get-secret-values -message JSON
+
+Retrieve the values of several secrets at once. Each secret is returned with its value or the error preventing its read, in the order of the request
+    -message JSON: 
+
+Example:
+    %[1]s secrets batch-get-secret-values --message '{
+      "secrets": [
+         {
+            "field": "password",
+            "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+         },
+         {
+            "field": "password",
+            "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+         },
+         {
+            "field": "password",
+            "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+         }
+      ]
+   }'
+`, os.Args[0])
+}
+
+func secretsRenderTemplateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets render-template -message JSON
+
+Render a Go text/template document reading secrets, given inline or stored in a secret. Templates read secrets with secret "/path", field "/path" "name" and fields "/path", and format values with b64enc, b64dec, json and env "NAME". You need read access to the template and to every secret it reads
+    -message JSON: 
+
+Example:
+    %[1]s secrets render-template --message '{
+      "template": "DATABASE_URL={{ secret \"/app/db/url\" }}\n{{ env \"API_KEY\" (secret \"/app/api_key\") }}",
+      "template_path": "L2FwcC90ZW1wbGF0ZXMvZW52"
+   }'
+`, os.Args[0])
+}
+
+func secretsWatchSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets watch-secrets -message JSON
+
+Stream the changes of the secrets at the given paths or under the given prefix which you can list. Reconnecting with the resume token of the last event received delivers every event since
+    -message JSON: 
+
+Example:
+    %[1]s secrets watch-secrets --message '{
+      "paths": [
+         "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+      ],
+      "prefix": "L2N1c3RvbWVycy8=",
+      "resume_token": "42"
+   }'
+`, os.Args[0])
+}
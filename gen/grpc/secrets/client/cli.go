@@ -15,6 +15,39 @@ import (
 	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
 )
 
+// BuildBrowseSecretsPayload builds the payload for the secrets browse secrets
+// endpoint from CLI flags.
+func BuildBrowseSecretsPayload(secretsBrowseSecretsMessage string) (*secrets.BrowseSecretsPayload, error) {
+	var err error
+	var message secretspb.BrowseSecretsRequest
+	{
+		if secretsBrowseSecretsMessage != "" {
+			err = json.Unmarshal([]byte(secretsBrowseSecretsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cursor\": \"Maiores exercitationem aliquam fugit similique.\",\n      \"limit\": 939,\n      \"prefix\": \"L2N1c3RvbWVycy8=\",\n      \"recursive\": false\n   }'")
+			}
+		}
+	}
+	v := &secrets.BrowseSecretsPayload{
+		Prefix: message.Prefix,
+		Cursor: message.Cursor,
+	}
+	if message.Recursive != nil {
+		v.Recursive = *message.Recursive
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Recursive == nil {
+		v.Recursive = false
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+
+	return v, nil
+}
+
 // BuildOperatorGetSecretValuePayload builds the payload for the secrets
 // operator get secret value endpoint from CLI flags.
 func BuildOperatorGetSecretValuePayload(secretsOperatorGetSecretValueMessage string) (*secrets.OperatorGetSecretValuePayload, error) {
@@ -24,12 +57,88 @@ func BuildOperatorGetSecretValuePayload(secretsOperatorGetSecretValueMessage str
 		if secretsOperatorGetSecretValueMessage != "" {
 			err = json.Unmarshal([]byte(secretsOperatorGetSecretValueMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"field\": \"password\",\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n   }'")
 			}
 		}
 	}
 	v := &secrets.OperatorGetSecretValuePayload{
-		Path: message.Path,
+		Path:  message.Path,
+		Field: message.Field,
+	}
+
+	return v, nil
+}
+
+// BuildBatchGetSecretValuesPayload builds the payload for the secrets batch
+// get secret values endpoint from CLI flags.
+func BuildBatchGetSecretValuesPayload(secretsBatchGetSecretValuesMessage string) (*secrets.BatchGetSecretValuesPayload, error) {
+	var err error
+	var message secretspb.BatchGetSecretValuesRequest
+	{
+		if secretsBatchGetSecretValuesMessage != "" {
+			err = json.Unmarshal([]byte(secretsBatchGetSecretValuesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"secrets\": [\n         {\n            \"field\": \"password\",\n            \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n         },\n         {\n            \"field\": \"password\",\n            \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n         },\n         {\n            \"field\": \"password\",\n            \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n         }\n      ]\n   }'")
+			}
+		}
+	}
+	v := &secrets.BatchGetSecretValuesPayload{}
+	if message.Secrets != nil {
+		v.Secrets = make([]*secrets.SecretValueRequest, len(message.Secrets))
+		for i, val := range message.Secrets {
+			v.Secrets[i] = &secrets.SecretValueRequest{
+				Path:  val.Path,
+				Field: val.Field,
+			}
+		}
+	}
+
+	return v, nil
+}
+
+// BuildRenderTemplatePayload builds the payload for the secrets render
+// template endpoint from CLI flags.
+func BuildRenderTemplatePayload(secretsRenderTemplateMessage string) (*secrets.RenderTemplatePayload, error) {
+	var err error
+	var message secretspb.RenderTemplateRequest
+	{
+		if secretsRenderTemplateMessage != "" {
+			err = json.Unmarshal([]byte(secretsRenderTemplateMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"template\": \"DATABASE_URL={{ secret \\\"/app/db/url\\\" }}\\n{{ env \\\"API_KEY\\\" (secret \\\"/app/api_key\\\") }}\",\n      \"template_path\": \"L2FwcC90ZW1wbGF0ZXMvZW52\"\n   }'")
+			}
+		}
+	}
+	v := &secrets.RenderTemplatePayload{
+		Template:     message.Template,
+		TemplatePath: message.TemplatePath,
+	}
+
+	return v, nil
+}
+
+// BuildWatchSecretsPayload builds the payload for the secrets watch secrets
+// endpoint from CLI flags.
+func BuildWatchSecretsPayload(secretsWatchSecretsMessage string) (*secrets.WatchSecretsPayload, error) {
+	var err error
+	var message secretspb.WatchSecretsRequest
+	{
+		if secretsWatchSecretsMessage != "" {
+			err = json.Unmarshal([]byte(secretsWatchSecretsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"paths\": [\n         \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n      ],\n      \"prefix\": \"L2N1c3RvbWVycy8=\",\n      \"resume_token\": \"42\"\n   }'")
+			}
+		}
+	}
+	v := &secrets.WatchSecretsPayload{
+		Prefix:      message.Prefix,
+		ResumeToken: message.ResumeToken,
+	}
+	if message.Paths != nil {
+		v.Paths = make([]string, len(message.Paths))
+		for i, val := range message.Paths {
+			v.Paths[i] = val
+		}
 	}
 
 	return v, nil
@@ -11,6 +11,7 @@ import (
 	"context"
 
 	secretspb "github.com/Vidalee/FishyKeys/gen/grpc/secrets/pb"
+	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
 	goagrpc "goa.design/goa/v3/grpc"
 	goapb "goa.design/goa/v3/grpc/pb"
 	goa "goa.design/goa/v3/pkg"
@@ -23,6 +24,12 @@ type Client struct {
 	opts    []grpc.CallOption
 }
 
+// WatchSecretsClientStream implements the secrets.WatchSecretsClientStream
+// interface.
+type WatchSecretsClientStream struct {
+	stream secretspb.Secrets_WatchSecretsClient
+}
+
 // NewClient instantiates gRPC client for all the secrets service servers.
 func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	return &Client{
@@ -31,6 +38,28 @@ func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	}
 }
 
+// BrowseSecrets calls the "BrowseSecrets" function in secretspb.SecretsClient
+// interface.
+func (c *Client) BrowseSecrets() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildBrowseSecretsFunc(c.grpccli, c.opts...),
+			EncodeBrowseSecretsRequest,
+			DecodeBrowseSecretsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // OperatorGetSecretValue calls the "OperatorGetSecretValue" function in
 // secretspb.SecretsClient interface.
 func (c *Client) OperatorGetSecretValue() goa.Endpoint {
@@ -52,3 +81,89 @@ func (c *Client) OperatorGetSecretValue() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// BatchGetSecretValues calls the "BatchGetSecretValues" function in
+// secretspb.SecretsClient interface.
+func (c *Client) BatchGetSecretValues() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildBatchGetSecretValuesFunc(c.grpccli, c.opts...),
+			EncodeBatchGetSecretValuesRequest,
+			DecodeBatchGetSecretValuesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RenderTemplate calls the "RenderTemplate" function in
+// secretspb.SecretsClient interface.
+func (c *Client) RenderTemplate() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRenderTemplateFunc(c.grpccli, c.opts...),
+			EncodeRenderTemplateRequest,
+			DecodeRenderTemplateResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// WatchSecrets calls the "WatchSecrets" function in secretspb.SecretsClient
+// interface.
+func (c *Client) WatchSecrets() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildWatchSecretsFunc(c.grpccli, c.opts...),
+			EncodeWatchSecretsRequest,
+			DecodeWatchSecretsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Recv reads instances of "secretspb.WatchSecretsResponse" from the "watch
+// secrets" endpoint gRPC stream.
+func (s *WatchSecretsClientStream) Recv() (*secrets.SecretEvent, error) {
+	var res *secrets.SecretEvent
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	if err = ValidateWatchSecretsResponse(v); err != nil {
+		return res, err
+	}
+	return NewWatchSecretsResponseSecretEvent(v), nil
+}
+
+// RecvWithContext reads instances of "secretspb.WatchSecretsResponse" from the
+// "watch secrets" endpoint gRPC stream with context.
+func (s *WatchSecretsClientStream) RecvWithContext(ctx context.Context) (*secrets.SecretEvent, error) {
+	return s.Recv()
+}
@@ -17,6 +17,44 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// BuildBrowseSecretsFunc builds the remote method to invoke for "secrets"
+// service "browse secrets" endpoint.
+func BuildBrowseSecretsFunc(grpccli secretspb.SecretsClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.BrowseSecrets(ctx, reqpb.(*secretspb.BrowseSecretsRequest), opts...)
+		}
+		return grpccli.BrowseSecrets(ctx, &secretspb.BrowseSecretsRequest{}, opts...)
+	}
+}
+
+// EncodeBrowseSecretsRequest encodes requests sent to secrets browse secrets
+// endpoint.
+func EncodeBrowseSecretsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*secrets.BrowseSecretsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "browse secrets", "*secrets.BrowseSecretsPayload", v)
+	}
+	return NewProtoBrowseSecretsRequest(payload), nil
+}
+
+// DecodeBrowseSecretsResponse decodes responses from the secrets browse
+// secrets endpoint.
+func DecodeBrowseSecretsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*secretspb.BrowseSecretsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "browse secrets", "*secretspb.BrowseSecretsResponse", v)
+	}
+	if err := ValidateBrowseSecretsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewBrowseSecretsResult(message)
+	return res, nil
+}
+
 // BuildOperatorGetSecretValueFunc builds the remote method to invoke for
 // "secrets" service "operator get secret value" endpoint.
 func BuildOperatorGetSecretValueFunc(grpccli secretspb.SecretsClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -51,3 +89,108 @@ func DecodeOperatorGetSecretValueResponse(ctx context.Context, v any, hdr, trlr
 	res := NewOperatorGetSecretValueResult(message)
 	return res, nil
 }
+
+// BuildBatchGetSecretValuesFunc builds the remote method to invoke for
+// "secrets" service "batch get secret values" endpoint.
+func BuildBatchGetSecretValuesFunc(grpccli secretspb.SecretsClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.BatchGetSecretValues(ctx, reqpb.(*secretspb.BatchGetSecretValuesRequest), opts...)
+		}
+		return grpccli.BatchGetSecretValues(ctx, &secretspb.BatchGetSecretValuesRequest{}, opts...)
+	}
+}
+
+// EncodeBatchGetSecretValuesRequest encodes requests sent to secrets batch get
+// secret values endpoint.
+func EncodeBatchGetSecretValuesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*secrets.BatchGetSecretValuesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "batch get secret values", "*secrets.BatchGetSecretValuesPayload", v)
+	}
+	return NewProtoBatchGetSecretValuesRequest(payload), nil
+}
+
+// DecodeBatchGetSecretValuesResponse decodes responses from the secrets batch
+// get secret values endpoint.
+func DecodeBatchGetSecretValuesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*secretspb.BatchGetSecretValuesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "batch get secret values", "*secretspb.BatchGetSecretValuesResponse", v)
+	}
+	if err := ValidateBatchGetSecretValuesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewBatchGetSecretValuesResult(message)
+	return res, nil
+}
+
+// BuildRenderTemplateFunc builds the remote method to invoke for "secrets"
+// service "render template" endpoint.
+func BuildRenderTemplateFunc(grpccli secretspb.SecretsClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RenderTemplate(ctx, reqpb.(*secretspb.RenderTemplateRequest), opts...)
+		}
+		return grpccli.RenderTemplate(ctx, &secretspb.RenderTemplateRequest{}, opts...)
+	}
+}
+
+// EncodeRenderTemplateRequest encodes requests sent to secrets render template
+// endpoint.
+func EncodeRenderTemplateRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*secrets.RenderTemplatePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "render template", "*secrets.RenderTemplatePayload", v)
+	}
+	return NewProtoRenderTemplateRequest(payload), nil
+}
+
+// DecodeRenderTemplateResponse decodes responses from the secrets render
+// template endpoint.
+func DecodeRenderTemplateResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*secretspb.RenderTemplateResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "render template", "*secretspb.RenderTemplateResponse", v)
+	}
+	res := NewRenderTemplateResult(message)
+	return res, nil
+}
+
+// BuildWatchSecretsFunc builds the remote method to invoke for "secrets"
+// service "watch secrets" endpoint.
+func BuildWatchSecretsFunc(grpccli secretspb.SecretsClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.WatchSecrets(ctx, reqpb.(*secretspb.WatchSecretsRequest), opts...)
+		}
+		return grpccli.WatchSecrets(ctx, &secretspb.WatchSecretsRequest{}, opts...)
+	}
+}
+
+// EncodeWatchSecretsRequest encodes requests sent to secrets watch secrets
+// endpoint.
+func EncodeWatchSecretsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*secrets.WatchSecretsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "watch secrets", "*secrets.WatchSecretsPayload", v)
+	}
+	return NewProtoWatchSecretsRequest(payload), nil
+}
+
+// DecodeWatchSecretsResponse decodes responses from the secrets watch secrets
+// endpoint.
+func DecodeWatchSecretsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &WatchSecretsClientStream{
+		stream: v.(secretspb.Secrets_WatchSecretsClient),
+	}, nil
+}
@@ -10,13 +10,49 @@ package client
 import (
 	secretspb "github.com/Vidalee/FishyKeys/gen/grpc/secrets/pb"
 	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// NewProtoBrowseSecretsRequest builds the gRPC request type from the payload
+// of the "browse secrets" endpoint of the "secrets" service.
+func NewProtoBrowseSecretsRequest(payload *secrets.BrowseSecretsPayload) *secretspb.BrowseSecretsRequest {
+	message := &secretspb.BrowseSecretsRequest{
+		Prefix:    payload.Prefix,
+		Recursive: &payload.Recursive,
+		Cursor:    payload.Cursor,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewBrowseSecretsResult builds the result type of the "browse secrets"
+// endpoint of the "secrets" service from the gRPC response type.
+func NewBrowseSecretsResult(message *secretspb.BrowseSecretsResponse) *secrets.BrowseSecretsResult {
+	result := &secrets.BrowseSecretsResult{
+		NextCursor: message.NextCursor,
+	}
+	if message.Entries != nil {
+		result.Entries = make([]*secrets.SecretEntry, len(message.Entries))
+		for i, val := range message.Entries {
+			result.Entries[i] = &secrets.SecretEntry{
+				Path:      val.Path,
+				Name:      val.Name,
+				Folder:    val.Folder,
+				CreatedAt: val.CreatedAt,
+				UpdatedAt: val.UpdatedAt,
+			}
+		}
+	}
+	return result
+}
+
 // NewProtoOperatorGetSecretValueRequest builds the gRPC request type from the
 // payload of the "operator get secret value" endpoint of the "secrets" service.
 func NewProtoOperatorGetSecretValueRequest(payload *secrets.OperatorGetSecretValuePayload) *secretspb.OperatorGetSecretValueRequest {
 	message := &secretspb.OperatorGetSecretValueRequest{
-		Path: payload.Path,
+		Path:  payload.Path,
+		Field: payload.Field,
 	}
 	return message
 }
@@ -25,8 +61,157 @@ func NewProtoOperatorGetSecretValueRequest(payload *secrets.OperatorGetSecretVal
 // secret value" endpoint of the "secrets" service from the gRPC response type.
 func NewOperatorGetSecretValueResult(message *secretspb.OperatorGetSecretValueResponse) *secrets.OperatorGetSecretValueResult {
 	result := &secrets.OperatorGetSecretValueResult{
-		Value: message.Value,
-		Path:  message.Path,
+		Value:       message.Value,
+		Path:        message.Path,
+		RawValue:    message.RawValue,
+		ContentType: message.ContentType,
+		Filename:    message.Filename,
+	}
+	if message.Fields != nil {
+		result.Fields = make(map[string]string, len(message.Fields))
+		for key, val := range message.Fields {
+			tk := key
+			tv := val
+			result.Fields[tk] = tv
+		}
 	}
 	return result
 }
+
+// NewProtoBatchGetSecretValuesRequest builds the gRPC request type from the
+// payload of the "batch get secret values" endpoint of the "secrets" service.
+func NewProtoBatchGetSecretValuesRequest(payload *secrets.BatchGetSecretValuesPayload) *secretspb.BatchGetSecretValuesRequest {
+	message := &secretspb.BatchGetSecretValuesRequest{}
+	if payload.Secrets != nil {
+		message.Secrets = make([]*secretspb.SecretValueRequest, len(payload.Secrets))
+		for i, val := range payload.Secrets {
+			message.Secrets[i] = &secretspb.SecretValueRequest{
+				Path:  val.Path,
+				Field: val.Field,
+			}
+		}
+	}
+	return message
+}
+
+// NewBatchGetSecretValuesResult builds the result type of the "batch get
+// secret values" endpoint of the "secrets" service from the gRPC response type.
+func NewBatchGetSecretValuesResult(message *secretspb.BatchGetSecretValuesResponse) *secrets.BatchGetSecretValuesResult {
+	result := &secrets.BatchGetSecretValuesResult{}
+	if message.Secrets != nil {
+		result.Secrets = make([]*secrets.SecretValue, len(message.Secrets))
+		for i, val := range message.Secrets {
+			result.Secrets[i] = &secrets.SecretValue{
+				Path:         val.Path,
+				Value:        val.Value,
+				RawValue:     val.RawValue,
+				ContentType:  val.ContentType,
+				Filename:     val.Filename,
+				Error:        val.Error,
+				ErrorMessage: val.ErrorMessage,
+			}
+			if val.Fields != nil {
+				result.Secrets[i].Fields = make(map[string]string, len(val.Fields))
+				for key, val := range val.Fields {
+					tk := key
+					tv := val
+					result.Secrets[i].Fields[tk] = tv
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoRenderTemplateRequest builds the gRPC request type from the payload
+// of the "render template" endpoint of the "secrets" service.
+func NewProtoRenderTemplateRequest(payload *secrets.RenderTemplatePayload) *secretspb.RenderTemplateRequest {
+	message := &secretspb.RenderTemplateRequest{
+		Template:     payload.Template,
+		TemplatePath: payload.TemplatePath,
+	}
+	return message
+}
+
+// NewRenderTemplateResult builds the result type of the "render template"
+// endpoint of the "secrets" service from the gRPC response type.
+func NewRenderTemplateResult(message *secretspb.RenderTemplateResponse) *secrets.RenderTemplateResult {
+	result := &secrets.RenderTemplateResult{
+		Rendered: message.Rendered,
+	}
+	return result
+}
+
+// NewProtoWatchSecretsRequest builds the gRPC request type from the payload of
+// the "watch secrets" endpoint of the "secrets" service.
+func NewProtoWatchSecretsRequest(payload *secrets.WatchSecretsPayload) *secretspb.WatchSecretsRequest {
+	message := &secretspb.WatchSecretsRequest{
+		Prefix:      payload.Prefix,
+		ResumeToken: payload.ResumeToken,
+	}
+	if payload.Paths != nil {
+		message.Paths = make([]string, len(payload.Paths))
+		for i, val := range payload.Paths {
+			message.Paths[i] = val
+		}
+	}
+	return message
+}
+
+func NewWatchSecretsResponseSecretEvent(v *secretspb.WatchSecretsResponse) *secrets.SecretEvent {
+	result := &secrets.SecretEvent{
+		Type:        v.Type,
+		Path:        v.Path,
+		ResumeToken: v.ResumeToken,
+		OccurredAt:  v.OccurredAt,
+	}
+	if v.Version != nil {
+		version := int(*v.Version)
+		result.Version = &version
+	}
+	return result
+}
+
+// ValidateBrowseSecretsResponse runs the validations defined on
+// BrowseSecretsResponse.
+func ValidateBrowseSecretsResponse(message *secretspb.BrowseSecretsResponse) (err error) {
+	if message.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "message"))
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesResponse runs the validations defined on
+// BatchGetSecretValuesResponse.
+func ValidateBatchGetSecretValuesResponse(message *secretspb.BatchGetSecretValuesResponse) (err error) {
+	if message.Secrets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secrets", "message"))
+	}
+	for _, e := range message.Secrets {
+		if e != nil {
+			if err2 := ValidateSecretValue(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSecretValue runs the validations defined on SecretValue.
+func ValidateSecretValue(elem *secretspb.SecretValue) (err error) {
+	if elem.Error != nil {
+		if !(*elem.Error == "invalid_parameters" || *elem.Error == "forbidden" || *elem.Error == "secret_not_found" || *elem.Error == "field_not_found" || *elem.Error == "internal_error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.error", *elem.Error, []any{"invalid_parameters", "forbidden", "secret_not_found", "field_not_found", "internal_error"}))
+		}
+	}
+	return
+}
+
+// ValidateWatchSecretsResponse runs the validations defined on
+// WatchSecretsResponse.
+func ValidateWatchSecretsResponse(stream *secretspb.WatchSecretsResponse) (err error) {
+	if !(stream.Type == "bookmark" || stream.Type == "secret.created" || stream.Type == "secret.updated" || stream.Type == "secret.deleted" || stream.Type == "secret.access_changed") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.type", stream.Type, []any{"bookmark", "secret.created", "secret.updated", "secret.deleted", "secret.access_changed"}))
+	}
+	return
+}
@@ -28,17 +28,226 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BrowseSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64 encoded folder path
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// List every secret below the prefix instead of its immediate children
+	Recursive *bool `protobuf:"varint,2,opt,name=recursive,proto3,oneof" json:"recursive,omitempty"`
+	// Cursor returned by a previous call to fetch the next page
+	Cursor *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Maximum number of entries to return
+	Limit         *int32 `protobuf:"zigzag32,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseSecretsRequest) Reset() {
+	*x = BrowseSecretsRequest{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseSecretsRequest) ProtoMessage() {}
+
+func (x *BrowseSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseSecretsRequest.ProtoReflect.Descriptor instead.
+func (*BrowseSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BrowseSecretsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *BrowseSecretsRequest) GetRecursive() bool {
+	if x != nil && x.Recursive != nil {
+		return *x.Recursive
+	}
+	return false
+}
+
+func (x *BrowseSecretsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *BrowseSecretsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type BrowseSecretsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The folders and secrets found under the prefix
+	Entries []*SecretEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Cursor to fetch the next page, absent on the last page
+	NextCursor    *string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseSecretsResponse) Reset() {
+	*x = BrowseSecretsResponse{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseSecretsResponse) ProtoMessage() {}
+
+func (x *BrowseSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseSecretsResponse.ProtoReflect.Descriptor instead.
+func (*BrowseSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BrowseSecretsResponse) GetEntries() []*SecretEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BrowseSecretsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type SecretEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full path of the folder or secret, folders end with a '/'
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The last segment of the path
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the entry is a folder
+	Folder bool `protobuf:"varint,3,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Creation timestamp of the secret, absent for folders
+	CreatedAt *string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`
+	// Last update timestamp of the secret, absent for folders
+	UpdatedAt     *string `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretEntry) Reset() {
+	*x = SecretEntry{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretEntry) ProtoMessage() {}
+
+func (x *SecretEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretEntry.ProtoReflect.Descriptor instead.
+func (*SecretEntry) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SecretEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SecretEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretEntry) GetFolder() bool {
+	if x != nil {
+		return x.Folder
+	}
+	return false
+}
+
+func (x *SecretEntry) GetCreatedAt() string {
+	if x != nil && x.CreatedAt != nil {
+		return *x.CreatedAt
+	}
+	return ""
+}
+
+func (x *SecretEntry) GetUpdatedAt() string {
+	if x != nil && x.UpdatedAt != nil {
+		return *x.UpdatedAt
+	}
+	return ""
+}
+
 type OperatorGetSecretValueRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Base64 encoded secret's path
-	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Name of the field to retrieve from a structured secret
+	Field         *string `protobuf:"bytes,2,opt,name=field,proto3,oneof" json:"field,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OperatorGetSecretValueRequest) Reset() {
 	*x = OperatorGetSecretValueRequest{}
-	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[0]
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -50,7 +259,7 @@ func (x *OperatorGetSecretValueRequest) String() string {
 func (*OperatorGetSecretValueRequest) ProtoMessage() {}
 
 func (x *OperatorGetSecretValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[0]
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +272,7 @@ func (x *OperatorGetSecretValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperatorGetSecretValueRequest.ProtoReflect.Descriptor instead.
 func (*OperatorGetSecretValueRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{0}
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *OperatorGetSecretValueRequest) GetPath() string {
@@ -73,19 +282,35 @@ func (x *OperatorGetSecretValueRequest) GetPath() string {
 	return ""
 }
 
+func (x *OperatorGetSecretValueRequest) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
 type OperatorGetSecretValueResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The secret value
+	// The secret value, or the value of the requested field
 	Value *string `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	// The original path of the secret
-	Path          *string `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	Path *string `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte `protobuf:"bytes,4,opt,name=raw_value,json=rawValue,proto3,oneof" json:"raw_value,omitempty"`
+	// Content type of a binary secret
+	ContentType *string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	// Original filename of a binary secret
+	Filename      *string `protobuf:"bytes,6,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OperatorGetSecretValueResponse) Reset() {
 	*x = OperatorGetSecretValueResponse{}
-	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[1]
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -97,7 +322,7 @@ func (x *OperatorGetSecretValueResponse) String() string {
 func (*OperatorGetSecretValueResponse) ProtoMessage() {}
 
 func (x *OperatorGetSecretValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[1]
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +335,7 @@ func (x *OperatorGetSecretValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperatorGetSecretValueResponse.ProtoReflect.Descriptor instead.
 func (*OperatorGetSecretValueResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{1}
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *OperatorGetSecretValueResponse) GetValue() string {
@@ -127,20 +352,637 @@ func (x *OperatorGetSecretValueResponse) GetPath() string {
 	return ""
 }
 
+func (x *OperatorGetSecretValueResponse) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *OperatorGetSecretValueResponse) GetRawValue() []byte {
+	if x != nil {
+		return x.RawValue
+	}
+	return nil
+}
+
+func (x *OperatorGetSecretValueResponse) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *OperatorGetSecretValueResponse) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+type BatchGetSecretValuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The secrets to read
+	Secrets       []*SecretValueRequest `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSecretValuesRequest) Reset() {
+	*x = BatchGetSecretValuesRequest{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSecretValuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSecretValuesRequest) ProtoMessage() {}
+
+func (x *BatchGetSecretValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSecretValuesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetSecretValuesRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetSecretValuesRequest) GetSecrets() []*SecretValueRequest {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type SecretValueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64 encoded secret's path
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Name of the field to retrieve from a structured secret
+	Field         *string `protobuf:"bytes,2,opt,name=field,proto3,oneof" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretValueRequest) Reset() {
+	*x = SecretValueRequest{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretValueRequest) ProtoMessage() {}
+
+func (x *SecretValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretValueRequest.ProtoReflect.Descriptor instead.
+func (*SecretValueRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SecretValueRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SecretValueRequest) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+type BatchGetSecretValuesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The values of the secrets, in the order of the request
+	Secrets       []*SecretValue `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetSecretValuesResponse) Reset() {
+	*x = BatchGetSecretValuesResponse{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetSecretValuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetSecretValuesResponse) ProtoMessage() {}
+
+func (x *BatchGetSecretValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetSecretValuesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetSecretValuesResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetSecretValuesResponse) GetSecrets() []*SecretValue {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+type SecretValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64 encoded secret's path, as requested
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The secret value, or the value of the requested field
+	Value *string `protobuf:"bytes,2,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte `protobuf:"bytes,4,opt,name=raw_value,json=rawValue,proto3,oneof" json:"raw_value,omitempty"`
+	// Content type of a binary secret
+	ContentType *string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3,oneof" json:"content_type,omitempty"`
+	// Original filename of a binary secret
+	Filename *string `protobuf:"bytes,6,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+	// Why the value could not be read, the other fields are absent when set
+	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Description of the error
+	ErrorMessage  *string `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretValue) Reset() {
+	*x = SecretValue{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretValue) ProtoMessage() {}
+
+func (x *SecretValue) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretValue.ProtoReflect.Descriptor instead.
+func (*SecretValue) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SecretValue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SecretValue) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *SecretValue) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SecretValue) GetRawValue() []byte {
+	if x != nil {
+		return x.RawValue
+	}
+	return nil
+}
+
+func (x *SecretValue) GetContentType() string {
+	if x != nil && x.ContentType != nil {
+		return *x.ContentType
+	}
+	return ""
+}
+
+func (x *SecretValue) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
+	}
+	return ""
+}
+
+func (x *SecretValue) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *SecretValue) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+type RenderTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The template to render
+	Template *string `protobuf:"bytes,1,opt,name=template,proto3,oneof" json:"template,omitempty"`
+	// Base64 encoded path of a secret holding the template to render
+	TemplatePath  *string `protobuf:"bytes,2,opt,name=template_path,json=templatePath,proto3,oneof" json:"template_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateRequest) Reset() {
+	*x = RenderTemplateRequest{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateRequest) ProtoMessage() {}
+
+func (x *RenderTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateRequest.ProtoReflect.Descriptor instead.
+func (*RenderTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RenderTemplateRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+func (x *RenderTemplateRequest) GetTemplatePath() string {
+	if x != nil && x.TemplatePath != nil {
+		return *x.TemplatePath
+	}
+	return ""
+}
+
+type RenderTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rendered document
+	Rendered      string `protobuf:"bytes,1,opt,name=rendered,proto3" json:"rendered,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderTemplateResponse) Reset() {
+	*x = RenderTemplateResponse{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderTemplateResponse) ProtoMessage() {}
+
+func (x *RenderTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RenderTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RenderTemplateResponse) GetRendered() string {
+	if x != nil {
+		return x.Rendered
+	}
+	return ""
+}
+
+type WatchSecretsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base64 encoded paths of the secrets to watch
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	// Base64 encoded prefix of the paths of the secrets to watch
+	Prefix *string `protobuf:"bytes,2,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	// Resume token of the last event received
+	ResumeToken   *string `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3,oneof" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSecretsRequest) Reset() {
+	*x = WatchSecretsRequest{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSecretsRequest) ProtoMessage() {}
+
+func (x *WatchSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSecretsRequest.ProtoReflect.Descriptor instead.
+func (*WatchSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchSecretsRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *WatchSecretsRequest) GetPrefix() string {
+	if x != nil && x.Prefix != nil {
+		return *x.Prefix
+	}
+	return ""
+}
+
+func (x *WatchSecretsRequest) GetResumeToken() string {
+	if x != nil && x.ResumeToken != nil {
+		return *x.ResumeToken
+	}
+	return ""
+}
+
+type WatchSecretsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the event. A bookmark is sent first, with the position the watch
+	// starts from
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The original path of the secret
+	Path *string `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// Version of the secret's value when the event occurred
+	Version *int32 `protobuf:"zigzag32,3,opt,name=version,proto3,oneof" json:"version,omitempty"`
+	// Token to resume the watch right after this event
+	ResumeToken string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	// Time of the event
+	OccurredAt    *string `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3,oneof" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSecretsResponse) Reset() {
+	*x = WatchSecretsResponse{}
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSecretsResponse) ProtoMessage() {}
+
+func (x *WatchSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_FishyKeys_secrets_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSecretsResponse.ProtoReflect.Descriptor instead.
+func (*WatchSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_FishyKeys_secrets_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchSecretsResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchSecretsResponse) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
+	}
+	return ""
+}
+
+func (x *WatchSecretsResponse) GetVersion() int32 {
+	if x != nil && x.Version != nil {
+		return *x.Version
+	}
+	return 0
+}
+
+func (x *WatchSecretsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchSecretsResponse) GetOccurredAt() string {
+	if x != nil && x.OccurredAt != nil {
+		return *x.OccurredAt
+	}
+	return ""
+}
+
 var File_goagen_FishyKeys_secrets_proto protoreflect.FileDescriptor
 
 const file_goagen_FishyKeys_secrets_proto_rawDesc = "" +
 	"\n" +
-	"\x1egoagen_FishyKeys_secrets.proto\x12\asecrets\"3\n" +
+	"\x1egoagen_FishyKeys_secrets.proto\x12\asecrets\"\xac\x01\n" +
+	"\x14BrowseSecretsRequest\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12!\n" +
+	"\trecursive\x18\x02 \x01(\bH\x00R\trecursive\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12\x19\n" +
+	"\x05limit\x18\x04 \x01(\x11H\x02R\x05limit\x88\x01\x01B\f\n" +
+	"\n" +
+	"_recursiveB\t\n" +
+	"\a_cursorB\b\n" +
+	"\x06_limit\"}\n" +
+	"\x15BrowseSecretsResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.secrets.SecretEntryR\aentries\x12$\n" +
+	"\vnext_cursor\x18\x02 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xb3\x01\n" +
+	"\vSecretEntry\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06folder\x18\x03 \x01(\bR\x06folder\x12\"\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tH\x00R\tcreatedAt\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tH\x01R\tupdatedAt\x88\x01\x01B\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_at\"X\n" +
 	"\x1dOperatorGetSecretValueRequest\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\"g\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x19\n" +
+	"\x05field\x18\x02 \x01(\tH\x00R\x05field\x88\x01\x01B\b\n" +
+	"\x06_field\"\x86\x03\n" +
 	"\x1eOperatorGetSecretValueResponse\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x17\n" +
-	"\x04path\x18\x02 \x01(\tH\x01R\x04path\x88\x01\x01B\b\n" +
+	"\x04path\x18\x02 \x01(\tH\x01R\x04path\x88\x01\x01\x12K\n" +
+	"\x06fields\x18\x03 \x03(\v23.secrets.OperatorGetSecretValueResponse.FieldsEntryR\x06fields\x12 \n" +
+	"\traw_value\x18\x04 \x01(\fH\x02R\brawValue\x88\x01\x01\x12&\n" +
+	"\fcontent_type\x18\x05 \x01(\tH\x03R\vcontentType\x88\x01\x01\x12\x1f\n" +
+	"\bfilename\x18\x06 \x01(\tH\x04R\bfilename\x88\x01\x01\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
 	"\x06_valueB\a\n" +
-	"\x05_path2t\n" +
-	"\aSecrets\x12i\n" +
-	"\x16OperatorGetSecretValue\x12&.secrets.OperatorGetSecretValueRequest\x1a'.secrets.OperatorGetSecretValueResponseB\fZ\n" +
+	"\x05_pathB\f\n" +
+	"\n" +
+	"_raw_valueB\x0f\n" +
+	"\r_content_typeB\v\n" +
+	"\t_filename\"T\n" +
+	"\x1bBatchGetSecretValuesRequest\x125\n" +
+	"\asecrets\x18\x01 \x03(\v2\x1b.secrets.SecretValueRequestR\asecrets\"M\n" +
+	"\x12SecretValueRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x19\n" +
+	"\x05field\x18\x02 \x01(\tH\x00R\x05field\x88\x01\x01B\b\n" +
+	"\x06_field\"N\n" +
+	"\x1cBatchGetSecretValuesResponse\x12.\n" +
+	"\asecrets\x18\x01 \x03(\v2\x14.secrets.SecretValueR\asecrets\"\xb3\x03\n" +
+	"\vSecretValue\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x19\n" +
+	"\x05value\x18\x02 \x01(\tH\x00R\x05value\x88\x01\x01\x128\n" +
+	"\x06fields\x18\x03 \x03(\v2 .secrets.SecretValue.FieldsEntryR\x06fields\x12 \n" +
+	"\traw_value\x18\x04 \x01(\fH\x01R\brawValue\x88\x01\x01\x12&\n" +
+	"\fcontent_type\x18\x05 \x01(\tH\x02R\vcontentType\x88\x01\x01\x12\x1f\n" +
+	"\bfilename\x18\x06 \x01(\tH\x03R\bfilename\x88\x01\x01\x12\x19\n" +
+	"\x05error\x18\a \x01(\tH\x04R\x05error\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\b \x01(\tH\x05R\ferrorMessage\x88\x01\x01\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_valueB\f\n" +
+	"\n" +
+	"_raw_valueB\x0f\n" +
+	"\r_content_typeB\v\n" +
+	"\t_filenameB\b\n" +
+	"\x06_errorB\x10\n" +
+	"\x0e_error_message\"\x81\x01\n" +
+	"\x15RenderTemplateRequest\x12\x1f\n" +
+	"\btemplate\x18\x01 \x01(\tH\x00R\btemplate\x88\x01\x01\x12(\n" +
+	"\rtemplate_path\x18\x02 \x01(\tH\x01R\ftemplatePath\x88\x01\x01B\v\n" +
+	"\t_templateB\x10\n" +
+	"\x0e_template_path\"4\n" +
+	"\x16RenderTemplateResponse\x12\x1a\n" +
+	"\brendered\x18\x01 \x01(\tR\brendered\"\x8c\x01\n" +
+	"\x13WatchSecretsRequest\x12\x14\n" +
+	"\x05paths\x18\x01 \x03(\tR\x05paths\x12\x1b\n" +
+	"\x06prefix\x18\x02 \x01(\tH\x00R\x06prefix\x88\x01\x01\x12&\n" +
+	"\fresume_token\x18\x03 \x01(\tH\x01R\vresumeToken\x88\x01\x01B\t\n" +
+	"\a_prefixB\x0f\n" +
+	"\r_resume_token\"\xd0\x01\n" +
+	"\x14WatchSecretsResponse\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x17\n" +
+	"\x04path\x18\x02 \x01(\tH\x00R\x04path\x88\x01\x01\x12\x1d\n" +
+	"\aversion\x18\x03 \x01(\x11H\x01R\aversion\x88\x01\x01\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\x12$\n" +
+	"\voccurred_at\x18\x05 \x01(\tH\x02R\n" +
+	"occurredAt\x88\x01\x01B\a\n" +
+	"\x05_pathB\n" +
+	"\n" +
+	"\b_versionB\x0e\n" +
+	"\f_occurred_at2\xcb\x03\n" +
+	"\aSecrets\x12N\n" +
+	"\rBrowseSecrets\x12\x1d.secrets.BrowseSecretsRequest\x1a\x1e.secrets.BrowseSecretsResponse\x12i\n" +
+	"\x16OperatorGetSecretValue\x12&.secrets.OperatorGetSecretValueRequest\x1a'.secrets.OperatorGetSecretValueResponse\x12c\n" +
+	"\x14BatchGetSecretValues\x12$.secrets.BatchGetSecretValuesRequest\x1a%.secrets.BatchGetSecretValuesResponse\x12Q\n" +
+	"\x0eRenderTemplate\x12\x1e.secrets.RenderTemplateRequest\x1a\x1f.secrets.RenderTemplateResponse\x12M\n" +
+	"\fWatchSecrets\x12\x1c.secrets.WatchSecretsRequest\x1a\x1d.secrets.WatchSecretsResponse0\x01B\fZ\n" +
 	"/secretspbb\x06proto3"
 
 var (
@@ -155,19 +997,45 @@ func file_goagen_FishyKeys_secrets_proto_rawDescGZIP() []byte {
 	return file_goagen_FishyKeys_secrets_proto_rawDescData
 }
 
-var file_goagen_FishyKeys_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_goagen_FishyKeys_secrets_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_goagen_FishyKeys_secrets_proto_goTypes = []any{
-	(*OperatorGetSecretValueRequest)(nil),  // 0: secrets.OperatorGetSecretValueRequest
-	(*OperatorGetSecretValueResponse)(nil), // 1: secrets.OperatorGetSecretValueResponse
+	(*BrowseSecretsRequest)(nil),           // 0: secrets.BrowseSecretsRequest
+	(*BrowseSecretsResponse)(nil),          // 1: secrets.BrowseSecretsResponse
+	(*SecretEntry)(nil),                    // 2: secrets.SecretEntry
+	(*OperatorGetSecretValueRequest)(nil),  // 3: secrets.OperatorGetSecretValueRequest
+	(*OperatorGetSecretValueResponse)(nil), // 4: secrets.OperatorGetSecretValueResponse
+	(*BatchGetSecretValuesRequest)(nil),    // 5: secrets.BatchGetSecretValuesRequest
+	(*SecretValueRequest)(nil),             // 6: secrets.SecretValueRequest
+	(*BatchGetSecretValuesResponse)(nil),   // 7: secrets.BatchGetSecretValuesResponse
+	(*SecretValue)(nil),                    // 8: secrets.SecretValue
+	(*RenderTemplateRequest)(nil),          // 9: secrets.RenderTemplateRequest
+	(*RenderTemplateResponse)(nil),         // 10: secrets.RenderTemplateResponse
+	(*WatchSecretsRequest)(nil),            // 11: secrets.WatchSecretsRequest
+	(*WatchSecretsResponse)(nil),           // 12: secrets.WatchSecretsResponse
+	nil,                                    // 13: secrets.OperatorGetSecretValueResponse.FieldsEntry
+	nil,                                    // 14: secrets.SecretValue.FieldsEntry
 }
 var file_goagen_FishyKeys_secrets_proto_depIdxs = []int32{
-	0, // 0: secrets.Secrets.OperatorGetSecretValue:input_type -> secrets.OperatorGetSecretValueRequest
-	1, // 1: secrets.Secrets.OperatorGetSecretValue:output_type -> secrets.OperatorGetSecretValueResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: secrets.BrowseSecretsResponse.entries:type_name -> secrets.SecretEntry
+	13, // 1: secrets.OperatorGetSecretValueResponse.fields:type_name -> secrets.OperatorGetSecretValueResponse.FieldsEntry
+	6,  // 2: secrets.BatchGetSecretValuesRequest.secrets:type_name -> secrets.SecretValueRequest
+	8,  // 3: secrets.BatchGetSecretValuesResponse.secrets:type_name -> secrets.SecretValue
+	14, // 4: secrets.SecretValue.fields:type_name -> secrets.SecretValue.FieldsEntry
+	0,  // 5: secrets.Secrets.BrowseSecrets:input_type -> secrets.BrowseSecretsRequest
+	3,  // 6: secrets.Secrets.OperatorGetSecretValue:input_type -> secrets.OperatorGetSecretValueRequest
+	5,  // 7: secrets.Secrets.BatchGetSecretValues:input_type -> secrets.BatchGetSecretValuesRequest
+	9,  // 8: secrets.Secrets.RenderTemplate:input_type -> secrets.RenderTemplateRequest
+	11, // 9: secrets.Secrets.WatchSecrets:input_type -> secrets.WatchSecretsRequest
+	1,  // 10: secrets.Secrets.BrowseSecrets:output_type -> secrets.BrowseSecretsResponse
+	4,  // 11: secrets.Secrets.OperatorGetSecretValue:output_type -> secrets.OperatorGetSecretValueResponse
+	7,  // 12: secrets.Secrets.BatchGetSecretValues:output_type -> secrets.BatchGetSecretValuesResponse
+	10, // 13: secrets.Secrets.RenderTemplate:output_type -> secrets.RenderTemplateResponse
+	12, // 14: secrets.Secrets.WatchSecrets:output_type -> secrets.WatchSecretsResponse
+	10, // [10:15] is the sub-list for method output_type
+	5,  // [5:10] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_goagen_FishyKeys_secrets_proto_init() }
@@ -175,14 +1043,23 @@ func file_goagen_FishyKeys_secrets_proto_init() {
 	if File_goagen_FishyKeys_secrets_proto != nil {
 		return
 	}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[0].OneofWrappers = []any{}
 	file_goagen_FishyKeys_secrets_proto_msgTypes[1].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[2].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[3].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[4].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[6].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[8].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[9].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[11].OneofWrappers = []any{}
+	file_goagen_FishyKeys_secrets_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_FishyKeys_secrets_proto_rawDesc), len(file_goagen_FishyKeys_secrets_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -16,6 +16,39 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// EncodeBrowseSecretsResponse encodes responses from the "secrets" service
+// "browse secrets" endpoint.
+func EncodeBrowseSecretsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*secrets.BrowseSecretsResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "browse secrets", "*secrets.BrowseSecretsResult", v)
+	}
+	resp := NewProtoBrowseSecretsResponse(result)
+	return resp, nil
+}
+
+// DecodeBrowseSecretsRequest decodes requests sent to "secrets" service
+// "browse secrets" endpoint.
+func DecodeBrowseSecretsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *secretspb.BrowseSecretsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*secretspb.BrowseSecretsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("secrets", "browse secrets", "*secretspb.BrowseSecretsRequest", v)
+		}
+		if err := ValidateBrowseSecretsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *secrets.BrowseSecretsPayload
+	{
+		payload = NewBrowseSecretsPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeOperatorGetSecretValueResponse encodes responses from the "secrets"
 // service "operator get secret value" endpoint.
 func EncodeOperatorGetSecretValueResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
@@ -48,3 +81,102 @@ func DecodeOperatorGetSecretValueRequest(ctx context.Context, v any, md metadata
 	}
 	return payload, nil
 }
+
+// EncodeBatchGetSecretValuesResponse encodes responses from the "secrets"
+// service "batch get secret values" endpoint.
+func EncodeBatchGetSecretValuesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*secrets.BatchGetSecretValuesResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "batch get secret values", "*secrets.BatchGetSecretValuesResult", v)
+	}
+	resp := NewProtoBatchGetSecretValuesResponse(result)
+	return resp, nil
+}
+
+// DecodeBatchGetSecretValuesRequest decodes requests sent to "secrets" service
+// "batch get secret values" endpoint.
+func DecodeBatchGetSecretValuesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *secretspb.BatchGetSecretValuesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*secretspb.BatchGetSecretValuesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("secrets", "batch get secret values", "*secretspb.BatchGetSecretValuesRequest", v)
+		}
+		if err := ValidateBatchGetSecretValuesRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *secrets.BatchGetSecretValuesPayload
+	{
+		payload = NewBatchGetSecretValuesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRenderTemplateResponse encodes responses from the "secrets" service
+// "render template" endpoint.
+func EncodeRenderTemplateResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*secrets.RenderTemplateResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "render template", "*secrets.RenderTemplateResult", v)
+	}
+	resp := NewProtoRenderTemplateResponse(result)
+	return resp, nil
+}
+
+// DecodeRenderTemplateRequest decodes requests sent to "secrets" service
+// "render template" endpoint.
+func DecodeRenderTemplateRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *secretspb.RenderTemplateRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*secretspb.RenderTemplateRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("secrets", "render template", "*secretspb.RenderTemplateRequest", v)
+		}
+		if err := ValidateRenderTemplateRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *secrets.RenderTemplatePayload
+	{
+		payload = NewRenderTemplatePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeWatchSecretsResponse encodes responses from the "secrets" service
+// "watch secrets" endpoint.
+func EncodeWatchSecretsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*secrets.SecretEvent)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("secrets", "watch secrets", "*secrets.SecretEvent", v)
+	}
+	resp := NewProtoWatchSecretsResponse(result)
+	return resp, nil
+}
+
+// DecodeWatchSecretsRequest decodes requests sent to "secrets" service "watch
+// secrets" endpoint.
+func DecodeWatchSecretsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *secretspb.WatchSecretsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*secretspb.WatchSecretsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("secrets", "watch secrets", "*secretspb.WatchSecretsRequest", v)
+		}
+		if err := ValidateWatchSecretsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *secrets.WatchSecretsPayload
+	{
+		payload = NewWatchSecretsPayload(message)
+	}
+	return payload, nil
+}
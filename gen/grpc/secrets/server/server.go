@@ -20,15 +20,63 @@ import (
 
 // Server implements the secretspb.SecretsServer interface.
 type Server struct {
+	BrowseSecretsH          goagrpc.UnaryHandler
 	OperatorGetSecretValueH goagrpc.UnaryHandler
+	BatchGetSecretValuesH   goagrpc.UnaryHandler
+	RenderTemplateH         goagrpc.UnaryHandler
+	WatchSecretsH           goagrpc.StreamHandler
 	secretspb.UnimplementedSecretsServer
 }
 
+// WatchSecretsServerStream implements the secrets.WatchSecretsServerStream
+// interface.
+type WatchSecretsServerStream struct {
+	stream secretspb.Secrets_WatchSecretsServer
+}
+
 // New instantiates the server struct with the secrets service endpoints.
-func New(e *secrets.Endpoints, uh goagrpc.UnaryHandler) *Server {
+func New(e *secrets.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
+		BrowseSecretsH:          NewBrowseSecretsHandler(e.BrowseSecrets, uh),
 		OperatorGetSecretValueH: NewOperatorGetSecretValueHandler(e.OperatorGetSecretValue, uh),
+		BatchGetSecretValuesH:   NewBatchGetSecretValuesHandler(e.BatchGetSecretValues, uh),
+		RenderTemplateH:         NewRenderTemplateHandler(e.RenderTemplate, uh),
+		WatchSecretsH:           NewWatchSecretsHandler(e.WatchSecrets, sh),
+	}
+}
+
+// NewBrowseSecretsHandler creates a gRPC handler which serves the "secrets"
+// service "browse secrets" endpoint.
+func NewBrowseSecretsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeBrowseSecretsRequest, EncodeBrowseSecretsResponse)
+	}
+	return h
+}
+
+// BrowseSecrets implements the "BrowseSecrets" method in
+// secretspb.SecretsServer interface.
+func (s *Server) BrowseSecrets(ctx context.Context, message *secretspb.BrowseSecretsRequest) (*secretspb.BrowseSecretsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "browse secrets")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+	resp, err := s.BrowseSecretsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_parameters":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "forbidden":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
 	}
+	return resp.(*secretspb.BrowseSecretsResponse), nil
 }
 
 // NewOperatorGetSecretValueHandler creates a gRPC handler which serves the
@@ -52,6 +100,8 @@ func (s *Server) OperatorGetSecretValue(ctx context.Context, message *secretspb.
 			switch en.GoaErrorName() {
 			case "secret_not_found":
 				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "field_not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
 			case "invalid_parameters":
 				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
 			case "unauthorized":
@@ -66,3 +116,153 @@ func (s *Server) OperatorGetSecretValue(ctx context.Context, message *secretspb.
 	}
 	return resp.(*secretspb.OperatorGetSecretValueResponse), nil
 }
+
+// NewBatchGetSecretValuesHandler creates a gRPC handler which serves the
+// "secrets" service "batch get secret values" endpoint.
+func NewBatchGetSecretValuesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeBatchGetSecretValuesRequest, EncodeBatchGetSecretValuesResponse)
+	}
+	return h
+}
+
+// BatchGetSecretValues implements the "BatchGetSecretValues" method in
+// secretspb.SecretsServer interface.
+func (s *Server) BatchGetSecretValues(ctx context.Context, message *secretspb.BatchGetSecretValuesRequest) (*secretspb.BatchGetSecretValuesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "batch get secret values")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+	resp, err := s.BatchGetSecretValuesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_parameters":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "forbidden":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*secretspb.BatchGetSecretValuesResponse), nil
+}
+
+// NewRenderTemplateHandler creates a gRPC handler which serves the "secrets"
+// service "render template" endpoint.
+func NewRenderTemplateHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRenderTemplateRequest, EncodeRenderTemplateResponse)
+	}
+	return h
+}
+
+// RenderTemplate implements the "RenderTemplate" method in
+// secretspb.SecretsServer interface.
+func (s *Server) RenderTemplate(ctx context.Context, message *secretspb.RenderTemplateRequest) (*secretspb.RenderTemplateResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "render template")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+	resp, err := s.RenderTemplateH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "secret_not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "field_not_found":
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, goagrpc.NewErrorResponse(err))
+			case "invalid_parameters":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unauthorized":
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "forbidden":
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return nil, goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*secretspb.RenderTemplateResponse), nil
+}
+
+// NewWatchSecretsHandler creates a gRPC handler which serves the "secrets"
+// service "watch secrets" endpoint.
+func NewWatchSecretsHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeWatchSecretsRequest)
+	}
+	return h
+}
+
+// WatchSecrets implements the "WatchSecrets" method in secretspb.SecretsServer
+// interface.
+func (s *Server) WatchSecrets(message *secretspb.WatchSecretsRequest, stream secretspb.Secrets_WatchSecretsServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "watch secrets")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+	p, err := s.WatchSecretsH.Decode(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "resume_token_expired":
+				return goagrpc.NewStatusError(codes.OutOfRange, err, goagrpc.NewErrorResponse(err))
+			case "invalid_parameters":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unauthorized":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "forbidden":
+				return goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	ep := &secrets.WatchSecretsEndpointInput{
+		Stream:  &WatchSecretsServerStream{stream: stream},
+		Payload: p.(*secrets.WatchSecretsPayload),
+	}
+	err = s.WatchSecretsH.Handle(ctx, ep)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "resume_token_expired":
+				return goagrpc.NewStatusError(codes.OutOfRange, err, goagrpc.NewErrorResponse(err))
+			case "invalid_parameters":
+				return goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			case "unauthorized":
+				return goagrpc.NewStatusError(codes.Unauthenticated, err, goagrpc.NewErrorResponse(err))
+			case "forbidden":
+				return goagrpc.NewStatusError(codes.PermissionDenied, err, goagrpc.NewErrorResponse(err))
+			case "internal_error":
+				return goagrpc.NewStatusError(codes.Internal, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// Send streams instances of "secretspb.WatchSecretsResponse" to the "watch
+// secrets" endpoint gRPC stream.
+func (s *WatchSecretsServerStream) Send(res *secrets.SecretEvent) error {
+	v := NewProtoSecretEventWatchSecretsResponse(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "secretspb.WatchSecretsResponse" to the
+// "watch secrets" endpoint gRPC stream with context.
+func (s *WatchSecretsServerStream) SendWithContext(ctx context.Context, res *secrets.SecretEvent) error {
+	return s.Send(res)
+}
+
+func (s *WatchSecretsServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
@@ -15,11 +15,55 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// NewBrowseSecretsPayload builds the payload of the "browse secrets" endpoint
+// of the "secrets" service from the gRPC request type.
+func NewBrowseSecretsPayload(message *secretspb.BrowseSecretsRequest) *secrets.BrowseSecretsPayload {
+	v := &secrets.BrowseSecretsPayload{
+		Prefix: message.Prefix,
+		Cursor: message.Cursor,
+	}
+	if message.Recursive != nil {
+		v.Recursive = *message.Recursive
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Recursive == nil {
+		v.Recursive = false
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+	return v
+}
+
+// NewProtoBrowseSecretsResponse builds the gRPC response type from the result
+// of the "browse secrets" endpoint of the "secrets" service.
+func NewProtoBrowseSecretsResponse(result *secrets.BrowseSecretsResult) *secretspb.BrowseSecretsResponse {
+	message := &secretspb.BrowseSecretsResponse{
+		NextCursor: result.NextCursor,
+	}
+	if result.Entries != nil {
+		message.Entries = make([]*secretspb.SecretEntry, len(result.Entries))
+		for i, val := range result.Entries {
+			message.Entries[i] = &secretspb.SecretEntry{
+				Path:      val.Path,
+				Name:      val.Name,
+				Folder:    val.Folder,
+				CreatedAt: val.CreatedAt,
+				UpdatedAt: val.UpdatedAt,
+			}
+		}
+	}
+	return message
+}
+
 // NewOperatorGetSecretValuePayload builds the payload of the "operator get
 // secret value" endpoint of the "secrets" service from the gRPC request type.
 func NewOperatorGetSecretValuePayload(message *secretspb.OperatorGetSecretValueRequest) *secrets.OperatorGetSecretValuePayload {
 	v := &secrets.OperatorGetSecretValuePayload{
-		Path: message.Path,
+		Path:  message.Path,
+		Field: message.Field,
 	}
 	return v
 }
@@ -29,12 +73,152 @@ func NewOperatorGetSecretValuePayload(message *secretspb.OperatorGetSecretValueR
 // service.
 func NewProtoOperatorGetSecretValueResponse(result *secrets.OperatorGetSecretValueResult) *secretspb.OperatorGetSecretValueResponse {
 	message := &secretspb.OperatorGetSecretValueResponse{
-		Value: result.Value,
-		Path:  result.Path,
+		Value:       result.Value,
+		Path:        result.Path,
+		RawValue:    result.RawValue,
+		ContentType: result.ContentType,
+		Filename:    result.Filename,
+	}
+	if result.Fields != nil {
+		message.Fields = make(map[string]string, len(result.Fields))
+		for key, val := range result.Fields {
+			tk := key
+			tv := val
+			message.Fields[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewBatchGetSecretValuesPayload builds the payload of the "batch get secret
+// values" endpoint of the "secrets" service from the gRPC request type.
+func NewBatchGetSecretValuesPayload(message *secretspb.BatchGetSecretValuesRequest) *secrets.BatchGetSecretValuesPayload {
+	v := &secrets.BatchGetSecretValuesPayload{}
+	if message.Secrets != nil {
+		v.Secrets = make([]*secrets.SecretValueRequest, len(message.Secrets))
+		for i, val := range message.Secrets {
+			v.Secrets[i] = &secrets.SecretValueRequest{
+				Path:  val.Path,
+				Field: val.Field,
+			}
+		}
+	}
+	return v
+}
+
+// NewProtoBatchGetSecretValuesResponse builds the gRPC response type from the
+// result of the "batch get secret values" endpoint of the "secrets" service.
+func NewProtoBatchGetSecretValuesResponse(result *secrets.BatchGetSecretValuesResult) *secretspb.BatchGetSecretValuesResponse {
+	message := &secretspb.BatchGetSecretValuesResponse{}
+	if result.Secrets != nil {
+		message.Secrets = make([]*secretspb.SecretValue, len(result.Secrets))
+		for i, val := range result.Secrets {
+			message.Secrets[i] = &secretspb.SecretValue{
+				Path:         val.Path,
+				Value:        val.Value,
+				RawValue:     val.RawValue,
+				ContentType:  val.ContentType,
+				Filename:     val.Filename,
+				Error:        val.Error,
+				ErrorMessage: val.ErrorMessage,
+			}
+			if val.Fields != nil {
+				message.Secrets[i].Fields = make(map[string]string, len(val.Fields))
+				for key, val := range val.Fields {
+					tk := key
+					tv := val
+					message.Secrets[i].Fields[tk] = tv
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewRenderTemplatePayload builds the payload of the "render template"
+// endpoint of the "secrets" service from the gRPC request type.
+func NewRenderTemplatePayload(message *secretspb.RenderTemplateRequest) *secrets.RenderTemplatePayload {
+	v := &secrets.RenderTemplatePayload{
+		Template:     message.Template,
+		TemplatePath: message.TemplatePath,
+	}
+	return v
+}
+
+// NewProtoRenderTemplateResponse builds the gRPC response type from the result
+// of the "render template" endpoint of the "secrets" service.
+func NewProtoRenderTemplateResponse(result *secrets.RenderTemplateResult) *secretspb.RenderTemplateResponse {
+	message := &secretspb.RenderTemplateResponse{
+		Rendered: result.Rendered,
 	}
 	return message
 }
 
+// NewWatchSecretsPayload builds the payload of the "watch secrets" endpoint of
+// the "secrets" service from the gRPC request type.
+func NewWatchSecretsPayload(message *secretspb.WatchSecretsRequest) *secrets.WatchSecretsPayload {
+	v := &secrets.WatchSecretsPayload{
+		Prefix:      message.Prefix,
+		ResumeToken: message.ResumeToken,
+	}
+	if message.Paths != nil {
+		v.Paths = make([]string, len(message.Paths))
+		for i, val := range message.Paths {
+			v.Paths[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoWatchSecretsResponse builds the gRPC response type from the result
+// of the "watch secrets" endpoint of the "secrets" service.
+func NewProtoWatchSecretsResponse(result *secrets.SecretEvent) *secretspb.WatchSecretsResponse {
+	message := &secretspb.WatchSecretsResponse{
+		Type:        result.Type,
+		Path:        result.Path,
+		ResumeToken: result.ResumeToken,
+		OccurredAt:  result.OccurredAt,
+	}
+	if result.Version != nil {
+		version := int32(*result.Version)
+		message.Version = &version
+	}
+	return message
+}
+
+func NewProtoSecretEventWatchSecretsResponse(result *secrets.SecretEvent) *secretspb.WatchSecretsResponse {
+	v := &secretspb.WatchSecretsResponse{
+		Type:        result.Type,
+		Path:        result.Path,
+		ResumeToken: result.ResumeToken,
+		OccurredAt:  result.OccurredAt,
+	}
+	if result.Version != nil {
+		version := int32(*result.Version)
+		v.Version = &version
+	}
+	return v
+}
+
+// ValidateBrowseSecretsRequest runs the validations defined on
+// BrowseSecretsRequest.
+func ValidateBrowseSecretsRequest(message *secretspb.BrowseSecretsRequest) (err error) {
+	if utf8.RuneCountInString(message.Prefix) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.prefix", message.Prefix, utf8.RuneCountInString(message.Prefix), 1, true))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1000, false))
+		}
+	}
+	return
+}
+
 // ValidateOperatorGetSecretValueRequest runs the validations defined on
 // OperatorGetSecretValueRequest.
 func ValidateOperatorGetSecretValueRequest(message *secretspb.OperatorGetSecretValueRequest) (err error) {
@@ -43,3 +227,59 @@ func ValidateOperatorGetSecretValueRequest(message *secretspb.OperatorGetSecretV
 	}
 	return
 }
+
+// ValidateBatchGetSecretValuesRequest runs the validations defined on
+// BatchGetSecretValuesRequest.
+func ValidateBatchGetSecretValuesRequest(message *secretspb.BatchGetSecretValuesRequest) (err error) {
+	if message.Secrets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secrets", "message"))
+	}
+	if len(message.Secrets) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.secrets", message.Secrets, len(message.Secrets), 1, true))
+	}
+	if len(message.Secrets) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.secrets", message.Secrets, len(message.Secrets), 100, false))
+	}
+	for _, e := range message.Secrets {
+		if e != nil {
+			if err2 := ValidateSecretValueRequest(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSecretValueRequest runs the validations defined on
+// SecretValueRequest.
+func ValidateSecretValueRequest(elem *secretspb.SecretValueRequest) (err error) {
+	if utf8.RuneCountInString(elem.Path) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("elem.path", elem.Path, utf8.RuneCountInString(elem.Path), 2, true))
+	}
+	return
+}
+
+// ValidateRenderTemplateRequest runs the validations defined on
+// RenderTemplateRequest.
+func ValidateRenderTemplateRequest(message *secretspb.RenderTemplateRequest) (err error) {
+	if message.Template != nil {
+		if utf8.RuneCountInString(*message.Template) > 65536 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.template", *message.Template, utf8.RuneCountInString(*message.Template), 65536, false))
+		}
+	}
+	if message.TemplatePath != nil {
+		if utf8.RuneCountInString(*message.TemplatePath) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.template_path", *message.TemplatePath, utf8.RuneCountInString(*message.TemplatePath), 2, true))
+		}
+	}
+	return
+}
+
+// ValidateWatchSecretsRequest runs the validations defined on
+// WatchSecretsRequest.
+func ValidateWatchSecretsRequest(message *secretspb.WatchSecretsRequest) (err error) {
+	if len(message.Paths) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.paths", message.Paths, len(message.Paths), 100, false))
+	}
+	return
+}
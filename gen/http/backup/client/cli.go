@@ -0,0 +1,33 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	backup "github.com/Vidalee/FishyKeys/gen/backup"
+)
+
+// BuildCreateBackupPayload builds the payload for the backup create backup
+// endpoint from CLI flags.
+func BuildCreateBackupPayload(backupCreateBackupBody string) (*backup.CreateBackupPayload, error) {
+	var err error
+	var body CreateBackupRequestBody
+	{
+		err = json.Unmarshal([]byte(backupCreateBackupBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"backup_key\": \"correct horse battery staple\"\n   }'")
+		}
+	}
+	v := &backup.CreateBackupPayload{
+		BackupKey: body.BackupKey,
+	}
+
+	return v, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup client HTTP transport
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	backup "github.com/Vidalee/FishyKeys/gen/backup"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the backup service endpoint HTTP clients.
+type Client struct {
+	// CreateBackup Doer is the HTTP client used to make requests to the create
+	// backup endpoint.
+	CreateBackupDoer goahttp.Doer
+
+	// CORS Doer is the HTTP client used to make requests to the  endpoint.
+	CORSDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the backup service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		CreateBackupDoer:    doer,
+		CORSDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// CreateBackup returns an endpoint that makes HTTP requests to the backup
+// service create backup server.
+func (c *Client) CreateBackup() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateBackupRequest(c.encoder)
+		decodeResponse = DecodeCreateBackupResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateBackupRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateBackupDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("backup", "create backup", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &backup.CreateBackupResponseData{Result: res.(*backup.CreateBackupResult), Body: resp.Body}, nil
+	}
+}
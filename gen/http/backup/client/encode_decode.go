@@ -0,0 +1,164 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	backup "github.com/Vidalee/FishyKeys/gen/backup"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateBackupRequest instantiates a HTTP request object with method and
+// path set to call the "backup" service "create backup" endpoint
+func (c *Client) BuildCreateBackupRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateBackupBackupPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("backup", "create backup", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateBackupRequest returns an encoder for requests sent to the backup
+// create backup server.
+func EncodeCreateBackupRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*backup.CreateBackupPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("backup", "create backup", "*backup.CreateBackupPayload", v)
+		}
+		body := NewCreateBackupRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("backup", "create backup", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateBackupResponse returns a decoder for responses returned by the
+// backup create backup endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateBackupResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateBackupResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentDisposition string
+				contentLength      int64
+				err                error
+			)
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("backup", "create backup", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("backup", "create backup", err)
+			}
+			res := NewCreateBackupResultOK(contentDisposition, contentLength)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateBackupInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("backup", "create backup", err)
+			}
+			err = ValidateCreateBackupInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("backup", "create backup", err)
+			}
+			return nil, NewCreateBackupInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateBackupUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("backup", "create backup", err)
+			}
+			err = ValidateCreateBackupUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("backup", "create backup", err)
+			}
+			return nil, NewCreateBackupUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateBackupForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("backup", "create backup", err)
+			}
+			err = ValidateCreateBackupForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("backup", "create backup", err)
+			}
+			return nil, NewCreateBackupForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateBackupInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("backup", "create backup", err)
+			}
+			err = ValidateCreateBackupInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("backup", "create backup", err)
+			}
+			return nil, NewCreateBackupInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("backup", "create backup", resp.StatusCode, string(body))
+		}
+	}
+}
@@ -0,0 +1,13 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the backup service.
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+// CreateBackupBackupPath returns the URL path to the backup service create backup HTTP endpoint.
+func CreateBackupBackupPath() string {
+	return "/backup"
+}
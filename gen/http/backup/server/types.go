@@ -0,0 +1,172 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// backup HTTP server types
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"unicode/utf8"
+
+	backup "github.com/Vidalee/FishyKeys/gen/backup"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateBackupRequestBody is the type of the "backup" service "create backup"
+// endpoint HTTP request body.
+type CreateBackupRequestBody struct {
+	// Passphrase the archive is encrypted under instead of the master key
+	BackupKey *string `form:"backup_key,omitempty" json:"backup_key,omitempty" xml:"backup_key,omitempty"`
+}
+
+// CreateBackupInvalidParametersResponseBody is the type of the "backup"
+// service "create backup" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type CreateBackupInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBackupUnauthorizedResponseBody is the type of the "backup" service
+// "create backup" endpoint HTTP response body for the "unauthorized" error.
+type CreateBackupUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBackupForbiddenResponseBody is the type of the "backup" service
+// "create backup" endpoint HTTP response body for the "forbidden" error.
+type CreateBackupForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBackupInternalErrorResponseBody is the type of the "backup" service
+// "create backup" endpoint HTTP response body for the "internal_error" error.
+type CreateBackupInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// NewCreateBackupInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "create backup" endpoint of the "backup" service.
+func NewCreateBackupInvalidParametersResponseBody(res *goa.ServiceError) *CreateBackupInvalidParametersResponseBody {
+	body := &CreateBackupInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBackupUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create backup" endpoint of the "backup" service.
+func NewCreateBackupUnauthorizedResponseBody(res *goa.ServiceError) *CreateBackupUnauthorizedResponseBody {
+	body := &CreateBackupUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBackupForbiddenResponseBody builds the HTTP response body from the
+// result of the "create backup" endpoint of the "backup" service.
+func NewCreateBackupForbiddenResponseBody(res *goa.ServiceError) *CreateBackupForbiddenResponseBody {
+	body := &CreateBackupForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBackupInternalErrorResponseBody builds the HTTP response body from
+// the result of the "create backup" endpoint of the "backup" service.
+func NewCreateBackupInternalErrorResponseBody(res *goa.ServiceError) *CreateBackupInternalErrorResponseBody {
+	body := &CreateBackupInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBackupPayload builds a backup service create backup endpoint
+// payload.
+func NewCreateBackupPayload(body *CreateBackupRequestBody) *backup.CreateBackupPayload {
+	v := &backup.CreateBackupPayload{
+		BackupKey: body.BackupKey,
+	}
+
+	return v
+}
+
+// ValidateCreateBackupRequestBody runs the validations defined on Create
+// BackupRequestBody
+func ValidateCreateBackupRequestBody(body *CreateBackupRequestBody) (err error) {
+	if body.BackupKey != nil {
+		if utf8.RuneCountInString(*body.BackupKey) < 12 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.backup_key", *body.BackupKey, utf8.RuneCountInString(*body.BackupKey), 12, true))
+		}
+	}
+	return
+}
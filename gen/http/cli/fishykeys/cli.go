@@ -13,10 +13,14 @@ import (
 	"net/http"
 	"os"
 
+	backupc "github.com/Vidalee/FishyKeys/gen/http/backup/client"
+	generationc "github.com/Vidalee/FishyKeys/gen/http/generation/client"
 	keymanagementc "github.com/Vidalee/FishyKeys/gen/http/key_management/client"
+	policiesc "github.com/Vidalee/FishyKeys/gen/http/policies/client"
 	rolesc "github.com/Vidalee/FishyKeys/gen/http/roles/client"
 	secretsc "github.com/Vidalee/FishyKeys/gen/http/secrets/client"
 	usersc "github.com/Vidalee/FishyKeys/gen/http/users/client"
+	webhooksc "github.com/Vidalee/FishyKeys/gen/http/webhooks/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -25,27 +29,31 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `key-management (create-master-key|get-key-status|add-share|delete-share)
+	return `backup create-backup
+generation (list-generation-policies|create-generation-policy|update-generation-policy|delete-generation-policy)
+key-management (create-master-key|get-key-status|add-share|delete-share)
+policies (list-policies|get-policy|create-policy|update-policy|delete-policy|attach-policy|detach-policy|explain)
 roles (list-roles|create-role|delete-role|assign-role-to-user|unassign-role-to-user)
-secrets (list-secrets|get-secret-value|get-secret|create-secret|update-secret)
+secrets (list-secrets|browse-secrets|get-secret-value|batch-get-secret-values|render-template|upload-secret-file|download-secret-file|export-secrets|import-secrets|get-secret|create-secret|update-secret|regenerate-secret|set-secret-rotation|list-secret-rotations|delete-secret|move-secrets|diff-secrets|promote-secrets|transfer-secret-ownership|list-stale-secrets|set-secret-canary|list-canary-secrets|wrap-secret|unwrap-secret|request-access|list-access-requests|approve-access-request|deny-access-request|list-validation-rules|create-validation-rule|delete-validation-rule|list-break-glass-rules|create-break-glass-rule|delete-break-glass-rule|list-break-glass-accesses|review-break-glass-access|list-folder-accesses|grant-folder-access|revoke-folder-access)
 users (create-user|list-users|delete-user|auth-user|get-operator-token)
+webhooks (list-webhooks|create-webhook|update-webhook|delete-webhook|list-webhook-deliveries|replay-webhook-delivery)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` key-management create-master-key --body '{
+	return os.Args[0] + ` backup create-backup --body '{
+      "backup_key": "correct horse battery staple"
+   }'` + "\n" +
+		os.Args[0] + ` generation list-generation-policies` + "\n" +
+		os.Args[0] + ` key-management create-master-key --body '{
       "admin_password": "admin_password123!",
       "admin_username": "admin",
       "min_shares": 3,
       "total_shares": 5
    }'` + "\n" +
+		os.Args[0] + ` policies list-policies` + "\n" +
 		os.Args[0] + ` roles list-roles` + "\n" +
-		os.Args[0] + ` secrets list-secrets` + "\n" +
-		os.Args[0] + ` users create-user --body '{
-      "password": "s3cr3t",
-      "username": "alice"
-   }'` + "\n" +
 		""
 }
 
@@ -59,6 +67,25 @@ func ParseEndpoint(
 	restore bool,
 ) (goa.Endpoint, any, error) {
 	var (
+		backupFlags = flag.NewFlagSet("backup", flag.ContinueOnError)
+
+		backupCreateBackupFlags    = flag.NewFlagSet("create-backup", flag.ExitOnError)
+		backupCreateBackupBodyFlag = backupCreateBackupFlags.String("body", "REQUIRED", "")
+
+		generationFlags = flag.NewFlagSet("generation", flag.ContinueOnError)
+
+		generationListGenerationPoliciesFlags = flag.NewFlagSet("list-generation-policies", flag.ExitOnError)
+
+		generationCreateGenerationPolicyFlags    = flag.NewFlagSet("create-generation-policy", flag.ExitOnError)
+		generationCreateGenerationPolicyBodyFlag = generationCreateGenerationPolicyFlags.String("body", "REQUIRED", "")
+
+		generationUpdateGenerationPolicyFlags    = flag.NewFlagSet("update-generation-policy", flag.ExitOnError)
+		generationUpdateGenerationPolicyBodyFlag = generationUpdateGenerationPolicyFlags.String("body", "REQUIRED", "")
+		generationUpdateGenerationPolicyIDFlag   = generationUpdateGenerationPolicyFlags.String("id", "REQUIRED", "ID of the generation policy")
+
+		generationDeleteGenerationPolicyFlags  = flag.NewFlagSet("delete-generation-policy", flag.ExitOnError)
+		generationDeleteGenerationPolicyIDFlag = generationDeleteGenerationPolicyFlags.String("id", "REQUIRED", "ID of the generation policy")
+
 		keyManagementFlags = flag.NewFlagSet("key-management", flag.ContinueOnError)
 
 		keyManagementCreateMasterKeyFlags    = flag.NewFlagSet("create-master-key", flag.ExitOnError)
@@ -72,6 +99,34 @@ func ParseEndpoint(
 		keyManagementDeleteShareFlags    = flag.NewFlagSet("delete-share", flag.ExitOnError)
 		keyManagementDeleteShareBodyFlag = keyManagementDeleteShareFlags.String("body", "REQUIRED", "")
 
+		policiesFlags = flag.NewFlagSet("policies", flag.ContinueOnError)
+
+		policiesListPoliciesFlags = flag.NewFlagSet("list-policies", flag.ExitOnError)
+
+		policiesGetPolicyFlags  = flag.NewFlagSet("get-policy", flag.ExitOnError)
+		policiesGetPolicyIDFlag = policiesGetPolicyFlags.String("id", "REQUIRED", "ID of the policy")
+
+		policiesCreatePolicyFlags    = flag.NewFlagSet("create-policy", flag.ExitOnError)
+		policiesCreatePolicyBodyFlag = policiesCreatePolicyFlags.String("body", "REQUIRED", "")
+
+		policiesUpdatePolicyFlags    = flag.NewFlagSet("update-policy", flag.ExitOnError)
+		policiesUpdatePolicyBodyFlag = policiesUpdatePolicyFlags.String("body", "REQUIRED", "")
+		policiesUpdatePolicyIDFlag   = policiesUpdatePolicyFlags.String("id", "REQUIRED", "ID of the policy")
+
+		policiesDeletePolicyFlags  = flag.NewFlagSet("delete-policy", flag.ExitOnError)
+		policiesDeletePolicyIDFlag = policiesDeletePolicyFlags.String("id", "REQUIRED", "ID of the policy")
+
+		policiesAttachPolicyFlags    = flag.NewFlagSet("attach-policy", flag.ExitOnError)
+		policiesAttachPolicyBodyFlag = policiesAttachPolicyFlags.String("body", "REQUIRED", "")
+		policiesAttachPolicyIDFlag   = policiesAttachPolicyFlags.String("id", "REQUIRED", "ID of the policy")
+
+		policiesDetachPolicyFlags    = flag.NewFlagSet("detach-policy", flag.ExitOnError)
+		policiesDetachPolicyBodyFlag = policiesDetachPolicyFlags.String("body", "REQUIRED", "")
+		policiesDetachPolicyIDFlag   = policiesDetachPolicyFlags.String("id", "REQUIRED", "ID of the policy")
+
+		policiesExplainFlags    = flag.NewFlagSet("explain", flag.ExitOnError)
+		policiesExplainBodyFlag = policiesExplainFlags.String("body", "REQUIRED", "")
+
 		rolesFlags = flag.NewFlagSet("roles", flag.ContinueOnError)
 
 		rolesListRolesFlags = flag.NewFlagSet("list-roles", flag.ExitOnError)
@@ -92,8 +147,46 @@ func ParseEndpoint(
 
 		secretsListSecretsFlags = flag.NewFlagSet("list-secrets", flag.ExitOnError)
 
-		secretsGetSecretValueFlags    = flag.NewFlagSet("get-secret-value", flag.ExitOnError)
-		secretsGetSecretValuePathFlag = secretsGetSecretValueFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+		secretsBrowseSecretsFlags         = flag.NewFlagSet("browse-secrets", flag.ExitOnError)
+		secretsBrowseSecretsPrefixFlag    = secretsBrowseSecretsFlags.String("prefix", "REQUIRED", "")
+		secretsBrowseSecretsRecursiveFlag = secretsBrowseSecretsFlags.String("recursive", "", "")
+		secretsBrowseSecretsCursorFlag    = secretsBrowseSecretsFlags.String("cursor", "", "")
+		secretsBrowseSecretsLimitFlag     = secretsBrowseSecretsFlags.String("limit", "100", "")
+
+		secretsGetSecretValueFlags                       = flag.NewFlagSet("get-secret-value", flag.ExitOnError)
+		secretsGetSecretValuePathFlag                    = secretsGetSecretValueFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+		secretsGetSecretValueFieldFlag                   = secretsGetSecretValueFlags.String("field", "", "")
+		secretsGetSecretValueRawFlag                     = secretsGetSecretValueFlags.String("raw", "", "")
+		secretsGetSecretValueBreakGlassJustificationFlag = secretsGetSecretValueFlags.String("break-glass-justification", "", "")
+
+		secretsBatchGetSecretValuesFlags    = flag.NewFlagSet("batch-get-secret-values", flag.ExitOnError)
+		secretsBatchGetSecretValuesBodyFlag = secretsBatchGetSecretValuesFlags.String("body", "REQUIRED", "")
+
+		secretsRenderTemplateFlags    = flag.NewFlagSet("render-template", flag.ExitOnError)
+		secretsRenderTemplateBodyFlag = secretsRenderTemplateFlags.String("body", "REQUIRED", "")
+
+		secretsUploadSecretFileFlags           = flag.NewFlagSet("upload-secret-file", flag.ExitOnError)
+		secretsUploadSecretFilePathFlag        = secretsUploadSecretFileFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+		secretsUploadSecretFileFilenameFlag    = secretsUploadSecretFileFlags.String("filename", "", "")
+		secretsUploadSecretFileContentTypeFlag = secretsUploadSecretFileFlags.String("content-type", "application/octet-stream", "")
+		secretsUploadSecretFileStreamFlag      = secretsUploadSecretFileFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		secretsDownloadSecretFileFlags    = flag.NewFlagSet("download-secret-file", flag.ExitOnError)
+		secretsDownloadSecretFilePathFlag = secretsDownloadSecretFileFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+
+		secretsExportSecretsFlags         = flag.NewFlagSet("export-secrets", flag.ExitOnError)
+		secretsExportSecretsPrefixFlag    = secretsExportSecretsFlags.String("prefix", "REQUIRED", "")
+		secretsExportSecretsFormatFlag    = secretsExportSecretsFlags.String("format", "dotenv", "")
+		secretsExportSecretsKeyNamingFlag = secretsExportSecretsFlags.String("key-naming", "", "")
+		secretsExportSecretsRawFlag       = secretsExportSecretsFlags.String("raw", "", "")
+
+		secretsImportSecretsFlags          = flag.NewFlagSet("import-secrets", flag.ExitOnError)
+		secretsImportSecretsPrefixFlag     = secretsImportSecretsFlags.String("prefix", "REQUIRED", "")
+		secretsImportSecretsFormatFlag     = secretsImportSecretsFlags.String("format", "dotenv", "")
+		secretsImportSecretsKeyNamingFlag  = secretsImportSecretsFlags.String("key-naming", "", "")
+		secretsImportSecretsOnExistingFlag = secretsImportSecretsFlags.String("on-existing", "fail", "")
+		secretsImportSecretsDryRunFlag     = secretsImportSecretsFlags.String("dry-run", "", "")
+		secretsImportSecretsStreamFlag     = secretsImportSecretsFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
 
 		secretsGetSecretFlags    = flag.NewFlagSet("get-secret", flag.ExitOnError)
 		secretsGetSecretPathFlag = secretsGetSecretFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
@@ -104,6 +197,94 @@ func ParseEndpoint(
 		secretsUpdateSecretFlags    = flag.NewFlagSet("update-secret", flag.ExitOnError)
 		secretsUpdateSecretBodyFlag = secretsUpdateSecretFlags.String("body", "REQUIRED", "")
 
+		secretsRegenerateSecretFlags    = flag.NewFlagSet("regenerate-secret", flag.ExitOnError)
+		secretsRegenerateSecretBodyFlag = secretsRegenerateSecretFlags.String("body", "REQUIRED", "")
+		secretsRegenerateSecretPathFlag = secretsRegenerateSecretFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+
+		secretsSetSecretRotationFlags    = flag.NewFlagSet("set-secret-rotation", flag.ExitOnError)
+		secretsSetSecretRotationBodyFlag = secretsSetSecretRotationFlags.String("body", "REQUIRED", "")
+		secretsSetSecretRotationPathFlag = secretsSetSecretRotationFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+
+		secretsListSecretRotationsFlags     = flag.NewFlagSet("list-secret-rotations", flag.ExitOnError)
+		secretsListSecretRotationsPathFlag  = secretsListSecretRotationsFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+		secretsListSecretRotationsLimitFlag = secretsListSecretRotationsFlags.String("limit", "20", "")
+
+		secretsDeleteSecretFlags    = flag.NewFlagSet("delete-secret", flag.ExitOnError)
+		secretsDeleteSecretPathFlag = secretsDeleteSecretFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+
+		secretsMoveSecretsFlags    = flag.NewFlagSet("move-secrets", flag.ExitOnError)
+		secretsMoveSecretsBodyFlag = secretsMoveSecretsFlags.String("body", "REQUIRED", "")
+
+		secretsDiffSecretsFlags           = flag.NewFlagSet("diff-secrets", flag.ExitOnError)
+		secretsDiffSecretsSourceFlag      = secretsDiffSecretsFlags.String("source", "REQUIRED", "")
+		secretsDiffSecretsDestinationFlag = secretsDiffSecretsFlags.String("destination", "REQUIRED", "")
+
+		secretsPromoteSecretsFlags    = flag.NewFlagSet("promote-secrets", flag.ExitOnError)
+		secretsPromoteSecretsBodyFlag = secretsPromoteSecretsFlags.String("body", "REQUIRED", "")
+
+		secretsTransferSecretOwnershipFlags    = flag.NewFlagSet("transfer-secret-ownership", flag.ExitOnError)
+		secretsTransferSecretOwnershipBodyFlag = secretsTransferSecretOwnershipFlags.String("body", "REQUIRED", "")
+
+		secretsListStaleSecretsFlags    = flag.NewFlagSet("list-stale-secrets", flag.ExitOnError)
+		secretsListStaleSecretsDaysFlag = secretsListStaleSecretsFlags.String("days", "90", "")
+
+		secretsSetSecretCanaryFlags    = flag.NewFlagSet("set-secret-canary", flag.ExitOnError)
+		secretsSetSecretCanaryBodyFlag = secretsSetSecretCanaryFlags.String("body", "REQUIRED", "")
+		secretsSetSecretCanaryPathFlag = secretsSetSecretCanaryFlags.String("path", "REQUIRED", "Base64 encoded secret's path")
+
+		secretsListCanarySecretsFlags = flag.NewFlagSet("list-canary-secrets", flag.ExitOnError)
+
+		secretsWrapSecretFlags    = flag.NewFlagSet("wrap-secret", flag.ExitOnError)
+		secretsWrapSecretBodyFlag = secretsWrapSecretFlags.String("body", "REQUIRED", "")
+
+		secretsUnwrapSecretFlags    = flag.NewFlagSet("unwrap-secret", flag.ExitOnError)
+		secretsUnwrapSecretBodyFlag = secretsUnwrapSecretFlags.String("body", "REQUIRED", "")
+
+		secretsRequestAccessFlags    = flag.NewFlagSet("request-access", flag.ExitOnError)
+		secretsRequestAccessBodyFlag = secretsRequestAccessFlags.String("body", "REQUIRED", "")
+
+		secretsListAccessRequestsFlags      = flag.NewFlagSet("list-access-requests", flag.ExitOnError)
+		secretsListAccessRequestsStatusFlag = secretsListAccessRequestsFlags.String("status", "", "")
+
+		secretsApproveAccessRequestFlags    = flag.NewFlagSet("approve-access-request", flag.ExitOnError)
+		secretsApproveAccessRequestBodyFlag = secretsApproveAccessRequestFlags.String("body", "REQUIRED", "")
+		secretsApproveAccessRequestIDFlag   = secretsApproveAccessRequestFlags.String("id", "REQUIRED", "ID of the access request")
+
+		secretsDenyAccessRequestFlags    = flag.NewFlagSet("deny-access-request", flag.ExitOnError)
+		secretsDenyAccessRequestBodyFlag = secretsDenyAccessRequestFlags.String("body", "REQUIRED", "")
+		secretsDenyAccessRequestIDFlag   = secretsDenyAccessRequestFlags.String("id", "REQUIRED", "ID of the access request")
+
+		secretsListValidationRulesFlags = flag.NewFlagSet("list-validation-rules", flag.ExitOnError)
+
+		secretsCreateValidationRuleFlags    = flag.NewFlagSet("create-validation-rule", flag.ExitOnError)
+		secretsCreateValidationRuleBodyFlag = secretsCreateValidationRuleFlags.String("body", "REQUIRED", "")
+
+		secretsDeleteValidationRuleFlags  = flag.NewFlagSet("delete-validation-rule", flag.ExitOnError)
+		secretsDeleteValidationRuleIDFlag = secretsDeleteValidationRuleFlags.String("id", "REQUIRED", "ID of the rule")
+
+		secretsListBreakGlassRulesFlags = flag.NewFlagSet("list-break-glass-rules", flag.ExitOnError)
+
+		secretsCreateBreakGlassRuleFlags    = flag.NewFlagSet("create-break-glass-rule", flag.ExitOnError)
+		secretsCreateBreakGlassRuleBodyFlag = secretsCreateBreakGlassRuleFlags.String("body", "REQUIRED", "")
+
+		secretsDeleteBreakGlassRuleFlags  = flag.NewFlagSet("delete-break-glass-rule", flag.ExitOnError)
+		secretsDeleteBreakGlassRuleIDFlag = secretsDeleteBreakGlassRuleFlags.String("id", "REQUIRED", "ID of the rule")
+
+		secretsListBreakGlassAccessesFlags          = flag.NewFlagSet("list-break-glass-accesses", flag.ExitOnError)
+		secretsListBreakGlassAccessesUnreviewedFlag = secretsListBreakGlassAccessesFlags.String("unreviewed", "", "")
+
+		secretsReviewBreakGlassAccessFlags    = flag.NewFlagSet("review-break-glass-access", flag.ExitOnError)
+		secretsReviewBreakGlassAccessBodyFlag = secretsReviewBreakGlassAccessFlags.String("body", "REQUIRED", "")
+		secretsReviewBreakGlassAccessIDFlag   = secretsReviewBreakGlassAccessFlags.String("id", "REQUIRED", "ID of the emergency access")
+
+		secretsListFolderAccessesFlags = flag.NewFlagSet("list-folder-accesses", flag.ExitOnError)
+
+		secretsGrantFolderAccessFlags    = flag.NewFlagSet("grant-folder-access", flag.ExitOnError)
+		secretsGrantFolderAccessBodyFlag = secretsGrantFolderAccessFlags.String("body", "REQUIRED", "")
+
+		secretsRevokeFolderAccessFlags  = flag.NewFlagSet("revoke-folder-access", flag.ExitOnError)
+		secretsRevokeFolderAccessIDFlag = secretsRevokeFolderAccessFlags.String("id", "REQUIRED", "ID of the folder access to revoke")
+
 		usersFlags = flag.NewFlagSet("users", flag.ContinueOnError)
 
 		usersCreateUserFlags    = flag.NewFlagSet("create-user", flag.ExitOnError)
@@ -111,20 +292,63 @@ func ParseEndpoint(
 
 		usersListUsersFlags = flag.NewFlagSet("list-users", flag.ExitOnError)
 
-		usersDeleteUserFlags        = flag.NewFlagSet("delete-user", flag.ExitOnError)
-		usersDeleteUserUsernameFlag = usersDeleteUserFlags.String("username", "REQUIRED", "Username of the user to delete")
+		usersDeleteUserFlags          = flag.NewFlagSet("delete-user", flag.ExitOnError)
+		usersDeleteUserUsernameFlag   = usersDeleteUserFlags.String("username", "REQUIRED", "Username of the user to delete")
+		usersDeleteUserReassignToFlag = usersDeleteUserFlags.String("reassign-to", "", "")
 
 		usersAuthUserFlags    = flag.NewFlagSet("auth-user", flag.ExitOnError)
 		usersAuthUserBodyFlag = usersAuthUserFlags.String("body", "REQUIRED", "")
 
 		usersGetOperatorTokenFlags = flag.NewFlagSet("get-operator-token", flag.ExitOnError)
+
+		webhooksFlags = flag.NewFlagSet("webhooks", flag.ContinueOnError)
+
+		webhooksListWebhooksFlags = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+
+		webhooksCreateWebhookFlags    = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		webhooksCreateWebhookBodyFlag = webhooksCreateWebhookFlags.String("body", "REQUIRED", "")
+
+		webhooksUpdateWebhookFlags    = flag.NewFlagSet("update-webhook", flag.ExitOnError)
+		webhooksUpdateWebhookBodyFlag = webhooksUpdateWebhookFlags.String("body", "REQUIRED", "")
+		webhooksUpdateWebhookIDFlag   = webhooksUpdateWebhookFlags.String("id", "REQUIRED", "ID of the webhook")
+
+		webhooksDeleteWebhookFlags  = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		webhooksDeleteWebhookIDFlag = webhooksDeleteWebhookFlags.String("id", "REQUIRED", "ID of the webhook")
+
+		webhooksListWebhookDeliveriesFlags      = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		webhooksListWebhookDeliveriesIDFlag     = webhooksListWebhookDeliveriesFlags.String("id", "REQUIRED", "ID of the webhook")
+		webhooksListWebhookDeliveriesStatusFlag = webhooksListWebhookDeliveriesFlags.String("status", "", "")
+		webhooksListWebhookDeliveriesLimitFlag  = webhooksListWebhookDeliveriesFlags.String("limit", "50", "")
+
+		webhooksReplayWebhookDeliveryFlags          = flag.NewFlagSet("replay-webhook-delivery", flag.ExitOnError)
+		webhooksReplayWebhookDeliveryIDFlag         = webhooksReplayWebhookDeliveryFlags.String("id", "REQUIRED", "ID of the webhook")
+		webhooksReplayWebhookDeliveryDeliveryIDFlag = webhooksReplayWebhookDeliveryFlags.String("delivery-id", "REQUIRED", "ID of the failed delivery")
 	)
+	backupFlags.Usage = backupUsage
+	backupCreateBackupFlags.Usage = backupCreateBackupUsage
+
+	generationFlags.Usage = generationUsage
+	generationListGenerationPoliciesFlags.Usage = generationListGenerationPoliciesUsage
+	generationCreateGenerationPolicyFlags.Usage = generationCreateGenerationPolicyUsage
+	generationUpdateGenerationPolicyFlags.Usage = generationUpdateGenerationPolicyUsage
+	generationDeleteGenerationPolicyFlags.Usage = generationDeleteGenerationPolicyUsage
+
 	keyManagementFlags.Usage = keyManagementUsage
 	keyManagementCreateMasterKeyFlags.Usage = keyManagementCreateMasterKeyUsage
 	keyManagementGetKeyStatusFlags.Usage = keyManagementGetKeyStatusUsage
 	keyManagementAddShareFlags.Usage = keyManagementAddShareUsage
 	keyManagementDeleteShareFlags.Usage = keyManagementDeleteShareUsage
 
+	policiesFlags.Usage = policiesUsage
+	policiesListPoliciesFlags.Usage = policiesListPoliciesUsage
+	policiesGetPolicyFlags.Usage = policiesGetPolicyUsage
+	policiesCreatePolicyFlags.Usage = policiesCreatePolicyUsage
+	policiesUpdatePolicyFlags.Usage = policiesUpdatePolicyUsage
+	policiesDeletePolicyFlags.Usage = policiesDeletePolicyUsage
+	policiesAttachPolicyFlags.Usage = policiesAttachPolicyUsage
+	policiesDetachPolicyFlags.Usage = policiesDetachPolicyUsage
+	policiesExplainFlags.Usage = policiesExplainUsage
+
 	rolesFlags.Usage = rolesUsage
 	rolesListRolesFlags.Usage = rolesListRolesUsage
 	rolesCreateRoleFlags.Usage = rolesCreateRoleUsage
@@ -134,10 +358,45 @@ func ParseEndpoint(
 
 	secretsFlags.Usage = secretsUsage
 	secretsListSecretsFlags.Usage = secretsListSecretsUsage
+	secretsBrowseSecretsFlags.Usage = secretsBrowseSecretsUsage
 	secretsGetSecretValueFlags.Usage = secretsGetSecretValueUsage
+	secretsBatchGetSecretValuesFlags.Usage = secretsBatchGetSecretValuesUsage
+	secretsRenderTemplateFlags.Usage = secretsRenderTemplateUsage
+	secretsUploadSecretFileFlags.Usage = secretsUploadSecretFileUsage
+	secretsDownloadSecretFileFlags.Usage = secretsDownloadSecretFileUsage
+	secretsExportSecretsFlags.Usage = secretsExportSecretsUsage
+	secretsImportSecretsFlags.Usage = secretsImportSecretsUsage
 	secretsGetSecretFlags.Usage = secretsGetSecretUsage
 	secretsCreateSecretFlags.Usage = secretsCreateSecretUsage
 	secretsUpdateSecretFlags.Usage = secretsUpdateSecretUsage
+	secretsRegenerateSecretFlags.Usage = secretsRegenerateSecretUsage
+	secretsSetSecretRotationFlags.Usage = secretsSetSecretRotationUsage
+	secretsListSecretRotationsFlags.Usage = secretsListSecretRotationsUsage
+	secretsDeleteSecretFlags.Usage = secretsDeleteSecretUsage
+	secretsMoveSecretsFlags.Usage = secretsMoveSecretsUsage
+	secretsDiffSecretsFlags.Usage = secretsDiffSecretsUsage
+	secretsPromoteSecretsFlags.Usage = secretsPromoteSecretsUsage
+	secretsTransferSecretOwnershipFlags.Usage = secretsTransferSecretOwnershipUsage
+	secretsListStaleSecretsFlags.Usage = secretsListStaleSecretsUsage
+	secretsSetSecretCanaryFlags.Usage = secretsSetSecretCanaryUsage
+	secretsListCanarySecretsFlags.Usage = secretsListCanarySecretsUsage
+	secretsWrapSecretFlags.Usage = secretsWrapSecretUsage
+	secretsUnwrapSecretFlags.Usage = secretsUnwrapSecretUsage
+	secretsRequestAccessFlags.Usage = secretsRequestAccessUsage
+	secretsListAccessRequestsFlags.Usage = secretsListAccessRequestsUsage
+	secretsApproveAccessRequestFlags.Usage = secretsApproveAccessRequestUsage
+	secretsDenyAccessRequestFlags.Usage = secretsDenyAccessRequestUsage
+	secretsListValidationRulesFlags.Usage = secretsListValidationRulesUsage
+	secretsCreateValidationRuleFlags.Usage = secretsCreateValidationRuleUsage
+	secretsDeleteValidationRuleFlags.Usage = secretsDeleteValidationRuleUsage
+	secretsListBreakGlassRulesFlags.Usage = secretsListBreakGlassRulesUsage
+	secretsCreateBreakGlassRuleFlags.Usage = secretsCreateBreakGlassRuleUsage
+	secretsDeleteBreakGlassRuleFlags.Usage = secretsDeleteBreakGlassRuleUsage
+	secretsListBreakGlassAccessesFlags.Usage = secretsListBreakGlassAccessesUsage
+	secretsReviewBreakGlassAccessFlags.Usage = secretsReviewBreakGlassAccessUsage
+	secretsListFolderAccessesFlags.Usage = secretsListFolderAccessesUsage
+	secretsGrantFolderAccessFlags.Usage = secretsGrantFolderAccessUsage
+	secretsRevokeFolderAccessFlags.Usage = secretsRevokeFolderAccessUsage
 
 	usersFlags.Usage = usersUsage
 	usersCreateUserFlags.Usage = usersCreateUserUsage
@@ -146,6 +405,14 @@ func ParseEndpoint(
 	usersAuthUserFlags.Usage = usersAuthUserUsage
 	usersGetOperatorTokenFlags.Usage = usersGetOperatorTokenUsage
 
+	webhooksFlags.Usage = webhooksUsage
+	webhooksListWebhooksFlags.Usage = webhooksListWebhooksUsage
+	webhooksCreateWebhookFlags.Usage = webhooksCreateWebhookUsage
+	webhooksUpdateWebhookFlags.Usage = webhooksUpdateWebhookUsage
+	webhooksDeleteWebhookFlags.Usage = webhooksDeleteWebhookUsage
+	webhooksListWebhookDeliveriesFlags.Usage = webhooksListWebhookDeliveriesUsage
+	webhooksReplayWebhookDeliveryFlags.Usage = webhooksReplayWebhookDeliveryUsage
+
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
 	}
@@ -161,14 +428,22 @@ func ParseEndpoint(
 	{
 		svcn = flag.Arg(0)
 		switch svcn {
+		case "backup":
+			svcf = backupFlags
+		case "generation":
+			svcf = generationFlags
 		case "key-management":
 			svcf = keyManagementFlags
+		case "policies":
+			svcf = policiesFlags
 		case "roles":
 			svcf = rolesFlags
 		case "secrets":
 			svcf = secretsFlags
 		case "users":
 			svcf = usersFlags
+		case "webhooks":
+			svcf = webhooksFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -184,6 +459,29 @@ func ParseEndpoint(
 	{
 		epn = svcf.Arg(0)
 		switch svcn {
+		case "backup":
+			switch epn {
+			case "create-backup":
+				epf = backupCreateBackupFlags
+
+			}
+
+		case "generation":
+			switch epn {
+			case "list-generation-policies":
+				epf = generationListGenerationPoliciesFlags
+
+			case "create-generation-policy":
+				epf = generationCreateGenerationPolicyFlags
+
+			case "update-generation-policy":
+				epf = generationUpdateGenerationPolicyFlags
+
+			case "delete-generation-policy":
+				epf = generationDeleteGenerationPolicyFlags
+
+			}
+
 		case "key-management":
 			switch epn {
 			case "create-master-key":
@@ -200,6 +498,34 @@ func ParseEndpoint(
 
 			}
 
+		case "policies":
+			switch epn {
+			case "list-policies":
+				epf = policiesListPoliciesFlags
+
+			case "get-policy":
+				epf = policiesGetPolicyFlags
+
+			case "create-policy":
+				epf = policiesCreatePolicyFlags
+
+			case "update-policy":
+				epf = policiesUpdatePolicyFlags
+
+			case "delete-policy":
+				epf = policiesDeletePolicyFlags
+
+			case "attach-policy":
+				epf = policiesAttachPolicyFlags
+
+			case "detach-policy":
+				epf = policiesDetachPolicyFlags
+
+			case "explain":
+				epf = policiesExplainFlags
+
+			}
+
 		case "roles":
 			switch epn {
 			case "list-roles":
@@ -224,9 +550,30 @@ func ParseEndpoint(
 			case "list-secrets":
 				epf = secretsListSecretsFlags
 
+			case "browse-secrets":
+				epf = secretsBrowseSecretsFlags
+
 			case "get-secret-value":
 				epf = secretsGetSecretValueFlags
 
+			case "batch-get-secret-values":
+				epf = secretsBatchGetSecretValuesFlags
+
+			case "render-template":
+				epf = secretsRenderTemplateFlags
+
+			case "upload-secret-file":
+				epf = secretsUploadSecretFileFlags
+
+			case "download-secret-file":
+				epf = secretsDownloadSecretFileFlags
+
+			case "export-secrets":
+				epf = secretsExportSecretsFlags
+
+			case "import-secrets":
+				epf = secretsImportSecretsFlags
+
 			case "get-secret":
 				epf = secretsGetSecretFlags
 
@@ -236,6 +583,90 @@ func ParseEndpoint(
 			case "update-secret":
 				epf = secretsUpdateSecretFlags
 
+			case "regenerate-secret":
+				epf = secretsRegenerateSecretFlags
+
+			case "set-secret-rotation":
+				epf = secretsSetSecretRotationFlags
+
+			case "list-secret-rotations":
+				epf = secretsListSecretRotationsFlags
+
+			case "delete-secret":
+				epf = secretsDeleteSecretFlags
+
+			case "move-secrets":
+				epf = secretsMoveSecretsFlags
+
+			case "diff-secrets":
+				epf = secretsDiffSecretsFlags
+
+			case "promote-secrets":
+				epf = secretsPromoteSecretsFlags
+
+			case "transfer-secret-ownership":
+				epf = secretsTransferSecretOwnershipFlags
+
+			case "list-stale-secrets":
+				epf = secretsListStaleSecretsFlags
+
+			case "set-secret-canary":
+				epf = secretsSetSecretCanaryFlags
+
+			case "list-canary-secrets":
+				epf = secretsListCanarySecretsFlags
+
+			case "wrap-secret":
+				epf = secretsWrapSecretFlags
+
+			case "unwrap-secret":
+				epf = secretsUnwrapSecretFlags
+
+			case "request-access":
+				epf = secretsRequestAccessFlags
+
+			case "list-access-requests":
+				epf = secretsListAccessRequestsFlags
+
+			case "approve-access-request":
+				epf = secretsApproveAccessRequestFlags
+
+			case "deny-access-request":
+				epf = secretsDenyAccessRequestFlags
+
+			case "list-validation-rules":
+				epf = secretsListValidationRulesFlags
+
+			case "create-validation-rule":
+				epf = secretsCreateValidationRuleFlags
+
+			case "delete-validation-rule":
+				epf = secretsDeleteValidationRuleFlags
+
+			case "list-break-glass-rules":
+				epf = secretsListBreakGlassRulesFlags
+
+			case "create-break-glass-rule":
+				epf = secretsCreateBreakGlassRuleFlags
+
+			case "delete-break-glass-rule":
+				epf = secretsDeleteBreakGlassRuleFlags
+
+			case "list-break-glass-accesses":
+				epf = secretsListBreakGlassAccessesFlags
+
+			case "review-break-glass-access":
+				epf = secretsReviewBreakGlassAccessFlags
+
+			case "list-folder-accesses":
+				epf = secretsListFolderAccessesFlags
+
+			case "grant-folder-access":
+				epf = secretsGrantFolderAccessFlags
+
+			case "revoke-folder-access":
+				epf = secretsRevokeFolderAccessFlags
+
 			}
 
 		case "users":
@@ -257,6 +688,28 @@ func ParseEndpoint(
 
 			}
 
+		case "webhooks":
+			switch epn {
+			case "list-webhooks":
+				epf = webhooksListWebhooksFlags
+
+			case "create-webhook":
+				epf = webhooksCreateWebhookFlags
+
+			case "update-webhook":
+				epf = webhooksUpdateWebhookFlags
+
+			case "delete-webhook":
+				epf = webhooksDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = webhooksListWebhookDeliveriesFlags
+
+			case "replay-webhook-delivery":
+				epf = webhooksReplayWebhookDeliveryFlags
+
+			}
+
 		}
 	}
 	if epf == nil {
@@ -277,6 +730,28 @@ func ParseEndpoint(
 	)
 	{
 		switch svcn {
+		case "backup":
+			c := backupc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-backup":
+				endpoint = c.CreateBackup()
+				data, err = backupc.BuildCreateBackupPayload(*backupCreateBackupBodyFlag)
+			}
+		case "generation":
+			c := generationc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list-generation-policies":
+				endpoint = c.ListGenerationPolicies()
+			case "create-generation-policy":
+				endpoint = c.CreateGenerationPolicy()
+				data, err = generationc.BuildCreateGenerationPolicyPayload(*generationCreateGenerationPolicyBodyFlag)
+			case "update-generation-policy":
+				endpoint = c.UpdateGenerationPolicy()
+				data, err = generationc.BuildUpdateGenerationPolicyPayload(*generationUpdateGenerationPolicyBodyFlag, *generationUpdateGenerationPolicyIDFlag)
+			case "delete-generation-policy":
+				endpoint = c.DeleteGenerationPolicy()
+				data, err = generationc.BuildDeleteGenerationPolicyPayload(*generationDeleteGenerationPolicyIDFlag)
+			}
 		case "key-management":
 			c := keymanagementc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -292,6 +767,33 @@ func ParseEndpoint(
 				endpoint = c.DeleteShare()
 				data, err = keymanagementc.BuildDeleteSharePayload(*keyManagementDeleteShareBodyFlag)
 			}
+		case "policies":
+			c := policiesc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list-policies":
+				endpoint = c.ListPolicies()
+			case "get-policy":
+				endpoint = c.GetPolicy()
+				data, err = policiesc.BuildGetPolicyPayload(*policiesGetPolicyIDFlag)
+			case "create-policy":
+				endpoint = c.CreatePolicy()
+				data, err = policiesc.BuildCreatePolicyPayload(*policiesCreatePolicyBodyFlag)
+			case "update-policy":
+				endpoint = c.UpdatePolicy()
+				data, err = policiesc.BuildUpdatePolicyPayload(*policiesUpdatePolicyBodyFlag, *policiesUpdatePolicyIDFlag)
+			case "delete-policy":
+				endpoint = c.DeletePolicy()
+				data, err = policiesc.BuildDeletePolicyPayload(*policiesDeletePolicyIDFlag)
+			case "attach-policy":
+				endpoint = c.AttachPolicy()
+				data, err = policiesc.BuildAttachPolicyPayload(*policiesAttachPolicyBodyFlag, *policiesAttachPolicyIDFlag)
+			case "detach-policy":
+				endpoint = c.DetachPolicy()
+				data, err = policiesc.BuildDetachPolicyPayload(*policiesDetachPolicyBodyFlag, *policiesDetachPolicyIDFlag)
+			case "explain":
+				endpoint = c.Explain()
+				data, err = policiesc.BuildExplainPayload(*policiesExplainBodyFlag)
+			}
 		case "roles":
 			c := rolesc.NewClient(scheme, host, doer, enc, dec, restore)
 			switch epn {
@@ -315,9 +817,36 @@ func ParseEndpoint(
 			switch epn {
 			case "list-secrets":
 				endpoint = c.ListSecrets()
+			case "browse-secrets":
+				endpoint = c.BrowseSecrets()
+				data, err = secretsc.BuildBrowseSecretsPayload(*secretsBrowseSecretsPrefixFlag, *secretsBrowseSecretsRecursiveFlag, *secretsBrowseSecretsCursorFlag, *secretsBrowseSecretsLimitFlag)
 			case "get-secret-value":
 				endpoint = c.GetSecretValue()
-				data, err = secretsc.BuildGetSecretValuePayload(*secretsGetSecretValuePathFlag)
+				data, err = secretsc.BuildGetSecretValuePayload(*secretsGetSecretValuePathFlag, *secretsGetSecretValueFieldFlag, *secretsGetSecretValueRawFlag, *secretsGetSecretValueBreakGlassJustificationFlag)
+			case "batch-get-secret-values":
+				endpoint = c.BatchGetSecretValues()
+				data, err = secretsc.BuildBatchGetSecretValuesPayload(*secretsBatchGetSecretValuesBodyFlag)
+			case "render-template":
+				endpoint = c.RenderTemplate()
+				data, err = secretsc.BuildRenderTemplatePayload(*secretsRenderTemplateBodyFlag)
+			case "upload-secret-file":
+				endpoint = c.UploadSecretFile()
+				data, err = secretsc.BuildUploadSecretFilePayload(*secretsUploadSecretFilePathFlag, *secretsUploadSecretFileFilenameFlag, *secretsUploadSecretFileContentTypeFlag)
+				if err == nil {
+					data, err = secretsc.BuildUploadSecretFileStreamPayload(data, *secretsUploadSecretFileStreamFlag)
+				}
+			case "download-secret-file":
+				endpoint = c.DownloadSecretFile()
+				data, err = secretsc.BuildDownloadSecretFilePayload(*secretsDownloadSecretFilePathFlag)
+			case "export-secrets":
+				endpoint = c.ExportSecrets()
+				data, err = secretsc.BuildExportSecretsPayload(*secretsExportSecretsPrefixFlag, *secretsExportSecretsFormatFlag, *secretsExportSecretsKeyNamingFlag, *secretsExportSecretsRawFlag)
+			case "import-secrets":
+				endpoint = c.ImportSecrets()
+				data, err = secretsc.BuildImportSecretsPayload(*secretsImportSecretsPrefixFlag, *secretsImportSecretsFormatFlag, *secretsImportSecretsKeyNamingFlag, *secretsImportSecretsOnExistingFlag, *secretsImportSecretsDryRunFlag)
+				if err == nil {
+					data, err = secretsc.BuildImportSecretsStreamPayload(data, *secretsImportSecretsStreamFlag)
+				}
 			case "get-secret":
 				endpoint = c.GetSecret()
 				data, err = secretsc.BuildGetSecretPayload(*secretsGetSecretPathFlag)
@@ -327,6 +856,86 @@ func ParseEndpoint(
 			case "update-secret":
 				endpoint = c.UpdateSecret()
 				data, err = secretsc.BuildUpdateSecretPayload(*secretsUpdateSecretBodyFlag)
+			case "regenerate-secret":
+				endpoint = c.RegenerateSecret()
+				data, err = secretsc.BuildRegenerateSecretPayload(*secretsRegenerateSecretBodyFlag, *secretsRegenerateSecretPathFlag)
+			case "set-secret-rotation":
+				endpoint = c.SetSecretRotation()
+				data, err = secretsc.BuildSetSecretRotationPayload(*secretsSetSecretRotationBodyFlag, *secretsSetSecretRotationPathFlag)
+			case "list-secret-rotations":
+				endpoint = c.ListSecretRotations()
+				data, err = secretsc.BuildListSecretRotationsPayload(*secretsListSecretRotationsPathFlag, *secretsListSecretRotationsLimitFlag)
+			case "delete-secret":
+				endpoint = c.DeleteSecret()
+				data, err = secretsc.BuildDeleteSecretPayload(*secretsDeleteSecretPathFlag)
+			case "move-secrets":
+				endpoint = c.MoveSecrets()
+				data, err = secretsc.BuildMoveSecretsPayload(*secretsMoveSecretsBodyFlag)
+			case "diff-secrets":
+				endpoint = c.DiffSecrets()
+				data, err = secretsc.BuildDiffSecretsPayload(*secretsDiffSecretsSourceFlag, *secretsDiffSecretsDestinationFlag)
+			case "promote-secrets":
+				endpoint = c.PromoteSecrets()
+				data, err = secretsc.BuildPromoteSecretsPayload(*secretsPromoteSecretsBodyFlag)
+			case "transfer-secret-ownership":
+				endpoint = c.TransferSecretOwnership()
+				data, err = secretsc.BuildTransferSecretOwnershipPayload(*secretsTransferSecretOwnershipBodyFlag)
+			case "list-stale-secrets":
+				endpoint = c.ListStaleSecrets()
+				data, err = secretsc.BuildListStaleSecretsPayload(*secretsListStaleSecretsDaysFlag)
+			case "set-secret-canary":
+				endpoint = c.SetSecretCanary()
+				data, err = secretsc.BuildSetSecretCanaryPayload(*secretsSetSecretCanaryBodyFlag, *secretsSetSecretCanaryPathFlag)
+			case "list-canary-secrets":
+				endpoint = c.ListCanarySecrets()
+			case "wrap-secret":
+				endpoint = c.WrapSecret()
+				data, err = secretsc.BuildWrapSecretPayload(*secretsWrapSecretBodyFlag)
+			case "unwrap-secret":
+				endpoint = c.UnwrapSecret()
+				data, err = secretsc.BuildUnwrapSecretPayload(*secretsUnwrapSecretBodyFlag)
+			case "request-access":
+				endpoint = c.RequestAccess()
+				data, err = secretsc.BuildRequestAccessPayload(*secretsRequestAccessBodyFlag)
+			case "list-access-requests":
+				endpoint = c.ListAccessRequests()
+				data, err = secretsc.BuildListAccessRequestsPayload(*secretsListAccessRequestsStatusFlag)
+			case "approve-access-request":
+				endpoint = c.ApproveAccessRequest()
+				data, err = secretsc.BuildApproveAccessRequestPayload(*secretsApproveAccessRequestBodyFlag, *secretsApproveAccessRequestIDFlag)
+			case "deny-access-request":
+				endpoint = c.DenyAccessRequest()
+				data, err = secretsc.BuildDenyAccessRequestPayload(*secretsDenyAccessRequestBodyFlag, *secretsDenyAccessRequestIDFlag)
+			case "list-validation-rules":
+				endpoint = c.ListValidationRules()
+			case "create-validation-rule":
+				endpoint = c.CreateValidationRule()
+				data, err = secretsc.BuildCreateValidationRulePayload(*secretsCreateValidationRuleBodyFlag)
+			case "delete-validation-rule":
+				endpoint = c.DeleteValidationRule()
+				data, err = secretsc.BuildDeleteValidationRulePayload(*secretsDeleteValidationRuleIDFlag)
+			case "list-break-glass-rules":
+				endpoint = c.ListBreakGlassRules()
+			case "create-break-glass-rule":
+				endpoint = c.CreateBreakGlassRule()
+				data, err = secretsc.BuildCreateBreakGlassRulePayload(*secretsCreateBreakGlassRuleBodyFlag)
+			case "delete-break-glass-rule":
+				endpoint = c.DeleteBreakGlassRule()
+				data, err = secretsc.BuildDeleteBreakGlassRulePayload(*secretsDeleteBreakGlassRuleIDFlag)
+			case "list-break-glass-accesses":
+				endpoint = c.ListBreakGlassAccesses()
+				data, err = secretsc.BuildListBreakGlassAccessesPayload(*secretsListBreakGlassAccessesUnreviewedFlag)
+			case "review-break-glass-access":
+				endpoint = c.ReviewBreakGlassAccess()
+				data, err = secretsc.BuildReviewBreakGlassAccessPayload(*secretsReviewBreakGlassAccessBodyFlag, *secretsReviewBreakGlassAccessIDFlag)
+			case "list-folder-accesses":
+				endpoint = c.ListFolderAccesses()
+			case "grant-folder-access":
+				endpoint = c.GrantFolderAccess()
+				data, err = secretsc.BuildGrantFolderAccessPayload(*secretsGrantFolderAccessBodyFlag)
+			case "revoke-folder-access":
+				endpoint = c.RevokeFolderAccess()
+				data, err = secretsc.BuildRevokeFolderAccessPayload(*secretsRevokeFolderAccessIDFlag)
 			}
 		case "users":
 			c := usersc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -338,13 +947,34 @@ func ParseEndpoint(
 				endpoint = c.ListUsers()
 			case "delete-user":
 				endpoint = c.DeleteUser()
-				data, err = usersc.BuildDeleteUserPayload(*usersDeleteUserUsernameFlag)
+				data, err = usersc.BuildDeleteUserPayload(*usersDeleteUserUsernameFlag, *usersDeleteUserReassignToFlag)
 			case "auth-user":
 				endpoint = c.AuthUser()
 				data, err = usersc.BuildAuthUserPayload(*usersAuthUserBodyFlag)
 			case "get-operator-token":
 				endpoint = c.GetOperatorToken()
 			}
+		case "webhooks":
+			c := webhooksc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = webhooksc.BuildCreateWebhookPayload(*webhooksCreateWebhookBodyFlag)
+			case "update-webhook":
+				endpoint = c.UpdateWebhook()
+				data, err = webhooksc.BuildUpdateWebhookPayload(*webhooksUpdateWebhookBodyFlag, *webhooksUpdateWebhookIDFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = webhooksc.BuildDeleteWebhookPayload(*webhooksDeleteWebhookIDFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = webhooksc.BuildListWebhookDeliveriesPayload(*webhooksListWebhookDeliveriesIDFlag, *webhooksListWebhookDeliveriesStatusFlag, *webhooksListWebhookDeliveriesLimitFlag)
+			case "replay-webhook-delivery":
+				endpoint = c.ReplayWebhookDelivery()
+				data, err = webhooksc.BuildReplayWebhookDeliveryPayload(*webhooksReplayWebhookDeliveryIDFlag, *webhooksReplayWebhookDeliveryDeliveryIDFlag)
+			}
 		}
 	}
 	if err != nil {
@@ -354,6 +984,117 @@ func ParseEndpoint(
 	return endpoint, data, nil
 }
 
+// backupUsage displays the usage of the backup command and its subcommands.
+func backupUsage() {
+	fmt.Fprintf(os.Stderr, `Backup service writes encrypted archives of the database, restored with the restore command
+Usage:
+    %[1]s [globalflags] backup COMMAND [flags]
+
+COMMAND:
+    create-backup: Download an archive of the secrets, access rules, users, roles and settings, encrypted under the master key or under the backup key when one is given. Secret values stay encrypted under the master key either way
+
+Additional help:
+    %[1]s backup COMMAND --help
+`, os.Args[0])
+}
+func backupCreateBackupUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] backup create-backup -body JSON
+
+Download an archive of the secrets, access rules, users, roles and settings, encrypted under the master key or under the backup key when one is given. Secret values stay encrypted under the master key either way
+    -body JSON: 
+
+Example:
+    %[1]s backup create-backup --body '{
+      "backup_key": "correct horse battery staple"
+   }'
+`, os.Args[0])
+}
+
+// generationUsage displays the usage of the generation command and its
+// subcommands.
+func generationUsage() {
+	fmt.Fprintf(os.Stderr, `Generation service manages the policies used to generate secret values server-side
+Usage:
+    %[1]s [globalflags] generation COMMAND [flags]
+
+COMMAND:
+    list-generation-policies: List all generation policies
+    create-generation-policy: Create a generation policy
+    update-generation-policy: Replace the name and parameters of a generation policy
+    delete-generation-policy: Delete a generation policy, the secrets generated with it are kept
+
+Additional help:
+    %[1]s generation COMMAND --help
+`, os.Args[0])
+}
+func generationListGenerationPoliciesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] generation list-generation-policies
+
+List all generation policies
+
+Example:
+    %[1]s generation list-generation-policies
+`, os.Args[0])
+}
+
+func generationCreateGenerationPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] generation create-generation-policy -body JSON
+
+Create a generation policy
+    -body JSON: 
+
+Example:
+    %[1]s generation create-generation-policy --body '{
+      "bits": 4096,
+      "digits": false,
+      "encoding": "hex",
+      "kind": "bytes",
+      "length": 32,
+      "lowercase": false,
+      "name": "strong-password",
+      "separator": "-",
+      "symbols": false,
+      "uppercase": true,
+      "words": 6
+   }'
+`, os.Args[0])
+}
+
+func generationUpdateGenerationPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] generation update-generation-policy -body JSON -id INT
+
+Replace the name and parameters of a generation policy
+    -body JSON: 
+    -id INT: ID of the generation policy
+
+Example:
+    %[1]s generation update-generation-policy --body '{
+      "bits": 3072,
+      "digits": false,
+      "encoding": "base64",
+      "kind": "passphrase",
+      "length": 32,
+      "lowercase": true,
+      "name": "strong-password",
+      "separator": "-",
+      "symbols": false,
+      "uppercase": true,
+      "words": 6
+   }' --id 1
+`, os.Args[0])
+}
+
+func generationDeleteGenerationPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] generation delete-generation-policy -id INT
+
+Delete a generation policy, the secrets generated with it are kept
+    -id INT: ID of the generation policy
+
+Example:
+    %[1]s generation delete-generation-policy --id 1
+`, os.Args[0])
+}
+
 // keyManagementUsage displays the usage of the key-management command and its
 // subcommands.
 func keyManagementUsage() {
@@ -423,6 +1164,134 @@ Example:
 `, os.Args[0])
 }
 
+// policiesUsage displays the usage of the policies command and its subcommands.
+func policiesUsage() {
+	fmt.Fprintf(os.Stderr, `Policies service manages path-pattern policy documents attached to users and roles
+Usage:
+    %[1]s [globalflags] policies COMMAND [flags]
+
+COMMAND:
+    list-policies: List all policies
+    get-policy: Get a policy by id
+    create-policy: Create a policy from a HCL or YAML document
+    update-policy: Replace the name and document of a policy
+    delete-policy: Delete a policy by id
+    attach-policy: Attach a policy to a user or a role
+    detach-policy: Detach a policy from a user or a role
+    explain: Dry-run an access check and show which rules allowed or denied it
+
+Additional help:
+    %[1]s policies COMMAND --help
+`, os.Args[0])
+}
+func policiesListPoliciesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies list-policies
+
+List all policies
+
+Example:
+    %[1]s policies list-policies
+`, os.Args[0])
+}
+
+func policiesGetPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies get-policy -id INT
+
+Get a policy by id
+    -id INT: ID of the policy
+
+Example:
+    %[1]s policies get-policy --id 1
+`, os.Args[0])
+}
+
+func policiesCreatePolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies create-policy -body JSON
+
+Create a policy from a HCL or YAML document
+    -body JSON: 
+
+Example:
+    %[1]s policies create-policy --body '{
+      "document": "path \"/apps/*/db/*\" {\n  capabilities = [\"read\", \"list\"]\n}\n",
+      "format": "hcl",
+      "name": "apps-db-readers"
+   }'
+`, os.Args[0])
+}
+
+func policiesUpdatePolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies update-policy -body JSON -id INT
+
+Replace the name and document of a policy
+    -body JSON: 
+    -id INT: ID of the policy
+
+Example:
+    %[1]s policies update-policy --body '{
+      "document": "path \"/apps/*/db/*\" {\n  capabilities = [\"read\", \"list\"]\n}\n",
+      "format": "yaml",
+      "name": "apps-db-readers"
+   }' --id 1
+`, os.Args[0])
+}
+
+func policiesDeletePolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies delete-policy -id INT
+
+Delete a policy by id
+    -id INT: ID of the policy
+
+Example:
+    %[1]s policies delete-policy --id 1
+`, os.Args[0])
+}
+
+func policiesAttachPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies attach-policy -body JSON -id INT
+
+Attach a policy to a user or a role
+    -body JSON: 
+    -id INT: ID of the policy
+
+Example:
+    %[1]s policies attach-policy --body '{
+      "role_id": 1,
+      "user_id": 2
+   }' --id 1
+`, os.Args[0])
+}
+
+func policiesDetachPolicyUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies detach-policy -body JSON -id INT
+
+Detach a policy from a user or a role
+    -body JSON: 
+    -id INT: ID of the policy
+
+Example:
+    %[1]s policies detach-policy --body '{
+      "role_id": 1,
+      "user_id": 2
+   }' --id 1
+`, os.Args[0])
+}
+
+func policiesExplainUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] policies explain -body JSON
+
+Dry-run an access check and show which rules allowed or denied it
+    -body JSON: 
+
+Example:
+    %[1]s policies explain --body '{
+      "capability": "delete",
+      "path": "L2FwcHMvcGF5bWVudHMvZGIvcGFzc3dvcmQ=",
+      "user_id": 2
+   }'
+`, os.Args[0])
+}
+
 // rolesUsage displays the usage of the roles command and its subcommands.
 func rolesUsage() {
 	fmt.Fprintf(os.Stderr, `Roles service manages roles
@@ -511,10 +1380,45 @@ Usage:
 
 COMMAND:
     list-secrets: Retrieve all secrets you have access to
-    get-secret-value: Retrieve a secret value
+    browse-secrets: List the folders and secrets you have access to under a path prefix
+    get-secret-value: Retrieve a secret value, or the fields of a structured secret, with the references they hold to other secrets resolved
+    batch-get-secret-values: Retrieve the values of several secrets at once. Each secret is returned with its value or the error preventing its read, in the order of the request
+    render-template: Render a Go text/template document reading secrets, given inline or stored in a secret. Templates read secrets with secret "/path", field "/path" "name" and fields "/path", and format values with b64enc, b64dec, json and env "NAME". You need read access to the template and to every secret it reads
+    upload-secret-file: Create a binary secret from the request body, or replace the content of an existing binary secret which requires the update capability
+    download-secret-file: Download the value of a secret as a file, with the content type and filename of binary secrets
+    export-secrets: Download the secrets you can read under a folder as a dotenv, JSON or YAML file. Binary secrets are left out, the fields of structured secrets are exported as objects, or as keys of their own in dotenv files
+    import-secrets: Create the secrets of a dotenv, JSON or YAML file sent as the request body under a folder. Nothing is written unless every secret can be imported, overwriting a secret requires the update capability on it
     get-secret: Retrieve a secret's information
-    create-secret: Create a secret
-    update-secret: Update a secret
+    create-secret: Create a secret holding either a single value, named fields or a value generated from a generation policy
+    update-secret: Update a secret. Changing the value or the fields requires the update capability, changing the grants requires the manage_access capability
+    regenerate-secret: Replace a secret's value with a newly generated one, producing a new version of the secret
+    set-secret-rotation: Set or remove the rotation schedule of a generated secret
+    list-secret-rotations: List the latest rotations of a secret, most recent first
+    delete-secret: Delete a secret
+    move-secrets: Move or rename a secret, or every secret under a folder when both paths end with a '/'. Values, owners, grants and timestamps are kept, folder accesses stay on their folder. Requires the update and delete capabilities on the moved secrets, and the delete capability on the overwritten ones
+    diff-secrets: Compare the secrets you can read under two folders, such as /staging/ and /prod/, by the hashes of their values. Values and hashes are never returned
+    promote-secrets: Copy secrets from a folder to the same keys under another folder, in a single transaction. Created secrets get the owner and the grants of their source, overwritten ones keep their own. Requires read access to the promoted secrets and the update capability on the overwritten ones, identical secrets are skipped
+    transfer-secret-ownership: Give the ownership of a secret, or of every secret owned by a user, to another user or to a role
+    list-stale-secrets: List the secrets nobody read the value of for a number of days, least recently read first. Secrets never read are listed once they are that old
+    set-secret-canary: Mark a secret as a canary, or back as a normal secret. Reading the value of a canary raises a secret.canary_accessed alert, the caller sees a normal secret
+    list-canary-secrets: List the paths of the canary secrets
+    wrap-secret: Store a value, or a copy of a secret you can read, under a random single-use token expiring after the TTL. Only the token is returned, to be handed to someone who unwraps it
+    unwrap-secret: Return the value wrapped under a token and destroy it. No authentication is needed, the token can only be unwrapped once: a token reported as already used was unwrapped by someone else
+    request-access: File a request for more capabilities on a secret one can list, to be approved or denied by an admin or a user who can manage its access
+    list-access-requests: List the access requests you filed and the ones you can decide on, most recent first. Admins see every request
+    approve-access-request: Approve a pending access request, granting the requested capabilities to the requester for the requested duration, never shortening an existing grant of the requester. Only admins and users who can manage the access of the secret can approve, and not their own requests
+    deny-access-request: Deny a pending access request. Only admins and users who can manage the access of the secret can deny, and not their own requests
+    list-validation-rules: List the rules the values of secrets are validated against
+    create-validation-rule: Make the values of the secrets under a folder follow a rule when they are created or updated. Existing values are not checked
+    delete-validation-rule: Delete a validation rule
+    list-break-glass-rules: List the folders whose secrets are eligible to break-glass access
+    create-break-glass-rule: Make the secrets under a folder eligible to break-glass access, by anyone or only by the members of a role
+    delete-break-glass-rule: Delete a break-glass rule. The emergency accesses already given last until they expire
+    list-break-glass-accesses: List the emergency accesses on the secrets you can manage the access of, most recent first. Admins see every emergency access
+    review-break-glass-access: Acknowledge an emergency access after reviewing it. Only admins and users who can manage the access of the secret can review, and not their own emergency accesses
+    list-folder-accesses: List the accesses granted on folders
+    grant-folder-access: Grant a user or a role access to every secret under a folder, including future ones
+    revoke-folder-access: Revoke an access granted on a folder
 
 Additional help:
     %[1]s secrets COMMAND --help
@@ -530,21 +1434,129 @@ Example:
 `, os.Args[0])
 }
 
-func secretsGetSecretValueUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets get-secret-value -path STRING
+func secretsBrowseSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets browse-secrets -prefix STRING -recursive BOOL -cursor STRING -limit INT
 
-Retrieve a secret value
-    -path STRING: Base64 encoded secret's path
+List the folders and secrets you have access to under a path prefix
+    -prefix STRING: 
+    -recursive BOOL: 
+    -cursor STRING: 
+    -limit INT: 
 
 Example:
-    %[1]s secrets get-secret-value --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+    %[1]s secrets browse-secrets --prefix "L2N1c3RvbWVycy8=" --recursive true --cursor "Eligendi consequatur voluptas." --limit 970
 `, os.Args[0])
 }
 
-func secretsGetSecretUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets get-secret -path STRING
+func secretsGetSecretValueUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets get-secret-value -path STRING -field STRING -raw BOOL -break-glass-justification STRING
 
-Retrieve a secret's information
+Retrieve a secret value, or the fields of a structured secret, with the references they hold to other secrets resolved
+    -path STRING: Base64 encoded secret's path
+    -field STRING: 
+    -raw BOOL: 
+    -break-glass-justification STRING: 
+
+Example:
+    %[1]s secrets get-secret-value --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==" --field "password" --raw false --break-glass-justification "Production outage, the payment provider rejects our requests"
+`, os.Args[0])
+}
+
+func secretsBatchGetSecretValuesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets batch-get-secret-values -body JSON
+
+Retrieve the values of several secrets at once. Each secret is returned with its value or the error preventing its read, in the order of the request
+    -body JSON: 
+
+Example:
+    %[1]s secrets batch-get-secret-values --body '{
+      "secrets": [
+         {
+            "field": "password",
+            "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+         },
+         {
+            "field": "password",
+            "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+         }
+      ]
+   }'
+`, os.Args[0])
+}
+
+func secretsRenderTemplateUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets render-template -body JSON
+
+Render a Go text/template document reading secrets, given inline or stored in a secret. Templates read secrets with secret "/path", field "/path" "name" and fields "/path", and format values with b64enc, b64dec, json and env "NAME". You need read access to the template and to every secret it reads
+    -body JSON: 
+
+Example:
+    %[1]s secrets render-template --body '{
+      "template": "DATABASE_URL={{ secret \"/app/db/url\" }}\n{{ env \"API_KEY\" (secret \"/app/api_key\") }}",
+      "template_path": "L2FwcC90ZW1wbGF0ZXMvZW52"
+   }'
+`, os.Args[0])
+}
+
+func secretsUploadSecretFileUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets upload-secret-file -path STRING -filename STRING -content-type STRING -stream STRING
+
+Create a binary secret from the request body, or replace the content of an existing binary secret which requires the update capability
+    -path STRING: Base64 encoded secret's path
+    -filename STRING: 
+    -content-type STRING: 
+    -stream STRING: path to file containing the streamed request body
+
+Example:
+    %[1]s secrets upload-secret-file --path "L2FwcC90bHMvY2VydHMucDEy" --filename "certs.p12" --content-type "application/x-pkcs12" --stream "goa.png"
+`, os.Args[0])
+}
+
+func secretsDownloadSecretFileUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets download-secret-file -path STRING
+
+Download the value of a secret as a file, with the content type and filename of binary secrets
+    -path STRING: Base64 encoded secret's path
+
+Example:
+    %[1]s secrets download-secret-file --path "L2FwcC90bHMvY2VydHMucDEy"
+`, os.Args[0])
+}
+
+func secretsExportSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets export-secrets -prefix STRING -format STRING -key-naming STRING -raw BOOL
+
+Download the secrets you can read under a folder as a dotenv, JSON or YAML file. Binary secrets are left out, the fields of structured secrets are exported as objects, or as keys of their own in dotenv files
+    -prefix STRING: 
+    -format STRING: 
+    -key-naming STRING: 
+    -raw BOOL: 
+
+Example:
+    %[1]s secrets export-secrets --prefix "L2FwcC8=" --format "dotenv" --key-naming "segment" --raw false
+`, os.Args[0])
+}
+
+func secretsImportSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets import-secrets -prefix STRING -format STRING -key-naming STRING -on-existing STRING -dry-run BOOL -stream STRING
+
+Create the secrets of a dotenv, JSON or YAML file sent as the request body under a folder. Nothing is written unless every secret can be imported, overwriting a secret requires the update capability on it
+    -prefix STRING: 
+    -format STRING: 
+    -key-naming STRING: 
+    -on-existing STRING: 
+    -dry-run BOOL: 
+    -stream STRING: path to file containing the streamed request body
+
+Example:
+    %[1]s secrets import-secrets --prefix "L2FwcC8=" --format "yaml" --key-naming "path" --on-existing "skip" --dry-run false --stream "goa.png"
+`, os.Args[0])
+}
+
+func secretsGetSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets get-secret -path STRING
+
+Retrieve a secret's information
     -path STRING: Base64 encoded secret's path
 
 Example:
@@ -555,7 +1567,7 @@ Example:
 func secretsCreateSecretUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets create-secret -body JSON
 
-Create a secret
+Create a secret holding either a single value, named fields or a value generated from a generation policy
     -body JSON: 
 
 Example:
@@ -569,7 +1581,71 @@ Example:
          2,
          3
       ],
+      "fields": {
+         "password": "hunter2",
+         "username": "app"
+      },
+      "generation_policy": "strong-password",
       "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==",
+      "role_grants": [
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         }
+      ],
+      "rotation_schedule": "720h",
+      "user_grants": [
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         }
+      ],
       "value": "SECRET_API_KEY123"
    }'
 `, os.Args[0])
@@ -578,7 +1654,7 @@ Example:
 func secretsUpdateSecretUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets update-secret -body JSON
 
-Update a secret
+Update a secret. Changing the value or the fields requires the update capability, changing the grants requires the manage_access capability
     -body JSON: 
 
 Example:
@@ -592,12 +1668,467 @@ Example:
          2,
          3
       ],
+      "fields": {
+         "password": "hunter3"
+      },
       "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==",
+      "remove_fields": [
+         "legacy_password"
+      ],
+      "role_grants": [
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         }
+      ],
+      "user_grants": [
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         },
+         {
+            "capabilities": [
+               "read",
+               "list"
+            ],
+            "expires_at": "2025-07-01T18:00:00Z",
+            "expires_in": 3600,
+            "id": 2
+         }
+      ],
       "value": "SECRET_API_KEY123"
    }'
 `, os.Args[0])
 }
 
+func secretsRegenerateSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets regenerate-secret -body JSON -path STRING
+
+Replace a secret's value with a newly generated one, producing a new version of the secret
+    -body JSON: 
+    -path STRING: Base64 encoded secret's path
+
+Example:
+    %[1]s secrets regenerate-secret --body '{
+      "generation_policy": "strong-password"
+   }' --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+`, os.Args[0])
+}
+
+func secretsSetSecretRotationUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets set-secret-rotation -body JSON -path STRING
+
+Set or remove the rotation schedule of a generated secret
+    -body JSON: 
+    -path STRING: Base64 encoded secret's path
+
+Example:
+    %[1]s secrets set-secret-rotation --body '{
+      "schedule": "720h"
+   }' --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+`, os.Args[0])
+}
+
+func secretsListSecretRotationsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-secret-rotations -path STRING -limit INT
+
+List the latest rotations of a secret, most recent first
+    -path STRING: Base64 encoded secret's path
+    -limit INT: 
+
+Example:
+    %[1]s secrets list-secret-rotations --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==" --limit 9
+`, os.Args[0])
+}
+
+func secretsDeleteSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets delete-secret -path STRING
+
+Delete a secret
+    -path STRING: Base64 encoded secret's path
+
+Example:
+    %[1]s secrets delete-secret --path "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+`, os.Args[0])
+}
+
+func secretsMoveSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets move-secrets -body JSON
+
+Move or rename a secret, or every secret under a folder when both paths end with a '/'. Values, owners, grants and timestamps are kept, folder accesses stay on their folder. Requires the update and delete capabilities on the moved secrets, and the delete capability on the overwritten ones
+    -body JSON: 
+
+Example:
+    %[1]s secrets move-secrets --body '{
+      "destination": "L2JpbGxpbmcv",
+      "overwrite": true,
+      "source": "L3BheW1lbnRzLw=="
+   }'
+`, os.Args[0])
+}
+
+func secretsDiffSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets diff-secrets -source STRING -destination STRING
+
+Compare the secrets you can read under two folders, such as /staging/ and /prod/, by the hashes of their values. Values and hashes are never returned
+    -source STRING: 
+    -destination STRING: 
+
+Example:
+    %[1]s secrets diff-secrets --source "L3N0YWdpbmcv" --destination "L3Byb2Qv"
+`, os.Args[0])
+}
+
+func secretsPromoteSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets promote-secrets -body JSON
+
+Copy secrets from a folder to the same keys under another folder, in a single transaction. Created secrets get the owner and the grants of their source, overwritten ones keep their own. Requires read access to the promoted secrets and the update capability on the overwritten ones, identical secrets are skipped
+    -body JSON: 
+
+Example:
+    %[1]s secrets promote-secrets --body '{
+      "destination": "L3Byb2Qv",
+      "keys": [
+         "db/password",
+         "stripe/api_key"
+      ],
+      "overwrite": true,
+      "source": "L3N0YWdpbmcv"
+   }'
+`, os.Args[0])
+}
+
+func secretsTransferSecretOwnershipUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets transfer-secret-ownership -body JSON
+
+Give the ownership of a secret, or of every secret owned by a user, to another user or to a role
+    -body JSON: 
+
+Example:
+    %[1]s secrets transfer-secret-ownership --body '{
+      "from_user_id": 2,
+      "path": "L3BheW1lbnRzL3N0cmlwZV9rZXk=",
+      "to_role_id": 1,
+      "to_user_id": 3
+   }'
+`, os.Args[0])
+}
+
+func secretsListStaleSecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-stale-secrets -days INT
+
+List the secrets nobody read the value of for a number of days, least recently read first. Secrets never read are listed once they are that old
+    -days INT: 
+
+Example:
+    %[1]s secrets list-stale-secrets --days 918016278933152290
+`, os.Args[0])
+}
+
+func secretsSetSecretCanaryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets set-secret-canary -body JSON -path STRING
+
+Mark a secret as a canary, or back as a normal secret. Reading the value of a canary raises a secret.canary_accessed alert, the caller sees a normal secret
+    -body JSON: 
+    -path STRING: Base64 encoded secret's path
+
+Example:
+    %[1]s secrets set-secret-canary --body '{
+      "canary": false
+   }' --path "L3Byb2R1Y3Rpb24vbGVnYWN5X2FkbWluX3Bhc3N3b3Jk"
+`, os.Args[0])
+}
+
+func secretsListCanarySecretsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-canary-secrets
+
+List the paths of the canary secrets
+
+Example:
+    %[1]s secrets list-canary-secrets
+`, os.Args[0])
+}
+
+func secretsWrapSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets wrap-secret -body JSON
+
+Store a value, or a copy of a secret you can read, under a random single-use token expiring after the TTL. Only the token is returned, to be handed to someone who unwraps it
+    -body JSON: 
+
+Example:
+    %[1]s secrets wrap-secret --body '{
+      "field": "password",
+      "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==",
+      "ttl": 18261,
+      "value": "SECRET_API_KEY123"
+   }'
+`, os.Args[0])
+}
+
+func secretsUnwrapSecretUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets unwrap-secret -body JSON
+
+Return the value wrapped under a token and destroy it. No authentication is needed, the token can only be unwrapped once: a token reported as already used was unwrapped by someone else
+    -body JSON: 
+
+Example:
+    %[1]s secrets unwrap-secret --body '{
+      "token": "c1b0e4f5a9d84a2c8e7f6b5a4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c"
+   }'
+`, os.Args[0])
+}
+
+func secretsRequestAccessUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets request-access -body JSON
+
+File a request for more capabilities on a secret one can list, to be approved or denied by an admin or a user who can manage its access
+    -body JSON: 
+
+Example:
+    %[1]s secrets request-access --body '{
+      "capabilities": [
+         "manage_access",
+         "delete",
+         "read"
+      ],
+      "duration": 3600,
+      "justification": "Investigating the failed deployment of the billing API",
+      "path": "L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ=="
+   }'
+`, os.Args[0])
+}
+
+func secretsListAccessRequestsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-access-requests -status STRING
+
+List the access requests you filed and the ones you can decide on, most recent first. Admins see every request
+    -status STRING: 
+
+Example:
+    %[1]s secrets list-access-requests --status "approved"
+`, os.Args[0])
+}
+
+func secretsApproveAccessRequestUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets approve-access-request -body JSON -id INT
+
+Approve a pending access request, granting the requested capabilities to the requester for the requested duration, never shortening an existing grant of the requester. Only admins and users who can manage the access of the secret can approve, and not their own requests
+    -body JSON: 
+    -id INT: ID of the access request
+
+Example:
+    %[1]s secrets approve-access-request --body '{
+      "comment": "Granted for the incident"
+   }' --id 1
+`, os.Args[0])
+}
+
+func secretsDenyAccessRequestUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets deny-access-request -body JSON -id INT
+
+Deny a pending access request. Only admins and users who can manage the access of the secret can deny, and not their own requests
+    -body JSON: 
+    -id INT: ID of the access request
+
+Example:
+    %[1]s secrets deny-access-request --body '{
+      "comment": "Ask your team lead for the shared account instead"
+   }' --id 1
+`, os.Args[0])
+}
+
+func secretsListValidationRulesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-validation-rules
+
+List the rules the values of secrets are validated against
+
+Example:
+    %[1]s secrets list-validation-rules
+`, os.Args[0])
+}
+
+func secretsCreateValidationRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets create-validation-rule -body JSON
+
+Make the values of the secrets under a folder follow a rule when they are created or updated. Existing values are not checked
+    -body JSON: 
+
+Example:
+    %[1]s secrets create-validation-rule --body '{
+      "field": "certificate",
+      "kind": "regex",
+      "parameter": "^sk_live_[a-zA-Z0-9]{24}$",
+      "path_prefix": "L2NlcnRpZmljYXRlcy8="
+   }'
+`, os.Args[0])
+}
+
+func secretsDeleteValidationRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets delete-validation-rule -id INT
+
+Delete a validation rule
+    -id INT: ID of the rule
+
+Example:
+    %[1]s secrets delete-validation-rule --id 1
+`, os.Args[0])
+}
+
+func secretsListBreakGlassRulesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-break-glass-rules
+
+List the folders whose secrets are eligible to break-glass access
+
+Example:
+    %[1]s secrets list-break-glass-rules
+`, os.Args[0])
+}
+
+func secretsCreateBreakGlassRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets create-break-glass-rule -body JSON
+
+Make the secrets under a folder eligible to break-glass access, by anyone or only by the members of a role
+    -body JSON: 
+
+Example:
+    %[1]s secrets create-break-glass-rule --body '{
+      "path_prefix": "L3Byb2R1Y3Rpb24v",
+      "role_id": 3
+   }'
+`, os.Args[0])
+}
+
+func secretsDeleteBreakGlassRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets delete-break-glass-rule -id INT
+
+Delete a break-glass rule. The emergency accesses already given last until they expire
+    -id INT: ID of the rule
+
+Example:
+    %[1]s secrets delete-break-glass-rule --id 1
+`, os.Args[0])
+}
+
+func secretsListBreakGlassAccessesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-break-glass-accesses -unreviewed BOOL
+
+List the emergency accesses on the secrets you can manage the access of, most recent first. Admins see every emergency access
+    -unreviewed BOOL: 
+
+Example:
+    %[1]s secrets list-break-glass-accesses --unreviewed false
+`, os.Args[0])
+}
+
+func secretsReviewBreakGlassAccessUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets review-break-glass-access -body JSON -id INT
+
+Acknowledge an emergency access after reviewing it. Only admins and users who can manage the access of the secret can review, and not their own emergency accesses
+    -body JSON: 
+    -id INT: ID of the emergency access
+
+Example:
+    %[1]s secrets review-break-glass-access --body '{
+      "comment": "Legitimate, incident INC-42"
+   }' --id 1
+`, os.Args[0])
+}
+
+func secretsListFolderAccessesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets list-folder-accesses
+
+List the accesses granted on folders
+
+Example:
+    %[1]s secrets list-folder-accesses
+`, os.Args[0])
+}
+
+func secretsGrantFolderAccessUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets grant-folder-access -body JSON
+
+Grant a user or a role access to every secret under a folder, including future ones
+    -body JSON: 
+
+Example:
+    %[1]s secrets grant-folder-access --body '{
+      "capabilities": [
+         "update",
+         "manage_access"
+      ],
+      "path_prefix": "L3BheW1lbnRzLw==",
+      "role_id": 1,
+      "user_id": 2
+   }'
+`, os.Args[0])
+}
+
+func secretsRevokeFolderAccessUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] secrets revoke-folder-access -id INT
+
+Revoke an access granted on a folder
+    -id INT: ID of the folder access to revoke
+
+Example:
+    %[1]s secrets revoke-folder-access --id 1
+`, os.Args[0])
+}
+
 // usersUsage displays the usage of the users command and its subcommands.
 func usersUsage() {
 	fmt.Fprintf(os.Stderr, `User service manages user accounts and authentication
@@ -640,13 +2171,14 @@ Example:
 }
 
 func usersDeleteUserUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] users delete-user -username STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] users delete-user -username STRING -reassign-to STRING
 
 Delete a user by username
     -username STRING: Username of the user to delete
+    -reassign-to STRING: 
 
 Example:
-    %[1]s users delete-user --username "alice"
+    %[1]s users delete-user --username "alice" --reassign-to "bob"
 `, os.Args[0])
 }
 
@@ -673,3 +2205,108 @@ Example:
     %[1]s users get-operator-token
 `, os.Args[0])
 }
+
+// webhooksUsage displays the usage of the webhooks command and its subcommands.
+func webhooksUsage() {
+	fmt.Fprintf(os.Stderr, `Webhooks service manages the endpoints notified of the events of the server
+Usage:
+    %[1]s [globalflags] webhooks COMMAND [flags]
+
+COMMAND:
+    list-webhooks: List all webhooks
+    create-webhook: Register a webhook. The returned secret signs its deliveries and is not shown again
+    update-webhook: Replace the parameters of a webhook, its secret is kept
+    delete-webhook: Delete a webhook along with its deliveries
+    list-webhook-deliveries: List the latest deliveries of a webhook
+    replay-webhook-delivery: Deliver the event of a failed delivery again, as a new delivery
+
+Additional help:
+    %[1]s webhooks COMMAND --help
+`, os.Args[0])
+}
+func webhooksListWebhooksUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks list-webhooks
+
+List all webhooks
+
+Example:
+    %[1]s webhooks list-webhooks
+`, os.Args[0])
+}
+
+func webhooksCreateWebhookUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks create-webhook -body JSON
+
+Register a webhook. The returned secret signs its deliveries and is not shown again
+    -body JSON: 
+
+Example:
+    %[1]s webhooks create-webhook --body '{
+      "enabled": true,
+      "events": [
+         "secret.updated",
+         "secret.rotated"
+      ],
+      "name": "restart-api",
+      "path_prefix": "/customers/",
+      "url": "https://hooks.example.com/fishykeys"
+   }'
+`, os.Args[0])
+}
+
+func webhooksUpdateWebhookUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks update-webhook -body JSON -id INT
+
+Replace the parameters of a webhook, its secret is kept
+    -body JSON: 
+    -id INT: ID of the webhook
+
+Example:
+    %[1]s webhooks update-webhook --body '{
+      "enabled": false,
+      "events": [
+         "secret.updated",
+         "secret.rotated"
+      ],
+      "name": "restart-api",
+      "path_prefix": "/customers/",
+      "url": "https://hooks.example.com/fishykeys"
+   }' --id 1
+`, os.Args[0])
+}
+
+func webhooksDeleteWebhookUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks delete-webhook -id INT
+
+Delete a webhook along with its deliveries
+    -id INT: ID of the webhook
+
+Example:
+    %[1]s webhooks delete-webhook --id 1
+`, os.Args[0])
+}
+
+func webhooksListWebhookDeliveriesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks list-webhook-deliveries -id INT -status STRING -limit INT
+
+List the latest deliveries of a webhook
+    -id INT: ID of the webhook
+    -status STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s webhooks list-webhook-deliveries --id 1 --status "pending" --limit 403
+`, os.Args[0])
+}
+
+func webhooksReplayWebhookDeliveryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] webhooks replay-webhook-delivery -id INT -delivery-id INT
+
+Deliver the event of a failed delivery again, as a new delivery
+    -id INT: ID of the webhook
+    -delivery-id INT: ID of the failed delivery
+
+Example:
+    %[1]s webhooks replay-webhook-delivery --id 1 --delivery-id 12
+`, os.Args[0])
+}
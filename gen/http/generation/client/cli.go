@@ -0,0 +1,141 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	generation "github.com/Vidalee/FishyKeys/gen/generation"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateGenerationPolicyPayload builds the payload for the generation
+// create generation policy endpoint from CLI flags.
+func BuildCreateGenerationPolicyPayload(generationCreateGenerationPolicyBody string) (*generation.CreateGenerationPolicyPayload, error) {
+	var err error
+	var body CreateGenerationPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(generationCreateGenerationPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"bits\": 4096,\n      \"digits\": false,\n      \"encoding\": \"base64\",\n      \"kind\": \"passphrase\",\n      \"length\": 32,\n      \"lowercase\": false,\n      \"name\": \"strong-password\",\n      \"separator\": \"-\",\n      \"symbols\": false,\n      \"uppercase\": true,\n      \"words\": 6\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if !(body.Kind == "password" || body.Kind == "passphrase" || body.Kind == "bytes" || body.Kind == "uuid" || body.Kind == "rsa" || body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+		if body.Encoding != nil {
+			if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+			}
+		}
+		if body.Bits != nil {
+			if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &generation.CreateGenerationPolicyPayload{
+		Name:      body.Name,
+		Kind:      body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+
+	return v, nil
+}
+
+// BuildUpdateGenerationPolicyPayload builds the payload for the generation
+// update generation policy endpoint from CLI flags.
+func BuildUpdateGenerationPolicyPayload(generationUpdateGenerationPolicyBody string, generationUpdateGenerationPolicyID string) (*generation.UpdateGenerationPolicyPayload, error) {
+	var err error
+	var body UpdateGenerationPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(generationUpdateGenerationPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"bits\": 4096,\n      \"digits\": false,\n      \"encoding\": \"base64\",\n      \"kind\": \"password\",\n      \"length\": 32,\n      \"lowercase\": false,\n      \"name\": \"strong-password\",\n      \"separator\": \"-\",\n      \"symbols\": false,\n      \"uppercase\": false,\n      \"words\": 6\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if !(body.Kind == "password" || body.Kind == "passphrase" || body.Kind == "bytes" || body.Kind == "uuid" || body.Kind == "rsa" || body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+		if body.Encoding != nil {
+			if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+			}
+		}
+		if body.Bits != nil {
+			if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(generationUpdateGenerationPolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &generation.UpdateGenerationPolicyPayload{
+		Name:      body.Name,
+		Kind:      body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeleteGenerationPolicyPayload builds the payload for the generation
+// delete generation policy endpoint from CLI flags.
+func BuildDeleteGenerationPolicyPayload(generationDeleteGenerationPolicyID string) (*generation.DeleteGenerationPolicyPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(generationDeleteGenerationPolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &generation.DeleteGenerationPolicyPayload{}
+	v.ID = id
+
+	return v, nil
+}
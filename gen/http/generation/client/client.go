@@ -0,0 +1,156 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation client HTTP transport
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the generation service endpoint HTTP clients.
+type Client struct {
+	// ListGenerationPolicies Doer is the HTTP client used to make requests to the
+	// list generation policies endpoint.
+	ListGenerationPoliciesDoer goahttp.Doer
+
+	// CreateGenerationPolicy Doer is the HTTP client used to make requests to the
+	// create generation policy endpoint.
+	CreateGenerationPolicyDoer goahttp.Doer
+
+	// UpdateGenerationPolicy Doer is the HTTP client used to make requests to the
+	// update generation policy endpoint.
+	UpdateGenerationPolicyDoer goahttp.Doer
+
+	// DeleteGenerationPolicy Doer is the HTTP client used to make requests to the
+	// delete generation policy endpoint.
+	DeleteGenerationPolicyDoer goahttp.Doer
+
+	// CORS Doer is the HTTP client used to make requests to the  endpoint.
+	CORSDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the generation service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListGenerationPoliciesDoer: doer,
+		CreateGenerationPolicyDoer: doer,
+		UpdateGenerationPolicyDoer: doer,
+		DeleteGenerationPolicyDoer: doer,
+		CORSDoer:                   doer,
+		RestoreResponseBody:        restoreBody,
+		scheme:                     scheme,
+		host:                       host,
+		decoder:                    dec,
+		encoder:                    enc,
+	}
+}
+
+// ListGenerationPolicies returns an endpoint that makes HTTP requests to the
+// generation service list generation policies server.
+func (c *Client) ListGenerationPolicies() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListGenerationPoliciesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListGenerationPoliciesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListGenerationPoliciesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("generation", "list generation policies", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateGenerationPolicy returns an endpoint that makes HTTP requests to the
+// generation service create generation policy server.
+func (c *Client) CreateGenerationPolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateGenerationPolicyRequest(c.encoder)
+		decodeResponse = DecodeCreateGenerationPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateGenerationPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateGenerationPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("generation", "create generation policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateGenerationPolicy returns an endpoint that makes HTTP requests to the
+// generation service update generation policy server.
+func (c *Client) UpdateGenerationPolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateGenerationPolicyRequest(c.encoder)
+		decodeResponse = DecodeUpdateGenerationPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateGenerationPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateGenerationPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("generation", "update generation policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteGenerationPolicy returns an endpoint that makes HTTP requests to the
+// generation service delete generation policy server.
+func (c *Client) DeleteGenerationPolicy() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteGenerationPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteGenerationPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteGenerationPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("generation", "delete generation policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,591 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	generation "github.com/Vidalee/FishyKeys/gen/generation"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListGenerationPoliciesRequest instantiates a HTTP request object with
+// method and path set to call the "generation" service "list generation
+// policies" endpoint
+func (c *Client) BuildListGenerationPoliciesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListGenerationPoliciesGenerationPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("generation", "list generation policies", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListGenerationPoliciesResponse returns a decoder for responses
+// returned by the generation list generation policies endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListGenerationPoliciesResponse may return the following errors:
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListGenerationPoliciesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListGenerationPoliciesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "list generation policies", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateGenerationPolicyResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "list generation policies", err)
+			}
+			res := NewListGenerationPoliciesGenerationPolicyOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ListGenerationPoliciesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "list generation policies", err)
+			}
+			err = ValidateListGenerationPoliciesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "list generation policies", err)
+			}
+			return nil, NewListGenerationPoliciesInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ListGenerationPoliciesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "list generation policies", err)
+			}
+			err = ValidateListGenerationPoliciesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "list generation policies", err)
+			}
+			return nil, NewListGenerationPoliciesForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListGenerationPoliciesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "list generation policies", err)
+			}
+			err = ValidateListGenerationPoliciesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "list generation policies", err)
+			}
+			return nil, NewListGenerationPoliciesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("generation", "list generation policies", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateGenerationPolicyRequest instantiates a HTTP request object with
+// method and path set to call the "generation" service "create generation
+// policy" endpoint
+func (c *Client) BuildCreateGenerationPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateGenerationPolicyGenerationPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("generation", "create generation policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateGenerationPolicyRequest returns an encoder for requests sent to
+// the generation create generation policy server.
+func EncodeCreateGenerationPolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*generation.CreateGenerationPolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("generation", "create generation policy", "*generation.CreateGenerationPolicyPayload", v)
+		}
+		body := NewCreateGenerationPolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("generation", "create generation policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateGenerationPolicyResponse returns a decoder for responses
+// returned by the generation create generation policy endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeCreateGenerationPolicyResponse may return the following errors:
+//   - "generation_policy_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateGenerationPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateGenerationPolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			res := NewCreateGenerationPolicyGenerationPolicyCreated(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CreateGenerationPolicyGenerationPolicyTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyGenerationPolicyTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			return nil, NewCreateGenerationPolicyGenerationPolicyTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateGenerationPolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			return nil, NewCreateGenerationPolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateGenerationPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			return nil, NewCreateGenerationPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateGenerationPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			return nil, NewCreateGenerationPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateGenerationPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "create generation policy", err)
+			}
+			err = ValidateCreateGenerationPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "create generation policy", err)
+			}
+			return nil, NewCreateGenerationPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("generation", "create generation policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateGenerationPolicyRequest instantiates a HTTP request object with
+// method and path set to call the "generation" service "update generation
+// policy" endpoint
+func (c *Client) BuildUpdateGenerationPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*generation.UpdateGenerationPolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("generation", "update generation policy", "*generation.UpdateGenerationPolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateGenerationPolicyGenerationPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("generation", "update generation policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateGenerationPolicyRequest returns an encoder for requests sent to
+// the generation update generation policy server.
+func EncodeUpdateGenerationPolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*generation.UpdateGenerationPolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("generation", "update generation policy", "*generation.UpdateGenerationPolicyPayload", v)
+		}
+		body := NewUpdateGenerationPolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("generation", "update generation policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateGenerationPolicyResponse returns a decoder for responses
+// returned by the generation update generation policy endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeUpdateGenerationPolicyResponse may return the following errors:
+//   - "generation_policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "generation_policy_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateGenerationPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateGenerationPolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			res := NewUpdateGenerationPolicyGenerationPolicyOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyGenerationPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyGenerationPolicyNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateGenerationPolicyGenerationPolicyTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyGenerationPolicyTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyGenerationPolicyTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateGenerationPolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateGenerationPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateGenerationPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateGenerationPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "update generation policy", err)
+			}
+			err = ValidateUpdateGenerationPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "update generation policy", err)
+			}
+			return nil, NewUpdateGenerationPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("generation", "update generation policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteGenerationPolicyRequest instantiates a HTTP request object with
+// method and path set to call the "generation" service "delete generation
+// policy" endpoint
+func (c *Client) BuildDeleteGenerationPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*generation.DeleteGenerationPolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("generation", "delete generation policy", "*generation.DeleteGenerationPolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteGenerationPolicyGenerationPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("generation", "delete generation policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteGenerationPolicyResponse returns a decoder for responses
+// returned by the generation delete generation policy endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeDeleteGenerationPolicyResponse may return the following errors:
+//   - "generation_policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteGenerationPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "delete generation policy", err)
+			}
+			err = ValidateDeleteGenerationPolicyGenerationPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "delete generation policy", err)
+			}
+			return nil, NewDeleteGenerationPolicyGenerationPolicyNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteGenerationPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "delete generation policy", err)
+			}
+			err = ValidateDeleteGenerationPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "delete generation policy", err)
+			}
+			return nil, NewDeleteGenerationPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteGenerationPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "delete generation policy", err)
+			}
+			err = ValidateDeleteGenerationPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "delete generation policy", err)
+			}
+			return nil, NewDeleteGenerationPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteGenerationPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("generation", "delete generation policy", err)
+			}
+			err = ValidateDeleteGenerationPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("generation", "delete generation policy", err)
+			}
+			return nil, NewDeleteGenerationPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("generation", "delete generation policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalGenerationPolicyResponseToGenerationGenerationPolicy builds a value
+// of type *generation.GenerationPolicy from a value of type
+// *GenerationPolicyResponse.
+func unmarshalGenerationPolicyResponseToGenerationGenerationPolicy(v *GenerationPolicyResponse) *generation.GenerationPolicy {
+	res := &generation.GenerationPolicy{
+		ID:        *v.ID,
+		Name:      *v.Name,
+		CreatedAt: *v.CreatedAt,
+		UpdatedAt: *v.UpdatedAt,
+		Kind:      *v.Kind,
+		Length:    v.Length,
+		Lowercase: v.Lowercase,
+		Uppercase: v.Uppercase,
+		Digits:    v.Digits,
+		Symbols:   v.Symbols,
+		Words:     v.Words,
+		Separator: v.Separator,
+		Encoding:  v.Encoding,
+		Bits:      v.Bits,
+	}
+
+	return res
+}
@@ -0,0 +1,32 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the generation service.
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// ListGenerationPoliciesGenerationPath returns the URL path to the generation service list generation policies HTTP endpoint.
+func ListGenerationPoliciesGenerationPath() string {
+	return "/generation-policies"
+}
+
+// CreateGenerationPolicyGenerationPath returns the URL path to the generation service create generation policy HTTP endpoint.
+func CreateGenerationPolicyGenerationPath() string {
+	return "/generation-policies"
+}
+
+// UpdateGenerationPolicyGenerationPath returns the URL path to the generation service update generation policy HTTP endpoint.
+func UpdateGenerationPolicyGenerationPath(id int) string {
+	return fmt.Sprintf("/generation-policies/%v", id)
+}
+
+// DeleteGenerationPolicyGenerationPath returns the URL path to the generation service delete generation policy HTTP endpoint.
+func DeleteGenerationPolicyGenerationPath(id int) string {
+	return fmt.Sprintf("/generation-policies/%v", id)
+}
@@ -0,0 +1,1425 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation HTTP client types
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	generation "github.com/Vidalee/FishyKeys/gen/generation"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateGenerationPolicyRequestBody is the type of the "generation" service
+// "create generation policy" endpoint HTTP request body.
+type CreateGenerationPolicyRequestBody struct {
+	// Name of the generation policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Kind of value generated by the policy
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// UpdateGenerationPolicyRequestBody is the type of the "generation" service
+// "update generation policy" endpoint HTTP request body.
+type UpdateGenerationPolicyRequestBody struct {
+	// Name of the generation policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Kind of value generated by the policy
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// ListGenerationPoliciesResponseBody is the type of the "generation" service
+// "list generation policies" endpoint HTTP response body.
+type ListGenerationPoliciesResponseBody []*GenerationPolicyResponse
+
+// CreateGenerationPolicyResponseBody is the type of the "generation" service
+// "create generation policy" endpoint HTTP response body.
+type CreateGenerationPolicyResponseBody struct {
+	// Unique identifier for the generation policy
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the generation policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Generation policy creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Generation policy last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Kind of value generated by the policy
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// UpdateGenerationPolicyResponseBody is the type of the "generation" service
+// "update generation policy" endpoint HTTP response body.
+type UpdateGenerationPolicyResponseBody struct {
+	// Unique identifier for the generation policy
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the generation policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Generation policy creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Generation policy last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Kind of value generated by the policy
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// ListGenerationPoliciesInternalErrorResponseBody is the type of the
+// "generation" service "list generation policies" endpoint HTTP response body
+// for the "internal_error" error.
+type ListGenerationPoliciesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListGenerationPoliciesForbiddenResponseBody is the type of the "generation"
+// service "list generation policies" endpoint HTTP response body for the
+// "forbidden" error.
+type ListGenerationPoliciesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListGenerationPoliciesUnauthorizedResponseBody is the type of the
+// "generation" service "list generation policies" endpoint HTTP response body
+// for the "unauthorized" error.
+type ListGenerationPoliciesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateGenerationPolicyGenerationPolicyTakenResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "generation_policy_taken" error.
+type CreateGenerationPolicyGenerationPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateGenerationPolicyInvalidParametersResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type CreateGenerationPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type CreateGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "create generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type CreateGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody is the type of
+// the "generation" service "update generation policy" endpoint HTTP response
+// body for the "generation_policy_not_found" error.
+type UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyGenerationPolicyTakenResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "generation_policy_taken" error.
+type UpdateGenerationPolicyGenerationPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyInvalidParametersResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type UpdateGenerationPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type UpdateGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "update generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type UpdateGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type UpdateGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody is the type of
+// the "generation" service "delete generation policy" endpoint HTTP response
+// body for the "generation_policy_not_found" error.
+type DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "delete generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type DeleteGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "delete generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "delete generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type DeleteGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GenerationPolicyResponse is used to define fields on response body types.
+type GenerationPolicyResponse struct {
+	// Unique identifier for the generation policy
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the generation policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Generation policy creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Generation policy last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Kind of value generated by the policy
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// NewCreateGenerationPolicyRequestBody builds the HTTP request body from the
+// payload of the "create generation policy" endpoint of the "generation"
+// service.
+func NewCreateGenerationPolicyRequestBody(p *generation.CreateGenerationPolicyPayload) *CreateGenerationPolicyRequestBody {
+	body := &CreateGenerationPolicyRequestBody{
+		Name:      p.Name,
+		Kind:      p.Kind,
+		Length:    p.Length,
+		Lowercase: p.Lowercase,
+		Uppercase: p.Uppercase,
+		Digits:    p.Digits,
+		Symbols:   p.Symbols,
+		Words:     p.Words,
+		Separator: p.Separator,
+		Encoding:  p.Encoding,
+		Bits:      p.Bits,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyRequestBody builds the HTTP request body from the
+// payload of the "update generation policy" endpoint of the "generation"
+// service.
+func NewUpdateGenerationPolicyRequestBody(p *generation.UpdateGenerationPolicyPayload) *UpdateGenerationPolicyRequestBody {
+	body := &UpdateGenerationPolicyRequestBody{
+		Name:      p.Name,
+		Kind:      p.Kind,
+		Length:    p.Length,
+		Lowercase: p.Lowercase,
+		Uppercase: p.Uppercase,
+		Digits:    p.Digits,
+		Symbols:   p.Symbols,
+		Words:     p.Words,
+		Separator: p.Separator,
+		Encoding:  p.Encoding,
+		Bits:      p.Bits,
+	}
+	return body
+}
+
+// NewListGenerationPoliciesGenerationPolicyOK builds a "generation" service
+// "list generation policies" endpoint result from a HTTP "OK" response.
+func NewListGenerationPoliciesGenerationPolicyOK(body []*GenerationPolicyResponse) []*generation.GenerationPolicy {
+	v := make([]*generation.GenerationPolicy, len(body))
+	for i, val := range body {
+		v[i] = unmarshalGenerationPolicyResponseToGenerationGenerationPolicy(val)
+	}
+
+	return v
+}
+
+// NewListGenerationPoliciesInternalError builds a generation service list
+// generation policies endpoint internal_error error.
+func NewListGenerationPoliciesInternalError(body *ListGenerationPoliciesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListGenerationPoliciesForbidden builds a generation service list
+// generation policies endpoint forbidden error.
+func NewListGenerationPoliciesForbidden(body *ListGenerationPoliciesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListGenerationPoliciesUnauthorized builds a generation service list
+// generation policies endpoint unauthorized error.
+func NewListGenerationPoliciesUnauthorized(body *ListGenerationPoliciesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyGenerationPolicyCreated builds a "generation"
+// service "create generation policy" endpoint result from a HTTP "Created"
+// response.
+func NewCreateGenerationPolicyGenerationPolicyCreated(body *CreateGenerationPolicyResponseBody) *generation.GenerationPolicy {
+	v := &generation.GenerationPolicy{
+		ID:        *body.ID,
+		Name:      *body.Name,
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		Kind:      *body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyGenerationPolicyTaken builds a generation service
+// create generation policy endpoint generation_policy_taken error.
+func NewCreateGenerationPolicyGenerationPolicyTaken(body *CreateGenerationPolicyGenerationPolicyTakenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyInvalidParameters builds a generation service
+// create generation policy endpoint invalid_parameters error.
+func NewCreateGenerationPolicyInvalidParameters(body *CreateGenerationPolicyInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyInternalError builds a generation service create
+// generation policy endpoint internal_error error.
+func NewCreateGenerationPolicyInternalError(body *CreateGenerationPolicyInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyForbidden builds a generation service create
+// generation policy endpoint forbidden error.
+func NewCreateGenerationPolicyForbidden(body *CreateGenerationPolicyForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateGenerationPolicyUnauthorized builds a generation service create
+// generation policy endpoint unauthorized error.
+func NewCreateGenerationPolicyUnauthorized(body *CreateGenerationPolicyUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyGenerationPolicyOK builds a "generation" service
+// "update generation policy" endpoint result from a HTTP "OK" response.
+func NewUpdateGenerationPolicyGenerationPolicyOK(body *UpdateGenerationPolicyResponseBody) *generation.GenerationPolicy {
+	v := &generation.GenerationPolicy{
+		ID:        *body.ID,
+		Name:      *body.Name,
+		CreatedAt: *body.CreatedAt,
+		UpdatedAt: *body.UpdatedAt,
+		Kind:      *body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyGenerationPolicyNotFound builds a generation
+// service update generation policy endpoint generation_policy_not_found error.
+func NewUpdateGenerationPolicyGenerationPolicyNotFound(body *UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyGenerationPolicyTaken builds a generation service
+// update generation policy endpoint generation_policy_taken error.
+func NewUpdateGenerationPolicyGenerationPolicyTaken(body *UpdateGenerationPolicyGenerationPolicyTakenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyInvalidParameters builds a generation service
+// update generation policy endpoint invalid_parameters error.
+func NewUpdateGenerationPolicyInvalidParameters(body *UpdateGenerationPolicyInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyInternalError builds a generation service update
+// generation policy endpoint internal_error error.
+func NewUpdateGenerationPolicyInternalError(body *UpdateGenerationPolicyInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyForbidden builds a generation service update
+// generation policy endpoint forbidden error.
+func NewUpdateGenerationPolicyForbidden(body *UpdateGenerationPolicyForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyUnauthorized builds a generation service update
+// generation policy endpoint unauthorized error.
+func NewUpdateGenerationPolicyUnauthorized(body *UpdateGenerationPolicyUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteGenerationPolicyGenerationPolicyNotFound builds a generation
+// service delete generation policy endpoint generation_policy_not_found error.
+func NewDeleteGenerationPolicyGenerationPolicyNotFound(body *DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteGenerationPolicyInternalError builds a generation service delete
+// generation policy endpoint internal_error error.
+func NewDeleteGenerationPolicyInternalError(body *DeleteGenerationPolicyInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteGenerationPolicyForbidden builds a generation service delete
+// generation policy endpoint forbidden error.
+func NewDeleteGenerationPolicyForbidden(body *DeleteGenerationPolicyForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteGenerationPolicyUnauthorized builds a generation service delete
+// generation policy endpoint unauthorized error.
+func NewDeleteGenerationPolicyUnauthorized(body *DeleteGenerationPolicyUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateCreateGenerationPolicyResponseBody runs the validations defined on
+// Create Generation PolicyResponseBody
+func ValidateCreateGenerationPolicyResponseBody(body *CreateGenerationPolicyResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "password" || *body.Kind == "passphrase" || *body.Kind == "bytes" || *body.Kind == "uuid" || *body.Kind == "rsa" || *body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+	}
+	if body.Encoding != nil {
+		if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+		}
+	}
+	if body.Bits != nil {
+		if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyResponseBody runs the validations defined on
+// Update Generation PolicyResponseBody
+func ValidateUpdateGenerationPolicyResponseBody(body *UpdateGenerationPolicyResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "password" || *body.Kind == "passphrase" || *body.Kind == "bytes" || *body.Kind == "uuid" || *body.Kind == "rsa" || *body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+	}
+	if body.Encoding != nil {
+		if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+		}
+	}
+	if body.Bits != nil {
+		if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+		}
+	}
+	return
+}
+
+// ValidateListGenerationPoliciesInternalErrorResponseBody runs the validations
+// defined on list generation policies_internal_error_response_body
+func ValidateListGenerationPoliciesInternalErrorResponseBody(body *ListGenerationPoliciesInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListGenerationPoliciesForbiddenResponseBody runs the validations
+// defined on list generation policies_forbidden_response_body
+func ValidateListGenerationPoliciesForbiddenResponseBody(body *ListGenerationPoliciesForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListGenerationPoliciesUnauthorizedResponseBody runs the validations
+// defined on list generation policies_unauthorized_response_body
+func ValidateListGenerationPoliciesUnauthorizedResponseBody(body *ListGenerationPoliciesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateGenerationPolicyGenerationPolicyTakenResponseBody runs the
+// validations defined on create generation
+// policy_generation_policy_taken_response_body
+func ValidateCreateGenerationPolicyGenerationPolicyTakenResponseBody(body *CreateGenerationPolicyGenerationPolicyTakenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateGenerationPolicyInvalidParametersResponseBody runs the
+// validations defined on create generation
+// policy_invalid_parameters_response_body
+func ValidateCreateGenerationPolicyInvalidParametersResponseBody(body *CreateGenerationPolicyInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateGenerationPolicyInternalErrorResponseBody runs the validations
+// defined on create generation policy_internal_error_response_body
+func ValidateCreateGenerationPolicyInternalErrorResponseBody(body *CreateGenerationPolicyInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateGenerationPolicyForbiddenResponseBody runs the validations
+// defined on create generation policy_forbidden_response_body
+func ValidateCreateGenerationPolicyForbiddenResponseBody(body *CreateGenerationPolicyForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateGenerationPolicyUnauthorizedResponseBody runs the validations
+// defined on create generation policy_unauthorized_response_body
+func ValidateCreateGenerationPolicyUnauthorizedResponseBody(body *CreateGenerationPolicyUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyGenerationPolicyNotFoundResponseBody runs the
+// validations defined on update generation
+// policy_generation_policy_not_found_response_body
+func ValidateUpdateGenerationPolicyGenerationPolicyNotFoundResponseBody(body *UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyGenerationPolicyTakenResponseBody runs the
+// validations defined on update generation
+// policy_generation_policy_taken_response_body
+func ValidateUpdateGenerationPolicyGenerationPolicyTakenResponseBody(body *UpdateGenerationPolicyGenerationPolicyTakenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyInvalidParametersResponseBody runs the
+// validations defined on update generation
+// policy_invalid_parameters_response_body
+func ValidateUpdateGenerationPolicyInvalidParametersResponseBody(body *UpdateGenerationPolicyInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyInternalErrorResponseBody runs the validations
+// defined on update generation policy_internal_error_response_body
+func ValidateUpdateGenerationPolicyInternalErrorResponseBody(body *UpdateGenerationPolicyInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyForbiddenResponseBody runs the validations
+// defined on update generation policy_forbidden_response_body
+func ValidateUpdateGenerationPolicyForbiddenResponseBody(body *UpdateGenerationPolicyForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyUnauthorizedResponseBody runs the validations
+// defined on update generation policy_unauthorized_response_body
+func ValidateUpdateGenerationPolicyUnauthorizedResponseBody(body *UpdateGenerationPolicyUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGenerationPolicyGenerationPolicyNotFoundResponseBody runs the
+// validations defined on delete generation
+// policy_generation_policy_not_found_response_body
+func ValidateDeleteGenerationPolicyGenerationPolicyNotFoundResponseBody(body *DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGenerationPolicyInternalErrorResponseBody runs the validations
+// defined on delete generation policy_internal_error_response_body
+func ValidateDeleteGenerationPolicyInternalErrorResponseBody(body *DeleteGenerationPolicyInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGenerationPolicyForbiddenResponseBody runs the validations
+// defined on delete generation policy_forbidden_response_body
+func ValidateDeleteGenerationPolicyForbiddenResponseBody(body *DeleteGenerationPolicyForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteGenerationPolicyUnauthorizedResponseBody runs the validations
+// defined on delete generation policy_unauthorized_response_body
+func ValidateDeleteGenerationPolicyUnauthorizedResponseBody(body *DeleteGenerationPolicyUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGenerationPolicyResponse runs the validations defined on
+// GenerationPolicyResponse
+func ValidateGenerationPolicyResponse(body *GenerationPolicyResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "password" || *body.Kind == "passphrase" || *body.Kind == "bytes" || *body.Kind == "uuid" || *body.Kind == "rsa" || *body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+	}
+	if body.Encoding != nil {
+		if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+		}
+	}
+	if body.Bits != nil {
+		if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+		}
+	}
+	return
+}
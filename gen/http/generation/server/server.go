@@ -0,0 +1,344 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation HTTP server
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	generation "github.com/Vidalee/FishyKeys/gen/generation"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/plugins/v3/cors"
+)
+
+// Server lists the generation service endpoint HTTP handlers.
+type Server struct {
+	Mounts                 []*MountPoint
+	ListGenerationPolicies http.Handler
+	CreateGenerationPolicy http.Handler
+	UpdateGenerationPolicy http.Handler
+	DeleteGenerationPolicy http.Handler
+	CORS                   http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the generation service endpoints
+// using the provided encoder and decoder. The handlers are mounted on the
+// given mux using the HTTP verb and path defined in the design. errhandler is
+// called whenever a response fails to be encoded. formatter is used to format
+// errors returned by the service methods prior to encoding. Both errhandler
+// and formatter are optional and can be nil.
+func New(
+	e *generation.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"ListGenerationPolicies", "GET", "/generation-policies"},
+			{"CreateGenerationPolicy", "POST", "/generation-policies"},
+			{"UpdateGenerationPolicy", "PUT", "/generation-policies/{id}"},
+			{"DeleteGenerationPolicy", "DELETE", "/generation-policies/{id}"},
+			{"CORS", "OPTIONS", "/generation-policies"},
+			{"CORS", "OPTIONS", "/generation-policies/{id}"},
+		},
+		ListGenerationPolicies: NewListGenerationPoliciesHandler(e.ListGenerationPolicies, mux, decoder, encoder, errhandler, formatter),
+		CreateGenerationPolicy: NewCreateGenerationPolicyHandler(e.CreateGenerationPolicy, mux, decoder, encoder, errhandler, formatter),
+		UpdateGenerationPolicy: NewUpdateGenerationPolicyHandler(e.UpdateGenerationPolicy, mux, decoder, encoder, errhandler, formatter),
+		DeleteGenerationPolicy: NewDeleteGenerationPolicyHandler(e.DeleteGenerationPolicy, mux, decoder, encoder, errhandler, formatter),
+		CORS:                   NewCORSHandler(),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "generation" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.ListGenerationPolicies = m(s.ListGenerationPolicies)
+	s.CreateGenerationPolicy = m(s.CreateGenerationPolicy)
+	s.UpdateGenerationPolicy = m(s.UpdateGenerationPolicy)
+	s.DeleteGenerationPolicy = m(s.DeleteGenerationPolicy)
+	s.CORS = m(s.CORS)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return generation.MethodNames[:] }
+
+// Mount configures the mux to serve the generation endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountListGenerationPoliciesHandler(mux, h.ListGenerationPolicies)
+	MountCreateGenerationPolicyHandler(mux, h.CreateGenerationPolicy)
+	MountUpdateGenerationPolicyHandler(mux, h.UpdateGenerationPolicy)
+	MountDeleteGenerationPolicyHandler(mux, h.DeleteGenerationPolicy)
+	MountCORSHandler(mux, h.CORS)
+}
+
+// Mount configures the mux to serve the generation endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountListGenerationPoliciesHandler configures the mux to serve the
+// "generation" service "list generation policies" endpoint.
+func MountListGenerationPoliciesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGenerationOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/generation-policies", f)
+}
+
+// NewListGenerationPoliciesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "generation" service "list generation policies"
+// endpoint.
+func NewListGenerationPoliciesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListGenerationPoliciesResponse(encoder)
+		encodeError    = EncodeListGenerationPoliciesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list generation policies")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "generation")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateGenerationPolicyHandler configures the mux to serve the
+// "generation" service "create generation policy" endpoint.
+func MountCreateGenerationPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGenerationOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/generation-policies", f)
+}
+
+// NewCreateGenerationPolicyHandler creates a HTTP handler which loads the HTTP
+// request and calls the "generation" service "create generation policy"
+// endpoint.
+func NewCreateGenerationPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateGenerationPolicyRequest(mux, decoder)
+		encodeResponse = EncodeCreateGenerationPolicyResponse(encoder)
+		encodeError    = EncodeCreateGenerationPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create generation policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "generation")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateGenerationPolicyHandler configures the mux to serve the
+// "generation" service "update generation policy" endpoint.
+func MountUpdateGenerationPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGenerationOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/generation-policies/{id}", f)
+}
+
+// NewUpdateGenerationPolicyHandler creates a HTTP handler which loads the HTTP
+// request and calls the "generation" service "update generation policy"
+// endpoint.
+func NewUpdateGenerationPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateGenerationPolicyRequest(mux, decoder)
+		encodeResponse = EncodeUpdateGenerationPolicyResponse(encoder)
+		encodeError    = EncodeUpdateGenerationPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update generation policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "generation")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteGenerationPolicyHandler configures the mux to serve the
+// "generation" service "delete generation policy" endpoint.
+func MountDeleteGenerationPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleGenerationOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/generation-policies/{id}", f)
+}
+
+// NewDeleteGenerationPolicyHandler creates a HTTP handler which loads the HTTP
+// request and calls the "generation" service "delete generation policy"
+// endpoint.
+func NewDeleteGenerationPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteGenerationPolicyRequest(mux, decoder)
+		encodeResponse = EncodeDeleteGenerationPolicyResponse(encoder)
+		encodeError    = EncodeDeleteGenerationPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete generation policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "generation")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCORSHandler configures the mux to serve the CORS endpoints for the
+// service generation.
+func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
+	h = HandleGenerationOrigin(h)
+	mux.Handle("OPTIONS", "/generation-policies", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/generation-policies/{id}", h.ServeHTTP)
+}
+
+// NewCORSHandler creates a HTTP handler which returns a simple 204 response.
+func NewCORSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	})
+}
+
+// HandleGenerationOrigin applies the CORS response headers corresponding to
+// the origin for the service generation.
+func HandleGenerationOrigin(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			h.ServeHTTP(w, r)
+			return
+		}
+		if cors.MatchOrigin(origin, "http://localhost:3000") {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Vary", "Origin")
+			w.Header().Set("Access-Control-Max-Age", "3600")
+			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.WriteHeader(204)
+				return
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+		return
+	})
+}
@@ -0,0 +1,954 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// generation HTTP server types
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"unicode/utf8"
+
+	generation "github.com/Vidalee/FishyKeys/gen/generation"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateGenerationPolicyRequestBody is the type of the "generation" service
+// "create generation policy" endpoint HTTP request body.
+type CreateGenerationPolicyRequestBody struct {
+	// Name of the generation policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Kind of value generated by the policy
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// UpdateGenerationPolicyRequestBody is the type of the "generation" service
+// "update generation policy" endpoint HTTP request body.
+type UpdateGenerationPolicyRequestBody struct {
+	// Name of the generation policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Kind of value generated by the policy
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// ListGenerationPoliciesResponseBody is the type of the "generation" service
+// "list generation policies" endpoint HTTP response body.
+type ListGenerationPoliciesResponseBody []*GenerationPolicyResponse
+
+// CreateGenerationPolicyResponseBody is the type of the "generation" service
+// "create generation policy" endpoint HTTP response body.
+type CreateGenerationPolicyResponseBody struct {
+	// Unique identifier for the generation policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the generation policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Generation policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Generation policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Kind of value generated by the policy
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// UpdateGenerationPolicyResponseBody is the type of the "generation" service
+// "update generation policy" endpoint HTTP response body.
+type UpdateGenerationPolicyResponseBody struct {
+	// Unique identifier for the generation policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the generation policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Generation policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Generation policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Kind of value generated by the policy
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// ListGenerationPoliciesInternalErrorResponseBody is the type of the
+// "generation" service "list generation policies" endpoint HTTP response body
+// for the "internal_error" error.
+type ListGenerationPoliciesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListGenerationPoliciesForbiddenResponseBody is the type of the "generation"
+// service "list generation policies" endpoint HTTP response body for the
+// "forbidden" error.
+type ListGenerationPoliciesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListGenerationPoliciesUnauthorizedResponseBody is the type of the
+// "generation" service "list generation policies" endpoint HTTP response body
+// for the "unauthorized" error.
+type ListGenerationPoliciesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateGenerationPolicyGenerationPolicyTakenResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "generation_policy_taken" error.
+type CreateGenerationPolicyGenerationPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateGenerationPolicyInvalidParametersResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type CreateGenerationPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type CreateGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "create generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "create generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type CreateGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody is the type of
+// the "generation" service "update generation policy" endpoint HTTP response
+// body for the "generation_policy_not_found" error.
+type UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyGenerationPolicyTakenResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "generation_policy_taken" error.
+type UpdateGenerationPolicyGenerationPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyInvalidParametersResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type UpdateGenerationPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type UpdateGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "update generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type UpdateGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "update generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type UpdateGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody is the type of
+// the "generation" service "delete generation policy" endpoint HTTP response
+// body for the "generation_policy_not_found" error.
+type DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteGenerationPolicyInternalErrorResponseBody is the type of the
+// "generation" service "delete generation policy" endpoint HTTP response body
+// for the "internal_error" error.
+type DeleteGenerationPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteGenerationPolicyForbiddenResponseBody is the type of the "generation"
+// service "delete generation policy" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteGenerationPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteGenerationPolicyUnauthorizedResponseBody is the type of the
+// "generation" service "delete generation policy" endpoint HTTP response body
+// for the "unauthorized" error.
+type DeleteGenerationPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GenerationPolicyResponse is used to define fields on response body types.
+type GenerationPolicyResponse struct {
+	// Unique identifier for the generation policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the generation policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Generation policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Generation policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Kind of value generated by the policy
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// Number of characters of a password, or number of random bytes
+	Length *int `form:"length,omitempty" json:"length,omitempty" xml:"length,omitempty"`
+	// Whether passwords contain lowercase letters
+	Lowercase *bool `form:"lowercase,omitempty" json:"lowercase,omitempty" xml:"lowercase,omitempty"`
+	// Whether passwords contain uppercase letters
+	Uppercase *bool `form:"uppercase,omitempty" json:"uppercase,omitempty" xml:"uppercase,omitempty"`
+	// Whether passwords contain digits
+	Digits *bool `form:"digits,omitempty" json:"digits,omitempty" xml:"digits,omitempty"`
+	// Whether passwords contain symbols
+	Symbols *bool `form:"symbols,omitempty" json:"symbols,omitempty" xml:"symbols,omitempty"`
+	// Number of words of a passphrase
+	Words *int `form:"words,omitempty" json:"words,omitempty" xml:"words,omitempty"`
+	// Separator between the words of a passphrase, defaults to '-'
+	Separator *string `form:"separator,omitempty" json:"separator,omitempty" xml:"separator,omitempty"`
+	// Encoding of random bytes
+	Encoding *string `form:"encoding,omitempty" json:"encoding,omitempty" xml:"encoding,omitempty"`
+	// Size of RSA keys
+	Bits *int `form:"bits,omitempty" json:"bits,omitempty" xml:"bits,omitempty"`
+}
+
+// NewListGenerationPoliciesResponseBody builds the HTTP response body from the
+// result of the "list generation policies" endpoint of the "generation"
+// service.
+func NewListGenerationPoliciesResponseBody(res []*generation.GenerationPolicy) ListGenerationPoliciesResponseBody {
+	body := make([]*GenerationPolicyResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalGenerationGenerationPolicyToGenerationPolicyResponse(val)
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyResponseBody builds the HTTP response body from the
+// result of the "create generation policy" endpoint of the "generation"
+// service.
+func NewCreateGenerationPolicyResponseBody(res *generation.GenerationPolicy) *CreateGenerationPolicyResponseBody {
+	body := &CreateGenerationPolicyResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		Kind:      res.Kind,
+		Length:    res.Length,
+		Lowercase: res.Lowercase,
+		Uppercase: res.Uppercase,
+		Digits:    res.Digits,
+		Symbols:   res.Symbols,
+		Words:     res.Words,
+		Separator: res.Separator,
+		Encoding:  res.Encoding,
+		Bits:      res.Bits,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyResponseBody builds the HTTP response body from the
+// result of the "update generation policy" endpoint of the "generation"
+// service.
+func NewUpdateGenerationPolicyResponseBody(res *generation.GenerationPolicy) *UpdateGenerationPolicyResponseBody {
+	body := &UpdateGenerationPolicyResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+		Kind:      res.Kind,
+		Length:    res.Length,
+		Lowercase: res.Lowercase,
+		Uppercase: res.Uppercase,
+		Digits:    res.Digits,
+		Symbols:   res.Symbols,
+		Words:     res.Words,
+		Separator: res.Separator,
+		Encoding:  res.Encoding,
+		Bits:      res.Bits,
+	}
+	return body
+}
+
+// NewListGenerationPoliciesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list generation policies" endpoint of the
+// "generation" service.
+func NewListGenerationPoliciesInternalErrorResponseBody(res *goa.ServiceError) *ListGenerationPoliciesInternalErrorResponseBody {
+	body := &ListGenerationPoliciesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListGenerationPoliciesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list generation policies" endpoint of the
+// "generation" service.
+func NewListGenerationPoliciesForbiddenResponseBody(res *goa.ServiceError) *ListGenerationPoliciesForbiddenResponseBody {
+	body := &ListGenerationPoliciesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListGenerationPoliciesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list generation policies" endpoint of the
+// "generation" service.
+func NewListGenerationPoliciesUnauthorizedResponseBody(res *goa.ServiceError) *ListGenerationPoliciesUnauthorizedResponseBody {
+	body := &ListGenerationPoliciesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyGenerationPolicyTakenResponseBody builds the HTTP
+// response body from the result of the "create generation policy" endpoint of
+// the "generation" service.
+func NewCreateGenerationPolicyGenerationPolicyTakenResponseBody(res *goa.ServiceError) *CreateGenerationPolicyGenerationPolicyTakenResponseBody {
+	body := &CreateGenerationPolicyGenerationPolicyTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "create generation policy" endpoint of
+// the "generation" service.
+func NewCreateGenerationPolicyInvalidParametersResponseBody(res *goa.ServiceError) *CreateGenerationPolicyInvalidParametersResponseBody {
+	body := &CreateGenerationPolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyInternalErrorResponseBody builds the HTTP response
+// body from the result of the "create generation policy" endpoint of the
+// "generation" service.
+func NewCreateGenerationPolicyInternalErrorResponseBody(res *goa.ServiceError) *CreateGenerationPolicyInternalErrorResponseBody {
+	body := &CreateGenerationPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyForbiddenResponseBody builds the HTTP response body
+// from the result of the "create generation policy" endpoint of the
+// "generation" service.
+func NewCreateGenerationPolicyForbiddenResponseBody(res *goa.ServiceError) *CreateGenerationPolicyForbiddenResponseBody {
+	body := &CreateGenerationPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "create generation policy" endpoint of the
+// "generation" service.
+func NewCreateGenerationPolicyUnauthorizedResponseBody(res *goa.ServiceError) *CreateGenerationPolicyUnauthorizedResponseBody {
+	body := &CreateGenerationPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyGenerationPolicyNotFoundResponseBody builds the
+// HTTP response body from the result of the "update generation policy"
+// endpoint of the "generation" service.
+func NewUpdateGenerationPolicyGenerationPolicyNotFoundResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody {
+	body := &UpdateGenerationPolicyGenerationPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyGenerationPolicyTakenResponseBody builds the HTTP
+// response body from the result of the "update generation policy" endpoint of
+// the "generation" service.
+func NewUpdateGenerationPolicyGenerationPolicyTakenResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyGenerationPolicyTakenResponseBody {
+	body := &UpdateGenerationPolicyGenerationPolicyTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "update generation policy" endpoint of
+// the "generation" service.
+func NewUpdateGenerationPolicyInvalidParametersResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyInvalidParametersResponseBody {
+	body := &UpdateGenerationPolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyInternalErrorResponseBody builds the HTTP response
+// body from the result of the "update generation policy" endpoint of the
+// "generation" service.
+func NewUpdateGenerationPolicyInternalErrorResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyInternalErrorResponseBody {
+	body := &UpdateGenerationPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyForbiddenResponseBody builds the HTTP response body
+// from the result of the "update generation policy" endpoint of the
+// "generation" service.
+func NewUpdateGenerationPolicyForbiddenResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyForbiddenResponseBody {
+	body := &UpdateGenerationPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateGenerationPolicyUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "update generation policy" endpoint of the
+// "generation" service.
+func NewUpdateGenerationPolicyUnauthorizedResponseBody(res *goa.ServiceError) *UpdateGenerationPolicyUnauthorizedResponseBody {
+	body := &UpdateGenerationPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteGenerationPolicyGenerationPolicyNotFoundResponseBody builds the
+// HTTP response body from the result of the "delete generation policy"
+// endpoint of the "generation" service.
+func NewDeleteGenerationPolicyGenerationPolicyNotFoundResponseBody(res *goa.ServiceError) *DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody {
+	body := &DeleteGenerationPolicyGenerationPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteGenerationPolicyInternalErrorResponseBody builds the HTTP response
+// body from the result of the "delete generation policy" endpoint of the
+// "generation" service.
+func NewDeleteGenerationPolicyInternalErrorResponseBody(res *goa.ServiceError) *DeleteGenerationPolicyInternalErrorResponseBody {
+	body := &DeleteGenerationPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteGenerationPolicyForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete generation policy" endpoint of the
+// "generation" service.
+func NewDeleteGenerationPolicyForbiddenResponseBody(res *goa.ServiceError) *DeleteGenerationPolicyForbiddenResponseBody {
+	body := &DeleteGenerationPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteGenerationPolicyUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "delete generation policy" endpoint of the
+// "generation" service.
+func NewDeleteGenerationPolicyUnauthorizedResponseBody(res *goa.ServiceError) *DeleteGenerationPolicyUnauthorizedResponseBody {
+	body := &DeleteGenerationPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateGenerationPolicyPayload builds a generation service create
+// generation policy endpoint payload.
+func NewCreateGenerationPolicyPayload(body *CreateGenerationPolicyRequestBody) *generation.CreateGenerationPolicyPayload {
+	v := &generation.CreateGenerationPolicyPayload{
+		Name:      *body.Name,
+		Kind:      *body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+
+	return v
+}
+
+// NewUpdateGenerationPolicyPayload builds a generation service update
+// generation policy endpoint payload.
+func NewUpdateGenerationPolicyPayload(body *UpdateGenerationPolicyRequestBody, id int) *generation.UpdateGenerationPolicyPayload {
+	v := &generation.UpdateGenerationPolicyPayload{
+		Name:      *body.Name,
+		Kind:      *body.Kind,
+		Length:    body.Length,
+		Lowercase: body.Lowercase,
+		Uppercase: body.Uppercase,
+		Digits:    body.Digits,
+		Symbols:   body.Symbols,
+		Words:     body.Words,
+		Separator: body.Separator,
+		Encoding:  body.Encoding,
+		Bits:      body.Bits,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewDeleteGenerationPolicyPayload builds a generation service delete
+// generation policy endpoint payload.
+func NewDeleteGenerationPolicyPayload(id int) *generation.DeleteGenerationPolicyPayload {
+	v := &generation.DeleteGenerationPolicyPayload{}
+	v.ID = id
+
+	return v
+}
+
+// ValidateCreateGenerationPolicyRequestBody runs the validations defined on
+// Create Generation PolicyRequestBody
+func ValidateCreateGenerationPolicyRequestBody(body *CreateGenerationPolicyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "password" || *body.Kind == "passphrase" || *body.Kind == "bytes" || *body.Kind == "uuid" || *body.Kind == "rsa" || *body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+	}
+	if body.Encoding != nil {
+		if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+		}
+	}
+	if body.Bits != nil {
+		if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateGenerationPolicyRequestBody runs the validations defined on
+// Update Generation PolicyRequestBody
+func ValidateUpdateGenerationPolicyRequestBody(body *UpdateGenerationPolicyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "password" || *body.Kind == "passphrase" || *body.Kind == "bytes" || *body.Kind == "uuid" || *body.Kind == "rsa" || *body.Kind == "ed25519") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"password", "passphrase", "bytes", "uuid", "rsa", "ed25519"}))
+		}
+	}
+	if body.Encoding != nil {
+		if !(*body.Encoding == "hex" || *body.Encoding == "base64") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.encoding", *body.Encoding, []any{"hex", "base64"}))
+		}
+	}
+	if body.Bits != nil {
+		if !(*body.Bits == 2048 || *body.Bits == 3072 || *body.Bits == 4096) {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.bits", *body.Bits, []any{2048, 3072, 4096}))
+		}
+	}
+	return
+}
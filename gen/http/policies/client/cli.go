@@ -0,0 +1,217 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	policies "github.com/Vidalee/FishyKeys/gen/policies"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildGetPolicyPayload builds the payload for the policies get policy
+// endpoint from CLI flags.
+func BuildGetPolicyPayload(policiesGetPolicyID string) (*policies.GetPolicyPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(policiesGetPolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &policies.GetPolicyPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCreatePolicyPayload builds the payload for the policies create policy
+// endpoint from CLI flags.
+func BuildCreatePolicyPayload(policiesCreatePolicyBody string) (*policies.CreatePolicyPayload, error) {
+	var err error
+	var body CreatePolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(policiesCreatePolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"document\": \"path \\\"/apps/*/db/*\\\" {\\n  capabilities = [\\\"read\\\", \\\"list\\\"]\\n}\\n\",\n      \"format\": \"hcl\",\n      \"name\": \"apps-db-readers\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if !(body.Format == "hcl" || body.Format == "yaml") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", body.Format, []any{"hcl", "yaml"}))
+		}
+		if utf8.RuneCountInString(body.Document) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.document", body.Document, utf8.RuneCountInString(body.Document), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &policies.CreatePolicyPayload{
+		Name:     body.Name,
+		Format:   body.Format,
+		Document: body.Document,
+	}
+
+	return v, nil
+}
+
+// BuildUpdatePolicyPayload builds the payload for the policies update policy
+// endpoint from CLI flags.
+func BuildUpdatePolicyPayload(policiesUpdatePolicyBody string, policiesUpdatePolicyID string) (*policies.UpdatePolicyPayload, error) {
+	var err error
+	var body UpdatePolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(policiesUpdatePolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"document\": \"path \\\"/apps/*/db/*\\\" {\\n  capabilities = [\\\"read\\\", \\\"list\\\"]\\n}\\n\",\n      \"format\": \"hcl\",\n      \"name\": \"apps-db-readers\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if !(body.Format == "hcl" || body.Format == "yaml") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", body.Format, []any{"hcl", "yaml"}))
+		}
+		if utf8.RuneCountInString(body.Document) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.document", body.Document, utf8.RuneCountInString(body.Document), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(policiesUpdatePolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &policies.UpdatePolicyPayload{
+		Name:     body.Name,
+		Format:   body.Format,
+		Document: body.Document,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeletePolicyPayload builds the payload for the policies delete policy
+// endpoint from CLI flags.
+func BuildDeletePolicyPayload(policiesDeletePolicyID string) (*policies.DeletePolicyPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(policiesDeletePolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &policies.DeletePolicyPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildAttachPolicyPayload builds the payload for the policies attach policy
+// endpoint from CLI flags.
+func BuildAttachPolicyPayload(policiesAttachPolicyBody string, policiesAttachPolicyID string) (*policies.AttachPolicyPayload, error) {
+	var err error
+	var body AttachPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(policiesAttachPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"role_id\": 1,\n      \"user_id\": 2\n   }'")
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(policiesAttachPolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &policies.AttachPolicyPayload{
+		UserID: body.UserID,
+		RoleID: body.RoleID,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDetachPolicyPayload builds the payload for the policies detach policy
+// endpoint from CLI flags.
+func BuildDetachPolicyPayload(policiesDetachPolicyBody string, policiesDetachPolicyID string) (*policies.DetachPolicyPayload, error) {
+	var err error
+	var body DetachPolicyRequestBody
+	{
+		err = json.Unmarshal([]byte(policiesDetachPolicyBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"role_id\": 1,\n      \"user_id\": 2\n   }'")
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(policiesDetachPolicyID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &policies.DetachPolicyPayload{
+		UserID: body.UserID,
+		RoleID: body.RoleID,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildExplainPayload builds the payload for the policies explain endpoint
+// from CLI flags.
+func BuildExplainPayload(policiesExplainBody string) (*policies.ExplainPayload, error) {
+	var err error
+	var body ExplainRequestBody
+	{
+		err = json.Unmarshal([]byte(policiesExplainBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"capability\": \"update\",\n      \"path\": \"L2FwcHMvcGF5bWVudHMvZGIvcGFzc3dvcmQ=\",\n      \"user_id\": 2\n   }'")
+		}
+		if utf8.RuneCountInString(body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", body.Path, utf8.RuneCountInString(body.Path), 2, true))
+		}
+		if !(body.Capability == "read" || body.Capability == "list" || body.Capability == "update" || body.Capability == "delete" || body.Capability == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capability", body.Capability, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &policies.ExplainPayload{
+		UserID:     body.UserID,
+		Path:       body.Path,
+		Capability: body.Capability,
+	}
+
+	return v, nil
+}
@@ -0,0 +1,267 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies client HTTP transport
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the policies service endpoint HTTP clients.
+type Client struct {
+	// ListPolicies Doer is the HTTP client used to make requests to the list
+	// policies endpoint.
+	ListPoliciesDoer goahttp.Doer
+
+	// GetPolicy Doer is the HTTP client used to make requests to the get policy
+	// endpoint.
+	GetPolicyDoer goahttp.Doer
+
+	// CreatePolicy Doer is the HTTP client used to make requests to the create
+	// policy endpoint.
+	CreatePolicyDoer goahttp.Doer
+
+	// UpdatePolicy Doer is the HTTP client used to make requests to the update
+	// policy endpoint.
+	UpdatePolicyDoer goahttp.Doer
+
+	// DeletePolicy Doer is the HTTP client used to make requests to the delete
+	// policy endpoint.
+	DeletePolicyDoer goahttp.Doer
+
+	// AttachPolicy Doer is the HTTP client used to make requests to the attach
+	// policy endpoint.
+	AttachPolicyDoer goahttp.Doer
+
+	// DetachPolicy Doer is the HTTP client used to make requests to the detach
+	// policy endpoint.
+	DetachPolicyDoer goahttp.Doer
+
+	// Explain Doer is the HTTP client used to make requests to the explain
+	// endpoint.
+	ExplainDoer goahttp.Doer
+
+	// CORS Doer is the HTTP client used to make requests to the  endpoint.
+	CORSDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the policies service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListPoliciesDoer:    doer,
+		GetPolicyDoer:       doer,
+		CreatePolicyDoer:    doer,
+		UpdatePolicyDoer:    doer,
+		DeletePolicyDoer:    doer,
+		AttachPolicyDoer:    doer,
+		DetachPolicyDoer:    doer,
+		ExplainDoer:         doer,
+		CORSDoer:            doer,
+		RestoreResponseBody: restoreBody,
+		scheme:              scheme,
+		host:                host,
+		decoder:             dec,
+		encoder:             enc,
+	}
+}
+
+// ListPolicies returns an endpoint that makes HTTP requests to the policies
+// service list policies server.
+func (c *Client) ListPolicies() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListPoliciesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListPoliciesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListPoliciesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "list policies", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetPolicy returns an endpoint that makes HTTP requests to the policies
+// service get policy server.
+func (c *Client) GetPolicy() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "get policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreatePolicy returns an endpoint that makes HTTP requests to the policies
+// service create policy server.
+func (c *Client) CreatePolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreatePolicyRequest(c.encoder)
+		decodeResponse = DecodeCreatePolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreatePolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreatePolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "create policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdatePolicy returns an endpoint that makes HTTP requests to the policies
+// service update policy server.
+func (c *Client) UpdatePolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdatePolicyRequest(c.encoder)
+		decodeResponse = DecodeUpdatePolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdatePolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdatePolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "update policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeletePolicy returns an endpoint that makes HTTP requests to the policies
+// service delete policy server.
+func (c *Client) DeletePolicy() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeletePolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeletePolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeletePolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "delete policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AttachPolicy returns an endpoint that makes HTTP requests to the policies
+// service attach policy server.
+func (c *Client) AttachPolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAttachPolicyRequest(c.encoder)
+		decodeResponse = DecodeAttachPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAttachPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AttachPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "attach policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DetachPolicy returns an endpoint that makes HTTP requests to the policies
+// service detach policy server.
+func (c *Client) DetachPolicy() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDetachPolicyRequest(c.encoder)
+		decodeResponse = DecodeDetachPolicyResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDetachPolicyRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DetachPolicyDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "detach policy", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Explain returns an endpoint that makes HTTP requests to the policies service
+// explain server.
+func (c *Client) Explain() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExplainRequest(c.encoder)
+		decodeResponse = DecodeExplainResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExplainRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExplainDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("policies", "explain", err)
+		}
+		return decodeResponse(resp)
+	}
+}
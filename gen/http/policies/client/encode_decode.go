@@ -0,0 +1,1183 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	policies "github.com/Vidalee/FishyKeys/gen/policies"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListPoliciesRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "list policies" endpoint
+func (c *Client) BuildListPoliciesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListPoliciesPoliciesPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "list policies", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListPoliciesResponse returns a decoder for responses returned by the
+// policies list policies endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListPoliciesResponse may return the following errors:
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListPoliciesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListPoliciesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "list policies", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidatePolicyResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "list policies", err)
+			}
+			res := NewListPoliciesPolicyOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ListPoliciesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "list policies", err)
+			}
+			err = ValidateListPoliciesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "list policies", err)
+			}
+			return nil, NewListPoliciesInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ListPoliciesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "list policies", err)
+			}
+			err = ValidateListPoliciesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "list policies", err)
+			}
+			return nil, NewListPoliciesForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListPoliciesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "list policies", err)
+			}
+			err = ValidateListPoliciesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "list policies", err)
+			}
+			return nil, NewListPoliciesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "list policies", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetPolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "get policy" endpoint
+func (c *Client) BuildGetPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*policies.GetPolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("policies", "get policy", "*policies.GetPolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetPolicyPoliciesPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "get policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetPolicyResponse returns a decoder for responses returned by the
+// policies get policy endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetPolicyResponse may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetPolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "get policy", err)
+			}
+			err = ValidateGetPolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "get policy", err)
+			}
+			res := NewGetPolicyPolicyOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetPolicyPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "get policy", err)
+			}
+			err = ValidateGetPolicyPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "get policy", err)
+			}
+			return nil, NewGetPolicyPolicyNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "get policy", err)
+			}
+			err = ValidateGetPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "get policy", err)
+			}
+			return nil, NewGetPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body GetPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "get policy", err)
+			}
+			err = ValidateGetPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "get policy", err)
+			}
+			return nil, NewGetPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "get policy", err)
+			}
+			err = ValidateGetPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "get policy", err)
+			}
+			return nil, NewGetPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "get policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreatePolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "create policy" endpoint
+func (c *Client) BuildCreatePolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreatePolicyPoliciesPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "create policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreatePolicyRequest returns an encoder for requests sent to the
+// policies create policy server.
+func EncodeCreatePolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*policies.CreatePolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("policies", "create policy", "*policies.CreatePolicyPayload", v)
+		}
+		body := NewCreatePolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("policies", "create policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreatePolicyResponse returns a decoder for responses returned by the
+// policies create policy endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreatePolicyResponse may return the following errors:
+//   - "policy_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreatePolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreatePolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			res := NewCreatePolicyPolicyCreated(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CreatePolicyPolicyTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyPolicyTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			return nil, NewCreatePolicyPolicyTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreatePolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			return nil, NewCreatePolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreatePolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			return nil, NewCreatePolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body CreatePolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			return nil, NewCreatePolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreatePolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "create policy", err)
+			}
+			err = ValidateCreatePolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "create policy", err)
+			}
+			return nil, NewCreatePolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "create policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdatePolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "update policy" endpoint
+func (c *Client) BuildUpdatePolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*policies.UpdatePolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("policies", "update policy", "*policies.UpdatePolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdatePolicyPoliciesPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "update policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdatePolicyRequest returns an encoder for requests sent to the
+// policies update policy server.
+func EncodeUpdatePolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*policies.UpdatePolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("policies", "update policy", "*policies.UpdatePolicyPayload", v)
+		}
+		body := NewUpdatePolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("policies", "update policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdatePolicyResponse returns a decoder for responses returned by the
+// policies update policy endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdatePolicyResponse may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "policy_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdatePolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdatePolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			res := NewUpdatePolicyPolicyOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body UpdatePolicyPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyPolicyNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdatePolicyPolicyTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyPolicyTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyPolicyTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdatePolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdatePolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdatePolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdatePolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "update policy", err)
+			}
+			err = ValidateUpdatePolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "update policy", err)
+			}
+			return nil, NewUpdatePolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "update policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeletePolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "delete policy" endpoint
+func (c *Client) BuildDeletePolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*policies.DeletePolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("policies", "delete policy", "*policies.DeletePolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeletePolicyPoliciesPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "delete policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeletePolicyResponse returns a decoder for responses returned by the
+// policies delete policy endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeletePolicyResponse may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeletePolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeletePolicyPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "delete policy", err)
+			}
+			err = ValidateDeletePolicyPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "delete policy", err)
+			}
+			return nil, NewDeletePolicyPolicyNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeletePolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "delete policy", err)
+			}
+			err = ValidateDeletePolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "delete policy", err)
+			}
+			return nil, NewDeletePolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body DeletePolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "delete policy", err)
+			}
+			err = ValidateDeletePolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "delete policy", err)
+			}
+			return nil, NewDeletePolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeletePolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "delete policy", err)
+			}
+			err = ValidateDeletePolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "delete policy", err)
+			}
+			return nil, NewDeletePolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "delete policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAttachPolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "attach policy" endpoint
+func (c *Client) BuildAttachPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*policies.AttachPolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("policies", "attach policy", "*policies.AttachPolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AttachPolicyPoliciesPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "attach policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAttachPolicyRequest returns an encoder for requests sent to the
+// policies attach policy server.
+func EncodeAttachPolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*policies.AttachPolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("policies", "attach policy", "*policies.AttachPolicyPayload", v)
+		}
+		body := NewAttachPolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("policies", "attach policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAttachPolicyResponse returns a decoder for responses returned by the
+// policies attach policy endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeAttachPolicyResponse may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAttachPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body AttachPolicyPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "attach policy", err)
+			}
+			err = ValidateAttachPolicyPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "attach policy", err)
+			}
+			return nil, NewAttachPolicyPolicyNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body AttachPolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "attach policy", err)
+			}
+			err = ValidateAttachPolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "attach policy", err)
+			}
+			return nil, NewAttachPolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body AttachPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "attach policy", err)
+			}
+			err = ValidateAttachPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "attach policy", err)
+			}
+			return nil, NewAttachPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body AttachPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "attach policy", err)
+			}
+			err = ValidateAttachPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "attach policy", err)
+			}
+			return nil, NewAttachPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AttachPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "attach policy", err)
+			}
+			err = ValidateAttachPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "attach policy", err)
+			}
+			return nil, NewAttachPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "attach policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDetachPolicyRequest instantiates a HTTP request object with method and
+// path set to call the "policies" service "detach policy" endpoint
+func (c *Client) BuildDetachPolicyRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*policies.DetachPolicyPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("policies", "detach policy", "*policies.DetachPolicyPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DetachPolicyPoliciesPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "detach policy", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDetachPolicyRequest returns an encoder for requests sent to the
+// policies detach policy server.
+func EncodeDetachPolicyRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*policies.DetachPolicyPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("policies", "detach policy", "*policies.DetachPolicyPayload", v)
+		}
+		body := NewDetachPolicyRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("policies", "detach policy", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDetachPolicyResponse returns a decoder for responses returned by the
+// policies detach policy endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDetachPolicyResponse may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDetachPolicyResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DetachPolicyPolicyNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "detach policy", err)
+			}
+			err = ValidateDetachPolicyPolicyNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "detach policy", err)
+			}
+			return nil, NewDetachPolicyPolicyNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body DetachPolicyInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "detach policy", err)
+			}
+			err = ValidateDetachPolicyInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "detach policy", err)
+			}
+			return nil, NewDetachPolicyInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DetachPolicyInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "detach policy", err)
+			}
+			err = ValidateDetachPolicyInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "detach policy", err)
+			}
+			return nil, NewDetachPolicyInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body DetachPolicyForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "detach policy", err)
+			}
+			err = ValidateDetachPolicyForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "detach policy", err)
+			}
+			return nil, NewDetachPolicyForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DetachPolicyUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "detach policy", err)
+			}
+			err = ValidateDetachPolicyUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "detach policy", err)
+			}
+			return nil, NewDetachPolicyUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "detach policy", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExplainRequest instantiates a HTTP request object with method and path
+// set to call the "policies" service "explain" endpoint
+func (c *Client) BuildExplainRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExplainPoliciesPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("policies", "explain", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExplainRequest returns an encoder for requests sent to the policies
+// explain server.
+func EncodeExplainRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*policies.ExplainPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("policies", "explain", "*policies.ExplainPayload", v)
+		}
+		body := NewExplainRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("policies", "explain", err)
+		}
+		return nil
+	}
+}
+
+// DecodeExplainResponse returns a decoder for responses returned by the
+// policies explain endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeExplainResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExplainResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ExplainResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "explain", err)
+			}
+			err = ValidateExplainResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "explain", err)
+			}
+			res := NewExplainResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ExplainInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "explain", err)
+			}
+			err = ValidateExplainInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "explain", err)
+			}
+			return nil, NewExplainInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ExplainInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "explain", err)
+			}
+			err = ValidateExplainInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "explain", err)
+			}
+			return nil, NewExplainInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ExplainForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "explain", err)
+			}
+			err = ValidateExplainForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "explain", err)
+			}
+			return nil, NewExplainForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ExplainUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("policies", "explain", err)
+			}
+			err = ValidateExplainUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("policies", "explain", err)
+			}
+			return nil, NewExplainUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("policies", "explain", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalPolicyResponseToPoliciesPolicy builds a value of type
+// *policies.Policy from a value of type *PolicyResponse.
+func unmarshalPolicyResponseToPoliciesPolicy(v *PolicyResponse) *policies.Policy {
+	res := &policies.Policy{
+		ID:        *v.ID,
+		Name:      *v.Name,
+		Format:    *v.Format,
+		Document:  *v.Document,
+		CreatedAt: *v.CreatedAt,
+		UpdatedAt: *v.UpdatedAt,
+	}
+	res.Rules = make([]*policies.PolicyRule, len(v.Rules))
+	for i, val := range v.Rules {
+		res.Rules[i] = unmarshalPolicyRuleResponseToPoliciesPolicyRule(val)
+	}
+	res.Users = make([]int, len(v.Users))
+	for i, val := range v.Users {
+		res.Users[i] = val
+	}
+	res.Roles = make([]int, len(v.Roles))
+	for i, val := range v.Roles {
+		res.Roles[i] = val
+	}
+
+	return res
+}
+
+// unmarshalPolicyRuleResponseToPoliciesPolicyRule builds a value of type
+// *policies.PolicyRule from a value of type *PolicyRuleResponse.
+func unmarshalPolicyRuleResponseToPoliciesPolicyRule(v *PolicyRuleResponse) *policies.PolicyRule {
+	res := &policies.PolicyRule{
+		Path:   *v.Path,
+		Effect: *v.Effect,
+	}
+	res.Capabilities = make([]string, len(v.Capabilities))
+	for i, val := range v.Capabilities {
+		res.Capabilities[i] = val
+	}
+
+	return res
+}
+
+// unmarshalPolicyRuleResponseBodyToPoliciesPolicyRule builds a value of type
+// *policies.PolicyRule from a value of type *PolicyRuleResponseBody.
+func unmarshalPolicyRuleResponseBodyToPoliciesPolicyRule(v *PolicyRuleResponseBody) *policies.PolicyRule {
+	res := &policies.PolicyRule{
+		Path:   *v.Path,
+		Effect: *v.Effect,
+	}
+	res.Capabilities = make([]string, len(v.Capabilities))
+	for i, val := range v.Capabilities {
+		res.Capabilities[i] = val
+	}
+
+	return res
+}
+
+// unmarshalExplainMatchResponseBodyToPoliciesExplainMatch builds a value of
+// type *policies.ExplainMatch from a value of type *ExplainMatchResponseBody.
+func unmarshalExplainMatchResponseBodyToPoliciesExplainMatch(v *ExplainMatchResponseBody) *policies.ExplainMatch {
+	res := &policies.ExplainMatch{
+		Source: *v.Source,
+		Effect: *v.Effect,
+		Path:   *v.Path,
+		Policy: v.Policy,
+	}
+
+	return res
+}
@@ -0,0 +1,52 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the policies service.
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"fmt"
+)
+
+// ListPoliciesPoliciesPath returns the URL path to the policies service list policies HTTP endpoint.
+func ListPoliciesPoliciesPath() string {
+	return "/policies"
+}
+
+// GetPolicyPoliciesPath returns the URL path to the policies service get policy HTTP endpoint.
+func GetPolicyPoliciesPath(id int) string {
+	return fmt.Sprintf("/policies/%v", id)
+}
+
+// CreatePolicyPoliciesPath returns the URL path to the policies service create policy HTTP endpoint.
+func CreatePolicyPoliciesPath() string {
+	return "/policies"
+}
+
+// UpdatePolicyPoliciesPath returns the URL path to the policies service update policy HTTP endpoint.
+func UpdatePolicyPoliciesPath(id int) string {
+	return fmt.Sprintf("/policies/%v", id)
+}
+
+// DeletePolicyPoliciesPath returns the URL path to the policies service delete policy HTTP endpoint.
+func DeletePolicyPoliciesPath(id int) string {
+	return fmt.Sprintf("/policies/%v", id)
+}
+
+// AttachPolicyPoliciesPath returns the URL path to the policies service attach policy HTTP endpoint.
+func AttachPolicyPoliciesPath(id int) string {
+	return fmt.Sprintf("/policies/%v/attach", id)
+}
+
+// DetachPolicyPoliciesPath returns the URL path to the policies service detach policy HTTP endpoint.
+func DetachPolicyPoliciesPath(id int) string {
+	return fmt.Sprintf("/policies/%v/detach", id)
+}
+
+// ExplainPoliciesPath returns the URL path to the policies service explain HTTP endpoint.
+func ExplainPoliciesPath() string {
+	return "/policies/explain"
+}
@@ -0,0 +1,570 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies HTTP server
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	policies "github.com/Vidalee/FishyKeys/gen/policies"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/plugins/v3/cors"
+)
+
+// Server lists the policies service endpoint HTTP handlers.
+type Server struct {
+	Mounts       []*MountPoint
+	ListPolicies http.Handler
+	GetPolicy    http.Handler
+	CreatePolicy http.Handler
+	UpdatePolicy http.Handler
+	DeletePolicy http.Handler
+	AttachPolicy http.Handler
+	DetachPolicy http.Handler
+	Explain      http.Handler
+	CORS         http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the policies service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *policies.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"ListPolicies", "GET", "/policies"},
+			{"GetPolicy", "GET", "/policies/{id}"},
+			{"CreatePolicy", "POST", "/policies"},
+			{"UpdatePolicy", "PUT", "/policies/{id}"},
+			{"DeletePolicy", "DELETE", "/policies/{id}"},
+			{"AttachPolicy", "POST", "/policies/{id}/attach"},
+			{"DetachPolicy", "POST", "/policies/{id}/detach"},
+			{"Explain", "POST", "/policies/explain"},
+			{"CORS", "OPTIONS", "/policies"},
+			{"CORS", "OPTIONS", "/policies/{id}"},
+			{"CORS", "OPTIONS", "/policies/{id}/attach"},
+			{"CORS", "OPTIONS", "/policies/{id}/detach"},
+			{"CORS", "OPTIONS", "/policies/explain"},
+		},
+		ListPolicies: NewListPoliciesHandler(e.ListPolicies, mux, decoder, encoder, errhandler, formatter),
+		GetPolicy:    NewGetPolicyHandler(e.GetPolicy, mux, decoder, encoder, errhandler, formatter),
+		CreatePolicy: NewCreatePolicyHandler(e.CreatePolicy, mux, decoder, encoder, errhandler, formatter),
+		UpdatePolicy: NewUpdatePolicyHandler(e.UpdatePolicy, mux, decoder, encoder, errhandler, formatter),
+		DeletePolicy: NewDeletePolicyHandler(e.DeletePolicy, mux, decoder, encoder, errhandler, formatter),
+		AttachPolicy: NewAttachPolicyHandler(e.AttachPolicy, mux, decoder, encoder, errhandler, formatter),
+		DetachPolicy: NewDetachPolicyHandler(e.DetachPolicy, mux, decoder, encoder, errhandler, formatter),
+		Explain:      NewExplainHandler(e.Explain, mux, decoder, encoder, errhandler, formatter),
+		CORS:         NewCORSHandler(),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "policies" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.ListPolicies = m(s.ListPolicies)
+	s.GetPolicy = m(s.GetPolicy)
+	s.CreatePolicy = m(s.CreatePolicy)
+	s.UpdatePolicy = m(s.UpdatePolicy)
+	s.DeletePolicy = m(s.DeletePolicy)
+	s.AttachPolicy = m(s.AttachPolicy)
+	s.DetachPolicy = m(s.DetachPolicy)
+	s.Explain = m(s.Explain)
+	s.CORS = m(s.CORS)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return policies.MethodNames[:] }
+
+// Mount configures the mux to serve the policies endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountListPoliciesHandler(mux, h.ListPolicies)
+	MountGetPolicyHandler(mux, h.GetPolicy)
+	MountCreatePolicyHandler(mux, h.CreatePolicy)
+	MountUpdatePolicyHandler(mux, h.UpdatePolicy)
+	MountDeletePolicyHandler(mux, h.DeletePolicy)
+	MountAttachPolicyHandler(mux, h.AttachPolicy)
+	MountDetachPolicyHandler(mux, h.DetachPolicy)
+	MountExplainHandler(mux, h.Explain)
+	MountCORSHandler(mux, h.CORS)
+}
+
+// Mount configures the mux to serve the policies endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountListPoliciesHandler configures the mux to serve the "policies" service
+// "list policies" endpoint.
+func MountListPoliciesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/policies", f)
+}
+
+// NewListPoliciesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "list policies" endpoint.
+func NewListPoliciesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListPoliciesResponse(encoder)
+		encodeError    = EncodeListPoliciesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list policies")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetPolicyHandler configures the mux to serve the "policies" service
+// "get policy" endpoint.
+func MountGetPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/policies/{id}", f)
+}
+
+// NewGetPolicyHandler creates a HTTP handler which loads the HTTP request and
+// calls the "policies" service "get policy" endpoint.
+func NewGetPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetPolicyRequest(mux, decoder)
+		encodeResponse = EncodeGetPolicyResponse(encoder)
+		encodeError    = EncodeGetPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreatePolicyHandler configures the mux to serve the "policies" service
+// "create policy" endpoint.
+func MountCreatePolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/policies", f)
+}
+
+// NewCreatePolicyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "create policy" endpoint.
+func NewCreatePolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreatePolicyRequest(mux, decoder)
+		encodeResponse = EncodeCreatePolicyResponse(encoder)
+		encodeError    = EncodeCreatePolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdatePolicyHandler configures the mux to serve the "policies" service
+// "update policy" endpoint.
+func MountUpdatePolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/policies/{id}", f)
+}
+
+// NewUpdatePolicyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "update policy" endpoint.
+func NewUpdatePolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdatePolicyRequest(mux, decoder)
+		encodeResponse = EncodeUpdatePolicyResponse(encoder)
+		encodeError    = EncodeUpdatePolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeletePolicyHandler configures the mux to serve the "policies" service
+// "delete policy" endpoint.
+func MountDeletePolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/policies/{id}", f)
+}
+
+// NewDeletePolicyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "delete policy" endpoint.
+func NewDeletePolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeletePolicyRequest(mux, decoder)
+		encodeResponse = EncodeDeletePolicyResponse(encoder)
+		encodeError    = EncodeDeletePolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountAttachPolicyHandler configures the mux to serve the "policies" service
+// "attach policy" endpoint.
+func MountAttachPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/policies/{id}/attach", f)
+}
+
+// NewAttachPolicyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "attach policy" endpoint.
+func NewAttachPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAttachPolicyRequest(mux, decoder)
+		encodeResponse = EncodeAttachPolicyResponse(encoder)
+		encodeError    = EncodeAttachPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "attach policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDetachPolicyHandler configures the mux to serve the "policies" service
+// "detach policy" endpoint.
+func MountDetachPolicyHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/policies/{id}/detach", f)
+}
+
+// NewDetachPolicyHandler creates a HTTP handler which loads the HTTP request
+// and calls the "policies" service "detach policy" endpoint.
+func NewDetachPolicyHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDetachPolicyRequest(mux, decoder)
+		encodeResponse = EncodeDetachPolicyResponse(encoder)
+		encodeError    = EncodeDetachPolicyError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "detach policy")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountExplainHandler configures the mux to serve the "policies" service
+// "explain" endpoint.
+func MountExplainHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandlePoliciesOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/policies/explain", f)
+}
+
+// NewExplainHandler creates a HTTP handler which loads the HTTP request and
+// calls the "policies" service "explain" endpoint.
+func NewExplainHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExplainRequest(mux, decoder)
+		encodeResponse = EncodeExplainResponse(encoder)
+		encodeError    = EncodeExplainError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "explain")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "policies")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCORSHandler configures the mux to serve the CORS endpoints for the
+// service policies.
+func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
+	h = HandlePoliciesOrigin(h)
+	mux.Handle("OPTIONS", "/policies", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/policies/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/policies/{id}/attach", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/policies/{id}/detach", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/policies/explain", h.ServeHTTP)
+}
+
+// NewCORSHandler creates a HTTP handler which returns a simple 204 response.
+func NewCORSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	})
+}
+
+// HandlePoliciesOrigin applies the CORS response headers corresponding to the
+// origin for the service policies.
+func HandlePoliciesOrigin(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			h.ServeHTTP(w, r)
+			return
+		}
+		if cors.MatchOrigin(origin, "http://localhost:3000") {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Vary", "Origin")
+			w.Header().Set("Access-Control-Max-Age", "3600")
+			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.WriteHeader(204)
+				return
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+		return
+	})
+}
@@ -0,0 +1,1663 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies HTTP server types
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"unicode/utf8"
+
+	policies "github.com/Vidalee/FishyKeys/gen/policies"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreatePolicyRequestBody is the type of the "policies" service "create
+// policy" endpoint HTTP request body.
+type CreatePolicyRequestBody struct {
+	// Name of the policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Format of the policy document
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// The policy document
+	Document *string `form:"document,omitempty" json:"document,omitempty" xml:"document,omitempty"`
+}
+
+// UpdatePolicyRequestBody is the type of the "policies" service "update
+// policy" endpoint HTTP request body.
+type UpdatePolicyRequestBody struct {
+	// Name of the policy
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Format of the policy document
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// The policy document
+	Document *string `form:"document,omitempty" json:"document,omitempty" xml:"document,omitempty"`
+}
+
+// AttachPolicyRequestBody is the type of the "policies" service "attach
+// policy" endpoint HTTP request body.
+type AttachPolicyRequestBody struct {
+	// ID of the user to attach the policy to
+	UserID *int `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// ID of the role to attach the policy to
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+}
+
+// DetachPolicyRequestBody is the type of the "policies" service "detach
+// policy" endpoint HTTP request body.
+type DetachPolicyRequestBody struct {
+	// ID of the user to detach the policy from
+	UserID *int `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// ID of the role to detach the policy from
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+}
+
+// ExplainRequestBody is the type of the "policies" service "explain" endpoint
+// HTTP request body.
+type ExplainRequestBody struct {
+	// ID of the user performing the request
+	UserID *int `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Base64 encoded secret's path, the secret does not need to exist
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The capability to check
+	Capability *string `form:"capability,omitempty" json:"capability,omitempty" xml:"capability,omitempty"`
+}
+
+// ListPoliciesResponseBody is the type of the "policies" service "list
+// policies" endpoint HTTP response body.
+type ListPoliciesResponseBody []*PolicyResponse
+
+// GetPolicyResponseBody is the type of the "policies" service "get policy"
+// endpoint HTTP response body.
+type GetPolicyResponseBody struct {
+	// Unique identifier for the policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Format of the policy document
+	Format string `form:"format" json:"format" xml:"format"`
+	// The policy document
+	Document string `form:"document" json:"document" xml:"document"`
+	// Rules parsed from the policy document
+	Rules []*PolicyRuleResponseBody `form:"rules" json:"rules" xml:"rules"`
+	// IDs of the users the policy is attached to
+	Users []int `form:"users" json:"users" xml:"users"`
+	// IDs of the roles the policy is attached to
+	Roles []int `form:"roles" json:"roles" xml:"roles"`
+	// Policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// CreatePolicyResponseBody is the type of the "policies" service "create
+// policy" endpoint HTTP response body.
+type CreatePolicyResponseBody struct {
+	// Unique identifier for the policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Format of the policy document
+	Format string `form:"format" json:"format" xml:"format"`
+	// The policy document
+	Document string `form:"document" json:"document" xml:"document"`
+	// Rules parsed from the policy document
+	Rules []*PolicyRuleResponseBody `form:"rules" json:"rules" xml:"rules"`
+	// IDs of the users the policy is attached to
+	Users []int `form:"users" json:"users" xml:"users"`
+	// IDs of the roles the policy is attached to
+	Roles []int `form:"roles" json:"roles" xml:"roles"`
+	// Policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// UpdatePolicyResponseBody is the type of the "policies" service "update
+// policy" endpoint HTTP response body.
+type UpdatePolicyResponseBody struct {
+	// Unique identifier for the policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Format of the policy document
+	Format string `form:"format" json:"format" xml:"format"`
+	// The policy document
+	Document string `form:"document" json:"document" xml:"document"`
+	// Rules parsed from the policy document
+	Rules []*PolicyRuleResponseBody `form:"rules" json:"rules" xml:"rules"`
+	// IDs of the users the policy is attached to
+	Users []int `form:"users" json:"users" xml:"users"`
+	// IDs of the roles the policy is attached to
+	Roles []int `form:"roles" json:"roles" xml:"roles"`
+	// Policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// ExplainResponseBody is the type of the "policies" service "explain" endpoint
+// HTTP response body.
+type ExplainResponseBody struct {
+	// Whether the request would be allowed
+	Allowed bool `form:"allowed" json:"allowed" xml:"allowed"`
+	// Explanation of the decision
+	Decision string `form:"decision" json:"decision" xml:"decision"`
+	// Every grant and rule giving or denying the capability on the path
+	Matches []*ExplainMatchResponseBody `form:"matches" json:"matches" xml:"matches"`
+}
+
+// ListPoliciesInternalErrorResponseBody is the type of the "policies" service
+// "list policies" endpoint HTTP response body for the "internal_error" error.
+type ListPoliciesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListPoliciesForbiddenResponseBody is the type of the "policies" service
+// "list policies" endpoint HTTP response body for the "forbidden" error.
+type ListPoliciesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListPoliciesUnauthorizedResponseBody is the type of the "policies" service
+// "list policies" endpoint HTTP response body for the "unauthorized" error.
+type ListPoliciesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPolicyPolicyNotFoundResponseBody is the type of the "policies" service
+// "get policy" endpoint HTTP response body for the "policy_not_found" error.
+type GetPolicyPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPolicyInternalErrorResponseBody is the type of the "policies" service
+// "get policy" endpoint HTTP response body for the "internal_error" error.
+type GetPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPolicyForbiddenResponseBody is the type of the "policies" service "get
+// policy" endpoint HTTP response body for the "forbidden" error.
+type GetPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetPolicyUnauthorizedResponseBody is the type of the "policies" service "get
+// policy" endpoint HTTP response body for the "unauthorized" error.
+type GetPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatePolicyPolicyTakenResponseBody is the type of the "policies" service
+// "create policy" endpoint HTTP response body for the "policy_taken" error.
+type CreatePolicyPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatePolicyInvalidParametersResponseBody is the type of the "policies"
+// service "create policy" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type CreatePolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatePolicyInternalErrorResponseBody is the type of the "policies" service
+// "create policy" endpoint HTTP response body for the "internal_error" error.
+type CreatePolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatePolicyForbiddenResponseBody is the type of the "policies" service
+// "create policy" endpoint HTTP response body for the "forbidden" error.
+type CreatePolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreatePolicyUnauthorizedResponseBody is the type of the "policies" service
+// "create policy" endpoint HTTP response body for the "unauthorized" error.
+type CreatePolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyPolicyNotFoundResponseBody is the type of the "policies" service
+// "update policy" endpoint HTTP response body for the "policy_not_found" error.
+type UpdatePolicyPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyPolicyTakenResponseBody is the type of the "policies" service
+// "update policy" endpoint HTTP response body for the "policy_taken" error.
+type UpdatePolicyPolicyTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyInvalidParametersResponseBody is the type of the "policies"
+// service "update policy" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UpdatePolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyInternalErrorResponseBody is the type of the "policies" service
+// "update policy" endpoint HTTP response body for the "internal_error" error.
+type UpdatePolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyForbiddenResponseBody is the type of the "policies" service
+// "update policy" endpoint HTTP response body for the "forbidden" error.
+type UpdatePolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdatePolicyUnauthorizedResponseBody is the type of the "policies" service
+// "update policy" endpoint HTTP response body for the "unauthorized" error.
+type UpdatePolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeletePolicyPolicyNotFoundResponseBody is the type of the "policies" service
+// "delete policy" endpoint HTTP response body for the "policy_not_found" error.
+type DeletePolicyPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeletePolicyInternalErrorResponseBody is the type of the "policies" service
+// "delete policy" endpoint HTTP response body for the "internal_error" error.
+type DeletePolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeletePolicyForbiddenResponseBody is the type of the "policies" service
+// "delete policy" endpoint HTTP response body for the "forbidden" error.
+type DeletePolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeletePolicyUnauthorizedResponseBody is the type of the "policies" service
+// "delete policy" endpoint HTTP response body for the "unauthorized" error.
+type DeletePolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AttachPolicyPolicyNotFoundResponseBody is the type of the "policies" service
+// "attach policy" endpoint HTTP response body for the "policy_not_found" error.
+type AttachPolicyPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AttachPolicyInvalidParametersResponseBody is the type of the "policies"
+// service "attach policy" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type AttachPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AttachPolicyInternalErrorResponseBody is the type of the "policies" service
+// "attach policy" endpoint HTTP response body for the "internal_error" error.
+type AttachPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AttachPolicyForbiddenResponseBody is the type of the "policies" service
+// "attach policy" endpoint HTTP response body for the "forbidden" error.
+type AttachPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// AttachPolicyUnauthorizedResponseBody is the type of the "policies" service
+// "attach policy" endpoint HTTP response body for the "unauthorized" error.
+type AttachPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DetachPolicyPolicyNotFoundResponseBody is the type of the "policies" service
+// "detach policy" endpoint HTTP response body for the "policy_not_found" error.
+type DetachPolicyPolicyNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DetachPolicyInvalidParametersResponseBody is the type of the "policies"
+// service "detach policy" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DetachPolicyInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DetachPolicyInternalErrorResponseBody is the type of the "policies" service
+// "detach policy" endpoint HTTP response body for the "internal_error" error.
+type DetachPolicyInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DetachPolicyForbiddenResponseBody is the type of the "policies" service
+// "detach policy" endpoint HTTP response body for the "forbidden" error.
+type DetachPolicyForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DetachPolicyUnauthorizedResponseBody is the type of the "policies" service
+// "detach policy" endpoint HTTP response body for the "unauthorized" error.
+type DetachPolicyUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExplainInvalidParametersResponseBody is the type of the "policies" service
+// "explain" endpoint HTTP response body for the "invalid_parameters" error.
+type ExplainInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExplainInternalErrorResponseBody is the type of the "policies" service
+// "explain" endpoint HTTP response body for the "internal_error" error.
+type ExplainInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExplainForbiddenResponseBody is the type of the "policies" service "explain"
+// endpoint HTTP response body for the "forbidden" error.
+type ExplainForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExplainUnauthorizedResponseBody is the type of the "policies" service
+// "explain" endpoint HTTP response body for the "unauthorized" error.
+type ExplainUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PolicyResponse is used to define fields on response body types.
+type PolicyResponse struct {
+	// Unique identifier for the policy
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the policy
+	Name string `form:"name" json:"name" xml:"name"`
+	// Format of the policy document
+	Format string `form:"format" json:"format" xml:"format"`
+	// The policy document
+	Document string `form:"document" json:"document" xml:"document"`
+	// Rules parsed from the policy document
+	Rules []*PolicyRuleResponse `form:"rules" json:"rules" xml:"rules"`
+	// IDs of the users the policy is attached to
+	Users []int `form:"users" json:"users" xml:"users"`
+	// IDs of the roles the policy is attached to
+	Roles []int `form:"roles" json:"roles" xml:"roles"`
+	// Policy creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Policy last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// PolicyRuleResponse is used to define fields on response body types.
+type PolicyRuleResponse struct {
+	// Glob of the secret paths the rule applies to
+	Path string `form:"path" json:"path" xml:"path"`
+	// Whether the rule allows or denies the capabilities
+	Effect string `form:"effect" json:"effect" xml:"effect"`
+	// Capabilities allowed or denied by the rule
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+}
+
+// PolicyRuleResponseBody is used to define fields on response body types.
+type PolicyRuleResponseBody struct {
+	// Glob of the secret paths the rule applies to
+	Path string `form:"path" json:"path" xml:"path"`
+	// Whether the rule allows or denies the capabilities
+	Effect string `form:"effect" json:"effect" xml:"effect"`
+	// Capabilities allowed or denied by the rule
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+}
+
+// ExplainMatchResponseBody is used to define fields on response body types.
+type ExplainMatchResponseBody struct {
+	// What the match comes from
+	Source string `form:"source" json:"source" xml:"source"`
+	// Whether the match allows or denies the capability
+	Effect string `form:"effect" json:"effect" xml:"effect"`
+	// The secret path, folder prefix or policy path glob that matched
+	Path string `form:"path" json:"path" xml:"path"`
+	// Name of the policy the rule belongs to, for policy matches
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// NewListPoliciesResponseBody builds the HTTP response body from the result of
+// the "list policies" endpoint of the "policies" service.
+func NewListPoliciesResponseBody(res []*policies.Policy) ListPoliciesResponseBody {
+	body := make([]*PolicyResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalPoliciesPolicyToPolicyResponse(val)
+	}
+	return body
+}
+
+// NewGetPolicyResponseBody builds the HTTP response body from the result of
+// the "get policy" endpoint of the "policies" service.
+func NewGetPolicyResponseBody(res *policies.Policy) *GetPolicyResponseBody {
+	body := &GetPolicyResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		Format:    res.Format,
+		Document:  res.Document,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}
+	if res.Rules != nil {
+		body.Rules = make([]*PolicyRuleResponseBody, len(res.Rules))
+		for i, val := range res.Rules {
+			body.Rules[i] = marshalPoliciesPolicyRuleToPolicyRuleResponseBody(val)
+		}
+	} else {
+		body.Rules = []*PolicyRuleResponseBody{}
+	}
+	if res.Users != nil {
+		body.Users = make([]int, len(res.Users))
+		for i, val := range res.Users {
+			body.Users[i] = val
+		}
+	} else {
+		body.Users = []int{}
+	}
+	if res.Roles != nil {
+		body.Roles = make([]int, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []int{}
+	}
+	return body
+}
+
+// NewCreatePolicyResponseBody builds the HTTP response body from the result of
+// the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyResponseBody(res *policies.Policy) *CreatePolicyResponseBody {
+	body := &CreatePolicyResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		Format:    res.Format,
+		Document:  res.Document,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}
+	if res.Rules != nil {
+		body.Rules = make([]*PolicyRuleResponseBody, len(res.Rules))
+		for i, val := range res.Rules {
+			body.Rules[i] = marshalPoliciesPolicyRuleToPolicyRuleResponseBody(val)
+		}
+	} else {
+		body.Rules = []*PolicyRuleResponseBody{}
+	}
+	if res.Users != nil {
+		body.Users = make([]int, len(res.Users))
+		for i, val := range res.Users {
+			body.Users[i] = val
+		}
+	} else {
+		body.Users = []int{}
+	}
+	if res.Roles != nil {
+		body.Roles = make([]int, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []int{}
+	}
+	return body
+}
+
+// NewUpdatePolicyResponseBody builds the HTTP response body from the result of
+// the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyResponseBody(res *policies.Policy) *UpdatePolicyResponseBody {
+	body := &UpdatePolicyResponseBody{
+		ID:        res.ID,
+		Name:      res.Name,
+		Format:    res.Format,
+		Document:  res.Document,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}
+	if res.Rules != nil {
+		body.Rules = make([]*PolicyRuleResponseBody, len(res.Rules))
+		for i, val := range res.Rules {
+			body.Rules[i] = marshalPoliciesPolicyRuleToPolicyRuleResponseBody(val)
+		}
+	} else {
+		body.Rules = []*PolicyRuleResponseBody{}
+	}
+	if res.Users != nil {
+		body.Users = make([]int, len(res.Users))
+		for i, val := range res.Users {
+			body.Users[i] = val
+		}
+	} else {
+		body.Users = []int{}
+	}
+	if res.Roles != nil {
+		body.Roles = make([]int, len(res.Roles))
+		for i, val := range res.Roles {
+			body.Roles[i] = val
+		}
+	} else {
+		body.Roles = []int{}
+	}
+	return body
+}
+
+// NewExplainResponseBody builds the HTTP response body from the result of the
+// "explain" endpoint of the "policies" service.
+func NewExplainResponseBody(res *policies.ExplainResult) *ExplainResponseBody {
+	body := &ExplainResponseBody{
+		Allowed:  res.Allowed,
+		Decision: res.Decision,
+	}
+	if res.Matches != nil {
+		body.Matches = make([]*ExplainMatchResponseBody, len(res.Matches))
+		for i, val := range res.Matches {
+			body.Matches[i] = marshalPoliciesExplainMatchToExplainMatchResponseBody(val)
+		}
+	} else {
+		body.Matches = []*ExplainMatchResponseBody{}
+	}
+	return body
+}
+
+// NewListPoliciesInternalErrorResponseBody builds the HTTP response body from
+// the result of the "list policies" endpoint of the "policies" service.
+func NewListPoliciesInternalErrorResponseBody(res *goa.ServiceError) *ListPoliciesInternalErrorResponseBody {
+	body := &ListPoliciesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPoliciesForbiddenResponseBody builds the HTTP response body from the
+// result of the "list policies" endpoint of the "policies" service.
+func NewListPoliciesForbiddenResponseBody(res *goa.ServiceError) *ListPoliciesForbiddenResponseBody {
+	body := &ListPoliciesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListPoliciesUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list policies" endpoint of the "policies" service.
+func NewListPoliciesUnauthorizedResponseBody(res *goa.ServiceError) *ListPoliciesUnauthorizedResponseBody {
+	body := &ListPoliciesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPolicyPolicyNotFoundResponseBody builds the HTTP response body from
+// the result of the "get policy" endpoint of the "policies" service.
+func NewGetPolicyPolicyNotFoundResponseBody(res *goa.ServiceError) *GetPolicyPolicyNotFoundResponseBody {
+	body := &GetPolicyPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPolicyInternalErrorResponseBody builds the HTTP response body from the
+// result of the "get policy" endpoint of the "policies" service.
+func NewGetPolicyInternalErrorResponseBody(res *goa.ServiceError) *GetPolicyInternalErrorResponseBody {
+	body := &GetPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "get policy" endpoint of the "policies" service.
+func NewGetPolicyForbiddenResponseBody(res *goa.ServiceError) *GetPolicyForbiddenResponseBody {
+	body := &GetPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPolicyUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get policy" endpoint of the "policies" service.
+func NewGetPolicyUnauthorizedResponseBody(res *goa.ServiceError) *GetPolicyUnauthorizedResponseBody {
+	body := &GetPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePolicyPolicyTakenResponseBody builds the HTTP response body from
+// the result of the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyPolicyTakenResponseBody(res *goa.ServiceError) *CreatePolicyPolicyTakenResponseBody {
+	body := &CreatePolicyPolicyTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePolicyInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyInvalidParametersResponseBody(res *goa.ServiceError) *CreatePolicyInvalidParametersResponseBody {
+	body := &CreatePolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePolicyInternalErrorResponseBody builds the HTTP response body from
+// the result of the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyInternalErrorResponseBody(res *goa.ServiceError) *CreatePolicyInternalErrorResponseBody {
+	body := &CreatePolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyForbiddenResponseBody(res *goa.ServiceError) *CreatePolicyForbiddenResponseBody {
+	body := &CreatePolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreatePolicyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create policy" endpoint of the "policies" service.
+func NewCreatePolicyUnauthorizedResponseBody(res *goa.ServiceError) *CreatePolicyUnauthorizedResponseBody {
+	body := &CreatePolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyPolicyNotFoundResponseBody builds the HTTP response body from
+// the result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyPolicyNotFoundResponseBody(res *goa.ServiceError) *UpdatePolicyPolicyNotFoundResponseBody {
+	body := &UpdatePolicyPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyPolicyTakenResponseBody builds the HTTP response body from
+// the result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyPolicyTakenResponseBody(res *goa.ServiceError) *UpdatePolicyPolicyTakenResponseBody {
+	body := &UpdatePolicyPolicyTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyInvalidParametersResponseBody(res *goa.ServiceError) *UpdatePolicyInvalidParametersResponseBody {
+	body := &UpdatePolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyInternalErrorResponseBody builds the HTTP response body from
+// the result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyInternalErrorResponseBody(res *goa.ServiceError) *UpdatePolicyInternalErrorResponseBody {
+	body := &UpdatePolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyForbiddenResponseBody(res *goa.ServiceError) *UpdatePolicyForbiddenResponseBody {
+	body := &UpdatePolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdatePolicyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "update policy" endpoint of the "policies" service.
+func NewUpdatePolicyUnauthorizedResponseBody(res *goa.ServiceError) *UpdatePolicyUnauthorizedResponseBody {
+	body := &UpdatePolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeletePolicyPolicyNotFoundResponseBody builds the HTTP response body from
+// the result of the "delete policy" endpoint of the "policies" service.
+func NewDeletePolicyPolicyNotFoundResponseBody(res *goa.ServiceError) *DeletePolicyPolicyNotFoundResponseBody {
+	body := &DeletePolicyPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeletePolicyInternalErrorResponseBody builds the HTTP response body from
+// the result of the "delete policy" endpoint of the "policies" service.
+func NewDeletePolicyInternalErrorResponseBody(res *goa.ServiceError) *DeletePolicyInternalErrorResponseBody {
+	body := &DeletePolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeletePolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "delete policy" endpoint of the "policies" service.
+func NewDeletePolicyForbiddenResponseBody(res *goa.ServiceError) *DeletePolicyForbiddenResponseBody {
+	body := &DeletePolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeletePolicyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete policy" endpoint of the "policies" service.
+func NewDeletePolicyUnauthorizedResponseBody(res *goa.ServiceError) *DeletePolicyUnauthorizedResponseBody {
+	body := &DeletePolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAttachPolicyPolicyNotFoundResponseBody builds the HTTP response body from
+// the result of the "attach policy" endpoint of the "policies" service.
+func NewAttachPolicyPolicyNotFoundResponseBody(res *goa.ServiceError) *AttachPolicyPolicyNotFoundResponseBody {
+	body := &AttachPolicyPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAttachPolicyInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "attach policy" endpoint of the "policies" service.
+func NewAttachPolicyInvalidParametersResponseBody(res *goa.ServiceError) *AttachPolicyInvalidParametersResponseBody {
+	body := &AttachPolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAttachPolicyInternalErrorResponseBody builds the HTTP response body from
+// the result of the "attach policy" endpoint of the "policies" service.
+func NewAttachPolicyInternalErrorResponseBody(res *goa.ServiceError) *AttachPolicyInternalErrorResponseBody {
+	body := &AttachPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAttachPolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "attach policy" endpoint of the "policies" service.
+func NewAttachPolicyForbiddenResponseBody(res *goa.ServiceError) *AttachPolicyForbiddenResponseBody {
+	body := &AttachPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewAttachPolicyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "attach policy" endpoint of the "policies" service.
+func NewAttachPolicyUnauthorizedResponseBody(res *goa.ServiceError) *AttachPolicyUnauthorizedResponseBody {
+	body := &AttachPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDetachPolicyPolicyNotFoundResponseBody builds the HTTP response body from
+// the result of the "detach policy" endpoint of the "policies" service.
+func NewDetachPolicyPolicyNotFoundResponseBody(res *goa.ServiceError) *DetachPolicyPolicyNotFoundResponseBody {
+	body := &DetachPolicyPolicyNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDetachPolicyInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "detach policy" endpoint of the "policies" service.
+func NewDetachPolicyInvalidParametersResponseBody(res *goa.ServiceError) *DetachPolicyInvalidParametersResponseBody {
+	body := &DetachPolicyInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDetachPolicyInternalErrorResponseBody builds the HTTP response body from
+// the result of the "detach policy" endpoint of the "policies" service.
+func NewDetachPolicyInternalErrorResponseBody(res *goa.ServiceError) *DetachPolicyInternalErrorResponseBody {
+	body := &DetachPolicyInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDetachPolicyForbiddenResponseBody builds the HTTP response body from the
+// result of the "detach policy" endpoint of the "policies" service.
+func NewDetachPolicyForbiddenResponseBody(res *goa.ServiceError) *DetachPolicyForbiddenResponseBody {
+	body := &DetachPolicyForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDetachPolicyUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "detach policy" endpoint of the "policies" service.
+func NewDetachPolicyUnauthorizedResponseBody(res *goa.ServiceError) *DetachPolicyUnauthorizedResponseBody {
+	body := &DetachPolicyUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExplainInvalidParametersResponseBody builds the HTTP response body from
+// the result of the "explain" endpoint of the "policies" service.
+func NewExplainInvalidParametersResponseBody(res *goa.ServiceError) *ExplainInvalidParametersResponseBody {
+	body := &ExplainInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExplainInternalErrorResponseBody builds the HTTP response body from the
+// result of the "explain" endpoint of the "policies" service.
+func NewExplainInternalErrorResponseBody(res *goa.ServiceError) *ExplainInternalErrorResponseBody {
+	body := &ExplainInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExplainForbiddenResponseBody builds the HTTP response body from the
+// result of the "explain" endpoint of the "policies" service.
+func NewExplainForbiddenResponseBody(res *goa.ServiceError) *ExplainForbiddenResponseBody {
+	body := &ExplainForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExplainUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "explain" endpoint of the "policies" service.
+func NewExplainUnauthorizedResponseBody(res *goa.ServiceError) *ExplainUnauthorizedResponseBody {
+	body := &ExplainUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetPolicyPayload builds a policies service get policy endpoint payload.
+func NewGetPolicyPayload(id int) *policies.GetPolicyPayload {
+	v := &policies.GetPolicyPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewCreatePolicyPayload builds a policies service create policy endpoint
+// payload.
+func NewCreatePolicyPayload(body *CreatePolicyRequestBody) *policies.CreatePolicyPayload {
+	v := &policies.CreatePolicyPayload{
+		Name:     *body.Name,
+		Format:   *body.Format,
+		Document: *body.Document,
+	}
+
+	return v
+}
+
+// NewUpdatePolicyPayload builds a policies service update policy endpoint
+// payload.
+func NewUpdatePolicyPayload(body *UpdatePolicyRequestBody, id int) *policies.UpdatePolicyPayload {
+	v := &policies.UpdatePolicyPayload{
+		Name:     *body.Name,
+		Format:   *body.Format,
+		Document: *body.Document,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewDeletePolicyPayload builds a policies service delete policy endpoint
+// payload.
+func NewDeletePolicyPayload(id int) *policies.DeletePolicyPayload {
+	v := &policies.DeletePolicyPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewAttachPolicyPayload builds a policies service attach policy endpoint
+// payload.
+func NewAttachPolicyPayload(body *AttachPolicyRequestBody, id int) *policies.AttachPolicyPayload {
+	v := &policies.AttachPolicyPayload{
+		UserID: body.UserID,
+		RoleID: body.RoleID,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewDetachPolicyPayload builds a policies service detach policy endpoint
+// payload.
+func NewDetachPolicyPayload(body *DetachPolicyRequestBody, id int) *policies.DetachPolicyPayload {
+	v := &policies.DetachPolicyPayload{
+		UserID: body.UserID,
+		RoleID: body.RoleID,
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewExplainPayload builds a policies service explain endpoint payload.
+func NewExplainPayload(body *ExplainRequestBody) *policies.ExplainPayload {
+	v := &policies.ExplainPayload{
+		UserID:     *body.UserID,
+		Path:       *body.Path,
+		Capability: *body.Capability,
+	}
+
+	return v
+}
+
+// ValidateCreatePolicyRequestBody runs the validations defined on Create
+// PolicyRequestBody
+func ValidateCreatePolicyRequestBody(body *CreatePolicyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.Document == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("document", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "hcl" || *body.Format == "yaml") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"hcl", "yaml"}))
+		}
+	}
+	if body.Document != nil {
+		if utf8.RuneCountInString(*body.Document) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.document", *body.Document, utf8.RuneCountInString(*body.Document), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateUpdatePolicyRequestBody runs the validations defined on Update
+// PolicyRequestBody
+func ValidateUpdatePolicyRequestBody(body *UpdatePolicyRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.Document == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("document", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "hcl" || *body.Format == "yaml") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"hcl", "yaml"}))
+		}
+	}
+	if body.Document != nil {
+		if utf8.RuneCountInString(*body.Document) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.document", *body.Document, utf8.RuneCountInString(*body.Document), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateExplainRequestBody runs the validations defined on ExplainRequestBody
+func ValidateExplainRequestBody(body *ExplainRequestBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Capability == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capability", "body"))
+	}
+	if body.Path != nil {
+		if utf8.RuneCountInString(*body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		}
+	}
+	if body.Capability != nil {
+		if !(*body.Capability == "read" || *body.Capability == "list" || *body.Capability == "update" || *body.Capability == "delete" || *body.Capability == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capability", *body.Capability, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	return
+}
@@ -10,15 +10,74 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"unicode/utf8"
 
 	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// BuildBrowseSecretsPayload builds the payload for the secrets browse secrets
+// endpoint from CLI flags.
+func BuildBrowseSecretsPayload(secretsBrowseSecretsPrefix string, secretsBrowseSecretsRecursive string, secretsBrowseSecretsCursor string, secretsBrowseSecretsLimit string) (*secrets.BrowseSecretsPayload, error) {
+	var err error
+	var prefix string
+	{
+		prefix = secretsBrowseSecretsPrefix
+		if utf8.RuneCountInString(prefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("prefix", prefix, utf8.RuneCountInString(prefix), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var recursive bool
+	{
+		if secretsBrowseSecretsRecursive != "" {
+			recursive, err = strconv.ParseBool(secretsBrowseSecretsRecursive)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for recursive, must be BOOL")
+			}
+		}
+	}
+	var cursor *string
+	{
+		if secretsBrowseSecretsCursor != "" {
+			cursor = &secretsBrowseSecretsCursor
+		}
+	}
+	var limit int
+	{
+		if secretsBrowseSecretsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(secretsBrowseSecretsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &secrets.BrowseSecretsPayload{}
+	v.Prefix = prefix
+	v.Recursive = recursive
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v, nil
+}
+
 // BuildGetSecretValuePayload builds the payload for the secrets get secret
 // value endpoint from CLI flags.
-func BuildGetSecretValuePayload(secretsGetSecretValuePath string) (*secrets.GetSecretValuePayload, error) {
+func BuildGetSecretValuePayload(secretsGetSecretValuePath string, secretsGetSecretValueField string, secretsGetSecretValueRaw string, secretsGetSecretValueBreakGlassJustification string) (*secrets.GetSecretValuePayload, error) {
 	var err error
 	var path string
 	{
@@ -30,8 +89,282 @@ func BuildGetSecretValuePayload(secretsGetSecretValuePath string) (*secrets.GetS
 			return nil, err
 		}
 	}
+	var field *string
+	{
+		if secretsGetSecretValueField != "" {
+			field = &secretsGetSecretValueField
+		}
+	}
+	var raw bool
+	{
+		if secretsGetSecretValueRaw != "" {
+			raw, err = strconv.ParseBool(secretsGetSecretValueRaw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for raw, must be BOOL")
+			}
+		}
+	}
+	var breakGlassJustification *string
+	{
+		if secretsGetSecretValueBreakGlassJustification != "" {
+			breakGlassJustification = &secretsGetSecretValueBreakGlassJustification
+			if utf8.RuneCountInString(*breakGlassJustification) < 10 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("break_glass_justification", *breakGlassJustification, utf8.RuneCountInString(*breakGlassJustification), 10, true))
+			}
+			if utf8.RuneCountInString(*breakGlassJustification) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("break_glass_justification", *breakGlassJustification, utf8.RuneCountInString(*breakGlassJustification), 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := &secrets.GetSecretValuePayload{}
 	v.Path = path
+	v.Field = field
+	v.Raw = raw
+	v.BreakGlassJustification = breakGlassJustification
+
+	return v, nil
+}
+
+// BuildBatchGetSecretValuesPayload builds the payload for the secrets batch
+// get secret values endpoint from CLI flags.
+func BuildBatchGetSecretValuesPayload(secretsBatchGetSecretValuesBody string) (*secrets.BatchGetSecretValuesPayload, error) {
+	var err error
+	var body BatchGetSecretValuesRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsBatchGetSecretValuesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"secrets\": [\n         {\n            \"field\": \"password\",\n            \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n         }\n      ]\n   }'")
+		}
+		if body.Secrets == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("secrets", "body"))
+		}
+		if len(body.Secrets) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.secrets", body.Secrets, len(body.Secrets), 1, true))
+		}
+		if len(body.Secrets) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.secrets", body.Secrets, len(body.Secrets), 100, false))
+		}
+		for _, e := range body.Secrets {
+			if e != nil {
+				if err2 := ValidateSecretValueRequestRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.BatchGetSecretValuesPayload{}
+	if body.Secrets != nil {
+		v.Secrets = make([]*secrets.SecretValueRequest, len(body.Secrets))
+		for i, val := range body.Secrets {
+			v.Secrets[i] = marshalSecretValueRequestRequestBodyToSecretsSecretValueRequest(val)
+		}
+	} else {
+		v.Secrets = []*secrets.SecretValueRequest{}
+	}
+
+	return v, nil
+}
+
+// BuildRenderTemplatePayload builds the payload for the secrets render
+// template endpoint from CLI flags.
+func BuildRenderTemplatePayload(secretsRenderTemplateBody string) (*secrets.RenderTemplatePayload, error) {
+	var err error
+	var body RenderTemplateRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsRenderTemplateBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"template\": \"DATABASE_URL={{ secret \\\"/app/db/url\\\" }}\\n{{ env \\\"API_KEY\\\" (secret \\\"/app/api_key\\\") }}\",\n      \"template_path\": \"L2FwcC90ZW1wbGF0ZXMvZW52\"\n   }'")
+		}
+	}
+	v := &secrets.RenderTemplatePayload{
+		Template:     body.Template,
+		TemplatePath: body.TemplatePath,
+	}
+
+	return v, nil
+}
+
+// BuildUploadSecretFilePayload builds the payload for the secrets upload
+// secret file endpoint from CLI flags.
+func BuildUploadSecretFilePayload(secretsUploadSecretFilePath string, secretsUploadSecretFileFilename string, secretsUploadSecretFileContentType string) (*secrets.UploadSecretFilePayload, error) {
+	var err error
+	var path string
+	{
+		path = secretsUploadSecretFilePath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var filename *string
+	{
+		if secretsUploadSecretFileFilename != "" {
+			filename = &secretsUploadSecretFileFilename
+		}
+	}
+	var contentType string
+	{
+		if secretsUploadSecretFileContentType != "" {
+			contentType = secretsUploadSecretFileContentType
+		}
+	}
+	v := &secrets.UploadSecretFilePayload{}
+	v.Path = path
+	v.Filename = filename
+	v.ContentType = contentType
+
+	return v, nil
+}
+
+// BuildDownloadSecretFilePayload builds the payload for the secrets download
+// secret file endpoint from CLI flags.
+func BuildDownloadSecretFilePayload(secretsDownloadSecretFilePath string) (*secrets.DownloadSecretFilePayload, error) {
+	var err error
+	var path string
+	{
+		path = secretsDownloadSecretFilePath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.DownloadSecretFilePayload{}
+	v.Path = path
+
+	return v, nil
+}
+
+// BuildExportSecretsPayload builds the payload for the secrets export secrets
+// endpoint from CLI flags.
+func BuildExportSecretsPayload(secretsExportSecretsPrefix string, secretsExportSecretsFormat string, secretsExportSecretsKeyNaming string, secretsExportSecretsRaw string) (*secrets.ExportSecretsPayload, error) {
+	var err error
+	var prefix string
+	{
+		prefix = secretsExportSecretsPrefix
+		if utf8.RuneCountInString(prefix) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("prefix", prefix, utf8.RuneCountInString(prefix), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if secretsExportSecretsFormat != "" {
+			format = secretsExportSecretsFormat
+			if !(format == "dotenv" || format == "json" || format == "yaml") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"dotenv", "json", "yaml"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var keyNaming *string
+	{
+		if secretsExportSecretsKeyNaming != "" {
+			keyNaming = &secretsExportSecretsKeyNaming
+			if !(*keyNaming == "path" || *keyNaming == "segment" || *keyNaming == "env") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("key_naming", *keyNaming, []any{"path", "segment", "env"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var raw bool
+	{
+		if secretsExportSecretsRaw != "" {
+			raw, err = strconv.ParseBool(secretsExportSecretsRaw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for raw, must be BOOL")
+			}
+		}
+	}
+	v := &secrets.ExportSecretsPayload{}
+	v.Prefix = prefix
+	v.Format = format
+	v.KeyNaming = keyNaming
+	v.Raw = raw
+
+	return v, nil
+}
+
+// BuildImportSecretsPayload builds the payload for the secrets import secrets
+// endpoint from CLI flags.
+func BuildImportSecretsPayload(secretsImportSecretsPrefix string, secretsImportSecretsFormat string, secretsImportSecretsKeyNaming string, secretsImportSecretsOnExisting string, secretsImportSecretsDryRun string) (*secrets.ImportSecretsPayload, error) {
+	var err error
+	var prefix string
+	{
+		prefix = secretsImportSecretsPrefix
+		if utf8.RuneCountInString(prefix) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("prefix", prefix, utf8.RuneCountInString(prefix), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if secretsImportSecretsFormat != "" {
+			format = secretsImportSecretsFormat
+			if !(format == "dotenv" || format == "json" || format == "yaml") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"dotenv", "json", "yaml"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var keyNaming *string
+	{
+		if secretsImportSecretsKeyNaming != "" {
+			keyNaming = &secretsImportSecretsKeyNaming
+			if !(*keyNaming == "path" || *keyNaming == "segment" || *keyNaming == "env") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("key_naming", *keyNaming, []any{"path", "segment", "env"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var onExisting string
+	{
+		if secretsImportSecretsOnExisting != "" {
+			onExisting = secretsImportSecretsOnExisting
+			if !(onExisting == "fail" || onExisting == "skip" || onExisting == "overwrite") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("on_existing", onExisting, []any{"fail", "skip", "overwrite"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var dryRun bool
+	{
+		if secretsImportSecretsDryRun != "" {
+			dryRun, err = strconv.ParseBool(secretsImportSecretsDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	v := &secrets.ImportSecretsPayload{}
+	v.Prefix = prefix
+	v.Format = format
+	v.KeyNaming = keyNaming
+	v.OnExisting = onExisting
+	v.DryRun = dryRun
 
 	return v, nil
 }
@@ -64,7 +397,7 @@ func BuildCreateSecretPayload(secretsCreateSecretBody string) (*secrets.CreateSe
 	{
 		err = json.Unmarshal([]byte(secretsCreateSecretBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorized_roles\": [\n         1,\n         2\n      ],\n      \"authorized_users\": [\n         1,\n         2,\n         3\n      ],\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\",\n      \"value\": \"SECRET_API_KEY123\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorized_roles\": [\n         1,\n         2\n      ],\n      \"authorized_users\": [\n         1,\n         2,\n         3\n      ],\n      \"fields\": {\n         \"password\": \"hunter2\",\n         \"username\": \"app\"\n      },\n      \"generation_policy\": \"strong-password\",\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\",\n      \"role_grants\": [\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         }\n      ],\n      \"rotation_schedule\": \"720h\",\n      \"user_grants\": [\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         }\n      ],\n      \"value\": \"SECRET_API_KEY123\"\n   }'")
 		}
 		if body.AuthorizedUsers == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
@@ -75,16 +408,42 @@ func BuildCreateSecretPayload(secretsCreateSecretBody string) (*secrets.CreateSe
 		if utf8.RuneCountInString(body.Path) < 2 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", body.Path, utf8.RuneCountInString(body.Path), 2, true))
 		}
-		if utf8.RuneCountInString(body.Value) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", body.Value, utf8.RuneCountInString(body.Value), 1, true))
+		if body.Value != nil {
+			if utf8.RuneCountInString(*body.Value) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+			}
+		}
+		for _, e := range body.UserGrants {
+			if e != nil {
+				if err2 := ValidateGrantRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		for _, e := range body.RoleGrants {
+			if e != nil {
+				if err2 := ValidateGrantRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
 		}
 		if err != nil {
 			return nil, err
 		}
 	}
 	v := &secrets.CreateSecretPayload{
-		Path:  body.Path,
-		Value: body.Value,
+		Path:             body.Path,
+		Value:            body.Value,
+		GenerationPolicy: body.GenerationPolicy,
+		RotationSchedule: body.RotationSchedule,
+	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
 	}
 	if body.AuthorizedUsers != nil {
 		v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
@@ -102,6 +461,18 @@ func BuildCreateSecretPayload(secretsCreateSecretBody string) (*secrets.CreateSe
 	} else {
 		v.AuthorizedRoles = []int{}
 	}
+	if body.UserGrants != nil {
+		v.UserGrants = make([]*secrets.Grant, len(body.UserGrants))
+		for i, val := range body.UserGrants {
+			v.UserGrants[i] = marshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+	if body.RoleGrants != nil {
+		v.RoleGrants = make([]*secrets.Grant, len(body.RoleGrants))
+		for i, val := range body.RoleGrants {
+			v.RoleGrants[i] = marshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
 
 	return v, nil
 }
@@ -114,19 +485,29 @@ func BuildUpdateSecretPayload(secretsUpdateSecretBody string) (*secrets.UpdateSe
 	{
 		err = json.Unmarshal([]byte(secretsUpdateSecretBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorized_roles\": [\n         1,\n         2\n      ],\n      \"authorized_users\": [\n         1,\n         2,\n         3\n      ],\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\",\n      \"value\": \"SECRET_API_KEY123\"\n   }'")
-		}
-		if body.AuthorizedUsers == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
-		}
-		if body.AuthorizedRoles == nil {
-			err = goa.MergeErrors(err, goa.MissingFieldError("authorized_roles", "body"))
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorized_roles\": [\n         1,\n         2\n      ],\n      \"authorized_users\": [\n         1,\n         2,\n         3\n      ],\n      \"fields\": {\n         \"password\": \"hunter3\"\n      },\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\",\n      \"remove_fields\": [\n         \"legacy_password\"\n      ],\n      \"role_grants\": [\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         }\n      ],\n      \"user_grants\": [\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         },\n         {\n            \"capabilities\": [\n               \"read\",\n               \"list\"\n            ],\n            \"expires_at\": \"2025-07-01T18:00:00Z\",\n            \"expires_in\": 3600,\n            \"id\": 2\n         }\n      ],\n      \"value\": \"SECRET_API_KEY123\"\n   }'")
 		}
 		if utf8.RuneCountInString(body.Path) < 2 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", body.Path, utf8.RuneCountInString(body.Path), 2, true))
 		}
-		if utf8.RuneCountInString(body.Value) < 1 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", body.Value, utf8.RuneCountInString(body.Value), 1, true))
+		if body.Value != nil {
+			if utf8.RuneCountInString(*body.Value) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+			}
+		}
+		for _, e := range body.UserGrants {
+			if e != nil {
+				if err2 := ValidateGrantRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		for _, e := range body.RoleGrants {
+			if e != nil {
+				if err2 := ValidateGrantRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
 		}
 		if err != nil {
 			return nil, err
@@ -136,22 +517,763 @@ func BuildUpdateSecretPayload(secretsUpdateSecretBody string) (*secrets.UpdateSe
 		Path:  body.Path,
 		Value: body.Value,
 	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
+	}
+	if body.RemoveFields != nil {
+		v.RemoveFields = make([]string, len(body.RemoveFields))
+		for i, val := range body.RemoveFields {
+			v.RemoveFields[i] = val
+		}
+	}
 	if body.AuthorizedUsers != nil {
 		v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
 		for i, val := range body.AuthorizedUsers {
 			v.AuthorizedUsers[i] = val
 		}
-	} else {
-		v.AuthorizedUsers = []int{}
 	}
 	if body.AuthorizedRoles != nil {
 		v.AuthorizedRoles = make([]int, len(body.AuthorizedRoles))
 		for i, val := range body.AuthorizedRoles {
 			v.AuthorizedRoles[i] = val
 		}
-	} else {
-		v.AuthorizedRoles = []int{}
 	}
+	if body.UserGrants != nil {
+		v.UserGrants = make([]*secrets.Grant, len(body.UserGrants))
+		for i, val := range body.UserGrants {
+			v.UserGrants[i] = marshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+	if body.RoleGrants != nil {
+		v.RoleGrants = make([]*secrets.Grant, len(body.RoleGrants))
+		for i, val := range body.RoleGrants {
+			v.RoleGrants[i] = marshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+
+	return v, nil
+}
+
+// BuildRegenerateSecretPayload builds the payload for the secrets regenerate
+// secret endpoint from CLI flags.
+func BuildRegenerateSecretPayload(secretsRegenerateSecretBody string, secretsRegenerateSecretPath string) (*secrets.RegenerateSecretPayload, error) {
+	var err error
+	var body RegenerateSecretRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsRegenerateSecretBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"generation_policy\": \"strong-password\"\n   }'")
+		}
+	}
+	var path string
+	{
+		path = secretsRegenerateSecretPath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.RegenerateSecretPayload{
+		GenerationPolicy: body.GenerationPolicy,
+	}
+	v.Path = path
+
+	return v, nil
+}
+
+// BuildSetSecretRotationPayload builds the payload for the secrets set secret
+// rotation endpoint from CLI flags.
+func BuildSetSecretRotationPayload(secretsSetSecretRotationBody string, secretsSetSecretRotationPath string) (*secrets.SetSecretRotationPayload, error) {
+	var err error
+	var body SetSecretRotationRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsSetSecretRotationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"schedule\": \"720h\"\n   }'")
+		}
+	}
+	var path string
+	{
+		path = secretsSetSecretRotationPath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.SetSecretRotationPayload{
+		Schedule: body.Schedule,
+	}
+	v.Path = path
+
+	return v, nil
+}
+
+// BuildListSecretRotationsPayload builds the payload for the secrets list
+// secret rotations endpoint from CLI flags.
+func BuildListSecretRotationsPayload(secretsListSecretRotationsPath string, secretsListSecretRotationsLimit string) (*secrets.ListSecretRotationsPayload, error) {
+	var err error
+	var path string
+	{
+		path = secretsListSecretRotationsPath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if secretsListSecretRotationsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(secretsListSecretRotationsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &secrets.ListSecretRotationsPayload{}
+	v.Path = path
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildDeleteSecretPayload builds the payload for the secrets delete secret
+// endpoint from CLI flags.
+func BuildDeleteSecretPayload(secretsDeleteSecretPath string) (*secrets.DeleteSecretPayload, error) {
+	var err error
+	var path string
+	{
+		path = secretsDeleteSecretPath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.DeleteSecretPayload{}
+	v.Path = path
+
+	return v, nil
+}
+
+// BuildMoveSecretsPayload builds the payload for the secrets move secrets
+// endpoint from CLI flags.
+func BuildMoveSecretsPayload(secretsMoveSecretsBody string) (*secrets.MoveSecretsPayload, error) {
+	var err error
+	var body MoveSecretsRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsMoveSecretsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"destination\": \"L2JpbGxpbmcv\",\n      \"overwrite\": true,\n      \"source\": \"L3BheW1lbnRzLw==\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Source) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.source", body.Source, utf8.RuneCountInString(body.Source), 2, true))
+		}
+		if utf8.RuneCountInString(body.Destination) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.destination", body.Destination, utf8.RuneCountInString(body.Destination), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.MoveSecretsPayload{
+		Source:      body.Source,
+		Destination: body.Destination,
+		Overwrite:   body.Overwrite,
+	}
+	{
+		var zero bool
+		if v.Overwrite == zero {
+			v.Overwrite = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildDiffSecretsPayload builds the payload for the secrets diff secrets
+// endpoint from CLI flags.
+func BuildDiffSecretsPayload(secretsDiffSecretsSource string, secretsDiffSecretsDestination string) (*secrets.DiffSecretsPayload, error) {
+	var err error
+	var source string
+	{
+		source = secretsDiffSecretsSource
+		if utf8.RuneCountInString(source) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("source", source, utf8.RuneCountInString(source), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var destination string
+	{
+		destination = secretsDiffSecretsDestination
+		if utf8.RuneCountInString(destination) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("destination", destination, utf8.RuneCountInString(destination), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.DiffSecretsPayload{}
+	v.Source = source
+	v.Destination = destination
+
+	return v, nil
+}
+
+// BuildPromoteSecretsPayload builds the payload for the secrets promote
+// secrets endpoint from CLI flags.
+func BuildPromoteSecretsPayload(secretsPromoteSecretsBody string) (*secrets.PromoteSecretsPayload, error) {
+	var err error
+	var body PromoteSecretsRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsPromoteSecretsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"destination\": \"L3Byb2Qv\",\n      \"keys\": [\n         \"db/password\",\n         \"stripe/api_key\"\n      ],\n      \"overwrite\": false,\n      \"source\": \"L3N0YWdpbmcv\"\n   }'")
+		}
+		if body.Keys == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+		}
+		if utf8.RuneCountInString(body.Source) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.source", body.Source, utf8.RuneCountInString(body.Source), 2, true))
+		}
+		if utf8.RuneCountInString(body.Destination) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.destination", body.Destination, utf8.RuneCountInString(body.Destination), 2, true))
+		}
+		if len(body.Keys) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keys", body.Keys, len(body.Keys), 1, true))
+		}
+		if len(body.Keys) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keys", body.Keys, len(body.Keys), 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.PromoteSecretsPayload{
+		Source:      body.Source,
+		Destination: body.Destination,
+		Overwrite:   body.Overwrite,
+	}
+	if body.Keys != nil {
+		v.Keys = make([]string, len(body.Keys))
+		for i, val := range body.Keys {
+			v.Keys[i] = val
+		}
+	} else {
+		v.Keys = []string{}
+	}
+	{
+		var zero bool
+		if v.Overwrite == zero {
+			v.Overwrite = false
+		}
+	}
+
+	return v, nil
+}
+
+// BuildTransferSecretOwnershipPayload builds the payload for the secrets
+// transfer secret ownership endpoint from CLI flags.
+func BuildTransferSecretOwnershipPayload(secretsTransferSecretOwnershipBody string) (*secrets.TransferSecretOwnershipPayload, error) {
+	var err error
+	var body TransferSecretOwnershipRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsTransferSecretOwnershipBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"from_user_id\": 2,\n      \"path\": \"L3BheW1lbnRzL3N0cmlwZV9rZXk=\",\n      \"to_role_id\": 1,\n      \"to_user_id\": 3\n   }'")
+		}
+	}
+	v := &secrets.TransferSecretOwnershipPayload{
+		Path:       body.Path,
+		FromUserID: body.FromUserID,
+		ToUserID:   body.ToUserID,
+		ToRoleID:   body.ToRoleID,
+	}
+
+	return v, nil
+}
+
+// BuildListStaleSecretsPayload builds the payload for the secrets list stale
+// secrets endpoint from CLI flags.
+func BuildListStaleSecretsPayload(secretsListStaleSecretsDays string) (*secrets.ListStaleSecretsPayload, error) {
+	var err error
+	var days int
+	{
+		if secretsListStaleSecretsDays != "" {
+			var v int64
+			v, err = strconv.ParseInt(secretsListStaleSecretsDays, 10, strconv.IntSize)
+			days = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for days, must be INT")
+			}
+			if days < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("days", days, 1, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &secrets.ListStaleSecretsPayload{}
+	v.Days = days
+
+	return v, nil
+}
+
+// BuildSetSecretCanaryPayload builds the payload for the secrets set secret
+// canary endpoint from CLI flags.
+func BuildSetSecretCanaryPayload(secretsSetSecretCanaryBody string, secretsSetSecretCanaryPath string) (*secrets.SetSecretCanaryPayload, error) {
+	var err error
+	var body SetSecretCanaryRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsSetSecretCanaryBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"canary\": false\n   }'")
+		}
+	}
+	var path string
+	{
+		path = secretsSetSecretCanaryPath
+		if utf8.RuneCountInString(path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("path", path, utf8.RuneCountInString(path), 2, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.SetSecretCanaryPayload{
+		Canary: body.Canary,
+	}
+	v.Path = path
+
+	return v, nil
+}
+
+// BuildWrapSecretPayload builds the payload for the secrets wrap secret
+// endpoint from CLI flags.
+func BuildWrapSecretPayload(secretsWrapSecretBody string) (*secrets.WrapSecretPayload, error) {
+	var err error
+	var body WrapSecretRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsWrapSecretBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"field\": \"password\",\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\",\n      \"ttl\": 51721,\n      \"value\": \"SECRET_API_KEY123\"\n   }'")
+		}
+	}
+	v := &secrets.WrapSecretPayload{
+		Value: body.Value,
+		Path:  body.Path,
+		Field: body.Field,
+		TTL:   body.TTL,
+	}
+	{
+		var zero int
+		if v.TTL == zero {
+			v.TTL = 300
+		}
+	}
+
+	return v, nil
+}
+
+// BuildUnwrapSecretPayload builds the payload for the secrets unwrap secret
+// endpoint from CLI flags.
+func BuildUnwrapSecretPayload(secretsUnwrapSecretBody string) (*secrets.UnwrapSecretPayload, error) {
+	var err error
+	var body UnwrapSecretRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsUnwrapSecretBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"c1b0e4f5a9d84a2c8e7f6b5a4d3c2b1a0f9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c\"\n   }'")
+		}
+	}
+	v := &secrets.UnwrapSecretPayload{
+		Token: body.Token,
+	}
+
+	return v, nil
+}
+
+// BuildRequestAccessPayload builds the payload for the secrets request access
+// endpoint from CLI flags.
+func BuildRequestAccessPayload(secretsRequestAccessBody string) (*secrets.RequestAccessPayload, error) {
+	var err error
+	var body RequestAccessRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsRequestAccessBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"capabilities\": [\n         \"delete\",\n         \"update\"\n      ],\n      \"duration\": 3600,\n      \"justification\": \"Investigating the failed deployment of the billing API\",\n      \"path\": \"L2N1c3RvbWVycy9nb29nbGUvYXBpX2tleQ==\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", body.Path, utf8.RuneCountInString(body.Path), 2, true))
+		}
+		if len(body.Capabilities) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.capabilities", body.Capabilities, len(body.Capabilities), 1, true))
+		}
+		for _, e := range body.Capabilities {
+			if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+			}
+		}
+		if utf8.RuneCountInString(body.Justification) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.justification", body.Justification, utf8.RuneCountInString(body.Justification), 1, true))
+		}
+		if utf8.RuneCountInString(body.Justification) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.justification", body.Justification, utf8.RuneCountInString(body.Justification), 1000, false))
+		}
+		if body.Duration != nil {
+			if *body.Duration < 60 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 60, true))
+			}
+		}
+		if body.Duration != nil {
+			if *body.Duration > 7.776e+06 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 7.776e+06, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.RequestAccessPayload{
+		Path:          body.Path,
+		Justification: body.Justification,
+		Duration:      body.Duration,
+	}
+	if body.Capabilities != nil {
+		v.Capabilities = make([]string, len(body.Capabilities))
+		for i, val := range body.Capabilities {
+			v.Capabilities[i] = val
+		}
+	}
+	if body.Capabilities == nil {
+		v.Capabilities = []string{"read", "list"}
+	}
+
+	return v, nil
+}
+
+// BuildListAccessRequestsPayload builds the payload for the secrets list
+// access requests endpoint from CLI flags.
+func BuildListAccessRequestsPayload(secretsListAccessRequestsStatus string) (*secrets.ListAccessRequestsPayload, error) {
+	var err error
+	var status *string
+	{
+		if secretsListAccessRequestsStatus != "" {
+			status = &secretsListAccessRequestsStatus
+			if !(*status == "pending" || *status == "approved" || *status == "denied") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "approved", "denied"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &secrets.ListAccessRequestsPayload{}
+	v.Status = status
+
+	return v, nil
+}
+
+// BuildApproveAccessRequestPayload builds the payload for the secrets approve
+// access request endpoint from CLI flags.
+func BuildApproveAccessRequestPayload(secretsApproveAccessRequestBody string, secretsApproveAccessRequestID string) (*secrets.ApproveAccessRequestPayload, error) {
+	var err error
+	var body ApproveAccessRequestRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsApproveAccessRequestBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"comment\": \"Granted for the incident\"\n   }'")
+		}
+		if body.Comment != nil {
+			if utf8.RuneCountInString(*body.Comment) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsApproveAccessRequestID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.ApproveAccessRequestPayload{
+		Comment: body.Comment,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDenyAccessRequestPayload builds the payload for the secrets deny access
+// request endpoint from CLI flags.
+func BuildDenyAccessRequestPayload(secretsDenyAccessRequestBody string, secretsDenyAccessRequestID string) (*secrets.DenyAccessRequestPayload, error) {
+	var err error
+	var body DenyAccessRequestRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsDenyAccessRequestBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"comment\": \"Ask your team lead for the shared account instead\"\n   }'")
+		}
+		if body.Comment != nil {
+			if utf8.RuneCountInString(*body.Comment) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsDenyAccessRequestID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.DenyAccessRequestPayload{
+		Comment: body.Comment,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCreateValidationRulePayload builds the payload for the secrets create
+// validation rule endpoint from CLI flags.
+func BuildCreateValidationRulePayload(secretsCreateValidationRuleBody string) (*secrets.CreateValidationRulePayload, error) {
+	var err error
+	var body CreateValidationRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsCreateValidationRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"field\": \"certificate\",\n      \"kind\": \"regex\",\n      \"parameter\": \"^sk_live_[a-zA-Z0-9]{24}$\",\n      \"path_prefix\": \"L2NlcnRpZmljYXRlcy8=\"\n   }'")
+		}
+		if utf8.RuneCountInString(body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", body.PathPrefix, utf8.RuneCountInString(body.PathPrefix), 1, true))
+		}
+		if !(body.Kind == "json_schema" || body.Kind == "regex" || body.Kind == "max_length" || body.Kind == "pem_type" || body.Kind == "x509_certificate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", body.Kind, []any{"json_schema", "regex", "max_length", "pem_type", "x509_certificate"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.CreateValidationRulePayload{
+		PathPrefix: body.PathPrefix,
+		Kind:       body.Kind,
+		Parameter:  body.Parameter,
+		Field:      body.Field,
+	}
+
+	return v, nil
+}
+
+// BuildDeleteValidationRulePayload builds the payload for the secrets delete
+// validation rule endpoint from CLI flags.
+func BuildDeleteValidationRulePayload(secretsDeleteValidationRuleID string) (*secrets.DeleteValidationRulePayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsDeleteValidationRuleID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.DeleteValidationRulePayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCreateBreakGlassRulePayload builds the payload for the secrets create
+// break glass rule endpoint from CLI flags.
+func BuildCreateBreakGlassRulePayload(secretsCreateBreakGlassRuleBody string) (*secrets.CreateBreakGlassRulePayload, error) {
+	var err error
+	var body CreateBreakGlassRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsCreateBreakGlassRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"path_prefix\": \"L3Byb2R1Y3Rpb24v\",\n      \"role_id\": 3\n   }'")
+		}
+		if utf8.RuneCountInString(body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", body.PathPrefix, utf8.RuneCountInString(body.PathPrefix), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.CreateBreakGlassRulePayload{
+		PathPrefix: body.PathPrefix,
+		RoleID:     body.RoleID,
+	}
+
+	return v, nil
+}
+
+// BuildDeleteBreakGlassRulePayload builds the payload for the secrets delete
+// break glass rule endpoint from CLI flags.
+func BuildDeleteBreakGlassRulePayload(secretsDeleteBreakGlassRuleID string) (*secrets.DeleteBreakGlassRulePayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsDeleteBreakGlassRuleID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.DeleteBreakGlassRulePayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildListBreakGlassAccessesPayload builds the payload for the secrets list
+// break glass accesses endpoint from CLI flags.
+func BuildListBreakGlassAccessesPayload(secretsListBreakGlassAccessesUnreviewed string) (*secrets.ListBreakGlassAccessesPayload, error) {
+	var err error
+	var unreviewed bool
+	{
+		if secretsListBreakGlassAccessesUnreviewed != "" {
+			unreviewed, err = strconv.ParseBool(secretsListBreakGlassAccessesUnreviewed)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for unreviewed, must be BOOL")
+			}
+		}
+	}
+	v := &secrets.ListBreakGlassAccessesPayload{}
+	v.Unreviewed = unreviewed
+
+	return v, nil
+}
+
+// BuildReviewBreakGlassAccessPayload builds the payload for the secrets review
+// break glass access endpoint from CLI flags.
+func BuildReviewBreakGlassAccessPayload(secretsReviewBreakGlassAccessBody string, secretsReviewBreakGlassAccessID string) (*secrets.ReviewBreakGlassAccessPayload, error) {
+	var err error
+	var body ReviewBreakGlassAccessRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsReviewBreakGlassAccessBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"comment\": \"Legitimate, incident INC-42\"\n   }'")
+		}
+		if body.Comment != nil {
+			if utf8.RuneCountInString(*body.Comment) > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsReviewBreakGlassAccessID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.ReviewBreakGlassAccessPayload{
+		Comment: body.Comment,
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildGrantFolderAccessPayload builds the payload for the secrets grant
+// folder access endpoint from CLI flags.
+func BuildGrantFolderAccessPayload(secretsGrantFolderAccessBody string) (*secrets.GrantFolderAccessPayload, error) {
+	var err error
+	var body GrantFolderAccessRequestBody
+	{
+		err = json.Unmarshal([]byte(secretsGrantFolderAccessBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"capabilities\": [\n         \"read\",\n         \"read\",\n         \"delete\",\n         \"read\"\n      ],\n      \"path_prefix\": \"L3BheW1lbnRzLw==\",\n      \"role_id\": 1,\n      \"user_id\": 2\n   }'")
+		}
+		if utf8.RuneCountInString(body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", body.PathPrefix, utf8.RuneCountInString(body.PathPrefix), 1, true))
+		}
+		for _, e := range body.Capabilities {
+			if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &secrets.GrantFolderAccessPayload{
+		PathPrefix: body.PathPrefix,
+		UserID:     body.UserID,
+		RoleID:     body.RoleID,
+	}
+	if body.Capabilities != nil {
+		v.Capabilities = make([]string, len(body.Capabilities))
+		for i, val := range body.Capabilities {
+			v.Capabilities[i] = val
+		}
+	}
+	if body.Capabilities == nil {
+		v.Capabilities = []string{"read", "list"}
+	}
+
+	return v, nil
+}
+
+// BuildRevokeFolderAccessPayload builds the payload for the secrets revoke
+// folder access endpoint from CLI flags.
+func BuildRevokeFolderAccessPayload(secretsRevokeFolderAccessID string) (*secrets.RevokeFolderAccessPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(secretsRevokeFolderAccessID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &secrets.RevokeFolderAccessPayload{}
+	v.ID = id
 
 	return v, nil
 }
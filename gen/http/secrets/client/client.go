@@ -11,6 +11,7 @@ import (
 	"context"
 	"net/http"
 
+	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -21,10 +22,38 @@ type Client struct {
 	// secrets endpoint.
 	ListSecretsDoer goahttp.Doer
 
+	// BrowseSecrets Doer is the HTTP client used to make requests to the browse
+	// secrets endpoint.
+	BrowseSecretsDoer goahttp.Doer
+
 	// GetSecretValue Doer is the HTTP client used to make requests to the get
 	// secret value endpoint.
 	GetSecretValueDoer goahttp.Doer
 
+	// BatchGetSecretValues Doer is the HTTP client used to make requests to the
+	// batch get secret values endpoint.
+	BatchGetSecretValuesDoer goahttp.Doer
+
+	// RenderTemplate Doer is the HTTP client used to make requests to the render
+	// template endpoint.
+	RenderTemplateDoer goahttp.Doer
+
+	// UploadSecretFile Doer is the HTTP client used to make requests to the upload
+	// secret file endpoint.
+	UploadSecretFileDoer goahttp.Doer
+
+	// DownloadSecretFile Doer is the HTTP client used to make requests to the
+	// download secret file endpoint.
+	DownloadSecretFileDoer goahttp.Doer
+
+	// ExportSecrets Doer is the HTTP client used to make requests to the export
+	// secrets endpoint.
+	ExportSecretsDoer goahttp.Doer
+
+	// ImportSecrets Doer is the HTTP client used to make requests to the import
+	// secrets endpoint.
+	ImportSecretsDoer goahttp.Doer
+
 	// GetSecret Doer is the HTTP client used to make requests to the get secret
 	// endpoint.
 	GetSecretDoer goahttp.Doer
@@ -37,6 +66,118 @@ type Client struct {
 	// secret endpoint.
 	UpdateSecretDoer goahttp.Doer
 
+	// RegenerateSecret Doer is the HTTP client used to make requests to the
+	// regenerate secret endpoint.
+	RegenerateSecretDoer goahttp.Doer
+
+	// SetSecretRotation Doer is the HTTP client used to make requests to the set
+	// secret rotation endpoint.
+	SetSecretRotationDoer goahttp.Doer
+
+	// ListSecretRotations Doer is the HTTP client used to make requests to the
+	// list secret rotations endpoint.
+	ListSecretRotationsDoer goahttp.Doer
+
+	// DeleteSecret Doer is the HTTP client used to make requests to the delete
+	// secret endpoint.
+	DeleteSecretDoer goahttp.Doer
+
+	// MoveSecrets Doer is the HTTP client used to make requests to the move
+	// secrets endpoint.
+	MoveSecretsDoer goahttp.Doer
+
+	// DiffSecrets Doer is the HTTP client used to make requests to the diff
+	// secrets endpoint.
+	DiffSecretsDoer goahttp.Doer
+
+	// PromoteSecrets Doer is the HTTP client used to make requests to the promote
+	// secrets endpoint.
+	PromoteSecretsDoer goahttp.Doer
+
+	// TransferSecretOwnership Doer is the HTTP client used to make requests to the
+	// transfer secret ownership endpoint.
+	TransferSecretOwnershipDoer goahttp.Doer
+
+	// ListStaleSecrets Doer is the HTTP client used to make requests to the list
+	// stale secrets endpoint.
+	ListStaleSecretsDoer goahttp.Doer
+
+	// SetSecretCanary Doer is the HTTP client used to make requests to the set
+	// secret canary endpoint.
+	SetSecretCanaryDoer goahttp.Doer
+
+	// ListCanarySecrets Doer is the HTTP client used to make requests to the list
+	// canary secrets endpoint.
+	ListCanarySecretsDoer goahttp.Doer
+
+	// WrapSecret Doer is the HTTP client used to make requests to the wrap secret
+	// endpoint.
+	WrapSecretDoer goahttp.Doer
+
+	// UnwrapSecret Doer is the HTTP client used to make requests to the unwrap
+	// secret endpoint.
+	UnwrapSecretDoer goahttp.Doer
+
+	// RequestAccess Doer is the HTTP client used to make requests to the request
+	// access endpoint.
+	RequestAccessDoer goahttp.Doer
+
+	// ListAccessRequests Doer is the HTTP client used to make requests to the list
+	// access requests endpoint.
+	ListAccessRequestsDoer goahttp.Doer
+
+	// ApproveAccessRequest Doer is the HTTP client used to make requests to the
+	// approve access request endpoint.
+	ApproveAccessRequestDoer goahttp.Doer
+
+	// DenyAccessRequest Doer is the HTTP client used to make requests to the deny
+	// access request endpoint.
+	DenyAccessRequestDoer goahttp.Doer
+
+	// ListValidationRules Doer is the HTTP client used to make requests to the
+	// list validation rules endpoint.
+	ListValidationRulesDoer goahttp.Doer
+
+	// CreateValidationRule Doer is the HTTP client used to make requests to the
+	// create validation rule endpoint.
+	CreateValidationRuleDoer goahttp.Doer
+
+	// DeleteValidationRule Doer is the HTTP client used to make requests to the
+	// delete validation rule endpoint.
+	DeleteValidationRuleDoer goahttp.Doer
+
+	// ListBreakGlassRules Doer is the HTTP client used to make requests to the
+	// list break glass rules endpoint.
+	ListBreakGlassRulesDoer goahttp.Doer
+
+	// CreateBreakGlassRule Doer is the HTTP client used to make requests to the
+	// create break glass rule endpoint.
+	CreateBreakGlassRuleDoer goahttp.Doer
+
+	// DeleteBreakGlassRule Doer is the HTTP client used to make requests to the
+	// delete break glass rule endpoint.
+	DeleteBreakGlassRuleDoer goahttp.Doer
+
+	// ListBreakGlassAccesses Doer is the HTTP client used to make requests to the
+	// list break glass accesses endpoint.
+	ListBreakGlassAccessesDoer goahttp.Doer
+
+	// ReviewBreakGlassAccess Doer is the HTTP client used to make requests to the
+	// review break glass access endpoint.
+	ReviewBreakGlassAccessDoer goahttp.Doer
+
+	// ListFolderAccesses Doer is the HTTP client used to make requests to the list
+	// folder accesses endpoint.
+	ListFolderAccessesDoer goahttp.Doer
+
+	// GrantFolderAccess Doer is the HTTP client used to make requests to the grant
+	// folder access endpoint.
+	GrantFolderAccessDoer goahttp.Doer
+
+	// RevokeFolderAccess Doer is the HTTP client used to make requests to the
+	// revoke folder access endpoint.
+	RevokeFolderAccessDoer goahttp.Doer
+
 	// CORS Doer is the HTTP client used to make requests to the  endpoint.
 	CORSDoer goahttp.Doer
 
@@ -60,17 +201,52 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		ListSecretsDoer:     doer,
-		GetSecretValueDoer:  doer,
-		GetSecretDoer:       doer,
-		CreateSecretDoer:    doer,
-		UpdateSecretDoer:    doer,
-		CORSDoer:            doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		ListSecretsDoer:             doer,
+		BrowseSecretsDoer:           doer,
+		GetSecretValueDoer:          doer,
+		BatchGetSecretValuesDoer:    doer,
+		RenderTemplateDoer:          doer,
+		UploadSecretFileDoer:        doer,
+		DownloadSecretFileDoer:      doer,
+		ExportSecretsDoer:           doer,
+		ImportSecretsDoer:           doer,
+		GetSecretDoer:               doer,
+		CreateSecretDoer:            doer,
+		UpdateSecretDoer:            doer,
+		RegenerateSecretDoer:        doer,
+		SetSecretRotationDoer:       doer,
+		ListSecretRotationsDoer:     doer,
+		DeleteSecretDoer:            doer,
+		MoveSecretsDoer:             doer,
+		DiffSecretsDoer:             doer,
+		PromoteSecretsDoer:          doer,
+		TransferSecretOwnershipDoer: doer,
+		ListStaleSecretsDoer:        doer,
+		SetSecretCanaryDoer:         doer,
+		ListCanarySecretsDoer:       doer,
+		WrapSecretDoer:              doer,
+		UnwrapSecretDoer:            doer,
+		RequestAccessDoer:           doer,
+		ListAccessRequestsDoer:      doer,
+		ApproveAccessRequestDoer:    doer,
+		DenyAccessRequestDoer:       doer,
+		ListValidationRulesDoer:     doer,
+		CreateValidationRuleDoer:    doer,
+		DeleteValidationRuleDoer:    doer,
+		ListBreakGlassRulesDoer:     doer,
+		CreateBreakGlassRuleDoer:    doer,
+		DeleteBreakGlassRuleDoer:    doer,
+		ListBreakGlassAccessesDoer:  doer,
+		ReviewBreakGlassAccessDoer:  doer,
+		ListFolderAccessesDoer:      doer,
+		GrantFolderAccessDoer:       doer,
+		RevokeFolderAccessDoer:      doer,
+		CORSDoer:                    doer,
+		RestoreResponseBody:         restoreBody,
+		scheme:                      scheme,
+		host:                        host,
+		decoder:                     dec,
+		encoder:                     enc,
 	}
 }
 
@@ -93,10 +269,35 @@ func (c *Client) ListSecrets() goa.Endpoint {
 	}
 }
 
+// BrowseSecrets returns an endpoint that makes HTTP requests to the secrets
+// service browse secrets server.
+func (c *Client) BrowseSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBrowseSecretsRequest(c.encoder)
+		decodeResponse = DecodeBrowseSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBrowseSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BrowseSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "browse secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetSecretValue returns an endpoint that makes HTTP requests to the secrets
 // service get secret value server.
 func (c *Client) GetSecretValue() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeGetSecretValueRequest(c.encoder)
 		decodeResponse = DecodeGetSecretValueResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -104,6 +305,10 @@ func (c *Client) GetSecretValue() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.GetSecretValueDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("secrets", "get secret value", err)
@@ -112,6 +317,155 @@ func (c *Client) GetSecretValue() goa.Endpoint {
 	}
 }
 
+// BatchGetSecretValues returns an endpoint that makes HTTP requests to the
+// secrets service batch get secret values server.
+func (c *Client) BatchGetSecretValues() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchGetSecretValuesRequest(c.encoder)
+		decodeResponse = DecodeBatchGetSecretValuesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchGetSecretValuesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchGetSecretValuesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "batch get secret values", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RenderTemplate returns an endpoint that makes HTTP requests to the secrets
+// service render template server.
+func (c *Client) RenderTemplate() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRenderTemplateRequest(c.encoder)
+		decodeResponse = DecodeRenderTemplateResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRenderTemplateRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RenderTemplateDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "render template", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UploadSecretFile returns an endpoint that makes HTTP requests to the secrets
+// service upload secret file server.
+func (c *Client) UploadSecretFile() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadSecretFileRequest(c.encoder)
+		decodeResponse = DecodeUploadSecretFileResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadSecretFileRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadSecretFileDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "upload secret file", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DownloadSecretFile returns an endpoint that makes HTTP requests to the
+// secrets service download secret file server.
+func (c *Client) DownloadSecretFile() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDownloadSecretFileResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadSecretFileRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadSecretFileDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "download secret file", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &secrets.DownloadSecretFileResponseData{Result: res.(*secrets.DownloadSecretFileResult), Body: resp.Body}, nil
+	}
+}
+
+// ExportSecrets returns an endpoint that makes HTTP requests to the secrets
+// service export secrets server.
+func (c *Client) ExportSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportSecretsRequest(c.encoder)
+		decodeResponse = DecodeExportSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "export secrets", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &secrets.ExportSecretsResponseData{Result: res.(*secrets.ExportSecretsResult), Body: resp.Body}, nil
+	}
+}
+
+// ImportSecrets returns an endpoint that makes HTTP requests to the secrets
+// service import secrets server.
+func (c *Client) ImportSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportSecretsRequest(c.encoder)
+		decodeResponse = DecodeImportSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "import secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetSecret returns an endpoint that makes HTTP requests to the secrets
 // service get secret server.
 func (c *Client) GetSecret() goa.Endpoint {
@@ -178,3 +532,635 @@ func (c *Client) UpdateSecret() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// RegenerateSecret returns an endpoint that makes HTTP requests to the secrets
+// service regenerate secret server.
+func (c *Client) RegenerateSecret() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRegenerateSecretRequest(c.encoder)
+		decodeResponse = DecodeRegenerateSecretResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRegenerateSecretRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RegenerateSecretDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "regenerate secret", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetSecretRotation returns an endpoint that makes HTTP requests to the
+// secrets service set secret rotation server.
+func (c *Client) SetSecretRotation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetSecretRotationRequest(c.encoder)
+		decodeResponse = DecodeSetSecretRotationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetSecretRotationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetSecretRotationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "set secret rotation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListSecretRotations returns an endpoint that makes HTTP requests to the
+// secrets service list secret rotations server.
+func (c *Client) ListSecretRotations() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListSecretRotationsRequest(c.encoder)
+		decodeResponse = DecodeListSecretRotationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListSecretRotationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListSecretRotationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list secret rotations", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteSecret returns an endpoint that makes HTTP requests to the secrets
+// service delete secret server.
+func (c *Client) DeleteSecret() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteSecretResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteSecretRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteSecretDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "delete secret", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// MoveSecrets returns an endpoint that makes HTTP requests to the secrets
+// service move secrets server.
+func (c *Client) MoveSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeMoveSecretsRequest(c.encoder)
+		decodeResponse = DecodeMoveSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildMoveSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.MoveSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "move secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DiffSecrets returns an endpoint that makes HTTP requests to the secrets
+// service diff secrets server.
+func (c *Client) DiffSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDiffSecretsRequest(c.encoder)
+		decodeResponse = DecodeDiffSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDiffSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DiffSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "diff secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PromoteSecrets returns an endpoint that makes HTTP requests to the secrets
+// service promote secrets server.
+func (c *Client) PromoteSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePromoteSecretsRequest(c.encoder)
+		decodeResponse = DecodePromoteSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPromoteSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PromoteSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "promote secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// TransferSecretOwnership returns an endpoint that makes HTTP requests to the
+// secrets service transfer secret ownership server.
+func (c *Client) TransferSecretOwnership() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeTransferSecretOwnershipRequest(c.encoder)
+		decodeResponse = DecodeTransferSecretOwnershipResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildTransferSecretOwnershipRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.TransferSecretOwnershipDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "transfer secret ownership", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListStaleSecrets returns an endpoint that makes HTTP requests to the secrets
+// service list stale secrets server.
+func (c *Client) ListStaleSecrets() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListStaleSecretsRequest(c.encoder)
+		decodeResponse = DecodeListStaleSecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListStaleSecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListStaleSecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list stale secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetSecretCanary returns an endpoint that makes HTTP requests to the secrets
+// service set secret canary server.
+func (c *Client) SetSecretCanary() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetSecretCanaryRequest(c.encoder)
+		decodeResponse = DecodeSetSecretCanaryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetSecretCanaryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetSecretCanaryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "set secret canary", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListCanarySecrets returns an endpoint that makes HTTP requests to the
+// secrets service list canary secrets server.
+func (c *Client) ListCanarySecrets() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListCanarySecretsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListCanarySecretsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListCanarySecretsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list canary secrets", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// WrapSecret returns an endpoint that makes HTTP requests to the secrets
+// service wrap secret server.
+func (c *Client) WrapSecret() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeWrapSecretRequest(c.encoder)
+		decodeResponse = DecodeWrapSecretResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildWrapSecretRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.WrapSecretDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "wrap secret", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnwrapSecret returns an endpoint that makes HTTP requests to the secrets
+// service unwrap secret server.
+func (c *Client) UnwrapSecret() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnwrapSecretRequest(c.encoder)
+		decodeResponse = DecodeUnwrapSecretResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnwrapSecretRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnwrapSecretDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "unwrap secret", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RequestAccess returns an endpoint that makes HTTP requests to the secrets
+// service request access server.
+func (c *Client) RequestAccess() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequestAccessRequest(c.encoder)
+		decodeResponse = DecodeRequestAccessResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequestAccessRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequestAccessDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "request access", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAccessRequests returns an endpoint that makes HTTP requests to the
+// secrets service list access requests server.
+func (c *Client) ListAccessRequests() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAccessRequestsRequest(c.encoder)
+		decodeResponse = DecodeListAccessRequestsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAccessRequestsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAccessRequestsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list access requests", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ApproveAccessRequest returns an endpoint that makes HTTP requests to the
+// secrets service approve access request server.
+func (c *Client) ApproveAccessRequest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeApproveAccessRequestRequest(c.encoder)
+		decodeResponse = DecodeApproveAccessRequestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildApproveAccessRequestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ApproveAccessRequestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "approve access request", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DenyAccessRequest returns an endpoint that makes HTTP requests to the
+// secrets service deny access request server.
+func (c *Client) DenyAccessRequest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDenyAccessRequestRequest(c.encoder)
+		decodeResponse = DecodeDenyAccessRequestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDenyAccessRequestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DenyAccessRequestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "deny access request", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListValidationRules returns an endpoint that makes HTTP requests to the
+// secrets service list validation rules server.
+func (c *Client) ListValidationRules() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListValidationRulesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListValidationRulesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListValidationRulesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list validation rules", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateValidationRule returns an endpoint that makes HTTP requests to the
+// secrets service create validation rule server.
+func (c *Client) CreateValidationRule() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateValidationRuleRequest(c.encoder)
+		decodeResponse = DecodeCreateValidationRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateValidationRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateValidationRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "create validation rule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteValidationRule returns an endpoint that makes HTTP requests to the
+// secrets service delete validation rule server.
+func (c *Client) DeleteValidationRule() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteValidationRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteValidationRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteValidationRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "delete validation rule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListBreakGlassRules returns an endpoint that makes HTTP requests to the
+// secrets service list break glass rules server.
+func (c *Client) ListBreakGlassRules() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListBreakGlassRulesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListBreakGlassRulesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListBreakGlassRulesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list break glass rules", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateBreakGlassRule returns an endpoint that makes HTTP requests to the
+// secrets service create break glass rule server.
+func (c *Client) CreateBreakGlassRule() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateBreakGlassRuleRequest(c.encoder)
+		decodeResponse = DecodeCreateBreakGlassRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateBreakGlassRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateBreakGlassRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "create break glass rule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteBreakGlassRule returns an endpoint that makes HTTP requests to the
+// secrets service delete break glass rule server.
+func (c *Client) DeleteBreakGlassRule() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteBreakGlassRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteBreakGlassRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteBreakGlassRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "delete break glass rule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListBreakGlassAccesses returns an endpoint that makes HTTP requests to the
+// secrets service list break glass accesses server.
+func (c *Client) ListBreakGlassAccesses() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListBreakGlassAccessesRequest(c.encoder)
+		decodeResponse = DecodeListBreakGlassAccessesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListBreakGlassAccessesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListBreakGlassAccessesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list break glass accesses", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReviewBreakGlassAccess returns an endpoint that makes HTTP requests to the
+// secrets service review break glass access server.
+func (c *Client) ReviewBreakGlassAccess() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeReviewBreakGlassAccessRequest(c.encoder)
+		decodeResponse = DecodeReviewBreakGlassAccessResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReviewBreakGlassAccessRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReviewBreakGlassAccessDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "review break glass access", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListFolderAccesses returns an endpoint that makes HTTP requests to the
+// secrets service list folder accesses server.
+func (c *Client) ListFolderAccesses() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListFolderAccessesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListFolderAccessesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListFolderAccessesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "list folder accesses", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GrantFolderAccess returns an endpoint that makes HTTP requests to the
+// secrets service grant folder access server.
+func (c *Client) GrantFolderAccess() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGrantFolderAccessRequest(c.encoder)
+		decodeResponse = DecodeGrantFolderAccessResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGrantFolderAccessRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GrantFolderAccessDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "grant folder access", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeFolderAccess returns an endpoint that makes HTTP requests to the
+// secrets service revoke folder access server.
+func (c *Client) RevokeFolderAccess() goa.Endpoint {
+	var (
+		decodeResponse = DecodeRevokeFolderAccessResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeFolderAccessRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeFolderAccessDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("secrets", "revoke folder access", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,9 +10,12 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 
 	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
 	goahttp "goa.design/goa/v3/http"
@@ -127,23 +130,13 @@ func DecodeListSecretsResponse(decoder func(*http.Response) goahttp.Decoder, res
 	}
 }
 
-// BuildGetSecretValueRequest instantiates a HTTP request object with method
-// and path set to call the "secrets" service "get secret value" endpoint
-func (c *Client) BuildGetSecretValueRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		path string
-	)
-	{
-		p, ok := v.(*secrets.GetSecretValuePayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("secrets", "get secret value", "*secrets.GetSecretValuePayload", v)
-		}
-		path = p.Path
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSecretValueSecretsPath(path)}
+// BuildBrowseSecretsRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "browse secrets" endpoint
+func (c *Client) BuildBrowseSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BrowseSecretsSecretsPath()}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("secrets", "get secret value", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("secrets", "browse secrets", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -152,17 +145,36 @@ func (c *Client) BuildGetSecretValueRequest(ctx context.Context, v any) (*http.R
 	return req, nil
 }
 
-// DecodeGetSecretValueResponse returns a decoder for responses returned by the
-// secrets get secret value endpoint. restoreBody controls whether the response
+// EncodeBrowseSecretsRequest returns an encoder for requests sent to the
+// secrets browse secrets server.
+func EncodeBrowseSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.BrowseSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "browse secrets", "*secrets.BrowseSecretsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("prefix", p.Prefix)
+		values.Add("recursive", fmt.Sprintf("%v", p.Recursive))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeBrowseSecretsResponse returns a decoder for responses returned by the
+// secrets browse secrets endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
-// DecodeGetSecretValueResponse may return the following errors:
-//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+// DecodeBrowseSecretsResponse may return the following errors:
 //   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
 //   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetSecretValueResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeBrowseSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -179,109 +191,99 @@ func DecodeGetSecretValueResponse(decoder func(*http.Response) goahttp.Decoder,
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetSecretValueResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
-			}
-			res := NewGetSecretValueResultOK(&body)
-			return res, nil
-		case http.StatusNotFound:
-			var (
-				body GetSecretValueSecretNotFoundResponseBody
+				body BrowseSecretsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				return nil, goahttp.ErrDecodingError("secrets", "browse secrets", err)
 			}
-			err = ValidateGetSecretValueSecretNotFoundResponseBody(&body)
+			err = ValidateBrowseSecretsResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				return nil, goahttp.ErrValidationError("secrets", "browse secrets", err)
 			}
-			return nil, NewGetSecretValueSecretNotFound(&body)
+			res := NewBrowseSecretsResultOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body GetSecretValueInvalidParametersResponseBody
+				body BrowseSecretsInvalidParametersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				return nil, goahttp.ErrDecodingError("secrets", "browse secrets", err)
 			}
-			err = ValidateGetSecretValueInvalidParametersResponseBody(&body)
+			err = ValidateBrowseSecretsInvalidParametersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				return nil, goahttp.ErrValidationError("secrets", "browse secrets", err)
 			}
-			return nil, NewGetSecretValueInvalidParameters(&body)
+			return nil, NewBrowseSecretsInvalidParameters(&body)
 		case http.StatusUnauthorized:
 			var (
-				body GetSecretValueUnauthorizedResponseBody
+				body BrowseSecretsUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				return nil, goahttp.ErrDecodingError("secrets", "browse secrets", err)
 			}
-			err = ValidateGetSecretValueUnauthorizedResponseBody(&body)
+			err = ValidateBrowseSecretsUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				return nil, goahttp.ErrValidationError("secrets", "browse secrets", err)
 			}
-			return nil, NewGetSecretValueUnauthorized(&body)
+			return nil, NewBrowseSecretsUnauthorized(&body)
 		case http.StatusForbidden:
 			var (
-				body GetSecretValueForbiddenResponseBody
+				body BrowseSecretsForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				return nil, goahttp.ErrDecodingError("secrets", "browse secrets", err)
 			}
-			err = ValidateGetSecretValueForbiddenResponseBody(&body)
+			err = ValidateBrowseSecretsForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				return nil, goahttp.ErrValidationError("secrets", "browse secrets", err)
 			}
-			return nil, NewGetSecretValueForbidden(&body)
+			return nil, NewBrowseSecretsForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetSecretValueInternalErrorResponseBody
+				body BrowseSecretsInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				return nil, goahttp.ErrDecodingError("secrets", "browse secrets", err)
 			}
-			err = ValidateGetSecretValueInternalErrorResponseBody(&body)
+			err = ValidateBrowseSecretsInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				return nil, goahttp.ErrValidationError("secrets", "browse secrets", err)
 			}
-			return nil, NewGetSecretValueInternalError(&body)
+			return nil, NewBrowseSecretsInternalError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("secrets", "get secret value", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("secrets", "browse secrets", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetSecretRequest instantiates a HTTP request object with method and
-// path set to call the "secrets" service "get secret" endpoint
-func (c *Client) BuildGetSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetSecretValueRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "get secret value" endpoint
+func (c *Client) BuildGetSecretValueRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		path string
 	)
 	{
-		p, ok := v.(*secrets.GetSecretPayload)
+		p, ok := v.(*secrets.GetSecretValuePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("secrets", "get secret", "*secrets.GetSecretPayload", v)
+			return nil, goahttp.ErrInvalidType("secrets", "get secret value", "*secrets.GetSecretValuePayload", v)
 		}
 		path = p.Path
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSecretSecretsPath(path)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSecretValueSecretsPath(path)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("secrets", "get secret", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("secrets", "get secret value", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -290,17 +292,40 @@ func (c *Client) BuildGetSecretRequest(ctx context.Context, v any) (*http.Reques
 	return req, nil
 }
 
-// DecodeGetSecretResponse returns a decoder for responses returned by the
-// secrets get secret endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeGetSecretResponse may return the following errors:
+// EncodeGetSecretValueRequest returns an encoder for requests sent to the
+// secrets get secret value server.
+func EncodeGetSecretValueRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.GetSecretValuePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "get secret value", "*secrets.GetSecretValuePayload", v)
+		}
+		if p.BreakGlassJustification != nil {
+			head := *p.BreakGlassJustification
+			req.Header.Set("X-Break-Glass-Justification", head)
+		}
+		values := req.URL.Query()
+		if p.Field != nil {
+			values.Add("field", *p.Field)
+		}
+		values.Add("raw", fmt.Sprintf("%v", p.Raw))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetSecretValueResponse returns a decoder for responses returned by the
+// secrets get secret value endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetSecretValueResponse may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "field_not_found" (type *goa.ServiceError): http.StatusNotFound
 //   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
 //   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeGetSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetSecretValueResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -317,103 +342,121 @@ func DecodeGetSecretResponse(decoder func(*http.Response) goahttp.Decoder, resto
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetSecretResponseBody
+				body GetSecretValueResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
-			}
-			err = ValidateGetSecretResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
 			}
-			res := NewGetSecretSecretInfoOK(&body)
+			res := NewGetSecretValueResultOK(&body)
 			return res, nil
 		case http.StatusNotFound:
-			var (
-				body GetSecretSecretNotFoundResponseBody
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
-			}
-			err = ValidateGetSecretSecretNotFoundResponseBody(&body)
-			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_not_found":
+				var (
+					body GetSecretValueSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				}
+				err = ValidateGetSecretValueSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				}
+				return nil, NewGetSecretValueSecretNotFound(&body)
+			case "field_not_found":
+				var (
+					body GetSecretValueFieldNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
+				}
+				err = ValidateGetSecretValueFieldNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
+				}
+				return nil, NewGetSecretValueFieldNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "get secret value", resp.StatusCode, string(body))
 			}
-			return nil, NewGetSecretSecretNotFound(&body)
 		case http.StatusBadRequest:
 			var (
-				body GetSecretInvalidParametersResponseBody
+				body GetSecretValueInvalidParametersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
 			}
-			err = ValidateGetSecretInvalidParametersResponseBody(&body)
+			err = ValidateGetSecretValueInvalidParametersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
 			}
-			return nil, NewGetSecretInvalidParameters(&body)
+			return nil, NewGetSecretValueInvalidParameters(&body)
 		case http.StatusUnauthorized:
 			var (
-				body GetSecretUnauthorizedResponseBody
+				body GetSecretValueUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
 			}
-			err = ValidateGetSecretUnauthorizedResponseBody(&body)
+			err = ValidateGetSecretValueUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
 			}
-			return nil, NewGetSecretUnauthorized(&body)
+			return nil, NewGetSecretValueUnauthorized(&body)
 		case http.StatusForbidden:
 			var (
-				body GetSecretForbiddenResponseBody
+				body GetSecretValueForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
 			}
-			err = ValidateGetSecretForbiddenResponseBody(&body)
+			err = ValidateGetSecretValueForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
 			}
-			return nil, NewGetSecretForbidden(&body)
+			return nil, NewGetSecretValueForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body GetSecretInternalErrorResponseBody
+				body GetSecretValueInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "get secret value", err)
 			}
-			err = ValidateGetSecretInternalErrorResponseBody(&body)
+			err = ValidateGetSecretValueInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "get secret value", err)
 			}
-			return nil, NewGetSecretInternalError(&body)
+			return nil, NewGetSecretValueInternalError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("secrets", "get secret", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("secrets", "get secret value", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateSecretRequest instantiates a HTTP request object with method and
-// path set to call the "secrets" service "create secret" endpoint
-func (c *Client) BuildCreateSecretRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSecretSecretsPath()}
+// BuildBatchGetSecretValuesRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "batch get secret values"
+// endpoint
+func (c *Client) BuildBatchGetSecretValuesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchGetSecretValuesSecretsPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("secrets", "create secret", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("secrets", "batch get secret values", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -422,32 +465,32 @@ func (c *Client) BuildCreateSecretRequest(ctx context.Context, v any) (*http.Req
 	return req, nil
 }
 
-// EncodeCreateSecretRequest returns an encoder for requests sent to the
-// secrets create secret server.
-func EncodeCreateSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeBatchGetSecretValuesRequest returns an encoder for requests sent to
+// the secrets batch get secret values server.
+func EncodeBatchGetSecretValuesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*secrets.CreateSecretPayload)
+		p, ok := v.(*secrets.BatchGetSecretValuesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("secrets", "create secret", "*secrets.CreateSecretPayload", v)
+			return goahttp.ErrInvalidType("secrets", "batch get secret values", "*secrets.BatchGetSecretValuesPayload", v)
 		}
-		body := NewCreateSecretRequestBody(p)
+		body := NewBatchGetSecretValuesRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("secrets", "create secret", err)
+			return goahttp.ErrEncodingError("secrets", "batch get secret values", err)
 		}
 		return nil
 	}
 }
 
-// DecodeCreateSecretResponse returns a decoder for responses returned by the
-// secrets create secret endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeCreateSecretResponse may return the following errors:
+// DecodeBatchGetSecretValuesResponse returns a decoder for responses returned
+// by the secrets batch get secret values endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeBatchGetSecretValuesResponse may return the following errors:
 //   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
 //   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeCreateSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeBatchGetSecretValuesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -462,78 +505,91 @@ func DecodeCreateSecretResponse(decoder func(*http.Response) goahttp.Decoder, re
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body BatchGetSecretValuesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "batch get secret values", err)
+			}
+			err = ValidateBatchGetSecretValuesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "batch get secret values", err)
+			}
+			res := NewBatchGetSecretValuesResultOK(&body)
+			return res, nil
 		case http.StatusBadRequest:
 			var (
-				body CreateSecretInvalidParametersResponseBody
+				body BatchGetSecretValuesInvalidParametersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "batch get secret values", err)
 			}
-			err = ValidateCreateSecretInvalidParametersResponseBody(&body)
+			err = ValidateBatchGetSecretValuesInvalidParametersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "batch get secret values", err)
 			}
-			return nil, NewCreateSecretInvalidParameters(&body)
+			return nil, NewBatchGetSecretValuesInvalidParameters(&body)
 		case http.StatusUnauthorized:
 			var (
-				body CreateSecretUnauthorizedResponseBody
+				body BatchGetSecretValuesUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "batch get secret values", err)
 			}
-			err = ValidateCreateSecretUnauthorizedResponseBody(&body)
+			err = ValidateBatchGetSecretValuesUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "batch get secret values", err)
 			}
-			return nil, NewCreateSecretUnauthorized(&body)
+			return nil, NewBatchGetSecretValuesUnauthorized(&body)
 		case http.StatusForbidden:
 			var (
-				body CreateSecretForbiddenResponseBody
+				body BatchGetSecretValuesForbiddenResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "batch get secret values", err)
 			}
-			err = ValidateCreateSecretForbiddenResponseBody(&body)
+			err = ValidateBatchGetSecretValuesForbiddenResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "batch get secret values", err)
 			}
-			return nil, NewCreateSecretForbidden(&body)
+			return nil, NewBatchGetSecretValuesForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body CreateSecretInternalErrorResponseBody
+				body BatchGetSecretValuesInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "batch get secret values", err)
 			}
-			err = ValidateCreateSecretInternalErrorResponseBody(&body)
+			err = ValidateBatchGetSecretValuesInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "batch get secret values", err)
 			}
-			return nil, NewCreateSecretInternalError(&body)
+			return nil, NewBatchGetSecretValuesInternalError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("secrets", "create secret", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("secrets", "batch get secret values", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateSecretRequest instantiates a HTTP request object with method and
-// path set to call the "secrets" service "update secret" endpoint
-func (c *Client) BuildUpdateSecretRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSecretSecretsPath()}
-	req, err := http.NewRequest("PATCH", u.String(), nil)
+// BuildRenderTemplateRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "render template" endpoint
+func (c *Client) BuildRenderTemplateRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RenderTemplateSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("secrets", "update secret", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("secrets", "render template", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -542,33 +598,34 @@ func (c *Client) BuildUpdateSecretRequest(ctx context.Context, v any) (*http.Req
 	return req, nil
 }
 
-// EncodeUpdateSecretRequest returns an encoder for requests sent to the
-// secrets update secret server.
-func EncodeUpdateSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRenderTemplateRequest returns an encoder for requests sent to the
+// secrets render template server.
+func EncodeRenderTemplateRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*secrets.UpdateSecretPayload)
+		p, ok := v.(*secrets.RenderTemplatePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("secrets", "update secret", "*secrets.UpdateSecretPayload", v)
+			return goahttp.ErrInvalidType("secrets", "render template", "*secrets.RenderTemplatePayload", v)
 		}
-		body := NewUpdateSecretRequestBody(p)
+		body := NewRenderTemplateRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("secrets", "update secret", err)
+			return goahttp.ErrEncodingError("secrets", "render template", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateSecretResponse returns a decoder for responses returned by the
-// secrets update secret endpoint. restoreBody controls whether the response
+// DecodeRenderTemplateResponse returns a decoder for responses returned by the
+// secrets render template endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
-// DecodeUpdateSecretResponse may return the following errors:
+// DecodeRenderTemplateResponse may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "field_not_found" (type *goa.ServiceError): http.StatusNotFound
 //   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
 //   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
 //   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - error: internal error
-func DecodeUpdateSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRenderTemplateResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -583,50 +640,1245 @@ func DecodeUpdateSecretResponse(decoder func(*http.Response) goahttp.Decoder, re
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
-			return nil, nil
-		case http.StatusNotFound:
+		case http.StatusOK:
 			var (
-				body UpdateSecretSecretNotFoundResponseBody
+				body RenderTemplateResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "render template", err)
 			}
-			err = ValidateUpdateSecretSecretNotFoundResponseBody(&body)
+			err = ValidateRenderTemplateResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "render template", err)
+			}
+			res := NewRenderTemplateResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_not_found":
+				var (
+					body RenderTemplateSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "render template", err)
+				}
+				err = ValidateRenderTemplateSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "render template", err)
+				}
+				return nil, NewRenderTemplateSecretNotFound(&body)
+			case "field_not_found":
+				var (
+					body RenderTemplateFieldNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "render template", err)
+				}
+				err = ValidateRenderTemplateFieldNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "render template", err)
+				}
+				return nil, NewRenderTemplateFieldNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "render template", resp.StatusCode, string(body))
 			}
-			return nil, NewUpdateSecretSecretNotFound(&body)
 		case http.StatusBadRequest:
 			var (
-				body UpdateSecretInvalidParametersResponseBody
+				body RenderTemplateInvalidParametersResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "render template", err)
 			}
-			err = ValidateUpdateSecretInvalidParametersResponseBody(&body)
+			err = ValidateRenderTemplateInvalidParametersResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "render template", err)
 			}
-			return nil, NewUpdateSecretInvalidParameters(&body)
+			return nil, NewRenderTemplateInvalidParameters(&body)
 		case http.StatusUnauthorized:
 			var (
-				body UpdateSecretUnauthorizedResponseBody
+				body RenderTemplateUnauthorizedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "render template", err)
 			}
-			err = ValidateUpdateSecretUnauthorizedResponseBody(&body)
+			err = ValidateRenderTemplateUnauthorizedResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "render template", err)
 			}
-			return nil, NewUpdateSecretUnauthorized(&body)
+			return nil, NewRenderTemplateUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body RenderTemplateForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "render template", err)
+			}
+			err = ValidateRenderTemplateForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "render template", err)
+			}
+			return nil, NewRenderTemplateForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RenderTemplateInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "render template", err)
+			}
+			err = ValidateRenderTemplateInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "render template", err)
+			}
+			return nil, NewRenderTemplateInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "render template", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUploadSecretFileRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "upload secret file" endpoint
+func (c *Client) BuildUploadSecretFileRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+		body io.Reader
+	)
+	{
+		rd, ok := v.(*secrets.UploadSecretFileRequestData)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "upload secret file", "secrets.UploadSecretFileRequestData", v)
+		}
+		p := rd.Payload
+		body = rd.Body
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadSecretFileSecretsPath(path)}
+	req, err := http.NewRequest("PUT", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "upload secret file", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadSecretFileRequest returns an encoder for requests sent to the
+// secrets upload secret file server.
+func EncodeUploadSecretFileRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*secrets.UploadSecretFileRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "upload secret file", "*secrets.UploadSecretFileRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.ContentType
+			req.Header.Set("Content-Type", head)
+		}
+		values := req.URL.Query()
+		if p.Filename != nil {
+			values.Add("filename", *p.Filename)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeUploadSecretFileResponse returns a decoder for responses returned by
+// the secrets upload secret file endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUploadSecretFileResponse may return the following errors:
+//   - "secret_too_large" (type *goa.ServiceError): http.StatusRequestEntityTooLarge
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUploadSecretFileResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body UploadSecretFileSecretTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "upload secret file", err)
+			}
+			err = ValidateUploadSecretFileSecretTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "upload secret file", err)
+			}
+			return nil, NewUploadSecretFileSecretTooLarge(&body)
+		case http.StatusBadRequest:
+			var (
+				body UploadSecretFileInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "upload secret file", err)
+			}
+			err = ValidateUploadSecretFileInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "upload secret file", err)
+			}
+			return nil, NewUploadSecretFileInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UploadSecretFileUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "upload secret file", err)
+			}
+			err = ValidateUploadSecretFileUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "upload secret file", err)
+			}
+			return nil, NewUploadSecretFileUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body UploadSecretFileForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "upload secret file", err)
+			}
+			err = ValidateUploadSecretFileForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "upload secret file", err)
+			}
+			return nil, NewUploadSecretFileForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UploadSecretFileInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "upload secret file", err)
+			}
+			err = ValidateUploadSecretFileInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "upload secret file", err)
+			}
+			return nil, NewUploadSecretFileInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "upload secret file", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadSecretFileStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildUploadSecretFileStreamPayload(payload any, fpath string) (*secrets.UploadSecretFileRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &secrets.UploadSecretFileRequestData{
+		Payload: payload.(*secrets.UploadSecretFilePayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildDownloadSecretFileRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "download secret file"
+// endpoint
+func (c *Client) BuildDownloadSecretFileRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.DownloadSecretFilePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "download secret file", "*secrets.DownloadSecretFilePayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadSecretFileSecretsPath(path)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "download secret file", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDownloadSecretFileResponse returns a decoder for responses returned by
+// the secrets download secret file endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDownloadSecretFileResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDownloadSecretFileResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				contentLength      int64
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("secrets", "download secret file", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			res := NewDownloadSecretFileResultOK(contentType, contentDisposition, contentLength)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DownloadSecretFileSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "download secret file", err)
+			}
+			err = ValidateDownloadSecretFileSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			return nil, NewDownloadSecretFileSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body DownloadSecretFileInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "download secret file", err)
+			}
+			err = ValidateDownloadSecretFileInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			return nil, NewDownloadSecretFileInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DownloadSecretFileUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "download secret file", err)
+			}
+			err = ValidateDownloadSecretFileUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			return nil, NewDownloadSecretFileUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DownloadSecretFileForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "download secret file", err)
+			}
+			err = ValidateDownloadSecretFileForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			return nil, NewDownloadSecretFileForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DownloadSecretFileInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "download secret file", err)
+			}
+			err = ValidateDownloadSecretFileInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "download secret file", err)
+			}
+			return nil, NewDownloadSecretFileInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "download secret file", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportSecretsRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "export secrets" endpoint
+func (c *Client) BuildExportSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportSecretsSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "export secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportSecretsRequest returns an encoder for requests sent to the
+// secrets export secrets server.
+func EncodeExportSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ExportSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "export secrets", "*secrets.ExportSecretsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("prefix", p.Prefix)
+		values.Add("format", p.Format)
+		if p.KeyNaming != nil {
+			values.Add("key_naming", *p.KeyNaming)
+		}
+		values.Add("raw", fmt.Sprintf("%v", p.Raw))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportSecretsResponse returns a decoder for responses returned by the
+// secrets export secrets endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeExportSecretsResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "field_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeExportSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				contentLength      int64
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("secrets", "export secrets", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+			}
+			res := NewExportSecretsResultOK(contentType, contentDisposition, contentLength)
+			return res, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_not_found":
+				var (
+					body ExportSecretsSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+				}
+				err = ValidateExportSecretsSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+				}
+				return nil, NewExportSecretsSecretNotFound(&body)
+			case "field_not_found":
+				var (
+					body ExportSecretsFieldNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+				}
+				err = ValidateExportSecretsFieldNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+				}
+				return nil, NewExportSecretsFieldNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "export secrets", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body ExportSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+			}
+			err = ValidateExportSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+			}
+			return nil, NewExportSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ExportSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+			}
+			err = ValidateExportSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+			}
+			return nil, NewExportSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ExportSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+			}
+			err = ValidateExportSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+			}
+			return nil, NewExportSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ExportSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "export secrets", err)
+			}
+			err = ValidateExportSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "export secrets", err)
+			}
+			return nil, NewExportSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "export secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportSecretsRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "import secrets" endpoint
+func (c *Client) BuildImportSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*secrets.ImportSecretsRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("secrets", "import secrets", "secrets.ImportSecretsRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportSecretsSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "import secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportSecretsRequest returns an encoder for requests sent to the
+// secrets import secrets server.
+func EncodeImportSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*secrets.ImportSecretsRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "import secrets", "*secrets.ImportSecretsRequestData", v)
+		}
+		p := data.Payload
+		values := req.URL.Query()
+		values.Add("prefix", p.Prefix)
+		values.Add("format", p.Format)
+		if p.KeyNaming != nil {
+			values.Add("key_naming", *p.KeyNaming)
+		}
+		values.Add("on_existing", p.OnExisting)
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeImportSecretsResponse returns a decoder for responses returned by the
+// secrets import secrets endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeImportSecretsResponse may return the following errors:
+//   - "secret_already_exists" (type *goa.ServiceError): http.StatusConflict
+//   - "secret_too_large" (type *goa.ServiceError): http.StatusRequestEntityTooLarge
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeImportSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportSecretsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateImportedSecretResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			res := NewImportSecretsImportedSecretOK(body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body ImportSecretsSecretAlreadyExistsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsSecretAlreadyExistsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsSecretAlreadyExists(&body)
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body ImportSecretsSecretTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsSecretTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsSecretTooLarge(&body)
+		case http.StatusBadRequest:
+			var (
+				body ImportSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ImportSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ImportSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ImportSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "import secrets", err)
+			}
+			err = ValidateImportSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "import secrets", err)
+			}
+			return nil, NewImportSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "import secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildImportSecretsStreamPayload creates a streaming endpoint request payload
+// from the method payload and the path to the file to be streamed
+func BuildImportSecretsStreamPayload(payload any, fpath string) (*secrets.ImportSecretsRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &secrets.ImportSecretsRequestData{
+		Payload: payload.(*secrets.ImportSecretsPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildGetSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "get secret" endpoint
+func (c *Client) BuildGetSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.GetSecretPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "get secret", "*secrets.GetSecretPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetSecretSecretsPath(path)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "get secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetSecretResponse returns a decoder for responses returned by the
+// secrets get secret endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetSecretResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGetSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetSecretResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			res := NewGetSecretSecretInfoOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetSecretSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			return nil, NewGetSecretSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body GetSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			return nil, NewGetSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			return nil, NewGetSecretUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body GetSecretForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			return nil, NewGetSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body GetSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "get secret", err)
+			}
+			err = ValidateGetSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "get secret", err)
+			}
+			return nil, NewGetSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "get secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "create secret" endpoint
+func (c *Client) BuildCreateSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateSecretSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "create secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateSecretRequest returns an encoder for requests sent to the
+// secrets create secret server.
+func EncodeCreateSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.CreateSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "create secret", "*secrets.CreateSecretPayload", v)
+		}
+		body := NewCreateSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "create secret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateSecretResponse returns a decoder for responses returned by the
+// secrets create secret endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateSecretResponse may return the following errors:
+//   - "secret_too_large" (type *goa.ServiceError): http.StatusRequestEntityTooLarge
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			return nil, nil
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body CreateSecretSecretTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+			}
+			err = ValidateCreateSecretSecretTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+			}
+			return nil, NewCreateSecretSecretTooLarge(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+			}
+			err = ValidateCreateSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+			}
+			return nil, NewCreateSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+			}
+			err = ValidateCreateSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+			}
+			return nil, NewCreateSecretUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateSecretForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+			}
+			err = ValidateCreateSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+			}
+			return nil, NewCreateSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create secret", err)
+			}
+			err = ValidateCreateSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create secret", err)
+			}
+			return nil, NewCreateSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "create secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "update secret" endpoint
+func (c *Client) BuildUpdateSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateSecretSecretsPath()}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "update secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateSecretRequest returns an encoder for requests sent to the
+// secrets update secret server.
+func EncodeUpdateSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.UpdateSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "update secret", "*secrets.UpdateSecretPayload", v)
+		}
+		body := NewUpdateSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "update secret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateSecretResponse returns a decoder for responses returned by the
+// secrets update secret endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdateSecretResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "field_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "secret_too_large" (type *goa.ServiceError): http.StatusRequestEntityTooLarge
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUpdateSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			return nil, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_not_found":
+				var (
+					body UpdateSecretSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				}
+				err = ValidateUpdateSecretSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				}
+				return nil, NewUpdateSecretSecretNotFound(&body)
+			case "field_not_found":
+				var (
+					body UpdateSecretFieldNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				}
+				err = ValidateUpdateSecretFieldNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				}
+				return nil, NewUpdateSecretFieldNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "update secret", resp.StatusCode, string(body))
+			}
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body UpdateSecretSecretTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+			}
+			err = ValidateUpdateSecretSecretTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+			}
+			return nil, NewUpdateSecretSecretTooLarge(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+			}
+			err = ValidateUpdateSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+			}
+			return nil, NewUpdateSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+			}
+			err = ValidateUpdateSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+			}
+			return nil, NewUpdateSecretUnauthorized(&body)
 		case http.StatusForbidden:
 			var (
 				body UpdateSecretForbiddenResponseBody
@@ -634,30 +1886,4020 @@ func DecodeUpdateSecretResponse(decoder func(*http.Response) goahttp.Decoder, re
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+			}
+			err = ValidateUpdateSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+			}
+			return nil, NewUpdateSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+			}
+			err = ValidateUpdateSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+			}
+			return nil, NewUpdateSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "update secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRegenerateSecretRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "regenerate secret" endpoint
+func (c *Client) BuildRegenerateSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.RegenerateSecretPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "regenerate secret", "*secrets.RegenerateSecretPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegenerateSecretSecretsPath(path)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "regenerate secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRegenerateSecretRequest returns an encoder for requests sent to the
+// secrets regenerate secret server.
+func EncodeRegenerateSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.RegenerateSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "regenerate secret", "*secrets.RegenerateSecretPayload", v)
+		}
+		body := NewRegenerateSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "regenerate secret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRegenerateSecretResponse returns a decoder for responses returned by
+// the secrets regenerate secret endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRegenerateSecretResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRegenerateSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RegenerateSecretResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			res := NewRegenerateSecretResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body RegenerateSecretSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			return nil, NewRegenerateSecretSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body RegenerateSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			return nil, NewRegenerateSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RegenerateSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			return nil, NewRegenerateSecretUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body RegenerateSecretForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			return nil, NewRegenerateSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RegenerateSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "regenerate secret", err)
+			}
+			err = ValidateRegenerateSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "regenerate secret", err)
+			}
+			return nil, NewRegenerateSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "regenerate secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetSecretRotationRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "set secret rotation" endpoint
+func (c *Client) BuildSetSecretRotationRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.SetSecretRotationPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "set secret rotation", "*secrets.SetSecretRotationPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetSecretRotationSecretsPath(path)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "set secret rotation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetSecretRotationRequest returns an encoder for requests sent to the
+// secrets set secret rotation server.
+func EncodeSetSecretRotationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.SetSecretRotationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "set secret rotation", "*secrets.SetSecretRotationPayload", v)
+		}
+		body := NewSetSecretRotationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "set secret rotation", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetSecretRotationResponse returns a decoder for responses returned by
+// the secrets set secret rotation endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSetSecretRotationResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetSecretRotationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetSecretRotationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			res := NewSetSecretRotationResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body SetSecretRotationSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			err = ValidateSetSecretRotationSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret rotation", err)
+			}
+			return nil, NewSetSecretRotationSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body SetSecretRotationInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			err = ValidateSetSecretRotationInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret rotation", err)
+			}
+			return nil, NewSetSecretRotationInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetSecretRotationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			err = ValidateSetSecretRotationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret rotation", err)
+			}
+			return nil, NewSetSecretRotationUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body SetSecretRotationForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			err = ValidateSetSecretRotationForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret rotation", err)
+			}
+			return nil, NewSetSecretRotationForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetSecretRotationInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret rotation", err)
+			}
+			err = ValidateSetSecretRotationInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret rotation", err)
+			}
+			return nil, NewSetSecretRotationInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "set secret rotation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListSecretRotationsRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list secret rotations"
+// endpoint
+func (c *Client) BuildListSecretRotationsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.ListSecretRotationsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "list secret rotations", "*secrets.ListSecretRotationsPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListSecretRotationsSecretsPath(path)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list secret rotations", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListSecretRotationsRequest returns an encoder for requests sent to the
+// secrets list secret rotations server.
+func EncodeListSecretRotationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ListSecretRotationsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "list secret rotations", "*secrets.ListSecretRotationsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListSecretRotationsResponse returns a decoder for responses returned
+// by the secrets list secret rotations endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListSecretRotationsResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListSecretRotationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListSecretRotationsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSecretRotationResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			res := NewListSecretRotationsSecretRotationOK(body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListSecretRotationsSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			err = ValidateListSecretRotationsSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			return nil, NewListSecretRotationsSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListSecretRotationsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			err = ValidateListSecretRotationsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			return nil, NewListSecretRotationsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListSecretRotationsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			err = ValidateListSecretRotationsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			return nil, NewListSecretRotationsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListSecretRotationsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			err = ValidateListSecretRotationsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			return nil, NewListSecretRotationsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListSecretRotationsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list secret rotations", err)
+			}
+			err = ValidateListSecretRotationsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list secret rotations", err)
+			}
+			return nil, NewListSecretRotationsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list secret rotations", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "delete secret" endpoint
+func (c *Client) BuildDeleteSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.DeleteSecretPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "delete secret", "*secrets.DeleteSecretPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteSecretSecretsPath(path)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "delete secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteSecretResponse returns a decoder for responses returned by the
+// secrets delete secret endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteSecretResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteSecretSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete secret", err)
+			}
+			err = ValidateDeleteSecretSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete secret", err)
+			}
+			return nil, NewDeleteSecretSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body DeleteSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete secret", err)
+			}
+			err = ValidateDeleteSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete secret", err)
+			}
+			return nil, NewDeleteSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete secret", err)
+			}
+			err = ValidateDeleteSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete secret", err)
+			}
+			return nil, NewDeleteSecretUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteSecretForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete secret", err)
+			}
+			err = ValidateDeleteSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete secret", err)
+			}
+			return nil, NewDeleteSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete secret", err)
+			}
+			err = ValidateDeleteSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete secret", err)
+			}
+			return nil, NewDeleteSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "delete secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildMoveSecretsRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "move secrets" endpoint
+func (c *Client) BuildMoveSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: MoveSecretsSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "move secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeMoveSecretsRequest returns an encoder for requests sent to the secrets
+// move secrets server.
+func EncodeMoveSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.MoveSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "move secrets", "*secrets.MoveSecretsPayload", v)
+		}
+		body := NewMoveSecretsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "move secrets", err)
+		}
+		return nil
+	}
+}
+
+// DecodeMoveSecretsResponse returns a decoder for responses returned by the
+// secrets move secrets endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeMoveSecretsResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "secret_already_exists" (type *goa.ServiceError): http.StatusConflict
+//   - "folder_changed" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeMoveSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			return body, nil
+		case http.StatusNotFound:
+			var (
+				body MoveSecretsSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			err = ValidateMoveSecretsSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+			}
+			return nil, NewMoveSecretsSecretNotFound(&body)
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_already_exists":
+				var (
+					body MoveSecretsSecretAlreadyExistsResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+				}
+				err = ValidateMoveSecretsSecretAlreadyExistsResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+				}
+				return nil, NewMoveSecretsSecretAlreadyExists(&body)
+			case "folder_changed":
+				var (
+					body MoveSecretsFolderChangedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+				}
+				err = ValidateMoveSecretsFolderChangedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+				}
+				return nil, NewMoveSecretsFolderChanged(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "move secrets", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body MoveSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			err = ValidateMoveSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+			}
+			return nil, NewMoveSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body MoveSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			err = ValidateMoveSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+			}
+			return nil, NewMoveSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body MoveSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			err = ValidateMoveSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+			}
+			return nil, NewMoveSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body MoveSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "move secrets", err)
+			}
+			err = ValidateMoveSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "move secrets", err)
+			}
+			return nil, NewMoveSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "move secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDiffSecretsRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "diff secrets" endpoint
+func (c *Client) BuildDiffSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DiffSecretsSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "diff secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDiffSecretsRequest returns an encoder for requests sent to the secrets
+// diff secrets server.
+func EncodeDiffSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.DiffSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "diff secrets", "*secrets.DiffSecretsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("source", p.Source)
+		values.Add("destination", p.Destination)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDiffSecretsResponse returns a decoder for responses returned by the
+// secrets diff secrets endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDiffSecretsResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDiffSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DiffSecretsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "diff secrets", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateSecretDiffResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "diff secrets", err)
+			}
+			res := NewDiffSecretsSecretDiffOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body DiffSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "diff secrets", err)
+			}
+			err = ValidateDiffSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "diff secrets", err)
+			}
+			return nil, NewDiffSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DiffSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "diff secrets", err)
+			}
+			err = ValidateDiffSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "diff secrets", err)
+			}
+			return nil, NewDiffSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DiffSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "diff secrets", err)
+			}
+			err = ValidateDiffSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "diff secrets", err)
+			}
+			return nil, NewDiffSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DiffSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "diff secrets", err)
+			}
+			err = ValidateDiffSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "diff secrets", err)
+			}
+			return nil, NewDiffSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "diff secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPromoteSecretsRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "promote secrets" endpoint
+func (c *Client) BuildPromoteSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PromoteSecretsSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "promote secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePromoteSecretsRequest returns an encoder for requests sent to the
+// secrets promote secrets server.
+func EncodePromoteSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.PromoteSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "promote secrets", "*secrets.PromoteSecretsPayload", v)
+		}
+		body := NewPromoteSecretsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "promote secrets", err)
+		}
+		return nil
+	}
+}
+
+// DecodePromoteSecretsResponse returns a decoder for responses returned by the
+// secrets promote secrets endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodePromoteSecretsResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "secret_already_exists" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodePromoteSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			return body, nil
+		case http.StatusNotFound:
+			var (
+				body PromoteSecretsSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsSecretNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body PromoteSecretsSecretAlreadyExistsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsSecretAlreadyExistsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsSecretAlreadyExists(&body)
+		case http.StatusBadRequest:
+			var (
+				body PromoteSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body PromoteSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body PromoteSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body PromoteSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "promote secrets", err)
+			}
+			err = ValidatePromoteSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "promote secrets", err)
+			}
+			return nil, NewPromoteSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "promote secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildTransferSecretOwnershipRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "transfer secret
+// ownership" endpoint
+func (c *Client) BuildTransferSecretOwnershipRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: TransferSecretOwnershipSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "transfer secret ownership", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeTransferSecretOwnershipRequest returns an encoder for requests sent to
+// the secrets transfer secret ownership server.
+func EncodeTransferSecretOwnershipRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.TransferSecretOwnershipPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "transfer secret ownership", "*secrets.TransferSecretOwnershipPayload", v)
+		}
+		body := NewTransferSecretOwnershipRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "transfer secret ownership", err)
+		}
+		return nil
+	}
+}
+
+// DecodeTransferSecretOwnershipResponse returns a decoder for responses
+// returned by the secrets transfer secret ownership endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeTransferSecretOwnershipResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeTransferSecretOwnershipResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			return body, nil
+		case http.StatusNotFound:
+			var (
+				body TransferSecretOwnershipSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			err = ValidateTransferSecretOwnershipSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "transfer secret ownership", err)
+			}
+			return nil, NewTransferSecretOwnershipSecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body TransferSecretOwnershipInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			err = ValidateTransferSecretOwnershipInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "transfer secret ownership", err)
+			}
+			return nil, NewTransferSecretOwnershipInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body TransferSecretOwnershipUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			err = ValidateTransferSecretOwnershipUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "transfer secret ownership", err)
+			}
+			return nil, NewTransferSecretOwnershipUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body TransferSecretOwnershipForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			err = ValidateTransferSecretOwnershipForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "transfer secret ownership", err)
+			}
+			return nil, NewTransferSecretOwnershipForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body TransferSecretOwnershipInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "transfer secret ownership", err)
+			}
+			err = ValidateTransferSecretOwnershipInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "transfer secret ownership", err)
+			}
+			return nil, NewTransferSecretOwnershipInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "transfer secret ownership", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListStaleSecretsRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "list stale secrets" endpoint
+func (c *Client) BuildListStaleSecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListStaleSecretsSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list stale secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListStaleSecretsRequest returns an encoder for requests sent to the
+// secrets list stale secrets server.
+func EncodeListStaleSecretsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ListStaleSecretsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "list stale secrets", "*secrets.ListStaleSecretsPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("days", fmt.Sprintf("%v", p.Days))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListStaleSecretsResponse returns a decoder for responses returned by
+// the secrets list stale secrets endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListStaleSecretsResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListStaleSecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListStaleSecretsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list stale secrets", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateStaleSecretResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list stale secrets", err)
+			}
+			res := NewListStaleSecretsStaleSecretOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListStaleSecretsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list stale secrets", err)
+			}
+			err = ValidateListStaleSecretsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list stale secrets", err)
+			}
+			return nil, NewListStaleSecretsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListStaleSecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list stale secrets", err)
+			}
+			err = ValidateListStaleSecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list stale secrets", err)
+			}
+			return nil, NewListStaleSecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListStaleSecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list stale secrets", err)
+			}
+			err = ValidateListStaleSecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list stale secrets", err)
+			}
+			return nil, NewListStaleSecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListStaleSecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list stale secrets", err)
+			}
+			err = ValidateListStaleSecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list stale secrets", err)
+			}
+			return nil, NewListStaleSecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list stale secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetSecretCanaryRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "set secret canary" endpoint
+func (c *Client) BuildSetSecretCanaryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		path string
+	)
+	{
+		p, ok := v.(*secrets.SetSecretCanaryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "set secret canary", "*secrets.SetSecretCanaryPayload", v)
+		}
+		path = p.Path
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetSecretCanarySecretsPath(path)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "set secret canary", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetSecretCanaryRequest returns an encoder for requests sent to the
+// secrets set secret canary server.
+func EncodeSetSecretCanaryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.SetSecretCanaryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "set secret canary", "*secrets.SetSecretCanaryPayload", v)
+		}
+		body := NewSetSecretCanaryRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "set secret canary", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetSecretCanaryResponse returns a decoder for responses returned by
+// the secrets set secret canary endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeSetSecretCanaryResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeSetSecretCanaryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body SetSecretCanarySecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret canary", err)
+			}
+			err = ValidateSetSecretCanarySecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret canary", err)
+			}
+			return nil, NewSetSecretCanarySecretNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body SetSecretCanaryInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret canary", err)
+			}
+			err = ValidateSetSecretCanaryInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret canary", err)
+			}
+			return nil, NewSetSecretCanaryInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetSecretCanaryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret canary", err)
+			}
+			err = ValidateSetSecretCanaryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret canary", err)
+			}
+			return nil, NewSetSecretCanaryUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body SetSecretCanaryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret canary", err)
+			}
+			err = ValidateSetSecretCanaryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret canary", err)
+			}
+			return nil, NewSetSecretCanaryForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body SetSecretCanaryInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "set secret canary", err)
+			}
+			err = ValidateSetSecretCanaryInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "set secret canary", err)
+			}
+			return nil, NewSetSecretCanaryInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "set secret canary", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListCanarySecretsRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "list canary secrets" endpoint
+func (c *Client) BuildListCanarySecretsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListCanarySecretsSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list canary secrets", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListCanarySecretsResponse returns a decoder for responses returned by
+// the secrets list canary secrets endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListCanarySecretsResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListCanarySecretsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body []string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list canary secrets", err)
+			}
+			return body, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListCanarySecretsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list canary secrets", err)
+			}
+			err = ValidateListCanarySecretsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list canary secrets", err)
+			}
+			return nil, NewListCanarySecretsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListCanarySecretsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list canary secrets", err)
+			}
+			err = ValidateListCanarySecretsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list canary secrets", err)
+			}
+			return nil, NewListCanarySecretsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListCanarySecretsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list canary secrets", err)
+			}
+			err = ValidateListCanarySecretsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list canary secrets", err)
+			}
+			return nil, NewListCanarySecretsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list canary secrets", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildWrapSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "wrap secret" endpoint
+func (c *Client) BuildWrapSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: WrapSecretSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "wrap secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeWrapSecretRequest returns an encoder for requests sent to the secrets
+// wrap secret server.
+func EncodeWrapSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.WrapSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "wrap secret", "*secrets.WrapSecretPayload", v)
+		}
+		body := NewWrapSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "wrap secret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeWrapSecretResponse returns a decoder for responses returned by the
+// secrets wrap secret endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeWrapSecretResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "field_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "secret_too_large" (type *goa.ServiceError): http.StatusRequestEntityTooLarge
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeWrapSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body WrapSecretResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			res := NewWrapSecretResultCreated(&body)
+			return res, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "secret_not_found":
+				var (
+					body WrapSecretSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+				}
+				err = ValidateWrapSecretSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+				}
+				return nil, NewWrapSecretSecretNotFound(&body)
+			case "field_not_found":
+				var (
+					body WrapSecretFieldNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+				}
+				err = ValidateWrapSecretFieldNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+				}
+				return nil, NewWrapSecretFieldNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "wrap secret", resp.StatusCode, string(body))
+			}
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body WrapSecretSecretTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretSecretTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			return nil, NewWrapSecretSecretTooLarge(&body)
+		case http.StatusBadRequest:
+			var (
+				body WrapSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			return nil, NewWrapSecretInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body WrapSecretUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			return nil, NewWrapSecretUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body WrapSecretForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			return nil, NewWrapSecretForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body WrapSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "wrap secret", err)
+			}
+			err = ValidateWrapSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "wrap secret", err)
+			}
+			return nil, NewWrapSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "wrap secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnwrapSecretRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "unwrap secret" endpoint
+func (c *Client) BuildUnwrapSecretRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnwrapSecretSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "unwrap secret", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnwrapSecretRequest returns an encoder for requests sent to the
+// secrets unwrap secret server.
+func EncodeUnwrapSecretRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.UnwrapSecretPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "unwrap secret", "*secrets.UnwrapSecretPayload", v)
+		}
+		body := NewUnwrapSecretRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "unwrap secret", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUnwrapSecretResponse returns a decoder for responses returned by the
+// secrets unwrap secret endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUnwrapSecretResponse may return the following errors:
+//   - "token_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "token_expired" (type *goa.ServiceError): http.StatusGone
+//   - "token_already_used" (type *goa.ServiceError): http.StatusGone
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeUnwrapSecretResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UnwrapSecretResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+			}
+			err = ValidateUnwrapSecretResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+			}
+			res := NewUnwrapSecretResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body UnwrapSecretTokenNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+			}
+			err = ValidateUnwrapSecretTokenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+			}
+			return nil, NewUnwrapSecretTokenNotFound(&body)
+		case http.StatusGone:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "token_expired":
+				var (
+					body UnwrapSecretTokenExpiredResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+				}
+				err = ValidateUnwrapSecretTokenExpiredResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+				}
+				return nil, NewUnwrapSecretTokenExpired(&body)
+			case "token_already_used":
+				var (
+					body UnwrapSecretTokenAlreadyUsedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+				}
+				err = ValidateUnwrapSecretTokenAlreadyUsedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+				}
+				return nil, NewUnwrapSecretTokenAlreadyUsed(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "unwrap secret", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body UnwrapSecretInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+			}
+			err = ValidateUnwrapSecretInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+			}
+			return nil, NewUnwrapSecretInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UnwrapSecretInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "unwrap secret", err)
+			}
+			err = ValidateUnwrapSecretInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "unwrap secret", err)
+			}
+			return nil, NewUnwrapSecretInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "unwrap secret", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRequestAccessRequest instantiates a HTTP request object with method and
+// path set to call the "secrets" service "request access" endpoint
+func (c *Client) BuildRequestAccessRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequestAccessSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "request access", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRequestAccessRequest returns an encoder for requests sent to the
+// secrets request access server.
+func EncodeRequestAccessRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.RequestAccessPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "request access", "*secrets.RequestAccessPayload", v)
+		}
+		body := NewRequestAccessRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "request access", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRequestAccessResponse returns a decoder for responses returned by the
+// secrets request access endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRequestAccessResponse may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "access_request_pending" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRequestAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body RequestAccessResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			res := NewRequestAccessAccessRequestCreated(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body RequestAccessSecretNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessSecretNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessSecretNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body RequestAccessAccessRequestPendingResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessAccessRequestPendingResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessAccessRequestPending(&body)
+		case http.StatusBadRequest:
+			var (
+				body RequestAccessInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RequestAccessUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body RequestAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RequestAccessInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "request access", err)
+			}
+			err = ValidateRequestAccessInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "request access", err)
+			}
+			return nil, NewRequestAccessInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "request access", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAccessRequestsRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list access requests"
+// endpoint
+func (c *Client) BuildListAccessRequestsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAccessRequestsSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list access requests", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAccessRequestsRequest returns an encoder for requests sent to the
+// secrets list access requests server.
+func EncodeListAccessRequestsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ListAccessRequestsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "list access requests", "*secrets.ListAccessRequestsPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListAccessRequestsResponse returns a decoder for responses returned by
+// the secrets list access requests endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListAccessRequestsResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListAccessRequestsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAccessRequestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list access requests", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAccessRequestResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list access requests", err)
+			}
+			res := NewListAccessRequestsAccessRequestOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListAccessRequestsInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list access requests", err)
+			}
+			err = ValidateListAccessRequestsInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list access requests", err)
+			}
+			return nil, NewListAccessRequestsInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListAccessRequestsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list access requests", err)
+			}
+			err = ValidateListAccessRequestsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list access requests", err)
+			}
+			return nil, NewListAccessRequestsUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListAccessRequestsForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list access requests", err)
+			}
+			err = ValidateListAccessRequestsForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list access requests", err)
+			}
+			return nil, NewListAccessRequestsForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListAccessRequestsInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list access requests", err)
+			}
+			err = ValidateListAccessRequestsInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list access requests", err)
+			}
+			return nil, NewListAccessRequestsInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list access requests", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildApproveAccessRequestRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "approve access request"
+// endpoint
+func (c *Client) BuildApproveAccessRequestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.ApproveAccessRequestPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "approve access request", "*secrets.ApproveAccessRequestPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ApproveAccessRequestSecretsPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "approve access request", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeApproveAccessRequestRequest returns an encoder for requests sent to
+// the secrets approve access request server.
+func EncodeApproveAccessRequestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ApproveAccessRequestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "approve access request", "*secrets.ApproveAccessRequestPayload", v)
+		}
+		body := NewApproveAccessRequestRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "approve access request", err)
+		}
+		return nil
+	}
+}
+
+// DecodeApproveAccessRequestResponse returns a decoder for responses returned
+// by the secrets approve access request endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeApproveAccessRequestResponse may return the following errors:
+//   - "access_request_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "secret_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "access_request_decided" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeApproveAccessRequestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ApproveAccessRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			res := NewApproveAccessRequestAccessRequestOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "access_request_not_found":
+				var (
+					body ApproveAccessRequestAccessRequestNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+				}
+				err = ValidateApproveAccessRequestAccessRequestNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+				}
+				return nil, NewApproveAccessRequestAccessRequestNotFound(&body)
+			case "secret_not_found":
+				var (
+					body ApproveAccessRequestSecretNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+				}
+				err = ValidateApproveAccessRequestSecretNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+				}
+				return nil, NewApproveAccessRequestSecretNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("secrets", "approve access request", resp.StatusCode, string(body))
+			}
+		case http.StatusConflict:
+			var (
+				body ApproveAccessRequestAccessRequestDecidedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestAccessRequestDecidedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			return nil, NewApproveAccessRequestAccessRequestDecided(&body)
+		case http.StatusBadRequest:
+			var (
+				body ApproveAccessRequestInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			return nil, NewApproveAccessRequestInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ApproveAccessRequestUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			return nil, NewApproveAccessRequestUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ApproveAccessRequestForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			return nil, NewApproveAccessRequestForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ApproveAccessRequestInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "approve access request", err)
+			}
+			err = ValidateApproveAccessRequestInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "approve access request", err)
+			}
+			return nil, NewApproveAccessRequestInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "approve access request", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDenyAccessRequestRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "deny access request" endpoint
+func (c *Client) BuildDenyAccessRequestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.DenyAccessRequestPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "deny access request", "*secrets.DenyAccessRequestPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DenyAccessRequestSecretsPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "deny access request", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDenyAccessRequestRequest returns an encoder for requests sent to the
+// secrets deny access request server.
+func EncodeDenyAccessRequestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.DenyAccessRequestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "deny access request", "*secrets.DenyAccessRequestPayload", v)
+		}
+		body := NewDenyAccessRequestRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "deny access request", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDenyAccessRequestResponse returns a decoder for responses returned by
+// the secrets deny access request endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDenyAccessRequestResponse may return the following errors:
+//   - "access_request_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "access_request_decided" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDenyAccessRequestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DenyAccessRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			res := NewDenyAccessRequestAccessRequestOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DenyAccessRequestAccessRequestNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestAccessRequestNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestAccessRequestNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DenyAccessRequestAccessRequestDecidedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestAccessRequestDecidedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestAccessRequestDecided(&body)
+		case http.StatusBadRequest:
+			var (
+				body DenyAccessRequestInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DenyAccessRequestUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DenyAccessRequestForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DenyAccessRequestInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "deny access request", err)
+			}
+			err = ValidateDenyAccessRequestInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "deny access request", err)
+			}
+			return nil, NewDenyAccessRequestInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "deny access request", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListValidationRulesRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list validation rules"
+// endpoint
+func (c *Client) BuildListValidationRulesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListValidationRulesSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list validation rules", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListValidationRulesResponse returns a decoder for responses returned
+// by the secrets list validation rules endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListValidationRulesResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListValidationRulesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListValidationRulesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list validation rules", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateValidationRuleResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list validation rules", err)
+			}
+			res := NewListValidationRulesValidationRuleOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListValidationRulesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list validation rules", err)
+			}
+			err = ValidateListValidationRulesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list validation rules", err)
+			}
+			return nil, NewListValidationRulesUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListValidationRulesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list validation rules", err)
+			}
+			err = ValidateListValidationRulesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list validation rules", err)
+			}
+			return nil, NewListValidationRulesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListValidationRulesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list validation rules", err)
+			}
+			err = ValidateListValidationRulesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list validation rules", err)
+			}
+			return nil, NewListValidationRulesInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list validation rules", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateValidationRuleRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "create validation rule"
+// endpoint
+func (c *Client) BuildCreateValidationRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateValidationRuleSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "create validation rule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateValidationRuleRequest returns an encoder for requests sent to
+// the secrets create validation rule server.
+func EncodeCreateValidationRuleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.CreateValidationRulePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "create validation rule", "*secrets.CreateValidationRulePayload", v)
+		}
+		body := NewCreateValidationRuleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "create validation rule", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateValidationRuleResponse returns a decoder for responses returned
+// by the secrets create validation rule endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeCreateValidationRuleResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateValidationRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create validation rule", err)
+			}
+			return body, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateValidationRuleInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create validation rule", err)
+			}
+			err = ValidateCreateValidationRuleInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create validation rule", err)
+			}
+			return nil, NewCreateValidationRuleInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateValidationRuleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create validation rule", err)
+			}
+			err = ValidateCreateValidationRuleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create validation rule", err)
+			}
+			return nil, NewCreateValidationRuleUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateValidationRuleForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create validation rule", err)
+			}
+			err = ValidateCreateValidationRuleForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create validation rule", err)
+			}
+			return nil, NewCreateValidationRuleForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateValidationRuleInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create validation rule", err)
+			}
+			err = ValidateCreateValidationRuleInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create validation rule", err)
+			}
+			return nil, NewCreateValidationRuleInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "create validation rule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteValidationRuleRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "delete validation rule"
+// endpoint
+func (c *Client) BuildDeleteValidationRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.DeleteValidationRulePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "delete validation rule", "*secrets.DeleteValidationRulePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteValidationRuleSecretsPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "delete validation rule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteValidationRuleResponse returns a decoder for responses returned
+// by the secrets delete validation rule endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeDeleteValidationRuleResponse may return the following errors:
+//   - "validation_rule_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteValidationRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteValidationRuleValidationRuleNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete validation rule", err)
+			}
+			err = ValidateDeleteValidationRuleValidationRuleNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete validation rule", err)
+			}
+			return nil, NewDeleteValidationRuleValidationRuleNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteValidationRuleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete validation rule", err)
+			}
+			err = ValidateDeleteValidationRuleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete validation rule", err)
+			}
+			return nil, NewDeleteValidationRuleUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteValidationRuleForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete validation rule", err)
+			}
+			err = ValidateDeleteValidationRuleForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete validation rule", err)
+			}
+			return nil, NewDeleteValidationRuleForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteValidationRuleInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete validation rule", err)
+			}
+			err = ValidateDeleteValidationRuleInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete validation rule", err)
+			}
+			return nil, NewDeleteValidationRuleInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "delete validation rule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBreakGlassRulesRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list break glass rules"
+// endpoint
+func (c *Client) BuildListBreakGlassRulesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBreakGlassRulesSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list break glass rules", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListBreakGlassRulesResponse returns a decoder for responses returned
+// by the secrets list break glass rules endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListBreakGlassRulesResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListBreakGlassRulesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBreakGlassRulesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass rules", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateBreakGlassRuleResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass rules", err)
+			}
+			res := NewListBreakGlassRulesBreakGlassRuleOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListBreakGlassRulesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass rules", err)
+			}
+			err = ValidateListBreakGlassRulesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass rules", err)
+			}
+			return nil, NewListBreakGlassRulesUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListBreakGlassRulesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass rules", err)
+			}
+			err = ValidateListBreakGlassRulesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass rules", err)
+			}
+			return nil, NewListBreakGlassRulesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListBreakGlassRulesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass rules", err)
+			}
+			err = ValidateListBreakGlassRulesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass rules", err)
+			}
+			return nil, NewListBreakGlassRulesInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list break glass rules", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateBreakGlassRuleRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "create break glass rule"
+// endpoint
+func (c *Client) BuildCreateBreakGlassRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateBreakGlassRuleSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "create break glass rule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateBreakGlassRuleRequest returns an encoder for requests sent to
+// the secrets create break glass rule server.
+func EncodeCreateBreakGlassRuleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.CreateBreakGlassRulePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "create break glass rule", "*secrets.CreateBreakGlassRulePayload", v)
+		}
+		body := NewCreateBreakGlassRuleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "create break glass rule", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateBreakGlassRuleResponse returns a decoder for responses returned
+// by the secrets create break glass rule endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeCreateBreakGlassRuleResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeCreateBreakGlassRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create break glass rule", err)
+			}
+			return body, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateBreakGlassRuleInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create break glass rule", err)
+			}
+			err = ValidateCreateBreakGlassRuleInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create break glass rule", err)
+			}
+			return nil, NewCreateBreakGlassRuleInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateBreakGlassRuleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create break glass rule", err)
+			}
+			err = ValidateCreateBreakGlassRuleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create break glass rule", err)
+			}
+			return nil, NewCreateBreakGlassRuleUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateBreakGlassRuleForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create break glass rule", err)
+			}
+			err = ValidateCreateBreakGlassRuleForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create break glass rule", err)
+			}
+			return nil, NewCreateBreakGlassRuleForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateBreakGlassRuleInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "create break glass rule", err)
+			}
+			err = ValidateCreateBreakGlassRuleInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "create break glass rule", err)
+			}
+			return nil, NewCreateBreakGlassRuleInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "create break glass rule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteBreakGlassRuleRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "delete break glass rule"
+// endpoint
+func (c *Client) BuildDeleteBreakGlassRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.DeleteBreakGlassRulePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "delete break glass rule", "*secrets.DeleteBreakGlassRulePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteBreakGlassRuleSecretsPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "delete break glass rule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteBreakGlassRuleResponse returns a decoder for responses returned
+// by the secrets delete break glass rule endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeDeleteBreakGlassRuleResponse may return the following errors:
+//   - "break_glass_rule_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeDeleteBreakGlassRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete break glass rule", err)
+			}
+			err = ValidateDeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete break glass rule", err)
+			}
+			return nil, NewDeleteBreakGlassRuleBreakGlassRuleNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteBreakGlassRuleUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete break glass rule", err)
+			}
+			err = ValidateDeleteBreakGlassRuleUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete break glass rule", err)
+			}
+			return nil, NewDeleteBreakGlassRuleUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteBreakGlassRuleForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete break glass rule", err)
+			}
+			err = ValidateDeleteBreakGlassRuleForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete break glass rule", err)
+			}
+			return nil, NewDeleteBreakGlassRuleForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteBreakGlassRuleInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "delete break glass rule", err)
+			}
+			err = ValidateDeleteBreakGlassRuleInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "delete break glass rule", err)
+			}
+			return nil, NewDeleteBreakGlassRuleInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "delete break glass rule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListBreakGlassAccessesRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list break glass
+// accesses" endpoint
+func (c *Client) BuildListBreakGlassAccessesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListBreakGlassAccessesSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list break glass accesses", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListBreakGlassAccessesRequest returns an encoder for requests sent to
+// the secrets list break glass accesses server.
+func EncodeListBreakGlassAccessesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ListBreakGlassAccessesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "list break glass accesses", "*secrets.ListBreakGlassAccessesPayload", v)
+		}
+		values := req.URL.Query()
+		values.Add("unreviewed", fmt.Sprintf("%v", p.Unreviewed))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListBreakGlassAccessesResponse returns a decoder for responses
+// returned by the secrets list break glass accesses endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListBreakGlassAccessesResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListBreakGlassAccessesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListBreakGlassAccessesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass accesses", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateBreakGlassAccessResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass accesses", err)
+			}
+			res := NewListBreakGlassAccessesBreakGlassAccessOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListBreakGlassAccessesInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass accesses", err)
+			}
+			err = ValidateListBreakGlassAccessesInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass accesses", err)
+			}
+			return nil, NewListBreakGlassAccessesInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListBreakGlassAccessesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass accesses", err)
+			}
+			err = ValidateListBreakGlassAccessesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass accesses", err)
+			}
+			return nil, NewListBreakGlassAccessesUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListBreakGlassAccessesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass accesses", err)
+			}
+			err = ValidateListBreakGlassAccessesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass accesses", err)
+			}
+			return nil, NewListBreakGlassAccessesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListBreakGlassAccessesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list break glass accesses", err)
+			}
+			err = ValidateListBreakGlassAccessesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list break glass accesses", err)
+			}
+			return nil, NewListBreakGlassAccessesInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list break glass accesses", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReviewBreakGlassAccessRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "review break glass
+// access" endpoint
+func (c *Client) BuildReviewBreakGlassAccessRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.ReviewBreakGlassAccessPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "review break glass access", "*secrets.ReviewBreakGlassAccessPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReviewBreakGlassAccessSecretsPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "review break glass access", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeReviewBreakGlassAccessRequest returns an encoder for requests sent to
+// the secrets review break glass access server.
+func EncodeReviewBreakGlassAccessRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.ReviewBreakGlassAccessPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "review break glass access", "*secrets.ReviewBreakGlassAccessPayload", v)
+		}
+		body := NewReviewBreakGlassAccessRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "review break glass access", err)
+		}
+		return nil
+	}
+}
+
+// DecodeReviewBreakGlassAccessResponse returns a decoder for responses
+// returned by the secrets review break glass access endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeReviewBreakGlassAccessResponse may return the following errors:
+//   - "break_glass_access_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "break_glass_access_reviewed" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeReviewBreakGlassAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ReviewBreakGlassAccessResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			res := NewReviewBreakGlassAccessBreakGlassAccessOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessBreakGlassAccessNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessBreakGlassAccessReviewed(&body)
+		case http.StatusBadRequest:
+			var (
+				body ReviewBreakGlassAccessInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ReviewBreakGlassAccessUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ReviewBreakGlassAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReviewBreakGlassAccessInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "review break glass access", err)
+			}
+			err = ValidateReviewBreakGlassAccessInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "review break glass access", err)
+			}
+			return nil, NewReviewBreakGlassAccessInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "review break glass access", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListFolderAccessesRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "list folder accesses"
+// endpoint
+func (c *Client) BuildListFolderAccessesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListFolderAccessesSecretsPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "list folder accesses", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListFolderAccessesResponse returns a decoder for responses returned by
+// the secrets list folder accesses endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListFolderAccessesResponse may return the following errors:
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeListFolderAccessesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListFolderAccessesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list folder accesses", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateFolderAccessResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list folder accesses", err)
+			}
+			res := NewListFolderAccessesFolderAccessOK(body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListFolderAccessesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list folder accesses", err)
+			}
+			err = ValidateListFolderAccessesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list folder accesses", err)
+			}
+			return nil, NewListFolderAccessesUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body ListFolderAccessesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list folder accesses", err)
+			}
+			err = ValidateListFolderAccessesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list folder accesses", err)
+			}
+			return nil, NewListFolderAccessesForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListFolderAccessesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "list folder accesses", err)
+			}
+			err = ValidateListFolderAccessesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "list folder accesses", err)
+			}
+			return nil, NewListFolderAccessesInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "list folder accesses", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGrantFolderAccessRequest instantiates a HTTP request object with method
+// and path set to call the "secrets" service "grant folder access" endpoint
+func (c *Client) BuildGrantFolderAccessRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GrantFolderAccessSecretsPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "grant folder access", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGrantFolderAccessRequest returns an encoder for requests sent to the
+// secrets grant folder access server.
+func EncodeGrantFolderAccessRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*secrets.GrantFolderAccessPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("secrets", "grant folder access", "*secrets.GrantFolderAccessPayload", v)
+		}
+		body := NewGrantFolderAccessRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("secrets", "grant folder access", err)
+		}
+		return nil
+	}
+}
+
+// DecodeGrantFolderAccessResponse returns a decoder for responses returned by
+// the secrets grant folder access endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGrantFolderAccessResponse may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeGrantFolderAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body int
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "grant folder access", err)
 			}
-			err = ValidateUpdateSecretForbiddenResponseBody(&body)
+			return body, nil
+		case http.StatusBadRequest:
+			var (
+				body GrantFolderAccessInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "grant folder access", err)
 			}
-			return nil, NewUpdateSecretForbidden(&body)
+			err = ValidateGrantFolderAccessInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "grant folder access", err)
+			}
+			return nil, NewGrantFolderAccessInvalidParameters(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GrantFolderAccessUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "grant folder access", err)
+			}
+			err = ValidateGrantFolderAccessUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "grant folder access", err)
+			}
+			return nil, NewGrantFolderAccessUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body GrantFolderAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "grant folder access", err)
+			}
+			err = ValidateGrantFolderAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "grant folder access", err)
+			}
+			return nil, NewGrantFolderAccessForbidden(&body)
 		case http.StatusInternalServerError:
 			var (
-				body UpdateSecretInternalErrorResponseBody
+				body GrantFolderAccessInternalErrorResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("secrets", "update secret", err)
+				return nil, goahttp.ErrDecodingError("secrets", "grant folder access", err)
 			}
-			err = ValidateUpdateSecretInternalErrorResponseBody(&body)
+			err = ValidateGrantFolderAccessInternalErrorResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("secrets", "update secret", err)
+				return nil, goahttp.ErrValidationError("secrets", "grant folder access", err)
 			}
-			return nil, NewUpdateSecretInternalError(&body)
+			return nil, NewGrantFolderAccessInternalError(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("secrets", "update secret", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("secrets", "grant folder access", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeFolderAccessRequest instantiates a HTTP request object with
+// method and path set to call the "secrets" service "revoke folder access"
+// endpoint
+func (c *Client) BuildRevokeFolderAccessRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*secrets.RevokeFolderAccessPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("secrets", "revoke folder access", "*secrets.RevokeFolderAccessPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeFolderAccessSecretsPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("secrets", "revoke folder access", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeRevokeFolderAccessResponse returns a decoder for responses returned by
+// the secrets revoke folder access endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRevokeFolderAccessResponse may return the following errors:
+//   - "folder_access_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - error: internal error
+func DecodeRevokeFolderAccessResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body RevokeFolderAccessFolderAccessNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "revoke folder access", err)
+			}
+			err = ValidateRevokeFolderAccessFolderAccessNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "revoke folder access", err)
+			}
+			return nil, NewRevokeFolderAccessFolderAccessNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeFolderAccessUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "revoke folder access", err)
+			}
+			err = ValidateRevokeFolderAccessUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "revoke folder access", err)
+			}
+			return nil, NewRevokeFolderAccessUnauthorized(&body)
+		case http.StatusForbidden:
+			var (
+				body RevokeFolderAccessForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "revoke folder access", err)
+			}
+			err = ValidateRevokeFolderAccessForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "revoke folder access", err)
+			}
+			return nil, NewRevokeFolderAccessForbidden(&body)
+		case http.StatusInternalServerError:
+			var (
+				body RevokeFolderAccessInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("secrets", "revoke folder access", err)
+			}
+			err = ValidateRevokeFolderAccessInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("secrets", "revoke folder access", err)
+			}
+			return nil, NewRevokeFolderAccessInternalError(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("secrets", "revoke folder access", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -671,7 +5913,12 @@ func unmarshalSecretInfoSummaryResponseToSecretsSecretInfoSummary(v *SecretInfoS
 		CreatedAt: *v.CreatedAt,
 		UpdatedAt: *v.UpdatedAt,
 	}
-	res.Owner = unmarshalUserResponseToSecretsUser(v.Owner)
+	if v.Owner != nil {
+		res.Owner = unmarshalUserResponseToSecretsUser(v.Owner)
+	}
+	if v.OwnerRole != nil {
+		res.OwnerRole = unmarshalRoleResponseToSecretsRole(v.OwnerRole)
+	}
 	res.Users = make([]*secrets.User, len(v.Users))
 	for i, val := range v.Users {
 		res.Users[i] = unmarshalUserResponseToSecretsUser(val)
@@ -687,6 +5934,9 @@ func unmarshalSecretInfoSummaryResponseToSecretsSecretInfoSummary(v *SecretInfoS
 // unmarshalUserResponseToSecretsUser builds a value of type *secrets.User from
 // a value of type *UserResponse.
 func unmarshalUserResponseToSecretsUser(v *UserResponse) *secrets.User {
+	if v == nil {
+		return nil
+	}
 	res := &secrets.User{
 		ID:        *v.ID,
 		Username:  *v.Username,
@@ -716,9 +5966,86 @@ func unmarshalRoleResponseToSecretsRole(v *RoleResponse) *secrets.Role {
 	return res
 }
 
+// unmarshalSecretEntryResponseBodyToSecretsSecretEntry builds a value of type
+// *secrets.SecretEntry from a value of type *SecretEntryResponseBody.
+func unmarshalSecretEntryResponseBodyToSecretsSecretEntry(v *SecretEntryResponseBody) *secrets.SecretEntry {
+	res := &secrets.SecretEntry{
+		Path:      *v.Path,
+		Name:      *v.Name,
+		Folder:    *v.Folder,
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalSecretsSecretValueRequestToSecretValueRequestRequestBody builds a
+// value of type *SecretValueRequestRequestBody from a value of type
+// *secrets.SecretValueRequest.
+func marshalSecretsSecretValueRequestToSecretValueRequestRequestBody(v *secrets.SecretValueRequest) *SecretValueRequestRequestBody {
+	res := &SecretValueRequestRequestBody{
+		Path:  v.Path,
+		Field: v.Field,
+	}
+
+	return res
+}
+
+// marshalSecretValueRequestRequestBodyToSecretsSecretValueRequest builds a
+// value of type *secrets.SecretValueRequest from a value of type
+// *SecretValueRequestRequestBody.
+func marshalSecretValueRequestRequestBodyToSecretsSecretValueRequest(v *SecretValueRequestRequestBody) *secrets.SecretValueRequest {
+	res := &secrets.SecretValueRequest{
+		Path:  v.Path,
+		Field: v.Field,
+	}
+
+	return res
+}
+
+// unmarshalSecretValueResponseBodyToSecretsSecretValue builds a value of type
+// *secrets.SecretValue from a value of type *SecretValueResponseBody.
+func unmarshalSecretValueResponseBodyToSecretsSecretValue(v *SecretValueResponseBody) *secrets.SecretValue {
+	res := &secrets.SecretValue{
+		Path:         *v.Path,
+		Value:        v.Value,
+		RawValue:     v.RawValue,
+		ContentType:  v.ContentType,
+		Filename:     v.Filename,
+		Error:        v.Error,
+		ErrorMessage: v.ErrorMessage,
+	}
+	if v.Fields != nil {
+		res.Fields = make(map[string]string, len(v.Fields))
+		for key, val := range v.Fields {
+			tk := key
+			tv := val
+			res.Fields[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalImportedSecretResponseToSecretsImportedSecret builds a value of
+// type *secrets.ImportedSecret from a value of type *ImportedSecretResponse.
+func unmarshalImportedSecretResponseToSecretsImportedSecret(v *ImportedSecretResponse) *secrets.ImportedSecret {
+	res := &secrets.ImportedSecret{
+		Key:    *v.Key,
+		Path:   *v.Path,
+		Action: *v.Action,
+	}
+
+	return res
+}
+
 // unmarshalUserResponseBodyToSecretsUser builds a value of type *secrets.User
 // from a value of type *UserResponseBody.
 func unmarshalUserResponseBodyToSecretsUser(v *UserResponseBody) *secrets.User {
+	if v == nil {
+		return nil
+	}
 	res := &secrets.User{
 		ID:        *v.ID,
 		Username:  *v.Username,
@@ -747,3 +6074,207 @@ func unmarshalRoleResponseBodyToSecretsRole(v *RoleResponseBody) *secrets.Role {
 
 	return res
 }
+
+// unmarshalGrantResponseBodyToSecretsGrant builds a value of type
+// *secrets.Grant from a value of type *GrantResponseBody.
+func unmarshalGrantResponseBodyToSecretsGrant(v *GrantResponseBody) *secrets.Grant {
+	res := &secrets.Grant{
+		ID:        *v.ID,
+		ExpiresAt: v.ExpiresAt,
+		ExpiresIn: v.ExpiresIn,
+	}
+	res.Capabilities = make([]string, len(v.Capabilities))
+	for i, val := range v.Capabilities {
+		res.Capabilities[i] = val
+	}
+
+	return res
+}
+
+// marshalSecretsGrantToGrantRequestBody builds a value of type
+// *GrantRequestBody from a value of type *secrets.Grant.
+func marshalSecretsGrantToGrantRequestBody(v *secrets.Grant) *GrantRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &GrantRequestBody{
+		ID:        v.ID,
+		ExpiresAt: v.ExpiresAt,
+		ExpiresIn: v.ExpiresIn,
+	}
+	if v.Capabilities != nil {
+		res.Capabilities = make([]string, len(v.Capabilities))
+		for i, val := range v.Capabilities {
+			res.Capabilities[i] = val
+		}
+	} else {
+		res.Capabilities = []string{}
+	}
+
+	return res
+}
+
+// marshalGrantRequestBodyToSecretsGrant builds a value of type *secrets.Grant
+// from a value of type *GrantRequestBody.
+func marshalGrantRequestBodyToSecretsGrant(v *GrantRequestBody) *secrets.Grant {
+	if v == nil {
+		return nil
+	}
+	res := &secrets.Grant{
+		ID:        v.ID,
+		ExpiresAt: v.ExpiresAt,
+		ExpiresIn: v.ExpiresIn,
+	}
+	if v.Capabilities != nil {
+		res.Capabilities = make([]string, len(v.Capabilities))
+		for i, val := range v.Capabilities {
+			res.Capabilities[i] = val
+		}
+	} else {
+		res.Capabilities = []string{}
+	}
+
+	return res
+}
+
+// unmarshalSecretRotationResponseToSecretsSecretRotation builds a value of
+// type *secrets.SecretRotation from a value of type *SecretRotationResponse.
+func unmarshalSecretRotationResponseToSecretsSecretRotation(v *SecretRotationResponse) *secrets.SecretRotation {
+	res := &secrets.SecretRotation{
+		Version:   *v.Version,
+		Scheduled: *v.Scheduled,
+		Error:     v.Error,
+		RotatedAt: *v.RotatedAt,
+	}
+
+	return res
+}
+
+// unmarshalSecretDiffResponseToSecretsSecretDiff builds a value of type
+// *secrets.SecretDiff from a value of type *SecretDiffResponse.
+func unmarshalSecretDiffResponseToSecretsSecretDiff(v *SecretDiffResponse) *secrets.SecretDiff {
+	res := &secrets.SecretDiff{
+		Key:    *v.Key,
+		Status: *v.Status,
+	}
+
+	return res
+}
+
+// unmarshalStaleSecretResponseToSecretsStaleSecret builds a value of type
+// *secrets.StaleSecret from a value of type *StaleSecretResponse.
+func unmarshalStaleSecretResponseToSecretsStaleSecret(v *StaleSecretResponse) *secrets.StaleSecret {
+	res := &secrets.StaleSecret{
+		Path:       *v.Path,
+		LastReadAt: v.LastReadAt,
+		CreatedAt:  *v.CreatedAt,
+	}
+	if v.LastReader != nil {
+		res.LastReader = unmarshalUserResponseToSecretsUser(v.LastReader)
+	}
+
+	return res
+}
+
+// unmarshalAccessRequestResponseToSecretsAccessRequest builds a value of type
+// *secrets.AccessRequest from a value of type *AccessRequestResponse.
+func unmarshalAccessRequestResponseToSecretsAccessRequest(v *AccessRequestResponse) *secrets.AccessRequest {
+	res := &secrets.AccessRequest{
+		ID:             *v.ID,
+		Path:           *v.Path,
+		Justification:  *v.Justification,
+		Duration:       v.Duration,
+		Status:         *v.Status,
+		Comment:        v.Comment,
+		DecidedAt:      v.DecidedAt,
+		GrantExpiresAt: v.GrantExpiresAt,
+		CreatedAt:      *v.CreatedAt,
+	}
+	if v.Requester != nil {
+		res.Requester = unmarshalUserResponseToSecretsUser(v.Requester)
+	}
+	res.Capabilities = make([]string, len(v.Capabilities))
+	for i, val := range v.Capabilities {
+		res.Capabilities[i] = val
+	}
+	if v.DecidedBy != nil {
+		res.DecidedBy = unmarshalUserResponseToSecretsUser(v.DecidedBy)
+	}
+
+	return res
+}
+
+// unmarshalValidationRuleResponseToSecretsValidationRule builds a value of
+// type *secrets.ValidationRule from a value of type *ValidationRuleResponse.
+func unmarshalValidationRuleResponseToSecretsValidationRule(v *ValidationRuleResponse) *secrets.ValidationRule {
+	res := &secrets.ValidationRule{
+		ID:         *v.ID,
+		PathPrefix: *v.PathPrefix,
+		Kind:       *v.Kind,
+		Parameter:  *v.Parameter,
+		Field:      v.Field,
+		CreatedAt:  *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalBreakGlassRuleResponseToSecretsBreakGlassRule builds a value of
+// type *secrets.BreakGlassRule from a value of type *BreakGlassRuleResponse.
+func unmarshalBreakGlassRuleResponseToSecretsBreakGlassRule(v *BreakGlassRuleResponse) *secrets.BreakGlassRule {
+	res := &secrets.BreakGlassRule{
+		ID:         *v.ID,
+		PathPrefix: *v.PathPrefix,
+		CreatedAt:  *v.CreatedAt,
+	}
+	if v.Role != nil {
+		res.Role = unmarshalRoleResponseToSecretsRole(v.Role)
+	}
+
+	return res
+}
+
+// unmarshalBreakGlassAccessResponseToSecretsBreakGlassAccess builds a value of
+// type *secrets.BreakGlassAccess from a value of type
+// *BreakGlassAccessResponse.
+func unmarshalBreakGlassAccessResponseToSecretsBreakGlassAccess(v *BreakGlassAccessResponse) *secrets.BreakGlassAccess {
+	res := &secrets.BreakGlassAccess{
+		ID:            *v.ID,
+		Path:          *v.Path,
+		Justification: *v.Justification,
+		ExpiresAt:     *v.ExpiresAt,
+		ReviewedAt:    v.ReviewedAt,
+		ReviewComment: v.ReviewComment,
+		CreatedAt:     *v.CreatedAt,
+	}
+	if v.User != nil {
+		res.User = unmarshalUserResponseToSecretsUser(v.User)
+	}
+	if v.ReviewedBy != nil {
+		res.ReviewedBy = unmarshalUserResponseToSecretsUser(v.ReviewedBy)
+	}
+
+	return res
+}
+
+// unmarshalFolderAccessResponseToSecretsFolderAccess builds a value of type
+// *secrets.FolderAccess from a value of type *FolderAccessResponse.
+func unmarshalFolderAccessResponseToSecretsFolderAccess(v *FolderAccessResponse) *secrets.FolderAccess {
+	res := &secrets.FolderAccess{
+		ID:         *v.ID,
+		PathPrefix: *v.PathPrefix,
+		CreatedAt:  *v.CreatedAt,
+	}
+	if v.User != nil {
+		res.User = unmarshalUserResponseToSecretsUser(v.User)
+	}
+	if v.Role != nil {
+		res.Role = unmarshalRoleResponseToSecretsRole(v.Role)
+	}
+	res.Capabilities = make([]string, len(v.Capabilities))
+	for i, val := range v.Capabilities {
+		res.Capabilities[i] = val
+	}
+
+	return res
+}
@@ -14,17 +14,47 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// BatchGetSecretValuesRequestBody is the type of the "secrets" service "batch
+// get secret values" endpoint HTTP request body.
+type BatchGetSecretValuesRequestBody struct {
+	// The secrets to read
+	Secrets []*SecretValueRequestRequestBody `form:"secrets" json:"secrets" xml:"secrets"`
+}
+
+// RenderTemplateRequestBody is the type of the "secrets" service "render
+// template" endpoint HTTP request body.
+type RenderTemplateRequestBody struct {
+	// The template to render
+	Template *string `form:"template,omitempty" json:"template,omitempty" xml:"template,omitempty"`
+	// Base64 encoded path of a secret holding the template to render
+	TemplatePath *string `form:"template_path,omitempty" json:"template_path,omitempty" xml:"template_path,omitempty"`
+}
+
 // CreateSecretRequestBody is the type of the "secrets" service "create secret"
 // endpoint HTTP request body.
 type CreateSecretRequestBody struct {
 	// Base64 encoded secret's path
 	Path string `form:"path" json:"path" xml:"path"`
 	// The secret value
-	Value string `form:"value" json:"value" xml:"value"`
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, instead of a value
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Name of the generation policy generating the value, instead of a value. Key
+	// pairs are stored as private_key and public_key fields
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+	// Duration or cron expression to regenerate the value on, requires a
+	// generation policy
+	RotationSchedule *string `form:"rotation_schedule,omitempty" json:"rotation_schedule,omitempty" xml:"rotation_schedule,omitempty"`
 	// Users IDs authorized to access the secret
 	AuthorizedUsers []int `form:"authorized_users" json:"authorized_users" xml:"authorized_users"`
 	// Role IDs authorized to access the secret
 	AuthorizedRoles []int `form:"authorized_roles" json:"authorized_roles" xml:"authorized_roles"`
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*GrantRequestBody `form:"user_grants,omitempty" json:"user_grants,omitempty" xml:"user_grants,omitempty"`
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*GrantRequestBody `form:"role_grants,omitempty" json:"role_grants,omitempty" xml:"role_grants,omitempty"`
 }
 
 // UpdateSecretRequestBody is the type of the "secrets" service "update secret"
@@ -32,27 +62,220 @@ type CreateSecretRequestBody struct {
 type UpdateSecretRequestBody struct {
 	// Base64 encoded secret's path
 	Path string `form:"path" json:"path" xml:"path"`
-	// The secret value
-	Value string `form:"value" json:"value" xml:"value"`
-	// Users IDs authorized to access the secret
-	AuthorizedUsers []int `form:"authorized_users" json:"authorized_users" xml:"authorized_users"`
+	// The new secret value, unchanged if absent
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Fields to add or replace in a structured secret
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Names of the fields to remove from a structured secret
+	RemoveFields []string `form:"remove_fields,omitempty" json:"remove_fields,omitempty" xml:"remove_fields,omitempty"`
+	// Users IDs authorized to access the secret, grants are unchanged if no users
+	// nor roles are given
+	AuthorizedUsers []int `form:"authorized_users,omitempty" json:"authorized_users,omitempty" xml:"authorized_users,omitempty"`
 	// Role IDs authorized to access the secret
-	AuthorizedRoles []int `form:"authorized_roles" json:"authorized_roles" xml:"authorized_roles"`
+	AuthorizedRoles []int `form:"authorized_roles,omitempty" json:"authorized_roles,omitempty" xml:"authorized_roles,omitempty"`
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*GrantRequestBody `form:"user_grants,omitempty" json:"user_grants,omitempty" xml:"user_grants,omitempty"`
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*GrantRequestBody `form:"role_grants,omitempty" json:"role_grants,omitempty" xml:"role_grants,omitempty"`
+}
+
+// RegenerateSecretRequestBody is the type of the "secrets" service "regenerate
+// secret" endpoint HTTP request body.
+type RegenerateSecretRequestBody struct {
+	// Name of the generation policy to use, defaults to the one the secret was
+	// generated with
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+}
+
+// SetSecretRotationRequestBody is the type of the "secrets" service "set
+// secret rotation" endpoint HTTP request body.
+type SetSecretRotationRequestBody struct {
+	// Duration such as 720h or cron expression such as '0 3 * * 1', the schedule
+	// is removed if absent
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+}
+
+// MoveSecretsRequestBody is the type of the "secrets" service "move secrets"
+// endpoint HTTP request body.
+type MoveSecretsRequestBody struct {
+	// Base64 encoded path of the secret or folder to move
+	Source string `form:"source" json:"source" xml:"source"`
+	// Base64 encoded new path of the secret or folder
+	Destination string `form:"destination" json:"destination" xml:"destination"`
+	// Replace the secrets already existing at the destination paths
+	Overwrite bool `form:"overwrite" json:"overwrite" xml:"overwrite"`
+}
+
+// PromoteSecretsRequestBody is the type of the "secrets" service "promote
+// secrets" endpoint HTTP request body.
+type PromoteSecretsRequestBody struct {
+	// Base64 encoded path of the source folder
+	Source string `form:"source" json:"source" xml:"source"`
+	// Base64 encoded path of the destination folder
+	Destination string `form:"destination" json:"destination" xml:"destination"`
+	// Paths of the secrets to promote, relative to the folders
+	Keys []string `form:"keys" json:"keys" xml:"keys"`
+	// Replace the values of the secrets which differ at the destination
+	Overwrite bool `form:"overwrite" json:"overwrite" xml:"overwrite"`
+}
+
+// TransferSecretOwnershipRequestBody is the type of the "secrets" service
+// "transfer secret ownership" endpoint HTTP request body.
+type TransferSecretOwnershipRequestBody struct {
+	// Base64 encoded path of the secret to transfer
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// ID of the user whose secrets are all transferred, in place of a path
+	FromUserID *int `form:"from_user_id,omitempty" json:"from_user_id,omitempty" xml:"from_user_id,omitempty"`
+	// ID of the new owner
+	ToUserID *int `form:"to_user_id,omitempty" json:"to_user_id,omitempty" xml:"to_user_id,omitempty"`
+	// ID of the role becoming the owner, in place of a user
+	ToRoleID *int `form:"to_role_id,omitempty" json:"to_role_id,omitempty" xml:"to_role_id,omitempty"`
+}
+
+// SetSecretCanaryRequestBody is the type of the "secrets" service "set secret
+// canary" endpoint HTTP request body.
+type SetSecretCanaryRequestBody struct {
+	// Whether the secret is a canary
+	Canary bool `form:"canary" json:"canary" xml:"canary"`
+}
+
+// WrapSecretRequestBody is the type of the "secrets" service "wrap secret"
+// endpoint HTTP request body.
+type WrapSecretRequestBody struct {
+	// The value to wrap
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Base64 encoded path of the secret to wrap a copy of, instead of a value
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Name of the only field to wrap from a structured secret
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Seconds the token can be unwrapped for
+	TTL int `form:"ttl" json:"ttl" xml:"ttl"`
+}
+
+// UnwrapSecretRequestBody is the type of the "secrets" service "unwrap secret"
+// endpoint HTTP request body.
+type UnwrapSecretRequestBody struct {
+	// Token returned when wrapping the value
+	Token string `form:"token" json:"token" xml:"token"`
+}
+
+// RequestAccessRequestBody is the type of the "secrets" service "request
+// access" endpoint HTTP request body.
+type RequestAccessRequestBody struct {
+	// Base64 encoded path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Why the access is needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Seconds the access is needed for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+}
+
+// ApproveAccessRequestRequestBody is the type of the "secrets" service
+// "approve access request" endpoint HTTP request body.
+type ApproveAccessRequestRequestBody struct {
+	// Comment kept with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// DenyAccessRequestRequestBody is the type of the "secrets" service "deny
+// access request" endpoint HTTP request body.
+type DenyAccessRequestRequestBody struct {
+	// Comment kept with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// CreateValidationRuleRequestBody is the type of the "secrets" service "create
+// validation rule" endpoint HTTP request body.
+type CreateValidationRuleRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// What the rule checks
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, omitted for x509_certificate rules
+	Parameter *string `form:"parameter,omitempty" json:"parameter,omitempty" xml:"parameter,omitempty"`
+	// The only field of structured secrets the rule applies to
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+}
+
+// CreateBreakGlassRuleRequestBody is the type of the "secrets" service "create
+// break glass rule" endpoint HTTP request body.
+type CreateBreakGlassRuleRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// ID of the only role whose members can break the glass
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+}
+
+// ReviewBreakGlassAccessRequestBody is the type of the "secrets" service
+// "review break glass access" endpoint HTTP request body.
+type ReviewBreakGlassAccessRequestBody struct {
+	// Comment kept with the acknowledgement
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// GrantFolderAccessRequestBody is the type of the "secrets" service "grant
+// folder access" endpoint HTTP request body.
+type GrantFolderAccessRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// ID of the user to grant access to
+	UserID *int `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// ID of the role to grant access to
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
 }
 
 // ListSecretsResponseBody is the type of the "secrets" service "list secrets"
 // endpoint HTTP response body.
 type ListSecretsResponseBody []*SecretInfoSummaryResponse
 
+// BrowseSecretsResponseBody is the type of the "secrets" service "browse
+// secrets" endpoint HTTP response body.
+type BrowseSecretsResponseBody struct {
+	// The folders and secrets found under the prefix
+	Entries []*SecretEntryResponseBody `form:"entries,omitempty" json:"entries,omitempty" xml:"entries,omitempty"`
+	// Cursor to fetch the next page, absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+}
+
 // GetSecretValueResponseBody is the type of the "secrets" service "get secret
 // value" endpoint HTTP response body.
 type GetSecretValueResponseBody struct {
-	// The secret value
+	// The secret value, or the value of the requested field
 	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 	// The original path of the secret
 	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Time your emergency access to the secret lapses at, when the secret was read
+	// by breaking the glass
+	BreakGlassExpiresAt *string `form:"break_glass_expires_at,omitempty" json:"break_glass_expires_at,omitempty" xml:"break_glass_expires_at,omitempty"`
+}
+
+// BatchGetSecretValuesResponseBody is the type of the "secrets" service "batch
+// get secret values" endpoint HTTP response body.
+type BatchGetSecretValuesResponseBody struct {
+	// The values of the secrets, in the order of the request
+	Secrets []*SecretValueResponseBody `form:"secrets,omitempty" json:"secrets,omitempty" xml:"secrets,omitempty"`
 }
 
+// RenderTemplateResponseBody is the type of the "secrets" service "render
+// template" endpoint HTTP response body.
+type RenderTemplateResponseBody struct {
+	// The rendered document
+	Rendered *string `form:"rendered,omitempty" json:"rendered,omitempty" xml:"rendered,omitempty"`
+}
+
+// ImportSecretsResponseBody is the type of the "secrets" service "import
+// secrets" endpoint HTTP response body.
+type ImportSecretsResponseBody []*ImportedSecretResponse
+
 // GetSecretResponseBody is the type of the "secrets" service "get secret"
 // endpoint HTTP response body.
 type GetSecretResponseBody struct {
@@ -60,16 +283,221 @@ type GetSecretResponseBody struct {
 	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
 	// The owner of the secret
 	Owner *UserResponseBody `form:"owner,omitempty" json:"owner,omitempty" xml:"owner,omitempty"`
+	// The role owning the secret, in place of a user
+	OwnerRole *RoleResponseBody `form:"owner_role,omitempty" json:"owner_role,omitempty" xml:"owner_role,omitempty"`
 	// Members authorized to access the secret
 	AuthorizedUsers []*UserResponseBody `form:"authorized_users,omitempty" json:"authorized_users,omitempty" xml:"authorized_users,omitempty"`
 	// Roles authorized to access the secret
 	AuthorizedRoles []*RoleResponseBody `form:"authorized_roles,omitempty" json:"authorized_roles,omitempty" xml:"authorized_roles,omitempty"`
+	// Capabilities granted to each authorized user
+	UserGrants []*GrantResponseBody `form:"user_grants,omitempty" json:"user_grants,omitempty" xml:"user_grants,omitempty"`
+	// Capabilities granted to each authorized role
+	RoleGrants []*GrantResponseBody `form:"role_grants,omitempty" json:"role_grants,omitempty" xml:"role_grants,omitempty"`
+	// Capabilities you hold on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Whether the secret holds named fields instead of a single value
+	Structured *bool `form:"structured,omitempty" json:"structured,omitempty" xml:"structured,omitempty"`
+	// Names of the fields of a structured secret
+	Fields []string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Version of the secret's value, incremented on every change
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Name of the generation policy used to regenerate the secret
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+	// Duration or cron expression the secret is regenerated on
+	RotationSchedule *string `form:"rotation_schedule,omitempty" json:"rotation_schedule,omitempty" xml:"rotation_schedule,omitempty"`
+	// Time of the next scheduled rotation
+	NextRotationAt *string `form:"next_rotation_at,omitempty" json:"next_rotation_at,omitempty" xml:"next_rotation_at,omitempty"`
+	// Time the value of the secret was last read, recorded with a delay of a few
+	// seconds
+	LastReadAt *string `form:"last_read_at,omitempty" json:"last_read_at,omitempty" xml:"last_read_at,omitempty"`
+	// The user who last read the value of the secret
+	LastReader *UserResponseBody `form:"last_reader,omitempty" json:"last_reader,omitempty" xml:"last_reader,omitempty"`
+	// Number of reads of the value of the secret over the last 30 days
+	RecentReads *int `form:"recent_reads,omitempty" json:"recent_reads,omitempty" xml:"recent_reads,omitempty"`
 	// Creation timestamp of the secret
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp of the secret
 	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
+// RegenerateSecretResponseBody is the type of the "secrets" service
+// "regenerate secret" endpoint HTTP response body.
+type RegenerateSecretResponseBody struct {
+	// The new version of the secret
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+}
+
+// SetSecretRotationResponseBody is the type of the "secrets" service "set
+// secret rotation" endpoint HTTP response body.
+type SetSecretRotationResponseBody struct {
+	// Time of the next scheduled rotation
+	NextRotationAt *string `form:"next_rotation_at,omitempty" json:"next_rotation_at,omitempty" xml:"next_rotation_at,omitempty"`
+}
+
+// ListSecretRotationsResponseBody is the type of the "secrets" service "list
+// secret rotations" endpoint HTTP response body.
+type ListSecretRotationsResponseBody []*SecretRotationResponse
+
+// DiffSecretsResponseBody is the type of the "secrets" service "diff secrets"
+// endpoint HTTP response body.
+type DiffSecretsResponseBody []*SecretDiffResponse
+
+// ListStaleSecretsResponseBody is the type of the "secrets" service "list
+// stale secrets" endpoint HTTP response body.
+type ListStaleSecretsResponseBody []*StaleSecretResponse
+
+// WrapSecretResponseBody is the type of the "secrets" service "wrap secret"
+// endpoint HTTP response body.
+type WrapSecretResponseBody struct {
+	// Single-use token unwrapping the value
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Expiration time of the token
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// UnwrapSecretResponseBody is the type of the "secrets" service "unwrap
+// secret" endpoint HTTP response body.
+type UnwrapSecretResponseBody struct {
+	// The wrapped value
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a wrapped structured secret
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Path of the secret the value was copied from
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Time the value was wrapped
+	WrappedAt *string `form:"wrapped_at,omitempty" json:"wrapped_at,omitempty" xml:"wrapped_at,omitempty"`
+}
+
+// RequestAccessResponseBody is the type of the "secrets" service "request
+// access" endpoint HTTP response body.
+type RequestAccessResponseBody struct {
+	// Unique identifier of the access request
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Why the access is needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListAccessRequestsResponseBody is the type of the "secrets" service "list
+// access requests" endpoint HTTP response body.
+type ListAccessRequestsResponseBody []*AccessRequestResponse
+
+// ApproveAccessRequestResponseBody is the type of the "secrets" service
+// "approve access request" endpoint HTTP response body.
+type ApproveAccessRequestResponseBody struct {
+	// Unique identifier of the access request
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Why the access is needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// DenyAccessRequestResponseBody is the type of the "secrets" service "deny
+// access request" endpoint HTTP response body.
+type DenyAccessRequestResponseBody struct {
+	// Unique identifier of the access request
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Why the access is needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListValidationRulesResponseBody is the type of the "secrets" service "list
+// validation rules" endpoint HTTP response body.
+type ListValidationRulesResponseBody []*ValidationRuleResponse
+
+// ListBreakGlassRulesResponseBody is the type of the "secrets" service "list
+// break glass rules" endpoint HTTP response body.
+type ListBreakGlassRulesResponseBody []*BreakGlassRuleResponse
+
+// ListBreakGlassAccessesResponseBody is the type of the "secrets" service
+// "list break glass accesses" endpoint HTTP response body.
+type ListBreakGlassAccessesResponseBody []*BreakGlassAccessResponse
+
+// ReviewBreakGlassAccessResponseBody is the type of the "secrets" service
+// "review break glass access" endpoint HTTP response body.
+type ReviewBreakGlassAccessResponseBody struct {
+	// Unique identifier of the emergency access
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who broke the glass
+	User *UserResponseBody `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Why the emergency access was needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Time the read access given by breaking the glass lapses at
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// The user who acknowledged the emergency access
+	ReviewedBy *UserResponseBody `form:"reviewed_by,omitempty" json:"reviewed_by,omitempty" xml:"reviewed_by,omitempty"`
+	// Time of the acknowledgement
+	ReviewedAt *string `form:"reviewed_at,omitempty" json:"reviewed_at,omitempty" xml:"reviewed_at,omitempty"`
+	// Comment left with the acknowledgement
+	ReviewComment *string `form:"review_comment,omitempty" json:"review_comment,omitempty" xml:"review_comment,omitempty"`
+	// Time the glass was broken
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListFolderAccessesResponseBody is the type of the "secrets" service "list
+// folder accesses" endpoint HTTP response body.
+type ListFolderAccessesResponseBody []*FolderAccessResponse
+
 // ListSecretsUnauthorizedResponseBody is the type of the "secrets" service
 // "list secrets" endpoint HTTP response body for the "unauthorized" error.
 type ListSecretsUnauthorizedResponseBody struct {
@@ -124,6 +552,79 @@ type ListSecretsInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// BrowseSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "browse secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type BrowseSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BrowseSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "unauthorized" error.
+type BrowseSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BrowseSecretsForbiddenResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "forbidden" error.
+type BrowseSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// BrowseSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "internal_error" error.
+type BrowseSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // GetSecretValueSecretNotFoundResponseBody is the type of the "secrets"
 // service "get secret value" endpoint HTTP response body for the
 // "secret_not_found" error.
@@ -143,6 +644,25 @@ type GetSecretValueSecretNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// GetSecretValueFieldNotFoundResponseBody is the type of the "secrets" service
+// "get secret value" endpoint HTTP response body for the "field_not_found"
+// error.
+type GetSecretValueFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // GetSecretValueInvalidParametersResponseBody is the type of the "secrets"
 // service "get secret value" endpoint HTTP response body for the
 // "invalid_parameters" error.
@@ -217,9 +737,10 @@ type GetSecretValueInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetSecretSecretNotFoundResponseBody is the type of the "secrets" service
-// "get secret" endpoint HTTP response body for the "secret_not_found" error.
-type GetSecretSecretNotFoundResponseBody struct {
+// BatchGetSecretValuesInvalidParametersResponseBody is the type of the
+// "secrets" service "batch get secret values" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type BatchGetSecretValuesInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -235,9 +756,10 @@ type GetSecretSecretNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetSecretInvalidParametersResponseBody is the type of the "secrets" service
-// "get secret" endpoint HTTP response body for the "invalid_parameters" error.
-type GetSecretInvalidParametersResponseBody struct {
+// BatchGetSecretValuesUnauthorizedResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "unauthorized" error.
+type BatchGetSecretValuesUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -253,9 +775,10 @@ type GetSecretInvalidParametersResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetSecretUnauthorizedResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "unauthorized" error.
-type GetSecretUnauthorizedResponseBody struct {
+// BatchGetSecretValuesForbiddenResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "forbidden" error.
+type BatchGetSecretValuesForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -271,9 +794,10 @@ type GetSecretUnauthorizedResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetSecretForbiddenResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "forbidden" error.
-type GetSecretForbiddenResponseBody struct {
+// BatchGetSecretValuesInternalErrorResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "internal_error" error.
+type BatchGetSecretValuesInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -289,9 +813,10 @@ type GetSecretForbiddenResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// GetSecretInternalErrorResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "internal_error" error.
-type GetSecretInternalErrorResponseBody struct {
+// RenderTemplateSecretNotFoundResponseBody is the type of the "secrets"
+// service "render template" endpoint HTTP response body for the
+// "secret_not_found" error.
+type RenderTemplateSecretNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -307,10 +832,10 @@ type GetSecretInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CreateSecretInvalidParametersResponseBody is the type of the "secrets"
-// service "create secret" endpoint HTTP response body for the
-// "invalid_parameters" error.
-type CreateSecretInvalidParametersResponseBody struct {
+// RenderTemplateFieldNotFoundResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "field_not_found"
+// error.
+type RenderTemplateFieldNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -326,9 +851,10 @@ type CreateSecretInvalidParametersResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CreateSecretUnauthorizedResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "unauthorized" error.
-type CreateSecretUnauthorizedResponseBody struct {
+// RenderTemplateInvalidParametersResponseBody is the type of the "secrets"
+// service "render template" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RenderTemplateInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -344,9 +870,9 @@ type CreateSecretUnauthorizedResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CreateSecretForbiddenResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "forbidden" error.
-type CreateSecretForbiddenResponseBody struct {
+// RenderTemplateUnauthorizedResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "unauthorized" error.
+type RenderTemplateUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -362,9 +888,9 @@ type CreateSecretForbiddenResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// CreateSecretInternalErrorResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "internal_error" error.
-type CreateSecretInternalErrorResponseBody struct {
+// RenderTemplateForbiddenResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "forbidden" error.
+type RenderTemplateForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -380,9 +906,9 @@ type CreateSecretInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UpdateSecretSecretNotFoundResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "secret_not_found" error.
-type UpdateSecretSecretNotFoundResponseBody struct {
+// RenderTemplateInternalErrorResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "internal_error" error.
+type RenderTemplateInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -398,10 +924,10 @@ type UpdateSecretSecretNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UpdateSecretInvalidParametersResponseBody is the type of the "secrets"
-// service "update secret" endpoint HTTP response body for the
-// "invalid_parameters" error.
-type UpdateSecretInvalidParametersResponseBody struct {
+// UploadSecretFileSecretTooLargeResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "secret_too_large" error.
+type UploadSecretFileSecretTooLargeResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -417,9 +943,10 @@ type UpdateSecretInvalidParametersResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UpdateSecretUnauthorizedResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "unauthorized" error.
-type UpdateSecretUnauthorizedResponseBody struct {
+// UploadSecretFileInvalidParametersResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UploadSecretFileInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -435,9 +962,10 @@ type UpdateSecretUnauthorizedResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UpdateSecretForbiddenResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "forbidden" error.
-type UpdateSecretForbiddenResponseBody struct {
+// UploadSecretFileUnauthorizedResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "unauthorized" error.
+type UploadSecretFileUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -453,9 +981,9 @@ type UpdateSecretForbiddenResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UpdateSecretInternalErrorResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "internal_error" error.
-type UpdateSecretInternalErrorResponseBody struct {
+// UploadSecretFileForbiddenResponseBody is the type of the "secrets" service
+// "upload secret file" endpoint HTTP response body for the "forbidden" error.
+type UploadSecretFileForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -471,27 +999,3140 @@ type UpdateSecretInternalErrorResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// SecretInfoSummaryResponse is used to define fields on response body types.
-type SecretInfoSummaryResponse struct {
-	// The original path of the secret
-	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
-	// The owner of the secret
-	Owner *UserResponse `form:"owner,omitempty" json:"owner,omitempty" xml:"owner,omitempty"`
-	// Creation timestamp of the secret
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// Last update timestamp of the secret
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-	// Users authorized to access the secret
-	Users []*UserResponse `form:"users,omitempty" json:"users,omitempty" xml:"users,omitempty"`
-	// Roles authorized to access the secret
-	Roles []*RoleResponse `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+// UploadSecretFileInternalErrorResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "internal_error" error.
+type UploadSecretFileInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
-// UserResponse is used to define fields on response body types.
-type UserResponse struct {
-	// Unique identifier for the user
-	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The username
+// DownloadSecretFileSecretNotFoundResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "secret_not_found" error.
+type DownloadSecretFileSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DownloadSecretFileInvalidParametersResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DownloadSecretFileInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DownloadSecretFileUnauthorizedResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "unauthorized" error.
+type DownloadSecretFileUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DownloadSecretFileForbiddenResponseBody is the type of the "secrets" service
+// "download secret file" endpoint HTTP response body for the "forbidden" error.
+type DownloadSecretFileForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DownloadSecretFileInternalErrorResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "internal_error" error.
+type DownloadSecretFileInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsSecretNotFoundResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "secret_not_found"
+// error.
+type ExportSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsFieldNotFoundResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "field_not_found" error.
+type ExportSecretsFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "export secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ExportSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "unauthorized" error.
+type ExportSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsForbiddenResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "forbidden" error.
+type ExportSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ExportSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "internal_error" error.
+type ExportSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "import secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type ImportSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsSecretTooLargeResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "secret_too_large"
+// error.
+type ImportSecretsSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "import secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ImportSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "unauthorized" error.
+type ImportSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsForbiddenResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "forbidden" error.
+type ImportSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ImportSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "internal_error" error.
+type ImportSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "get secret" endpoint HTTP response body for the "secret_not_found" error.
+type GetSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetSecretInvalidParametersResponseBody is the type of the "secrets" service
+// "get secret" endpoint HTTP response body for the "invalid_parameters" error.
+type GetSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetSecretUnauthorizedResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "unauthorized" error.
+type GetSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetSecretForbiddenResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "forbidden" error.
+type GetSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GetSecretInternalErrorResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "internal_error" error.
+type GetSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "secret_too_large" error.
+type CreateSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "create secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type CreateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "unauthorized" error.
+type CreateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSecretForbiddenResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "forbidden" error.
+type CreateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateSecretInternalErrorResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "internal_error" error.
+type CreateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "secret_not_found" error.
+type UpdateSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretFieldNotFoundResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "field_not_found" error.
+type UpdateSecretFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "secret_too_large" error.
+type UpdateSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "update secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UpdateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "unauthorized" error.
+type UpdateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretForbiddenResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "forbidden" error.
+type UpdateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UpdateSecretInternalErrorResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "internal_error" error.
+type UpdateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RegenerateSecretSecretNotFoundResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "secret_not_found" error.
+type RegenerateSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RegenerateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RegenerateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RegenerateSecretUnauthorizedResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "unauthorized" error.
+type RegenerateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RegenerateSecretForbiddenResponseBody is the type of the "secrets" service
+// "regenerate secret" endpoint HTTP response body for the "forbidden" error.
+type RegenerateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RegenerateSecretInternalErrorResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "internal_error" error.
+type RegenerateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretRotationSecretNotFoundResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "secret_not_found" error.
+type SetSecretRotationSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretRotationInvalidParametersResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type SetSecretRotationInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretRotationUnauthorizedResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "unauthorized" error.
+type SetSecretRotationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretRotationForbiddenResponseBody is the type of the "secrets" service
+// "set secret rotation" endpoint HTTP response body for the "forbidden" error.
+type SetSecretRotationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretRotationInternalErrorResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "internal_error" error.
+type SetSecretRotationInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListSecretRotationsSecretNotFoundResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "secret_not_found" error.
+type ListSecretRotationsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListSecretRotationsInvalidParametersResponseBody is the type of the
+// "secrets" service "list secret rotations" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ListSecretRotationsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListSecretRotationsUnauthorizedResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListSecretRotationsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListSecretRotationsForbiddenResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "forbidden" error.
+type ListSecretRotationsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListSecretRotationsInternalErrorResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "internal_error" error.
+type ListSecretRotationsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "secret_not_found" error.
+type DeleteSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "delete secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DeleteSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "unauthorized" error.
+type DeleteSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSecretForbiddenResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "forbidden" error.
+type DeleteSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteSecretInternalErrorResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "internal_error" error.
+type DeleteSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsSecretNotFoundResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "secret_not_found" error.
+type MoveSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "move secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type MoveSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsFolderChangedResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "folder_changed" error.
+type MoveSecretsFolderChangedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "move secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type MoveSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "unauthorized" error.
+type MoveSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsForbiddenResponseBody is the type of the "secrets" service "move
+// secrets" endpoint HTTP response body for the "forbidden" error.
+type MoveSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// MoveSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "internal_error" error.
+type MoveSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "diff secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DiffSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "diff secrets" endpoint HTTP response body for the "unauthorized" error.
+type DiffSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffSecretsForbiddenResponseBody is the type of the "secrets" service "diff
+// secrets" endpoint HTTP response body for the "forbidden" error.
+type DiffSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DiffSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "diff secrets" endpoint HTTP response body for the "internal_error" error.
+type DiffSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsSecretNotFoundResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "secret_not_found" error.
+type PromoteSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type PromoteSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type PromoteSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "unauthorized" error.
+type PromoteSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsForbiddenResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "forbidden" error.
+type PromoteSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// PromoteSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "internal_error" error.
+type PromoteSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TransferSecretOwnershipSecretNotFoundResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "secret_not_found" error.
+type TransferSecretOwnershipSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TransferSecretOwnershipInvalidParametersResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type TransferSecretOwnershipInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TransferSecretOwnershipUnauthorizedResponseBody is the type of the "secrets"
+// service "transfer secret ownership" endpoint HTTP response body for the
+// "unauthorized" error.
+type TransferSecretOwnershipUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TransferSecretOwnershipForbiddenResponseBody is the type of the "secrets"
+// service "transfer secret ownership" endpoint HTTP response body for the
+// "forbidden" error.
+type TransferSecretOwnershipForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// TransferSecretOwnershipInternalErrorResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "internal_error" error.
+type TransferSecretOwnershipInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListStaleSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ListStaleSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListStaleSecretsUnauthorizedResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListStaleSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListStaleSecretsForbiddenResponseBody is the type of the "secrets" service
+// "list stale secrets" endpoint HTTP response body for the "forbidden" error.
+type ListStaleSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListStaleSecretsInternalErrorResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "internal_error" error.
+type ListStaleSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretCanarySecretNotFoundResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "secret_not_found" error.
+type SetSecretCanarySecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretCanaryInvalidParametersResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type SetSecretCanaryInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretCanaryUnauthorizedResponseBody is the type of the "secrets" service
+// "set secret canary" endpoint HTTP response body for the "unauthorized" error.
+type SetSecretCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretCanaryForbiddenResponseBody is the type of the "secrets" service
+// "set secret canary" endpoint HTTP response body for the "forbidden" error.
+type SetSecretCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SetSecretCanaryInternalErrorResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "internal_error" error.
+type SetSecretCanaryInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListCanarySecretsUnauthorizedResponseBody is the type of the "secrets"
+// service "list canary secrets" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListCanarySecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListCanarySecretsForbiddenResponseBody is the type of the "secrets" service
+// "list canary secrets" endpoint HTTP response body for the "forbidden" error.
+type ListCanarySecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListCanarySecretsInternalErrorResponseBody is the type of the "secrets"
+// service "list canary secrets" endpoint HTTP response body for the
+// "internal_error" error.
+type ListCanarySecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "secret_not_found" error.
+type WrapSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretFieldNotFoundResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "field_not_found" error.
+type WrapSecretFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "secret_too_large" error.
+type WrapSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretInvalidParametersResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "invalid_parameters" error.
+type WrapSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "unauthorized" error.
+type WrapSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretForbiddenResponseBody is the type of the "secrets" service "wrap
+// secret" endpoint HTTP response body for the "forbidden" error.
+type WrapSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// WrapSecretInternalErrorResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "internal_error" error.
+type WrapSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UnwrapSecretTokenNotFoundResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "token_not_found" error.
+type UnwrapSecretTokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UnwrapSecretTokenExpiredResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "token_expired" error.
+type UnwrapSecretTokenExpiredResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UnwrapSecretTokenAlreadyUsedResponseBody is the type of the "secrets"
+// service "unwrap secret" endpoint HTTP response body for the
+// "token_already_used" error.
+type UnwrapSecretTokenAlreadyUsedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UnwrapSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "unwrap secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UnwrapSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// UnwrapSecretInternalErrorResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "internal_error" error.
+type UnwrapSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessSecretNotFoundResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "secret_not_found"
+// error.
+type RequestAccessSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessAccessRequestPendingResponseBody is the type of the "secrets"
+// service "request access" endpoint HTTP response body for the
+// "access_request_pending" error.
+type RequestAccessAccessRequestPendingResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessInvalidParametersResponseBody is the type of the "secrets"
+// service "request access" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RequestAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessUnauthorizedResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "unauthorized" error.
+type RequestAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessForbiddenResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "forbidden" error.
+type RequestAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RequestAccessInternalErrorResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "internal_error" error.
+type RequestAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAccessRequestsInvalidParametersResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ListAccessRequestsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAccessRequestsUnauthorizedResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListAccessRequestsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAccessRequestsForbiddenResponseBody is the type of the "secrets" service
+// "list access requests" endpoint HTTP response body for the "forbidden" error.
+type ListAccessRequestsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListAccessRequestsInternalErrorResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "internal_error" error.
+type ListAccessRequestsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestAccessRequestNotFoundResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "access_request_not_found" error.
+type ApproveAccessRequestAccessRequestNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestSecretNotFoundResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "secret_not_found" error.
+type ApproveAccessRequestSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestAccessRequestDecidedResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "access_request_decided" error.
+type ApproveAccessRequestAccessRequestDecidedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestInvalidParametersResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ApproveAccessRequestInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestUnauthorizedResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "unauthorized" error.
+type ApproveAccessRequestUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestForbiddenResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "forbidden" error.
+type ApproveAccessRequestForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ApproveAccessRequestInternalErrorResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "internal_error" error.
+type ApproveAccessRequestInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestAccessRequestNotFoundResponseBody is the type of the
+// "secrets" service "deny access request" endpoint HTTP response body for the
+// "access_request_not_found" error.
+type DenyAccessRequestAccessRequestNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestAccessRequestDecidedResponseBody is the type of the
+// "secrets" service "deny access request" endpoint HTTP response body for the
+// "access_request_decided" error.
+type DenyAccessRequestAccessRequestDecidedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestInvalidParametersResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DenyAccessRequestInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestUnauthorizedResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "unauthorized" error.
+type DenyAccessRequestUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestForbiddenResponseBody is the type of the "secrets" service
+// "deny access request" endpoint HTTP response body for the "forbidden" error.
+type DenyAccessRequestForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DenyAccessRequestInternalErrorResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "internal_error" error.
+type DenyAccessRequestInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListValidationRulesUnauthorizedResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListValidationRulesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListValidationRulesForbiddenResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "forbidden" error.
+type ListValidationRulesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListValidationRulesInternalErrorResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "internal_error" error.
+type ListValidationRulesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateValidationRuleInvalidParametersResponseBody is the type of the
+// "secrets" service "create validation rule" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type CreateValidationRuleInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateValidationRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type CreateValidationRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateValidationRuleForbiddenResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateValidationRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateValidationRuleInternalErrorResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "internal_error" error.
+type CreateValidationRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteValidationRuleValidationRuleNotFoundResponseBody is the type of the
+// "secrets" service "delete validation rule" endpoint HTTP response body for
+// the "validation_rule_not_found" error.
+type DeleteValidationRuleValidationRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteValidationRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type DeleteValidationRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteValidationRuleForbiddenResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteValidationRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteValidationRuleInternalErrorResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "internal_error" error.
+type DeleteValidationRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassRulesUnauthorizedResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListBreakGlassRulesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassRulesForbiddenResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "forbidden" error.
+type ListBreakGlassRulesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassRulesInternalErrorResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "internal_error" error.
+type ListBreakGlassRulesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateBreakGlassRuleInvalidParametersResponseBody is the type of the
+// "secrets" service "create break glass rule" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type CreateBreakGlassRuleInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateBreakGlassRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type CreateBreakGlassRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateBreakGlassRuleForbiddenResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateBreakGlassRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// CreateBreakGlassRuleInternalErrorResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "internal_error" error.
+type CreateBreakGlassRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody is the type of the
+// "secrets" service "delete break glass rule" endpoint HTTP response body for
+// the "break_glass_rule_not_found" error.
+type DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteBreakGlassRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type DeleteBreakGlassRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteBreakGlassRuleForbiddenResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteBreakGlassRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// DeleteBreakGlassRuleInternalErrorResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "internal_error" error.
+type DeleteBreakGlassRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassAccessesInvalidParametersResponseBody is the type of the
+// "secrets" service "list break glass accesses" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type ListBreakGlassAccessesInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassAccessesUnauthorizedResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListBreakGlassAccessesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassAccessesForbiddenResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "forbidden" error.
+type ListBreakGlassAccessesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListBreakGlassAccessesInternalErrorResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "internal_error" error.
+type ListBreakGlassAccessesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody is the type of
+// the "secrets" service "review break glass access" endpoint HTTP response
+// body for the "break_glass_access_not_found" error.
+type ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody is the type of
+// the "secrets" service "review break glass access" endpoint HTTP response
+// body for the "break_glass_access_reviewed" error.
+type ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessInvalidParametersResponseBody is the type of the
+// "secrets" service "review break glass access" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type ReviewBreakGlassAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "unauthorized" error.
+type ReviewBreakGlassAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessForbiddenResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "forbidden" error.
+type ReviewBreakGlassAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ReviewBreakGlassAccessInternalErrorResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "internal_error" error.
+type ReviewBreakGlassAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListFolderAccessesUnauthorizedResponseBody is the type of the "secrets"
+// service "list folder accesses" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListFolderAccessesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListFolderAccessesForbiddenResponseBody is the type of the "secrets" service
+// "list folder accesses" endpoint HTTP response body for the "forbidden" error.
+type ListFolderAccessesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// ListFolderAccessesInternalErrorResponseBody is the type of the "secrets"
+// service "list folder accesses" endpoint HTTP response body for the
+// "internal_error" error.
+type ListFolderAccessesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GrantFolderAccessInvalidParametersResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type GrantFolderAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GrantFolderAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "unauthorized" error.
+type GrantFolderAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GrantFolderAccessForbiddenResponseBody is the type of the "secrets" service
+// "grant folder access" endpoint HTTP response body for the "forbidden" error.
+type GrantFolderAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// GrantFolderAccessInternalErrorResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "internal_error" error.
+type GrantFolderAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeFolderAccessFolderAccessNotFoundResponseBody is the type of the
+// "secrets" service "revoke folder access" endpoint HTTP response body for the
+// "folder_access_not_found" error.
+type RevokeFolderAccessFolderAccessNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeFolderAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "revoke folder access" endpoint HTTP response body for the
+// "unauthorized" error.
+type RevokeFolderAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeFolderAccessForbiddenResponseBody is the type of the "secrets" service
+// "revoke folder access" endpoint HTTP response body for the "forbidden" error.
+type RevokeFolderAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// RevokeFolderAccessInternalErrorResponseBody is the type of the "secrets"
+// service "revoke folder access" endpoint HTTP response body for the
+// "internal_error" error.
+type RevokeFolderAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
+// SecretInfoSummaryResponse is used to define fields on response body types.
+type SecretInfoSummaryResponse struct {
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The owner of the secret
+	Owner *UserResponse `form:"owner,omitempty" json:"owner,omitempty" xml:"owner,omitempty"`
+	// The role owning the secret, in place of a user
+	OwnerRole *RoleResponse `form:"owner_role,omitempty" json:"owner_role,omitempty" xml:"owner_role,omitempty"`
+	// Creation timestamp of the secret
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp of the secret
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Users authorized to access the secret
+	Users []*UserResponse `form:"users,omitempty" json:"users,omitempty" xml:"users,omitempty"`
+	// Roles authorized to access the secret
+	Roles []*RoleResponse `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+}
+
+// UserResponse is used to define fields on response body types.
+type UserResponse struct {
+	// Unique identifier for the user
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The username
 	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 	// User creation timestamp
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
@@ -501,523 +4142,8331 @@ type UserResponse struct {
 	Roles []*RoleResponse `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
 }
 
-// RoleResponse is used to define fields on response body types.
-type RoleResponse struct {
-	// Unique identifier for the role
-	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of the role
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Color associated with the role
-	Color *string `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
-	// Is this role an admin role?
-	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
-	// Role creation timestamp
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// Role last update timestamp
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+// RoleResponse is used to define fields on response body types.
+type RoleResponse struct {
+	// Unique identifier for the role
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the role
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Color associated with the role
+	Color *string `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+	// Is this role an admin role?
+	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// Role creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Role last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// SecretEntryResponseBody is used to define fields on response body types.
+type SecretEntryResponseBody struct {
+	// The full path of the folder or secret, folders end with a '/'
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The last segment of the path
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Whether the entry is a folder
+	Folder *bool `form:"folder,omitempty" json:"folder,omitempty" xml:"folder,omitempty"`
+	// Creation timestamp of the secret, absent for folders
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp of the secret, absent for folders
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// SecretValueRequestRequestBody is used to define fields on request body types.
+type SecretValueRequestRequestBody struct {
+	// Base64 encoded secret's path
+	Path string `form:"path" json:"path" xml:"path"`
+	// Name of the field to retrieve from a structured secret
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+}
+
+// SecretValueResponseBody is used to define fields on response body types.
+type SecretValueResponseBody struct {
+	// Base64 encoded secret's path, as requested
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The secret value, or the value of the requested field
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte `form:"raw_value,omitempty" json:"raw_value,omitempty" xml:"raw_value,omitempty"`
+	// Content type of a binary secret
+	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty" xml:"content_type,omitempty"`
+	// Original filename of a binary secret
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty" xml:"filename,omitempty"`
+	// Why the value could not be read, the other fields are absent when set
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Description of the error
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
+}
+
+// ImportedSecretResponse is used to define fields on response body types.
+type ImportedSecretResponse struct {
+	// Key of the secret in the imported file
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Path the secret is imported to
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// What the import does to the secret
+	Action *string `form:"action,omitempty" json:"action,omitempty" xml:"action,omitempty"`
+}
+
+// UserResponseBody is used to define fields on response body types.
+type UserResponseBody struct {
+	// Unique identifier for the user
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// User creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// User last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Roles assigned to the user
+	Roles []*RoleResponseBody `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+}
+
+// RoleResponseBody is used to define fields on response body types.
+type RoleResponseBody struct {
+	// Unique identifier for the role
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the role
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Color associated with the role
+	Color *string `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
+	// Is this role an admin role?
+	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
+	// Role creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Role last update timestamp
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// GrantResponseBody is used to define fields on response body types.
+type GrantResponseBody struct {
+	// ID of the user or role the capabilities are granted to
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Capabilities granted on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Time the grant lapses at, it never does when absent
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Seconds left before the grant lapses, only set in responses
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// GrantRequestBody is used to define fields on request body types.
+type GrantRequestBody struct {
+	// ID of the user or role the capabilities are granted to
+	ID int `form:"id" json:"id" xml:"id"`
+	// Capabilities granted on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Time the grant lapses at, it never does when absent
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Seconds left before the grant lapses, only set in responses
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// SecretRotationResponse is used to define fields on response body types.
+type SecretRotationResponse struct {
+	// Version of the secret after the rotation, unchanged when it failed
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// Whether the rotation was scheduled, or requested by a user
+	Scheduled *bool `form:"scheduled,omitempty" json:"scheduled,omitempty" xml:"scheduled,omitempty"`
+	// Why the rotation failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Time of the rotation
+	RotatedAt *string `form:"rotated_at,omitempty" json:"rotated_at,omitempty" xml:"rotated_at,omitempty"`
+}
+
+// SecretDiffResponse is used to define fields on response body types.
+type SecretDiffResponse struct {
+	// Path of the secret relative to the compared folders
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// missing when the secret is only in the source folder, extra when it is only
+	// in the destination folder, different or identical when the hashes of their
+	// values differ or not, inaccessible when you cannot read one of them
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+}
+
+// StaleSecretResponse is used to define fields on response body types.
+type StaleSecretResponse struct {
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Time the value of the secret was last read, unset when it was never read
+	LastReadAt *string `form:"last_read_at,omitempty" json:"last_read_at,omitempty" xml:"last_read_at,omitempty"`
+	// The user who last read the value of the secret
+	LastReader *UserResponse `form:"last_reader,omitempty" json:"last_reader,omitempty" xml:"last_reader,omitempty"`
+	// Creation timestamp of the secret
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// AccessRequestResponse is used to define fields on response body types.
+type AccessRequestResponse struct {
+	// Unique identifier of the access request
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who filed the request
+	Requester *UserResponse `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Why the access is needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponse `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ValidationRuleResponse is used to define fields on response body types.
+type ValidationRuleResponse struct {
+	// Unique identifier of the rule
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The folder path whose secrets the rule applies to, ends with a '/'
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// What the rule checks
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, empty for x509_certificate rules
+	Parameter *string `form:"parameter,omitempty" json:"parameter,omitempty" xml:"parameter,omitempty"`
+	// The only field of structured secrets the rule applies to, it applies to
+	// every field when absent
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Creation timestamp of the rule
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// BreakGlassRuleResponse is used to define fields on response body types.
+type BreakGlassRuleResponse struct {
+	// Unique identifier of the rule
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The folder path whose secrets are eligible to break-glass access, ends with
+	// a '/'
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// The only role whose members can break the glass, anyone can when absent
+	Role *RoleResponse `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Creation timestamp of the rule
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// BreakGlassAccessResponse is used to define fields on response body types.
+type BreakGlassAccessResponse struct {
+	// Unique identifier of the emergency access
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The original path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// The user who broke the glass
+	User *UserResponse `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Why the emergency access was needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Time the read access given by breaking the glass lapses at
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// The user who acknowledged the emergency access
+	ReviewedBy *UserResponse `form:"reviewed_by,omitempty" json:"reviewed_by,omitempty" xml:"reviewed_by,omitempty"`
+	// Time of the acknowledgement
+	ReviewedAt *string `form:"reviewed_at,omitempty" json:"reviewed_at,omitempty" xml:"reviewed_at,omitempty"`
+	// Comment left with the acknowledgement
+	ReviewComment *string `form:"review_comment,omitempty" json:"review_comment,omitempty" xml:"review_comment,omitempty"`
+	// Time the glass was broken
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// FolderAccessResponse is used to define fields on response body types.
+type FolderAccessResponse struct {
+	// Unique identifier of the folder access
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// The folder path the access applies to, ends with a '/'
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// The user granted access to the folder
+	User *UserResponse `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// The role granted access to the folder
+	Role *RoleResponse `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Creation timestamp of the folder access
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// NewBatchGetSecretValuesRequestBody builds the HTTP request body from the
+// payload of the "batch get secret values" endpoint of the "secrets" service.
+func NewBatchGetSecretValuesRequestBody(p *secrets.BatchGetSecretValuesPayload) *BatchGetSecretValuesRequestBody {
+	body := &BatchGetSecretValuesRequestBody{}
+	if p.Secrets != nil {
+		body.Secrets = make([]*SecretValueRequestRequestBody, len(p.Secrets))
+		for i, val := range p.Secrets {
+			body.Secrets[i] = marshalSecretsSecretValueRequestToSecretValueRequestRequestBody(val)
+		}
+	} else {
+		body.Secrets = []*SecretValueRequestRequestBody{}
+	}
+	return body
+}
+
+// NewRenderTemplateRequestBody builds the HTTP request body from the payload
+// of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateRequestBody(p *secrets.RenderTemplatePayload) *RenderTemplateRequestBody {
+	body := &RenderTemplateRequestBody{
+		Template:     p.Template,
+		TemplatePath: p.TemplatePath,
+	}
+	return body
+}
+
+// NewCreateSecretRequestBody builds the HTTP request body from the payload of
+// the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretRequestBody(p *secrets.CreateSecretPayload) *CreateSecretRequestBody {
+	body := &CreateSecretRequestBody{
+		Path:             p.Path,
+		Value:            p.Value,
+		GenerationPolicy: p.GenerationPolicy,
+		RotationSchedule: p.RotationSchedule,
+	}
+	if p.Fields != nil {
+		body.Fields = make(map[string]string, len(p.Fields))
+		for key, val := range p.Fields {
+			tk := key
+			tv := val
+			body.Fields[tk] = tv
+		}
+	}
+	if p.AuthorizedUsers != nil {
+		body.AuthorizedUsers = make([]int, len(p.AuthorizedUsers))
+		for i, val := range p.AuthorizedUsers {
+			body.AuthorizedUsers[i] = val
+		}
+	} else {
+		body.AuthorizedUsers = []int{}
+	}
+	if p.AuthorizedRoles != nil {
+		body.AuthorizedRoles = make([]int, len(p.AuthorizedRoles))
+		for i, val := range p.AuthorizedRoles {
+			body.AuthorizedRoles[i] = val
+		}
+	} else {
+		body.AuthorizedRoles = []int{}
+	}
+	if p.UserGrants != nil {
+		body.UserGrants = make([]*GrantRequestBody, len(p.UserGrants))
+		for i, val := range p.UserGrants {
+			body.UserGrants[i] = marshalSecretsGrantToGrantRequestBody(val)
+		}
+	}
+	if p.RoleGrants != nil {
+		body.RoleGrants = make([]*GrantRequestBody, len(p.RoleGrants))
+		for i, val := range p.RoleGrants {
+			body.RoleGrants[i] = marshalSecretsGrantToGrantRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewUpdateSecretRequestBody builds the HTTP request body from the payload of
+// the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretRequestBody(p *secrets.UpdateSecretPayload) *UpdateSecretRequestBody {
+	body := &UpdateSecretRequestBody{
+		Path:  p.Path,
+		Value: p.Value,
+	}
+	if p.Fields != nil {
+		body.Fields = make(map[string]string, len(p.Fields))
+		for key, val := range p.Fields {
+			tk := key
+			tv := val
+			body.Fields[tk] = tv
+		}
+	}
+	if p.RemoveFields != nil {
+		body.RemoveFields = make([]string, len(p.RemoveFields))
+		for i, val := range p.RemoveFields {
+			body.RemoveFields[i] = val
+		}
+	}
+	if p.AuthorizedUsers != nil {
+		body.AuthorizedUsers = make([]int, len(p.AuthorizedUsers))
+		for i, val := range p.AuthorizedUsers {
+			body.AuthorizedUsers[i] = val
+		}
+	}
+	if p.AuthorizedRoles != nil {
+		body.AuthorizedRoles = make([]int, len(p.AuthorizedRoles))
+		for i, val := range p.AuthorizedRoles {
+			body.AuthorizedRoles[i] = val
+		}
+	}
+	if p.UserGrants != nil {
+		body.UserGrants = make([]*GrantRequestBody, len(p.UserGrants))
+		for i, val := range p.UserGrants {
+			body.UserGrants[i] = marshalSecretsGrantToGrantRequestBody(val)
+		}
+	}
+	if p.RoleGrants != nil {
+		body.RoleGrants = make([]*GrantRequestBody, len(p.RoleGrants))
+		for i, val := range p.RoleGrants {
+			body.RoleGrants[i] = marshalSecretsGrantToGrantRequestBody(val)
+		}
+	}
+	return body
+}
+
+// NewRegenerateSecretRequestBody builds the HTTP request body from the payload
+// of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretRequestBody(p *secrets.RegenerateSecretPayload) *RegenerateSecretRequestBody {
+	body := &RegenerateSecretRequestBody{
+		GenerationPolicy: p.GenerationPolicy,
+	}
+	return body
+}
+
+// NewSetSecretRotationRequestBody builds the HTTP request body from the
+// payload of the "set secret rotation" endpoint of the "secrets" service.
+func NewSetSecretRotationRequestBody(p *secrets.SetSecretRotationPayload) *SetSecretRotationRequestBody {
+	body := &SetSecretRotationRequestBody{
+		Schedule: p.Schedule,
+	}
+	return body
+}
+
+// NewMoveSecretsRequestBody builds the HTTP request body from the payload of
+// the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsRequestBody(p *secrets.MoveSecretsPayload) *MoveSecretsRequestBody {
+	body := &MoveSecretsRequestBody{
+		Source:      p.Source,
+		Destination: p.Destination,
+		Overwrite:   p.Overwrite,
+	}
+	{
+		var zero bool
+		if body.Overwrite == zero {
+			body.Overwrite = false
+		}
+	}
+	return body
+}
+
+// NewPromoteSecretsRequestBody builds the HTTP request body from the payload
+// of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsRequestBody(p *secrets.PromoteSecretsPayload) *PromoteSecretsRequestBody {
+	body := &PromoteSecretsRequestBody{
+		Source:      p.Source,
+		Destination: p.Destination,
+		Overwrite:   p.Overwrite,
+	}
+	if p.Keys != nil {
+		body.Keys = make([]string, len(p.Keys))
+		for i, val := range p.Keys {
+			body.Keys[i] = val
+		}
+	} else {
+		body.Keys = []string{}
+	}
+	{
+		var zero bool
+		if body.Overwrite == zero {
+			body.Overwrite = false
+		}
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipRequestBody builds the HTTP request body from the
+// payload of the "transfer secret ownership" endpoint of the "secrets" service.
+func NewTransferSecretOwnershipRequestBody(p *secrets.TransferSecretOwnershipPayload) *TransferSecretOwnershipRequestBody {
+	body := &TransferSecretOwnershipRequestBody{
+		Path:       p.Path,
+		FromUserID: p.FromUserID,
+		ToUserID:   p.ToUserID,
+		ToRoleID:   p.ToRoleID,
+	}
+	return body
+}
+
+// NewSetSecretCanaryRequestBody builds the HTTP request body from the payload
+// of the "set secret canary" endpoint of the "secrets" service.
+func NewSetSecretCanaryRequestBody(p *secrets.SetSecretCanaryPayload) *SetSecretCanaryRequestBody {
+	body := &SetSecretCanaryRequestBody{
+		Canary: p.Canary,
+	}
+	return body
+}
+
+// NewWrapSecretRequestBody builds the HTTP request body from the payload of
+// the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretRequestBody(p *secrets.WrapSecretPayload) *WrapSecretRequestBody {
+	body := &WrapSecretRequestBody{
+		Value: p.Value,
+		Path:  p.Path,
+		Field: p.Field,
+		TTL:   p.TTL,
+	}
+	{
+		var zero int
+		if body.TTL == zero {
+			body.TTL = 300
+		}
+	}
+	return body
+}
+
+// NewUnwrapSecretRequestBody builds the HTTP request body from the payload of
+// the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretRequestBody(p *secrets.UnwrapSecretPayload) *UnwrapSecretRequestBody {
+	body := &UnwrapSecretRequestBody{
+		Token: p.Token,
+	}
+	return body
+}
+
+// NewRequestAccessRequestBody builds the HTTP request body from the payload of
+// the "request access" endpoint of the "secrets" service.
+func NewRequestAccessRequestBody(p *secrets.RequestAccessPayload) *RequestAccessRequestBody {
+	body := &RequestAccessRequestBody{
+		Path:          p.Path,
+		Justification: p.Justification,
+		Duration:      p.Duration,
+	}
+	if p.Capabilities != nil {
+		body.Capabilities = make([]string, len(p.Capabilities))
+		for i, val := range p.Capabilities {
+			body.Capabilities[i] = val
+		}
+	}
+	if p.Capabilities == nil {
+		body.Capabilities = []string{"read", "list"}
+	}
+	return body
+}
+
+// NewApproveAccessRequestRequestBody builds the HTTP request body from the
+// payload of the "approve access request" endpoint of the "secrets" service.
+func NewApproveAccessRequestRequestBody(p *secrets.ApproveAccessRequestPayload) *ApproveAccessRequestRequestBody {
+	body := &ApproveAccessRequestRequestBody{
+		Comment: p.Comment,
+	}
+	return body
+}
+
+// NewDenyAccessRequestRequestBody builds the HTTP request body from the
+// payload of the "deny access request" endpoint of the "secrets" service.
+func NewDenyAccessRequestRequestBody(p *secrets.DenyAccessRequestPayload) *DenyAccessRequestRequestBody {
+	body := &DenyAccessRequestRequestBody{
+		Comment: p.Comment,
+	}
+	return body
+}
+
+// NewCreateValidationRuleRequestBody builds the HTTP request body from the
+// payload of the "create validation rule" endpoint of the "secrets" service.
+func NewCreateValidationRuleRequestBody(p *secrets.CreateValidationRulePayload) *CreateValidationRuleRequestBody {
+	body := &CreateValidationRuleRequestBody{
+		PathPrefix: p.PathPrefix,
+		Kind:       p.Kind,
+		Parameter:  p.Parameter,
+		Field:      p.Field,
+	}
+	return body
+}
+
+// NewCreateBreakGlassRuleRequestBody builds the HTTP request body from the
+// payload of the "create break glass rule" endpoint of the "secrets" service.
+func NewCreateBreakGlassRuleRequestBody(p *secrets.CreateBreakGlassRulePayload) *CreateBreakGlassRuleRequestBody {
+	body := &CreateBreakGlassRuleRequestBody{
+		PathPrefix: p.PathPrefix,
+		RoleID:     p.RoleID,
+	}
+	return body
+}
+
+// NewReviewBreakGlassAccessRequestBody builds the HTTP request body from the
+// payload of the "review break glass access" endpoint of the "secrets" service.
+func NewReviewBreakGlassAccessRequestBody(p *secrets.ReviewBreakGlassAccessPayload) *ReviewBreakGlassAccessRequestBody {
+	body := &ReviewBreakGlassAccessRequestBody{
+		Comment: p.Comment,
+	}
+	return body
+}
+
+// NewGrantFolderAccessRequestBody builds the HTTP request body from the
+// payload of the "grant folder access" endpoint of the "secrets" service.
+func NewGrantFolderAccessRequestBody(p *secrets.GrantFolderAccessPayload) *GrantFolderAccessRequestBody {
+	body := &GrantFolderAccessRequestBody{
+		PathPrefix: p.PathPrefix,
+		UserID:     p.UserID,
+		RoleID:     p.RoleID,
+	}
+	if p.Capabilities != nil {
+		body.Capabilities = make([]string, len(p.Capabilities))
+		for i, val := range p.Capabilities {
+			body.Capabilities[i] = val
+		}
+	}
+	if p.Capabilities == nil {
+		body.Capabilities = []string{"read", "list"}
+	}
+	return body
+}
+
+// NewListSecretsSecretInfoSummaryOK builds a "secrets" service "list secrets"
+// endpoint result from a HTTP "OK" response.
+func NewListSecretsSecretInfoSummaryOK(body []*SecretInfoSummaryResponse) []*secrets.SecretInfoSummary {
+	v := make([]*secrets.SecretInfoSummary, len(body))
+	for i, val := range body {
+		v[i] = unmarshalSecretInfoSummaryResponseToSecretsSecretInfoSummary(val)
+	}
+
+	return v
+}
+
+// NewListSecretsUnauthorized builds a secrets service list secrets endpoint
+// unauthorized error.
+func NewListSecretsUnauthorized(body *ListSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretsForbidden builds a secrets service list secrets endpoint
+// forbidden error.
+func NewListSecretsForbidden(body *ListSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretsInternalError builds a secrets service list secrets endpoint
+// internal_error error.
+func NewListSecretsInternalError(body *ListSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBrowseSecretsResultOK builds a "secrets" service "browse secrets"
+// endpoint result from a HTTP "OK" response.
+func NewBrowseSecretsResultOK(body *BrowseSecretsResponseBody) *secrets.BrowseSecretsResult {
+	v := &secrets.BrowseSecretsResult{
+		NextCursor: body.NextCursor,
+	}
+	v.Entries = make([]*secrets.SecretEntry, len(body.Entries))
+	for i, val := range body.Entries {
+		v.Entries[i] = unmarshalSecretEntryResponseBodyToSecretsSecretEntry(val)
+	}
+
+	return v
+}
+
+// NewBrowseSecretsInvalidParameters builds a secrets service browse secrets
+// endpoint invalid_parameters error.
+func NewBrowseSecretsInvalidParameters(body *BrowseSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBrowseSecretsUnauthorized builds a secrets service browse secrets
+// endpoint unauthorized error.
+func NewBrowseSecretsUnauthorized(body *BrowseSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBrowseSecretsForbidden builds a secrets service browse secrets endpoint
+// forbidden error.
+func NewBrowseSecretsForbidden(body *BrowseSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBrowseSecretsInternalError builds a secrets service browse secrets
+// endpoint internal_error error.
+func NewBrowseSecretsInternalError(body *BrowseSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueResultOK builds a "secrets" service "get secret value"
+// endpoint result from a HTTP "OK" response.
+func NewGetSecretValueResultOK(body *GetSecretValueResponseBody) *secrets.GetSecretValueResult {
+	v := &secrets.GetSecretValueResult{
+		Value:               body.Value,
+		Path:                body.Path,
+		BreakGlassExpiresAt: body.BreakGlassExpiresAt,
+	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewGetSecretValueSecretNotFound builds a secrets service get secret value
+// endpoint secret_not_found error.
+func NewGetSecretValueSecretNotFound(body *GetSecretValueSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueFieldNotFound builds a secrets service get secret value
+// endpoint field_not_found error.
+func NewGetSecretValueFieldNotFound(body *GetSecretValueFieldNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueInvalidParameters builds a secrets service get secret value
+// endpoint invalid_parameters error.
+func NewGetSecretValueInvalidParameters(body *GetSecretValueInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueUnauthorized builds a secrets service get secret value
+// endpoint unauthorized error.
+func NewGetSecretValueUnauthorized(body *GetSecretValueUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueForbidden builds a secrets service get secret value
+// endpoint forbidden error.
+func NewGetSecretValueForbidden(body *GetSecretValueForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretValueInternalError builds a secrets service get secret value
+// endpoint internal_error error.
+func NewGetSecretValueInternalError(body *GetSecretValueInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBatchGetSecretValuesResultOK builds a "secrets" service "batch get secret
+// values" endpoint result from a HTTP "OK" response.
+func NewBatchGetSecretValuesResultOK(body *BatchGetSecretValuesResponseBody) *secrets.BatchGetSecretValuesResult {
+	v := &secrets.BatchGetSecretValuesResult{}
+	v.Secrets = make([]*secrets.SecretValue, len(body.Secrets))
+	for i, val := range body.Secrets {
+		v.Secrets[i] = unmarshalSecretValueResponseBodyToSecretsSecretValue(val)
+	}
+
+	return v
+}
+
+// NewBatchGetSecretValuesInvalidParameters builds a secrets service batch get
+// secret values endpoint invalid_parameters error.
+func NewBatchGetSecretValuesInvalidParameters(body *BatchGetSecretValuesInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBatchGetSecretValuesUnauthorized builds a secrets service batch get
+// secret values endpoint unauthorized error.
+func NewBatchGetSecretValuesUnauthorized(body *BatchGetSecretValuesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBatchGetSecretValuesForbidden builds a secrets service batch get secret
+// values endpoint forbidden error.
+func NewBatchGetSecretValuesForbidden(body *BatchGetSecretValuesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewBatchGetSecretValuesInternalError builds a secrets service batch get
+// secret values endpoint internal_error error.
+func NewBatchGetSecretValuesInternalError(body *BatchGetSecretValuesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateResultOK builds a "secrets" service "render template"
+// endpoint result from a HTTP "OK" response.
+func NewRenderTemplateResultOK(body *RenderTemplateResponseBody) *secrets.RenderTemplateResult {
+	v := &secrets.RenderTemplateResult{
+		Rendered: *body.Rendered,
+	}
+
+	return v
+}
+
+// NewRenderTemplateSecretNotFound builds a secrets service render template
+// endpoint secret_not_found error.
+func NewRenderTemplateSecretNotFound(body *RenderTemplateSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateFieldNotFound builds a secrets service render template
+// endpoint field_not_found error.
+func NewRenderTemplateFieldNotFound(body *RenderTemplateFieldNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateInvalidParameters builds a secrets service render template
+// endpoint invalid_parameters error.
+func NewRenderTemplateInvalidParameters(body *RenderTemplateInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateUnauthorized builds a secrets service render template
+// endpoint unauthorized error.
+func NewRenderTemplateUnauthorized(body *RenderTemplateUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateForbidden builds a secrets service render template endpoint
+// forbidden error.
+func NewRenderTemplateForbidden(body *RenderTemplateForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRenderTemplateInternalError builds a secrets service render template
+// endpoint internal_error error.
+func NewRenderTemplateInternalError(body *RenderTemplateInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSecretFileSecretTooLarge builds a secrets service upload secret
+// file endpoint secret_too_large error.
+func NewUploadSecretFileSecretTooLarge(body *UploadSecretFileSecretTooLargeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSecretFileInvalidParameters builds a secrets service upload secret
+// file endpoint invalid_parameters error.
+func NewUploadSecretFileInvalidParameters(body *UploadSecretFileInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSecretFileUnauthorized builds a secrets service upload secret file
+// endpoint unauthorized error.
+func NewUploadSecretFileUnauthorized(body *UploadSecretFileUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSecretFileForbidden builds a secrets service upload secret file
+// endpoint forbidden error.
+func NewUploadSecretFileForbidden(body *UploadSecretFileForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUploadSecretFileInternalError builds a secrets service upload secret file
+// endpoint internal_error error.
+func NewUploadSecretFileInternalError(body *UploadSecretFileInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSecretFileResultOK builds a "secrets" service "download secret
+// file" endpoint result from a HTTP "OK" response.
+func NewDownloadSecretFileResultOK(contentType string, contentDisposition string, contentLength int64) *secrets.DownloadSecretFileResult {
+	v := &secrets.DownloadSecretFileResult{}
+	v.ContentType = contentType
+	v.ContentDisposition = contentDisposition
+	v.ContentLength = contentLength
+
+	return v
+}
+
+// NewDownloadSecretFileSecretNotFound builds a secrets service download secret
+// file endpoint secret_not_found error.
+func NewDownloadSecretFileSecretNotFound(body *DownloadSecretFileSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSecretFileInvalidParameters builds a secrets service download
+// secret file endpoint invalid_parameters error.
+func NewDownloadSecretFileInvalidParameters(body *DownloadSecretFileInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSecretFileUnauthorized builds a secrets service download secret
+// file endpoint unauthorized error.
+func NewDownloadSecretFileUnauthorized(body *DownloadSecretFileUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSecretFileForbidden builds a secrets service download secret file
+// endpoint forbidden error.
+func NewDownloadSecretFileForbidden(body *DownloadSecretFileForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDownloadSecretFileInternalError builds a secrets service download secret
+// file endpoint internal_error error.
+func NewDownloadSecretFileInternalError(body *DownloadSecretFileInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsResultOK builds a "secrets" service "export secrets"
+// endpoint result from a HTTP "OK" response.
+func NewExportSecretsResultOK(contentType string, contentDisposition string, contentLength int64) *secrets.ExportSecretsResult {
+	v := &secrets.ExportSecretsResult{}
+	v.ContentType = contentType
+	v.ContentDisposition = contentDisposition
+	v.ContentLength = contentLength
+
+	return v
+}
+
+// NewExportSecretsSecretNotFound builds a secrets service export secrets
+// endpoint secret_not_found error.
+func NewExportSecretsSecretNotFound(body *ExportSecretsSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsFieldNotFound builds a secrets service export secrets
+// endpoint field_not_found error.
+func NewExportSecretsFieldNotFound(body *ExportSecretsFieldNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsInvalidParameters builds a secrets service export secrets
+// endpoint invalid_parameters error.
+func NewExportSecretsInvalidParameters(body *ExportSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsUnauthorized builds a secrets service export secrets
+// endpoint unauthorized error.
+func NewExportSecretsUnauthorized(body *ExportSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsForbidden builds a secrets service export secrets endpoint
+// forbidden error.
+func NewExportSecretsForbidden(body *ExportSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewExportSecretsInternalError builds a secrets service export secrets
+// endpoint internal_error error.
+func NewExportSecretsInternalError(body *ExportSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsImportedSecretOK builds a "secrets" service "import secrets"
+// endpoint result from a HTTP "OK" response.
+func NewImportSecretsImportedSecretOK(body []*ImportedSecretResponse) []*secrets.ImportedSecret {
+	v := make([]*secrets.ImportedSecret, len(body))
+	for i, val := range body {
+		v[i] = unmarshalImportedSecretResponseToSecretsImportedSecret(val)
+	}
+
+	return v
+}
+
+// NewImportSecretsSecretAlreadyExists builds a secrets service import secrets
+// endpoint secret_already_exists error.
+func NewImportSecretsSecretAlreadyExists(body *ImportSecretsSecretAlreadyExistsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsSecretTooLarge builds a secrets service import secrets
+// endpoint secret_too_large error.
+func NewImportSecretsSecretTooLarge(body *ImportSecretsSecretTooLargeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsInvalidParameters builds a secrets service import secrets
+// endpoint invalid_parameters error.
+func NewImportSecretsInvalidParameters(body *ImportSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsUnauthorized builds a secrets service import secrets
+// endpoint unauthorized error.
+func NewImportSecretsUnauthorized(body *ImportSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsForbidden builds a secrets service import secrets endpoint
+// forbidden error.
+func NewImportSecretsForbidden(body *ImportSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewImportSecretsInternalError builds a secrets service import secrets
+// endpoint internal_error error.
+func NewImportSecretsInternalError(body *ImportSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretSecretInfoOK builds a "secrets" service "get secret" endpoint
+// result from a HTTP "OK" response.
+func NewGetSecretSecretInfoOK(body *GetSecretResponseBody) *secrets.SecretInfo {
+	v := &secrets.SecretInfo{
+		Path:             *body.Path,
+		Structured:       *body.Structured,
+		Version:          *body.Version,
+		GenerationPolicy: body.GenerationPolicy,
+		RotationSchedule: body.RotationSchedule,
+		NextRotationAt:   body.NextRotationAt,
+		LastReadAt:       body.LastReadAt,
+		RecentReads:      *body.RecentReads,
+		CreatedAt:        *body.CreatedAt,
+		UpdatedAt:        *body.UpdatedAt,
+	}
+	if body.Owner != nil {
+		v.Owner = unmarshalUserResponseBodyToSecretsUser(body.Owner)
+	}
+	if body.OwnerRole != nil {
+		v.OwnerRole = unmarshalRoleResponseBodyToSecretsRole(body.OwnerRole)
+	}
+	v.AuthorizedUsers = make([]*secrets.User, len(body.AuthorizedUsers))
+	for i, val := range body.AuthorizedUsers {
+		v.AuthorizedUsers[i] = unmarshalUserResponseBodyToSecretsUser(val)
+	}
+	v.AuthorizedRoles = make([]*secrets.Role, len(body.AuthorizedRoles))
+	for i, val := range body.AuthorizedRoles {
+		v.AuthorizedRoles[i] = unmarshalRoleResponseBodyToSecretsRole(val)
+	}
+	v.UserGrants = make([]*secrets.Grant, len(body.UserGrants))
+	for i, val := range body.UserGrants {
+		v.UserGrants[i] = unmarshalGrantResponseBodyToSecretsGrant(val)
+	}
+	v.RoleGrants = make([]*secrets.Grant, len(body.RoleGrants))
+	for i, val := range body.RoleGrants {
+		v.RoleGrants[i] = unmarshalGrantResponseBodyToSecretsGrant(val)
+	}
+	v.Capabilities = make([]string, len(body.Capabilities))
+	for i, val := range body.Capabilities {
+		v.Capabilities[i] = val
+	}
+	if body.Fields != nil {
+		v.Fields = make([]string, len(body.Fields))
+		for i, val := range body.Fields {
+			v.Fields[i] = val
+		}
+	}
+	if body.LastReader != nil {
+		v.LastReader = unmarshalUserResponseBodyToSecretsUser(body.LastReader)
+	}
+
+	return v
+}
+
+// NewGetSecretSecretNotFound builds a secrets service get secret endpoint
+// secret_not_found error.
+func NewGetSecretSecretNotFound(body *GetSecretSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretInvalidParameters builds a secrets service get secret endpoint
+// invalid_parameters error.
+func NewGetSecretInvalidParameters(body *GetSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretUnauthorized builds a secrets service get secret endpoint
+// unauthorized error.
+func NewGetSecretUnauthorized(body *GetSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretForbidden builds a secrets service get secret endpoint forbidden
+// error.
+func NewGetSecretForbidden(body *GetSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGetSecretInternalError builds a secrets service get secret endpoint
+// internal_error error.
+func NewGetSecretInternalError(body *GetSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateSecretSecretTooLarge builds a secrets service create secret
+// endpoint secret_too_large error.
+func NewCreateSecretSecretTooLarge(body *CreateSecretSecretTooLargeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateSecretInvalidParameters builds a secrets service create secret
+// endpoint invalid_parameters error.
+func NewCreateSecretInvalidParameters(body *CreateSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateSecretUnauthorized builds a secrets service create secret endpoint
+// unauthorized error.
+func NewCreateSecretUnauthorized(body *CreateSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateSecretForbidden builds a secrets service create secret endpoint
+// forbidden error.
+func NewCreateSecretForbidden(body *CreateSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateSecretInternalError builds a secrets service create secret endpoint
+// internal_error error.
+func NewCreateSecretInternalError(body *CreateSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretSecretNotFound builds a secrets service update secret
+// endpoint secret_not_found error.
+func NewUpdateSecretSecretNotFound(body *UpdateSecretSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretFieldNotFound builds a secrets service update secret endpoint
+// field_not_found error.
+func NewUpdateSecretFieldNotFound(body *UpdateSecretFieldNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretSecretTooLarge builds a secrets service update secret
+// endpoint secret_too_large error.
+func NewUpdateSecretSecretTooLarge(body *UpdateSecretSecretTooLargeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretInvalidParameters builds a secrets service update secret
+// endpoint invalid_parameters error.
+func NewUpdateSecretInvalidParameters(body *UpdateSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretUnauthorized builds a secrets service update secret endpoint
+// unauthorized error.
+func NewUpdateSecretUnauthorized(body *UpdateSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretForbidden builds a secrets service update secret endpoint
+// forbidden error.
+func NewUpdateSecretForbidden(body *UpdateSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUpdateSecretInternalError builds a secrets service update secret endpoint
+// internal_error error.
+func NewUpdateSecretInternalError(body *UpdateSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretResultOK builds a "secrets" service "regenerate secret"
+// endpoint result from a HTTP "OK" response.
+func NewRegenerateSecretResultOK(body *RegenerateSecretResponseBody) *secrets.RegenerateSecretResult {
+	v := &secrets.RegenerateSecretResult{
+		Version: *body.Version,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretSecretNotFound builds a secrets service regenerate secret
+// endpoint secret_not_found error.
+func NewRegenerateSecretSecretNotFound(body *RegenerateSecretSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretInvalidParameters builds a secrets service regenerate
+// secret endpoint invalid_parameters error.
+func NewRegenerateSecretInvalidParameters(body *RegenerateSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretUnauthorized builds a secrets service regenerate secret
+// endpoint unauthorized error.
+func NewRegenerateSecretUnauthorized(body *RegenerateSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretForbidden builds a secrets service regenerate secret
+// endpoint forbidden error.
+func NewRegenerateSecretForbidden(body *RegenerateSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRegenerateSecretInternalError builds a secrets service regenerate secret
+// endpoint internal_error error.
+func NewRegenerateSecretInternalError(body *RegenerateSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationResultOK builds a "secrets" service "set secret
+// rotation" endpoint result from a HTTP "OK" response.
+func NewSetSecretRotationResultOK(body *SetSecretRotationResponseBody) *secrets.SetSecretRotationResult {
+	v := &secrets.SetSecretRotationResult{
+		NextRotationAt: body.NextRotationAt,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationSecretNotFound builds a secrets service set secret
+// rotation endpoint secret_not_found error.
+func NewSetSecretRotationSecretNotFound(body *SetSecretRotationSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationInvalidParameters builds a secrets service set secret
+// rotation endpoint invalid_parameters error.
+func NewSetSecretRotationInvalidParameters(body *SetSecretRotationInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationUnauthorized builds a secrets service set secret
+// rotation endpoint unauthorized error.
+func NewSetSecretRotationUnauthorized(body *SetSecretRotationUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationForbidden builds a secrets service set secret rotation
+// endpoint forbidden error.
+func NewSetSecretRotationForbidden(body *SetSecretRotationForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretRotationInternalError builds a secrets service set secret
+// rotation endpoint internal_error error.
+func NewSetSecretRotationInternalError(body *SetSecretRotationInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretRotationsSecretRotationOK builds a "secrets" service "list
+// secret rotations" endpoint result from a HTTP "OK" response.
+func NewListSecretRotationsSecretRotationOK(body []*SecretRotationResponse) []*secrets.SecretRotation {
+	v := make([]*secrets.SecretRotation, len(body))
+	for i, val := range body {
+		v[i] = unmarshalSecretRotationResponseToSecretsSecretRotation(val)
+	}
+
+	return v
+}
+
+// NewListSecretRotationsSecretNotFound builds a secrets service list secret
+// rotations endpoint secret_not_found error.
+func NewListSecretRotationsSecretNotFound(body *ListSecretRotationsSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretRotationsInvalidParameters builds a secrets service list secret
+// rotations endpoint invalid_parameters error.
+func NewListSecretRotationsInvalidParameters(body *ListSecretRotationsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretRotationsUnauthorized builds a secrets service list secret
+// rotations endpoint unauthorized error.
+func NewListSecretRotationsUnauthorized(body *ListSecretRotationsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretRotationsForbidden builds a secrets service list secret
+// rotations endpoint forbidden error.
+func NewListSecretRotationsForbidden(body *ListSecretRotationsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListSecretRotationsInternalError builds a secrets service list secret
+// rotations endpoint internal_error error.
+func NewListSecretRotationsInternalError(body *ListSecretRotationsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSecretSecretNotFound builds a secrets service delete secret
+// endpoint secret_not_found error.
+func NewDeleteSecretSecretNotFound(body *DeleteSecretSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSecretInvalidParameters builds a secrets service delete secret
+// endpoint invalid_parameters error.
+func NewDeleteSecretInvalidParameters(body *DeleteSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSecretUnauthorized builds a secrets service delete secret endpoint
+// unauthorized error.
+func NewDeleteSecretUnauthorized(body *DeleteSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSecretForbidden builds a secrets service delete secret endpoint
+// forbidden error.
+func NewDeleteSecretForbidden(body *DeleteSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteSecretInternalError builds a secrets service delete secret endpoint
+// internal_error error.
+func NewDeleteSecretInternalError(body *DeleteSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsSecretNotFound builds a secrets service move secrets endpoint
+// secret_not_found error.
+func NewMoveSecretsSecretNotFound(body *MoveSecretsSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsSecretAlreadyExists builds a secrets service move secrets
+// endpoint secret_already_exists error.
+func NewMoveSecretsSecretAlreadyExists(body *MoveSecretsSecretAlreadyExistsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsFolderChanged builds a secrets service move secrets endpoint
+// folder_changed error.
+func NewMoveSecretsFolderChanged(body *MoveSecretsFolderChangedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsInvalidParameters builds a secrets service move secrets
+// endpoint invalid_parameters error.
+func NewMoveSecretsInvalidParameters(body *MoveSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsUnauthorized builds a secrets service move secrets endpoint
+// unauthorized error.
+func NewMoveSecretsUnauthorized(body *MoveSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsForbidden builds a secrets service move secrets endpoint
+// forbidden error.
+func NewMoveSecretsForbidden(body *MoveSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewMoveSecretsInternalError builds a secrets service move secrets endpoint
+// internal_error error.
+func NewMoveSecretsInternalError(body *MoveSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffSecretsSecretDiffOK builds a "secrets" service "diff secrets"
+// endpoint result from a HTTP "OK" response.
+func NewDiffSecretsSecretDiffOK(body []*SecretDiffResponse) []*secrets.SecretDiff {
+	v := make([]*secrets.SecretDiff, len(body))
+	for i, val := range body {
+		v[i] = unmarshalSecretDiffResponseToSecretsSecretDiff(val)
+	}
+
+	return v
+}
+
+// NewDiffSecretsInvalidParameters builds a secrets service diff secrets
+// endpoint invalid_parameters error.
+func NewDiffSecretsInvalidParameters(body *DiffSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffSecretsUnauthorized builds a secrets service diff secrets endpoint
+// unauthorized error.
+func NewDiffSecretsUnauthorized(body *DiffSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffSecretsForbidden builds a secrets service diff secrets endpoint
+// forbidden error.
+func NewDiffSecretsForbidden(body *DiffSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDiffSecretsInternalError builds a secrets service diff secrets endpoint
+// internal_error error.
+func NewDiffSecretsInternalError(body *DiffSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsSecretNotFound builds a secrets service promote secrets
+// endpoint secret_not_found error.
+func NewPromoteSecretsSecretNotFound(body *PromoteSecretsSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsSecretAlreadyExists builds a secrets service promote
+// secrets endpoint secret_already_exists error.
+func NewPromoteSecretsSecretAlreadyExists(body *PromoteSecretsSecretAlreadyExistsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsInvalidParameters builds a secrets service promote secrets
+// endpoint invalid_parameters error.
+func NewPromoteSecretsInvalidParameters(body *PromoteSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsUnauthorized builds a secrets service promote secrets
+// endpoint unauthorized error.
+func NewPromoteSecretsUnauthorized(body *PromoteSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsForbidden builds a secrets service promote secrets endpoint
+// forbidden error.
+func NewPromoteSecretsForbidden(body *PromoteSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewPromoteSecretsInternalError builds a secrets service promote secrets
+// endpoint internal_error error.
+func NewPromoteSecretsInternalError(body *PromoteSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipSecretNotFound builds a secrets service transfer
+// secret ownership endpoint secret_not_found error.
+func NewTransferSecretOwnershipSecretNotFound(body *TransferSecretOwnershipSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipInvalidParameters builds a secrets service
+// transfer secret ownership endpoint invalid_parameters error.
+func NewTransferSecretOwnershipInvalidParameters(body *TransferSecretOwnershipInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipUnauthorized builds a secrets service transfer
+// secret ownership endpoint unauthorized error.
+func NewTransferSecretOwnershipUnauthorized(body *TransferSecretOwnershipUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipForbidden builds a secrets service transfer secret
+// ownership endpoint forbidden error.
+func NewTransferSecretOwnershipForbidden(body *TransferSecretOwnershipForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipInternalError builds a secrets service transfer
+// secret ownership endpoint internal_error error.
+func NewTransferSecretOwnershipInternalError(body *TransferSecretOwnershipInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListStaleSecretsStaleSecretOK builds a "secrets" service "list stale
+// secrets" endpoint result from a HTTP "OK" response.
+func NewListStaleSecretsStaleSecretOK(body []*StaleSecretResponse) []*secrets.StaleSecret {
+	v := make([]*secrets.StaleSecret, len(body))
+	for i, val := range body {
+		v[i] = unmarshalStaleSecretResponseToSecretsStaleSecret(val)
+	}
+
+	return v
+}
+
+// NewListStaleSecretsInvalidParameters builds a secrets service list stale
+// secrets endpoint invalid_parameters error.
+func NewListStaleSecretsInvalidParameters(body *ListStaleSecretsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListStaleSecretsUnauthorized builds a secrets service list stale secrets
+// endpoint unauthorized error.
+func NewListStaleSecretsUnauthorized(body *ListStaleSecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListStaleSecretsForbidden builds a secrets service list stale secrets
+// endpoint forbidden error.
+func NewListStaleSecretsForbidden(body *ListStaleSecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListStaleSecretsInternalError builds a secrets service list stale secrets
+// endpoint internal_error error.
+func NewListStaleSecretsInternalError(body *ListStaleSecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretCanarySecretNotFound builds a secrets service set secret canary
+// endpoint secret_not_found error.
+func NewSetSecretCanarySecretNotFound(body *SetSecretCanarySecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretCanaryInvalidParameters builds a secrets service set secret
+// canary endpoint invalid_parameters error.
+func NewSetSecretCanaryInvalidParameters(body *SetSecretCanaryInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretCanaryUnauthorized builds a secrets service set secret canary
+// endpoint unauthorized error.
+func NewSetSecretCanaryUnauthorized(body *SetSecretCanaryUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretCanaryForbidden builds a secrets service set secret canary
+// endpoint forbidden error.
+func NewSetSecretCanaryForbidden(body *SetSecretCanaryForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewSetSecretCanaryInternalError builds a secrets service set secret canary
+// endpoint internal_error error.
+func NewSetSecretCanaryInternalError(body *SetSecretCanaryInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListCanarySecretsUnauthorized builds a secrets service list canary
+// secrets endpoint unauthorized error.
+func NewListCanarySecretsUnauthorized(body *ListCanarySecretsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListCanarySecretsForbidden builds a secrets service list canary secrets
+// endpoint forbidden error.
+func NewListCanarySecretsForbidden(body *ListCanarySecretsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListCanarySecretsInternalError builds a secrets service list canary
+// secrets endpoint internal_error error.
+func NewListCanarySecretsInternalError(body *ListCanarySecretsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretResultCreated builds a "secrets" service "wrap secret" endpoint
+// result from a HTTP "Created" response.
+func NewWrapSecretResultCreated(body *WrapSecretResponseBody) *secrets.WrapSecretResult {
+	v := &secrets.WrapSecretResult{
+		Token:     *body.Token,
+		ExpiresAt: *body.ExpiresAt,
+	}
+
+	return v
+}
+
+// NewWrapSecretSecretNotFound builds a secrets service wrap secret endpoint
+// secret_not_found error.
+func NewWrapSecretSecretNotFound(body *WrapSecretSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretFieldNotFound builds a secrets service wrap secret endpoint
+// field_not_found error.
+func NewWrapSecretFieldNotFound(body *WrapSecretFieldNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretSecretTooLarge builds a secrets service wrap secret endpoint
+// secret_too_large error.
+func NewWrapSecretSecretTooLarge(body *WrapSecretSecretTooLargeResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretInvalidParameters builds a secrets service wrap secret endpoint
+// invalid_parameters error.
+func NewWrapSecretInvalidParameters(body *WrapSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretUnauthorized builds a secrets service wrap secret endpoint
+// unauthorized error.
+func NewWrapSecretUnauthorized(body *WrapSecretUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretForbidden builds a secrets service wrap secret endpoint
+// forbidden error.
+func NewWrapSecretForbidden(body *WrapSecretForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewWrapSecretInternalError builds a secrets service wrap secret endpoint
+// internal_error error.
+func NewWrapSecretInternalError(body *WrapSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUnwrapSecretResultOK builds a "secrets" service "unwrap secret" endpoint
+// result from a HTTP "OK" response.
+func NewUnwrapSecretResultOK(body *UnwrapSecretResponseBody) *secrets.UnwrapSecretResult {
+	v := &secrets.UnwrapSecretResult{
+		Value:     body.Value,
+		Path:      body.Path,
+		WrappedAt: *body.WrappedAt,
+	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewUnwrapSecretTokenNotFound builds a secrets service unwrap secret endpoint
+// token_not_found error.
+func NewUnwrapSecretTokenNotFound(body *UnwrapSecretTokenNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUnwrapSecretTokenExpired builds a secrets service unwrap secret endpoint
+// token_expired error.
+func NewUnwrapSecretTokenExpired(body *UnwrapSecretTokenExpiredResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUnwrapSecretTokenAlreadyUsed builds a secrets service unwrap secret
+// endpoint token_already_used error.
+func NewUnwrapSecretTokenAlreadyUsed(body *UnwrapSecretTokenAlreadyUsedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUnwrapSecretInvalidParameters builds a secrets service unwrap secret
+// endpoint invalid_parameters error.
+func NewUnwrapSecretInvalidParameters(body *UnwrapSecretInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewUnwrapSecretInternalError builds a secrets service unwrap secret endpoint
+// internal_error error.
+func NewUnwrapSecretInternalError(body *UnwrapSecretInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessAccessRequestCreated builds a "secrets" service "request
+// access" endpoint result from a HTTP "Created" response.
+func NewRequestAccessAccessRequestCreated(body *RequestAccessResponseBody) *secrets.AccessRequest {
+	v := &secrets.AccessRequest{
+		ID:             *body.ID,
+		Path:           *body.Path,
+		Justification:  *body.Justification,
+		Duration:       body.Duration,
+		Status:         *body.Status,
+		Comment:        body.Comment,
+		DecidedAt:      body.DecidedAt,
+		GrantExpiresAt: body.GrantExpiresAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+	if body.Requester != nil {
+		v.Requester = unmarshalUserResponseBodyToSecretsUser(body.Requester)
+	}
+	v.Capabilities = make([]string, len(body.Capabilities))
+	for i, val := range body.Capabilities {
+		v.Capabilities[i] = val
+	}
+	if body.DecidedBy != nil {
+		v.DecidedBy = unmarshalUserResponseBodyToSecretsUser(body.DecidedBy)
+	}
+
+	return v
+}
+
+// NewRequestAccessSecretNotFound builds a secrets service request access
+// endpoint secret_not_found error.
+func NewRequestAccessSecretNotFound(body *RequestAccessSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessAccessRequestPending builds a secrets service request access
+// endpoint access_request_pending error.
+func NewRequestAccessAccessRequestPending(body *RequestAccessAccessRequestPendingResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessInvalidParameters builds a secrets service request access
+// endpoint invalid_parameters error.
+func NewRequestAccessInvalidParameters(body *RequestAccessInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessUnauthorized builds a secrets service request access
+// endpoint unauthorized error.
+func NewRequestAccessUnauthorized(body *RequestAccessUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessForbidden builds a secrets service request access endpoint
+// forbidden error.
+func NewRequestAccessForbidden(body *RequestAccessForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRequestAccessInternalError builds a secrets service request access
+// endpoint internal_error error.
+func NewRequestAccessInternalError(body *RequestAccessInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListAccessRequestsAccessRequestOK builds a "secrets" service "list access
+// requests" endpoint result from a HTTP "OK" response.
+func NewListAccessRequestsAccessRequestOK(body []*AccessRequestResponse) []*secrets.AccessRequest {
+	v := make([]*secrets.AccessRequest, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAccessRequestResponseToSecretsAccessRequest(val)
+	}
+
+	return v
+}
+
+// NewListAccessRequestsInvalidParameters builds a secrets service list access
+// requests endpoint invalid_parameters error.
+func NewListAccessRequestsInvalidParameters(body *ListAccessRequestsInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListAccessRequestsUnauthorized builds a secrets service list access
+// requests endpoint unauthorized error.
+func NewListAccessRequestsUnauthorized(body *ListAccessRequestsUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListAccessRequestsForbidden builds a secrets service list access requests
+// endpoint forbidden error.
+func NewListAccessRequestsForbidden(body *ListAccessRequestsForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListAccessRequestsInternalError builds a secrets service list access
+// requests endpoint internal_error error.
+func NewListAccessRequestsInternalError(body *ListAccessRequestsInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestAccessRequestOK builds a "secrets" service "approve
+// access request" endpoint result from a HTTP "OK" response.
+func NewApproveAccessRequestAccessRequestOK(body *ApproveAccessRequestResponseBody) *secrets.AccessRequest {
+	v := &secrets.AccessRequest{
+		ID:             *body.ID,
+		Path:           *body.Path,
+		Justification:  *body.Justification,
+		Duration:       body.Duration,
+		Status:         *body.Status,
+		Comment:        body.Comment,
+		DecidedAt:      body.DecidedAt,
+		GrantExpiresAt: body.GrantExpiresAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+	if body.Requester != nil {
+		v.Requester = unmarshalUserResponseBodyToSecretsUser(body.Requester)
+	}
+	v.Capabilities = make([]string, len(body.Capabilities))
+	for i, val := range body.Capabilities {
+		v.Capabilities[i] = val
+	}
+	if body.DecidedBy != nil {
+		v.DecidedBy = unmarshalUserResponseBodyToSecretsUser(body.DecidedBy)
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestAccessRequestNotFound builds a secrets service
+// approve access request endpoint access_request_not_found error.
+func NewApproveAccessRequestAccessRequestNotFound(body *ApproveAccessRequestAccessRequestNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestSecretNotFound builds a secrets service approve
+// access request endpoint secret_not_found error.
+func NewApproveAccessRequestSecretNotFound(body *ApproveAccessRequestSecretNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestAccessRequestDecided builds a secrets service approve
+// access request endpoint access_request_decided error.
+func NewApproveAccessRequestAccessRequestDecided(body *ApproveAccessRequestAccessRequestDecidedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestInvalidParameters builds a secrets service approve
+// access request endpoint invalid_parameters error.
+func NewApproveAccessRequestInvalidParameters(body *ApproveAccessRequestInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestUnauthorized builds a secrets service approve access
+// request endpoint unauthorized error.
+func NewApproveAccessRequestUnauthorized(body *ApproveAccessRequestUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestForbidden builds a secrets service approve access
+// request endpoint forbidden error.
+func NewApproveAccessRequestForbidden(body *ApproveAccessRequestForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewApproveAccessRequestInternalError builds a secrets service approve access
+// request endpoint internal_error error.
+func NewApproveAccessRequestInternalError(body *ApproveAccessRequestInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestAccessRequestOK builds a "secrets" service "deny access
+// request" endpoint result from a HTTP "OK" response.
+func NewDenyAccessRequestAccessRequestOK(body *DenyAccessRequestResponseBody) *secrets.AccessRequest {
+	v := &secrets.AccessRequest{
+		ID:             *body.ID,
+		Path:           *body.Path,
+		Justification:  *body.Justification,
+		Duration:       body.Duration,
+		Status:         *body.Status,
+		Comment:        body.Comment,
+		DecidedAt:      body.DecidedAt,
+		GrantExpiresAt: body.GrantExpiresAt,
+		CreatedAt:      *body.CreatedAt,
+	}
+	if body.Requester != nil {
+		v.Requester = unmarshalUserResponseBodyToSecretsUser(body.Requester)
+	}
+	v.Capabilities = make([]string, len(body.Capabilities))
+	for i, val := range body.Capabilities {
+		v.Capabilities[i] = val
+	}
+	if body.DecidedBy != nil {
+		v.DecidedBy = unmarshalUserResponseBodyToSecretsUser(body.DecidedBy)
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestAccessRequestNotFound builds a secrets service deny
+// access request endpoint access_request_not_found error.
+func NewDenyAccessRequestAccessRequestNotFound(body *DenyAccessRequestAccessRequestNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestAccessRequestDecided builds a secrets service deny
+// access request endpoint access_request_decided error.
+func NewDenyAccessRequestAccessRequestDecided(body *DenyAccessRequestAccessRequestDecidedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestInvalidParameters builds a secrets service deny access
+// request endpoint invalid_parameters error.
+func NewDenyAccessRequestInvalidParameters(body *DenyAccessRequestInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestUnauthorized builds a secrets service deny access
+// request endpoint unauthorized error.
+func NewDenyAccessRequestUnauthorized(body *DenyAccessRequestUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestForbidden builds a secrets service deny access request
+// endpoint forbidden error.
+func NewDenyAccessRequestForbidden(body *DenyAccessRequestForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDenyAccessRequestInternalError builds a secrets service deny access
+// request endpoint internal_error error.
+func NewDenyAccessRequestInternalError(body *DenyAccessRequestInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListValidationRulesValidationRuleOK builds a "secrets" service "list
+// validation rules" endpoint result from a HTTP "OK" response.
+func NewListValidationRulesValidationRuleOK(body []*ValidationRuleResponse) []*secrets.ValidationRule {
+	v := make([]*secrets.ValidationRule, len(body))
+	for i, val := range body {
+		v[i] = unmarshalValidationRuleResponseToSecretsValidationRule(val)
+	}
+
+	return v
+}
+
+// NewListValidationRulesUnauthorized builds a secrets service list validation
+// rules endpoint unauthorized error.
+func NewListValidationRulesUnauthorized(body *ListValidationRulesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListValidationRulesForbidden builds a secrets service list validation
+// rules endpoint forbidden error.
+func NewListValidationRulesForbidden(body *ListValidationRulesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListValidationRulesInternalError builds a secrets service list validation
+// rules endpoint internal_error error.
+func NewListValidationRulesInternalError(body *ListValidationRulesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateValidationRuleInvalidParameters builds a secrets service create
+// validation rule endpoint invalid_parameters error.
+func NewCreateValidationRuleInvalidParameters(body *CreateValidationRuleInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateValidationRuleUnauthorized builds a secrets service create
+// validation rule endpoint unauthorized error.
+func NewCreateValidationRuleUnauthorized(body *CreateValidationRuleUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateValidationRuleForbidden builds a secrets service create validation
+// rule endpoint forbidden error.
+func NewCreateValidationRuleForbidden(body *CreateValidationRuleForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateValidationRuleInternalError builds a secrets service create
+// validation rule endpoint internal_error error.
+func NewCreateValidationRuleInternalError(body *CreateValidationRuleInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteValidationRuleValidationRuleNotFound builds a secrets service
+// delete validation rule endpoint validation_rule_not_found error.
+func NewDeleteValidationRuleValidationRuleNotFound(body *DeleteValidationRuleValidationRuleNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteValidationRuleUnauthorized builds a secrets service delete
+// validation rule endpoint unauthorized error.
+func NewDeleteValidationRuleUnauthorized(body *DeleteValidationRuleUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteValidationRuleForbidden builds a secrets service delete validation
+// rule endpoint forbidden error.
+func NewDeleteValidationRuleForbidden(body *DeleteValidationRuleForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteValidationRuleInternalError builds a secrets service delete
+// validation rule endpoint internal_error error.
+func NewDeleteValidationRuleInternalError(body *DeleteValidationRuleInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassRulesBreakGlassRuleOK builds a "secrets" service "list
+// break glass rules" endpoint result from a HTTP "OK" response.
+func NewListBreakGlassRulesBreakGlassRuleOK(body []*BreakGlassRuleResponse) []*secrets.BreakGlassRule {
+	v := make([]*secrets.BreakGlassRule, len(body))
+	for i, val := range body {
+		v[i] = unmarshalBreakGlassRuleResponseToSecretsBreakGlassRule(val)
+	}
+
+	return v
+}
+
+// NewListBreakGlassRulesUnauthorized builds a secrets service list break glass
+// rules endpoint unauthorized error.
+func NewListBreakGlassRulesUnauthorized(body *ListBreakGlassRulesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassRulesForbidden builds a secrets service list break glass
+// rules endpoint forbidden error.
+func NewListBreakGlassRulesForbidden(body *ListBreakGlassRulesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassRulesInternalError builds a secrets service list break
+// glass rules endpoint internal_error error.
+func NewListBreakGlassRulesInternalError(body *ListBreakGlassRulesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateBreakGlassRuleInvalidParameters builds a secrets service create
+// break glass rule endpoint invalid_parameters error.
+func NewCreateBreakGlassRuleInvalidParameters(body *CreateBreakGlassRuleInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateBreakGlassRuleUnauthorized builds a secrets service create break
+// glass rule endpoint unauthorized error.
+func NewCreateBreakGlassRuleUnauthorized(body *CreateBreakGlassRuleUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateBreakGlassRuleForbidden builds a secrets service create break glass
+// rule endpoint forbidden error.
+func NewCreateBreakGlassRuleForbidden(body *CreateBreakGlassRuleForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewCreateBreakGlassRuleInternalError builds a secrets service create break
+// glass rule endpoint internal_error error.
+func NewCreateBreakGlassRuleInternalError(body *CreateBreakGlassRuleInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteBreakGlassRuleBreakGlassRuleNotFound builds a secrets service
+// delete break glass rule endpoint break_glass_rule_not_found error.
+func NewDeleteBreakGlassRuleBreakGlassRuleNotFound(body *DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteBreakGlassRuleUnauthorized builds a secrets service delete break
+// glass rule endpoint unauthorized error.
+func NewDeleteBreakGlassRuleUnauthorized(body *DeleteBreakGlassRuleUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteBreakGlassRuleForbidden builds a secrets service delete break glass
+// rule endpoint forbidden error.
+func NewDeleteBreakGlassRuleForbidden(body *DeleteBreakGlassRuleForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewDeleteBreakGlassRuleInternalError builds a secrets service delete break
+// glass rule endpoint internal_error error.
+func NewDeleteBreakGlassRuleInternalError(body *DeleteBreakGlassRuleInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassAccessesBreakGlassAccessOK builds a "secrets" service "list
+// break glass accesses" endpoint result from a HTTP "OK" response.
+func NewListBreakGlassAccessesBreakGlassAccessOK(body []*BreakGlassAccessResponse) []*secrets.BreakGlassAccess {
+	v := make([]*secrets.BreakGlassAccess, len(body))
+	for i, val := range body {
+		v[i] = unmarshalBreakGlassAccessResponseToSecretsBreakGlassAccess(val)
+	}
+
+	return v
+}
+
+// NewListBreakGlassAccessesInvalidParameters builds a secrets service list
+// break glass accesses endpoint invalid_parameters error.
+func NewListBreakGlassAccessesInvalidParameters(body *ListBreakGlassAccessesInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassAccessesUnauthorized builds a secrets service list break
+// glass accesses endpoint unauthorized error.
+func NewListBreakGlassAccessesUnauthorized(body *ListBreakGlassAccessesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassAccessesForbidden builds a secrets service list break glass
+// accesses endpoint forbidden error.
+func NewListBreakGlassAccessesForbidden(body *ListBreakGlassAccessesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListBreakGlassAccessesInternalError builds a secrets service list break
+// glass accesses endpoint internal_error error.
+func NewListBreakGlassAccessesInternalError(body *ListBreakGlassAccessesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessBreakGlassAccessOK builds a "secrets" service
+// "review break glass access" endpoint result from a HTTP "OK" response.
+func NewReviewBreakGlassAccessBreakGlassAccessOK(body *ReviewBreakGlassAccessResponseBody) *secrets.BreakGlassAccess {
+	v := &secrets.BreakGlassAccess{
+		ID:            *body.ID,
+		Path:          *body.Path,
+		Justification: *body.Justification,
+		ExpiresAt:     *body.ExpiresAt,
+		ReviewedAt:    body.ReviewedAt,
+		ReviewComment: body.ReviewComment,
+		CreatedAt:     *body.CreatedAt,
+	}
+	if body.User != nil {
+		v.User = unmarshalUserResponseBodyToSecretsUser(body.User)
+	}
+	if body.ReviewedBy != nil {
+		v.ReviewedBy = unmarshalUserResponseBodyToSecretsUser(body.ReviewedBy)
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessBreakGlassAccessNotFound builds a secrets service
+// review break glass access endpoint break_glass_access_not_found error.
+func NewReviewBreakGlassAccessBreakGlassAccessNotFound(body *ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessBreakGlassAccessReviewed builds a secrets service
+// review break glass access endpoint break_glass_access_reviewed error.
+func NewReviewBreakGlassAccessBreakGlassAccessReviewed(body *ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessInvalidParameters builds a secrets service review
+// break glass access endpoint invalid_parameters error.
+func NewReviewBreakGlassAccessInvalidParameters(body *ReviewBreakGlassAccessInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessUnauthorized builds a secrets service review break
+// glass access endpoint unauthorized error.
+func NewReviewBreakGlassAccessUnauthorized(body *ReviewBreakGlassAccessUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessForbidden builds a secrets service review break
+// glass access endpoint forbidden error.
+func NewReviewBreakGlassAccessForbidden(body *ReviewBreakGlassAccessForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewReviewBreakGlassAccessInternalError builds a secrets service review break
+// glass access endpoint internal_error error.
+func NewReviewBreakGlassAccessInternalError(body *ReviewBreakGlassAccessInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListFolderAccessesFolderAccessOK builds a "secrets" service "list folder
+// accesses" endpoint result from a HTTP "OK" response.
+func NewListFolderAccessesFolderAccessOK(body []*FolderAccessResponse) []*secrets.FolderAccess {
+	v := make([]*secrets.FolderAccess, len(body))
+	for i, val := range body {
+		v[i] = unmarshalFolderAccessResponseToSecretsFolderAccess(val)
+	}
+
+	return v
+}
+
+// NewListFolderAccessesUnauthorized builds a secrets service list folder
+// accesses endpoint unauthorized error.
+func NewListFolderAccessesUnauthorized(body *ListFolderAccessesUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListFolderAccessesForbidden builds a secrets service list folder accesses
+// endpoint forbidden error.
+func NewListFolderAccessesForbidden(body *ListFolderAccessesForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewListFolderAccessesInternalError builds a secrets service list folder
+// accesses endpoint internal_error error.
+func NewListFolderAccessesInternalError(body *ListFolderAccessesInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGrantFolderAccessInvalidParameters builds a secrets service grant folder
+// access endpoint invalid_parameters error.
+func NewGrantFolderAccessInvalidParameters(body *GrantFolderAccessInvalidParametersResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGrantFolderAccessUnauthorized builds a secrets service grant folder
+// access endpoint unauthorized error.
+func NewGrantFolderAccessUnauthorized(body *GrantFolderAccessUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGrantFolderAccessForbidden builds a secrets service grant folder access
+// endpoint forbidden error.
+func NewGrantFolderAccessForbidden(body *GrantFolderAccessForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewGrantFolderAccessInternalError builds a secrets service grant folder
+// access endpoint internal_error error.
+func NewGrantFolderAccessInternalError(body *GrantFolderAccessInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeFolderAccessFolderAccessNotFound builds a secrets service revoke
+// folder access endpoint folder_access_not_found error.
+func NewRevokeFolderAccessFolderAccessNotFound(body *RevokeFolderAccessFolderAccessNotFoundResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeFolderAccessUnauthorized builds a secrets service revoke folder
+// access endpoint unauthorized error.
+func NewRevokeFolderAccessUnauthorized(body *RevokeFolderAccessUnauthorizedResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeFolderAccessForbidden builds a secrets service revoke folder access
+// endpoint forbidden error.
+func NewRevokeFolderAccessForbidden(body *RevokeFolderAccessForbiddenResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// NewRevokeFolderAccessInternalError builds a secrets service revoke folder
+// access endpoint internal_error error.
+func NewRevokeFolderAccessInternalError(body *RevokeFolderAccessInternalErrorResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
+// ValidateBrowseSecretsResponseBody runs the validations defined on Browse
+// SecretsResponseBody
+func ValidateBrowseSecretsResponseBody(body *BrowseSecretsResponseBody) (err error) {
+	if body.Entries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("entries", "body"))
+	}
+	for _, e := range body.Entries {
+		if e != nil {
+			if err2 := ValidateSecretEntryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesResponseBody runs the validations defined on
+// Batch Get Secret ValuesResponseBody
+func ValidateBatchGetSecretValuesResponseBody(body *BatchGetSecretValuesResponseBody) (err error) {
+	if body.Secrets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secrets", "body"))
+	}
+	for _, e := range body.Secrets {
+		if e != nil {
+			if err2 := ValidateSecretValueResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRenderTemplateResponseBody runs the validations defined on Render
+// TemplateResponseBody
+func ValidateRenderTemplateResponseBody(body *RenderTemplateResponseBody) (err error) {
+	if body.Rendered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rendered", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretResponseBody runs the validations defined on Get
+// SecretResponseBody
+func ValidateGetSecretResponseBody(body *GetSecretResponseBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.AuthorizedUsers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
+	}
+	if body.AuthorizedRoles == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_roles", "body"))
+	}
+	if body.UserGrants == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_grants", "body"))
+	}
+	if body.RoleGrants == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("role_grants", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.Structured == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("structured", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.RecentReads == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("recent_reads", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Owner != nil {
+		if err2 := ValidateUserResponseBody(body.Owner); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.OwnerRole != nil {
+		if err2 := ValidateRoleResponseBody(body.OwnerRole); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.AuthorizedUsers {
+		if e != nil {
+			if err2 := ValidateUserResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.AuthorizedRoles {
+		if e != nil {
+			if err2 := ValidateRoleResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.UserGrants {
+		if e != nil {
+			if err2 := ValidateGrantResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.RoleGrants {
+		if e != nil {
+			if err2 := ValidateGrantResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.LastReader != nil {
+		if err2 := ValidateUserResponseBody(body.LastReader); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateRegenerateSecretResponseBody runs the validations defined on
+// Regenerate SecretResponseBody
+func ValidateRegenerateSecretResponseBody(body *RegenerateSecretResponseBody) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretResponseBody runs the validations defined on Wrap
+// SecretResponseBody
+func ValidateWrapSecretResponseBody(body *WrapSecretResponseBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretResponseBody runs the validations defined on Unwrap
+// SecretResponseBody
+func ValidateUnwrapSecretResponseBody(body *UnwrapSecretResponseBody) (err error) {
+	if body.WrappedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("wrapped_at", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessResponseBody runs the validations defined on Request
+// AccessResponseBody
+func ValidateRequestAccessResponseBody(body *RequestAccessResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Requester != nil {
+		if err2 := ValidateUserResponseBody(body.Requester); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "denied") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "denied"}))
+		}
+	}
+	if body.DecidedBy != nil {
+		if err2 := ValidateUserResponseBody(body.DecidedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateApproveAccessRequestResponseBody runs the validations defined on
+// Approve Access RequestResponseBody
+func ValidateApproveAccessRequestResponseBody(body *ApproveAccessRequestResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Requester != nil {
+		if err2 := ValidateUserResponseBody(body.Requester); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "denied") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "denied"}))
+		}
+	}
+	if body.DecidedBy != nil {
+		if err2 := ValidateUserResponseBody(body.DecidedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateDenyAccessRequestResponseBody runs the validations defined on Deny
+// Access RequestResponseBody
+func ValidateDenyAccessRequestResponseBody(body *DenyAccessRequestResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Requester != nil {
+		if err2 := ValidateUserResponseBody(body.Requester); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "denied") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "denied"}))
+		}
+	}
+	if body.DecidedBy != nil {
+		if err2 := ValidateUserResponseBody(body.DecidedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateReviewBreakGlassAccessResponseBody runs the validations defined on
+// Review Break Glass AccessResponseBody
+func ValidateReviewBreakGlassAccessResponseBody(body *ReviewBreakGlassAccessResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.User != nil {
+		if err2 := ValidateUserResponseBody(body.User); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ReviewedBy != nil {
+		if err2 := ValidateUserResponseBody(body.ReviewedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListSecretsUnauthorizedResponseBody runs the validations defined on
+// list secrets_unauthorized_response_body
+func ValidateListSecretsUnauthorizedResponseBody(body *ListSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretsForbiddenResponseBody runs the validations defined on
+// list secrets_forbidden_response_body
+func ValidateListSecretsForbiddenResponseBody(body *ListSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretsInternalErrorResponseBody runs the validations defined on
+// list secrets_internal_error_response_body
+func ValidateListSecretsInternalErrorResponseBody(body *ListSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBrowseSecretsInvalidParametersResponseBody runs the validations
+// defined on browse secrets_invalid_parameters_response_body
+func ValidateBrowseSecretsInvalidParametersResponseBody(body *BrowseSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBrowseSecretsUnauthorizedResponseBody runs the validations defined
+// on browse secrets_unauthorized_response_body
+func ValidateBrowseSecretsUnauthorizedResponseBody(body *BrowseSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBrowseSecretsForbiddenResponseBody runs the validations defined on
+// browse secrets_forbidden_response_body
+func ValidateBrowseSecretsForbiddenResponseBody(body *BrowseSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBrowseSecretsInternalErrorResponseBody runs the validations defined
+// on browse secrets_internal_error_response_body
+func ValidateBrowseSecretsInternalErrorResponseBody(body *BrowseSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueSecretNotFoundResponseBody runs the validations
+// defined on get secret value_secret_not_found_response_body
+func ValidateGetSecretValueSecretNotFoundResponseBody(body *GetSecretValueSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueFieldNotFoundResponseBody runs the validations defined
+// on get secret value_field_not_found_response_body
+func ValidateGetSecretValueFieldNotFoundResponseBody(body *GetSecretValueFieldNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueInvalidParametersResponseBody runs the validations
+// defined on get secret value_invalid_parameters_response_body
+func ValidateGetSecretValueInvalidParametersResponseBody(body *GetSecretValueInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueUnauthorizedResponseBody runs the validations defined
+// on get secret value_unauthorized_response_body
+func ValidateGetSecretValueUnauthorizedResponseBody(body *GetSecretValueUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueForbiddenResponseBody runs the validations defined on
+// get secret value_forbidden_response_body
+func ValidateGetSecretValueForbiddenResponseBody(body *GetSecretValueForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretValueInternalErrorResponseBody runs the validations defined
+// on get secret value_internal_error_response_body
+func ValidateGetSecretValueInternalErrorResponseBody(body *GetSecretValueInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesInvalidParametersResponseBody runs the
+// validations defined on batch get secret
+// values_invalid_parameters_response_body
+func ValidateBatchGetSecretValuesInvalidParametersResponseBody(body *BatchGetSecretValuesInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesUnauthorizedResponseBody runs the validations
+// defined on batch get secret values_unauthorized_response_body
+func ValidateBatchGetSecretValuesUnauthorizedResponseBody(body *BatchGetSecretValuesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesForbiddenResponseBody runs the validations
+// defined on batch get secret values_forbidden_response_body
+func ValidateBatchGetSecretValuesForbiddenResponseBody(body *BatchGetSecretValuesForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateBatchGetSecretValuesInternalErrorResponseBody runs the validations
+// defined on batch get secret values_internal_error_response_body
+func ValidateBatchGetSecretValuesInternalErrorResponseBody(body *BatchGetSecretValuesInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateSecretNotFoundResponseBody runs the validations
+// defined on render template_secret_not_found_response_body
+func ValidateRenderTemplateSecretNotFoundResponseBody(body *RenderTemplateSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateFieldNotFoundResponseBody runs the validations defined
+// on render template_field_not_found_response_body
+func ValidateRenderTemplateFieldNotFoundResponseBody(body *RenderTemplateFieldNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateInvalidParametersResponseBody runs the validations
+// defined on render template_invalid_parameters_response_body
+func ValidateRenderTemplateInvalidParametersResponseBody(body *RenderTemplateInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateUnauthorizedResponseBody runs the validations defined
+// on render template_unauthorized_response_body
+func ValidateRenderTemplateUnauthorizedResponseBody(body *RenderTemplateUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateForbiddenResponseBody runs the validations defined on
+// render template_forbidden_response_body
+func ValidateRenderTemplateForbiddenResponseBody(body *RenderTemplateForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRenderTemplateInternalErrorResponseBody runs the validations defined
+// on render template_internal_error_response_body
+func ValidateRenderTemplateInternalErrorResponseBody(body *RenderTemplateInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadSecretFileSecretTooLargeResponseBody runs the validations
+// defined on upload secret file_secret_too_large_response_body
+func ValidateUploadSecretFileSecretTooLargeResponseBody(body *UploadSecretFileSecretTooLargeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadSecretFileInvalidParametersResponseBody runs the validations
+// defined on upload secret file_invalid_parameters_response_body
+func ValidateUploadSecretFileInvalidParametersResponseBody(body *UploadSecretFileInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadSecretFileUnauthorizedResponseBody runs the validations
+// defined on upload secret file_unauthorized_response_body
+func ValidateUploadSecretFileUnauthorizedResponseBody(body *UploadSecretFileUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadSecretFileForbiddenResponseBody runs the validations defined
+// on upload secret file_forbidden_response_body
+func ValidateUploadSecretFileForbiddenResponseBody(body *UploadSecretFileForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUploadSecretFileInternalErrorResponseBody runs the validations
+// defined on upload secret file_internal_error_response_body
+func ValidateUploadSecretFileInternalErrorResponseBody(body *UploadSecretFileInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDownloadSecretFileSecretNotFoundResponseBody runs the validations
+// defined on download secret file_secret_not_found_response_body
+func ValidateDownloadSecretFileSecretNotFoundResponseBody(body *DownloadSecretFileSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDownloadSecretFileInvalidParametersResponseBody runs the validations
+// defined on download secret file_invalid_parameters_response_body
+func ValidateDownloadSecretFileInvalidParametersResponseBody(body *DownloadSecretFileInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDownloadSecretFileUnauthorizedResponseBody runs the validations
+// defined on download secret file_unauthorized_response_body
+func ValidateDownloadSecretFileUnauthorizedResponseBody(body *DownloadSecretFileUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDownloadSecretFileForbiddenResponseBody runs the validations defined
+// on download secret file_forbidden_response_body
+func ValidateDownloadSecretFileForbiddenResponseBody(body *DownloadSecretFileForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDownloadSecretFileInternalErrorResponseBody runs the validations
+// defined on download secret file_internal_error_response_body
+func ValidateDownloadSecretFileInternalErrorResponseBody(body *DownloadSecretFileInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsSecretNotFoundResponseBody runs the validations defined
+// on export secrets_secret_not_found_response_body
+func ValidateExportSecretsSecretNotFoundResponseBody(body *ExportSecretsSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsFieldNotFoundResponseBody runs the validations defined
+// on export secrets_field_not_found_response_body
+func ValidateExportSecretsFieldNotFoundResponseBody(body *ExportSecretsFieldNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsInvalidParametersResponseBody runs the validations
+// defined on export secrets_invalid_parameters_response_body
+func ValidateExportSecretsInvalidParametersResponseBody(body *ExportSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsUnauthorizedResponseBody runs the validations defined
+// on export secrets_unauthorized_response_body
+func ValidateExportSecretsUnauthorizedResponseBody(body *ExportSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsForbiddenResponseBody runs the validations defined on
+// export secrets_forbidden_response_body
+func ValidateExportSecretsForbiddenResponseBody(body *ExportSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateExportSecretsInternalErrorResponseBody runs the validations defined
+// on export secrets_internal_error_response_body
+func ValidateExportSecretsInternalErrorResponseBody(body *ExportSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsSecretAlreadyExistsResponseBody runs the validations
+// defined on import secrets_secret_already_exists_response_body
+func ValidateImportSecretsSecretAlreadyExistsResponseBody(body *ImportSecretsSecretAlreadyExistsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsSecretTooLargeResponseBody runs the validations defined
+// on import secrets_secret_too_large_response_body
+func ValidateImportSecretsSecretTooLargeResponseBody(body *ImportSecretsSecretTooLargeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsInvalidParametersResponseBody runs the validations
+// defined on import secrets_invalid_parameters_response_body
+func ValidateImportSecretsInvalidParametersResponseBody(body *ImportSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsUnauthorizedResponseBody runs the validations defined
+// on import secrets_unauthorized_response_body
+func ValidateImportSecretsUnauthorizedResponseBody(body *ImportSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsForbiddenResponseBody runs the validations defined on
+// import secrets_forbidden_response_body
+func ValidateImportSecretsForbiddenResponseBody(body *ImportSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateImportSecretsInternalErrorResponseBody runs the validations defined
+// on import secrets_internal_error_response_body
+func ValidateImportSecretsInternalErrorResponseBody(body *ImportSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretSecretNotFoundResponseBody runs the validations defined on
+// get secret_secret_not_found_response_body
+func ValidateGetSecretSecretNotFoundResponseBody(body *GetSecretSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretInvalidParametersResponseBody runs the validations defined
+// on get secret_invalid_parameters_response_body
+func ValidateGetSecretInvalidParametersResponseBody(body *GetSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretUnauthorizedResponseBody runs the validations defined on
+// get secret_unauthorized_response_body
+func ValidateGetSecretUnauthorizedResponseBody(body *GetSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretForbiddenResponseBody runs the validations defined on get
+// secret_forbidden_response_body
+func ValidateGetSecretForbiddenResponseBody(body *GetSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateGetSecretInternalErrorResponseBody runs the validations defined on
+// get secret_internal_error_response_body
+func ValidateGetSecretInternalErrorResponseBody(body *GetSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateSecretSecretTooLargeResponseBody runs the validations defined
+// on create secret_secret_too_large_response_body
+func ValidateCreateSecretSecretTooLargeResponseBody(body *CreateSecretSecretTooLargeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateSecretInvalidParametersResponseBody runs the validations
+// defined on create secret_invalid_parameters_response_body
+func ValidateCreateSecretInvalidParametersResponseBody(body *CreateSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateSecretUnauthorizedResponseBody runs the validations defined on
+// create secret_unauthorized_response_body
+func ValidateCreateSecretUnauthorizedResponseBody(body *CreateSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateSecretForbiddenResponseBody runs the validations defined on
+// create secret_forbidden_response_body
+func ValidateCreateSecretForbiddenResponseBody(body *CreateSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateCreateSecretInternalErrorResponseBody runs the validations defined
+// on create secret_internal_error_response_body
+func ValidateCreateSecretInternalErrorResponseBody(body *CreateSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretSecretNotFoundResponseBody runs the validations defined
+// on update secret_secret_not_found_response_body
+func ValidateUpdateSecretSecretNotFoundResponseBody(body *UpdateSecretSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretFieldNotFoundResponseBody runs the validations defined
+// on update secret_field_not_found_response_body
+func ValidateUpdateSecretFieldNotFoundResponseBody(body *UpdateSecretFieldNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretSecretTooLargeResponseBody runs the validations defined
+// on update secret_secret_too_large_response_body
+func ValidateUpdateSecretSecretTooLargeResponseBody(body *UpdateSecretSecretTooLargeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretInvalidParametersResponseBody runs the validations
+// defined on update secret_invalid_parameters_response_body
+func ValidateUpdateSecretInvalidParametersResponseBody(body *UpdateSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretUnauthorizedResponseBody runs the validations defined on
+// update secret_unauthorized_response_body
+func ValidateUpdateSecretUnauthorizedResponseBody(body *UpdateSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretForbiddenResponseBody runs the validations defined on
+// update secret_forbidden_response_body
+func ValidateUpdateSecretForbiddenResponseBody(body *UpdateSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUpdateSecretInternalErrorResponseBody runs the validations defined
+// on update secret_internal_error_response_body
+func ValidateUpdateSecretInternalErrorResponseBody(body *UpdateSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRegenerateSecretSecretNotFoundResponseBody runs the validations
+// defined on regenerate secret_secret_not_found_response_body
+func ValidateRegenerateSecretSecretNotFoundResponseBody(body *RegenerateSecretSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRegenerateSecretInvalidParametersResponseBody runs the validations
+// defined on regenerate secret_invalid_parameters_response_body
+func ValidateRegenerateSecretInvalidParametersResponseBody(body *RegenerateSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRegenerateSecretUnauthorizedResponseBody runs the validations
+// defined on regenerate secret_unauthorized_response_body
+func ValidateRegenerateSecretUnauthorizedResponseBody(body *RegenerateSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRegenerateSecretForbiddenResponseBody runs the validations defined
+// on regenerate secret_forbidden_response_body
+func ValidateRegenerateSecretForbiddenResponseBody(body *RegenerateSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRegenerateSecretInternalErrorResponseBody runs the validations
+// defined on regenerate secret_internal_error_response_body
+func ValidateRegenerateSecretInternalErrorResponseBody(body *RegenerateSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretRotationSecretNotFoundResponseBody runs the validations
+// defined on set secret rotation_secret_not_found_response_body
+func ValidateSetSecretRotationSecretNotFoundResponseBody(body *SetSecretRotationSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretRotationInvalidParametersResponseBody runs the validations
+// defined on set secret rotation_invalid_parameters_response_body
+func ValidateSetSecretRotationInvalidParametersResponseBody(body *SetSecretRotationInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretRotationUnauthorizedResponseBody runs the validations
+// defined on set secret rotation_unauthorized_response_body
+func ValidateSetSecretRotationUnauthorizedResponseBody(body *SetSecretRotationUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretRotationForbiddenResponseBody runs the validations defined
+// on set secret rotation_forbidden_response_body
+func ValidateSetSecretRotationForbiddenResponseBody(body *SetSecretRotationForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretRotationInternalErrorResponseBody runs the validations
+// defined on set secret rotation_internal_error_response_body
+func ValidateSetSecretRotationInternalErrorResponseBody(body *SetSecretRotationInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretRotationsSecretNotFoundResponseBody runs the validations
+// defined on list secret rotations_secret_not_found_response_body
+func ValidateListSecretRotationsSecretNotFoundResponseBody(body *ListSecretRotationsSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretRotationsInvalidParametersResponseBody runs the
+// validations defined on list secret rotations_invalid_parameters_response_body
+func ValidateListSecretRotationsInvalidParametersResponseBody(body *ListSecretRotationsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretRotationsUnauthorizedResponseBody runs the validations
+// defined on list secret rotations_unauthorized_response_body
+func ValidateListSecretRotationsUnauthorizedResponseBody(body *ListSecretRotationsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretRotationsForbiddenResponseBody runs the validations
+// defined on list secret rotations_forbidden_response_body
+func ValidateListSecretRotationsForbiddenResponseBody(body *ListSecretRotationsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListSecretRotationsInternalErrorResponseBody runs the validations
+// defined on list secret rotations_internal_error_response_body
+func ValidateListSecretRotationsInternalErrorResponseBody(body *ListSecretRotationsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSecretSecretNotFoundResponseBody runs the validations defined
+// on delete secret_secret_not_found_response_body
+func ValidateDeleteSecretSecretNotFoundResponseBody(body *DeleteSecretSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSecretInvalidParametersResponseBody runs the validations
+// defined on delete secret_invalid_parameters_response_body
+func ValidateDeleteSecretInvalidParametersResponseBody(body *DeleteSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSecretUnauthorizedResponseBody runs the validations defined on
+// delete secret_unauthorized_response_body
+func ValidateDeleteSecretUnauthorizedResponseBody(body *DeleteSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSecretForbiddenResponseBody runs the validations defined on
+// delete secret_forbidden_response_body
+func ValidateDeleteSecretForbiddenResponseBody(body *DeleteSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteSecretInternalErrorResponseBody runs the validations defined
+// on delete secret_internal_error_response_body
+func ValidateDeleteSecretInternalErrorResponseBody(body *DeleteSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsSecretNotFoundResponseBody runs the validations defined
+// on move secrets_secret_not_found_response_body
+func ValidateMoveSecretsSecretNotFoundResponseBody(body *MoveSecretsSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsSecretAlreadyExistsResponseBody runs the validations
+// defined on move secrets_secret_already_exists_response_body
+func ValidateMoveSecretsSecretAlreadyExistsResponseBody(body *MoveSecretsSecretAlreadyExistsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsFolderChangedResponseBody runs the validations defined on
+// move secrets_folder_changed_response_body
+func ValidateMoveSecretsFolderChangedResponseBody(body *MoveSecretsFolderChangedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsInvalidParametersResponseBody runs the validations
+// defined on move secrets_invalid_parameters_response_body
+func ValidateMoveSecretsInvalidParametersResponseBody(body *MoveSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsUnauthorizedResponseBody runs the validations defined on
+// move secrets_unauthorized_response_body
+func ValidateMoveSecretsUnauthorizedResponseBody(body *MoveSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsForbiddenResponseBody runs the validations defined on
+// move secrets_forbidden_response_body
+func ValidateMoveSecretsForbiddenResponseBody(body *MoveSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateMoveSecretsInternalErrorResponseBody runs the validations defined on
+// move secrets_internal_error_response_body
+func ValidateMoveSecretsInternalErrorResponseBody(body *MoveSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffSecretsInvalidParametersResponseBody runs the validations
+// defined on diff secrets_invalid_parameters_response_body
+func ValidateDiffSecretsInvalidParametersResponseBody(body *DiffSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffSecretsUnauthorizedResponseBody runs the validations defined on
+// diff secrets_unauthorized_response_body
+func ValidateDiffSecretsUnauthorizedResponseBody(body *DiffSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffSecretsForbiddenResponseBody runs the validations defined on
+// diff secrets_forbidden_response_body
+func ValidateDiffSecretsForbiddenResponseBody(body *DiffSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDiffSecretsInternalErrorResponseBody runs the validations defined on
+// diff secrets_internal_error_response_body
+func ValidateDiffSecretsInternalErrorResponseBody(body *DiffSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsSecretNotFoundResponseBody runs the validations
+// defined on promote secrets_secret_not_found_response_body
+func ValidatePromoteSecretsSecretNotFoundResponseBody(body *PromoteSecretsSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsSecretAlreadyExistsResponseBody runs the validations
+// defined on promote secrets_secret_already_exists_response_body
+func ValidatePromoteSecretsSecretAlreadyExistsResponseBody(body *PromoteSecretsSecretAlreadyExistsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsInvalidParametersResponseBody runs the validations
+// defined on promote secrets_invalid_parameters_response_body
+func ValidatePromoteSecretsInvalidParametersResponseBody(body *PromoteSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsUnauthorizedResponseBody runs the validations defined
+// on promote secrets_unauthorized_response_body
+func ValidatePromoteSecretsUnauthorizedResponseBody(body *PromoteSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsForbiddenResponseBody runs the validations defined on
+// promote secrets_forbidden_response_body
+func ValidatePromoteSecretsForbiddenResponseBody(body *PromoteSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidatePromoteSecretsInternalErrorResponseBody runs the validations defined
+// on promote secrets_internal_error_response_body
+func ValidatePromoteSecretsInternalErrorResponseBody(body *PromoteSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipSecretNotFoundResponseBody runs the
+// validations defined on transfer secret
+// ownership_secret_not_found_response_body
+func ValidateTransferSecretOwnershipSecretNotFoundResponseBody(body *TransferSecretOwnershipSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipInvalidParametersResponseBody runs the
+// validations defined on transfer secret
+// ownership_invalid_parameters_response_body
+func ValidateTransferSecretOwnershipInvalidParametersResponseBody(body *TransferSecretOwnershipInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipUnauthorizedResponseBody runs the validations
+// defined on transfer secret ownership_unauthorized_response_body
+func ValidateTransferSecretOwnershipUnauthorizedResponseBody(body *TransferSecretOwnershipUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipForbiddenResponseBody runs the validations
+// defined on transfer secret ownership_forbidden_response_body
+func ValidateTransferSecretOwnershipForbiddenResponseBody(body *TransferSecretOwnershipForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipInternalErrorResponseBody runs the
+// validations defined on transfer secret ownership_internal_error_response_body
+func ValidateTransferSecretOwnershipInternalErrorResponseBody(body *TransferSecretOwnershipInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListStaleSecretsInvalidParametersResponseBody runs the validations
+// defined on list stale secrets_invalid_parameters_response_body
+func ValidateListStaleSecretsInvalidParametersResponseBody(body *ListStaleSecretsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListStaleSecretsUnauthorizedResponseBody runs the validations
+// defined on list stale secrets_unauthorized_response_body
+func ValidateListStaleSecretsUnauthorizedResponseBody(body *ListStaleSecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListStaleSecretsForbiddenResponseBody runs the validations defined
+// on list stale secrets_forbidden_response_body
+func ValidateListStaleSecretsForbiddenResponseBody(body *ListStaleSecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListStaleSecretsInternalErrorResponseBody runs the validations
+// defined on list stale secrets_internal_error_response_body
+func ValidateListStaleSecretsInternalErrorResponseBody(body *ListStaleSecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretCanarySecretNotFoundResponseBody runs the validations
+// defined on set secret canary_secret_not_found_response_body
+func ValidateSetSecretCanarySecretNotFoundResponseBody(body *SetSecretCanarySecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretCanaryInvalidParametersResponseBody runs the validations
+// defined on set secret canary_invalid_parameters_response_body
+func ValidateSetSecretCanaryInvalidParametersResponseBody(body *SetSecretCanaryInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretCanaryUnauthorizedResponseBody runs the validations defined
+// on set secret canary_unauthorized_response_body
+func ValidateSetSecretCanaryUnauthorizedResponseBody(body *SetSecretCanaryUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretCanaryForbiddenResponseBody runs the validations defined on
+// set secret canary_forbidden_response_body
+func ValidateSetSecretCanaryForbiddenResponseBody(body *SetSecretCanaryForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateSetSecretCanaryInternalErrorResponseBody runs the validations
+// defined on set secret canary_internal_error_response_body
+func ValidateSetSecretCanaryInternalErrorResponseBody(body *SetSecretCanaryInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListCanarySecretsUnauthorizedResponseBody runs the validations
+// defined on list canary secrets_unauthorized_response_body
+func ValidateListCanarySecretsUnauthorizedResponseBody(body *ListCanarySecretsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListCanarySecretsForbiddenResponseBody runs the validations defined
+// on list canary secrets_forbidden_response_body
+func ValidateListCanarySecretsForbiddenResponseBody(body *ListCanarySecretsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListCanarySecretsInternalErrorResponseBody runs the validations
+// defined on list canary secrets_internal_error_response_body
+func ValidateListCanarySecretsInternalErrorResponseBody(body *ListCanarySecretsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretSecretNotFoundResponseBody runs the validations defined on
+// wrap secret_secret_not_found_response_body
+func ValidateWrapSecretSecretNotFoundResponseBody(body *WrapSecretSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretFieldNotFoundResponseBody runs the validations defined on
+// wrap secret_field_not_found_response_body
+func ValidateWrapSecretFieldNotFoundResponseBody(body *WrapSecretFieldNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretSecretTooLargeResponseBody runs the validations defined on
+// wrap secret_secret_too_large_response_body
+func ValidateWrapSecretSecretTooLargeResponseBody(body *WrapSecretSecretTooLargeResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretInvalidParametersResponseBody runs the validations defined
+// on wrap secret_invalid_parameters_response_body
+func ValidateWrapSecretInvalidParametersResponseBody(body *WrapSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretUnauthorizedResponseBody runs the validations defined on
+// wrap secret_unauthorized_response_body
+func ValidateWrapSecretUnauthorizedResponseBody(body *WrapSecretUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretForbiddenResponseBody runs the validations defined on wrap
+// secret_forbidden_response_body
+func ValidateWrapSecretForbiddenResponseBody(body *WrapSecretForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretInternalErrorResponseBody runs the validations defined on
+// wrap secret_internal_error_response_body
+func ValidateWrapSecretInternalErrorResponseBody(body *WrapSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretTokenNotFoundResponseBody runs the validations defined
+// on unwrap secret_token_not_found_response_body
+func ValidateUnwrapSecretTokenNotFoundResponseBody(body *UnwrapSecretTokenNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretTokenExpiredResponseBody runs the validations defined on
+// unwrap secret_token_expired_response_body
+func ValidateUnwrapSecretTokenExpiredResponseBody(body *UnwrapSecretTokenExpiredResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretTokenAlreadyUsedResponseBody runs the validations
+// defined on unwrap secret_token_already_used_response_body
+func ValidateUnwrapSecretTokenAlreadyUsedResponseBody(body *UnwrapSecretTokenAlreadyUsedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretInvalidParametersResponseBody runs the validations
+// defined on unwrap secret_invalid_parameters_response_body
+func ValidateUnwrapSecretInvalidParametersResponseBody(body *UnwrapSecretInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateUnwrapSecretInternalErrorResponseBody runs the validations defined
+// on unwrap secret_internal_error_response_body
+func ValidateUnwrapSecretInternalErrorResponseBody(body *UnwrapSecretInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessSecretNotFoundResponseBody runs the validations defined
+// on request access_secret_not_found_response_body
+func ValidateRequestAccessSecretNotFoundResponseBody(body *RequestAccessSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessAccessRequestPendingResponseBody runs the validations
+// defined on request access_access_request_pending_response_body
+func ValidateRequestAccessAccessRequestPendingResponseBody(body *RequestAccessAccessRequestPendingResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessInvalidParametersResponseBody runs the validations
+// defined on request access_invalid_parameters_response_body
+func ValidateRequestAccessInvalidParametersResponseBody(body *RequestAccessInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessUnauthorizedResponseBody runs the validations defined
+// on request access_unauthorized_response_body
+func ValidateRequestAccessUnauthorizedResponseBody(body *RequestAccessUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessForbiddenResponseBody runs the validations defined on
+// request access_forbidden_response_body
+func ValidateRequestAccessForbiddenResponseBody(body *RequestAccessForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessInternalErrorResponseBody runs the validations defined
+// on request access_internal_error_response_body
+func ValidateRequestAccessInternalErrorResponseBody(body *RequestAccessInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListAccessRequestsInvalidParametersResponseBody runs the validations
+// defined on list access requests_invalid_parameters_response_body
+func ValidateListAccessRequestsInvalidParametersResponseBody(body *ListAccessRequestsInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListAccessRequestsUnauthorizedResponseBody runs the validations
+// defined on list access requests_unauthorized_response_body
+func ValidateListAccessRequestsUnauthorizedResponseBody(body *ListAccessRequestsUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListAccessRequestsForbiddenResponseBody runs the validations defined
+// on list access requests_forbidden_response_body
+func ValidateListAccessRequestsForbiddenResponseBody(body *ListAccessRequestsForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateListAccessRequestsInternalErrorResponseBody runs the validations
+// defined on list access requests_internal_error_response_body
+func ValidateListAccessRequestsInternalErrorResponseBody(body *ListAccessRequestsInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// UserResponseBody is used to define fields on response body types.
-type UserResponseBody struct {
-	// Unique identifier for the user
-	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// The username
-	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
-	// User creation timestamp
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// User last update timestamp
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
-	// Roles assigned to the user
-	Roles []*RoleResponseBody `form:"roles,omitempty" json:"roles,omitempty" xml:"roles,omitempty"`
+// ValidateApproveAccessRequestAccessRequestNotFoundResponseBody runs the
+// validations defined on approve access
+// request_access_request_not_found_response_body
+func ValidateApproveAccessRequestAccessRequestNotFoundResponseBody(body *ApproveAccessRequestAccessRequestNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// RoleResponseBody is used to define fields on response body types.
-type RoleResponseBody struct {
-	// Unique identifier for the role
-	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Name of the role
-	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
-	// Color associated with the role
-	Color *string `form:"color,omitempty" json:"color,omitempty" xml:"color,omitempty"`
-	// Is this role an admin role?
-	Admin *bool `form:"admin,omitempty" json:"admin,omitempty" xml:"admin,omitempty"`
-	// Role creation timestamp
-	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
-	// Role last update timestamp
-	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+// ValidateApproveAccessRequestSecretNotFoundResponseBody runs the validations
+// defined on approve access request_secret_not_found_response_body
+func ValidateApproveAccessRequestSecretNotFoundResponseBody(body *ApproveAccessRequestSecretNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCreateSecretRequestBody builds the HTTP request body from the payload of
-// the "create secret" endpoint of the "secrets" service.
-func NewCreateSecretRequestBody(p *secrets.CreateSecretPayload) *CreateSecretRequestBody {
-	body := &CreateSecretRequestBody{
-		Path:  p.Path,
-		Value: p.Value,
+// ValidateApproveAccessRequestAccessRequestDecidedResponseBody runs the
+// validations defined on approve access
+// request_access_request_decided_response_body
+func ValidateApproveAccessRequestAccessRequestDecidedResponseBody(body *ApproveAccessRequestAccessRequestDecidedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if p.AuthorizedUsers != nil {
-		body.AuthorizedUsers = make([]int, len(p.AuthorizedUsers))
-		for i, val := range p.AuthorizedUsers {
-			body.AuthorizedUsers[i] = val
-		}
-	} else {
-		body.AuthorizedUsers = []int{}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if p.AuthorizedRoles != nil {
-		body.AuthorizedRoles = make([]int, len(p.AuthorizedRoles))
-		for i, val := range p.AuthorizedRoles {
-			body.AuthorizedRoles[i] = val
-		}
-	} else {
-		body.AuthorizedRoles = []int{}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateApproveAccessRequestInvalidParametersResponseBody runs the
+// validations defined on approve access
+// request_invalid_parameters_response_body
+func ValidateApproveAccessRequestInvalidParametersResponseBody(body *ApproveAccessRequestInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateApproveAccessRequestUnauthorizedResponseBody runs the validations
+// defined on approve access request_unauthorized_response_body
+func ValidateApproveAccessRequestUnauthorizedResponseBody(body *ApproveAccessRequestUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateApproveAccessRequestForbiddenResponseBody runs the validations
+// defined on approve access request_forbidden_response_body
+func ValidateApproveAccessRequestForbiddenResponseBody(body *ApproveAccessRequestForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateApproveAccessRequestInternalErrorResponseBody runs the validations
+// defined on approve access request_internal_error_response_body
+func ValidateApproveAccessRequestInternalErrorResponseBody(body *ApproveAccessRequestInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDenyAccessRequestAccessRequestNotFoundResponseBody runs the
+// validations defined on deny access
+// request_access_request_not_found_response_body
+func ValidateDenyAccessRequestAccessRequestNotFoundResponseBody(body *DenyAccessRequestAccessRequestNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDenyAccessRequestAccessRequestDecidedResponseBody runs the
+// validations defined on deny access
+// request_access_request_decided_response_body
+func ValidateDenyAccessRequestAccessRequestDecidedResponseBody(body *DenyAccessRequestAccessRequestDecidedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	return body
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretRequestBody builds the HTTP request body from the payload of
-// the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretRequestBody(p *secrets.UpdateSecretPayload) *UpdateSecretRequestBody {
-	body := &UpdateSecretRequestBody{
-		Path:  p.Path,
-		Value: p.Value,
+// ValidateDenyAccessRequestInvalidParametersResponseBody runs the validations
+// defined on deny access request_invalid_parameters_response_body
+func ValidateDenyAccessRequestInvalidParametersResponseBody(body *DenyAccessRequestInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if p.AuthorizedUsers != nil {
-		body.AuthorizedUsers = make([]int, len(p.AuthorizedUsers))
-		for i, val := range p.AuthorizedUsers {
-			body.AuthorizedUsers[i] = val
-		}
-	} else {
-		body.AuthorizedUsers = []int{}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if p.AuthorizedRoles != nil {
-		body.AuthorizedRoles = make([]int, len(p.AuthorizedRoles))
-		for i, val := range p.AuthorizedRoles {
-			body.AuthorizedRoles[i] = val
-		}
-	} else {
-		body.AuthorizedRoles = []int{}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	return body
-}
-
-// NewListSecretsSecretInfoSummaryOK builds a "secrets" service "list secrets"
-// endpoint result from a HTTP "OK" response.
-func NewListSecretsSecretInfoSummaryOK(body []*SecretInfoSummaryResponse) []*secrets.SecretInfoSummary {
-	v := make([]*secrets.SecretInfoSummary, len(body))
-	for i, val := range body {
-		v[i] = unmarshalSecretInfoSummaryResponseToSecretsSecretInfoSummary(val)
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-
-	return v
-}
-
-// NewListSecretsUnauthorized builds a secrets service list secrets endpoint
-// unauthorized error.
-func NewListSecretsUnauthorized(body *ListSecretsUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-
-	return v
-}
-
-// NewListSecretsForbidden builds a secrets service list secrets endpoint
-// forbidden error.
-func NewListSecretsForbidden(body *ListSecretsForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
-
-	return v
+	return
 }
 
-// NewListSecretsInternalError builds a secrets service list secrets endpoint
-// internal_error error.
-func NewListSecretsInternalError(body *ListSecretsInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDenyAccessRequestUnauthorizedResponseBody runs the validations
+// defined on deny access request_unauthorized_response_body
+func ValidateDenyAccessRequestUnauthorizedResponseBody(body *DenyAccessRequestUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
-}
-
-// NewGetSecretValueResultOK builds a "secrets" service "get secret value"
-// endpoint result from a HTTP "OK" response.
-func NewGetSecretValueResultOK(body *GetSecretValueResponseBody) *secrets.GetSecretValueResult {
-	v := &secrets.GetSecretValueResult{
-		Value: body.Value,
-		Path:  body.Path,
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-
-	return v
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretValueSecretNotFound builds a secrets service get secret value
-// endpoint secret_not_found error.
-func NewGetSecretValueSecretNotFound(body *GetSecretValueSecretNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDenyAccessRequestForbiddenResponseBody runs the validations defined
+// on deny access request_forbidden_response_body
+func ValidateDenyAccessRequestForbiddenResponseBody(body *DenyAccessRequestForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretValueInvalidParameters builds a secrets service get secret value
-// endpoint invalid_parameters error.
-func NewGetSecretValueInvalidParameters(body *GetSecretValueInvalidParametersResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDenyAccessRequestInternalErrorResponseBody runs the validations
+// defined on deny access request_internal_error_response_body
+func ValidateDenyAccessRequestInternalErrorResponseBody(body *DenyAccessRequestInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretValueUnauthorized builds a secrets service get secret value
-// endpoint unauthorized error.
-func NewGetSecretValueUnauthorized(body *GetSecretValueUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListValidationRulesUnauthorizedResponseBody runs the validations
+// defined on list validation rules_unauthorized_response_body
+func ValidateListValidationRulesUnauthorizedResponseBody(body *ListValidationRulesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretValueForbidden builds a secrets service get secret value
-// endpoint forbidden error.
-func NewGetSecretValueForbidden(body *GetSecretValueForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListValidationRulesForbiddenResponseBody runs the validations
+// defined on list validation rules_forbidden_response_body
+func ValidateListValidationRulesForbiddenResponseBody(body *ListValidationRulesForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretValueInternalError builds a secrets service get secret value
-// endpoint internal_error error.
-func NewGetSecretValueInternalError(body *GetSecretValueInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListValidationRulesInternalErrorResponseBody runs the validations
+// defined on list validation rules_internal_error_response_body
+func ValidateListValidationRulesInternalErrorResponseBody(body *ListValidationRulesInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-
-	return v
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretSecretInfoOK builds a "secrets" service "get secret" endpoint
-// result from a HTTP "OK" response.
-func NewGetSecretSecretInfoOK(body *GetSecretResponseBody) *secrets.SecretInfo {
-	v := &secrets.SecretInfo{
-		Path:      *body.Path,
-		CreatedAt: *body.CreatedAt,
-		UpdatedAt: *body.UpdatedAt,
+// ValidateCreateValidationRuleInvalidParametersResponseBody runs the
+// validations defined on create validation
+// rule_invalid_parameters_response_body
+func ValidateCreateValidationRuleInvalidParametersResponseBody(body *CreateValidationRuleInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	v.Owner = unmarshalUserResponseBodyToSecretsUser(body.Owner)
-	v.AuthorizedUsers = make([]*secrets.User, len(body.AuthorizedUsers))
-	for i, val := range body.AuthorizedUsers {
-		v.AuthorizedUsers[i] = unmarshalUserResponseBodyToSecretsUser(val)
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	v.AuthorizedRoles = make([]*secrets.Role, len(body.AuthorizedRoles))
-	for i, val := range body.AuthorizedRoles {
-		v.AuthorizedRoles[i] = unmarshalRoleResponseBodyToSecretsRole(val)
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-
-	return v
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretSecretNotFound builds a secrets service get secret endpoint
-// secret_not_found error.
-func NewGetSecretSecretNotFound(body *GetSecretSecretNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateValidationRuleUnauthorizedResponseBody runs the validations
+// defined on create validation rule_unauthorized_response_body
+func ValidateCreateValidationRuleUnauthorizedResponseBody(body *CreateValidationRuleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretInvalidParameters builds a secrets service get secret endpoint
-// invalid_parameters error.
-func NewGetSecretInvalidParameters(body *GetSecretInvalidParametersResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateValidationRuleForbiddenResponseBody runs the validations
+// defined on create validation rule_forbidden_response_body
+func ValidateCreateValidationRuleForbiddenResponseBody(body *CreateValidationRuleForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretUnauthorized builds a secrets service get secret endpoint
-// unauthorized error.
-func NewGetSecretUnauthorized(body *GetSecretUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateValidationRuleInternalErrorResponseBody runs the validations
+// defined on create validation rule_internal_error_response_body
+func ValidateCreateValidationRuleInternalErrorResponseBody(body *CreateValidationRuleInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretForbidden builds a secrets service get secret endpoint forbidden
-// error.
-func NewGetSecretForbidden(body *GetSecretForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteValidationRuleValidationRuleNotFoundResponseBody runs the
+// validations defined on delete validation
+// rule_validation_rule_not_found_response_body
+func ValidateDeleteValidationRuleValidationRuleNotFoundResponseBody(body *DeleteValidationRuleValidationRuleNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewGetSecretInternalError builds a secrets service get secret endpoint
-// internal_error error.
-func NewGetSecretInternalError(body *GetSecretInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteValidationRuleUnauthorizedResponseBody runs the validations
+// defined on delete validation rule_unauthorized_response_body
+func ValidateDeleteValidationRuleUnauthorizedResponseBody(body *DeleteValidationRuleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCreateSecretInvalidParameters builds a secrets service create secret
-// endpoint invalid_parameters error.
-func NewCreateSecretInvalidParameters(body *CreateSecretInvalidParametersResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteValidationRuleForbiddenResponseBody runs the validations
+// defined on delete validation rule_forbidden_response_body
+func ValidateDeleteValidationRuleForbiddenResponseBody(body *DeleteValidationRuleForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
 
-	return v
+// ValidateDeleteValidationRuleInternalErrorResponseBody runs the validations
+// defined on delete validation rule_internal_error_response_body
+func ValidateDeleteValidationRuleInternalErrorResponseBody(body *DeleteValidationRuleInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCreateSecretUnauthorized builds a secrets service create secret endpoint
-// unauthorized error.
-func NewCreateSecretUnauthorized(body *CreateSecretUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListBreakGlassRulesUnauthorizedResponseBody runs the validations
+// defined on list break glass rules_unauthorized_response_body
+func ValidateListBreakGlassRulesUnauthorizedResponseBody(body *ListBreakGlassRulesUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-
-	return v
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCreateSecretForbidden builds a secrets service create secret endpoint
-// forbidden error.
-func NewCreateSecretForbidden(body *CreateSecretForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListBreakGlassRulesForbiddenResponseBody runs the validations
+// defined on list break glass rules_forbidden_response_body
+func ValidateListBreakGlassRulesForbiddenResponseBody(body *ListBreakGlassRulesForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewCreateSecretInternalError builds a secrets service create secret endpoint
-// internal_error error.
-func NewCreateSecretInternalError(body *CreateSecretInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateListBreakGlassRulesInternalErrorResponseBody runs the validations
+// defined on list break glass rules_internal_error_response_body
+func ValidateListBreakGlassRulesInternalErrorResponseBody(body *ListBreakGlassRulesInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretSecretNotFound builds a secrets service update secret
-// endpoint secret_not_found error.
-func NewUpdateSecretSecretNotFound(body *UpdateSecretSecretNotFoundResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateBreakGlassRuleInvalidParametersResponseBody runs the
+// validations defined on create break glass
+// rule_invalid_parameters_response_body
+func ValidateCreateBreakGlassRuleInvalidParametersResponseBody(body *CreateBreakGlassRuleInvalidParametersResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretInvalidParameters builds a secrets service update secret
-// endpoint invalid_parameters error.
-func NewUpdateSecretInvalidParameters(body *UpdateSecretInvalidParametersResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateBreakGlassRuleUnauthorizedResponseBody runs the validations
+// defined on create break glass rule_unauthorized_response_body
+func ValidateCreateBreakGlassRuleUnauthorizedResponseBody(body *CreateBreakGlassRuleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretUnauthorized builds a secrets service update secret endpoint
-// unauthorized error.
-func NewUpdateSecretUnauthorized(body *UpdateSecretUnauthorizedResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateBreakGlassRuleForbiddenResponseBody runs the validations
+// defined on create break glass rule_forbidden_response_body
+func ValidateCreateBreakGlassRuleForbiddenResponseBody(body *CreateBreakGlassRuleForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretForbidden builds a secrets service update secret endpoint
-// forbidden error.
-func NewUpdateSecretForbidden(body *UpdateSecretForbiddenResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateCreateBreakGlassRuleInternalErrorResponseBody runs the validations
+// defined on create break glass rule_internal_error_response_body
+func ValidateCreateBreakGlassRuleInternalErrorResponseBody(body *CreateBreakGlassRuleInternalErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// NewUpdateSecretInternalError builds a secrets service update secret endpoint
-// internal_error error.
-func NewUpdateSecretInternalError(body *UpdateSecretInternalErrorResponseBody) *goa.ServiceError {
-	v := &goa.ServiceError{
-		Name:      *body.Name,
-		ID:        *body.ID,
-		Message:   *body.Message,
-		Temporary: *body.Temporary,
-		Timeout:   *body.Timeout,
-		Fault:     *body.Fault,
+// ValidateDeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody runs the
+// validations defined on delete break glass
+// rule_break_glass_rule_not_found_response_body
+func ValidateDeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody(body *DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-
-	return v
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
 }
 
-// ValidateGetSecretResponseBody runs the validations defined on Get
-// SecretResponseBody
-func ValidateGetSecretResponseBody(body *GetSecretResponseBody) (err error) {
-	if body.Path == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+// ValidateDeleteBreakGlassRuleUnauthorizedResponseBody runs the validations
+// defined on delete break glass rule_unauthorized_response_body
+func ValidateDeleteBreakGlassRuleUnauthorizedResponseBody(body *DeleteBreakGlassRuleUnauthorizedResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
-	if body.Owner == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("owner", "body"))
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.AuthorizedUsers == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.AuthorizedRoles == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_roles", "body"))
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
+// ValidateDeleteBreakGlassRuleForbiddenResponseBody runs the validations
+// defined on delete break glass rule_forbidden_response_body
+func ValidateDeleteBreakGlassRuleForbiddenResponseBody(body *DeleteBreakGlassRuleForbiddenResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
-	if body.Owner != nil {
-		if err2 := ValidateUserResponseBody(body.Owner); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
 	}
-	for _, e := range body.AuthorizedUsers {
-		if e != nil {
-			if err2 := ValidateUserResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
 	}
-	for _, e := range body.AuthorizedRoles {
-		if e != nil {
-			if err2 := ValidateRoleResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
 	}
 	return
 }
 
-// ValidateListSecretsUnauthorizedResponseBody runs the validations defined on
-// list secrets_unauthorized_response_body
-func ValidateListSecretsUnauthorizedResponseBody(body *ListSecretsUnauthorizedResponseBody) (err error) {
+// ValidateDeleteBreakGlassRuleInternalErrorResponseBody runs the validations
+// defined on delete break glass rule_internal_error_response_body
+func ValidateDeleteBreakGlassRuleInternalErrorResponseBody(body *DeleteBreakGlassRuleInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1039,9 +12488,10 @@ func ValidateListSecretsUnauthorizedResponseBody(body *ListSecretsUnauthorizedRe
 	return
 }
 
-// ValidateListSecretsForbiddenResponseBody runs the validations defined on
-// list secrets_forbidden_response_body
-func ValidateListSecretsForbiddenResponseBody(body *ListSecretsForbiddenResponseBody) (err error) {
+// ValidateListBreakGlassAccessesInvalidParametersResponseBody runs the
+// validations defined on list break glass
+// accesses_invalid_parameters_response_body
+func ValidateListBreakGlassAccessesInvalidParametersResponseBody(body *ListBreakGlassAccessesInvalidParametersResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1063,9 +12513,9 @@ func ValidateListSecretsForbiddenResponseBody(body *ListSecretsForbiddenResponse
 	return
 }
 
-// ValidateListSecretsInternalErrorResponseBody runs the validations defined on
-// list secrets_internal_error_response_body
-func ValidateListSecretsInternalErrorResponseBody(body *ListSecretsInternalErrorResponseBody) (err error) {
+// ValidateListBreakGlassAccessesUnauthorizedResponseBody runs the validations
+// defined on list break glass accesses_unauthorized_response_body
+func ValidateListBreakGlassAccessesUnauthorizedResponseBody(body *ListBreakGlassAccessesUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1087,9 +12537,9 @@ func ValidateListSecretsInternalErrorResponseBody(body *ListSecretsInternalError
 	return
 }
 
-// ValidateGetSecretValueSecretNotFoundResponseBody runs the validations
-// defined on get secret value_secret_not_found_response_body
-func ValidateGetSecretValueSecretNotFoundResponseBody(body *GetSecretValueSecretNotFoundResponseBody) (err error) {
+// ValidateListBreakGlassAccessesForbiddenResponseBody runs the validations
+// defined on list break glass accesses_forbidden_response_body
+func ValidateListBreakGlassAccessesForbiddenResponseBody(body *ListBreakGlassAccessesForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1111,9 +12561,9 @@ func ValidateGetSecretValueSecretNotFoundResponseBody(body *GetSecretValueSecret
 	return
 }
 
-// ValidateGetSecretValueInvalidParametersResponseBody runs the validations
-// defined on get secret value_invalid_parameters_response_body
-func ValidateGetSecretValueInvalidParametersResponseBody(body *GetSecretValueInvalidParametersResponseBody) (err error) {
+// ValidateListBreakGlassAccessesInternalErrorResponseBody runs the validations
+// defined on list break glass accesses_internal_error_response_body
+func ValidateListBreakGlassAccessesInternalErrorResponseBody(body *ListBreakGlassAccessesInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1135,9 +12585,10 @@ func ValidateGetSecretValueInvalidParametersResponseBody(body *GetSecretValueInv
 	return
 }
 
-// ValidateGetSecretValueUnauthorizedResponseBody runs the validations defined
-// on get secret value_unauthorized_response_body
-func ValidateGetSecretValueUnauthorizedResponseBody(body *GetSecretValueUnauthorizedResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody runs the
+// validations defined on review break glass
+// access_break_glass_access_not_found_response_body
+func ValidateReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody(body *ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1159,9 +12610,10 @@ func ValidateGetSecretValueUnauthorizedResponseBody(body *GetSecretValueUnauthor
 	return
 }
 
-// ValidateGetSecretValueForbiddenResponseBody runs the validations defined on
-// get secret value_forbidden_response_body
-func ValidateGetSecretValueForbiddenResponseBody(body *GetSecretValueForbiddenResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody runs the
+// validations defined on review break glass
+// access_break_glass_access_reviewed_response_body
+func ValidateReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody(body *ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1183,9 +12635,10 @@ func ValidateGetSecretValueForbiddenResponseBody(body *GetSecretValueForbiddenRe
 	return
 }
 
-// ValidateGetSecretValueInternalErrorResponseBody runs the validations defined
-// on get secret value_internal_error_response_body
-func ValidateGetSecretValueInternalErrorResponseBody(body *GetSecretValueInternalErrorResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessInvalidParametersResponseBody runs the
+// validations defined on review break glass
+// access_invalid_parameters_response_body
+func ValidateReviewBreakGlassAccessInvalidParametersResponseBody(body *ReviewBreakGlassAccessInvalidParametersResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1207,9 +12660,9 @@ func ValidateGetSecretValueInternalErrorResponseBody(body *GetSecretValueInterna
 	return
 }
 
-// ValidateGetSecretSecretNotFoundResponseBody runs the validations defined on
-// get secret_secret_not_found_response_body
-func ValidateGetSecretSecretNotFoundResponseBody(body *GetSecretSecretNotFoundResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessUnauthorizedResponseBody runs the validations
+// defined on review break glass access_unauthorized_response_body
+func ValidateReviewBreakGlassAccessUnauthorizedResponseBody(body *ReviewBreakGlassAccessUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1231,9 +12684,9 @@ func ValidateGetSecretSecretNotFoundResponseBody(body *GetSecretSecretNotFoundRe
 	return
 }
 
-// ValidateGetSecretInvalidParametersResponseBody runs the validations defined
-// on get secret_invalid_parameters_response_body
-func ValidateGetSecretInvalidParametersResponseBody(body *GetSecretInvalidParametersResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessForbiddenResponseBody runs the validations
+// defined on review break glass access_forbidden_response_body
+func ValidateReviewBreakGlassAccessForbiddenResponseBody(body *ReviewBreakGlassAccessForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1255,9 +12708,9 @@ func ValidateGetSecretInvalidParametersResponseBody(body *GetSecretInvalidParame
 	return
 }
 
-// ValidateGetSecretUnauthorizedResponseBody runs the validations defined on
-// get secret_unauthorized_response_body
-func ValidateGetSecretUnauthorizedResponseBody(body *GetSecretUnauthorizedResponseBody) (err error) {
+// ValidateReviewBreakGlassAccessInternalErrorResponseBody runs the validations
+// defined on review break glass access_internal_error_response_body
+func ValidateReviewBreakGlassAccessInternalErrorResponseBody(body *ReviewBreakGlassAccessInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1279,9 +12732,9 @@ func ValidateGetSecretUnauthorizedResponseBody(body *GetSecretUnauthorizedRespon
 	return
 }
 
-// ValidateGetSecretForbiddenResponseBody runs the validations defined on get
-// secret_forbidden_response_body
-func ValidateGetSecretForbiddenResponseBody(body *GetSecretForbiddenResponseBody) (err error) {
+// ValidateListFolderAccessesUnauthorizedResponseBody runs the validations
+// defined on list folder accesses_unauthorized_response_body
+func ValidateListFolderAccessesUnauthorizedResponseBody(body *ListFolderAccessesUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1303,9 +12756,9 @@ func ValidateGetSecretForbiddenResponseBody(body *GetSecretForbiddenResponseBody
 	return
 }
 
-// ValidateGetSecretInternalErrorResponseBody runs the validations defined on
-// get secret_internal_error_response_body
-func ValidateGetSecretInternalErrorResponseBody(body *GetSecretInternalErrorResponseBody) (err error) {
+// ValidateListFolderAccessesForbiddenResponseBody runs the validations defined
+// on list folder accesses_forbidden_response_body
+func ValidateListFolderAccessesForbiddenResponseBody(body *ListFolderAccessesForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1327,9 +12780,9 @@ func ValidateGetSecretInternalErrorResponseBody(body *GetSecretInternalErrorResp
 	return
 }
 
-// ValidateCreateSecretInvalidParametersResponseBody runs the validations
-// defined on create secret_invalid_parameters_response_body
-func ValidateCreateSecretInvalidParametersResponseBody(body *CreateSecretInvalidParametersResponseBody) (err error) {
+// ValidateListFolderAccessesInternalErrorResponseBody runs the validations
+// defined on list folder accesses_internal_error_response_body
+func ValidateListFolderAccessesInternalErrorResponseBody(body *ListFolderAccessesInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1351,9 +12804,9 @@ func ValidateCreateSecretInvalidParametersResponseBody(body *CreateSecretInvalid
 	return
 }
 
-// ValidateCreateSecretUnauthorizedResponseBody runs the validations defined on
-// create secret_unauthorized_response_body
-func ValidateCreateSecretUnauthorizedResponseBody(body *CreateSecretUnauthorizedResponseBody) (err error) {
+// ValidateGrantFolderAccessInvalidParametersResponseBody runs the validations
+// defined on grant folder access_invalid_parameters_response_body
+func ValidateGrantFolderAccessInvalidParametersResponseBody(body *GrantFolderAccessInvalidParametersResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1375,9 +12828,9 @@ func ValidateCreateSecretUnauthorizedResponseBody(body *CreateSecretUnauthorized
 	return
 }
 
-// ValidateCreateSecretForbiddenResponseBody runs the validations defined on
-// create secret_forbidden_response_body
-func ValidateCreateSecretForbiddenResponseBody(body *CreateSecretForbiddenResponseBody) (err error) {
+// ValidateGrantFolderAccessUnauthorizedResponseBody runs the validations
+// defined on grant folder access_unauthorized_response_body
+func ValidateGrantFolderAccessUnauthorizedResponseBody(body *GrantFolderAccessUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1399,9 +12852,9 @@ func ValidateCreateSecretForbiddenResponseBody(body *CreateSecretForbiddenRespon
 	return
 }
 
-// ValidateCreateSecretInternalErrorResponseBody runs the validations defined
-// on create secret_internal_error_response_body
-func ValidateCreateSecretInternalErrorResponseBody(body *CreateSecretInternalErrorResponseBody) (err error) {
+// ValidateGrantFolderAccessForbiddenResponseBody runs the validations defined
+// on grant folder access_forbidden_response_body
+func ValidateGrantFolderAccessForbiddenResponseBody(body *GrantFolderAccessForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1423,9 +12876,9 @@ func ValidateCreateSecretInternalErrorResponseBody(body *CreateSecretInternalErr
 	return
 }
 
-// ValidateUpdateSecretSecretNotFoundResponseBody runs the validations defined
-// on update secret_secret_not_found_response_body
-func ValidateUpdateSecretSecretNotFoundResponseBody(body *UpdateSecretSecretNotFoundResponseBody) (err error) {
+// ValidateGrantFolderAccessInternalErrorResponseBody runs the validations
+// defined on grant folder access_internal_error_response_body
+func ValidateGrantFolderAccessInternalErrorResponseBody(body *GrantFolderAccessInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1447,9 +12900,10 @@ func ValidateUpdateSecretSecretNotFoundResponseBody(body *UpdateSecretSecretNotF
 	return
 }
 
-// ValidateUpdateSecretInvalidParametersResponseBody runs the validations
-// defined on update secret_invalid_parameters_response_body
-func ValidateUpdateSecretInvalidParametersResponseBody(body *UpdateSecretInvalidParametersResponseBody) (err error) {
+// ValidateRevokeFolderAccessFolderAccessNotFoundResponseBody runs the
+// validations defined on revoke folder
+// access_folder_access_not_found_response_body
+func ValidateRevokeFolderAccessFolderAccessNotFoundResponseBody(body *RevokeFolderAccessFolderAccessNotFoundResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1471,9 +12925,9 @@ func ValidateUpdateSecretInvalidParametersResponseBody(body *UpdateSecretInvalid
 	return
 }
 
-// ValidateUpdateSecretUnauthorizedResponseBody runs the validations defined on
-// update secret_unauthorized_response_body
-func ValidateUpdateSecretUnauthorizedResponseBody(body *UpdateSecretUnauthorizedResponseBody) (err error) {
+// ValidateRevokeFolderAccessUnauthorizedResponseBody runs the validations
+// defined on revoke folder access_unauthorized_response_body
+func ValidateRevokeFolderAccessUnauthorizedResponseBody(body *RevokeFolderAccessUnauthorizedResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1495,9 +12949,9 @@ func ValidateUpdateSecretUnauthorizedResponseBody(body *UpdateSecretUnauthorized
 	return
 }
 
-// ValidateUpdateSecretForbiddenResponseBody runs the validations defined on
-// update secret_forbidden_response_body
-func ValidateUpdateSecretForbiddenResponseBody(body *UpdateSecretForbiddenResponseBody) (err error) {
+// ValidateRevokeFolderAccessForbiddenResponseBody runs the validations defined
+// on revoke folder access_forbidden_response_body
+func ValidateRevokeFolderAccessForbiddenResponseBody(body *RevokeFolderAccessForbiddenResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1519,9 +12973,9 @@ func ValidateUpdateSecretForbiddenResponseBody(body *UpdateSecretForbiddenRespon
 	return
 }
 
-// ValidateUpdateSecretInternalErrorResponseBody runs the validations defined
-// on update secret_internal_error_response_body
-func ValidateUpdateSecretInternalErrorResponseBody(body *UpdateSecretInternalErrorResponseBody) (err error) {
+// ValidateRevokeFolderAccessInternalErrorResponseBody runs the validations
+// defined on revoke folder access_internal_error_response_body
+func ValidateRevokeFolderAccessInternalErrorResponseBody(body *RevokeFolderAccessInternalErrorResponseBody) (err error) {
 	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
@@ -1549,9 +13003,6 @@ func ValidateSecretInfoSummaryResponse(body *SecretInfoSummaryResponse) (err err
 	if body.Path == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
 	}
-	if body.Owner == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("owner", "body"))
-	}
 	if body.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
@@ -1569,6 +13020,11 @@ func ValidateSecretInfoSummaryResponse(body *SecretInfoSummaryResponse) (err err
 			err = goa.MergeErrors(err, err2)
 		}
 	}
+	if body.OwnerRole != nil {
+		if err2 := ValidateRoleResponse(body.OwnerRole); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	for _, e := range body.Users {
 		if e != nil {
 			if err2 := ValidateUserResponse(e); err2 != nil {
@@ -1641,6 +13097,64 @@ func ValidateRoleResponse(body *RoleResponse) (err error) {
 	return
 }
 
+// ValidateSecretEntryResponseBody runs the validations defined on
+// SecretEntryResponseBody
+func ValidateSecretEntryResponseBody(body *SecretEntryResponseBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Folder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("folder", "body"))
+	}
+	return
+}
+
+// ValidateSecretValueRequestRequestBody runs the validations defined on
+// SecretValueRequestRequestBody
+func ValidateSecretValueRequestRequestBody(body *SecretValueRequestRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Path) < 2 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", body.Path, utf8.RuneCountInString(body.Path), 2, true))
+	}
+	return
+}
+
+// ValidateSecretValueResponseBody runs the validations defined on
+// SecretValueResponseBody
+func ValidateSecretValueResponseBody(body *SecretValueResponseBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Error != nil {
+		if !(*body.Error == "invalid_parameters" || *body.Error == "forbidden" || *body.Error == "secret_not_found" || *body.Error == "field_not_found" || *body.Error == "internal_error") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.error", *body.Error, []any{"invalid_parameters", "forbidden", "secret_not_found", "field_not_found", "internal_error"}))
+		}
+	}
+	return
+}
+
+// ValidateImportedSecretResponse runs the validations defined on
+// ImportedSecretResponse
+func ValidateImportedSecretResponse(body *ImportedSecretResponse) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Action == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("action", "body"))
+	}
+	if body.Action != nil {
+		if !(*body.Action == "create" || *body.Action == "overwrite" || *body.Action == "skip") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.action", *body.Action, []any{"create", "overwrite", "skip"}))
+		}
+	}
+	return
+}
+
 // ValidateUserResponseBody runs the validations defined on UserResponseBody
 func ValidateUserResponseBody(body *UserResponseBody) (err error) {
 	if body.ID == nil {
@@ -1695,3 +13209,236 @@ func ValidateRoleResponseBody(body *RoleResponseBody) (err error) {
 	}
 	return
 }
+
+// ValidateGrantResponseBody runs the validations defined on GrantResponseBody
+func ValidateGrantResponseBody(body *GrantResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if len(body.Capabilities) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.capabilities", body.Capabilities, len(body.Capabilities), 1, true))
+	}
+	for _, e := range body.Capabilities {
+		if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGrantRequestBody runs the validations defined on GrantRequestBody
+func ValidateGrantRequestBody(body *GrantRequestBody) (err error) {
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if len(body.Capabilities) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.capabilities", body.Capabilities, len(body.Capabilities), 1, true))
+	}
+	for _, e := range body.Capabilities {
+		if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSecretRotationResponse runs the validations defined on
+// SecretRotationResponse
+func ValidateSecretRotationResponse(body *SecretRotationResponse) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Scheduled == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("scheduled", "body"))
+	}
+	if body.RotatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rotated_at", "body"))
+	}
+	return
+}
+
+// ValidateSecretDiffResponse runs the validations defined on SecretDiffResponse
+func ValidateSecretDiffResponse(body *SecretDiffResponse) (err error) {
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "missing" || *body.Status == "extra" || *body.Status == "different" || *body.Status == "identical" || *body.Status == "inaccessible") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"missing", "extra", "different", "identical", "inaccessible"}))
+		}
+	}
+	return
+}
+
+// ValidateStaleSecretResponse runs the validations defined on
+// StaleSecretResponse
+func ValidateStaleSecretResponse(body *StaleSecretResponse) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.LastReader != nil {
+		if err2 := ValidateUserResponse(body.LastReader); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAccessRequestResponse runs the validations defined on
+// AccessRequestResponse
+func ValidateAccessRequestResponse(body *AccessRequestResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Requester != nil {
+		if err2 := ValidateUserResponse(body.Requester); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "approved" || *body.Status == "denied") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "approved", "denied"}))
+		}
+	}
+	if body.DecidedBy != nil {
+		if err2 := ValidateUserResponse(body.DecidedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateValidationRuleResponse runs the validations defined on
+// ValidationRuleResponse
+func ValidateValidationRuleResponse(body *ValidationRuleResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.Parameter == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("parameter", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "json_schema" || *body.Kind == "regex" || *body.Kind == "max_length" || *body.Kind == "pem_type" || *body.Kind == "x509_certificate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"json_schema", "regex", "max_length", "pem_type", "x509_certificate"}))
+		}
+	}
+	return
+}
+
+// ValidateBreakGlassRuleResponse runs the validations defined on
+// BreakGlassRuleResponse
+func ValidateBreakGlassRuleResponse(body *BreakGlassRuleResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Role != nil {
+		if err2 := ValidateRoleResponse(body.Role); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBreakGlassAccessResponse runs the validations defined on
+// BreakGlassAccessResponse
+func ValidateBreakGlassAccessResponse(body *BreakGlassAccessResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.User != nil {
+		if err2 := ValidateUserResponse(body.User); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.ReviewedBy != nil {
+		if err2 := ValidateUserResponse(body.ReviewedBy); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateFolderAccessResponse runs the validations defined on
+// FolderAccessResponse
+func ValidateFolderAccessResponse(body *FolderAccessResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.User != nil {
+		if err2 := ValidateUserResponse(body.User); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Role != nil {
+		if err2 := ValidateRoleResponse(body.Role); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
@@ -16,11 +16,46 @@ func ListSecretsSecretsPath() string {
 	return "/secrets"
 }
 
+// BrowseSecretsSecretsPath returns the URL path to the secrets service browse secrets HTTP endpoint.
+func BrowseSecretsSecretsPath() string {
+	return "/secrets/browse"
+}
+
 // GetSecretValueSecretsPath returns the URL path to the secrets service get secret value HTTP endpoint.
 func GetSecretValueSecretsPath(path string) string {
 	return fmt.Sprintf("/secrets/%v/value", path)
 }
 
+// BatchGetSecretValuesSecretsPath returns the URL path to the secrets service batch get secret values HTTP endpoint.
+func BatchGetSecretValuesSecretsPath() string {
+	return "/secrets/values"
+}
+
+// RenderTemplateSecretsPath returns the URL path to the secrets service render template HTTP endpoint.
+func RenderTemplateSecretsPath() string {
+	return "/secrets/render"
+}
+
+// UploadSecretFileSecretsPath returns the URL path to the secrets service upload secret file HTTP endpoint.
+func UploadSecretFileSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/file", path)
+}
+
+// DownloadSecretFileSecretsPath returns the URL path to the secrets service download secret file HTTP endpoint.
+func DownloadSecretFileSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/file", path)
+}
+
+// ExportSecretsSecretsPath returns the URL path to the secrets service export secrets HTTP endpoint.
+func ExportSecretsSecretsPath() string {
+	return "/secrets/export"
+}
+
+// ImportSecretsSecretsPath returns the URL path to the secrets service import secrets HTTP endpoint.
+func ImportSecretsSecretsPath() string {
+	return "/secrets/import"
+}
+
 // GetSecretSecretsPath returns the URL path to the secrets service get secret HTTP endpoint.
 func GetSecretSecretsPath(path string) string {
 	return fmt.Sprintf("/secrets/%v", path)
@@ -35,3 +70,143 @@ func CreateSecretSecretsPath() string {
 func UpdateSecretSecretsPath() string {
 	return "/secrets"
 }
+
+// RegenerateSecretSecretsPath returns the URL path to the secrets service regenerate secret HTTP endpoint.
+func RegenerateSecretSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/regenerate", path)
+}
+
+// SetSecretRotationSecretsPath returns the URL path to the secrets service set secret rotation HTTP endpoint.
+func SetSecretRotationSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/rotation", path)
+}
+
+// ListSecretRotationsSecretsPath returns the URL path to the secrets service list secret rotations HTTP endpoint.
+func ListSecretRotationsSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/rotations", path)
+}
+
+// DeleteSecretSecretsPath returns the URL path to the secrets service delete secret HTTP endpoint.
+func DeleteSecretSecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v", path)
+}
+
+// MoveSecretsSecretsPath returns the URL path to the secrets service move secrets HTTP endpoint.
+func MoveSecretsSecretsPath() string {
+	return "/secrets/move"
+}
+
+// DiffSecretsSecretsPath returns the URL path to the secrets service diff secrets HTTP endpoint.
+func DiffSecretsSecretsPath() string {
+	return "/secrets/diff"
+}
+
+// PromoteSecretsSecretsPath returns the URL path to the secrets service promote secrets HTTP endpoint.
+func PromoteSecretsSecretsPath() string {
+	return "/secrets/promote"
+}
+
+// TransferSecretOwnershipSecretsPath returns the URL path to the secrets service transfer secret ownership HTTP endpoint.
+func TransferSecretOwnershipSecretsPath() string {
+	return "/secrets/ownership/transfer"
+}
+
+// ListStaleSecretsSecretsPath returns the URL path to the secrets service list stale secrets HTTP endpoint.
+func ListStaleSecretsSecretsPath() string {
+	return "/secrets/stale"
+}
+
+// SetSecretCanarySecretsPath returns the URL path to the secrets service set secret canary HTTP endpoint.
+func SetSecretCanarySecretsPath(path string) string {
+	return fmt.Sprintf("/secrets/%v/canary", path)
+}
+
+// ListCanarySecretsSecretsPath returns the URL path to the secrets service list canary secrets HTTP endpoint.
+func ListCanarySecretsSecretsPath() string {
+	return "/secrets/canaries"
+}
+
+// WrapSecretSecretsPath returns the URL path to the secrets service wrap secret HTTP endpoint.
+func WrapSecretSecretsPath() string {
+	return "/secrets/wrap"
+}
+
+// UnwrapSecretSecretsPath returns the URL path to the secrets service unwrap secret HTTP endpoint.
+func UnwrapSecretSecretsPath() string {
+	return "/secrets/unwrap"
+}
+
+// RequestAccessSecretsPath returns the URL path to the secrets service request access HTTP endpoint.
+func RequestAccessSecretsPath() string {
+	return "/secrets/access-requests"
+}
+
+// ListAccessRequestsSecretsPath returns the URL path to the secrets service list access requests HTTP endpoint.
+func ListAccessRequestsSecretsPath() string {
+	return "/secrets/access-requests"
+}
+
+// ApproveAccessRequestSecretsPath returns the URL path to the secrets service approve access request HTTP endpoint.
+func ApproveAccessRequestSecretsPath(id int) string {
+	return fmt.Sprintf("/secrets/access-requests/%v/approve", id)
+}
+
+// DenyAccessRequestSecretsPath returns the URL path to the secrets service deny access request HTTP endpoint.
+func DenyAccessRequestSecretsPath(id int) string {
+	return fmt.Sprintf("/secrets/access-requests/%v/deny", id)
+}
+
+// ListValidationRulesSecretsPath returns the URL path to the secrets service list validation rules HTTP endpoint.
+func ListValidationRulesSecretsPath() string {
+	return "/validation-rules"
+}
+
+// CreateValidationRuleSecretsPath returns the URL path to the secrets service create validation rule HTTP endpoint.
+func CreateValidationRuleSecretsPath() string {
+	return "/validation-rules"
+}
+
+// DeleteValidationRuleSecretsPath returns the URL path to the secrets service delete validation rule HTTP endpoint.
+func DeleteValidationRuleSecretsPath(id int) string {
+	return fmt.Sprintf("/validation-rules/%v", id)
+}
+
+// ListBreakGlassRulesSecretsPath returns the URL path to the secrets service list break glass rules HTTP endpoint.
+func ListBreakGlassRulesSecretsPath() string {
+	return "/break-glass/rules"
+}
+
+// CreateBreakGlassRuleSecretsPath returns the URL path to the secrets service create break glass rule HTTP endpoint.
+func CreateBreakGlassRuleSecretsPath() string {
+	return "/break-glass/rules"
+}
+
+// DeleteBreakGlassRuleSecretsPath returns the URL path to the secrets service delete break glass rule HTTP endpoint.
+func DeleteBreakGlassRuleSecretsPath(id int) string {
+	return fmt.Sprintf("/break-glass/rules/%v", id)
+}
+
+// ListBreakGlassAccessesSecretsPath returns the URL path to the secrets service list break glass accesses HTTP endpoint.
+func ListBreakGlassAccessesSecretsPath() string {
+	return "/break-glass/accesses"
+}
+
+// ReviewBreakGlassAccessSecretsPath returns the URL path to the secrets service review break glass access HTTP endpoint.
+func ReviewBreakGlassAccessSecretsPath(id int) string {
+	return fmt.Sprintf("/break-glass/accesses/%v/review", id)
+}
+
+// ListFolderAccessesSecretsPath returns the URL path to the secrets service list folder accesses HTTP endpoint.
+func ListFolderAccessesSecretsPath() string {
+	return "/folders/access"
+}
+
+// GrantFolderAccessSecretsPath returns the URL path to the secrets service grant folder access HTTP endpoint.
+func GrantFolderAccessSecretsPath() string {
+	return "/folders/access"
+}
+
+// RevokeFolderAccessSecretsPath returns the URL path to the secrets service revoke folder access HTTP endpoint.
+func RevokeFolderAccessSecretsPath(id int) string {
+	return fmt.Sprintf("/folders/access/%v", id)
+}
@@ -8,7 +8,9 @@
 package server
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 
 	secrets "github.com/Vidalee/FishyKeys/gen/secrets"
@@ -19,13 +21,48 @@ import (
 
 // Server lists the secrets service endpoint HTTP handlers.
 type Server struct {
-	Mounts         []*MountPoint
-	ListSecrets    http.Handler
-	GetSecretValue http.Handler
-	GetSecret      http.Handler
-	CreateSecret   http.Handler
-	UpdateSecret   http.Handler
-	CORS           http.Handler
+	Mounts                  []*MountPoint
+	ListSecrets             http.Handler
+	BrowseSecrets           http.Handler
+	GetSecretValue          http.Handler
+	BatchGetSecretValues    http.Handler
+	RenderTemplate          http.Handler
+	UploadSecretFile        http.Handler
+	DownloadSecretFile      http.Handler
+	ExportSecrets           http.Handler
+	ImportSecrets           http.Handler
+	GetSecret               http.Handler
+	CreateSecret            http.Handler
+	UpdateSecret            http.Handler
+	RegenerateSecret        http.Handler
+	SetSecretRotation       http.Handler
+	ListSecretRotations     http.Handler
+	DeleteSecret            http.Handler
+	MoveSecrets             http.Handler
+	DiffSecrets             http.Handler
+	PromoteSecrets          http.Handler
+	TransferSecretOwnership http.Handler
+	ListStaleSecrets        http.Handler
+	SetSecretCanary         http.Handler
+	ListCanarySecrets       http.Handler
+	WrapSecret              http.Handler
+	UnwrapSecret            http.Handler
+	RequestAccess           http.Handler
+	ListAccessRequests      http.Handler
+	ApproveAccessRequest    http.Handler
+	DenyAccessRequest       http.Handler
+	ListValidationRules     http.Handler
+	CreateValidationRule    http.Handler
+	DeleteValidationRule    http.Handler
+	ListBreakGlassRules     http.Handler
+	CreateBreakGlassRule    http.Handler
+	DeleteBreakGlassRule    http.Handler
+	ListBreakGlassAccesses  http.Handler
+	ReviewBreakGlassAccess  http.Handler
+	ListFolderAccesses      http.Handler
+	GrantFolderAccess       http.Handler
+	RevokeFolderAccess      http.Handler
+	CORS                    http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -56,20 +93,119 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"ListSecrets", "GET", "/secrets"},
+			{"BrowseSecrets", "GET", "/secrets/browse"},
 			{"GetSecretValue", "GET", "/secrets/{path}/value"},
+			{"BatchGetSecretValues", "POST", "/secrets/values"},
+			{"RenderTemplate", "POST", "/secrets/render"},
+			{"UploadSecretFile", "PUT", "/secrets/{path}/file"},
+			{"DownloadSecretFile", "GET", "/secrets/{path}/file"},
+			{"ExportSecrets", "GET", "/secrets/export"},
+			{"ImportSecrets", "POST", "/secrets/import"},
 			{"GetSecret", "GET", "/secrets/{path}"},
 			{"CreateSecret", "POST", "/secrets"},
 			{"UpdateSecret", "PATCH", "/secrets"},
+			{"RegenerateSecret", "POST", "/secrets/{path}/regenerate"},
+			{"SetSecretRotation", "PUT", "/secrets/{path}/rotation"},
+			{"ListSecretRotations", "GET", "/secrets/{path}/rotations"},
+			{"DeleteSecret", "DELETE", "/secrets/{path}"},
+			{"MoveSecrets", "POST", "/secrets/move"},
+			{"DiffSecrets", "GET", "/secrets/diff"},
+			{"PromoteSecrets", "POST", "/secrets/promote"},
+			{"TransferSecretOwnership", "POST", "/secrets/ownership/transfer"},
+			{"ListStaleSecrets", "GET", "/secrets/stale"},
+			{"SetSecretCanary", "PUT", "/secrets/{path}/canary"},
+			{"ListCanarySecrets", "GET", "/secrets/canaries"},
+			{"WrapSecret", "POST", "/secrets/wrap"},
+			{"UnwrapSecret", "POST", "/secrets/unwrap"},
+			{"RequestAccess", "POST", "/secrets/access-requests"},
+			{"ListAccessRequests", "GET", "/secrets/access-requests"},
+			{"ApproveAccessRequest", "POST", "/secrets/access-requests/{id}/approve"},
+			{"DenyAccessRequest", "POST", "/secrets/access-requests/{id}/deny"},
+			{"ListValidationRules", "GET", "/validation-rules"},
+			{"CreateValidationRule", "POST", "/validation-rules"},
+			{"DeleteValidationRule", "DELETE", "/validation-rules/{id}"},
+			{"ListBreakGlassRules", "GET", "/break-glass/rules"},
+			{"CreateBreakGlassRule", "POST", "/break-glass/rules"},
+			{"DeleteBreakGlassRule", "DELETE", "/break-glass/rules/{id}"},
+			{"ListBreakGlassAccesses", "GET", "/break-glass/accesses"},
+			{"ReviewBreakGlassAccess", "POST", "/break-glass/accesses/{id}/review"},
+			{"ListFolderAccesses", "GET", "/folders/access"},
+			{"GrantFolderAccess", "POST", "/folders/access"},
+			{"RevokeFolderAccess", "DELETE", "/folders/access/{id}"},
 			{"CORS", "OPTIONS", "/secrets"},
+			{"CORS", "OPTIONS", "/secrets/browse"},
 			{"CORS", "OPTIONS", "/secrets/{path}/value"},
+			{"CORS", "OPTIONS", "/secrets/values"},
+			{"CORS", "OPTIONS", "/secrets/render"},
+			{"CORS", "OPTIONS", "/secrets/{path}/file"},
+			{"CORS", "OPTIONS", "/secrets/export"},
+			{"CORS", "OPTIONS", "/secrets/import"},
 			{"CORS", "OPTIONS", "/secrets/{path}"},
+			{"CORS", "OPTIONS", "/secrets/{path}/regenerate"},
+			{"CORS", "OPTIONS", "/secrets/{path}/rotation"},
+			{"CORS", "OPTIONS", "/secrets/{path}/rotations"},
+			{"CORS", "OPTIONS", "/secrets/move"},
+			{"CORS", "OPTIONS", "/secrets/diff"},
+			{"CORS", "OPTIONS", "/secrets/promote"},
+			{"CORS", "OPTIONS", "/secrets/ownership/transfer"},
+			{"CORS", "OPTIONS", "/secrets/stale"},
+			{"CORS", "OPTIONS", "/secrets/{path}/canary"},
+			{"CORS", "OPTIONS", "/secrets/canaries"},
+			{"CORS", "OPTIONS", "/secrets/wrap"},
+			{"CORS", "OPTIONS", "/secrets/unwrap"},
+			{"CORS", "OPTIONS", "/secrets/access-requests"},
+			{"CORS", "OPTIONS", "/secrets/access-requests/{id}/approve"},
+			{"CORS", "OPTIONS", "/secrets/access-requests/{id}/deny"},
+			{"CORS", "OPTIONS", "/validation-rules"},
+			{"CORS", "OPTIONS", "/validation-rules/{id}"},
+			{"CORS", "OPTIONS", "/break-glass/rules"},
+			{"CORS", "OPTIONS", "/break-glass/rules/{id}"},
+			{"CORS", "OPTIONS", "/break-glass/accesses"},
+			{"CORS", "OPTIONS", "/break-glass/accesses/{id}/review"},
+			{"CORS", "OPTIONS", "/folders/access"},
+			{"CORS", "OPTIONS", "/folders/access/{id}"},
 		},
-		ListSecrets:    NewListSecretsHandler(e.ListSecrets, mux, decoder, encoder, errhandler, formatter),
-		GetSecretValue: NewGetSecretValueHandler(e.GetSecretValue, mux, decoder, encoder, errhandler, formatter),
-		GetSecret:      NewGetSecretHandler(e.GetSecret, mux, decoder, encoder, errhandler, formatter),
-		CreateSecret:   NewCreateSecretHandler(e.CreateSecret, mux, decoder, encoder, errhandler, formatter),
-		UpdateSecret:   NewUpdateSecretHandler(e.UpdateSecret, mux, decoder, encoder, errhandler, formatter),
-		CORS:           NewCORSHandler(),
+		ListSecrets:             NewListSecretsHandler(e.ListSecrets, mux, decoder, encoder, errhandler, formatter),
+		BrowseSecrets:           NewBrowseSecretsHandler(e.BrowseSecrets, mux, decoder, encoder, errhandler, formatter),
+		GetSecretValue:          NewGetSecretValueHandler(e.GetSecretValue, mux, decoder, encoder, errhandler, formatter),
+		BatchGetSecretValues:    NewBatchGetSecretValuesHandler(e.BatchGetSecretValues, mux, decoder, encoder, errhandler, formatter),
+		RenderTemplate:          NewRenderTemplateHandler(e.RenderTemplate, mux, decoder, encoder, errhandler, formatter),
+		UploadSecretFile:        NewUploadSecretFileHandler(e.UploadSecretFile, mux, decoder, encoder, errhandler, formatter),
+		DownloadSecretFile:      NewDownloadSecretFileHandler(e.DownloadSecretFile, mux, decoder, encoder, errhandler, formatter),
+		ExportSecrets:           NewExportSecretsHandler(e.ExportSecrets, mux, decoder, encoder, errhandler, formatter),
+		ImportSecrets:           NewImportSecretsHandler(e.ImportSecrets, mux, decoder, encoder, errhandler, formatter),
+		GetSecret:               NewGetSecretHandler(e.GetSecret, mux, decoder, encoder, errhandler, formatter),
+		CreateSecret:            NewCreateSecretHandler(e.CreateSecret, mux, decoder, encoder, errhandler, formatter),
+		UpdateSecret:            NewUpdateSecretHandler(e.UpdateSecret, mux, decoder, encoder, errhandler, formatter),
+		RegenerateSecret:        NewRegenerateSecretHandler(e.RegenerateSecret, mux, decoder, encoder, errhandler, formatter),
+		SetSecretRotation:       NewSetSecretRotationHandler(e.SetSecretRotation, mux, decoder, encoder, errhandler, formatter),
+		ListSecretRotations:     NewListSecretRotationsHandler(e.ListSecretRotations, mux, decoder, encoder, errhandler, formatter),
+		DeleteSecret:            NewDeleteSecretHandler(e.DeleteSecret, mux, decoder, encoder, errhandler, formatter),
+		MoveSecrets:             NewMoveSecretsHandler(e.MoveSecrets, mux, decoder, encoder, errhandler, formatter),
+		DiffSecrets:             NewDiffSecretsHandler(e.DiffSecrets, mux, decoder, encoder, errhandler, formatter),
+		PromoteSecrets:          NewPromoteSecretsHandler(e.PromoteSecrets, mux, decoder, encoder, errhandler, formatter),
+		TransferSecretOwnership: NewTransferSecretOwnershipHandler(e.TransferSecretOwnership, mux, decoder, encoder, errhandler, formatter),
+		ListStaleSecrets:        NewListStaleSecretsHandler(e.ListStaleSecrets, mux, decoder, encoder, errhandler, formatter),
+		SetSecretCanary:         NewSetSecretCanaryHandler(e.SetSecretCanary, mux, decoder, encoder, errhandler, formatter),
+		ListCanarySecrets:       NewListCanarySecretsHandler(e.ListCanarySecrets, mux, decoder, encoder, errhandler, formatter),
+		WrapSecret:              NewWrapSecretHandler(e.WrapSecret, mux, decoder, encoder, errhandler, formatter),
+		UnwrapSecret:            NewUnwrapSecretHandler(e.UnwrapSecret, mux, decoder, encoder, errhandler, formatter),
+		RequestAccess:           NewRequestAccessHandler(e.RequestAccess, mux, decoder, encoder, errhandler, formatter),
+		ListAccessRequests:      NewListAccessRequestsHandler(e.ListAccessRequests, mux, decoder, encoder, errhandler, formatter),
+		ApproveAccessRequest:    NewApproveAccessRequestHandler(e.ApproveAccessRequest, mux, decoder, encoder, errhandler, formatter),
+		DenyAccessRequest:       NewDenyAccessRequestHandler(e.DenyAccessRequest, mux, decoder, encoder, errhandler, formatter),
+		ListValidationRules:     NewListValidationRulesHandler(e.ListValidationRules, mux, decoder, encoder, errhandler, formatter),
+		CreateValidationRule:    NewCreateValidationRuleHandler(e.CreateValidationRule, mux, decoder, encoder, errhandler, formatter),
+		DeleteValidationRule:    NewDeleteValidationRuleHandler(e.DeleteValidationRule, mux, decoder, encoder, errhandler, formatter),
+		ListBreakGlassRules:     NewListBreakGlassRulesHandler(e.ListBreakGlassRules, mux, decoder, encoder, errhandler, formatter),
+		CreateBreakGlassRule:    NewCreateBreakGlassRuleHandler(e.CreateBreakGlassRule, mux, decoder, encoder, errhandler, formatter),
+		DeleteBreakGlassRule:    NewDeleteBreakGlassRuleHandler(e.DeleteBreakGlassRule, mux, decoder, encoder, errhandler, formatter),
+		ListBreakGlassAccesses:  NewListBreakGlassAccessesHandler(e.ListBreakGlassAccesses, mux, decoder, encoder, errhandler, formatter),
+		ReviewBreakGlassAccess:  NewReviewBreakGlassAccessHandler(e.ReviewBreakGlassAccess, mux, decoder, encoder, errhandler, formatter),
+		ListFolderAccesses:      NewListFolderAccessesHandler(e.ListFolderAccesses, mux, decoder, encoder, errhandler, formatter),
+		GrantFolderAccess:       NewGrantFolderAccessHandler(e.GrantFolderAccess, mux, decoder, encoder, errhandler, formatter),
+		RevokeFolderAccess:      NewRevokeFolderAccessHandler(e.RevokeFolderAccess, mux, decoder, encoder, errhandler, formatter),
+		CORS:                    NewCORSHandler(),
 	}
 }
 
@@ -79,10 +215,45 @@ func (s *Server) Service() string { return "secrets" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.ListSecrets = m(s.ListSecrets)
+	s.BrowseSecrets = m(s.BrowseSecrets)
 	s.GetSecretValue = m(s.GetSecretValue)
+	s.BatchGetSecretValues = m(s.BatchGetSecretValues)
+	s.RenderTemplate = m(s.RenderTemplate)
+	s.UploadSecretFile = m(s.UploadSecretFile)
+	s.DownloadSecretFile = m(s.DownloadSecretFile)
+	s.ExportSecrets = m(s.ExportSecrets)
+	s.ImportSecrets = m(s.ImportSecrets)
 	s.GetSecret = m(s.GetSecret)
 	s.CreateSecret = m(s.CreateSecret)
 	s.UpdateSecret = m(s.UpdateSecret)
+	s.RegenerateSecret = m(s.RegenerateSecret)
+	s.SetSecretRotation = m(s.SetSecretRotation)
+	s.ListSecretRotations = m(s.ListSecretRotations)
+	s.DeleteSecret = m(s.DeleteSecret)
+	s.MoveSecrets = m(s.MoveSecrets)
+	s.DiffSecrets = m(s.DiffSecrets)
+	s.PromoteSecrets = m(s.PromoteSecrets)
+	s.TransferSecretOwnership = m(s.TransferSecretOwnership)
+	s.ListStaleSecrets = m(s.ListStaleSecrets)
+	s.SetSecretCanary = m(s.SetSecretCanary)
+	s.ListCanarySecrets = m(s.ListCanarySecrets)
+	s.WrapSecret = m(s.WrapSecret)
+	s.UnwrapSecret = m(s.UnwrapSecret)
+	s.RequestAccess = m(s.RequestAccess)
+	s.ListAccessRequests = m(s.ListAccessRequests)
+	s.ApproveAccessRequest = m(s.ApproveAccessRequest)
+	s.DenyAccessRequest = m(s.DenyAccessRequest)
+	s.ListValidationRules = m(s.ListValidationRules)
+	s.CreateValidationRule = m(s.CreateValidationRule)
+	s.DeleteValidationRule = m(s.DeleteValidationRule)
+	s.ListBreakGlassRules = m(s.ListBreakGlassRules)
+	s.CreateBreakGlassRule = m(s.CreateBreakGlassRule)
+	s.DeleteBreakGlassRule = m(s.DeleteBreakGlassRule)
+	s.ListBreakGlassAccesses = m(s.ListBreakGlassAccesses)
+	s.ReviewBreakGlassAccess = m(s.ReviewBreakGlassAccess)
+	s.ListFolderAccesses = m(s.ListFolderAccesses)
+	s.GrantFolderAccess = m(s.GrantFolderAccess)
+	s.RevokeFolderAccess = m(s.RevokeFolderAccess)
 	s.CORS = m(s.CORS)
 }
 
@@ -92,10 +263,45 @@ func (s *Server) MethodNames() []string { return secrets.MethodNames[:] }
 // Mount configures the mux to serve the secrets endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountListSecretsHandler(mux, h.ListSecrets)
+	MountBrowseSecretsHandler(mux, h.BrowseSecrets)
 	MountGetSecretValueHandler(mux, h.GetSecretValue)
+	MountBatchGetSecretValuesHandler(mux, h.BatchGetSecretValues)
+	MountRenderTemplateHandler(mux, h.RenderTemplate)
+	MountUploadSecretFileHandler(mux, h.UploadSecretFile)
+	MountDownloadSecretFileHandler(mux, h.DownloadSecretFile)
+	MountExportSecretsHandler(mux, h.ExportSecrets)
+	MountImportSecretsHandler(mux, h.ImportSecrets)
 	MountGetSecretHandler(mux, h.GetSecret)
 	MountCreateSecretHandler(mux, h.CreateSecret)
 	MountUpdateSecretHandler(mux, h.UpdateSecret)
+	MountRegenerateSecretHandler(mux, h.RegenerateSecret)
+	MountSetSecretRotationHandler(mux, h.SetSecretRotation)
+	MountListSecretRotationsHandler(mux, h.ListSecretRotations)
+	MountDeleteSecretHandler(mux, h.DeleteSecret)
+	MountMoveSecretsHandler(mux, h.MoveSecrets)
+	MountDiffSecretsHandler(mux, h.DiffSecrets)
+	MountPromoteSecretsHandler(mux, h.PromoteSecrets)
+	MountTransferSecretOwnershipHandler(mux, h.TransferSecretOwnership)
+	MountListStaleSecretsHandler(mux, h.ListStaleSecrets)
+	MountSetSecretCanaryHandler(mux, h.SetSecretCanary)
+	MountListCanarySecretsHandler(mux, h.ListCanarySecrets)
+	MountWrapSecretHandler(mux, h.WrapSecret)
+	MountUnwrapSecretHandler(mux, h.UnwrapSecret)
+	MountRequestAccessHandler(mux, h.RequestAccess)
+	MountListAccessRequestsHandler(mux, h.ListAccessRequests)
+	MountApproveAccessRequestHandler(mux, h.ApproveAccessRequest)
+	MountDenyAccessRequestHandler(mux, h.DenyAccessRequest)
+	MountListValidationRulesHandler(mux, h.ListValidationRules)
+	MountCreateValidationRuleHandler(mux, h.CreateValidationRule)
+	MountDeleteValidationRuleHandler(mux, h.DeleteValidationRule)
+	MountListBreakGlassRulesHandler(mux, h.ListBreakGlassRules)
+	MountCreateBreakGlassRuleHandler(mux, h.CreateBreakGlassRule)
+	MountDeleteBreakGlassRuleHandler(mux, h.DeleteBreakGlassRule)
+	MountListBreakGlassAccessesHandler(mux, h.ListBreakGlassAccesses)
+	MountReviewBreakGlassAccessHandler(mux, h.ReviewBreakGlassAccess)
+	MountListFolderAccessesHandler(mux, h.ListFolderAccesses)
+	MountGrantFolderAccessHandler(mux, h.GrantFolderAccess)
+	MountRevokeFolderAccessHandler(mux, h.RevokeFolderAccess)
 	MountCORSHandler(mux, h.CORS)
 }
 
@@ -148,6 +354,57 @@ func NewListSecretsHandler(
 	})
 }
 
+// MountBrowseSecretsHandler configures the mux to serve the "secrets" service
+// "browse secrets" endpoint.
+func MountBrowseSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/browse", f)
+}
+
+// NewBrowseSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "browse secrets" endpoint.
+func NewBrowseSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBrowseSecretsRequest(mux, decoder)
+		encodeResponse = EncodeBrowseSecretsResponse(encoder)
+		encodeError    = EncodeBrowseSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "browse secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountGetSecretValueHandler configures the mux to serve the "secrets" service
 // "get secret value" endpoint.
 func MountGetSecretValueHandler(mux goahttp.Muxer, h http.Handler) {
@@ -199,21 +456,21 @@ func NewGetSecretValueHandler(
 	})
 }
 
-// MountGetSecretHandler configures the mux to serve the "secrets" service "get
-// secret" endpoint.
-func MountGetSecretHandler(mux goahttp.Muxer, h http.Handler) {
+// MountBatchGetSecretValuesHandler configures the mux to serve the "secrets"
+// service "batch get secret values" endpoint.
+func MountBatchGetSecretValuesHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/secrets/{path}", f)
+	mux.Handle("POST", "/secrets/values", f)
 }
 
-// NewGetSecretHandler creates a HTTP handler which loads the HTTP request and
-// calls the "secrets" service "get secret" endpoint.
-func NewGetSecretHandler(
+// NewBatchGetSecretValuesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "batch get secret values" endpoint.
+func NewBatchGetSecretValuesHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -222,13 +479,13 @@ func NewGetSecretHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetSecretRequest(mux, decoder)
-		encodeResponse = EncodeGetSecretResponse(encoder)
-		encodeError    = EncodeGetSecretError(encoder, formatter)
+		decodeRequest  = DecodeBatchGetSecretValuesRequest(mux, decoder)
+		encodeResponse = EncodeBatchGetSecretValuesResponse(encoder)
+		encodeError    = EncodeBatchGetSecretValuesError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "get secret")
+		ctx = context.WithValue(ctx, goa.MethodKey, "batch get secret values")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -250,21 +507,21 @@ func NewGetSecretHandler(
 	})
 }
 
-// MountCreateSecretHandler configures the mux to serve the "secrets" service
-// "create secret" endpoint.
-func MountCreateSecretHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRenderTemplateHandler configures the mux to serve the "secrets" service
+// "render template" endpoint.
+func MountRenderTemplateHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/secrets", f)
+	mux.Handle("POST", "/secrets/render", f)
 }
 
-// NewCreateSecretHandler creates a HTTP handler which loads the HTTP request
-// and calls the "secrets" service "create secret" endpoint.
-func NewCreateSecretHandler(
+// NewRenderTemplateHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "render template" endpoint.
+func NewRenderTemplateHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -273,13 +530,13 @@ func NewCreateSecretHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCreateSecretRequest(mux, decoder)
-		encodeResponse = EncodeCreateSecretResponse(encoder)
-		encodeError    = EncodeCreateSecretError(encoder, formatter)
+		decodeRequest  = DecodeRenderTemplateRequest(mux, decoder)
+		encodeResponse = EncodeRenderTemplateResponse(encoder)
+		encodeError    = EncodeRenderTemplateError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "create secret")
+		ctx = context.WithValue(ctx, goa.MethodKey, "render template")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -301,21 +558,21 @@ func NewCreateSecretHandler(
 	})
 }
 
-// MountUpdateSecretHandler configures the mux to serve the "secrets" service
-// "update secret" endpoint.
-func MountUpdateSecretHandler(mux goahttp.Muxer, h http.Handler) {
+// MountUploadSecretFileHandler configures the mux to serve the "secrets"
+// service "upload secret file" endpoint.
+func MountUploadSecretFileHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("PATCH", "/secrets", f)
+	mux.Handle("PUT", "/secrets/{path}/file", f)
 }
 
-// NewUpdateSecretHandler creates a HTTP handler which loads the HTTP request
-// and calls the "secrets" service "update secret" endpoint.
-func NewUpdateSecretHandler(
+// NewUploadSecretFileHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "upload secret file" endpoint.
+func NewUploadSecretFileHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -324,13 +581,1796 @@ func NewUpdateSecretHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeUpdateSecretRequest(mux, decoder)
-		encodeResponse = EncodeUpdateSecretResponse(encoder)
-		encodeError    = EncodeUpdateSecretError(encoder, formatter)
+		decodeRequest  = DecodeUploadSecretFileRequest(mux, decoder)
+		encodeResponse = EncodeUploadSecretFileResponse(encoder)
+		encodeError    = EncodeUploadSecretFileError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "update secret")
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload secret file")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &secrets.UploadSecretFileRequestData{Payload: payload.(*secrets.UploadSecretFilePayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDownloadSecretFileHandler configures the mux to serve the "secrets"
+// service "download secret file" endpoint.
+func MountDownloadSecretFileHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/{path}/file", f)
+}
+
+// NewDownloadSecretFileHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "download secret file" endpoint.
+func NewDownloadSecretFileHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadSecretFileRequest(mux, decoder)
+		encodeResponse = EncodeDownloadSecretFileResponse(encoder)
+		encodeError    = EncodeDownloadSecretFileError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download secret file")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*secrets.DownloadSecretFileResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				errhandler(ctx, w, err)
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					if f, ok := w.(http.Flusher); ok {
+						f.Flush()
+					}
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			errhandler(ctx, w, err)
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountExportSecretsHandler configures the mux to serve the "secrets" service
+// "export secrets" endpoint.
+func MountExportSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/export", f)
+}
+
+// NewExportSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "export secrets" endpoint.
+func NewExportSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportSecretsRequest(mux, decoder)
+		encodeResponse = EncodeExportSecretsResponse(encoder)
+		encodeError    = EncodeExportSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "export secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*secrets.ExportSecretsResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				errhandler(ctx, w, err)
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					if f, ok := w.(http.Flusher); ok {
+						f.Flush()
+					}
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			errhandler(ctx, w, err)
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountImportSecretsHandler configures the mux to serve the "secrets" service
+// "import secrets" endpoint.
+func MountImportSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/import", f)
+}
+
+// NewImportSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "import secrets" endpoint.
+func NewImportSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportSecretsRequest(mux, decoder)
+		encodeResponse = EncodeImportSecretsResponse(encoder)
+		encodeError    = EncodeImportSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "import secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &secrets.ImportSecretsRequestData{Payload: payload.(*secrets.ImportSecretsPayload), Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetSecretHandler configures the mux to serve the "secrets" service "get
+// secret" endpoint.
+func MountGetSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/{path}", f)
+}
+
+// NewGetSecretHandler creates a HTTP handler which loads the HTTP request and
+// calls the "secrets" service "get secret" endpoint.
+func NewGetSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetSecretRequest(mux, decoder)
+		encodeResponse = EncodeGetSecretResponse(encoder)
+		encodeError    = EncodeGetSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "get secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateSecretHandler configures the mux to serve the "secrets" service
+// "create secret" endpoint.
+func MountCreateSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets", f)
+}
+
+// NewCreateSecretHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "create secret" endpoint.
+func NewCreateSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateSecretRequest(mux, decoder)
+		encodeResponse = EncodeCreateSecretResponse(encoder)
+		encodeError    = EncodeCreateSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateSecretHandler configures the mux to serve the "secrets" service
+// "update secret" endpoint.
+func MountUpdateSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/secrets", f)
+}
+
+// NewUpdateSecretHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "update secret" endpoint.
+func NewUpdateSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateSecretRequest(mux, decoder)
+		encodeResponse = EncodeUpdateSecretResponse(encoder)
+		encodeError    = EncodeUpdateSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRegenerateSecretHandler configures the mux to serve the "secrets"
+// service "regenerate secret" endpoint.
+func MountRegenerateSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/{path}/regenerate", f)
+}
+
+// NewRegenerateSecretHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "regenerate secret" endpoint.
+func NewRegenerateSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRegenerateSecretRequest(mux, decoder)
+		encodeResponse = EncodeRegenerateSecretResponse(encoder)
+		encodeError    = EncodeRegenerateSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "regenerate secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSetSecretRotationHandler configures the mux to serve the "secrets"
+// service "set secret rotation" endpoint.
+func MountSetSecretRotationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/secrets/{path}/rotation", f)
+}
+
+// NewSetSecretRotationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "set secret rotation" endpoint.
+func NewSetSecretRotationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetSecretRotationRequest(mux, decoder)
+		encodeResponse = EncodeSetSecretRotationResponse(encoder)
+		encodeError    = EncodeSetSecretRotationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set secret rotation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListSecretRotationsHandler configures the mux to serve the "secrets"
+// service "list secret rotations" endpoint.
+func MountListSecretRotationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/{path}/rotations", f)
+}
+
+// NewListSecretRotationsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list secret rotations" endpoint.
+func NewListSecretRotationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListSecretRotationsRequest(mux, decoder)
+		encodeResponse = EncodeListSecretRotationsResponse(encoder)
+		encodeError    = EncodeListSecretRotationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list secret rotations")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteSecretHandler configures the mux to serve the "secrets" service
+// "delete secret" endpoint.
+func MountDeleteSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/secrets/{path}", f)
+}
+
+// NewDeleteSecretHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "delete secret" endpoint.
+func NewDeleteSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteSecretRequest(mux, decoder)
+		encodeResponse = EncodeDeleteSecretResponse(encoder)
+		encodeError    = EncodeDeleteSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountMoveSecretsHandler configures the mux to serve the "secrets" service
+// "move secrets" endpoint.
+func MountMoveSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/move", f)
+}
+
+// NewMoveSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "move secrets" endpoint.
+func NewMoveSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeMoveSecretsRequest(mux, decoder)
+		encodeResponse = EncodeMoveSecretsResponse(encoder)
+		encodeError    = EncodeMoveSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "move secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDiffSecretsHandler configures the mux to serve the "secrets" service
+// "diff secrets" endpoint.
+func MountDiffSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/diff", f)
+}
+
+// NewDiffSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "diff secrets" endpoint.
+func NewDiffSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDiffSecretsRequest(mux, decoder)
+		encodeResponse = EncodeDiffSecretsResponse(encoder)
+		encodeError    = EncodeDiffSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "diff secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountPromoteSecretsHandler configures the mux to serve the "secrets" service
+// "promote secrets" endpoint.
+func MountPromoteSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/promote", f)
+}
+
+// NewPromoteSecretsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "promote secrets" endpoint.
+func NewPromoteSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePromoteSecretsRequest(mux, decoder)
+		encodeResponse = EncodePromoteSecretsResponse(encoder)
+		encodeError    = EncodePromoteSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "promote secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountTransferSecretOwnershipHandler configures the mux to serve the
+// "secrets" service "transfer secret ownership" endpoint.
+func MountTransferSecretOwnershipHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/ownership/transfer", f)
+}
+
+// NewTransferSecretOwnershipHandler creates a HTTP handler which loads the
+// HTTP request and calls the "secrets" service "transfer secret ownership"
+// endpoint.
+func NewTransferSecretOwnershipHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeTransferSecretOwnershipRequest(mux, decoder)
+		encodeResponse = EncodeTransferSecretOwnershipResponse(encoder)
+		encodeError    = EncodeTransferSecretOwnershipError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "transfer secret ownership")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListStaleSecretsHandler configures the mux to serve the "secrets"
+// service "list stale secrets" endpoint.
+func MountListStaleSecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/stale", f)
+}
+
+// NewListStaleSecretsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list stale secrets" endpoint.
+func NewListStaleSecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListStaleSecretsRequest(mux, decoder)
+		encodeResponse = EncodeListStaleSecretsResponse(encoder)
+		encodeError    = EncodeListStaleSecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list stale secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSetSecretCanaryHandler configures the mux to serve the "secrets"
+// service "set secret canary" endpoint.
+func MountSetSecretCanaryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/secrets/{path}/canary", f)
+}
+
+// NewSetSecretCanaryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "set secret canary" endpoint.
+func NewSetSecretCanaryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetSecretCanaryRequest(mux, decoder)
+		encodeResponse = EncodeSetSecretCanaryResponse(encoder)
+		encodeError    = EncodeSetSecretCanaryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set secret canary")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListCanarySecretsHandler configures the mux to serve the "secrets"
+// service "list canary secrets" endpoint.
+func MountListCanarySecretsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/canaries", f)
+}
+
+// NewListCanarySecretsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list canary secrets" endpoint.
+func NewListCanarySecretsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListCanarySecretsResponse(encoder)
+		encodeError    = EncodeListCanarySecretsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list canary secrets")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountWrapSecretHandler configures the mux to serve the "secrets" service
+// "wrap secret" endpoint.
+func MountWrapSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/wrap", f)
+}
+
+// NewWrapSecretHandler creates a HTTP handler which loads the HTTP request and
+// calls the "secrets" service "wrap secret" endpoint.
+func NewWrapSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeWrapSecretRequest(mux, decoder)
+		encodeResponse = EncodeWrapSecretResponse(encoder)
+		encodeError    = EncodeWrapSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "wrap secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUnwrapSecretHandler configures the mux to serve the "secrets" service
+// "unwrap secret" endpoint.
+func MountUnwrapSecretHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/unwrap", f)
+}
+
+// NewUnwrapSecretHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "unwrap secret" endpoint.
+func NewUnwrapSecretHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnwrapSecretRequest(mux, decoder)
+		encodeResponse = EncodeUnwrapSecretResponse(encoder)
+		encodeError    = EncodeUnwrapSecretError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unwrap secret")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRequestAccessHandler configures the mux to serve the "secrets" service
+// "request access" endpoint.
+func MountRequestAccessHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/access-requests", f)
+}
+
+// NewRequestAccessHandler creates a HTTP handler which loads the HTTP request
+// and calls the "secrets" service "request access" endpoint.
+func NewRequestAccessHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRequestAccessRequest(mux, decoder)
+		encodeResponse = EncodeRequestAccessResponse(encoder)
+		encodeError    = EncodeRequestAccessError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "request access")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListAccessRequestsHandler configures the mux to serve the "secrets"
+// service "list access requests" endpoint.
+func MountListAccessRequestsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/secrets/access-requests", f)
+}
+
+// NewListAccessRequestsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list access requests" endpoint.
+func NewListAccessRequestsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAccessRequestsRequest(mux, decoder)
+		encodeResponse = EncodeListAccessRequestsResponse(encoder)
+		encodeError    = EncodeListAccessRequestsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list access requests")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountApproveAccessRequestHandler configures the mux to serve the "secrets"
+// service "approve access request" endpoint.
+func MountApproveAccessRequestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/access-requests/{id}/approve", f)
+}
+
+// NewApproveAccessRequestHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "approve access request" endpoint.
+func NewApproveAccessRequestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeApproveAccessRequestRequest(mux, decoder)
+		encodeResponse = EncodeApproveAccessRequestResponse(encoder)
+		encodeError    = EncodeApproveAccessRequestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "approve access request")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDenyAccessRequestHandler configures the mux to serve the "secrets"
+// service "deny access request" endpoint.
+func MountDenyAccessRequestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/secrets/access-requests/{id}/deny", f)
+}
+
+// NewDenyAccessRequestHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "deny access request" endpoint.
+func NewDenyAccessRequestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDenyAccessRequestRequest(mux, decoder)
+		encodeResponse = EncodeDenyAccessRequestResponse(encoder)
+		encodeError    = EncodeDenyAccessRequestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "deny access request")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListValidationRulesHandler configures the mux to serve the "secrets"
+// service "list validation rules" endpoint.
+func MountListValidationRulesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/validation-rules", f)
+}
+
+// NewListValidationRulesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list validation rules" endpoint.
+func NewListValidationRulesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListValidationRulesResponse(encoder)
+		encodeError    = EncodeListValidationRulesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list validation rules")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateValidationRuleHandler configures the mux to serve the "secrets"
+// service "create validation rule" endpoint.
+func MountCreateValidationRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/validation-rules", f)
+}
+
+// NewCreateValidationRuleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "create validation rule" endpoint.
+func NewCreateValidationRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateValidationRuleRequest(mux, decoder)
+		encodeResponse = EncodeCreateValidationRuleResponse(encoder)
+		encodeError    = EncodeCreateValidationRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create validation rule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteValidationRuleHandler configures the mux to serve the "secrets"
+// service "delete validation rule" endpoint.
+func MountDeleteValidationRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/validation-rules/{id}", f)
+}
+
+// NewDeleteValidationRuleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "delete validation rule" endpoint.
+func NewDeleteValidationRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteValidationRuleRequest(mux, decoder)
+		encodeResponse = EncodeDeleteValidationRuleResponse(encoder)
+		encodeError    = EncodeDeleteValidationRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete validation rule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListBreakGlassRulesHandler configures the mux to serve the "secrets"
+// service "list break glass rules" endpoint.
+func MountListBreakGlassRulesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/break-glass/rules", f)
+}
+
+// NewListBreakGlassRulesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list break glass rules" endpoint.
+func NewListBreakGlassRulesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListBreakGlassRulesResponse(encoder)
+		encodeError    = EncodeListBreakGlassRulesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list break glass rules")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateBreakGlassRuleHandler configures the mux to serve the "secrets"
+// service "create break glass rule" endpoint.
+func MountCreateBreakGlassRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/break-glass/rules", f)
+}
+
+// NewCreateBreakGlassRuleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "create break glass rule" endpoint.
+func NewCreateBreakGlassRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateBreakGlassRuleRequest(mux, decoder)
+		encodeResponse = EncodeCreateBreakGlassRuleResponse(encoder)
+		encodeError    = EncodeCreateBreakGlassRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create break glass rule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteBreakGlassRuleHandler configures the mux to serve the "secrets"
+// service "delete break glass rule" endpoint.
+func MountDeleteBreakGlassRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/break-glass/rules/{id}", f)
+}
+
+// NewDeleteBreakGlassRuleHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "delete break glass rule" endpoint.
+func NewDeleteBreakGlassRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteBreakGlassRuleRequest(mux, decoder)
+		encodeResponse = EncodeDeleteBreakGlassRuleResponse(encoder)
+		encodeError    = EncodeDeleteBreakGlassRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete break glass rule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListBreakGlassAccessesHandler configures the mux to serve the "secrets"
+// service "list break glass accesses" endpoint.
+func MountListBreakGlassAccessesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/break-glass/accesses", f)
+}
+
+// NewListBreakGlassAccessesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list break glass accesses" endpoint.
+func NewListBreakGlassAccessesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListBreakGlassAccessesRequest(mux, decoder)
+		encodeResponse = EncodeListBreakGlassAccessesResponse(encoder)
+		encodeError    = EncodeListBreakGlassAccessesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list break glass accesses")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountReviewBreakGlassAccessHandler configures the mux to serve the "secrets"
+// service "review break glass access" endpoint.
+func MountReviewBreakGlassAccessHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/break-glass/accesses/{id}/review", f)
+}
+
+// NewReviewBreakGlassAccessHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "review break glass access" endpoint.
+func NewReviewBreakGlassAccessHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReviewBreakGlassAccessRequest(mux, decoder)
+		encodeResponse = EncodeReviewBreakGlassAccessResponse(encoder)
+		encodeError    = EncodeReviewBreakGlassAccessError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "review break glass access")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListFolderAccessesHandler configures the mux to serve the "secrets"
+// service "list folder accesses" endpoint.
+func MountListFolderAccessesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/folders/access", f)
+}
+
+// NewListFolderAccessesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "list folder accesses" endpoint.
+func NewListFolderAccessesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListFolderAccessesResponse(encoder)
+		encodeError    = EncodeListFolderAccessesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list folder accesses")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGrantFolderAccessHandler configures the mux to serve the "secrets"
+// service "grant folder access" endpoint.
+func MountGrantFolderAccessHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/folders/access", f)
+}
+
+// NewGrantFolderAccessHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "grant folder access" endpoint.
+func NewGrantFolderAccessHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGrantFolderAccessRequest(mux, decoder)
+		encodeResponse = EncodeGrantFolderAccessResponse(encoder)
+		encodeError    = EncodeGrantFolderAccessError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "grant folder access")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRevokeFolderAccessHandler configures the mux to serve the "secrets"
+// service "revoke folder access" endpoint.
+func MountRevokeFolderAccessHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleSecretsOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/folders/access/{id}", f)
+}
+
+// NewRevokeFolderAccessHandler creates a HTTP handler which loads the HTTP
+// request and calls the "secrets" service "revoke folder access" endpoint.
+func NewRevokeFolderAccessHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeFolderAccessRequest(mux, decoder)
+		encodeResponse = EncodeRevokeFolderAccessResponse(encoder)
+		encodeError    = EncodeRevokeFolderAccessError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke folder access")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "secrets")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -357,8 +2397,37 @@ func NewUpdateSecretHandler(
 func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
 	h = HandleSecretsOrigin(h)
 	mux.Handle("OPTIONS", "/secrets", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/browse", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/secrets/{path}/value", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/values", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/render", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/{path}/file", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/export", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/import", h.ServeHTTP)
 	mux.Handle("OPTIONS", "/secrets/{path}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/{path}/regenerate", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/{path}/rotation", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/{path}/rotations", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/move", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/diff", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/promote", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/ownership/transfer", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/stale", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/{path}/canary", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/canaries", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/wrap", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/unwrap", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/access-requests", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/access-requests/{id}/approve", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/secrets/access-requests/{id}/deny", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/validation-rules", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/validation-rules/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/break-glass/rules", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/break-glass/rules/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/break-glass/accesses", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/break-glass/accesses/{id}/review", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/folders/access", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/folders/access/{id}", h.ServeHTTP)
 }
 
 // NewCORSHandler creates a HTTP handler which returns a simple 204 response.
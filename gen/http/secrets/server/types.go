@@ -14,6 +14,22 @@ import (
 	goa "goa.design/goa/v3/pkg"
 )
 
+// BatchGetSecretValuesRequestBody is the type of the "secrets" service "batch
+// get secret values" endpoint HTTP request body.
+type BatchGetSecretValuesRequestBody struct {
+	// The secrets to read
+	Secrets []*SecretValueRequestRequestBody `form:"secrets,omitempty" json:"secrets,omitempty" xml:"secrets,omitempty"`
+}
+
+// RenderTemplateRequestBody is the type of the "secrets" service "render
+// template" endpoint HTTP request body.
+type RenderTemplateRequestBody struct {
+	// The template to render
+	Template *string `form:"template,omitempty" json:"template,omitempty" xml:"template,omitempty"`
+	// Base64 encoded path of a secret holding the template to render
+	TemplatePath *string `form:"template_path,omitempty" json:"template_path,omitempty" xml:"template_path,omitempty"`
+}
+
 // CreateSecretRequestBody is the type of the "secrets" service "create secret"
 // endpoint HTTP request body.
 type CreateSecretRequestBody struct {
@@ -21,10 +37,24 @@ type CreateSecretRequestBody struct {
 	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
 	// The secret value
 	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, instead of a value
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Name of the generation policy generating the value, instead of a value. Key
+	// pairs are stored as private_key and public_key fields
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+	// Duration or cron expression to regenerate the value on, requires a
+	// generation policy
+	RotationSchedule *string `form:"rotation_schedule,omitempty" json:"rotation_schedule,omitempty" xml:"rotation_schedule,omitempty"`
 	// Users IDs authorized to access the secret
 	AuthorizedUsers []int `form:"authorized_users,omitempty" json:"authorized_users,omitempty" xml:"authorized_users,omitempty"`
 	// Role IDs authorized to access the secret
 	AuthorizedRoles []int `form:"authorized_roles,omitempty" json:"authorized_roles,omitempty" xml:"authorized_roles,omitempty"`
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*GrantRequestBody `form:"user_grants,omitempty" json:"user_grants,omitempty" xml:"user_grants,omitempty"`
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*GrantRequestBody `form:"role_grants,omitempty" json:"role_grants,omitempty" xml:"role_grants,omitempty"`
 }
 
 // UpdateSecretRequestBody is the type of the "secrets" service "update secret"
@@ -32,44 +62,442 @@ type CreateSecretRequestBody struct {
 type UpdateSecretRequestBody struct {
 	// Base64 encoded secret's path
 	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
-	// The secret value
+	// The new secret value, unchanged if absent
 	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
-	// Users IDs authorized to access the secret
+	// Fields to add or replace in a structured secret
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Names of the fields to remove from a structured secret
+	RemoveFields []string `form:"remove_fields,omitempty" json:"remove_fields,omitempty" xml:"remove_fields,omitempty"`
+	// Users IDs authorized to access the secret, grants are unchanged if no users
+	// nor roles are given
 	AuthorizedUsers []int `form:"authorized_users,omitempty" json:"authorized_users,omitempty" xml:"authorized_users,omitempty"`
 	// Role IDs authorized to access the secret
 	AuthorizedRoles []int `form:"authorized_roles,omitempty" json:"authorized_roles,omitempty" xml:"authorized_roles,omitempty"`
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*GrantRequestBody `form:"user_grants,omitempty" json:"user_grants,omitempty" xml:"user_grants,omitempty"`
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*GrantRequestBody `form:"role_grants,omitempty" json:"role_grants,omitempty" xml:"role_grants,omitempty"`
+}
+
+// RegenerateSecretRequestBody is the type of the "secrets" service "regenerate
+// secret" endpoint HTTP request body.
+type RegenerateSecretRequestBody struct {
+	// Name of the generation policy to use, defaults to the one the secret was
+	// generated with
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+}
+
+// SetSecretRotationRequestBody is the type of the "secrets" service "set
+// secret rotation" endpoint HTTP request body.
+type SetSecretRotationRequestBody struct {
+	// Duration such as 720h or cron expression such as '0 3 * * 1', the schedule
+	// is removed if absent
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+}
+
+// MoveSecretsRequestBody is the type of the "secrets" service "move secrets"
+// endpoint HTTP request body.
+type MoveSecretsRequestBody struct {
+	// Base64 encoded path of the secret or folder to move
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Base64 encoded new path of the secret or folder
+	Destination *string `form:"destination,omitempty" json:"destination,omitempty" xml:"destination,omitempty"`
+	// Replace the secrets already existing at the destination paths
+	Overwrite *bool `form:"overwrite,omitempty" json:"overwrite,omitempty" xml:"overwrite,omitempty"`
+}
+
+// PromoteSecretsRequestBody is the type of the "secrets" service "promote
+// secrets" endpoint HTTP request body.
+type PromoteSecretsRequestBody struct {
+	// Base64 encoded path of the source folder
+	Source *string `form:"source,omitempty" json:"source,omitempty" xml:"source,omitempty"`
+	// Base64 encoded path of the destination folder
+	Destination *string `form:"destination,omitempty" json:"destination,omitempty" xml:"destination,omitempty"`
+	// Paths of the secrets to promote, relative to the folders
+	Keys []string `form:"keys,omitempty" json:"keys,omitempty" xml:"keys,omitempty"`
+	// Replace the values of the secrets which differ at the destination
+	Overwrite *bool `form:"overwrite,omitempty" json:"overwrite,omitempty" xml:"overwrite,omitempty"`
+}
+
+// TransferSecretOwnershipRequestBody is the type of the "secrets" service
+// "transfer secret ownership" endpoint HTTP request body.
+type TransferSecretOwnershipRequestBody struct {
+	// Base64 encoded path of the secret to transfer
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// ID of the user whose secrets are all transferred, in place of a path
+	FromUserID *int `form:"from_user_id,omitempty" json:"from_user_id,omitempty" xml:"from_user_id,omitempty"`
+	// ID of the new owner
+	ToUserID *int `form:"to_user_id,omitempty" json:"to_user_id,omitempty" xml:"to_user_id,omitempty"`
+	// ID of the role becoming the owner, in place of a user
+	ToRoleID *int `form:"to_role_id,omitempty" json:"to_role_id,omitempty" xml:"to_role_id,omitempty"`
+}
+
+// SetSecretCanaryRequestBody is the type of the "secrets" service "set secret
+// canary" endpoint HTTP request body.
+type SetSecretCanaryRequestBody struct {
+	// Whether the secret is a canary
+	Canary *bool `form:"canary,omitempty" json:"canary,omitempty" xml:"canary,omitempty"`
+}
+
+// WrapSecretRequestBody is the type of the "secrets" service "wrap secret"
+// endpoint HTTP request body.
+type WrapSecretRequestBody struct {
+	// The value to wrap
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Base64 encoded path of the secret to wrap a copy of, instead of a value
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Name of the only field to wrap from a structured secret
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Seconds the token can be unwrapped for
+	TTL *int `form:"ttl,omitempty" json:"ttl,omitempty" xml:"ttl,omitempty"`
+}
+
+// UnwrapSecretRequestBody is the type of the "secrets" service "unwrap secret"
+// endpoint HTTP request body.
+type UnwrapSecretRequestBody struct {
+	// Token returned when wrapping the value
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// RequestAccessRequestBody is the type of the "secrets" service "request
+// access" endpoint HTTP request body.
+type RequestAccessRequestBody struct {
+	// Base64 encoded path of the secret
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Why the access is needed
+	Justification *string `form:"justification,omitempty" json:"justification,omitempty" xml:"justification,omitempty"`
+	// Seconds the access is needed for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+}
+
+// ApproveAccessRequestRequestBody is the type of the "secrets" service
+// "approve access request" endpoint HTTP request body.
+type ApproveAccessRequestRequestBody struct {
+	// Comment kept with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// DenyAccessRequestRequestBody is the type of the "secrets" service "deny
+// access request" endpoint HTTP request body.
+type DenyAccessRequestRequestBody struct {
+	// Comment kept with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// CreateValidationRuleRequestBody is the type of the "secrets" service "create
+// validation rule" endpoint HTTP request body.
+type CreateValidationRuleRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// What the rule checks
+	Kind *string `form:"kind,omitempty" json:"kind,omitempty" xml:"kind,omitempty"`
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, omitted for x509_certificate rules
+	Parameter *string `form:"parameter,omitempty" json:"parameter,omitempty" xml:"parameter,omitempty"`
+	// The only field of structured secrets the rule applies to
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+}
+
+// CreateBreakGlassRuleRequestBody is the type of the "secrets" service "create
+// break glass rule" endpoint HTTP request body.
+type CreateBreakGlassRuleRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// ID of the only role whose members can break the glass
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+}
+
+// ReviewBreakGlassAccessRequestBody is the type of the "secrets" service
+// "review break glass access" endpoint HTTP request body.
+type ReviewBreakGlassAccessRequestBody struct {
+	// Comment kept with the acknowledgement
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+}
+
+// GrantFolderAccessRequestBody is the type of the "secrets" service "grant
+// folder access" endpoint HTTP request body.
+type GrantFolderAccessRequestBody struct {
+	// Base64 encoded folder path
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// ID of the user to grant access to
+	UserID *int `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// ID of the role to grant access to
+	RoleID *int `form:"role_id,omitempty" json:"role_id,omitempty" xml:"role_id,omitempty"`
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
 }
 
 // ListSecretsResponseBody is the type of the "secrets" service "list secrets"
 // endpoint HTTP response body.
 type ListSecretsResponseBody []*SecretInfoSummaryResponse
 
+// BrowseSecretsResponseBody is the type of the "secrets" service "browse
+// secrets" endpoint HTTP response body.
+type BrowseSecretsResponseBody struct {
+	// The folders and secrets found under the prefix
+	Entries []*SecretEntryResponseBody `form:"entries" json:"entries" xml:"entries"`
+	// Cursor to fetch the next page, absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+}
+
 // GetSecretValueResponseBody is the type of the "secrets" service "get secret
 // value" endpoint HTTP response body.
 type GetSecretValueResponseBody struct {
-	// The secret value
+	// The secret value, or the value of the requested field
 	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
 	// The original path of the secret
 	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Time your emergency access to the secret lapses at, when the secret was read
+	// by breaking the glass
+	BreakGlassExpiresAt *string `form:"break_glass_expires_at,omitempty" json:"break_glass_expires_at,omitempty" xml:"break_glass_expires_at,omitempty"`
+}
+
+// BatchGetSecretValuesResponseBody is the type of the "secrets" service "batch
+// get secret values" endpoint HTTP response body.
+type BatchGetSecretValuesResponseBody struct {
+	// The values of the secrets, in the order of the request
+	Secrets []*SecretValueResponseBody `form:"secrets" json:"secrets" xml:"secrets"`
 }
 
+// RenderTemplateResponseBody is the type of the "secrets" service "render
+// template" endpoint HTTP response body.
+type RenderTemplateResponseBody struct {
+	// The rendered document
+	Rendered string `form:"rendered" json:"rendered" xml:"rendered"`
+}
+
+// ImportSecretsResponseBody is the type of the "secrets" service "import
+// secrets" endpoint HTTP response body.
+type ImportSecretsResponseBody []*ImportedSecretResponse
+
 // GetSecretResponseBody is the type of the "secrets" service "get secret"
 // endpoint HTTP response body.
 type GetSecretResponseBody struct {
 	// The original path of the secret
 	Path string `form:"path" json:"path" xml:"path"`
 	// The owner of the secret
-	Owner *UserResponseBody `form:"owner" json:"owner" xml:"owner"`
+	Owner *UserResponseBody `form:"owner,omitempty" json:"owner,omitempty" xml:"owner,omitempty"`
+	// The role owning the secret, in place of a user
+	OwnerRole *RoleResponseBody `form:"owner_role,omitempty" json:"owner_role,omitempty" xml:"owner_role,omitempty"`
 	// Members authorized to access the secret
 	AuthorizedUsers []*UserResponseBody `form:"authorized_users" json:"authorized_users" xml:"authorized_users"`
 	// Roles authorized to access the secret
 	AuthorizedRoles []*RoleResponseBody `form:"authorized_roles" json:"authorized_roles" xml:"authorized_roles"`
+	// Capabilities granted to each authorized user
+	UserGrants []*GrantResponseBody `form:"user_grants" json:"user_grants" xml:"user_grants"`
+	// Capabilities granted to each authorized role
+	RoleGrants []*GrantResponseBody `form:"role_grants" json:"role_grants" xml:"role_grants"`
+	// Capabilities you hold on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Whether the secret holds named fields instead of a single value
+	Structured bool `form:"structured" json:"structured" xml:"structured"`
+	// Names of the fields of a structured secret
+	Fields []string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Version of the secret's value, incremented on every change
+	Version int `form:"version" json:"version" xml:"version"`
+	// Name of the generation policy used to regenerate the secret
+	GenerationPolicy *string `form:"generation_policy,omitempty" json:"generation_policy,omitempty" xml:"generation_policy,omitempty"`
+	// Duration or cron expression the secret is regenerated on
+	RotationSchedule *string `form:"rotation_schedule,omitempty" json:"rotation_schedule,omitempty" xml:"rotation_schedule,omitempty"`
+	// Time of the next scheduled rotation
+	NextRotationAt *string `form:"next_rotation_at,omitempty" json:"next_rotation_at,omitempty" xml:"next_rotation_at,omitempty"`
+	// Time the value of the secret was last read, recorded with a delay of a few
+	// seconds
+	LastReadAt *string `form:"last_read_at,omitempty" json:"last_read_at,omitempty" xml:"last_read_at,omitempty"`
+	// The user who last read the value of the secret
+	LastReader *UserResponseBody `form:"last_reader,omitempty" json:"last_reader,omitempty" xml:"last_reader,omitempty"`
+	// Number of reads of the value of the secret over the last 30 days
+	RecentReads int `form:"recent_reads" json:"recent_reads" xml:"recent_reads"`
 	// Creation timestamp of the secret
 	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp of the secret
 	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
+// RegenerateSecretResponseBody is the type of the "secrets" service
+// "regenerate secret" endpoint HTTP response body.
+type RegenerateSecretResponseBody struct {
+	// The new version of the secret
+	Version int `form:"version" json:"version" xml:"version"`
+}
+
+// SetSecretRotationResponseBody is the type of the "secrets" service "set
+// secret rotation" endpoint HTTP response body.
+type SetSecretRotationResponseBody struct {
+	// Time of the next scheduled rotation
+	NextRotationAt *string `form:"next_rotation_at,omitempty" json:"next_rotation_at,omitempty" xml:"next_rotation_at,omitempty"`
+}
+
+// ListSecretRotationsResponseBody is the type of the "secrets" service "list
+// secret rotations" endpoint HTTP response body.
+type ListSecretRotationsResponseBody []*SecretRotationResponse
+
+// DiffSecretsResponseBody is the type of the "secrets" service "diff secrets"
+// endpoint HTTP response body.
+type DiffSecretsResponseBody []*SecretDiffResponse
+
+// ListStaleSecretsResponseBody is the type of the "secrets" service "list
+// stale secrets" endpoint HTTP response body.
+type ListStaleSecretsResponseBody []*StaleSecretResponse
+
+// WrapSecretResponseBody is the type of the "secrets" service "wrap secret"
+// endpoint HTTP response body.
+type WrapSecretResponseBody struct {
+	// Single-use token unwrapping the value
+	Token string `form:"token" json:"token" xml:"token"`
+	// Expiration time of the token
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// UnwrapSecretResponseBody is the type of the "secrets" service "unwrap
+// secret" endpoint HTTP response body.
+type UnwrapSecretResponseBody struct {
+	// The wrapped value
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a wrapped structured secret
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Path of the secret the value was copied from
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Time the value was wrapped
+	WrappedAt string `form:"wrapped_at" json:"wrapped_at" xml:"wrapped_at"`
+}
+
+// RequestAccessResponseBody is the type of the "secrets" service "request
+// access" endpoint HTTP response body.
+type RequestAccessResponseBody struct {
+	// Unique identifier of the access request
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Why the access is needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status string `form:"status" json:"status" xml:"status"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListAccessRequestsResponseBody is the type of the "secrets" service "list
+// access requests" endpoint HTTP response body.
+type ListAccessRequestsResponseBody []*AccessRequestResponse
+
+// ApproveAccessRequestResponseBody is the type of the "secrets" service
+// "approve access request" endpoint HTTP response body.
+type ApproveAccessRequestResponseBody struct {
+	// Unique identifier of the access request
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Why the access is needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status string `form:"status" json:"status" xml:"status"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// DenyAccessRequestResponseBody is the type of the "secrets" service "deny
+// access request" endpoint HTTP response body.
+type DenyAccessRequestResponseBody struct {
+	// Unique identifier of the access request
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who filed the request
+	Requester *UserResponseBody `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Why the access is needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status string `form:"status" json:"status" xml:"status"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponseBody `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListValidationRulesResponseBody is the type of the "secrets" service "list
+// validation rules" endpoint HTTP response body.
+type ListValidationRulesResponseBody []*ValidationRuleResponse
+
+// ListBreakGlassRulesResponseBody is the type of the "secrets" service "list
+// break glass rules" endpoint HTTP response body.
+type ListBreakGlassRulesResponseBody []*BreakGlassRuleResponse
+
+// ListBreakGlassAccessesResponseBody is the type of the "secrets" service
+// "list break glass accesses" endpoint HTTP response body.
+type ListBreakGlassAccessesResponseBody []*BreakGlassAccessResponse
+
+// ReviewBreakGlassAccessResponseBody is the type of the "secrets" service
+// "review break glass access" endpoint HTTP response body.
+type ReviewBreakGlassAccessResponseBody struct {
+	// Unique identifier of the emergency access
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who broke the glass
+	User *UserResponseBody `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Why the emergency access was needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Time the read access given by breaking the glass lapses at
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+	// The user who acknowledged the emergency access
+	ReviewedBy *UserResponseBody `form:"reviewed_by,omitempty" json:"reviewed_by,omitempty" xml:"reviewed_by,omitempty"`
+	// Time of the acknowledgement
+	ReviewedAt *string `form:"reviewed_at,omitempty" json:"reviewed_at,omitempty" xml:"reviewed_at,omitempty"`
+	// Comment left with the acknowledgement
+	ReviewComment *string `form:"review_comment,omitempty" json:"review_comment,omitempty" xml:"review_comment,omitempty"`
+	// Time the glass was broken
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListFolderAccessesResponseBody is the type of the "secrets" service "list
+// folder accesses" endpoint HTTP response body.
+type ListFolderAccessesResponseBody []*FolderAccessResponse
+
 // ListSecretsUnauthorizedResponseBody is the type of the "secrets" service
 // "list secrets" endpoint HTTP response body for the "unauthorized" error.
 type ListSecretsUnauthorizedResponseBody struct {
@@ -124,6 +552,79 @@ type ListSecretsInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// BrowseSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "browse secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type BrowseSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BrowseSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "unauthorized" error.
+type BrowseSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BrowseSecretsForbiddenResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "forbidden" error.
+type BrowseSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// BrowseSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "browse secrets" endpoint HTTP response body for the "internal_error" error.
+type BrowseSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // GetSecretValueSecretNotFoundResponseBody is the type of the "secrets"
 // service "get secret value" endpoint HTTP response body for the
 // "secret_not_found" error.
@@ -143,6 +644,25 @@ type GetSecretValueSecretNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// GetSecretValueFieldNotFoundResponseBody is the type of the "secrets" service
+// "get secret value" endpoint HTTP response body for the "field_not_found"
+// error.
+type GetSecretValueFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // GetSecretValueInvalidParametersResponseBody is the type of the "secrets"
 // service "get secret value" endpoint HTTP response body for the
 // "invalid_parameters" error.
@@ -217,9 +737,10 @@ type GetSecretValueInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetSecretSecretNotFoundResponseBody is the type of the "secrets" service
-// "get secret" endpoint HTTP response body for the "secret_not_found" error.
-type GetSecretSecretNotFoundResponseBody struct {
+// BatchGetSecretValuesInvalidParametersResponseBody is the type of the
+// "secrets" service "batch get secret values" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type BatchGetSecretValuesInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -235,9 +756,10 @@ type GetSecretSecretNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetSecretInvalidParametersResponseBody is the type of the "secrets" service
-// "get secret" endpoint HTTP response body for the "invalid_parameters" error.
-type GetSecretInvalidParametersResponseBody struct {
+// BatchGetSecretValuesUnauthorizedResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "unauthorized" error.
+type BatchGetSecretValuesUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -253,9 +775,10 @@ type GetSecretInvalidParametersResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetSecretUnauthorizedResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "unauthorized" error.
-type GetSecretUnauthorizedResponseBody struct {
+// BatchGetSecretValuesForbiddenResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "forbidden" error.
+type BatchGetSecretValuesForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -271,9 +794,10 @@ type GetSecretUnauthorizedResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetSecretForbiddenResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "forbidden" error.
-type GetSecretForbiddenResponseBody struct {
+// BatchGetSecretValuesInternalErrorResponseBody is the type of the "secrets"
+// service "batch get secret values" endpoint HTTP response body for the
+// "internal_error" error.
+type BatchGetSecretValuesInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -289,9 +813,10 @@ type GetSecretForbiddenResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// GetSecretInternalErrorResponseBody is the type of the "secrets" service "get
-// secret" endpoint HTTP response body for the "internal_error" error.
-type GetSecretInternalErrorResponseBody struct {
+// RenderTemplateSecretNotFoundResponseBody is the type of the "secrets"
+// service "render template" endpoint HTTP response body for the
+// "secret_not_found" error.
+type RenderTemplateSecretNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -307,10 +832,10 @@ type GetSecretInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CreateSecretInvalidParametersResponseBody is the type of the "secrets"
-// service "create secret" endpoint HTTP response body for the
-// "invalid_parameters" error.
-type CreateSecretInvalidParametersResponseBody struct {
+// RenderTemplateFieldNotFoundResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "field_not_found"
+// error.
+type RenderTemplateFieldNotFoundResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -326,9 +851,10 @@ type CreateSecretInvalidParametersResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CreateSecretUnauthorizedResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "unauthorized" error.
-type CreateSecretUnauthorizedResponseBody struct {
+// RenderTemplateInvalidParametersResponseBody is the type of the "secrets"
+// service "render template" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RenderTemplateInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -344,9 +870,9 @@ type CreateSecretUnauthorizedResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CreateSecretForbiddenResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "forbidden" error.
-type CreateSecretForbiddenResponseBody struct {
+// RenderTemplateUnauthorizedResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "unauthorized" error.
+type RenderTemplateUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -362,9 +888,9 @@ type CreateSecretForbiddenResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// CreateSecretInternalErrorResponseBody is the type of the "secrets" service
-// "create secret" endpoint HTTP response body for the "internal_error" error.
-type CreateSecretInternalErrorResponseBody struct {
+// RenderTemplateForbiddenResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "forbidden" error.
+type RenderTemplateForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -380,9 +906,9 @@ type CreateSecretInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UpdateSecretSecretNotFoundResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "secret_not_found" error.
-type UpdateSecretSecretNotFoundResponseBody struct {
+// RenderTemplateInternalErrorResponseBody is the type of the "secrets" service
+// "render template" endpoint HTTP response body for the "internal_error" error.
+type RenderTemplateInternalErrorResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -398,10 +924,10 @@ type UpdateSecretSecretNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UpdateSecretInvalidParametersResponseBody is the type of the "secrets"
-// service "update secret" endpoint HTTP response body for the
-// "invalid_parameters" error.
-type UpdateSecretInvalidParametersResponseBody struct {
+// UploadSecretFileSecretTooLargeResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "secret_too_large" error.
+type UploadSecretFileSecretTooLargeResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -417,9 +943,10 @@ type UpdateSecretInvalidParametersResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UpdateSecretUnauthorizedResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "unauthorized" error.
-type UpdateSecretUnauthorizedResponseBody struct {
+// UploadSecretFileInvalidParametersResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UploadSecretFileInvalidParametersResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -435,9 +962,10 @@ type UpdateSecretUnauthorizedResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UpdateSecretForbiddenResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "forbidden" error.
-type UpdateSecretForbiddenResponseBody struct {
+// UploadSecretFileUnauthorizedResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "unauthorized" error.
+type UploadSecretFileUnauthorizedResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -453,9 +981,9 @@ type UpdateSecretForbiddenResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UpdateSecretInternalErrorResponseBody is the type of the "secrets" service
-// "update secret" endpoint HTTP response body for the "internal_error" error.
-type UpdateSecretInternalErrorResponseBody struct {
+// UploadSecretFileForbiddenResponseBody is the type of the "secrets" service
+// "upload secret file" endpoint HTTP response body for the "forbidden" error.
+type UploadSecretFileForbiddenResponseBody struct {
 	// Name is the name of this class of errors.
 	Name string `form:"name" json:"name" xml:"name"`
 	// ID is a unique identifier for this particular occurrence of the problem.
@@ -471,136 +999,6344 @@ type UpdateSecretInternalErrorResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// SecretInfoSummaryResponse is used to define fields on response body types.
-type SecretInfoSummaryResponse struct {
-	// The original path of the secret
-	Path string `form:"path" json:"path" xml:"path"`
-	// The owner of the secret
-	Owner *UserResponse `form:"owner" json:"owner" xml:"owner"`
-	// Creation timestamp of the secret
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// Last update timestamp of the secret
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
-	// Users authorized to access the secret
-	Users []*UserResponse `form:"users" json:"users" xml:"users"`
-	// Roles authorized to access the secret
-	Roles []*RoleResponse `form:"roles" json:"roles" xml:"roles"`
+// UploadSecretFileInternalErrorResponseBody is the type of the "secrets"
+// service "upload secret file" endpoint HTTP response body for the
+// "internal_error" error.
+type UploadSecretFileInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
-// UserResponse is used to define fields on response body types.
-type UserResponse struct {
-	// Unique identifier for the user
-	ID int `form:"id" json:"id" xml:"id"`
-	// The username
-	Username string `form:"username" json:"username" xml:"username"`
-	// User creation timestamp
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// User last update timestamp
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+// DownloadSecretFileSecretNotFoundResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "secret_not_found" error.
+type DownloadSecretFileSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DownloadSecretFileInvalidParametersResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DownloadSecretFileInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DownloadSecretFileUnauthorizedResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "unauthorized" error.
+type DownloadSecretFileUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DownloadSecretFileForbiddenResponseBody is the type of the "secrets" service
+// "download secret file" endpoint HTTP response body for the "forbidden" error.
+type DownloadSecretFileForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DownloadSecretFileInternalErrorResponseBody is the type of the "secrets"
+// service "download secret file" endpoint HTTP response body for the
+// "internal_error" error.
+type DownloadSecretFileInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsSecretNotFoundResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "secret_not_found"
+// error.
+type ExportSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsFieldNotFoundResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "field_not_found" error.
+type ExportSecretsFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "export secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ExportSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "unauthorized" error.
+type ExportSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsForbiddenResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "forbidden" error.
+type ExportSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ExportSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "export secrets" endpoint HTTP response body for the "internal_error" error.
+type ExportSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "import secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type ImportSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsSecretTooLargeResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "secret_too_large"
+// error.
+type ImportSecretsSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "import secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ImportSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "unauthorized" error.
+type ImportSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsForbiddenResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "forbidden" error.
+type ImportSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ImportSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "import secrets" endpoint HTTP response body for the "internal_error" error.
+type ImportSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "get secret" endpoint HTTP response body for the "secret_not_found" error.
+type GetSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetSecretInvalidParametersResponseBody is the type of the "secrets" service
+// "get secret" endpoint HTTP response body for the "invalid_parameters" error.
+type GetSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetSecretUnauthorizedResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "unauthorized" error.
+type GetSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetSecretForbiddenResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "forbidden" error.
+type GetSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GetSecretInternalErrorResponseBody is the type of the "secrets" service "get
+// secret" endpoint HTTP response body for the "internal_error" error.
+type GetSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "secret_too_large" error.
+type CreateSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "create secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type CreateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "unauthorized" error.
+type CreateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSecretForbiddenResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "forbidden" error.
+type CreateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateSecretInternalErrorResponseBody is the type of the "secrets" service
+// "create secret" endpoint HTTP response body for the "internal_error" error.
+type CreateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "secret_not_found" error.
+type UpdateSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretFieldNotFoundResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "field_not_found" error.
+type UpdateSecretFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "secret_too_large" error.
+type UpdateSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "update secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UpdateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "unauthorized" error.
+type UpdateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretForbiddenResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "forbidden" error.
+type UpdateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateSecretInternalErrorResponseBody is the type of the "secrets" service
+// "update secret" endpoint HTTP response body for the "internal_error" error.
+type UpdateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RegenerateSecretSecretNotFoundResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "secret_not_found" error.
+type RegenerateSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RegenerateSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RegenerateSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RegenerateSecretUnauthorizedResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "unauthorized" error.
+type RegenerateSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RegenerateSecretForbiddenResponseBody is the type of the "secrets" service
+// "regenerate secret" endpoint HTTP response body for the "forbidden" error.
+type RegenerateSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RegenerateSecretInternalErrorResponseBody is the type of the "secrets"
+// service "regenerate secret" endpoint HTTP response body for the
+// "internal_error" error.
+type RegenerateSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretRotationSecretNotFoundResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "secret_not_found" error.
+type SetSecretRotationSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretRotationInvalidParametersResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type SetSecretRotationInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretRotationUnauthorizedResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "unauthorized" error.
+type SetSecretRotationUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretRotationForbiddenResponseBody is the type of the "secrets" service
+// "set secret rotation" endpoint HTTP response body for the "forbidden" error.
+type SetSecretRotationForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretRotationInternalErrorResponseBody is the type of the "secrets"
+// service "set secret rotation" endpoint HTTP response body for the
+// "internal_error" error.
+type SetSecretRotationInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSecretRotationsSecretNotFoundResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "secret_not_found" error.
+type ListSecretRotationsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSecretRotationsInvalidParametersResponseBody is the type of the
+// "secrets" service "list secret rotations" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ListSecretRotationsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSecretRotationsUnauthorizedResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListSecretRotationsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSecretRotationsForbiddenResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "forbidden" error.
+type ListSecretRotationsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListSecretRotationsInternalErrorResponseBody is the type of the "secrets"
+// service "list secret rotations" endpoint HTTP response body for the
+// "internal_error" error.
+type ListSecretRotationsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "secret_not_found" error.
+type DeleteSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "delete secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DeleteSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "unauthorized" error.
+type DeleteSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSecretForbiddenResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "forbidden" error.
+type DeleteSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteSecretInternalErrorResponseBody is the type of the "secrets" service
+// "delete secret" endpoint HTTP response body for the "internal_error" error.
+type DeleteSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsSecretNotFoundResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "secret_not_found" error.
+type MoveSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "move secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type MoveSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsFolderChangedResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "folder_changed" error.
+type MoveSecretsFolderChangedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "move secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type MoveSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "unauthorized" error.
+type MoveSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsForbiddenResponseBody is the type of the "secrets" service "move
+// secrets" endpoint HTTP response body for the "forbidden" error.
+type MoveSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// MoveSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "move secrets" endpoint HTTP response body for the "internal_error" error.
+type MoveSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "diff secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DiffSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "diff secrets" endpoint HTTP response body for the "unauthorized" error.
+type DiffSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffSecretsForbiddenResponseBody is the type of the "secrets" service "diff
+// secrets" endpoint HTTP response body for the "forbidden" error.
+type DiffSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DiffSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "diff secrets" endpoint HTTP response body for the "internal_error" error.
+type DiffSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsSecretNotFoundResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "secret_not_found" error.
+type PromoteSecretsSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsSecretAlreadyExistsResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "secret_already_exists" error.
+type PromoteSecretsSecretAlreadyExistsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "promote secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type PromoteSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsUnauthorizedResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "unauthorized" error.
+type PromoteSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsForbiddenResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "forbidden" error.
+type PromoteSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// PromoteSecretsInternalErrorResponseBody is the type of the "secrets" service
+// "promote secrets" endpoint HTTP response body for the "internal_error" error.
+type PromoteSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TransferSecretOwnershipSecretNotFoundResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "secret_not_found" error.
+type TransferSecretOwnershipSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TransferSecretOwnershipInvalidParametersResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type TransferSecretOwnershipInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TransferSecretOwnershipUnauthorizedResponseBody is the type of the "secrets"
+// service "transfer secret ownership" endpoint HTTP response body for the
+// "unauthorized" error.
+type TransferSecretOwnershipUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TransferSecretOwnershipForbiddenResponseBody is the type of the "secrets"
+// service "transfer secret ownership" endpoint HTTP response body for the
+// "forbidden" error.
+type TransferSecretOwnershipForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// TransferSecretOwnershipInternalErrorResponseBody is the type of the
+// "secrets" service "transfer secret ownership" endpoint HTTP response body
+// for the "internal_error" error.
+type TransferSecretOwnershipInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListStaleSecretsInvalidParametersResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ListStaleSecretsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListStaleSecretsUnauthorizedResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListStaleSecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListStaleSecretsForbiddenResponseBody is the type of the "secrets" service
+// "list stale secrets" endpoint HTTP response body for the "forbidden" error.
+type ListStaleSecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListStaleSecretsInternalErrorResponseBody is the type of the "secrets"
+// service "list stale secrets" endpoint HTTP response body for the
+// "internal_error" error.
+type ListStaleSecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretCanarySecretNotFoundResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "secret_not_found" error.
+type SetSecretCanarySecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretCanaryInvalidParametersResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type SetSecretCanaryInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretCanaryUnauthorizedResponseBody is the type of the "secrets" service
+// "set secret canary" endpoint HTTP response body for the "unauthorized" error.
+type SetSecretCanaryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretCanaryForbiddenResponseBody is the type of the "secrets" service
+// "set secret canary" endpoint HTTP response body for the "forbidden" error.
+type SetSecretCanaryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SetSecretCanaryInternalErrorResponseBody is the type of the "secrets"
+// service "set secret canary" endpoint HTTP response body for the
+// "internal_error" error.
+type SetSecretCanaryInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListCanarySecretsUnauthorizedResponseBody is the type of the "secrets"
+// service "list canary secrets" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListCanarySecretsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListCanarySecretsForbiddenResponseBody is the type of the "secrets" service
+// "list canary secrets" endpoint HTTP response body for the "forbidden" error.
+type ListCanarySecretsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListCanarySecretsInternalErrorResponseBody is the type of the "secrets"
+// service "list canary secrets" endpoint HTTP response body for the
+// "internal_error" error.
+type ListCanarySecretsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretSecretNotFoundResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "secret_not_found" error.
+type WrapSecretSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretFieldNotFoundResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "field_not_found" error.
+type WrapSecretFieldNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretSecretTooLargeResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "secret_too_large" error.
+type WrapSecretSecretTooLargeResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretInvalidParametersResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "invalid_parameters" error.
+type WrapSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretUnauthorizedResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "unauthorized" error.
+type WrapSecretUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretForbiddenResponseBody is the type of the "secrets" service "wrap
+// secret" endpoint HTTP response body for the "forbidden" error.
+type WrapSecretForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WrapSecretInternalErrorResponseBody is the type of the "secrets" service
+// "wrap secret" endpoint HTTP response body for the "internal_error" error.
+type WrapSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnwrapSecretTokenNotFoundResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "token_not_found" error.
+type UnwrapSecretTokenNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnwrapSecretTokenExpiredResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "token_expired" error.
+type UnwrapSecretTokenExpiredResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnwrapSecretTokenAlreadyUsedResponseBody is the type of the "secrets"
+// service "unwrap secret" endpoint HTTP response body for the
+// "token_already_used" error.
+type UnwrapSecretTokenAlreadyUsedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnwrapSecretInvalidParametersResponseBody is the type of the "secrets"
+// service "unwrap secret" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UnwrapSecretInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UnwrapSecretInternalErrorResponseBody is the type of the "secrets" service
+// "unwrap secret" endpoint HTTP response body for the "internal_error" error.
+type UnwrapSecretInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessSecretNotFoundResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "secret_not_found"
+// error.
+type RequestAccessSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessAccessRequestPendingResponseBody is the type of the "secrets"
+// service "request access" endpoint HTTP response body for the
+// "access_request_pending" error.
+type RequestAccessAccessRequestPendingResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessInvalidParametersResponseBody is the type of the "secrets"
+// service "request access" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type RequestAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessUnauthorizedResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "unauthorized" error.
+type RequestAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessForbiddenResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "forbidden" error.
+type RequestAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RequestAccessInternalErrorResponseBody is the type of the "secrets" service
+// "request access" endpoint HTTP response body for the "internal_error" error.
+type RequestAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAccessRequestsInvalidParametersResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type ListAccessRequestsInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAccessRequestsUnauthorizedResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListAccessRequestsUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAccessRequestsForbiddenResponseBody is the type of the "secrets" service
+// "list access requests" endpoint HTTP response body for the "forbidden" error.
+type ListAccessRequestsForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListAccessRequestsInternalErrorResponseBody is the type of the "secrets"
+// service "list access requests" endpoint HTTP response body for the
+// "internal_error" error.
+type ListAccessRequestsInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestAccessRequestNotFoundResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "access_request_not_found" error.
+type ApproveAccessRequestAccessRequestNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestSecretNotFoundResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "secret_not_found" error.
+type ApproveAccessRequestSecretNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestAccessRequestDecidedResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "access_request_decided" error.
+type ApproveAccessRequestAccessRequestDecidedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestInvalidParametersResponseBody is the type of the
+// "secrets" service "approve access request" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ApproveAccessRequestInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestUnauthorizedResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "unauthorized" error.
+type ApproveAccessRequestUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestForbiddenResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "forbidden" error.
+type ApproveAccessRequestForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ApproveAccessRequestInternalErrorResponseBody is the type of the "secrets"
+// service "approve access request" endpoint HTTP response body for the
+// "internal_error" error.
+type ApproveAccessRequestInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestAccessRequestNotFoundResponseBody is the type of the
+// "secrets" service "deny access request" endpoint HTTP response body for the
+// "access_request_not_found" error.
+type DenyAccessRequestAccessRequestNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestAccessRequestDecidedResponseBody is the type of the
+// "secrets" service "deny access request" endpoint HTTP response body for the
+// "access_request_decided" error.
+type DenyAccessRequestAccessRequestDecidedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestInvalidParametersResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type DenyAccessRequestInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestUnauthorizedResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "unauthorized" error.
+type DenyAccessRequestUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestForbiddenResponseBody is the type of the "secrets" service
+// "deny access request" endpoint HTTP response body for the "forbidden" error.
+type DenyAccessRequestForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DenyAccessRequestInternalErrorResponseBody is the type of the "secrets"
+// service "deny access request" endpoint HTTP response body for the
+// "internal_error" error.
+type DenyAccessRequestInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListValidationRulesUnauthorizedResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListValidationRulesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListValidationRulesForbiddenResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "forbidden" error.
+type ListValidationRulesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListValidationRulesInternalErrorResponseBody is the type of the "secrets"
+// service "list validation rules" endpoint HTTP response body for the
+// "internal_error" error.
+type ListValidationRulesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateValidationRuleInvalidParametersResponseBody is the type of the
+// "secrets" service "create validation rule" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type CreateValidationRuleInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateValidationRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type CreateValidationRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateValidationRuleForbiddenResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateValidationRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateValidationRuleInternalErrorResponseBody is the type of the "secrets"
+// service "create validation rule" endpoint HTTP response body for the
+// "internal_error" error.
+type CreateValidationRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteValidationRuleValidationRuleNotFoundResponseBody is the type of the
+// "secrets" service "delete validation rule" endpoint HTTP response body for
+// the "validation_rule_not_found" error.
+type DeleteValidationRuleValidationRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteValidationRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type DeleteValidationRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteValidationRuleForbiddenResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteValidationRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteValidationRuleInternalErrorResponseBody is the type of the "secrets"
+// service "delete validation rule" endpoint HTTP response body for the
+// "internal_error" error.
+type DeleteValidationRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassRulesUnauthorizedResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListBreakGlassRulesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassRulesForbiddenResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "forbidden" error.
+type ListBreakGlassRulesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassRulesInternalErrorResponseBody is the type of the "secrets"
+// service "list break glass rules" endpoint HTTP response body for the
+// "internal_error" error.
+type ListBreakGlassRulesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBreakGlassRuleInvalidParametersResponseBody is the type of the
+// "secrets" service "create break glass rule" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type CreateBreakGlassRuleInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBreakGlassRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type CreateBreakGlassRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBreakGlassRuleForbiddenResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "forbidden" error.
+type CreateBreakGlassRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateBreakGlassRuleInternalErrorResponseBody is the type of the "secrets"
+// service "create break glass rule" endpoint HTTP response body for the
+// "internal_error" error.
+type CreateBreakGlassRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody is the type of the
+// "secrets" service "delete break glass rule" endpoint HTTP response body for
+// the "break_glass_rule_not_found" error.
+type DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteBreakGlassRuleUnauthorizedResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "unauthorized" error.
+type DeleteBreakGlassRuleUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteBreakGlassRuleForbiddenResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "forbidden" error.
+type DeleteBreakGlassRuleForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteBreakGlassRuleInternalErrorResponseBody is the type of the "secrets"
+// service "delete break glass rule" endpoint HTTP response body for the
+// "internal_error" error.
+type DeleteBreakGlassRuleInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassAccessesInvalidParametersResponseBody is the type of the
+// "secrets" service "list break glass accesses" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type ListBreakGlassAccessesInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassAccessesUnauthorizedResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListBreakGlassAccessesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassAccessesForbiddenResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "forbidden" error.
+type ListBreakGlassAccessesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListBreakGlassAccessesInternalErrorResponseBody is the type of the "secrets"
+// service "list break glass accesses" endpoint HTTP response body for the
+// "internal_error" error.
+type ListBreakGlassAccessesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody is the type of
+// the "secrets" service "review break glass access" endpoint HTTP response
+// body for the "break_glass_access_not_found" error.
+type ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody is the type of
+// the "secrets" service "review break glass access" endpoint HTTP response
+// body for the "break_glass_access_reviewed" error.
+type ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessInvalidParametersResponseBody is the type of the
+// "secrets" service "review break glass access" endpoint HTTP response body
+// for the "invalid_parameters" error.
+type ReviewBreakGlassAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "unauthorized" error.
+type ReviewBreakGlassAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessForbiddenResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "forbidden" error.
+type ReviewBreakGlassAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReviewBreakGlassAccessInternalErrorResponseBody is the type of the "secrets"
+// service "review break glass access" endpoint HTTP response body for the
+// "internal_error" error.
+type ReviewBreakGlassAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListFolderAccessesUnauthorizedResponseBody is the type of the "secrets"
+// service "list folder accesses" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListFolderAccessesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListFolderAccessesForbiddenResponseBody is the type of the "secrets" service
+// "list folder accesses" endpoint HTTP response body for the "forbidden" error.
+type ListFolderAccessesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListFolderAccessesInternalErrorResponseBody is the type of the "secrets"
+// service "list folder accesses" endpoint HTTP response body for the
+// "internal_error" error.
+type ListFolderAccessesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GrantFolderAccessInvalidParametersResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type GrantFolderAccessInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GrantFolderAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "unauthorized" error.
+type GrantFolderAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GrantFolderAccessForbiddenResponseBody is the type of the "secrets" service
+// "grant folder access" endpoint HTTP response body for the "forbidden" error.
+type GrantFolderAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// GrantFolderAccessInternalErrorResponseBody is the type of the "secrets"
+// service "grant folder access" endpoint HTTP response body for the
+// "internal_error" error.
+type GrantFolderAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeFolderAccessFolderAccessNotFoundResponseBody is the type of the
+// "secrets" service "revoke folder access" endpoint HTTP response body for the
+// "folder_access_not_found" error.
+type RevokeFolderAccessFolderAccessNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeFolderAccessUnauthorizedResponseBody is the type of the "secrets"
+// service "revoke folder access" endpoint HTTP response body for the
+// "unauthorized" error.
+type RevokeFolderAccessUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeFolderAccessForbiddenResponseBody is the type of the "secrets" service
+// "revoke folder access" endpoint HTTP response body for the "forbidden" error.
+type RevokeFolderAccessForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// RevokeFolderAccessInternalErrorResponseBody is the type of the "secrets"
+// service "revoke folder access" endpoint HTTP response body for the
+// "internal_error" error.
+type RevokeFolderAccessInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// SecretInfoSummaryResponse is used to define fields on response body types.
+type SecretInfoSummaryResponse struct {
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The owner of the secret
+	Owner *UserResponse `form:"owner,omitempty" json:"owner,omitempty" xml:"owner,omitempty"`
+	// The role owning the secret, in place of a user
+	OwnerRole *RoleResponse `form:"owner_role,omitempty" json:"owner_role,omitempty" xml:"owner_role,omitempty"`
+	// Creation timestamp of the secret
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp of the secret
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Users authorized to access the secret
+	Users []*UserResponse `form:"users" json:"users" xml:"users"`
+	// Roles authorized to access the secret
+	Roles []*RoleResponse `form:"roles" json:"roles" xml:"roles"`
+}
+
+// UserResponse is used to define fields on response body types.
+type UserResponse struct {
+	// Unique identifier for the user
+	ID int `form:"id" json:"id" xml:"id"`
+	// The username
+	Username string `form:"username" json:"username" xml:"username"`
+	// User creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// User last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
 	// Roles assigned to the user
 	Roles []*RoleResponse `form:"roles" json:"roles" xml:"roles"`
 }
 
-// RoleResponse is used to define fields on response body types.
-type RoleResponse struct {
-	// Unique identifier for the role
-	ID int `form:"id" json:"id" xml:"id"`
-	// Name of the role
-	Name string `form:"name" json:"name" xml:"name"`
-	// Color associated with the role
-	Color string `form:"color" json:"color" xml:"color"`
-	// Is this role an admin role?
-	Admin bool `form:"admin" json:"admin" xml:"admin"`
-	// Role creation timestamp
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// Role last update timestamp
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+// RoleResponse is used to define fields on response body types.
+type RoleResponse struct {
+	// Unique identifier for the role
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the role
+	Name string `form:"name" json:"name" xml:"name"`
+	// Color associated with the role
+	Color string `form:"color" json:"color" xml:"color"`
+	// Is this role an admin role?
+	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// Role creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Role last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// SecretEntryResponseBody is used to define fields on response body types.
+type SecretEntryResponseBody struct {
+	// The full path of the folder or secret, folders end with a '/'
+	Path string `form:"path" json:"path" xml:"path"`
+	// The last segment of the path
+	Name string `form:"name" json:"name" xml:"name"`
+	// Whether the entry is a folder
+	Folder bool `form:"folder" json:"folder" xml:"folder"`
+	// Creation timestamp of the secret, absent for folders
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp of the secret, absent for folders
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// SecretValueResponseBody is used to define fields on response body types.
+type SecretValueResponseBody struct {
+	// Base64 encoded secret's path, as requested
+	Path string `form:"path" json:"path" xml:"path"`
+	// The secret value, or the value of the requested field
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte `form:"raw_value,omitempty" json:"raw_value,omitempty" xml:"raw_value,omitempty"`
+	// Content type of a binary secret
+	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty" xml:"content_type,omitempty"`
+	// Original filename of a binary secret
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty" xml:"filename,omitempty"`
+	// Why the value could not be read, the other fields are absent when set
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Description of the error
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
+}
+
+// ImportedSecretResponse is used to define fields on response body types.
+type ImportedSecretResponse struct {
+	// Key of the secret in the imported file
+	Key string `form:"key" json:"key" xml:"key"`
+	// Path the secret is imported to
+	Path string `form:"path" json:"path" xml:"path"`
+	// What the import does to the secret
+	Action string `form:"action" json:"action" xml:"action"`
+}
+
+// UserResponseBody is used to define fields on response body types.
+type UserResponseBody struct {
+	// Unique identifier for the user
+	ID int `form:"id" json:"id" xml:"id"`
+	// The username
+	Username string `form:"username" json:"username" xml:"username"`
+	// User creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// User last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Roles assigned to the user
+	Roles []*RoleResponseBody `form:"roles" json:"roles" xml:"roles"`
+}
+
+// RoleResponseBody is used to define fields on response body types.
+type RoleResponseBody struct {
+	// Unique identifier for the role
+	ID int `form:"id" json:"id" xml:"id"`
+	// Name of the role
+	Name string `form:"name" json:"name" xml:"name"`
+	// Color associated with the role
+	Color string `form:"color" json:"color" xml:"color"`
+	// Is this role an admin role?
+	Admin bool `form:"admin" json:"admin" xml:"admin"`
+	// Role creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Role last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// GrantResponseBody is used to define fields on response body types.
+type GrantResponseBody struct {
+	// ID of the user or role the capabilities are granted to
+	ID int `form:"id" json:"id" xml:"id"`
+	// Capabilities granted on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Time the grant lapses at, it never does when absent
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Seconds left before the grant lapses, only set in responses
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// SecretRotationResponse is used to define fields on response body types.
+type SecretRotationResponse struct {
+	// Version of the secret after the rotation, unchanged when it failed
+	Version int `form:"version" json:"version" xml:"version"`
+	// Whether the rotation was scheduled, or requested by a user
+	Scheduled bool `form:"scheduled" json:"scheduled" xml:"scheduled"`
+	// Why the rotation failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Time of the rotation
+	RotatedAt string `form:"rotated_at" json:"rotated_at" xml:"rotated_at"`
+}
+
+// SecretDiffResponse is used to define fields on response body types.
+type SecretDiffResponse struct {
+	// Path of the secret relative to the compared folders
+	Key string `form:"key" json:"key" xml:"key"`
+	// missing when the secret is only in the source folder, extra when it is only
+	// in the destination folder, different or identical when the hashes of their
+	// values differ or not, inaccessible when you cannot read one of them
+	Status string `form:"status" json:"status" xml:"status"`
+}
+
+// StaleSecretResponse is used to define fields on response body types.
+type StaleSecretResponse struct {
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// Time the value of the secret was last read, unset when it was never read
+	LastReadAt *string `form:"last_read_at,omitempty" json:"last_read_at,omitempty" xml:"last_read_at,omitempty"`
+	// The user who last read the value of the secret
+	LastReader *UserResponse `form:"last_reader,omitempty" json:"last_reader,omitempty" xml:"last_reader,omitempty"`
+	// Creation timestamp of the secret
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// AccessRequestResponse is used to define fields on response body types.
+type AccessRequestResponse struct {
+	// Unique identifier of the access request
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who filed the request
+	Requester *UserResponse `form:"requester,omitempty" json:"requester,omitempty" xml:"requester,omitempty"`
+	// Capabilities requested on the secret
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Why the access is needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int `form:"duration,omitempty" json:"duration,omitempty" xml:"duration,omitempty"`
+	// Status of the request
+	Status string `form:"status" json:"status" xml:"status"`
+	// The user who approved or denied the request
+	DecidedBy *UserResponse `form:"decided_by,omitempty" json:"decided_by,omitempty" xml:"decided_by,omitempty"`
+	// Comment left with the decision
+	Comment *string `form:"comment,omitempty" json:"comment,omitempty" xml:"comment,omitempty"`
+	// Time of the decision
+	DecidedAt *string `form:"decided_at,omitempty" json:"decided_at,omitempty" xml:"decided_at,omitempty"`
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string `form:"grant_expires_at,omitempty" json:"grant_expires_at,omitempty" xml:"grant_expires_at,omitempty"`
+	// Time the request was filed
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ValidationRuleResponse is used to define fields on response body types.
+type ValidationRuleResponse struct {
+	// Unique identifier of the rule
+	ID int `form:"id" json:"id" xml:"id"`
+	// The folder path whose secrets the rule applies to, ends with a '/'
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// What the rule checks
+	Kind string `form:"kind" json:"kind" xml:"kind"`
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, empty for x509_certificate rules
+	Parameter string `form:"parameter" json:"parameter" xml:"parameter"`
+	// The only field of structured secrets the rule applies to, it applies to
+	// every field when absent
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Creation timestamp of the rule
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// BreakGlassRuleResponse is used to define fields on response body types.
+type BreakGlassRuleResponse struct {
+	// Unique identifier of the rule
+	ID int `form:"id" json:"id" xml:"id"`
+	// The folder path whose secrets are eligible to break-glass access, ends with
+	// a '/'
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// The only role whose members can break the glass, anyone can when absent
+	Role *RoleResponse `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Creation timestamp of the rule
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// BreakGlassAccessResponse is used to define fields on response body types.
+type BreakGlassAccessResponse struct {
+	// Unique identifier of the emergency access
+	ID int `form:"id" json:"id" xml:"id"`
+	// The original path of the secret
+	Path string `form:"path" json:"path" xml:"path"`
+	// The user who broke the glass
+	User *UserResponse `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// Why the emergency access was needed
+	Justification string `form:"justification" json:"justification" xml:"justification"`
+	// Time the read access given by breaking the glass lapses at
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+	// The user who acknowledged the emergency access
+	ReviewedBy *UserResponse `form:"reviewed_by,omitempty" json:"reviewed_by,omitempty" xml:"reviewed_by,omitempty"`
+	// Time of the acknowledgement
+	ReviewedAt *string `form:"reviewed_at,omitempty" json:"reviewed_at,omitempty" xml:"reviewed_at,omitempty"`
+	// Comment left with the acknowledgement
+	ReviewComment *string `form:"review_comment,omitempty" json:"review_comment,omitempty" xml:"review_comment,omitempty"`
+	// Time the glass was broken
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// FolderAccessResponse is used to define fields on response body types.
+type FolderAccessResponse struct {
+	// Unique identifier of the folder access
+	ID int `form:"id" json:"id" xml:"id"`
+	// The folder path the access applies to, ends with a '/'
+	PathPrefix string `form:"path_prefix" json:"path_prefix" xml:"path_prefix"`
+	// The user granted access to the folder
+	User *UserResponse `form:"user,omitempty" json:"user,omitempty" xml:"user,omitempty"`
+	// The role granted access to the folder
+	Role *RoleResponse `form:"role,omitempty" json:"role,omitempty" xml:"role,omitempty"`
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string `form:"capabilities" json:"capabilities" xml:"capabilities"`
+	// Creation timestamp of the folder access
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// SecretValueRequestRequestBody is used to define fields on request body types.
+type SecretValueRequestRequestBody struct {
+	// Base64 encoded secret's path
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// Name of the field to retrieve from a structured secret
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+}
+
+// GrantRequestBody is used to define fields on request body types.
+type GrantRequestBody struct {
+	// ID of the user or role the capabilities are granted to
+	ID *int `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Capabilities granted on the secret
+	Capabilities []string `form:"capabilities,omitempty" json:"capabilities,omitempty" xml:"capabilities,omitempty"`
+	// Time the grant lapses at, it never does when absent
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+	// Seconds left before the grant lapses, only set in responses
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
+// NewListSecretsResponseBody builds the HTTP response body from the result of
+// the "list secrets" endpoint of the "secrets" service.
+func NewListSecretsResponseBody(res []*secrets.SecretInfoSummary) ListSecretsResponseBody {
+	body := make([]*SecretInfoSummaryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsSecretInfoSummaryToSecretInfoSummaryResponse(val)
+	}
+	return body
+}
+
+// NewBrowseSecretsResponseBody builds the HTTP response body from the result
+// of the "browse secrets" endpoint of the "secrets" service.
+func NewBrowseSecretsResponseBody(res *secrets.BrowseSecretsResult) *BrowseSecretsResponseBody {
+	body := &BrowseSecretsResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Entries != nil {
+		body.Entries = make([]*SecretEntryResponseBody, len(res.Entries))
+		for i, val := range res.Entries {
+			body.Entries[i] = marshalSecretsSecretEntryToSecretEntryResponseBody(val)
+		}
+	} else {
+		body.Entries = []*SecretEntryResponseBody{}
+	}
+	return body
+}
+
+// NewGetSecretValueResponseBody builds the HTTP response body from the result
+// of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueResponseBody(res *secrets.GetSecretValueResult) *GetSecretValueResponseBody {
+	body := &GetSecretValueResponseBody{
+		Value:               res.Value,
+		Path:                res.Path,
+		BreakGlassExpiresAt: res.BreakGlassExpiresAt,
+	}
+	if res.Fields != nil {
+		body.Fields = make(map[string]string, len(res.Fields))
+		for key, val := range res.Fields {
+			tk := key
+			tv := val
+			body.Fields[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewBatchGetSecretValuesResponseBody builds the HTTP response body from the
+// result of the "batch get secret values" endpoint of the "secrets" service.
+func NewBatchGetSecretValuesResponseBody(res *secrets.BatchGetSecretValuesResult) *BatchGetSecretValuesResponseBody {
+	body := &BatchGetSecretValuesResponseBody{}
+	if res.Secrets != nil {
+		body.Secrets = make([]*SecretValueResponseBody, len(res.Secrets))
+		for i, val := range res.Secrets {
+			body.Secrets[i] = marshalSecretsSecretValueToSecretValueResponseBody(val)
+		}
+	} else {
+		body.Secrets = []*SecretValueResponseBody{}
+	}
+	return body
+}
+
+// NewRenderTemplateResponseBody builds the HTTP response body from the result
+// of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateResponseBody(res *secrets.RenderTemplateResult) *RenderTemplateResponseBody {
+	body := &RenderTemplateResponseBody{
+		Rendered: res.Rendered,
+	}
+	return body
+}
+
+// NewImportSecretsResponseBody builds the HTTP response body from the result
+// of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsResponseBody(res []*secrets.ImportedSecret) ImportSecretsResponseBody {
+	body := make([]*ImportedSecretResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsImportedSecretToImportedSecretResponse(val)
+	}
+	return body
+}
+
+// NewGetSecretResponseBody builds the HTTP response body from the result of
+// the "get secret" endpoint of the "secrets" service.
+func NewGetSecretResponseBody(res *secrets.SecretInfo) *GetSecretResponseBody {
+	body := &GetSecretResponseBody{
+		Path:             res.Path,
+		Structured:       res.Structured,
+		Version:          res.Version,
+		GenerationPolicy: res.GenerationPolicy,
+		RotationSchedule: res.RotationSchedule,
+		NextRotationAt:   res.NextRotationAt,
+		LastReadAt:       res.LastReadAt,
+		RecentReads:      res.RecentReads,
+		CreatedAt:        res.CreatedAt,
+		UpdatedAt:        res.UpdatedAt,
+	}
+	if res.Owner != nil {
+		body.Owner = marshalSecretsUserToUserResponseBody(res.Owner)
+	}
+	if res.OwnerRole != nil {
+		body.OwnerRole = marshalSecretsRoleToRoleResponseBody(res.OwnerRole)
+	}
+	if res.AuthorizedUsers != nil {
+		body.AuthorizedUsers = make([]*UserResponseBody, len(res.AuthorizedUsers))
+		for i, val := range res.AuthorizedUsers {
+			body.AuthorizedUsers[i] = marshalSecretsUserToUserResponseBody(val)
+		}
+	} else {
+		body.AuthorizedUsers = []*UserResponseBody{}
+	}
+	if res.AuthorizedRoles != nil {
+		body.AuthorizedRoles = make([]*RoleResponseBody, len(res.AuthorizedRoles))
+		for i, val := range res.AuthorizedRoles {
+			body.AuthorizedRoles[i] = marshalSecretsRoleToRoleResponseBody(val)
+		}
+	} else {
+		body.AuthorizedRoles = []*RoleResponseBody{}
+	}
+	if res.UserGrants != nil {
+		body.UserGrants = make([]*GrantResponseBody, len(res.UserGrants))
+		for i, val := range res.UserGrants {
+			body.UserGrants[i] = marshalSecretsGrantToGrantResponseBody(val)
+		}
+	} else {
+		body.UserGrants = []*GrantResponseBody{}
+	}
+	if res.RoleGrants != nil {
+		body.RoleGrants = make([]*GrantResponseBody, len(res.RoleGrants))
+		for i, val := range res.RoleGrants {
+			body.RoleGrants[i] = marshalSecretsGrantToGrantResponseBody(val)
+		}
+	} else {
+		body.RoleGrants = []*GrantResponseBody{}
+	}
+	if res.Capabilities != nil {
+		body.Capabilities = make([]string, len(res.Capabilities))
+		for i, val := range res.Capabilities {
+			body.Capabilities[i] = val
+		}
+	} else {
+		body.Capabilities = []string{}
+	}
+	if res.Fields != nil {
+		body.Fields = make([]string, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = val
+		}
+	}
+	if res.LastReader != nil {
+		body.LastReader = marshalSecretsUserToUserResponseBody(res.LastReader)
+	}
+	return body
+}
+
+// NewRegenerateSecretResponseBody builds the HTTP response body from the
+// result of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretResponseBody(res *secrets.RegenerateSecretResult) *RegenerateSecretResponseBody {
+	body := &RegenerateSecretResponseBody{
+		Version: res.Version,
+	}
+	return body
+}
+
+// NewSetSecretRotationResponseBody builds the HTTP response body from the
+// result of the "set secret rotation" endpoint of the "secrets" service.
+func NewSetSecretRotationResponseBody(res *secrets.SetSecretRotationResult) *SetSecretRotationResponseBody {
+	body := &SetSecretRotationResponseBody{
+		NextRotationAt: res.NextRotationAt,
+	}
+	return body
+}
+
+// NewListSecretRotationsResponseBody builds the HTTP response body from the
+// result of the "list secret rotations" endpoint of the "secrets" service.
+func NewListSecretRotationsResponseBody(res []*secrets.SecretRotation) ListSecretRotationsResponseBody {
+	body := make([]*SecretRotationResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsSecretRotationToSecretRotationResponse(val)
+	}
+	return body
+}
+
+// NewDiffSecretsResponseBody builds the HTTP response body from the result of
+// the "diff secrets" endpoint of the "secrets" service.
+func NewDiffSecretsResponseBody(res []*secrets.SecretDiff) DiffSecretsResponseBody {
+	body := make([]*SecretDiffResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsSecretDiffToSecretDiffResponse(val)
+	}
+	return body
+}
+
+// NewListStaleSecretsResponseBody builds the HTTP response body from the
+// result of the "list stale secrets" endpoint of the "secrets" service.
+func NewListStaleSecretsResponseBody(res []*secrets.StaleSecret) ListStaleSecretsResponseBody {
+	body := make([]*StaleSecretResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsStaleSecretToStaleSecretResponse(val)
+	}
+	return body
+}
+
+// NewWrapSecretResponseBody builds the HTTP response body from the result of
+// the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretResponseBody(res *secrets.WrapSecretResult) *WrapSecretResponseBody {
+	body := &WrapSecretResponseBody{
+		Token:     res.Token,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return body
+}
+
+// NewUnwrapSecretResponseBody builds the HTTP response body from the result of
+// the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretResponseBody(res *secrets.UnwrapSecretResult) *UnwrapSecretResponseBody {
+	body := &UnwrapSecretResponseBody{
+		Value:     res.Value,
+		Path:      res.Path,
+		WrappedAt: res.WrappedAt,
+	}
+	if res.Fields != nil {
+		body.Fields = make(map[string]string, len(res.Fields))
+		for key, val := range res.Fields {
+			tk := key
+			tv := val
+			body.Fields[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewRequestAccessResponseBody builds the HTTP response body from the result
+// of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessResponseBody(res *secrets.AccessRequest) *RequestAccessResponseBody {
+	body := &RequestAccessResponseBody{
+		ID:             res.ID,
+		Path:           res.Path,
+		Justification:  res.Justification,
+		Duration:       res.Duration,
+		Status:         res.Status,
+		Comment:        res.Comment,
+		DecidedAt:      res.DecidedAt,
+		GrantExpiresAt: res.GrantExpiresAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.Requester != nil {
+		body.Requester = marshalSecretsUserToUserResponseBody(res.Requester)
+	}
+	if res.Capabilities != nil {
+		body.Capabilities = make([]string, len(res.Capabilities))
+		for i, val := range res.Capabilities {
+			body.Capabilities[i] = val
+		}
+	} else {
+		body.Capabilities = []string{}
+	}
+	if res.DecidedBy != nil {
+		body.DecidedBy = marshalSecretsUserToUserResponseBody(res.DecidedBy)
+	}
+	return body
+}
+
+// NewListAccessRequestsResponseBody builds the HTTP response body from the
+// result of the "list access requests" endpoint of the "secrets" service.
+func NewListAccessRequestsResponseBody(res []*secrets.AccessRequest) ListAccessRequestsResponseBody {
+	body := make([]*AccessRequestResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsAccessRequestToAccessRequestResponse(val)
+	}
+	return body
+}
+
+// NewApproveAccessRequestResponseBody builds the HTTP response body from the
+// result of the "approve access request" endpoint of the "secrets" service.
+func NewApproveAccessRequestResponseBody(res *secrets.AccessRequest) *ApproveAccessRequestResponseBody {
+	body := &ApproveAccessRequestResponseBody{
+		ID:             res.ID,
+		Path:           res.Path,
+		Justification:  res.Justification,
+		Duration:       res.Duration,
+		Status:         res.Status,
+		Comment:        res.Comment,
+		DecidedAt:      res.DecidedAt,
+		GrantExpiresAt: res.GrantExpiresAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.Requester != nil {
+		body.Requester = marshalSecretsUserToUserResponseBody(res.Requester)
+	}
+	if res.Capabilities != nil {
+		body.Capabilities = make([]string, len(res.Capabilities))
+		for i, val := range res.Capabilities {
+			body.Capabilities[i] = val
+		}
+	} else {
+		body.Capabilities = []string{}
+	}
+	if res.DecidedBy != nil {
+		body.DecidedBy = marshalSecretsUserToUserResponseBody(res.DecidedBy)
+	}
+	return body
+}
+
+// NewDenyAccessRequestResponseBody builds the HTTP response body from the
+// result of the "deny access request" endpoint of the "secrets" service.
+func NewDenyAccessRequestResponseBody(res *secrets.AccessRequest) *DenyAccessRequestResponseBody {
+	body := &DenyAccessRequestResponseBody{
+		ID:             res.ID,
+		Path:           res.Path,
+		Justification:  res.Justification,
+		Duration:       res.Duration,
+		Status:         res.Status,
+		Comment:        res.Comment,
+		DecidedAt:      res.DecidedAt,
+		GrantExpiresAt: res.GrantExpiresAt,
+		CreatedAt:      res.CreatedAt,
+	}
+	if res.Requester != nil {
+		body.Requester = marshalSecretsUserToUserResponseBody(res.Requester)
+	}
+	if res.Capabilities != nil {
+		body.Capabilities = make([]string, len(res.Capabilities))
+		for i, val := range res.Capabilities {
+			body.Capabilities[i] = val
+		}
+	} else {
+		body.Capabilities = []string{}
+	}
+	if res.DecidedBy != nil {
+		body.DecidedBy = marshalSecretsUserToUserResponseBody(res.DecidedBy)
+	}
+	return body
+}
+
+// NewListValidationRulesResponseBody builds the HTTP response body from the
+// result of the "list validation rules" endpoint of the "secrets" service.
+func NewListValidationRulesResponseBody(res []*secrets.ValidationRule) ListValidationRulesResponseBody {
+	body := make([]*ValidationRuleResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsValidationRuleToValidationRuleResponse(val)
+	}
+	return body
+}
+
+// NewListBreakGlassRulesResponseBody builds the HTTP response body from the
+// result of the "list break glass rules" endpoint of the "secrets" service.
+func NewListBreakGlassRulesResponseBody(res []*secrets.BreakGlassRule) ListBreakGlassRulesResponseBody {
+	body := make([]*BreakGlassRuleResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsBreakGlassRuleToBreakGlassRuleResponse(val)
+	}
+	return body
+}
+
+// NewListBreakGlassAccessesResponseBody builds the HTTP response body from the
+// result of the "list break glass accesses" endpoint of the "secrets" service.
+func NewListBreakGlassAccessesResponseBody(res []*secrets.BreakGlassAccess) ListBreakGlassAccessesResponseBody {
+	body := make([]*BreakGlassAccessResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsBreakGlassAccessToBreakGlassAccessResponse(val)
+	}
+	return body
+}
+
+// NewReviewBreakGlassAccessResponseBody builds the HTTP response body from the
+// result of the "review break glass access" endpoint of the "secrets" service.
+func NewReviewBreakGlassAccessResponseBody(res *secrets.BreakGlassAccess) *ReviewBreakGlassAccessResponseBody {
+	body := &ReviewBreakGlassAccessResponseBody{
+		ID:            res.ID,
+		Path:          res.Path,
+		Justification: res.Justification,
+		ExpiresAt:     res.ExpiresAt,
+		ReviewedAt:    res.ReviewedAt,
+		ReviewComment: res.ReviewComment,
+		CreatedAt:     res.CreatedAt,
+	}
+	if res.User != nil {
+		body.User = marshalSecretsUserToUserResponseBody(res.User)
+	}
+	if res.ReviewedBy != nil {
+		body.ReviewedBy = marshalSecretsUserToUserResponseBody(res.ReviewedBy)
+	}
+	return body
+}
+
+// NewListFolderAccessesResponseBody builds the HTTP response body from the
+// result of the "list folder accesses" endpoint of the "secrets" service.
+func NewListFolderAccessesResponseBody(res []*secrets.FolderAccess) ListFolderAccessesResponseBody {
+	body := make([]*FolderAccessResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalSecretsFolderAccessToFolderAccessResponse(val)
+	}
+	return body
+}
+
+// NewListSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list secrets" endpoint of the "secrets" service.
+func NewListSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ListSecretsUnauthorizedResponseBody {
+	body := &ListSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "list secrets" endpoint of the "secrets" service.
+func NewListSecretsForbiddenResponseBody(res *goa.ServiceError) *ListSecretsForbiddenResponseBody {
+	body := &ListSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "list secrets" endpoint of the "secrets" service.
+func NewListSecretsInternalErrorResponseBody(res *goa.ServiceError) *ListSecretsInternalErrorResponseBody {
+	body := &ListSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBrowseSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "browse secrets" endpoint of the "secrets" service.
+func NewBrowseSecretsInvalidParametersResponseBody(res *goa.ServiceError) *BrowseSecretsInvalidParametersResponseBody {
+	body := &BrowseSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBrowseSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "browse secrets" endpoint of the "secrets" service.
+func NewBrowseSecretsUnauthorizedResponseBody(res *goa.ServiceError) *BrowseSecretsUnauthorizedResponseBody {
+	body := &BrowseSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBrowseSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "browse secrets" endpoint of the "secrets" service.
+func NewBrowseSecretsForbiddenResponseBody(res *goa.ServiceError) *BrowseSecretsForbiddenResponseBody {
+	body := &BrowseSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBrowseSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "browse secrets" endpoint of the "secrets" service.
+func NewBrowseSecretsInternalErrorResponseBody(res *goa.ServiceError) *BrowseSecretsInternalErrorResponseBody {
+	body := &BrowseSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecretValueSecretNotFoundResponseBody {
+	body := &GetSecretValueSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueFieldNotFoundResponseBody builds the HTTP response body
+// from the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueFieldNotFoundResponseBody(res *goa.ServiceError) *GetSecretValueFieldNotFoundResponseBody {
+	body := &GetSecretValueFieldNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueInvalidParametersResponseBody(res *goa.ServiceError) *GetSecretValueInvalidParametersResponseBody {
+	body := &GetSecretValueInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueUnauthorizedResponseBody(res *goa.ServiceError) *GetSecretValueUnauthorizedResponseBody {
+	body := &GetSecretValueUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueForbiddenResponseBody builds the HTTP response body from
+// the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueForbiddenResponseBody(res *goa.ServiceError) *GetSecretValueForbiddenResponseBody {
+	body := &GetSecretValueForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretValueInternalErrorResponseBody builds the HTTP response body
+// from the result of the "get secret value" endpoint of the "secrets" service.
+func NewGetSecretValueInternalErrorResponseBody(res *goa.ServiceError) *GetSecretValueInternalErrorResponseBody {
+	body := &GetSecretValueInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBatchGetSecretValuesInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "batch get secret values" endpoint of
+// the "secrets" service.
+func NewBatchGetSecretValuesInvalidParametersResponseBody(res *goa.ServiceError) *BatchGetSecretValuesInvalidParametersResponseBody {
+	body := &BatchGetSecretValuesInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBatchGetSecretValuesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "batch get secret values" endpoint of the
+// "secrets" service.
+func NewBatchGetSecretValuesUnauthorizedResponseBody(res *goa.ServiceError) *BatchGetSecretValuesUnauthorizedResponseBody {
+	body := &BatchGetSecretValuesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBatchGetSecretValuesForbiddenResponseBody builds the HTTP response body
+// from the result of the "batch get secret values" endpoint of the "secrets"
+// service.
+func NewBatchGetSecretValuesForbiddenResponseBody(res *goa.ServiceError) *BatchGetSecretValuesForbiddenResponseBody {
+	body := &BatchGetSecretValuesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewBatchGetSecretValuesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "batch get secret values" endpoint of the
+// "secrets" service.
+func NewBatchGetSecretValuesInternalErrorResponseBody(res *goa.ServiceError) *BatchGetSecretValuesInternalErrorResponseBody {
+	body := &BatchGetSecretValuesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateSecretNotFoundResponseBody(res *goa.ServiceError) *RenderTemplateSecretNotFoundResponseBody {
+	body := &RenderTemplateSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateFieldNotFoundResponseBody builds the HTTP response body
+// from the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateFieldNotFoundResponseBody(res *goa.ServiceError) *RenderTemplateFieldNotFoundResponseBody {
+	body := &RenderTemplateFieldNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateInvalidParametersResponseBody(res *goa.ServiceError) *RenderTemplateInvalidParametersResponseBody {
+	body := &RenderTemplateInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateUnauthorizedResponseBody(res *goa.ServiceError) *RenderTemplateUnauthorizedResponseBody {
+	body := &RenderTemplateUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateForbiddenResponseBody builds the HTTP response body from
+// the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateForbiddenResponseBody(res *goa.ServiceError) *RenderTemplateForbiddenResponseBody {
+	body := &RenderTemplateForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRenderTemplateInternalErrorResponseBody builds the HTTP response body
+// from the result of the "render template" endpoint of the "secrets" service.
+func NewRenderTemplateInternalErrorResponseBody(res *goa.ServiceError) *RenderTemplateInternalErrorResponseBody {
+	body := &RenderTemplateInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSecretFileSecretTooLargeResponseBody builds the HTTP response body
+// from the result of the "upload secret file" endpoint of the "secrets"
+// service.
+func NewUploadSecretFileSecretTooLargeResponseBody(res *goa.ServiceError) *UploadSecretFileSecretTooLargeResponseBody {
+	body := &UploadSecretFileSecretTooLargeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSecretFileInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "upload secret file" endpoint of the "secrets"
+// service.
+func NewUploadSecretFileInvalidParametersResponseBody(res *goa.ServiceError) *UploadSecretFileInvalidParametersResponseBody {
+	body := &UploadSecretFileInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSecretFileUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "upload secret file" endpoint of the "secrets"
+// service.
+func NewUploadSecretFileUnauthorizedResponseBody(res *goa.ServiceError) *UploadSecretFileUnauthorizedResponseBody {
+	body := &UploadSecretFileUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSecretFileForbiddenResponseBody builds the HTTP response body from
+// the result of the "upload secret file" endpoint of the "secrets" service.
+func NewUploadSecretFileForbiddenResponseBody(res *goa.ServiceError) *UploadSecretFileForbiddenResponseBody {
+	body := &UploadSecretFileForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUploadSecretFileInternalErrorResponseBody builds the HTTP response body
+// from the result of the "upload secret file" endpoint of the "secrets"
+// service.
+func NewUploadSecretFileInternalErrorResponseBody(res *goa.ServiceError) *UploadSecretFileInternalErrorResponseBody {
+	body := &UploadSecretFileInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSecretFileSecretNotFoundResponseBody builds the HTTP response
+// body from the result of the "download secret file" endpoint of the "secrets"
+// service.
+func NewDownloadSecretFileSecretNotFoundResponseBody(res *goa.ServiceError) *DownloadSecretFileSecretNotFoundResponseBody {
+	body := &DownloadSecretFileSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSecretFileInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "download secret file" endpoint of the "secrets"
+// service.
+func NewDownloadSecretFileInvalidParametersResponseBody(res *goa.ServiceError) *DownloadSecretFileInvalidParametersResponseBody {
+	body := &DownloadSecretFileInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSecretFileUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "download secret file" endpoint of the "secrets"
+// service.
+func NewDownloadSecretFileUnauthorizedResponseBody(res *goa.ServiceError) *DownloadSecretFileUnauthorizedResponseBody {
+	body := &DownloadSecretFileUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSecretFileForbiddenResponseBody builds the HTTP response body
+// from the result of the "download secret file" endpoint of the "secrets"
+// service.
+func NewDownloadSecretFileForbiddenResponseBody(res *goa.ServiceError) *DownloadSecretFileForbiddenResponseBody {
+	body := &DownloadSecretFileForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDownloadSecretFileInternalErrorResponseBody builds the HTTP response body
+// from the result of the "download secret file" endpoint of the "secrets"
+// service.
+func NewDownloadSecretFileInternalErrorResponseBody(res *goa.ServiceError) *DownloadSecretFileInternalErrorResponseBody {
+	body := &DownloadSecretFileInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsSecretNotFoundResponseBody(res *goa.ServiceError) *ExportSecretsSecretNotFoundResponseBody {
+	body := &ExportSecretsSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsFieldNotFoundResponseBody builds the HTTP response body from
+// the result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsFieldNotFoundResponseBody(res *goa.ServiceError) *ExportSecretsFieldNotFoundResponseBody {
+	body := &ExportSecretsFieldNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsInvalidParametersResponseBody(res *goa.ServiceError) *ExportSecretsInvalidParametersResponseBody {
+	body := &ExportSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ExportSecretsUnauthorizedResponseBody {
+	body := &ExportSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsForbiddenResponseBody(res *goa.ServiceError) *ExportSecretsForbiddenResponseBody {
+	body := &ExportSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewExportSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "export secrets" endpoint of the "secrets" service.
+func NewExportSecretsInternalErrorResponseBody(res *goa.ServiceError) *ExportSecretsInternalErrorResponseBody {
+	body := &ExportSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsSecretAlreadyExistsResponseBody builds the HTTP response
+// body from the result of the "import secrets" endpoint of the "secrets"
+// service.
+func NewImportSecretsSecretAlreadyExistsResponseBody(res *goa.ServiceError) *ImportSecretsSecretAlreadyExistsResponseBody {
+	body := &ImportSecretsSecretAlreadyExistsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsSecretTooLargeResponseBody builds the HTTP response body
+// from the result of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsSecretTooLargeResponseBody(res *goa.ServiceError) *ImportSecretsSecretTooLargeResponseBody {
+	body := &ImportSecretsSecretTooLargeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsInvalidParametersResponseBody(res *goa.ServiceError) *ImportSecretsInvalidParametersResponseBody {
+	body := &ImportSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ImportSecretsUnauthorizedResponseBody {
+	body := &ImportSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsForbiddenResponseBody(res *goa.ServiceError) *ImportSecretsForbiddenResponseBody {
+	body := &ImportSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewImportSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "import secrets" endpoint of the "secrets" service.
+func NewImportSecretsInternalErrorResponseBody(res *goa.ServiceError) *ImportSecretsInternalErrorResponseBody {
+	body := &ImportSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretSecretNotFoundResponseBody builds the HTTP response body from
+// the result of the "get secret" endpoint of the "secrets" service.
+func NewGetSecretSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecretSecretNotFoundResponseBody {
+	body := &GetSecretSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretInvalidParametersResponseBody builds the HTTP response body from
+// the result of the "get secret" endpoint of the "secrets" service.
+func NewGetSecretInvalidParametersResponseBody(res *goa.ServiceError) *GetSecretInvalidParametersResponseBody {
+	body := &GetSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get secret" endpoint of the "secrets" service.
+func NewGetSecretUnauthorizedResponseBody(res *goa.ServiceError) *GetSecretUnauthorizedResponseBody {
+	body := &GetSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretForbiddenResponseBody builds the HTTP response body from the
+// result of the "get secret" endpoint of the "secrets" service.
+func NewGetSecretForbiddenResponseBody(res *goa.ServiceError) *GetSecretForbiddenResponseBody {
+	body := &GetSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewGetSecretInternalErrorResponseBody builds the HTTP response body from the
+// result of the "get secret" endpoint of the "secrets" service.
+func NewGetSecretInternalErrorResponseBody(res *goa.ServiceError) *GetSecretInternalErrorResponseBody {
+	body := &GetSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateSecretSecretTooLargeResponseBody builds the HTTP response body from
+// the result of the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretSecretTooLargeResponseBody(res *goa.ServiceError) *CreateSecretSecretTooLargeResponseBody {
+	body := &CreateSecretSecretTooLargeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateSecretInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretInvalidParametersResponseBody(res *goa.ServiceError) *CreateSecretInvalidParametersResponseBody {
+	body := &CreateSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateSecretUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretUnauthorizedResponseBody(res *goa.ServiceError) *CreateSecretUnauthorizedResponseBody {
+	body := &CreateSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateSecretForbiddenResponseBody builds the HTTP response body from the
+// result of the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretForbiddenResponseBody(res *goa.ServiceError) *CreateSecretForbiddenResponseBody {
+	body := &CreateSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateSecretInternalErrorResponseBody builds the HTTP response body from
+// the result of the "create secret" endpoint of the "secrets" service.
+func NewCreateSecretInternalErrorResponseBody(res *goa.ServiceError) *CreateSecretInternalErrorResponseBody {
+	body := &CreateSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretSecretNotFoundResponseBody builds the HTTP response body from
+// the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretSecretNotFoundResponseBody(res *goa.ServiceError) *UpdateSecretSecretNotFoundResponseBody {
+	body := &UpdateSecretSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretFieldNotFoundResponseBody builds the HTTP response body from
+// the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretFieldNotFoundResponseBody(res *goa.ServiceError) *UpdateSecretFieldNotFoundResponseBody {
+	body := &UpdateSecretFieldNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretSecretTooLargeResponseBody builds the HTTP response body from
+// the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretSecretTooLargeResponseBody(res *goa.ServiceError) *UpdateSecretSecretTooLargeResponseBody {
+	body := &UpdateSecretSecretTooLargeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretInvalidParametersResponseBody(res *goa.ServiceError) *UpdateSecretInvalidParametersResponseBody {
+	body := &UpdateSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretUnauthorizedResponseBody(res *goa.ServiceError) *UpdateSecretUnauthorizedResponseBody {
+	body := &UpdateSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretForbiddenResponseBody builds the HTTP response body from the
+// result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretForbiddenResponseBody(res *goa.ServiceError) *UpdateSecretForbiddenResponseBody {
+	body := &UpdateSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateSecretInternalErrorResponseBody builds the HTTP response body from
+// the result of the "update secret" endpoint of the "secrets" service.
+func NewUpdateSecretInternalErrorResponseBody(res *goa.ServiceError) *UpdateSecretInternalErrorResponseBody {
+	body := &UpdateSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRegenerateSecretSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretSecretNotFoundResponseBody(res *goa.ServiceError) *RegenerateSecretSecretNotFoundResponseBody {
+	body := &RegenerateSecretSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRegenerateSecretInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "regenerate secret" endpoint of the "secrets"
+// service.
+func NewRegenerateSecretInvalidParametersResponseBody(res *goa.ServiceError) *RegenerateSecretInvalidParametersResponseBody {
+	body := &RegenerateSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRegenerateSecretUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretUnauthorizedResponseBody(res *goa.ServiceError) *RegenerateSecretUnauthorizedResponseBody {
+	body := &RegenerateSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRegenerateSecretForbiddenResponseBody builds the HTTP response body from
+// the result of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretForbiddenResponseBody(res *goa.ServiceError) *RegenerateSecretForbiddenResponseBody {
+	body := &RegenerateSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRegenerateSecretInternalErrorResponseBody builds the HTTP response body
+// from the result of the "regenerate secret" endpoint of the "secrets" service.
+func NewRegenerateSecretInternalErrorResponseBody(res *goa.ServiceError) *RegenerateSecretInternalErrorResponseBody {
+	body := &RegenerateSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretRotationSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "set secret rotation" endpoint of the "secrets"
+// service.
+func NewSetSecretRotationSecretNotFoundResponseBody(res *goa.ServiceError) *SetSecretRotationSecretNotFoundResponseBody {
+	body := &SetSecretRotationSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretRotationInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "set secret rotation" endpoint of the "secrets"
+// service.
+func NewSetSecretRotationInvalidParametersResponseBody(res *goa.ServiceError) *SetSecretRotationInvalidParametersResponseBody {
+	body := &SetSecretRotationInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretRotationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "set secret rotation" endpoint of the "secrets"
+// service.
+func NewSetSecretRotationUnauthorizedResponseBody(res *goa.ServiceError) *SetSecretRotationUnauthorizedResponseBody {
+	body := &SetSecretRotationUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretRotationForbiddenResponseBody builds the HTTP response body from
+// the result of the "set secret rotation" endpoint of the "secrets" service.
+func NewSetSecretRotationForbiddenResponseBody(res *goa.ServiceError) *SetSecretRotationForbiddenResponseBody {
+	body := &SetSecretRotationForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretRotationInternalErrorResponseBody builds the HTTP response body
+// from the result of the "set secret rotation" endpoint of the "secrets"
+// service.
+func NewSetSecretRotationInternalErrorResponseBody(res *goa.ServiceError) *SetSecretRotationInternalErrorResponseBody {
+	body := &SetSecretRotationInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretRotationsSecretNotFoundResponseBody builds the HTTP response
+// body from the result of the "list secret rotations" endpoint of the
+// "secrets" service.
+func NewListSecretRotationsSecretNotFoundResponseBody(res *goa.ServiceError) *ListSecretRotationsSecretNotFoundResponseBody {
+	body := &ListSecretRotationsSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretRotationsInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "list secret rotations" endpoint of the
+// "secrets" service.
+func NewListSecretRotationsInvalidParametersResponseBody(res *goa.ServiceError) *ListSecretRotationsInvalidParametersResponseBody {
+	body := &ListSecretRotationsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretRotationsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list secret rotations" endpoint of the "secrets"
+// service.
+func NewListSecretRotationsUnauthorizedResponseBody(res *goa.ServiceError) *ListSecretRotationsUnauthorizedResponseBody {
+	body := &ListSecretRotationsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretRotationsForbiddenResponseBody builds the HTTP response body
+// from the result of the "list secret rotations" endpoint of the "secrets"
+// service.
+func NewListSecretRotationsForbiddenResponseBody(res *goa.ServiceError) *ListSecretRotationsForbiddenResponseBody {
+	body := &ListSecretRotationsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListSecretRotationsInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list secret rotations" endpoint of the
+// "secrets" service.
+func NewListSecretRotationsInternalErrorResponseBody(res *goa.ServiceError) *ListSecretRotationsInternalErrorResponseBody {
+	body := &ListSecretRotationsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSecretSecretNotFoundResponseBody builds the HTTP response body from
+// the result of the "delete secret" endpoint of the "secrets" service.
+func NewDeleteSecretSecretNotFoundResponseBody(res *goa.ServiceError) *DeleteSecretSecretNotFoundResponseBody {
+	body := &DeleteSecretSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSecretInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "delete secret" endpoint of the "secrets" service.
+func NewDeleteSecretInvalidParametersResponseBody(res *goa.ServiceError) *DeleteSecretInvalidParametersResponseBody {
+	body := &DeleteSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSecretUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete secret" endpoint of the "secrets" service.
+func NewDeleteSecretUnauthorizedResponseBody(res *goa.ServiceError) *DeleteSecretUnauthorizedResponseBody {
+	body := &DeleteSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSecretForbiddenResponseBody builds the HTTP response body from the
+// result of the "delete secret" endpoint of the "secrets" service.
+func NewDeleteSecretForbiddenResponseBody(res *goa.ServiceError) *DeleteSecretForbiddenResponseBody {
+	body := &DeleteSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteSecretInternalErrorResponseBody builds the HTTP response body from
+// the result of the "delete secret" endpoint of the "secrets" service.
+func NewDeleteSecretInternalErrorResponseBody(res *goa.ServiceError) *DeleteSecretInternalErrorResponseBody {
+	body := &DeleteSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsSecretNotFoundResponseBody builds the HTTP response body from
+// the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsSecretNotFoundResponseBody(res *goa.ServiceError) *MoveSecretsSecretNotFoundResponseBody {
+	body := &MoveSecretsSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsSecretAlreadyExistsResponseBody builds the HTTP response body
+// from the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsSecretAlreadyExistsResponseBody(res *goa.ServiceError) *MoveSecretsSecretAlreadyExistsResponseBody {
+	body := &MoveSecretsSecretAlreadyExistsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsFolderChangedResponseBody builds the HTTP response body from
+// the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsFolderChangedResponseBody(res *goa.ServiceError) *MoveSecretsFolderChangedResponseBody {
+	body := &MoveSecretsFolderChangedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsInvalidParametersResponseBody(res *goa.ServiceError) *MoveSecretsInvalidParametersResponseBody {
+	body := &MoveSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsUnauthorizedResponseBody(res *goa.ServiceError) *MoveSecretsUnauthorizedResponseBody {
+	body := &MoveSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsForbiddenResponseBody(res *goa.ServiceError) *MoveSecretsForbiddenResponseBody {
+	body := &MoveSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewMoveSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "move secrets" endpoint of the "secrets" service.
+func NewMoveSecretsInternalErrorResponseBody(res *goa.ServiceError) *MoveSecretsInternalErrorResponseBody {
+	body := &MoveSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "diff secrets" endpoint of the "secrets" service.
+func NewDiffSecretsInvalidParametersResponseBody(res *goa.ServiceError) *DiffSecretsInvalidParametersResponseBody {
+	body := &DiffSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "diff secrets" endpoint of the "secrets" service.
+func NewDiffSecretsUnauthorizedResponseBody(res *goa.ServiceError) *DiffSecretsUnauthorizedResponseBody {
+	body := &DiffSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffSecretsForbiddenResponseBody builds the HTTP response body from the
+// result of the "diff secrets" endpoint of the "secrets" service.
+func NewDiffSecretsForbiddenResponseBody(res *goa.ServiceError) *DiffSecretsForbiddenResponseBody {
+	body := &DiffSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDiffSecretsInternalErrorResponseBody builds the HTTP response body from
+// the result of the "diff secrets" endpoint of the "secrets" service.
+func NewDiffSecretsInternalErrorResponseBody(res *goa.ServiceError) *DiffSecretsInternalErrorResponseBody {
+	body := &DiffSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsSecretNotFoundResponseBody(res *goa.ServiceError) *PromoteSecretsSecretNotFoundResponseBody {
+	body := &PromoteSecretsSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsSecretAlreadyExistsResponseBody builds the HTTP response
+// body from the result of the "promote secrets" endpoint of the "secrets"
+// service.
+func NewPromoteSecretsSecretAlreadyExistsResponseBody(res *goa.ServiceError) *PromoteSecretsSecretAlreadyExistsResponseBody {
+	body := &PromoteSecretsSecretAlreadyExistsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsInvalidParametersResponseBody(res *goa.ServiceError) *PromoteSecretsInvalidParametersResponseBody {
+	body := &PromoteSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsUnauthorizedResponseBody(res *goa.ServiceError) *PromoteSecretsUnauthorizedResponseBody {
+	body := &PromoteSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsForbiddenResponseBody builds the HTTP response body from
+// the result of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsForbiddenResponseBody(res *goa.ServiceError) *PromoteSecretsForbiddenResponseBody {
+	body := &PromoteSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewPromoteSecretsInternalErrorResponseBody builds the HTTP response body
+// from the result of the "promote secrets" endpoint of the "secrets" service.
+func NewPromoteSecretsInternalErrorResponseBody(res *goa.ServiceError) *PromoteSecretsInternalErrorResponseBody {
+	body := &PromoteSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipSecretNotFoundResponseBody builds the HTTP
+// response body from the result of the "transfer secret ownership" endpoint of
+// the "secrets" service.
+func NewTransferSecretOwnershipSecretNotFoundResponseBody(res *goa.ServiceError) *TransferSecretOwnershipSecretNotFoundResponseBody {
+	body := &TransferSecretOwnershipSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "transfer secret ownership" endpoint of
+// the "secrets" service.
+func NewTransferSecretOwnershipInvalidParametersResponseBody(res *goa.ServiceError) *TransferSecretOwnershipInvalidParametersResponseBody {
+	body := &TransferSecretOwnershipInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "transfer secret ownership" endpoint of the
+// "secrets" service.
+func NewTransferSecretOwnershipUnauthorizedResponseBody(res *goa.ServiceError) *TransferSecretOwnershipUnauthorizedResponseBody {
+	body := &TransferSecretOwnershipUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipForbiddenResponseBody builds the HTTP response
+// body from the result of the "transfer secret ownership" endpoint of the
+// "secrets" service.
+func NewTransferSecretOwnershipForbiddenResponseBody(res *goa.ServiceError) *TransferSecretOwnershipForbiddenResponseBody {
+	body := &TransferSecretOwnershipForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewTransferSecretOwnershipInternalErrorResponseBody builds the HTTP response
+// body from the result of the "transfer secret ownership" endpoint of the
+// "secrets" service.
+func NewTransferSecretOwnershipInternalErrorResponseBody(res *goa.ServiceError) *TransferSecretOwnershipInternalErrorResponseBody {
+	body := &TransferSecretOwnershipInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListStaleSecretsInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "list stale secrets" endpoint of the "secrets"
+// service.
+func NewListStaleSecretsInvalidParametersResponseBody(res *goa.ServiceError) *ListStaleSecretsInvalidParametersResponseBody {
+	body := &ListStaleSecretsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListStaleSecretsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list stale secrets" endpoint of the "secrets"
+// service.
+func NewListStaleSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ListStaleSecretsUnauthorizedResponseBody {
+	body := &ListStaleSecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListStaleSecretsForbiddenResponseBody builds the HTTP response body from
+// the result of the "list stale secrets" endpoint of the "secrets" service.
+func NewListStaleSecretsForbiddenResponseBody(res *goa.ServiceError) *ListStaleSecretsForbiddenResponseBody {
+	body := &ListStaleSecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListStaleSecretsInternalErrorResponseBody builds the HTTP response body
+// from the result of the "list stale secrets" endpoint of the "secrets"
+// service.
+func NewListStaleSecretsInternalErrorResponseBody(res *goa.ServiceError) *ListStaleSecretsInternalErrorResponseBody {
+	body := &ListStaleSecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretCanarySecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "set secret canary" endpoint of the "secrets" service.
+func NewSetSecretCanarySecretNotFoundResponseBody(res *goa.ServiceError) *SetSecretCanarySecretNotFoundResponseBody {
+	body := &SetSecretCanarySecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretCanaryInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "set secret canary" endpoint of the "secrets"
+// service.
+func NewSetSecretCanaryInvalidParametersResponseBody(res *goa.ServiceError) *SetSecretCanaryInvalidParametersResponseBody {
+	body := &SetSecretCanaryInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretCanaryUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "set secret canary" endpoint of the "secrets" service.
+func NewSetSecretCanaryUnauthorizedResponseBody(res *goa.ServiceError) *SetSecretCanaryUnauthorizedResponseBody {
+	body := &SetSecretCanaryUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretCanaryForbiddenResponseBody builds the HTTP response body from
+// the result of the "set secret canary" endpoint of the "secrets" service.
+func NewSetSecretCanaryForbiddenResponseBody(res *goa.ServiceError) *SetSecretCanaryForbiddenResponseBody {
+	body := &SetSecretCanaryForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewSetSecretCanaryInternalErrorResponseBody builds the HTTP response body
+// from the result of the "set secret canary" endpoint of the "secrets" service.
+func NewSetSecretCanaryInternalErrorResponseBody(res *goa.ServiceError) *SetSecretCanaryInternalErrorResponseBody {
+	body := &SetSecretCanaryInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListCanarySecretsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list canary secrets" endpoint of the "secrets"
+// service.
+func NewListCanarySecretsUnauthorizedResponseBody(res *goa.ServiceError) *ListCanarySecretsUnauthorizedResponseBody {
+	body := &ListCanarySecretsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListCanarySecretsForbiddenResponseBody builds the HTTP response body from
+// the result of the "list canary secrets" endpoint of the "secrets" service.
+func NewListCanarySecretsForbiddenResponseBody(res *goa.ServiceError) *ListCanarySecretsForbiddenResponseBody {
+	body := &ListCanarySecretsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListCanarySecretsInternalErrorResponseBody builds the HTTP response body
+// from the result of the "list canary secrets" endpoint of the "secrets"
+// service.
+func NewListCanarySecretsInternalErrorResponseBody(res *goa.ServiceError) *ListCanarySecretsInternalErrorResponseBody {
+	body := &ListCanarySecretsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretSecretNotFoundResponseBody builds the HTTP response body from
+// the result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretSecretNotFoundResponseBody(res *goa.ServiceError) *WrapSecretSecretNotFoundResponseBody {
+	body := &WrapSecretSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretFieldNotFoundResponseBody builds the HTTP response body from
+// the result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretFieldNotFoundResponseBody(res *goa.ServiceError) *WrapSecretFieldNotFoundResponseBody {
+	body := &WrapSecretFieldNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretSecretTooLargeResponseBody builds the HTTP response body from
+// the result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretSecretTooLargeResponseBody(res *goa.ServiceError) *WrapSecretSecretTooLargeResponseBody {
+	body := &WrapSecretSecretTooLargeResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretInvalidParametersResponseBody(res *goa.ServiceError) *WrapSecretInvalidParametersResponseBody {
+	body := &WrapSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretUnauthorizedResponseBody(res *goa.ServiceError) *WrapSecretUnauthorizedResponseBody {
+	body := &WrapSecretUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretForbiddenResponseBody builds the HTTP response body from the
+// result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretForbiddenResponseBody(res *goa.ServiceError) *WrapSecretForbiddenResponseBody {
+	body := &WrapSecretForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewWrapSecretInternalErrorResponseBody builds the HTTP response body from
+// the result of the "wrap secret" endpoint of the "secrets" service.
+func NewWrapSecretInternalErrorResponseBody(res *goa.ServiceError) *WrapSecretInternalErrorResponseBody {
+	body := &WrapSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnwrapSecretTokenNotFoundResponseBody builds the HTTP response body from
+// the result of the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretTokenNotFoundResponseBody(res *goa.ServiceError) *UnwrapSecretTokenNotFoundResponseBody {
+	body := &UnwrapSecretTokenNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnwrapSecretTokenExpiredResponseBody builds the HTTP response body from
+// the result of the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretTokenExpiredResponseBody(res *goa.ServiceError) *UnwrapSecretTokenExpiredResponseBody {
+	body := &UnwrapSecretTokenExpiredResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnwrapSecretTokenAlreadyUsedResponseBody builds the HTTP response body
+// from the result of the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretTokenAlreadyUsedResponseBody(res *goa.ServiceError) *UnwrapSecretTokenAlreadyUsedResponseBody {
+	body := &UnwrapSecretTokenAlreadyUsedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnwrapSecretInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretInvalidParametersResponseBody(res *goa.ServiceError) *UnwrapSecretInvalidParametersResponseBody {
+	body := &UnwrapSecretInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUnwrapSecretInternalErrorResponseBody builds the HTTP response body from
+// the result of the "unwrap secret" endpoint of the "secrets" service.
+func NewUnwrapSecretInternalErrorResponseBody(res *goa.ServiceError) *UnwrapSecretInternalErrorResponseBody {
+	body := &UnwrapSecretInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessSecretNotFoundResponseBody builds the HTTP response body
+// from the result of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessSecretNotFoundResponseBody(res *goa.ServiceError) *RequestAccessSecretNotFoundResponseBody {
+	body := &RequestAccessSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessAccessRequestPendingResponseBody builds the HTTP response
+// body from the result of the "request access" endpoint of the "secrets"
+// service.
+func NewRequestAccessAccessRequestPendingResponseBody(res *goa.ServiceError) *RequestAccessAccessRequestPendingResponseBody {
+	body := &RequestAccessAccessRequestPendingResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessInvalidParametersResponseBody(res *goa.ServiceError) *RequestAccessInvalidParametersResponseBody {
+	body := &RequestAccessInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessUnauthorizedResponseBody(res *goa.ServiceError) *RequestAccessUnauthorizedResponseBody {
+	body := &RequestAccessUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessForbiddenResponseBody builds the HTTP response body from the
+// result of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessForbiddenResponseBody(res *goa.ServiceError) *RequestAccessForbiddenResponseBody {
+	body := &RequestAccessForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewRequestAccessInternalErrorResponseBody builds the HTTP response body from
+// the result of the "request access" endpoint of the "secrets" service.
+func NewRequestAccessInternalErrorResponseBody(res *goa.ServiceError) *RequestAccessInternalErrorResponseBody {
+	body := &RequestAccessInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAccessRequestsInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "list access requests" endpoint of the "secrets"
+// service.
+func NewListAccessRequestsInvalidParametersResponseBody(res *goa.ServiceError) *ListAccessRequestsInvalidParametersResponseBody {
+	body := &ListAccessRequestsInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAccessRequestsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list access requests" endpoint of the "secrets"
+// service.
+func NewListAccessRequestsUnauthorizedResponseBody(res *goa.ServiceError) *ListAccessRequestsUnauthorizedResponseBody {
+	body := &ListAccessRequestsUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAccessRequestsForbiddenResponseBody builds the HTTP response body
+// from the result of the "list access requests" endpoint of the "secrets"
+// service.
+func NewListAccessRequestsForbiddenResponseBody(res *goa.ServiceError) *ListAccessRequestsForbiddenResponseBody {
+	body := &ListAccessRequestsForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListAccessRequestsInternalErrorResponseBody builds the HTTP response body
+// from the result of the "list access requests" endpoint of the "secrets"
+// service.
+func NewListAccessRequestsInternalErrorResponseBody(res *goa.ServiceError) *ListAccessRequestsInternalErrorResponseBody {
+	body := &ListAccessRequestsInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestAccessRequestNotFoundResponseBody builds the HTTP
+// response body from the result of the "approve access request" endpoint of
+// the "secrets" service.
+func NewApproveAccessRequestAccessRequestNotFoundResponseBody(res *goa.ServiceError) *ApproveAccessRequestAccessRequestNotFoundResponseBody {
+	body := &ApproveAccessRequestAccessRequestNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestSecretNotFoundResponseBody builds the HTTP response
+// body from the result of the "approve access request" endpoint of the
+// "secrets" service.
+func NewApproveAccessRequestSecretNotFoundResponseBody(res *goa.ServiceError) *ApproveAccessRequestSecretNotFoundResponseBody {
+	body := &ApproveAccessRequestSecretNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestAccessRequestDecidedResponseBody builds the HTTP
+// response body from the result of the "approve access request" endpoint of
+// the "secrets" service.
+func NewApproveAccessRequestAccessRequestDecidedResponseBody(res *goa.ServiceError) *ApproveAccessRequestAccessRequestDecidedResponseBody {
+	body := &ApproveAccessRequestAccessRequestDecidedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "approve access request" endpoint of
+// the "secrets" service.
+func NewApproveAccessRequestInvalidParametersResponseBody(res *goa.ServiceError) *ApproveAccessRequestInvalidParametersResponseBody {
+	body := &ApproveAccessRequestInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "approve access request" endpoint of the
+// "secrets" service.
+func NewApproveAccessRequestUnauthorizedResponseBody(res *goa.ServiceError) *ApproveAccessRequestUnauthorizedResponseBody {
+	body := &ApproveAccessRequestUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestForbiddenResponseBody builds the HTTP response body
+// from the result of the "approve access request" endpoint of the "secrets"
+// service.
+func NewApproveAccessRequestForbiddenResponseBody(res *goa.ServiceError) *ApproveAccessRequestForbiddenResponseBody {
+	body := &ApproveAccessRequestForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewApproveAccessRequestInternalErrorResponseBody builds the HTTP response
+// body from the result of the "approve access request" endpoint of the
+// "secrets" service.
+func NewApproveAccessRequestInternalErrorResponseBody(res *goa.ServiceError) *ApproveAccessRequestInternalErrorResponseBody {
+	body := &ApproveAccessRequestInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestAccessRequestNotFoundResponseBody builds the HTTP
+// response body from the result of the "deny access request" endpoint of the
+// "secrets" service.
+func NewDenyAccessRequestAccessRequestNotFoundResponseBody(res *goa.ServiceError) *DenyAccessRequestAccessRequestNotFoundResponseBody {
+	body := &DenyAccessRequestAccessRequestNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestAccessRequestDecidedResponseBody builds the HTTP
+// response body from the result of the "deny access request" endpoint of the
+// "secrets" service.
+func NewDenyAccessRequestAccessRequestDecidedResponseBody(res *goa.ServiceError) *DenyAccessRequestAccessRequestDecidedResponseBody {
+	body := &DenyAccessRequestAccessRequestDecidedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "deny access request" endpoint of the "secrets"
+// service.
+func NewDenyAccessRequestInvalidParametersResponseBody(res *goa.ServiceError) *DenyAccessRequestInvalidParametersResponseBody {
+	body := &DenyAccessRequestInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "deny access request" endpoint of the "secrets"
+// service.
+func NewDenyAccessRequestUnauthorizedResponseBody(res *goa.ServiceError) *DenyAccessRequestUnauthorizedResponseBody {
+	body := &DenyAccessRequestUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestForbiddenResponseBody builds the HTTP response body from
+// the result of the "deny access request" endpoint of the "secrets" service.
+func NewDenyAccessRequestForbiddenResponseBody(res *goa.ServiceError) *DenyAccessRequestForbiddenResponseBody {
+	body := &DenyAccessRequestForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDenyAccessRequestInternalErrorResponseBody builds the HTTP response body
+// from the result of the "deny access request" endpoint of the "secrets"
+// service.
+func NewDenyAccessRequestInternalErrorResponseBody(res *goa.ServiceError) *DenyAccessRequestInternalErrorResponseBody {
+	body := &DenyAccessRequestInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListValidationRulesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list validation rules" endpoint of the "secrets"
+// service.
+func NewListValidationRulesUnauthorizedResponseBody(res *goa.ServiceError) *ListValidationRulesUnauthorizedResponseBody {
+	body := &ListValidationRulesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListValidationRulesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list validation rules" endpoint of the "secrets"
+// service.
+func NewListValidationRulesForbiddenResponseBody(res *goa.ServiceError) *ListValidationRulesForbiddenResponseBody {
+	body := &ListValidationRulesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListValidationRulesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list validation rules" endpoint of the
+// "secrets" service.
+func NewListValidationRulesInternalErrorResponseBody(res *goa.ServiceError) *ListValidationRulesInternalErrorResponseBody {
+	body := &ListValidationRulesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateValidationRuleInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "create validation rule" endpoint of
+// the "secrets" service.
+func NewCreateValidationRuleInvalidParametersResponseBody(res *goa.ServiceError) *CreateValidationRuleInvalidParametersResponseBody {
+	body := &CreateValidationRuleInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateValidationRuleUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "create validation rule" endpoint of the
+// "secrets" service.
+func NewCreateValidationRuleUnauthorizedResponseBody(res *goa.ServiceError) *CreateValidationRuleUnauthorizedResponseBody {
+	body := &CreateValidationRuleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// UserResponseBody is used to define fields on response body types.
-type UserResponseBody struct {
-	// Unique identifier for the user
-	ID int `form:"id" json:"id" xml:"id"`
-	// The username
-	Username string `form:"username" json:"username" xml:"username"`
-	// User creation timestamp
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// User last update timestamp
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
-	// Roles assigned to the user
-	Roles []*RoleResponseBody `form:"roles" json:"roles" xml:"roles"`
+// NewCreateValidationRuleForbiddenResponseBody builds the HTTP response body
+// from the result of the "create validation rule" endpoint of the "secrets"
+// service.
+func NewCreateValidationRuleForbiddenResponseBody(res *goa.ServiceError) *CreateValidationRuleForbiddenResponseBody {
+	body := &CreateValidationRuleForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// RoleResponseBody is used to define fields on response body types.
-type RoleResponseBody struct {
-	// Unique identifier for the role
-	ID int `form:"id" json:"id" xml:"id"`
-	// Name of the role
-	Name string `form:"name" json:"name" xml:"name"`
-	// Color associated with the role
-	Color string `form:"color" json:"color" xml:"color"`
-	// Is this role an admin role?
-	Admin bool `form:"admin" json:"admin" xml:"admin"`
-	// Role creation timestamp
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
-	// Role last update timestamp
-	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+// NewCreateValidationRuleInternalErrorResponseBody builds the HTTP response
+// body from the result of the "create validation rule" endpoint of the
+// "secrets" service.
+func NewCreateValidationRuleInternalErrorResponseBody(res *goa.ServiceError) *CreateValidationRuleInternalErrorResponseBody {
+	body := &CreateValidationRuleInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
 }
 
-// NewListSecretsResponseBody builds the HTTP response body from the result of
-// the "list secrets" endpoint of the "secrets" service.
-func NewListSecretsResponseBody(res []*secrets.SecretInfoSummary) ListSecretsResponseBody {
-	body := make([]*SecretInfoSummaryResponse, len(res))
-	for i, val := range res {
-		body[i] = marshalSecretsSecretInfoSummaryToSecretInfoSummaryResponse(val)
+// NewDeleteValidationRuleValidationRuleNotFoundResponseBody builds the HTTP
+// response body from the result of the "delete validation rule" endpoint of
+// the "secrets" service.
+func NewDeleteValidationRuleValidationRuleNotFoundResponseBody(res *goa.ServiceError) *DeleteValidationRuleValidationRuleNotFoundResponseBody {
+	body := &DeleteValidationRuleValidationRuleNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewGetSecretValueResponseBody builds the HTTP response body from the result
-// of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueResponseBody(res *secrets.GetSecretValueResult) *GetSecretValueResponseBody {
-	body := &GetSecretValueResponseBody{
-		Value: res.Value,
-		Path:  res.Path,
+// NewDeleteValidationRuleUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "delete validation rule" endpoint of the
+// "secrets" service.
+func NewDeleteValidationRuleUnauthorizedResponseBody(res *goa.ServiceError) *DeleteValidationRuleUnauthorizedResponseBody {
+	body := &DeleteValidationRuleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewGetSecretResponseBody builds the HTTP response body from the result of
-// the "get secret" endpoint of the "secrets" service.
-func NewGetSecretResponseBody(res *secrets.SecretInfo) *GetSecretResponseBody {
-	body := &GetSecretResponseBody{
-		Path:      res.Path,
-		CreatedAt: res.CreatedAt,
-		UpdatedAt: res.UpdatedAt,
+// NewDeleteValidationRuleForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete validation rule" endpoint of the "secrets"
+// service.
+func NewDeleteValidationRuleForbiddenResponseBody(res *goa.ServiceError) *DeleteValidationRuleForbiddenResponseBody {
+	body := &DeleteValidationRuleForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
-	if res.Owner != nil {
-		body.Owner = marshalSecretsUserToUserResponseBody(res.Owner)
+	return body
+}
+
+// NewDeleteValidationRuleInternalErrorResponseBody builds the HTTP response
+// body from the result of the "delete validation rule" endpoint of the
+// "secrets" service.
+func NewDeleteValidationRuleInternalErrorResponseBody(res *goa.ServiceError) *DeleteValidationRuleInternalErrorResponseBody {
+	body := &DeleteValidationRuleInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
-	if res.AuthorizedUsers != nil {
-		body.AuthorizedUsers = make([]*UserResponseBody, len(res.AuthorizedUsers))
-		for i, val := range res.AuthorizedUsers {
-			body.AuthorizedUsers[i] = marshalSecretsUserToUserResponseBody(val)
-		}
-	} else {
-		body.AuthorizedUsers = []*UserResponseBody{}
+	return body
+}
+
+// NewListBreakGlassRulesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list break glass rules" endpoint of the "secrets"
+// service.
+func NewListBreakGlassRulesUnauthorizedResponseBody(res *goa.ServiceError) *ListBreakGlassRulesUnauthorizedResponseBody {
+	body := &ListBreakGlassRulesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
-	if res.AuthorizedRoles != nil {
-		body.AuthorizedRoles = make([]*RoleResponseBody, len(res.AuthorizedRoles))
-		for i, val := range res.AuthorizedRoles {
-			body.AuthorizedRoles[i] = marshalSecretsRoleToRoleResponseBody(val)
-		}
-	} else {
-		body.AuthorizedRoles = []*RoleResponseBody{}
+	return body
+}
+
+// NewListBreakGlassRulesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list break glass rules" endpoint of the "secrets"
+// service.
+func NewListBreakGlassRulesForbiddenResponseBody(res *goa.ServiceError) *ListBreakGlassRulesForbiddenResponseBody {
+	body := &ListBreakGlassRulesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListBreakGlassRulesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list break glass rules" endpoint of the
+// "secrets" service.
+func NewListBreakGlassRulesInternalErrorResponseBody(res *goa.ServiceError) *ListBreakGlassRulesInternalErrorResponseBody {
+	body := &ListBreakGlassRulesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBreakGlassRuleInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "create break glass rule" endpoint of
+// the "secrets" service.
+func NewCreateBreakGlassRuleInvalidParametersResponseBody(res *goa.ServiceError) *CreateBreakGlassRuleInvalidParametersResponseBody {
+	body := &CreateBreakGlassRuleInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBreakGlassRuleUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "create break glass rule" endpoint of the
+// "secrets" service.
+func NewCreateBreakGlassRuleUnauthorizedResponseBody(res *goa.ServiceError) *CreateBreakGlassRuleUnauthorizedResponseBody {
+	body := &CreateBreakGlassRuleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBreakGlassRuleForbiddenResponseBody builds the HTTP response body
+// from the result of the "create break glass rule" endpoint of the "secrets"
+// service.
+func NewCreateBreakGlassRuleForbiddenResponseBody(res *goa.ServiceError) *CreateBreakGlassRuleForbiddenResponseBody {
+	body := &CreateBreakGlassRuleForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateBreakGlassRuleInternalErrorResponseBody builds the HTTP response
+// body from the result of the "create break glass rule" endpoint of the
+// "secrets" service.
+func NewCreateBreakGlassRuleInternalErrorResponseBody(res *goa.ServiceError) *CreateBreakGlassRuleInternalErrorResponseBody {
+	body := &CreateBreakGlassRuleInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody builds the HTTP
+// response body from the result of the "delete break glass rule" endpoint of
+// the "secrets" service.
+func NewDeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody(res *goa.ServiceError) *DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody {
+	body := &DeleteBreakGlassRuleBreakGlassRuleNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteBreakGlassRuleUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "delete break glass rule" endpoint of the
+// "secrets" service.
+func NewDeleteBreakGlassRuleUnauthorizedResponseBody(res *goa.ServiceError) *DeleteBreakGlassRuleUnauthorizedResponseBody {
+	body := &DeleteBreakGlassRuleUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteBreakGlassRuleForbiddenResponseBody builds the HTTP response body
+// from the result of the "delete break glass rule" endpoint of the "secrets"
+// service.
+func NewDeleteBreakGlassRuleForbiddenResponseBody(res *goa.ServiceError) *DeleteBreakGlassRuleForbiddenResponseBody {
+	body := &DeleteBreakGlassRuleForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteBreakGlassRuleInternalErrorResponseBody builds the HTTP response
+// body from the result of the "delete break glass rule" endpoint of the
+// "secrets" service.
+func NewDeleteBreakGlassRuleInternalErrorResponseBody(res *goa.ServiceError) *DeleteBreakGlassRuleInternalErrorResponseBody {
+	body := &DeleteBreakGlassRuleInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListBreakGlassAccessesInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "list break glass accesses" endpoint of
+// the "secrets" service.
+func NewListBreakGlassAccessesInvalidParametersResponseBody(res *goa.ServiceError) *ListBreakGlassAccessesInvalidParametersResponseBody {
+	body := &ListBreakGlassAccessesInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListBreakGlassAccessesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list break glass accesses" endpoint of the
+// "secrets" service.
+func NewListBreakGlassAccessesUnauthorizedResponseBody(res *goa.ServiceError) *ListBreakGlassAccessesUnauthorizedResponseBody {
+	body := &ListBreakGlassAccessesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListBreakGlassAccessesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list break glass accesses" endpoint of the "secrets"
+// service.
+func NewListBreakGlassAccessesForbiddenResponseBody(res *goa.ServiceError) *ListBreakGlassAccessesForbiddenResponseBody {
+	body := &ListBreakGlassAccessesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
 	}
 	return body
 }
 
-// NewListSecretsUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "list secrets" endpoint of the "secrets" service.
-func NewListSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ListSecretsUnauthorizedResponseBody {
-	body := &ListSecretsUnauthorizedResponseBody{
+// NewListBreakGlassAccessesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list break glass accesses" endpoint of the
+// "secrets" service.
+func NewListBreakGlassAccessesInternalErrorResponseBody(res *goa.ServiceError) *ListBreakGlassAccessesInternalErrorResponseBody {
+	body := &ListBreakGlassAccessesInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -611,10 +7347,11 @@ func NewListSecretsUnauthorizedResponseBody(res *goa.ServiceError) *ListSecretsU
 	return body
 }
 
-// NewListSecretsForbiddenResponseBody builds the HTTP response body from the
-// result of the "list secrets" endpoint of the "secrets" service.
-func NewListSecretsForbiddenResponseBody(res *goa.ServiceError) *ListSecretsForbiddenResponseBody {
-	body := &ListSecretsForbiddenResponseBody{
+// NewReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody builds the
+// HTTP response body from the result of the "review break glass access"
+// endpoint of the "secrets" service.
+func NewReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody {
+	body := &ReviewBreakGlassAccessBreakGlassAccessNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -625,10 +7362,11 @@ func NewListSecretsForbiddenResponseBody(res *goa.ServiceError) *ListSecretsForb
 	return body
 }
 
-// NewListSecretsInternalErrorResponseBody builds the HTTP response body from
-// the result of the "list secrets" endpoint of the "secrets" service.
-func NewListSecretsInternalErrorResponseBody(res *goa.ServiceError) *ListSecretsInternalErrorResponseBody {
-	body := &ListSecretsInternalErrorResponseBody{
+// NewReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody builds the
+// HTTP response body from the result of the "review break glass access"
+// endpoint of the "secrets" service.
+func NewReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody {
+	body := &ReviewBreakGlassAccessBreakGlassAccessReviewedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -639,10 +7377,11 @@ func NewListSecretsInternalErrorResponseBody(res *goa.ServiceError) *ListSecrets
 	return body
 }
 
-// NewGetSecretValueSecretNotFoundResponseBody builds the HTTP response body
-// from the result of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecretValueSecretNotFoundResponseBody {
-	body := &GetSecretValueSecretNotFoundResponseBody{
+// NewReviewBreakGlassAccessInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "review break glass access" endpoint of
+// the "secrets" service.
+func NewReviewBreakGlassAccessInvalidParametersResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessInvalidParametersResponseBody {
+	body := &ReviewBreakGlassAccessInvalidParametersResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -653,10 +7392,11 @@ func NewGetSecretValueSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecr
 	return body
 }
 
-// NewGetSecretValueInvalidParametersResponseBody builds the HTTP response body
-// from the result of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueInvalidParametersResponseBody(res *goa.ServiceError) *GetSecretValueInvalidParametersResponseBody {
-	body := &GetSecretValueInvalidParametersResponseBody{
+// NewReviewBreakGlassAccessUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "review break glass access" endpoint of the
+// "secrets" service.
+func NewReviewBreakGlassAccessUnauthorizedResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessUnauthorizedResponseBody {
+	body := &ReviewBreakGlassAccessUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -667,10 +7407,11 @@ func NewGetSecretValueInvalidParametersResponseBody(res *goa.ServiceError) *GetS
 	return body
 }
 
-// NewGetSecretValueUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueUnauthorizedResponseBody(res *goa.ServiceError) *GetSecretValueUnauthorizedResponseBody {
-	body := &GetSecretValueUnauthorizedResponseBody{
+// NewReviewBreakGlassAccessForbiddenResponseBody builds the HTTP response body
+// from the result of the "review break glass access" endpoint of the "secrets"
+// service.
+func NewReviewBreakGlassAccessForbiddenResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessForbiddenResponseBody {
+	body := &ReviewBreakGlassAccessForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -681,10 +7422,11 @@ func NewGetSecretValueUnauthorizedResponseBody(res *goa.ServiceError) *GetSecret
 	return body
 }
 
-// NewGetSecretValueForbiddenResponseBody builds the HTTP response body from
-// the result of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueForbiddenResponseBody(res *goa.ServiceError) *GetSecretValueForbiddenResponseBody {
-	body := &GetSecretValueForbiddenResponseBody{
+// NewReviewBreakGlassAccessInternalErrorResponseBody builds the HTTP response
+// body from the result of the "review break glass access" endpoint of the
+// "secrets" service.
+func NewReviewBreakGlassAccessInternalErrorResponseBody(res *goa.ServiceError) *ReviewBreakGlassAccessInternalErrorResponseBody {
+	body := &ReviewBreakGlassAccessInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -695,10 +7437,11 @@ func NewGetSecretValueForbiddenResponseBody(res *goa.ServiceError) *GetSecretVal
 	return body
 }
 
-// NewGetSecretValueInternalErrorResponseBody builds the HTTP response body
-// from the result of the "get secret value" endpoint of the "secrets" service.
-func NewGetSecretValueInternalErrorResponseBody(res *goa.ServiceError) *GetSecretValueInternalErrorResponseBody {
-	body := &GetSecretValueInternalErrorResponseBody{
+// NewListFolderAccessesUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list folder accesses" endpoint of the "secrets"
+// service.
+func NewListFolderAccessesUnauthorizedResponseBody(res *goa.ServiceError) *ListFolderAccessesUnauthorizedResponseBody {
+	body := &ListFolderAccessesUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -709,10 +7452,11 @@ func NewGetSecretValueInternalErrorResponseBody(res *goa.ServiceError) *GetSecre
 	return body
 }
 
-// NewGetSecretSecretNotFoundResponseBody builds the HTTP response body from
-// the result of the "get secret" endpoint of the "secrets" service.
-func NewGetSecretSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecretSecretNotFoundResponseBody {
-	body := &GetSecretSecretNotFoundResponseBody{
+// NewListFolderAccessesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list folder accesses" endpoint of the "secrets"
+// service.
+func NewListFolderAccessesForbiddenResponseBody(res *goa.ServiceError) *ListFolderAccessesForbiddenResponseBody {
+	body := &ListFolderAccessesForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -723,10 +7467,11 @@ func NewGetSecretSecretNotFoundResponseBody(res *goa.ServiceError) *GetSecretSec
 	return body
 }
 
-// NewGetSecretInvalidParametersResponseBody builds the HTTP response body from
-// the result of the "get secret" endpoint of the "secrets" service.
-func NewGetSecretInvalidParametersResponseBody(res *goa.ServiceError) *GetSecretInvalidParametersResponseBody {
-	body := &GetSecretInvalidParametersResponseBody{
+// NewListFolderAccessesInternalErrorResponseBody builds the HTTP response body
+// from the result of the "list folder accesses" endpoint of the "secrets"
+// service.
+func NewListFolderAccessesInternalErrorResponseBody(res *goa.ServiceError) *ListFolderAccessesInternalErrorResponseBody {
+	body := &ListFolderAccessesInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -737,10 +7482,11 @@ func NewGetSecretInvalidParametersResponseBody(res *goa.ServiceError) *GetSecret
 	return body
 }
 
-// NewGetSecretUnauthorizedResponseBody builds the HTTP response body from the
-// result of the "get secret" endpoint of the "secrets" service.
-func NewGetSecretUnauthorizedResponseBody(res *goa.ServiceError) *GetSecretUnauthorizedResponseBody {
-	body := &GetSecretUnauthorizedResponseBody{
+// NewGrantFolderAccessInvalidParametersResponseBody builds the HTTP response
+// body from the result of the "grant folder access" endpoint of the "secrets"
+// service.
+func NewGrantFolderAccessInvalidParametersResponseBody(res *goa.ServiceError) *GrantFolderAccessInvalidParametersResponseBody {
+	body := &GrantFolderAccessInvalidParametersResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -751,10 +7497,11 @@ func NewGetSecretUnauthorizedResponseBody(res *goa.ServiceError) *GetSecretUnaut
 	return body
 }
 
-// NewGetSecretForbiddenResponseBody builds the HTTP response body from the
-// result of the "get secret" endpoint of the "secrets" service.
-func NewGetSecretForbiddenResponseBody(res *goa.ServiceError) *GetSecretForbiddenResponseBody {
-	body := &GetSecretForbiddenResponseBody{
+// NewGrantFolderAccessUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "grant folder access" endpoint of the "secrets"
+// service.
+func NewGrantFolderAccessUnauthorizedResponseBody(res *goa.ServiceError) *GrantFolderAccessUnauthorizedResponseBody {
+	body := &GrantFolderAccessUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -765,10 +7512,10 @@ func NewGetSecretForbiddenResponseBody(res *goa.ServiceError) *GetSecretForbidde
 	return body
 }
 
-// NewGetSecretInternalErrorResponseBody builds the HTTP response body from the
-// result of the "get secret" endpoint of the "secrets" service.
-func NewGetSecretInternalErrorResponseBody(res *goa.ServiceError) *GetSecretInternalErrorResponseBody {
-	body := &GetSecretInternalErrorResponseBody{
+// NewGrantFolderAccessForbiddenResponseBody builds the HTTP response body from
+// the result of the "grant folder access" endpoint of the "secrets" service.
+func NewGrantFolderAccessForbiddenResponseBody(res *goa.ServiceError) *GrantFolderAccessForbiddenResponseBody {
+	body := &GrantFolderAccessForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -779,10 +7526,11 @@ func NewGetSecretInternalErrorResponseBody(res *goa.ServiceError) *GetSecretInte
 	return body
 }
 
-// NewCreateSecretInvalidParametersResponseBody builds the HTTP response body
-// from the result of the "create secret" endpoint of the "secrets" service.
-func NewCreateSecretInvalidParametersResponseBody(res *goa.ServiceError) *CreateSecretInvalidParametersResponseBody {
-	body := &CreateSecretInvalidParametersResponseBody{
+// NewGrantFolderAccessInternalErrorResponseBody builds the HTTP response body
+// from the result of the "grant folder access" endpoint of the "secrets"
+// service.
+func NewGrantFolderAccessInternalErrorResponseBody(res *goa.ServiceError) *GrantFolderAccessInternalErrorResponseBody {
+	body := &GrantFolderAccessInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -793,10 +7541,11 @@ func NewCreateSecretInvalidParametersResponseBody(res *goa.ServiceError) *Create
 	return body
 }
 
-// NewCreateSecretUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "create secret" endpoint of the "secrets" service.
-func NewCreateSecretUnauthorizedResponseBody(res *goa.ServiceError) *CreateSecretUnauthorizedResponseBody {
-	body := &CreateSecretUnauthorizedResponseBody{
+// NewRevokeFolderAccessFolderAccessNotFoundResponseBody builds the HTTP
+// response body from the result of the "revoke folder access" endpoint of the
+// "secrets" service.
+func NewRevokeFolderAccessFolderAccessNotFoundResponseBody(res *goa.ServiceError) *RevokeFolderAccessFolderAccessNotFoundResponseBody {
+	body := &RevokeFolderAccessFolderAccessNotFoundResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -807,10 +7556,11 @@ func NewCreateSecretUnauthorizedResponseBody(res *goa.ServiceError) *CreateSecre
 	return body
 }
 
-// NewCreateSecretForbiddenResponseBody builds the HTTP response body from the
-// result of the "create secret" endpoint of the "secrets" service.
-func NewCreateSecretForbiddenResponseBody(res *goa.ServiceError) *CreateSecretForbiddenResponseBody {
-	body := &CreateSecretForbiddenResponseBody{
+// NewRevokeFolderAccessUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "revoke folder access" endpoint of the "secrets"
+// service.
+func NewRevokeFolderAccessUnauthorizedResponseBody(res *goa.ServiceError) *RevokeFolderAccessUnauthorizedResponseBody {
+	body := &RevokeFolderAccessUnauthorizedResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -821,10 +7571,11 @@ func NewCreateSecretForbiddenResponseBody(res *goa.ServiceError) *CreateSecretFo
 	return body
 }
 
-// NewCreateSecretInternalErrorResponseBody builds the HTTP response body from
-// the result of the "create secret" endpoint of the "secrets" service.
-func NewCreateSecretInternalErrorResponseBody(res *goa.ServiceError) *CreateSecretInternalErrorResponseBody {
-	body := &CreateSecretInternalErrorResponseBody{
+// NewRevokeFolderAccessForbiddenResponseBody builds the HTTP response body
+// from the result of the "revoke folder access" endpoint of the "secrets"
+// service.
+func NewRevokeFolderAccessForbiddenResponseBody(res *goa.ServiceError) *RevokeFolderAccessForbiddenResponseBody {
+	body := &RevokeFolderAccessForbiddenResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -835,10 +7586,11 @@ func NewCreateSecretInternalErrorResponseBody(res *goa.ServiceError) *CreateSecr
 	return body
 }
 
-// NewUpdateSecretSecretNotFoundResponseBody builds the HTTP response body from
-// the result of the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretSecretNotFoundResponseBody(res *goa.ServiceError) *UpdateSecretSecretNotFoundResponseBody {
-	body := &UpdateSecretSecretNotFoundResponseBody{
+// NewRevokeFolderAccessInternalErrorResponseBody builds the HTTP response body
+// from the result of the "revoke folder access" endpoint of the "secrets"
+// service.
+func NewRevokeFolderAccessInternalErrorResponseBody(res *goa.ServiceError) *RevokeFolderAccessInternalErrorResponseBody {
+	body := &RevokeFolderAccessInternalErrorResponseBody{
 		Name:      res.Name,
 		ID:        res.ID,
 		Message:   res.Message,
@@ -849,115 +7601,523 @@ func NewUpdateSecretSecretNotFoundResponseBody(res *goa.ServiceError) *UpdateSec
 	return body
 }
 
-// NewUpdateSecretInvalidParametersResponseBody builds the HTTP response body
-// from the result of the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretInvalidParametersResponseBody(res *goa.ServiceError) *UpdateSecretInvalidParametersResponseBody {
-	body := &UpdateSecretInvalidParametersResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+// NewBrowseSecretsPayload builds a secrets service browse secrets endpoint
+// payload.
+func NewBrowseSecretsPayload(prefix string, recursive bool, cursor *string, limit int) *secrets.BrowseSecretsPayload {
+	v := &secrets.BrowseSecretsPayload{}
+	v.Prefix = prefix
+	v.Recursive = recursive
+	v.Cursor = cursor
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetSecretValuePayload builds a secrets service get secret value endpoint
+// payload.
+func NewGetSecretValuePayload(path string, field *string, raw bool, breakGlassJustification *string) *secrets.GetSecretValuePayload {
+	v := &secrets.GetSecretValuePayload{}
+	v.Path = path
+	v.Field = field
+	v.Raw = raw
+	v.BreakGlassJustification = breakGlassJustification
+
+	return v
+}
+
+// NewBatchGetSecretValuesPayload builds a secrets service batch get secret
+// values endpoint payload.
+func NewBatchGetSecretValuesPayload(body *BatchGetSecretValuesRequestBody) *secrets.BatchGetSecretValuesPayload {
+	v := &secrets.BatchGetSecretValuesPayload{}
+	v.Secrets = make([]*secrets.SecretValueRequest, len(body.Secrets))
+	for i, val := range body.Secrets {
+		v.Secrets[i] = unmarshalSecretValueRequestRequestBodyToSecretsSecretValueRequest(val)
+	}
+
+	return v
+}
+
+// NewRenderTemplatePayload builds a secrets service render template endpoint
+// payload.
+func NewRenderTemplatePayload(body *RenderTemplateRequestBody) *secrets.RenderTemplatePayload {
+	v := &secrets.RenderTemplatePayload{
+		Template:     body.Template,
+		TemplatePath: body.TemplatePath,
+	}
+
+	return v
+}
+
+// NewUploadSecretFilePayload builds a secrets service upload secret file
+// endpoint payload.
+func NewUploadSecretFilePayload(path string, filename *string, contentType string) *secrets.UploadSecretFilePayload {
+	v := &secrets.UploadSecretFilePayload{}
+	v.Path = path
+	v.Filename = filename
+	v.ContentType = contentType
+
+	return v
+}
+
+// NewDownloadSecretFilePayload builds a secrets service download secret file
+// endpoint payload.
+func NewDownloadSecretFilePayload(path string) *secrets.DownloadSecretFilePayload {
+	v := &secrets.DownloadSecretFilePayload{}
+	v.Path = path
+
+	return v
+}
+
+// NewExportSecretsPayload builds a secrets service export secrets endpoint
+// payload.
+func NewExportSecretsPayload(prefix string, format string, keyNaming *string, raw bool) *secrets.ExportSecretsPayload {
+	v := &secrets.ExportSecretsPayload{}
+	v.Prefix = prefix
+	v.Format = format
+	v.KeyNaming = keyNaming
+	v.Raw = raw
+
+	return v
+}
+
+// NewImportSecretsPayload builds a secrets service import secrets endpoint
+// payload.
+func NewImportSecretsPayload(prefix string, format string, keyNaming *string, onExisting string, dryRun bool) *secrets.ImportSecretsPayload {
+	v := &secrets.ImportSecretsPayload{}
+	v.Prefix = prefix
+	v.Format = format
+	v.KeyNaming = keyNaming
+	v.OnExisting = onExisting
+	v.DryRun = dryRun
+
+	return v
+}
+
+// NewGetSecretPayload builds a secrets service get secret endpoint payload.
+func NewGetSecretPayload(path string) *secrets.GetSecretPayload {
+	v := &secrets.GetSecretPayload{}
+	v.Path = path
+
+	return v
+}
+
+// NewCreateSecretPayload builds a secrets service create secret endpoint
+// payload.
+func NewCreateSecretPayload(body *CreateSecretRequestBody) *secrets.CreateSecretPayload {
+	v := &secrets.CreateSecretPayload{
+		Path:             *body.Path,
+		Value:            body.Value,
+		GenerationPolicy: body.GenerationPolicy,
+		RotationSchedule: body.RotationSchedule,
+	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
+	}
+	v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
+	for i, val := range body.AuthorizedUsers {
+		v.AuthorizedUsers[i] = val
+	}
+	v.AuthorizedRoles = make([]int, len(body.AuthorizedRoles))
+	for i, val := range body.AuthorizedRoles {
+		v.AuthorizedRoles[i] = val
+	}
+	if body.UserGrants != nil {
+		v.UserGrants = make([]*secrets.Grant, len(body.UserGrants))
+		for i, val := range body.UserGrants {
+			v.UserGrants[i] = unmarshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+	if body.RoleGrants != nil {
+		v.RoleGrants = make([]*secrets.Grant, len(body.RoleGrants))
+		for i, val := range body.RoleGrants {
+			v.RoleGrants[i] = unmarshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+
+	return v
+}
+
+// NewUpdateSecretPayload builds a secrets service update secret endpoint
+// payload.
+func NewUpdateSecretPayload(body *UpdateSecretRequestBody) *secrets.UpdateSecretPayload {
+	v := &secrets.UpdateSecretPayload{
+		Path:  *body.Path,
+		Value: body.Value,
+	}
+	if body.Fields != nil {
+		v.Fields = make(map[string]string, len(body.Fields))
+		for key, val := range body.Fields {
+			tk := key
+			tv := val
+			v.Fields[tk] = tv
+		}
+	}
+	if body.RemoveFields != nil {
+		v.RemoveFields = make([]string, len(body.RemoveFields))
+		for i, val := range body.RemoveFields {
+			v.RemoveFields[i] = val
+		}
+	}
+	if body.AuthorizedUsers != nil {
+		v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
+		for i, val := range body.AuthorizedUsers {
+			v.AuthorizedUsers[i] = val
+		}
+	}
+	if body.AuthorizedRoles != nil {
+		v.AuthorizedRoles = make([]int, len(body.AuthorizedRoles))
+		for i, val := range body.AuthorizedRoles {
+			v.AuthorizedRoles[i] = val
+		}
+	}
+	if body.UserGrants != nil {
+		v.UserGrants = make([]*secrets.Grant, len(body.UserGrants))
+		for i, val := range body.UserGrants {
+			v.UserGrants[i] = unmarshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+	if body.RoleGrants != nil {
+		v.RoleGrants = make([]*secrets.Grant, len(body.RoleGrants))
+		for i, val := range body.RoleGrants {
+			v.RoleGrants[i] = unmarshalGrantRequestBodyToSecretsGrant(val)
+		}
+	}
+
+	return v
+}
+
+// NewRegenerateSecretPayload builds a secrets service regenerate secret
+// endpoint payload.
+func NewRegenerateSecretPayload(body *RegenerateSecretRequestBody, path string) *secrets.RegenerateSecretPayload {
+	v := &secrets.RegenerateSecretPayload{
+		GenerationPolicy: body.GenerationPolicy,
+	}
+	v.Path = path
+
+	return v
+}
+
+// NewSetSecretRotationPayload builds a secrets service set secret rotation
+// endpoint payload.
+func NewSetSecretRotationPayload(body *SetSecretRotationRequestBody, path string) *secrets.SetSecretRotationPayload {
+	v := &secrets.SetSecretRotationPayload{
+		Schedule: body.Schedule,
+	}
+	v.Path = path
+
+	return v
+}
+
+// NewListSecretRotationsPayload builds a secrets service list secret rotations
+// endpoint payload.
+func NewListSecretRotationsPayload(path string, limit int) *secrets.ListSecretRotationsPayload {
+	v := &secrets.ListSecretRotationsPayload{}
+	v.Path = path
+	v.Limit = limit
+
+	return v
+}
+
+// NewDeleteSecretPayload builds a secrets service delete secret endpoint
+// payload.
+func NewDeleteSecretPayload(path string) *secrets.DeleteSecretPayload {
+	v := &secrets.DeleteSecretPayload{}
+	v.Path = path
+
+	return v
+}
+
+// NewMoveSecretsPayload builds a secrets service move secrets endpoint payload.
+func NewMoveSecretsPayload(body *MoveSecretsRequestBody) *secrets.MoveSecretsPayload {
+	v := &secrets.MoveSecretsPayload{
+		Source:      *body.Source,
+		Destination: *body.Destination,
+	}
+	if body.Overwrite != nil {
+		v.Overwrite = *body.Overwrite
+	}
+	if body.Overwrite == nil {
+		v.Overwrite = false
+	}
+
+	return v
+}
+
+// NewDiffSecretsPayload builds a secrets service diff secrets endpoint payload.
+func NewDiffSecretsPayload(source string, destination string) *secrets.DiffSecretsPayload {
+	v := &secrets.DiffSecretsPayload{}
+	v.Source = source
+	v.Destination = destination
+
+	return v
+}
+
+// NewPromoteSecretsPayload builds a secrets service promote secrets endpoint
+// payload.
+func NewPromoteSecretsPayload(body *PromoteSecretsRequestBody) *secrets.PromoteSecretsPayload {
+	v := &secrets.PromoteSecretsPayload{
+		Source:      *body.Source,
+		Destination: *body.Destination,
+	}
+	if body.Overwrite != nil {
+		v.Overwrite = *body.Overwrite
+	}
+	v.Keys = make([]string, len(body.Keys))
+	for i, val := range body.Keys {
+		v.Keys[i] = val
+	}
+	if body.Overwrite == nil {
+		v.Overwrite = false
+	}
+
+	return v
+}
+
+// NewTransferSecretOwnershipPayload builds a secrets service transfer secret
+// ownership endpoint payload.
+func NewTransferSecretOwnershipPayload(body *TransferSecretOwnershipRequestBody) *secrets.TransferSecretOwnershipPayload {
+	v := &secrets.TransferSecretOwnershipPayload{
+		Path:       body.Path,
+		FromUserID: body.FromUserID,
+		ToUserID:   body.ToUserID,
+		ToRoleID:   body.ToRoleID,
+	}
+
+	return v
+}
+
+// NewListStaleSecretsPayload builds a secrets service list stale secrets
+// endpoint payload.
+func NewListStaleSecretsPayload(days int) *secrets.ListStaleSecretsPayload {
+	v := &secrets.ListStaleSecretsPayload{}
+	v.Days = days
+
+	return v
+}
+
+// NewSetSecretCanaryPayload builds a secrets service set secret canary
+// endpoint payload.
+func NewSetSecretCanaryPayload(body *SetSecretCanaryRequestBody, path string) *secrets.SetSecretCanaryPayload {
+	v := &secrets.SetSecretCanaryPayload{
+		Canary: *body.Canary,
+	}
+	v.Path = path
+
+	return v
+}
+
+// NewWrapSecretPayload builds a secrets service wrap secret endpoint payload.
+func NewWrapSecretPayload(body *WrapSecretRequestBody) *secrets.WrapSecretPayload {
+	v := &secrets.WrapSecretPayload{
+		Value: body.Value,
+		Path:  body.Path,
+		Field: body.Field,
+	}
+	if body.TTL != nil {
+		v.TTL = *body.TTL
+	}
+	if body.TTL == nil {
+		v.TTL = 300
+	}
+
+	return v
+}
+
+// NewUnwrapSecretPayload builds a secrets service unwrap secret endpoint
+// payload.
+func NewUnwrapSecretPayload(body *UnwrapSecretRequestBody) *secrets.UnwrapSecretPayload {
+	v := &secrets.UnwrapSecretPayload{
+		Token: *body.Token,
+	}
+
+	return v
+}
+
+// NewRequestAccessPayload builds a secrets service request access endpoint
+// payload.
+func NewRequestAccessPayload(body *RequestAccessRequestBody) *secrets.RequestAccessPayload {
+	v := &secrets.RequestAccessPayload{
+		Path:          *body.Path,
+		Justification: *body.Justification,
+		Duration:      body.Duration,
+	}
+	if body.Capabilities != nil {
+		v.Capabilities = make([]string, len(body.Capabilities))
+		for i, val := range body.Capabilities {
+			v.Capabilities[i] = val
+		}
+	}
+	if body.Capabilities == nil {
+		v.Capabilities = []string{"read", "list"}
+	}
+
+	return v
+}
+
+// NewListAccessRequestsPayload builds a secrets service list access requests
+// endpoint payload.
+func NewListAccessRequestsPayload(status *string) *secrets.ListAccessRequestsPayload {
+	v := &secrets.ListAccessRequestsPayload{}
+	v.Status = status
+
+	return v
+}
+
+// NewApproveAccessRequestPayload builds a secrets service approve access
+// request endpoint payload.
+func NewApproveAccessRequestPayload(body *ApproveAccessRequestRequestBody, id int) *secrets.ApproveAccessRequestPayload {
+	v := &secrets.ApproveAccessRequestPayload{
+		Comment: body.Comment,
 	}
-	return body
+	v.ID = id
+
+	return v
 }
 
-// NewUpdateSecretUnauthorizedResponseBody builds the HTTP response body from
-// the result of the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretUnauthorizedResponseBody(res *goa.ServiceError) *UpdateSecretUnauthorizedResponseBody {
-	body := &UpdateSecretUnauthorizedResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+// NewDenyAccessRequestPayload builds a secrets service deny access request
+// endpoint payload.
+func NewDenyAccessRequestPayload(body *DenyAccessRequestRequestBody, id int) *secrets.DenyAccessRequestPayload {
+	v := &secrets.DenyAccessRequestPayload{
+		Comment: body.Comment,
 	}
-	return body
+	v.ID = id
+
+	return v
 }
 
-// NewUpdateSecretForbiddenResponseBody builds the HTTP response body from the
-// result of the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretForbiddenResponseBody(res *goa.ServiceError) *UpdateSecretForbiddenResponseBody {
-	body := &UpdateSecretForbiddenResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+// NewCreateValidationRulePayload builds a secrets service create validation
+// rule endpoint payload.
+func NewCreateValidationRulePayload(body *CreateValidationRuleRequestBody) *secrets.CreateValidationRulePayload {
+	v := &secrets.CreateValidationRulePayload{
+		PathPrefix: *body.PathPrefix,
+		Kind:       *body.Kind,
+		Parameter:  body.Parameter,
+		Field:      body.Field,
 	}
-	return body
+
+	return v
 }
 
-// NewUpdateSecretInternalErrorResponseBody builds the HTTP response body from
-// the result of the "update secret" endpoint of the "secrets" service.
-func NewUpdateSecretInternalErrorResponseBody(res *goa.ServiceError) *UpdateSecretInternalErrorResponseBody {
-	body := &UpdateSecretInternalErrorResponseBody{
-		Name:      res.Name,
-		ID:        res.ID,
-		Message:   res.Message,
-		Temporary: res.Temporary,
-		Timeout:   res.Timeout,
-		Fault:     res.Fault,
+// NewDeleteValidationRulePayload builds a secrets service delete validation
+// rule endpoint payload.
+func NewDeleteValidationRulePayload(id int) *secrets.DeleteValidationRulePayload {
+	v := &secrets.DeleteValidationRulePayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewCreateBreakGlassRulePayload builds a secrets service create break glass
+// rule endpoint payload.
+func NewCreateBreakGlassRulePayload(body *CreateBreakGlassRuleRequestBody) *secrets.CreateBreakGlassRulePayload {
+	v := &secrets.CreateBreakGlassRulePayload{
+		PathPrefix: *body.PathPrefix,
+		RoleID:     body.RoleID,
 	}
-	return body
+
+	return v
 }
 
-// NewGetSecretValuePayload builds a secrets service get secret value endpoint
-// payload.
-func NewGetSecretValuePayload(path string) *secrets.GetSecretValuePayload {
-	v := &secrets.GetSecretValuePayload{}
-	v.Path = path
+// NewDeleteBreakGlassRulePayload builds a secrets service delete break glass
+// rule endpoint payload.
+func NewDeleteBreakGlassRulePayload(id int) *secrets.DeleteBreakGlassRulePayload {
+	v := &secrets.DeleteBreakGlassRulePayload{}
+	v.ID = id
 
 	return v
 }
 
-// NewGetSecretPayload builds a secrets service get secret endpoint payload.
-func NewGetSecretPayload(path string) *secrets.GetSecretPayload {
-	v := &secrets.GetSecretPayload{}
-	v.Path = path
+// NewListBreakGlassAccessesPayload builds a secrets service list break glass
+// accesses endpoint payload.
+func NewListBreakGlassAccessesPayload(unreviewed bool) *secrets.ListBreakGlassAccessesPayload {
+	v := &secrets.ListBreakGlassAccessesPayload{}
+	v.Unreviewed = unreviewed
 
 	return v
 }
 
-// NewCreateSecretPayload builds a secrets service create secret endpoint
-// payload.
-func NewCreateSecretPayload(body *CreateSecretRequestBody) *secrets.CreateSecretPayload {
-	v := &secrets.CreateSecretPayload{
-		Path:  *body.Path,
-		Value: *body.Value,
+// NewReviewBreakGlassAccessPayload builds a secrets service review break glass
+// access endpoint payload.
+func NewReviewBreakGlassAccessPayload(body *ReviewBreakGlassAccessRequestBody, id int) *secrets.ReviewBreakGlassAccessPayload {
+	v := &secrets.ReviewBreakGlassAccessPayload{
+		Comment: body.Comment,
 	}
-	v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
-	for i, val := range body.AuthorizedUsers {
-		v.AuthorizedUsers[i] = val
+	v.ID = id
+
+	return v
+}
+
+// NewGrantFolderAccessPayload builds a secrets service grant folder access
+// endpoint payload.
+func NewGrantFolderAccessPayload(body *GrantFolderAccessRequestBody) *secrets.GrantFolderAccessPayload {
+	v := &secrets.GrantFolderAccessPayload{
+		PathPrefix: *body.PathPrefix,
+		UserID:     body.UserID,
+		RoleID:     body.RoleID,
 	}
-	v.AuthorizedRoles = make([]int, len(body.AuthorizedRoles))
-	for i, val := range body.AuthorizedRoles {
-		v.AuthorizedRoles[i] = val
+	if body.Capabilities != nil {
+		v.Capabilities = make([]string, len(body.Capabilities))
+		for i, val := range body.Capabilities {
+			v.Capabilities[i] = val
+		}
+	}
+	if body.Capabilities == nil {
+		v.Capabilities = []string{"read", "list"}
 	}
 
 	return v
 }
 
-// NewUpdateSecretPayload builds a secrets service update secret endpoint
-// payload.
-func NewUpdateSecretPayload(body *UpdateSecretRequestBody) *secrets.UpdateSecretPayload {
-	v := &secrets.UpdateSecretPayload{
-		Path:  *body.Path,
-		Value: *body.Value,
+// NewRevokeFolderAccessPayload builds a secrets service revoke folder access
+// endpoint payload.
+func NewRevokeFolderAccessPayload(id int) *secrets.RevokeFolderAccessPayload {
+	v := &secrets.RevokeFolderAccessPayload{}
+	v.ID = id
+
+	return v
+}
+
+// ValidateBatchGetSecretValuesRequestBody runs the validations defined on
+// Batch Get Secret ValuesRequestBody
+func ValidateBatchGetSecretValuesRequestBody(body *BatchGetSecretValuesRequestBody) (err error) {
+	if body.Secrets == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("secrets", "body"))
 	}
-	v.AuthorizedUsers = make([]int, len(body.AuthorizedUsers))
-	for i, val := range body.AuthorizedUsers {
-		v.AuthorizedUsers[i] = val
+	if len(body.Secrets) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.secrets", body.Secrets, len(body.Secrets), 1, true))
 	}
-	v.AuthorizedRoles = make([]int, len(body.AuthorizedRoles))
-	for i, val := range body.AuthorizedRoles {
-		v.AuthorizedRoles[i] = val
+	if len(body.Secrets) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.secrets", body.Secrets, len(body.Secrets), 100, false))
+	}
+	for _, e := range body.Secrets {
+		if e != nil {
+			if err2 := ValidateSecretValueRequestRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
+	return
+}
 
-	return v
+// ValidateRenderTemplateRequestBody runs the validations defined on Render
+// TemplateRequestBody
+func ValidateRenderTemplateRequestBody(body *RenderTemplateRequestBody) (err error) {
+	if body.Template != nil {
+		if utf8.RuneCountInString(*body.Template) > 65536 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.template", *body.Template, utf8.RuneCountInString(*body.Template), 65536, false))
+		}
+	}
+	if body.TemplatePath != nil {
+		if utf8.RuneCountInString(*body.TemplatePath) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.template_path", *body.TemplatePath, utf8.RuneCountInString(*body.TemplatePath), 2, true))
+		}
+	}
+	return
 }
 
 // ValidateCreateSecretRequestBody runs the validations defined on Create
@@ -966,9 +8126,6 @@ func ValidateCreateSecretRequestBody(body *CreateSecretRequestBody) (err error)
 	if body.Path == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
 	}
-	if body.Value == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
-	}
 	if body.AuthorizedUsers == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
 	}
@@ -985,6 +8142,20 @@ func ValidateCreateSecretRequestBody(body *CreateSecretRequestBody) (err error)
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
 		}
 	}
+	for _, e := range body.UserGrants {
+		if e != nil {
+			if err2 := ValidateGrantRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.RoleGrants {
+		if e != nil {
+			if err2 := ValidateGrantRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -994,24 +8165,306 @@ func ValidateUpdateSecretRequestBody(body *UpdateSecretRequestBody) (err error)
 	if body.Path == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
 	}
-	if body.Value == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	if body.Path != nil {
+		if utf8.RuneCountInString(*body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		}
 	}
-	if body.AuthorizedUsers == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_users", "body"))
+	if body.Value != nil {
+		if utf8.RuneCountInString(*body.Value) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
+		}
 	}
-	if body.AuthorizedRoles == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("authorized_roles", "body"))
+	for _, e := range body.UserGrants {
+		if e != nil {
+			if err2 := ValidateGrantRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.RoleGrants {
+		if e != nil {
+			if err2 := ValidateGrantRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateMoveSecretsRequestBody runs the validations defined on Move
+// SecretsRequestBody
+func ValidateMoveSecretsRequestBody(body *MoveSecretsRequestBody) (err error) {
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Destination == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("destination", "body"))
+	}
+	if body.Source != nil {
+		if utf8.RuneCountInString(*body.Source) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.source", *body.Source, utf8.RuneCountInString(*body.Source), 2, true))
+		}
+	}
+	if body.Destination != nil {
+		if utf8.RuneCountInString(*body.Destination) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.destination", *body.Destination, utf8.RuneCountInString(*body.Destination), 2, true))
+		}
+	}
+	return
+}
+
+// ValidatePromoteSecretsRequestBody runs the validations defined on Promote
+// SecretsRequestBody
+func ValidatePromoteSecretsRequestBody(body *PromoteSecretsRequestBody) (err error) {
+	if body.Source == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("source", "body"))
+	}
+	if body.Destination == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("destination", "body"))
+	}
+	if body.Keys == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keys", "body"))
+	}
+	if body.Source != nil {
+		if utf8.RuneCountInString(*body.Source) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.source", *body.Source, utf8.RuneCountInString(*body.Source), 2, true))
+		}
 	}
+	if body.Destination != nil {
+		if utf8.RuneCountInString(*body.Destination) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.destination", *body.Destination, utf8.RuneCountInString(*body.Destination), 2, true))
+		}
+	}
+	if len(body.Keys) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.keys", body.Keys, len(body.Keys), 1, true))
+	}
+	if len(body.Keys) > 1000 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.keys", body.Keys, len(body.Keys), 1000, false))
+	}
+	return
+}
+
+// ValidateTransferSecretOwnershipRequestBody runs the validations defined on
+// Transfer Secret OwnershipRequestBody
+func ValidateTransferSecretOwnershipRequestBody(body *TransferSecretOwnershipRequestBody) (err error) {
 	if body.Path != nil {
-		if utf8.RuneCountInString(*body.Path) < 2 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		if utf8.RuneCountInString(*body.Path) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 1, true))
 		}
 	}
+	return
+}
+
+// ValidateSetSecretCanaryRequestBody runs the validations defined on Set
+// Secret CanaryRequestBody
+func ValidateSetSecretCanaryRequestBody(body *SetSecretCanaryRequestBody) (err error) {
+	if body.Canary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("canary", "body"))
+	}
+	return
+}
+
+// ValidateWrapSecretRequestBody runs the validations defined on Wrap
+// SecretRequestBody
+func ValidateWrapSecretRequestBody(body *WrapSecretRequestBody) (err error) {
 	if body.Value != nil {
 		if utf8.RuneCountInString(*body.Value) < 1 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.value", *body.Value, utf8.RuneCountInString(*body.Value), 1, true))
 		}
 	}
+	if body.Path != nil {
+		if utf8.RuneCountInString(*body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 1, true))
+		}
+	}
+	if body.TTL != nil {
+		if *body.TTL > 86400 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.ttl", *body.TTL, 86400, false))
+		}
+	}
+	return
+}
+
+// ValidateUnwrapSecretRequestBody runs the validations defined on Unwrap
+// SecretRequestBody
+func ValidateUnwrapSecretRequestBody(body *UnwrapSecretRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	return
+}
+
+// ValidateRequestAccessRequestBody runs the validations defined on Request
+// AccessRequestBody
+func ValidateRequestAccessRequestBody(body *RequestAccessRequestBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Justification == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("justification", "body"))
+	}
+	if body.Path != nil {
+		if utf8.RuneCountInString(*body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		}
+	}
+	if len(body.Capabilities) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.capabilities", body.Capabilities, len(body.Capabilities), 1, true))
+	}
+	for _, e := range body.Capabilities {
+		if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	if body.Justification != nil {
+		if utf8.RuneCountInString(*body.Justification) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.justification", *body.Justification, utf8.RuneCountInString(*body.Justification), 1, true))
+		}
+	}
+	if body.Justification != nil {
+		if utf8.RuneCountInString(*body.Justification) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.justification", *body.Justification, utf8.RuneCountInString(*body.Justification), 1000, false))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration < 60 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 60, true))
+		}
+	}
+	if body.Duration != nil {
+		if *body.Duration > 7.776e+06 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration", *body.Duration, 7.776e+06, false))
+		}
+	}
+	return
+}
+
+// ValidateApproveAccessRequestRequestBody runs the validations defined on
+// Approve Access RequestRequestBody
+func ValidateApproveAccessRequestRequestBody(body *ApproveAccessRequestRequestBody) (err error) {
+	if body.Comment != nil {
+		if utf8.RuneCountInString(*body.Comment) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateDenyAccessRequestRequestBody runs the validations defined on Deny
+// Access RequestRequestBody
+func ValidateDenyAccessRequestRequestBody(body *DenyAccessRequestRequestBody) (err error) {
+	if body.Comment != nil {
+		if utf8.RuneCountInString(*body.Comment) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateCreateValidationRuleRequestBody runs the validations defined on
+// Create Validation RuleRequestBody
+func ValidateCreateValidationRuleRequestBody(body *CreateValidationRuleRequestBody) (err error) {
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.Kind == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("kind", "body"))
+	}
+	if body.PathPrefix != nil {
+		if utf8.RuneCountInString(*body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", *body.PathPrefix, utf8.RuneCountInString(*body.PathPrefix), 1, true))
+		}
+	}
+	if body.Kind != nil {
+		if !(*body.Kind == "json_schema" || *body.Kind == "regex" || *body.Kind == "max_length" || *body.Kind == "pem_type" || *body.Kind == "x509_certificate") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.kind", *body.Kind, []any{"json_schema", "regex", "max_length", "pem_type", "x509_certificate"}))
+		}
+	}
+	return
+}
+
+// ValidateCreateBreakGlassRuleRequestBody runs the validations defined on
+// Create Break Glass RuleRequestBody
+func ValidateCreateBreakGlassRuleRequestBody(body *CreateBreakGlassRuleRequestBody) (err error) {
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.PathPrefix != nil {
+		if utf8.RuneCountInString(*body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", *body.PathPrefix, utf8.RuneCountInString(*body.PathPrefix), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateReviewBreakGlassAccessRequestBody runs the validations defined on
+// Review Break Glass AccessRequestBody
+func ValidateReviewBreakGlassAccessRequestBody(body *ReviewBreakGlassAccessRequestBody) (err error) {
+	if body.Comment != nil {
+		if utf8.RuneCountInString(*body.Comment) > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.comment", *body.Comment, utf8.RuneCountInString(*body.Comment), 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateGrantFolderAccessRequestBody runs the validations defined on Grant
+// Folder AccessRequestBody
+func ValidateGrantFolderAccessRequestBody(body *GrantFolderAccessRequestBody) (err error) {
+	if body.PathPrefix == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path_prefix", "body"))
+	}
+	if body.PathPrefix != nil {
+		if utf8.RuneCountInString(*body.PathPrefix) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path_prefix", *body.PathPrefix, utf8.RuneCountInString(*body.PathPrefix), 1, true))
+		}
+	}
+	for _, e := range body.Capabilities {
+		if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	return
+}
+
+// ValidateSecretValueRequestRequestBody runs the validations defined on
+// SecretValueRequestRequestBody
+func ValidateSecretValueRequestRequestBody(body *SecretValueRequestRequestBody) (err error) {
+	if body.Path == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("path", "body"))
+	}
+	if body.Path != nil {
+		if utf8.RuneCountInString(*body.Path) < 2 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.path", *body.Path, utf8.RuneCountInString(*body.Path), 2, true))
+		}
+	}
+	return
+}
+
+// ValidateGrantRequestBody runs the validations defined on GrantRequestBody
+func ValidateGrantRequestBody(body *GrantRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Capabilities == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("capabilities", "body"))
+	}
+	if len(body.Capabilities) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.capabilities", body.Capabilities, len(body.Capabilities), 1, true))
+	}
+	for _, e := range body.Capabilities {
+		if !(e == "read" || e == "list" || e == "update" || e == "delete" || e == "manage_access") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.capabilities[*]", e, []any{"read", "list", "update", "delete", "manage_access"}))
+		}
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
 	return
 }
@@ -43,7 +43,7 @@ func BuildCreateUserPayload(usersCreateUserBody string) (*users.CreateUserPayloa
 
 // BuildDeleteUserPayload builds the payload for the users delete user endpoint
 // from CLI flags.
-func BuildDeleteUserPayload(usersDeleteUserUsername string) (*users.DeleteUserPayload, error) {
+func BuildDeleteUserPayload(usersDeleteUserUsername string, usersDeleteUserReassignTo string) (*users.DeleteUserPayload, error) {
 	var err error
 	var username string
 	{
@@ -55,8 +55,21 @@ func BuildDeleteUserPayload(usersDeleteUserUsername string) (*users.DeleteUserPa
 			return nil, err
 		}
 	}
+	var reassignTo *string
+	{
+		if usersDeleteUserReassignTo != "" {
+			reassignTo = &usersDeleteUserReassignTo
+			if utf8.RuneCountInString(*reassignTo) < 3 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("reassign_to", *reassignTo, utf8.RuneCountInString(*reassignTo), 3, true))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	v := &users.DeleteUserPayload{}
 	v.Username = username
+	v.ReassignTo = reassignTo
 
 	return v, nil
 }
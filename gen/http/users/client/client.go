@@ -121,6 +121,7 @@ func (c *Client) ListUsers() goa.Endpoint {
 // delete user server.
 func (c *Client) DeleteUser() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeDeleteUserRequest(c.encoder)
 		decodeResponse = DecodeDeleteUserResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -128,6 +129,10 @@ func (c *Client) DeleteUser() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.DeleteUserDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("users", "delete user", err)
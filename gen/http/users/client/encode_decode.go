@@ -255,11 +255,29 @@ func (c *Client) BuildDeleteUserRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
+// EncodeDeleteUserRequest returns an encoder for requests sent to the users
+// delete user server.
+func EncodeDeleteUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*users.DeleteUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("users", "delete user", "*users.DeleteUserPayload", v)
+		}
+		values := req.URL.Query()
+		if p.ReassignTo != nil {
+			values.Add("reassign_to", *p.ReassignTo)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
 // DecodeDeleteUserResponse returns a decoder for responses returned by the
 // users delete user endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
 // DecodeDeleteUserResponse may return the following errors:
 //   - "user_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "user_owns_secrets" (type *goa.ServiceError): http.StatusConflict
 //   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
 //   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
 //   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
@@ -296,6 +314,20 @@ func DecodeDeleteUserResponse(decoder func(*http.Response) goahttp.Decoder, rest
 				return nil, goahttp.ErrValidationError("users", "delete user", err)
 			}
 			return nil, NewDeleteUserUserNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteUserUserOwnsSecretsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("users", "delete user", err)
+			}
+			err = ValidateDeleteUserUserOwnsSecretsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("users", "delete user", err)
+			}
+			return nil, NewDeleteUserUserOwnsSecrets(&body)
 		case http.StatusInternalServerError:
 			var (
 				body DeleteUserInternalErrorResponseBody
@@ -171,6 +171,24 @@ type DeleteUserUserNotFoundResponseBody struct {
 	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
 }
 
+// DeleteUserUserOwnsSecretsResponseBody is the type of the "users" service
+// "delete user" endpoint HTTP response body for the "user_owns_secrets" error.
+type DeleteUserUserOwnsSecretsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Is the error temporary?
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// Is the error a timeout?
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+	// Is the error a server-side fault?
+	Fault *bool `form:"fault,omitempty" json:"fault,omitempty" xml:"fault,omitempty"`
+}
+
 // DeleteUserInternalErrorResponseBody is the type of the "users" service
 // "delete user" endpoint HTTP response body for the "internal_error" error.
 type DeleteUserInternalErrorResponseBody struct {
@@ -478,6 +496,21 @@ func NewDeleteUserUserNotFound(body *DeleteUserUserNotFoundResponseBody) *goa.Se
 	return v
 }
 
+// NewDeleteUserUserOwnsSecrets builds a users service delete user endpoint
+// user_owns_secrets error.
+func NewDeleteUserUserOwnsSecrets(body *DeleteUserUserOwnsSecretsResponseBody) *goa.ServiceError {
+	v := &goa.ServiceError{
+		Name:      *body.Name,
+		ID:        *body.ID,
+		Message:   *body.Message,
+		Temporary: *body.Temporary,
+		Timeout:   *body.Timeout,
+		Fault:     *body.Fault,
+	}
+
+	return v
+}
+
 // NewDeleteUserInternalError builds a users service delete user endpoint
 // internal_error error.
 func NewDeleteUserInternalError(body *DeleteUserInternalErrorResponseBody) *goa.ServiceError {
@@ -776,6 +809,30 @@ func ValidateDeleteUserUserNotFoundResponseBody(body *DeleteUserUserNotFoundResp
 	return
 }
 
+// ValidateDeleteUserUserOwnsSecretsResponseBody runs the validations defined
+// on delete user_user_owns_secrets_response_body
+func ValidateDeleteUserUserOwnsSecretsResponseBody(body *DeleteUserUserOwnsSecretsResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Temporary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("temporary", "body"))
+	}
+	if body.Timeout == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("timeout", "body"))
+	}
+	if body.Fault == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fault", "body"))
+	}
+	return
+}
+
 // ValidateDeleteUserInternalErrorResponseBody runs the validations defined on
 // delete user_internal_error_response_body
 func ValidateDeleteUserInternalErrorResponseBody(body *DeleteUserInternalErrorResponseBody) (err error) {
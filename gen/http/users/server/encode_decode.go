@@ -183,8 +183,9 @@ func EncodeDeleteUserResponse(encoder func(context.Context, http.ResponseWriter)
 func DecodeDeleteUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			username string
-			err      error
+			username   string
+			reassignTo *string
+			err        error
 
 			params = mux.Vars(r)
 		)
@@ -192,10 +193,19 @@ func DecodeDeleteUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		if utf8.RuneCountInString(username) < 3 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("username", username, utf8.RuneCountInString(username), 3, true))
 		}
+		reassignToRaw := r.URL.Query().Get("reassign_to")
+		if reassignToRaw != "" {
+			reassignTo = &reassignToRaw
+		}
+		if reassignTo != nil {
+			if utf8.RuneCountInString(*reassignTo) < 3 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("reassign_to", *reassignTo, utf8.RuneCountInString(*reassignTo), 3, true))
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteUserPayload(username)
+		payload := NewDeleteUserPayload(username, reassignTo)
 
 		return payload, nil
 	}
@@ -224,6 +234,19 @@ func EncodeDeleteUserError(encoder func(context.Context, http.ResponseWriter) go
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusNotFound)
 			return enc.Encode(body)
+		case "user_owns_secrets":
+			var res *goa.ServiceError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteUserUserOwnsSecretsResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "internal_error":
 			var res *goa.ServiceError
 			errors.As(v, &res)
@@ -171,6 +171,24 @@ type DeleteUserUserNotFoundResponseBody struct {
 	Fault bool `form:"fault" json:"fault" xml:"fault"`
 }
 
+// DeleteUserUserOwnsSecretsResponseBody is the type of the "users" service
+// "delete user" endpoint HTTP response body for the "user_owns_secrets" error.
+type DeleteUserUserOwnsSecretsResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
 // DeleteUserInternalErrorResponseBody is the type of the "users" service
 // "delete user" endpoint HTTP response body for the "internal_error" error.
 type DeleteUserInternalErrorResponseBody struct {
@@ -470,6 +488,20 @@ func NewDeleteUserUserNotFoundResponseBody(res *goa.ServiceError) *DeleteUserUse
 	return body
 }
 
+// NewDeleteUserUserOwnsSecretsResponseBody builds the HTTP response body from
+// the result of the "delete user" endpoint of the "users" service.
+func NewDeleteUserUserOwnsSecretsResponseBody(res *goa.ServiceError) *DeleteUserUserOwnsSecretsResponseBody {
+	body := &DeleteUserUserOwnsSecretsResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
 // NewDeleteUserInternalErrorResponseBody builds the HTTP response body from
 // the result of the "delete user" endpoint of the "users" service.
 func NewDeleteUserInternalErrorResponseBody(res *goa.ServiceError) *DeleteUserInternalErrorResponseBody {
@@ -593,9 +625,10 @@ func NewCreateUserPayload(body *CreateUserRequestBody) *users.CreateUserPayload
 }
 
 // NewDeleteUserPayload builds a users service delete user endpoint payload.
-func NewDeleteUserPayload(username string) *users.DeleteUserPayload {
+func NewDeleteUserPayload(username string, reassignTo *string) *users.DeleteUserPayload {
 	v := &users.DeleteUserPayload{}
 	v.Username = username
+	v.ReassignTo = reassignTo
 
 	return v
 }
@@ -0,0 +1,229 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks HTTP client CLI support package
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	webhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildCreateWebhookPayload builds the payload for the webhooks create webhook
+// endpoint from CLI flags.
+func BuildCreateWebhookPayload(webhooksCreateWebhookBody string) (*webhooks.WebhookParameters, error) {
+	var err error
+	var body CreateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(webhooksCreateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled\": true,\n      \"events\": [\n         \"secret.updated\",\n         \"secret.rotated\"\n      ],\n      \"name\": \"restart-api\",\n      \"path_prefix\": \"/customers/\",\n      \"url\": \"https://hooks.example.com/fishykeys\"\n   }'")
+		}
+		if body.Events == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 255, false))
+		}
+		if len(body.Events) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &webhooks.WebhookParameters{
+		Name:       body.Name,
+		URL:        body.URL,
+		PathPrefix: body.PathPrefix,
+		Enabled:    body.Enabled,
+	}
+	if body.Events != nil {
+		v.Events = make([]string, len(body.Events))
+		for i, val := range body.Events {
+			v.Events[i] = val
+		}
+	} else {
+		v.Events = []string{}
+	}
+	{
+		var zero bool
+		if v.Enabled == zero {
+			v.Enabled = true
+		}
+	}
+
+	return v, nil
+}
+
+// BuildUpdateWebhookPayload builds the payload for the webhooks update webhook
+// endpoint from CLI flags.
+func BuildUpdateWebhookPayload(webhooksUpdateWebhookBody string, webhooksUpdateWebhookID string) (*webhooks.UpdateWebhookPayload, error) {
+	var err error
+	var body UpdateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(webhooksUpdateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"enabled\": true,\n      \"events\": [\n         \"secret.updated\",\n         \"secret.rotated\"\n      ],\n      \"name\": \"restart-api\",\n      \"path_prefix\": \"/customers/\",\n      \"url\": \"https://hooks.example.com/fishykeys\"\n   }'")
+		}
+		if body.Events == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+		}
+		if utf8.RuneCountInString(body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+		}
+		if utf8.RuneCountInString(body.Name) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 255, false))
+		}
+		if len(body.Events) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(webhooksUpdateWebhookID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &webhooks.UpdateWebhookPayload{
+		Name:       body.Name,
+		URL:        body.URL,
+		PathPrefix: body.PathPrefix,
+		Enabled:    body.Enabled,
+	}
+	if body.Events != nil {
+		v.Events = make([]string, len(body.Events))
+		for i, val := range body.Events {
+			v.Events[i] = val
+		}
+	} else {
+		v.Events = []string{}
+	}
+	{
+		var zero bool
+		if v.Enabled == zero {
+			v.Enabled = true
+		}
+	}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the webhooks delete webhook
+// endpoint from CLI flags.
+func BuildDeleteWebhookPayload(webhooksDeleteWebhookID string) (*webhooks.DeleteWebhookPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(webhooksDeleteWebhookID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	v := &webhooks.DeleteWebhookPayload{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the webhooks list
+// webhook deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(webhooksListWebhookDeliveriesID string, webhooksListWebhookDeliveriesStatus string, webhooksListWebhookDeliveriesLimit string) (*webhooks.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(webhooksListWebhookDeliveriesID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	var status *string
+	{
+		if webhooksListWebhookDeliveriesStatus != "" {
+			status = &webhooksListWebhookDeliveriesStatus
+			if !(*status == "pending" || *status == "succeeded" || *status == "failed") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"pending", "succeeded", "failed"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var limit int
+	{
+		if webhooksListWebhookDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(webhooksListWebhookDeliveriesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &webhooks.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.Status = status
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildReplayWebhookDeliveryPayload builds the payload for the webhooks replay
+// webhook delivery endpoint from CLI flags.
+func BuildReplayWebhookDeliveryPayload(webhooksReplayWebhookDeliveryID string, webhooksReplayWebhookDeliveryDeliveryID string) (*webhooks.ReplayWebhookDeliveryPayload, error) {
+	var err error
+	var id int
+	{
+		var v int64
+		v, err = strconv.ParseInt(webhooksReplayWebhookDeliveryID, 10, strconv.IntSize)
+		id = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT")
+		}
+	}
+	var deliveryID int
+	{
+		var v int64
+		v, err = strconv.ParseInt(webhooksReplayWebhookDeliveryDeliveryID, 10, strconv.IntSize)
+		deliveryID = int(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for deliveryID, must be INT")
+		}
+	}
+	v := &webhooks.ReplayWebhookDeliveryPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+
+	return v, nil
+}
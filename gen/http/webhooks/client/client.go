@@ -0,0 +1,209 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks client HTTP transport
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"context"
+	"net/http"
+
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client lists the webhooks service endpoint HTTP clients.
+type Client struct {
+	// ListWebhooks Doer is the HTTP client used to make requests to the list
+	// webhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// CreateWebhook Doer is the HTTP client used to make requests to the create
+	// webhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// UpdateWebhook Doer is the HTTP client used to make requests to the update
+	// webhook endpoint.
+	UpdateWebhookDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the delete
+	// webhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// list webhook deliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
+	// ReplayWebhookDelivery Doer is the HTTP client used to make requests to the
+	// replay webhook delivery endpoint.
+	ReplayWebhookDeliveryDoer goahttp.Doer
+
+	// CORS Doer is the HTTP client used to make requests to the  endpoint.
+	CORSDoer goahttp.Doer
+
+	// RestoreResponseBody controls whether the response bodies are reset after
+	// decoding so they can be read again.
+	RestoreResponseBody bool
+
+	scheme  string
+	host    string
+	encoder func(*http.Request) goahttp.Encoder
+	decoder func(*http.Response) goahttp.Decoder
+}
+
+// NewClient instantiates HTTP clients for all the webhooks service servers.
+func NewClient(
+	scheme string,
+	host string,
+	doer goahttp.Doer,
+	enc func(*http.Request) goahttp.Encoder,
+	dec func(*http.Response) goahttp.Decoder,
+	restoreBody bool,
+) *Client {
+	return &Client{
+		ListWebhooksDoer:          doer,
+		CreateWebhookDoer:         doer,
+		UpdateWebhookDoer:         doer,
+		DeleteWebhookDoer:         doer,
+		ListWebhookDeliveriesDoer: doer,
+		ReplayWebhookDeliveryDoer: doer,
+		CORSDoer:                  doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the webhooks
+// service list webhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "list webhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the webhooks
+// service create webhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "create webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateWebhook returns an endpoint that makes HTTP requests to the webhooks
+// service update webhook server.
+func (c *Client) UpdateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateWebhookRequest(c.encoder)
+		decodeResponse = DecodeUpdateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "update webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the webhooks
+// service delete webhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "delete webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// webhooks service list webhook deliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "list webhook deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ReplayWebhookDelivery returns an endpoint that makes HTTP requests to the
+// webhooks service replay webhook delivery server.
+func (c *Client) ReplayWebhookDelivery() goa.Endpoint {
+	var (
+		decodeResponse = DecodeReplayWebhookDeliveryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildReplayWebhookDeliveryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ReplayWebhookDeliveryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("webhooks", "replay webhook delivery", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -0,0 +1,939 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	webhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "webhooks" service "list webhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksWebhooksPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "list webhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// webhooks list webhooks endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhooks", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhooks", err)
+			}
+			res := NewListWebhooksWebhookOK(body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhooksInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhooks", err)
+			}
+			err = ValidateListWebhooksInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhooks", err)
+			}
+			return nil, NewListWebhooksInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ListWebhooksForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhooks", err)
+			}
+			err = ValidateListWebhooksForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhooks", err)
+			}
+			return nil, NewListWebhooksForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhooksUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhooks", err)
+			}
+			err = ValidateListWebhooksUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhooks", err)
+			}
+			return nil, NewListWebhooksUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "list webhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhooks" service "create webhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookWebhooksPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "create webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the
+// webhooks create webhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhooks.WebhookParameters)
+		if !ok {
+			return goahttp.ErrInvalidType("webhooks", "create webhook", "*webhooks.WebhookParameters", v)
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("webhooks", "create webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// webhooks create webhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "webhook_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			res := NewCreateWebhookResultCreated(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body CreateWebhookWebhookTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookWebhookTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			return nil, NewCreateWebhookWebhookTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body CreateWebhookInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			return nil, NewCreateWebhookInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body CreateWebhookInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			return nil, NewCreateWebhookInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body CreateWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			return nil, NewCreateWebhookForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "create webhook", err)
+			}
+			err = ValidateCreateWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "create webhook", err)
+			}
+			return nil, NewCreateWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "create webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhooks" service "update webhook" endpoint
+func (c *Client) BuildUpdateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*webhooks.UpdateWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhooks", "update webhook", "*webhooks.UpdateWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateWebhookWebhooksPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "update webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateWebhookRequest returns an encoder for requests sent to the
+// webhooks update webhook server.
+func EncodeUpdateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhooks.UpdateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhooks", "update webhook", "*webhooks.UpdateWebhookPayload", v)
+		}
+		body := NewUpdateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("webhooks", "update webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateWebhookResponse returns a decoder for responses returned by the
+// webhooks update webhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeUpdateWebhookResponse may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "webhook_taken" (type *goa.ServiceError): http.StatusConflict
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			res := NewUpdateWebhookWebhookOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body UpdateWebhookWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookWebhookNotFound(&body)
+		case http.StatusConflict:
+			var (
+				body UpdateWebhookWebhookTakenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookWebhookTakenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookWebhookTaken(&body)
+		case http.StatusBadRequest:
+			var (
+				body UpdateWebhookInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body UpdateWebhookInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body UpdateWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "update webhook", err)
+			}
+			err = ValidateUpdateWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "update webhook", err)
+			}
+			return nil, NewUpdateWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "update webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "webhooks" service "delete webhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*webhooks.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhooks", "delete webhook", "*webhooks.DeleteWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookWebhooksPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "delete webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// webhooks delete webhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteWebhookWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "delete webhook", err)
+			}
+			err = ValidateDeleteWebhookWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "delete webhook", err)
+			}
+			return nil, NewDeleteWebhookWebhookNotFound(&body)
+		case http.StatusInternalServerError:
+			var (
+				body DeleteWebhookInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "delete webhook", err)
+			}
+			err = ValidateDeleteWebhookInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "delete webhook", err)
+			}
+			return nil, NewDeleteWebhookInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body DeleteWebhookForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "delete webhook", err)
+			}
+			err = ValidateDeleteWebhookForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "delete webhook", err)
+			}
+			return nil, NewDeleteWebhookForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "delete webhook", err)
+			}
+			err = ValidateDeleteWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "delete webhook", err)
+			}
+			return nil, NewDeleteWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "delete webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "webhooks" service "list webhook deliveries"
+// endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int
+	)
+	{
+		p, ok := v.(*webhooks.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhooks", "list webhook deliveries", "*webhooks.ListWebhookDeliveriesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesWebhooksPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "list webhook deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the webhooks list webhook deliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*webhooks.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("webhooks", "list webhook deliveries", "*webhooks.ListWebhookDeliveriesPayload", v)
+		}
+		values := req.URL.Query()
+		if p.Status != nil {
+			values.Add("status", *p.Status)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the webhooks list webhook deliveries endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateWebhookDeliveryResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			res := NewListWebhookDeliveriesWebhookDeliveryOK(body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListWebhookDeliveriesWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesWebhookNotFound(&body)
+		case http.StatusBadRequest:
+			var (
+				body ListWebhookDeliveriesInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ListWebhookDeliveriesInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ListWebhookDeliveriesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhookDeliveriesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "list webhook deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "list webhook deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "list webhook deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildReplayWebhookDeliveryRequest instantiates a HTTP request object with
+// method and path set to call the "webhooks" service "replay webhook delivery"
+// endpoint
+func (c *Client) BuildReplayWebhookDeliveryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id         int
+		deliveryID int
+	)
+	{
+		p, ok := v.(*webhooks.ReplayWebhookDeliveryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("webhooks", "replay webhook delivery", "*webhooks.ReplayWebhookDeliveryPayload", v)
+		}
+		id = p.ID
+		deliveryID = p.DeliveryID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ReplayWebhookDeliveryWebhooksPath(id, deliveryID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("webhooks", "replay webhook delivery", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeReplayWebhookDeliveryResponse returns a decoder for responses returned
+// by the webhooks replay webhook delivery endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeReplayWebhookDeliveryResponse may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "webhook_delivery_not_found" (type *goa.ServiceError): http.StatusNotFound
+//   - "invalid_parameters" (type *goa.ServiceError): http.StatusBadRequest
+//   - "internal_error" (type *goa.ServiceError): http.StatusInternalServerError
+//   - "forbidden" (type *goa.ServiceError): http.StatusForbidden
+//   - "unauthorized" (type *goa.ServiceError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeReplayWebhookDeliveryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body ReplayWebhookDeliveryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+			}
+			err = ValidateReplayWebhookDeliveryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+			}
+			res := NewReplayWebhookDeliveryWebhookDeliveryCreated(&body)
+			return res, nil
+		case http.StatusNotFound:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "webhook_not_found":
+				var (
+					body ReplayWebhookDeliveryWebhookNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+				}
+				err = ValidateReplayWebhookDeliveryWebhookNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+				}
+				return nil, NewReplayWebhookDeliveryWebhookNotFound(&body)
+			case "webhook_delivery_not_found":
+				var (
+					body ReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+				}
+				err = ValidateReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+				}
+				return nil, NewReplayWebhookDeliveryWebhookDeliveryNotFound(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("webhooks", "replay webhook delivery", resp.StatusCode, string(body))
+			}
+		case http.StatusBadRequest:
+			var (
+				body ReplayWebhookDeliveryInvalidParametersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+			}
+			err = ValidateReplayWebhookDeliveryInvalidParametersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+			}
+			return nil, NewReplayWebhookDeliveryInvalidParameters(&body)
+		case http.StatusInternalServerError:
+			var (
+				body ReplayWebhookDeliveryInternalErrorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+			}
+			err = ValidateReplayWebhookDeliveryInternalErrorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+			}
+			return nil, NewReplayWebhookDeliveryInternalError(&body)
+		case http.StatusForbidden:
+			var (
+				body ReplayWebhookDeliveryForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+			}
+			err = ValidateReplayWebhookDeliveryForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+			}
+			return nil, NewReplayWebhookDeliveryForbidden(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ReplayWebhookDeliveryUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("webhooks", "replay webhook delivery", err)
+			}
+			err = ValidateReplayWebhookDeliveryUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("webhooks", "replay webhook delivery", err)
+			}
+			return nil, NewReplayWebhookDeliveryUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("webhooks", "replay webhook delivery", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalWebhookResponseToWebhooksWebhook builds a value of type
+// *webhooks.Webhook from a value of type *WebhookResponse.
+func unmarshalWebhookResponseToWebhooksWebhook(v *WebhookResponse) *webhooks.Webhook {
+	res := &webhooks.Webhook{
+		ID:         *v.ID,
+		CreatedAt:  *v.CreatedAt,
+		UpdatedAt:  *v.UpdatedAt,
+		Name:       *v.Name,
+		URL:        *v.URL,
+		PathPrefix: v.PathPrefix,
+		Enabled:    *v.Enabled,
+	}
+	res.Events = make([]string, len(v.Events))
+	for i, val := range v.Events {
+		res.Events[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseToWebhooksWebhookDelivery builds a value of
+// type *webhooks.WebhookDelivery from a value of type *WebhookDeliveryResponse.
+func unmarshalWebhookDeliveryResponseToWebhooksWebhookDelivery(v *WebhookDeliveryResponse) *webhooks.WebhookDelivery {
+	res := &webhooks.WebhookDelivery{
+		ID:             *v.ID,
+		EventID:        *v.EventID,
+		EventType:      *v.EventType,
+		Payload:        *v.Payload,
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		NextAttemptAt:  v.NextAttemptAt,
+		LastAttemptAt:  v.LastAttemptAt,
+		ResponseStatus: v.ResponseStatus,
+		Error:          v.Error,
+		ReplayOf:       v.ReplayOf,
+		CreatedAt:      *v.CreatedAt,
+	}
+
+	return res
+}
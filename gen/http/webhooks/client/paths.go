@@ -0,0 +1,42 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// HTTP request path constructors for the webhooks service.
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// ListWebhooksWebhooksPath returns the URL path to the webhooks service list webhooks HTTP endpoint.
+func ListWebhooksWebhooksPath() string {
+	return "/webhooks"
+}
+
+// CreateWebhookWebhooksPath returns the URL path to the webhooks service create webhook HTTP endpoint.
+func CreateWebhookWebhooksPath() string {
+	return "/webhooks"
+}
+
+// UpdateWebhookWebhooksPath returns the URL path to the webhooks service update webhook HTTP endpoint.
+func UpdateWebhookWebhooksPath(id int) string {
+	return fmt.Sprintf("/webhooks/%v", id)
+}
+
+// DeleteWebhookWebhooksPath returns the URL path to the webhooks service delete webhook HTTP endpoint.
+func DeleteWebhookWebhooksPath(id int) string {
+	return fmt.Sprintf("/webhooks/%v", id)
+}
+
+// ListWebhookDeliveriesWebhooksPath returns the URL path to the webhooks service list webhook deliveries HTTP endpoint.
+func ListWebhookDeliveriesWebhooksPath(id int) string {
+	return fmt.Sprintf("/webhooks/%v/deliveries", id)
+}
+
+// ReplayWebhookDeliveryWebhooksPath returns the URL path to the webhooks service replay webhook delivery HTTP endpoint.
+func ReplayWebhookDeliveryWebhooksPath(id int, deliveryID int) string {
+	return fmt.Sprintf("/webhooks/%v/deliveries/%v/replay", id, deliveryID)
+}
@@ -0,0 +1,456 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks HTTP server
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"context"
+	"net/http"
+
+	webhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+	"goa.design/plugins/v3/cors"
+)
+
+// Server lists the webhooks service endpoint HTTP handlers.
+type Server struct {
+	Mounts                []*MountPoint
+	ListWebhooks          http.Handler
+	CreateWebhook         http.Handler
+	UpdateWebhook         http.Handler
+	DeleteWebhook         http.Handler
+	ListWebhookDeliveries http.Handler
+	ReplayWebhookDelivery http.Handler
+	CORS                  http.Handler
+}
+
+// MountPoint holds information about the mounted endpoints.
+type MountPoint struct {
+	// Method is the name of the service method served by the mounted HTTP handler.
+	Method string
+	// Verb is the HTTP method used to match requests to the mounted handler.
+	Verb string
+	// Pattern is the HTTP request path pattern used to match requests to the
+	// mounted handler.
+	Pattern string
+}
+
+// New instantiates HTTP handlers for all the webhooks service endpoints using
+// the provided encoder and decoder. The handlers are mounted on the given mux
+// using the HTTP verb and path defined in the design. errhandler is called
+// whenever a response fails to be encoded. formatter is used to format errors
+// returned by the service methods prior to encoding. Both errhandler and
+// formatter are optional and can be nil.
+func New(
+	e *webhooks.Endpoints,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) *Server {
+	return &Server{
+		Mounts: []*MountPoint{
+			{"ListWebhooks", "GET", "/webhooks"},
+			{"CreateWebhook", "POST", "/webhooks"},
+			{"UpdateWebhook", "PUT", "/webhooks/{id}"},
+			{"DeleteWebhook", "DELETE", "/webhooks/{id}"},
+			{"ListWebhookDeliveries", "GET", "/webhooks/{id}/deliveries"},
+			{"ReplayWebhookDelivery", "POST", "/webhooks/{id}/deliveries/{delivery_id}/replay"},
+			{"CORS", "OPTIONS", "/webhooks"},
+			{"CORS", "OPTIONS", "/webhooks/{id}"},
+			{"CORS", "OPTIONS", "/webhooks/{id}/deliveries"},
+			{"CORS", "OPTIONS", "/webhooks/{id}/deliveries/{delivery_id}/replay"},
+		},
+		ListWebhooks:          NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		CreateWebhook:         NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		UpdateWebhook:         NewUpdateWebhookHandler(e.UpdateWebhook, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:         NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries: NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		ReplayWebhookDelivery: NewReplayWebhookDeliveryHandler(e.ReplayWebhookDelivery, mux, decoder, encoder, errhandler, formatter),
+		CORS:                  NewCORSHandler(),
+	}
+}
+
+// Service returns the name of the service served.
+func (s *Server) Service() string { return "webhooks" }
+
+// Use wraps the server handlers with the given middleware.
+func (s *Server) Use(m func(http.Handler) http.Handler) {
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.UpdateWebhook = m(s.UpdateWebhook)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
+	s.ReplayWebhookDelivery = m(s.ReplayWebhookDelivery)
+	s.CORS = m(s.CORS)
+}
+
+// MethodNames returns the methods served.
+func (s *Server) MethodNames() []string { return webhooks.MethodNames[:] }
+
+// Mount configures the mux to serve the webhooks endpoints.
+func Mount(mux goahttp.Muxer, h *Server) {
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountUpdateWebhookHandler(mux, h.UpdateWebhook)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
+	MountReplayWebhookDeliveryHandler(mux, h.ReplayWebhookDelivery)
+	MountCORSHandler(mux, h.CORS)
+}
+
+// Mount configures the mux to serve the webhooks endpoints.
+func (s *Server) Mount(mux goahttp.Muxer) {
+	Mount(mux, s)
+}
+
+// MountListWebhooksHandler configures the mux to serve the "webhooks" service
+// "list webhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/webhooks", f)
+}
+
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhooks" service "list webhooks" endpoint.
+func NewListWebhooksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list webhooks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateWebhookHandler configures the mux to serve the "webhooks" service
+// "create webhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/webhooks", f)
+}
+
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhooks" service "create webhook" endpoint.
+func NewCreateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateWebhookHandler configures the mux to serve the "webhooks" service
+// "update webhook" endpoint.
+func MountUpdateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/webhooks/{id}", f)
+}
+
+// NewUpdateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhooks" service "update webhook" endpoint.
+func NewUpdateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeUpdateWebhookResponse(encoder)
+		encodeError    = EncodeUpdateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteWebhookHandler configures the mux to serve the "webhooks" service
+// "delete webhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/webhooks/{id}", f)
+}
+
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "webhooks" service "delete webhook" endpoint.
+func NewDeleteWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListWebhookDeliveriesHandler configures the mux to serve the "webhooks"
+// service "list webhook deliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/webhooks/{id}/deliveries", f)
+}
+
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "webhooks" service "list webhook deliveries" endpoint.
+func NewListWebhookDeliveriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list webhook deliveries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountReplayWebhookDeliveryHandler configures the mux to serve the "webhooks"
+// service "replay webhook delivery" endpoint.
+func MountReplayWebhookDeliveryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := HandleWebhooksOrigin(h).(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/webhooks/{id}/deliveries/{delivery_id}/replay", f)
+}
+
+// NewReplayWebhookDeliveryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "webhooks" service "replay webhook delivery" endpoint.
+func NewReplayWebhookDeliveryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeReplayWebhookDeliveryRequest(mux, decoder)
+		encodeResponse = EncodeReplayWebhookDeliveryResponse(encoder)
+		encodeError    = EncodeReplayWebhookDeliveryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "replay webhook delivery")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "webhooks")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCORSHandler configures the mux to serve the CORS endpoints for the
+// service webhooks.
+func MountCORSHandler(mux goahttp.Muxer, h http.Handler) {
+	h = HandleWebhooksOrigin(h)
+	mux.Handle("OPTIONS", "/webhooks", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/webhooks/{id}", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/webhooks/{id}/deliveries", h.ServeHTTP)
+	mux.Handle("OPTIONS", "/webhooks/{id}/deliveries/{delivery_id}/replay", h.ServeHTTP)
+}
+
+// NewCORSHandler creates a HTTP handler which returns a simple 204 response.
+func NewCORSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(204)
+	})
+}
+
+// HandleWebhooksOrigin applies the CORS response headers corresponding to the
+// origin for the service webhooks.
+func HandleWebhooksOrigin(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			// Not a CORS request
+			h.ServeHTTP(w, r)
+			return
+		}
+		if cors.MatchOrigin(origin, "http://localhost:3000") {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Vary", "Origin")
+			w.Header().Set("Access-Control-Max-Age", "3600")
+			if acrm := r.Header.Get("Access-Control-Request-Method"); acrm != "" {
+				// We are handling a preflight request
+				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+				w.WriteHeader(204)
+				return
+			}
+			h.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+		return
+	})
+}
@@ -0,0 +1,1351 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks HTTP server types
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package server
+
+import (
+	"unicode/utf8"
+
+	webhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateWebhookRequestBody is the type of the "webhooks" service "create
+// webhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// Name of the webhook
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// HTTP or HTTPS URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+}
+
+// UpdateWebhookRequestBody is the type of the "webhooks" service "update
+// webhook" endpoint HTTP request body.
+type UpdateWebhookRequestBody struct {
+	// Name of the webhook
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// HTTP or HTTPS URL the events are posted to
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty" xml:"enabled,omitempty"`
+}
+
+// ListWebhooksResponseBody is the type of the "webhooks" service "list
+// webhooks" endpoint HTTP response body.
+type ListWebhooksResponseBody []*WebhookResponse
+
+// CreateWebhookResponseBody is the type of the "webhooks" service "create
+// webhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// Secret signing the deliveries with HMAC-SHA256
+	Secret string `form:"secret" json:"secret" xml:"secret"`
+	// Unique identifier for the webhook
+	ID int `form:"id" json:"id" xml:"id"`
+	// Webhook creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Webhook last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Name of the webhook
+	Name string `form:"name" json:"name" xml:"name"`
+	// HTTP or HTTPS URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string `form:"events" json:"events" xml:"events"`
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+}
+
+// UpdateWebhookResponseBody is the type of the "webhooks" service "update
+// webhook" endpoint HTTP response body.
+type UpdateWebhookResponseBody struct {
+	// Unique identifier for the webhook
+	ID int `form:"id" json:"id" xml:"id"`
+	// Webhook creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Webhook last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Name of the webhook
+	Name string `form:"name" json:"name" xml:"name"`
+	// HTTP or HTTPS URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string `form:"events" json:"events" xml:"events"`
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "webhooks" service
+// "list webhook deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody []*WebhookDeliveryResponse
+
+// ReplayWebhookDeliveryResponseBody is the type of the "webhooks" service
+// "replay webhook delivery" endpoint HTTP response body.
+type ReplayWebhookDeliveryResponseBody struct {
+	// Unique identifier for the delivery
+	ID int `form:"id" json:"id" xml:"id"`
+	// Identifier of the delivered event, shared by its deliveries to every webhook
+	EventID string `form:"event_id" json:"event_id" xml:"event_id"`
+	// Type of the delivered event
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// JSON body posted to the webhook
+	Payload string `form:"payload" json:"payload" xml:"payload"`
+	// Status of the delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Time of the next attempt of a pending delivery
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// HTTP status returned by the webhook on the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Why the last attempt failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of the delivery this one replays
+	ReplayOf *int `form:"replay_of,omitempty" json:"replay_of,omitempty" xml:"replay_of,omitempty"`
+	// Delivery creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListWebhooksInternalErrorResponseBody is the type of the "webhooks" service
+// "list webhooks" endpoint HTTP response body for the "internal_error" error.
+type ListWebhooksInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhooksForbiddenResponseBody is the type of the "webhooks" service
+// "list webhooks" endpoint HTTP response body for the "forbidden" error.
+type ListWebhooksForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhooksUnauthorizedResponseBody is the type of the "webhooks" service
+// "list webhooks" endpoint HTTP response body for the "unauthorized" error.
+type ListWebhooksUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookWebhookTakenResponseBody is the type of the "webhooks" service
+// "create webhook" endpoint HTTP response body for the "webhook_taken" error.
+type CreateWebhookWebhookTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookInvalidParametersResponseBody is the type of the "webhooks"
+// service "create webhook" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type CreateWebhookInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookInternalErrorResponseBody is the type of the "webhooks" service
+// "create webhook" endpoint HTTP response body for the "internal_error" error.
+type CreateWebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookForbiddenResponseBody is the type of the "webhooks" service
+// "create webhook" endpoint HTTP response body for the "forbidden" error.
+type CreateWebhookForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// CreateWebhookUnauthorizedResponseBody is the type of the "webhooks" service
+// "create webhook" endpoint HTTP response body for the "unauthorized" error.
+type CreateWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookWebhookNotFoundResponseBody is the type of the "webhooks"
+// service "update webhook" endpoint HTTP response body for the
+// "webhook_not_found" error.
+type UpdateWebhookWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookWebhookTakenResponseBody is the type of the "webhooks" service
+// "update webhook" endpoint HTTP response body for the "webhook_taken" error.
+type UpdateWebhookWebhookTakenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookInvalidParametersResponseBody is the type of the "webhooks"
+// service "update webhook" endpoint HTTP response body for the
+// "invalid_parameters" error.
+type UpdateWebhookInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookInternalErrorResponseBody is the type of the "webhooks" service
+// "update webhook" endpoint HTTP response body for the "internal_error" error.
+type UpdateWebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookForbiddenResponseBody is the type of the "webhooks" service
+// "update webhook" endpoint HTTP response body for the "forbidden" error.
+type UpdateWebhookForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// UpdateWebhookUnauthorizedResponseBody is the type of the "webhooks" service
+// "update webhook" endpoint HTTP response body for the "unauthorized" error.
+type UpdateWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookWebhookNotFoundResponseBody is the type of the "webhooks"
+// service "delete webhook" endpoint HTTP response body for the
+// "webhook_not_found" error.
+type DeleteWebhookWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookInternalErrorResponseBody is the type of the "webhooks" service
+// "delete webhook" endpoint HTTP response body for the "internal_error" error.
+type DeleteWebhookInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookForbiddenResponseBody is the type of the "webhooks" service
+// "delete webhook" endpoint HTTP response body for the "forbidden" error.
+type DeleteWebhookForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// DeleteWebhookUnauthorizedResponseBody is the type of the "webhooks" service
+// "delete webhook" endpoint HTTP response body for the "unauthorized" error.
+type DeleteWebhookUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesWebhookNotFoundResponseBody is the type of the
+// "webhooks" service "list webhook deliveries" endpoint HTTP response body for
+// the "webhook_not_found" error.
+type ListWebhookDeliveriesWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesInvalidParametersResponseBody is the type of the
+// "webhooks" service "list webhook deliveries" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ListWebhookDeliveriesInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesInternalErrorResponseBody is the type of the "webhooks"
+// service "list webhook deliveries" endpoint HTTP response body for the
+// "internal_error" error.
+type ListWebhookDeliveriesInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesForbiddenResponseBody is the type of the "webhooks"
+// service "list webhook deliveries" endpoint HTTP response body for the
+// "forbidden" error.
+type ListWebhookDeliveriesForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ListWebhookDeliveriesUnauthorizedResponseBody is the type of the "webhooks"
+// service "list webhook deliveries" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListWebhookDeliveriesUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryWebhookNotFoundResponseBody is the type of the
+// "webhooks" service "replay webhook delivery" endpoint HTTP response body for
+// the "webhook_not_found" error.
+type ReplayWebhookDeliveryWebhookNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody is the type of the
+// "webhooks" service "replay webhook delivery" endpoint HTTP response body for
+// the "webhook_delivery_not_found" error.
+type ReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryInvalidParametersResponseBody is the type of the
+// "webhooks" service "replay webhook delivery" endpoint HTTP response body for
+// the "invalid_parameters" error.
+type ReplayWebhookDeliveryInvalidParametersResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryInternalErrorResponseBody is the type of the "webhooks"
+// service "replay webhook delivery" endpoint HTTP response body for the
+// "internal_error" error.
+type ReplayWebhookDeliveryInternalErrorResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryForbiddenResponseBody is the type of the "webhooks"
+// service "replay webhook delivery" endpoint HTTP response body for the
+// "forbidden" error.
+type ReplayWebhookDeliveryForbiddenResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// ReplayWebhookDeliveryUnauthorizedResponseBody is the type of the "webhooks"
+// service "replay webhook delivery" endpoint HTTP response body for the
+// "unauthorized" error.
+type ReplayWebhookDeliveryUnauthorizedResponseBody struct {
+	// Name is the name of this class of errors.
+	Name string `form:"name" json:"name" xml:"name"`
+	// ID is a unique identifier for this particular occurrence of the problem.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Message is a human-readable explanation specific to this occurrence of the
+	// problem.
+	Message string `form:"message" json:"message" xml:"message"`
+	// Is the error temporary?
+	Temporary bool `form:"temporary" json:"temporary" xml:"temporary"`
+	// Is the error a timeout?
+	Timeout bool `form:"timeout" json:"timeout" xml:"timeout"`
+	// Is the error a server-side fault?
+	Fault bool `form:"fault" json:"fault" xml:"fault"`
+}
+
+// WebhookResponse is used to define fields on response body types.
+type WebhookResponse struct {
+	// Unique identifier for the webhook
+	ID int `form:"id" json:"id" xml:"id"`
+	// Webhook creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Webhook last update timestamp
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Name of the webhook
+	Name string `form:"name" json:"name" xml:"name"`
+	// HTTP or HTTPS URL the events are posted to
+	URL string `form:"url" json:"url" xml:"url"`
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string `form:"events" json:"events" xml:"events"`
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string `form:"path_prefix,omitempty" json:"path_prefix,omitempty" xml:"path_prefix,omitempty"`
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool `form:"enabled" json:"enabled" xml:"enabled"`
+}
+
+// WebhookDeliveryResponse is used to define fields on response body types.
+type WebhookDeliveryResponse struct {
+	// Unique identifier for the delivery
+	ID int `form:"id" json:"id" xml:"id"`
+	// Identifier of the delivered event, shared by its deliveries to every webhook
+	EventID string `form:"event_id" json:"event_id" xml:"event_id"`
+	// Type of the delivered event
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// JSON body posted to the webhook
+	Payload string `form:"payload" json:"payload" xml:"payload"`
+	// Status of the delivery
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Time of the next attempt of a pending delivery
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// HTTP status returned by the webhook on the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Why the last attempt failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of the delivery this one replays
+	ReplayOf *int `form:"replay_of,omitempty" json:"replay_of,omitempty" xml:"replay_of,omitempty"`
+	// Delivery creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// NewListWebhooksResponseBody builds the HTTP response body from the result of
+// the "list webhooks" endpoint of the "webhooks" service.
+func NewListWebhooksResponseBody(res []*webhooks.Webhook) ListWebhooksResponseBody {
+	body := make([]*WebhookResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalWebhooksWebhookToWebhookResponse(val)
+	}
+	return body
+}
+
+// NewCreateWebhookResponseBody builds the HTTP response body from the result
+// of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookResponseBody(res *webhooks.CreateWebhookResult) *CreateWebhookResponseBody {
+	body := &CreateWebhookResponseBody{
+		Secret:     res.Secret,
+		ID:         res.ID,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+		Name:       res.Name,
+		URL:        res.URL,
+		PathPrefix: res.PathPrefix,
+		Enabled:    res.Enabled,
+	}
+	if res.Events != nil {
+		body.Events = make([]string, len(res.Events))
+		for i, val := range res.Events {
+			body.Events[i] = val
+		}
+	} else {
+		body.Events = []string{}
+	}
+	return body
+}
+
+// NewUpdateWebhookResponseBody builds the HTTP response body from the result
+// of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookResponseBody(res *webhooks.Webhook) *UpdateWebhookResponseBody {
+	body := &UpdateWebhookResponseBody{
+		ID:         res.ID,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+		Name:       res.Name,
+		URL:        res.URL,
+		PathPrefix: res.PathPrefix,
+		Enabled:    res.Enabled,
+	}
+	if res.Events != nil {
+		body.Events = make([]string, len(res.Events))
+		for i, val := range res.Events {
+			body.Events[i] = val
+		}
+	} else {
+		body.Events = []string{}
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesResponseBody builds the HTTP response body from the
+// result of the "list webhook deliveries" endpoint of the "webhooks" service.
+func NewListWebhookDeliveriesResponseBody(res []*webhooks.WebhookDelivery) ListWebhookDeliveriesResponseBody {
+	body := make([]*WebhookDeliveryResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalWebhooksWebhookDeliveryToWebhookDeliveryResponse(val)
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryResponseBody builds the HTTP response body from the
+// result of the "replay webhook delivery" endpoint of the "webhooks" service.
+func NewReplayWebhookDeliveryResponseBody(res *webhooks.WebhookDelivery) *ReplayWebhookDeliveryResponseBody {
+	body := &ReplayWebhookDeliveryResponseBody{
+		ID:             res.ID,
+		EventID:        res.EventID,
+		EventType:      res.EventType,
+		Payload:        res.Payload,
+		Status:         res.Status,
+		Attempts:       res.Attempts,
+		NextAttemptAt:  res.NextAttemptAt,
+		LastAttemptAt:  res.LastAttemptAt,
+		ResponseStatus: res.ResponseStatus,
+		Error:          res.Error,
+		ReplayOf:       res.ReplayOf,
+		CreatedAt:      res.CreatedAt,
+	}
+	return body
+}
+
+// NewListWebhooksInternalErrorResponseBody builds the HTTP response body from
+// the result of the "list webhooks" endpoint of the "webhooks" service.
+func NewListWebhooksInternalErrorResponseBody(res *goa.ServiceError) *ListWebhooksInternalErrorResponseBody {
+	body := &ListWebhooksInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhooksForbiddenResponseBody builds the HTTP response body from the
+// result of the "list webhooks" endpoint of the "webhooks" service.
+func NewListWebhooksForbiddenResponseBody(res *goa.ServiceError) *ListWebhooksForbiddenResponseBody {
+	body := &ListWebhooksForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhooksUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list webhooks" endpoint of the "webhooks" service.
+func NewListWebhooksUnauthorizedResponseBody(res *goa.ServiceError) *ListWebhooksUnauthorizedResponseBody {
+	body := &ListWebhooksUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookWebhookTakenResponseBody builds the HTTP response body from
+// the result of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookWebhookTakenResponseBody(res *goa.ServiceError) *CreateWebhookWebhookTakenResponseBody {
+	body := &CreateWebhookWebhookTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookInvalidParametersResponseBody(res *goa.ServiceError) *CreateWebhookInvalidParametersResponseBody {
+	body := &CreateWebhookInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookInternalErrorResponseBody builds the HTTP response body from
+// the result of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookInternalErrorResponseBody(res *goa.ServiceError) *CreateWebhookInternalErrorResponseBody {
+	body := &CreateWebhookInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookForbiddenResponseBody builds the HTTP response body from the
+// result of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookForbiddenResponseBody(res *goa.ServiceError) *CreateWebhookForbiddenResponseBody {
+	body := &CreateWebhookForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create webhook" endpoint of the "webhooks" service.
+func NewCreateWebhookUnauthorizedResponseBody(res *goa.ServiceError) *CreateWebhookUnauthorizedResponseBody {
+	body := &CreateWebhookUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookWebhookNotFoundResponseBody builds the HTTP response body
+// from the result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookWebhookNotFoundResponseBody(res *goa.ServiceError) *UpdateWebhookWebhookNotFoundResponseBody {
+	body := &UpdateWebhookWebhookNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookWebhookTakenResponseBody builds the HTTP response body from
+// the result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookWebhookTakenResponseBody(res *goa.ServiceError) *UpdateWebhookWebhookTakenResponseBody {
+	body := &UpdateWebhookWebhookTakenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookInvalidParametersResponseBody builds the HTTP response body
+// from the result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookInvalidParametersResponseBody(res *goa.ServiceError) *UpdateWebhookInvalidParametersResponseBody {
+	body := &UpdateWebhookInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookInternalErrorResponseBody builds the HTTP response body from
+// the result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookInternalErrorResponseBody(res *goa.ServiceError) *UpdateWebhookInternalErrorResponseBody {
+	body := &UpdateWebhookInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookForbiddenResponseBody builds the HTTP response body from the
+// result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookForbiddenResponseBody(res *goa.ServiceError) *UpdateWebhookForbiddenResponseBody {
+	body := &UpdateWebhookForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewUpdateWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "update webhook" endpoint of the "webhooks" service.
+func NewUpdateWebhookUnauthorizedResponseBody(res *goa.ServiceError) *UpdateWebhookUnauthorizedResponseBody {
+	body := &UpdateWebhookUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWebhookWebhookNotFoundResponseBody builds the HTTP response body
+// from the result of the "delete webhook" endpoint of the "webhooks" service.
+func NewDeleteWebhookWebhookNotFoundResponseBody(res *goa.ServiceError) *DeleteWebhookWebhookNotFoundResponseBody {
+	body := &DeleteWebhookWebhookNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWebhookInternalErrorResponseBody builds the HTTP response body from
+// the result of the "delete webhook" endpoint of the "webhooks" service.
+func NewDeleteWebhookInternalErrorResponseBody(res *goa.ServiceError) *DeleteWebhookInternalErrorResponseBody {
+	body := &DeleteWebhookInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWebhookForbiddenResponseBody builds the HTTP response body from the
+// result of the "delete webhook" endpoint of the "webhooks" service.
+func NewDeleteWebhookForbiddenResponseBody(res *goa.ServiceError) *DeleteWebhookForbiddenResponseBody {
+	body := &DeleteWebhookForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewDeleteWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete webhook" endpoint of the "webhooks" service.
+func NewDeleteWebhookUnauthorizedResponseBody(res *goa.ServiceError) *DeleteWebhookUnauthorizedResponseBody {
+	body := &DeleteWebhookUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesWebhookNotFoundResponseBody builds the HTTP response
+// body from the result of the "list webhook deliveries" endpoint of the
+// "webhooks" service.
+func NewListWebhookDeliveriesWebhookNotFoundResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesWebhookNotFoundResponseBody {
+	body := &ListWebhookDeliveriesWebhookNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "list webhook deliveries" endpoint of
+// the "webhooks" service.
+func NewListWebhookDeliveriesInvalidParametersResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesInvalidParametersResponseBody {
+	body := &ListWebhookDeliveriesInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesInternalErrorResponseBody builds the HTTP response
+// body from the result of the "list webhook deliveries" endpoint of the
+// "webhooks" service.
+func NewListWebhookDeliveriesInternalErrorResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesInternalErrorResponseBody {
+	body := &ListWebhookDeliveriesInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesForbiddenResponseBody builds the HTTP response body
+// from the result of the "list webhook deliveries" endpoint of the "webhooks"
+// service.
+func NewListWebhookDeliveriesForbiddenResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesForbiddenResponseBody {
+	body := &ListWebhookDeliveriesForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list webhook deliveries" endpoint of the
+// "webhooks" service.
+func NewListWebhookDeliveriesUnauthorizedResponseBody(res *goa.ServiceError) *ListWebhookDeliveriesUnauthorizedResponseBody {
+	body := &ListWebhookDeliveriesUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryWebhookNotFoundResponseBody builds the HTTP response
+// body from the result of the "replay webhook delivery" endpoint of the
+// "webhooks" service.
+func NewReplayWebhookDeliveryWebhookNotFoundResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryWebhookNotFoundResponseBody {
+	body := &ReplayWebhookDeliveryWebhookNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody builds the HTTP
+// response body from the result of the "replay webhook delivery" endpoint of
+// the "webhooks" service.
+func NewReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody {
+	body := &ReplayWebhookDeliveryWebhookDeliveryNotFoundResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryInvalidParametersResponseBody builds the HTTP
+// response body from the result of the "replay webhook delivery" endpoint of
+// the "webhooks" service.
+func NewReplayWebhookDeliveryInvalidParametersResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryInvalidParametersResponseBody {
+	body := &ReplayWebhookDeliveryInvalidParametersResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryInternalErrorResponseBody builds the HTTP response
+// body from the result of the "replay webhook delivery" endpoint of the
+// "webhooks" service.
+func NewReplayWebhookDeliveryInternalErrorResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryInternalErrorResponseBody {
+	body := &ReplayWebhookDeliveryInternalErrorResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryForbiddenResponseBody builds the HTTP response body
+// from the result of the "replay webhook delivery" endpoint of the "webhooks"
+// service.
+func NewReplayWebhookDeliveryForbiddenResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryForbiddenResponseBody {
+	body := &ReplayWebhookDeliveryForbiddenResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewReplayWebhookDeliveryUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "replay webhook delivery" endpoint of the
+// "webhooks" service.
+func NewReplayWebhookDeliveryUnauthorizedResponseBody(res *goa.ServiceError) *ReplayWebhookDeliveryUnauthorizedResponseBody {
+	body := &ReplayWebhookDeliveryUnauthorizedResponseBody{
+		Name:      res.Name,
+		ID:        res.ID,
+		Message:   res.Message,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+		Fault:     res.Fault,
+	}
+	return body
+}
+
+// NewCreateWebhookWebhookParameters builds a webhooks service create webhook
+// endpoint payload.
+func NewCreateWebhookWebhookParameters(body *CreateWebhookRequestBody) *webhooks.WebhookParameters {
+	v := &webhooks.WebhookParameters{
+		Name:       *body.Name,
+		URL:        *body.URL,
+		PathPrefix: body.PathPrefix,
+	}
+	if body.Enabled != nil {
+		v.Enabled = *body.Enabled
+	}
+	v.Events = make([]string, len(body.Events))
+	for i, val := range body.Events {
+		v.Events[i] = val
+	}
+	if body.Enabled == nil {
+		v.Enabled = true
+	}
+
+	return v
+}
+
+// NewUpdateWebhookPayload builds a webhooks service update webhook endpoint
+// payload.
+func NewUpdateWebhookPayload(body *UpdateWebhookRequestBody, id int) *webhooks.UpdateWebhookPayload {
+	v := &webhooks.UpdateWebhookPayload{
+		Name:       *body.Name,
+		URL:        *body.URL,
+		PathPrefix: body.PathPrefix,
+	}
+	if body.Enabled != nil {
+		v.Enabled = *body.Enabled
+	}
+	v.Events = make([]string, len(body.Events))
+	for i, val := range body.Events {
+		v.Events[i] = val
+	}
+	if body.Enabled == nil {
+		v.Enabled = true
+	}
+	v.ID = id
+
+	return v
+}
+
+// NewDeleteWebhookPayload builds a webhooks service delete webhook endpoint
+// payload.
+func NewDeleteWebhookPayload(id int) *webhooks.DeleteWebhookPayload {
+	v := &webhooks.DeleteWebhookPayload{}
+	v.ID = id
+
+	return v
+}
+
+// NewListWebhookDeliveriesPayload builds a webhooks service list webhook
+// deliveries endpoint payload.
+func NewListWebhookDeliveriesPayload(id int, status *string, limit int) *webhooks.ListWebhookDeliveriesPayload {
+	v := &webhooks.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.Status = status
+	v.Limit = limit
+
+	return v
+}
+
+// NewReplayWebhookDeliveryPayload builds a webhooks service replay webhook
+// delivery endpoint payload.
+func NewReplayWebhookDeliveryPayload(id int, deliveryID int) *webhooks.ReplayWebhookDeliveryPayload {
+	v := &webhooks.ReplayWebhookDeliveryPayload{}
+	v.ID = id
+	v.DeliveryID = deliveryID
+
+	return v
+}
+
+// ValidateCreateWebhookRequestBody runs the validations defined on Create
+// WebhookRequestBody
+func ValidateCreateWebhookRequestBody(body *CreateWebhookRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 255, false))
+		}
+	}
+	if len(body.Events) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+	}
+	return
+}
+
+// ValidateUpdateWebhookRequestBody runs the validations defined on Update
+// WebhookRequestBody
+func ValidateUpdateWebhookRequestBody(body *UpdateWebhookRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 255, false))
+		}
+	}
+	if len(body.Events) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.events", body.Events, len(body.Events), 1, true))
+	}
+	return
+}
@@ -6,6 +6,7 @@ package repositorymocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/Vidalee/FishyKeys/internal/crypto"
 	"github.com/Vidalee/FishyKeys/repository"
@@ -39,6 +40,72 @@ func (_m *MockSecretsRepository) EXPECT() *MockSecretsRepository_Expecter {
 	return &MockSecretsRepository_Expecter{mock: &_m.Mock}
 }
 
+// CountSecretsOwnedByUser provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) CountSecretsOwnedByUser(ctx context.Context, userID int) (int, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountSecretsOwnedByUser")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_CountSecretsOwnedByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountSecretsOwnedByUser'
+type MockSecretsRepository_CountSecretsOwnedByUser_Call struct {
+	*mock.Call
+}
+
+// CountSecretsOwnedByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockSecretsRepository_Expecter) CountSecretsOwnedByUser(ctx interface{}, userID interface{}) *MockSecretsRepository_CountSecretsOwnedByUser_Call {
+	return &MockSecretsRepository_CountSecretsOwnedByUser_Call{Call: _e.mock.On("CountSecretsOwnedByUser", ctx, userID)}
+}
+
+func (_c *MockSecretsRepository_CountSecretsOwnedByUser_Call) Run(run func(ctx context.Context, userID int)) *MockSecretsRepository_CountSecretsOwnedByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_CountSecretsOwnedByUser_Call) Return(n int, err error) *MockSecretsRepository_CountSecretsOwnedByUser_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_CountSecretsOwnedByUser_Call) RunAndReturn(run func(ctx context.Context, userID int) (int, error)) *MockSecretsRepository_CountSecretsOwnedByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSecret provides a mock function for the type MockSecretsRepository
 func (_mock *MockSecretsRepository) CreateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, value string) (int, error) {
 	ret := _mock.Called(ctx, keyManager, path, ownerUserId, value)
@@ -123,105 +190,120 @@ func (_c *MockSecretsRepository_CreateSecret_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
-// DeleteSecret provides a mock function for the type MockSecretsRepository
-func (_mock *MockSecretsRepository) DeleteSecret(ctx context.Context, path string) error {
-	ret := _mock.Called(ctx, path)
+// CreateSecretWithGrants provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) CreateSecretWithGrants(ctx context.Context, keyManager *crypto.KeyManager, secret repository.NewSecret) (int, error) {
+	ret := _mock.Called(ctx, keyManager, secret)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSecret")
+		panic("no return value specified for CreateSecretWithGrants")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, path)
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, repository.NewSecret) (int, error)); ok {
+		return returnFunc(ctx, keyManager, secret)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, repository.NewSecret) int); ok {
+		r0 = returnFunc(ctx, keyManager, secret)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, repository.NewSecret) error); ok {
+		r1 = returnFunc(ctx, keyManager, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSecretsRepository_DeleteSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecret'
-type MockSecretsRepository_DeleteSecret_Call struct {
+// MockSecretsRepository_CreateSecretWithGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSecretWithGrants'
+type MockSecretsRepository_CreateSecretWithGrants_Call struct {
 	*mock.Call
 }
 
-// DeleteSecret is a helper method to define mock.On call
+// CreateSecretWithGrants is a helper method to define mock.On call
 //   - ctx context.Context
-//   - path string
-func (_e *MockSecretsRepository_Expecter) DeleteSecret(ctx interface{}, path interface{}) *MockSecretsRepository_DeleteSecret_Call {
-	return &MockSecretsRepository_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", ctx, path)}
+//   - keyManager *crypto.KeyManager
+//   - secret repository.NewSecret
+func (_e *MockSecretsRepository_Expecter) CreateSecretWithGrants(ctx interface{}, keyManager interface{}, secret interface{}) *MockSecretsRepository_CreateSecretWithGrants_Call {
+	return &MockSecretsRepository_CreateSecretWithGrants_Call{Call: _e.mock.On("CreateSecretWithGrants", ctx, keyManager, secret)}
 }
 
-func (_c *MockSecretsRepository_DeleteSecret_Call) Run(run func(ctx context.Context, path string)) *MockSecretsRepository_DeleteSecret_Call {
+func (_c *MockSecretsRepository_CreateSecretWithGrants_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, secret repository.NewSecret)) *MockSecretsRepository_CreateSecretWithGrants_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 string
+		var arg1 *crypto.KeyManager
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 repository.NewSecret
+		if args[2] != nil {
+			arg2 = args[2].(repository.NewSecret)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSecretsRepository_DeleteSecret_Call) Return(err error) *MockSecretsRepository_DeleteSecret_Call {
-	_c.Call.Return(err)
+func (_c *MockSecretsRepository_CreateSecretWithGrants_Call) Return(n int, err error) *MockSecretsRepository_CreateSecretWithGrants_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockSecretsRepository_DeleteSecret_Call) RunAndReturn(run func(ctx context.Context, path string) error) *MockSecretsRepository_DeleteSecret_Call {
+func (_c *MockSecretsRepository_CreateSecretWithGrants_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, secret repository.NewSecret) (int, error)) *MockSecretsRepository_CreateSecretWithGrants_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSecretByPath provides a mock function for the type MockSecretsRepository
-func (_mock *MockSecretsRepository) GetSecretByPath(ctx context.Context, keyManager *crypto.KeyManager, path string) (*repository.DecryptedSecret, error) {
-	ret := _mock.Called(ctx, keyManager, path)
+// CreateStructuredSecret provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) CreateStructuredSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, fields map[string]string) (int, error) {
+	ret := _mock.Called(ctx, keyManager, path, ownerUserId, fields)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetSecretByPath")
+		panic("no return value specified for CreateStructuredSecret")
 	}
 
-	var r0 *repository.DecryptedSecret
+	var r0 int
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string) (*repository.DecryptedSecret, error)); ok {
-		return returnFunc(ctx, keyManager, path)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, int, map[string]string) (int, error)); ok {
+		return returnFunc(ctx, keyManager, path, ownerUserId, fields)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string) *repository.DecryptedSecret); ok {
-		r0 = returnFunc(ctx, keyManager, path)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, int, map[string]string) int); ok {
+		r0 = returnFunc(ctx, keyManager, path, ownerUserId, fields)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*repository.DecryptedSecret)
-		}
+		r0 = ret.Get(0).(int)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, string) error); ok {
-		r1 = returnFunc(ctx, keyManager, path)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, string, int, map[string]string) error); ok {
+		r1 = returnFunc(ctx, keyManager, path, ownerUserId, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSecretsRepository_GetSecretByPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretByPath'
-type MockSecretsRepository_GetSecretByPath_Call struct {
+// MockSecretsRepository_CreateStructuredSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStructuredSecret'
+type MockSecretsRepository_CreateStructuredSecret_Call struct {
 	*mock.Call
 }
 
-// GetSecretByPath is a helper method to define mock.On call
+// CreateStructuredSecret is a helper method to define mock.On call
 //   - ctx context.Context
 //   - keyManager *crypto.KeyManager
 //   - path string
-func (_e *MockSecretsRepository_Expecter) GetSecretByPath(ctx interface{}, keyManager interface{}, path interface{}) *MockSecretsRepository_GetSecretByPath_Call {
-	return &MockSecretsRepository_GetSecretByPath_Call{Call: _e.mock.On("GetSecretByPath", ctx, keyManager, path)}
+//   - ownerUserId int
+//   - fields map[string]string
+func (_e *MockSecretsRepository_Expecter) CreateStructuredSecret(ctx interface{}, keyManager interface{}, path interface{}, ownerUserId interface{}, fields interface{}) *MockSecretsRepository_CreateStructuredSecret_Call {
+	return &MockSecretsRepository_CreateStructuredSecret_Call{Call: _e.mock.On("CreateStructuredSecret", ctx, keyManager, path, ownerUserId, fields)}
 }
 
-func (_c *MockSecretsRepository_GetSecretByPath_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string)) *MockSecretsRepository_GetSecretByPath_Call {
+func (_c *MockSecretsRepository_CreateStructuredSecret_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, fields map[string]string)) *MockSecretsRepository_CreateStructuredSecret_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -235,44 +317,113 @@ func (_c *MockSecretsRepository_GetSecretByPath_Call) Run(run func(ctx context.C
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 map[string]string
+		if args[4] != nil {
+			arg4 = args[4].(map[string]string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSecretsRepository_GetSecretByPath_Call) Return(decryptedSecret *repository.DecryptedSecret, err error) *MockSecretsRepository_GetSecretByPath_Call {
-	_c.Call.Return(decryptedSecret, err)
+func (_c *MockSecretsRepository_CreateStructuredSecret_Call) Return(n int, err error) *MockSecretsRepository_CreateStructuredSecret_Call {
+	_c.Call.Return(n, err)
 	return _c
 }
 
-func (_c *MockSecretsRepository_GetSecretByPath_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string) (*repository.DecryptedSecret, error)) *MockSecretsRepository_GetSecretByPath_Call {
+func (_c *MockSecretsRepository_CreateStructuredSecret_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, fields map[string]string) (int, error)) *MockSecretsRepository_CreateStructuredSecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// HasAccess provides a mock function for the type MockSecretsRepository
-func (_mock *MockSecretsRepository) HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int) (bool, error) {
+// DeleteSecret provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) DeleteSecret(ctx context.Context, path string) error {
+	ret := _mock.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSecret")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_DeleteSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSecret'
+type MockSecretsRepository_DeleteSecret_Call struct {
+	*mock.Call
+}
+
+// DeleteSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockSecretsRepository_Expecter) DeleteSecret(ctx interface{}, path interface{}) *MockSecretsRepository_DeleteSecret_Call {
+	return &MockSecretsRepository_DeleteSecret_Call{Call: _e.mock.On("DeleteSecret", ctx, path)}
+}
+
+func (_c *MockSecretsRepository_DeleteSecret_Call) Run(run func(ctx context.Context, path string)) *MockSecretsRepository_DeleteSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_DeleteSecret_Call) Return(err error) *MockSecretsRepository_DeleteSecret_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_DeleteSecret_Call) RunAndReturn(run func(ctx context.Context, path string) error) *MockSecretsRepository_DeleteSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCapabilities provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) GetCapabilities(ctx context.Context, secretPath string, userID int, roleIDs []int) ([]string, error) {
 	ret := _mock.Called(ctx, secretPath, userID, roleIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for HasAccess")
+		panic("no return value specified for GetCapabilities")
 	}
 
-	var r0 bool
+	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *int, []int) (bool, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, []int) ([]string, error)); ok {
 		return returnFunc(ctx, secretPath, userID, roleIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *int, []int) bool); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int, []int) []string); ok {
 		r0 = returnFunc(ctx, secretPath, userID, roleIDs)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *int, []int) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int, []int) error); ok {
 		r1 = returnFunc(ctx, secretPath, userID, roleIDs)
 	} else {
 		r1 = ret.Error(1)
@@ -280,21 +431,21 @@ func (_mock *MockSecretsRepository) HasAccess(ctx context.Context, secretPath st
 	return r0, r1
 }
 
-// MockSecretsRepository_HasAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasAccess'
-type MockSecretsRepository_HasAccess_Call struct {
+// MockSecretsRepository_GetCapabilities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCapabilities'
+type MockSecretsRepository_GetCapabilities_Call struct {
 	*mock.Call
 }
 
-// HasAccess is a helper method to define mock.On call
+// GetCapabilities is a helper method to define mock.On call
 //   - ctx context.Context
 //   - secretPath string
-//   - userID *int
+//   - userID int
 //   - roleIDs []int
-func (_e *MockSecretsRepository_Expecter) HasAccess(ctx interface{}, secretPath interface{}, userID interface{}, roleIDs interface{}) *MockSecretsRepository_HasAccess_Call {
-	return &MockSecretsRepository_HasAccess_Call{Call: _e.mock.On("HasAccess", ctx, secretPath, userID, roleIDs)}
+func (_e *MockSecretsRepository_Expecter) GetCapabilities(ctx interface{}, secretPath interface{}, userID interface{}, roleIDs interface{}) *MockSecretsRepository_GetCapabilities_Call {
+	return &MockSecretsRepository_GetCapabilities_Call{Call: _e.mock.On("GetCapabilities", ctx, secretPath, userID, roleIDs)}
 }
 
-func (_c *MockSecretsRepository_HasAccess_Call) Run(run func(ctx context.Context, secretPath string, userID *int, roleIDs []int)) *MockSecretsRepository_HasAccess_Call {
+func (_c *MockSecretsRepository_GetCapabilities_Call) Run(run func(ctx context.Context, secretPath string, userID int, roleIDs []int)) *MockSecretsRepository_GetCapabilities_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -304,9 +455,9 @@ func (_c *MockSecretsRepository_HasAccess_Call) Run(run func(ctx context.Context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *int
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(*int)
+			arg2 = args[2].(int)
 		}
 		var arg3 []int
 		if args[3] != nil {
@@ -322,80 +473,1530 @@ func (_c *MockSecretsRepository_HasAccess_Call) Run(run func(ctx context.Context
 	return _c
 }
 
-func (_c *MockSecretsRepository_HasAccess_Call) Return(b bool, err error) *MockSecretsRepository_HasAccess_Call {
-	_c.Call.Return(b, err)
+func (_c *MockSecretsRepository_GetCapabilities_Call) Return(strings []string, err error) *MockSecretsRepository_GetCapabilities_Call {
+	_c.Call.Return(strings, err)
 	return _c
 }
 
-func (_c *MockSecretsRepository_HasAccess_Call) RunAndReturn(run func(ctx context.Context, secretPath string, userID *int, roleIDs []int) (bool, error)) *MockSecretsRepository_HasAccess_Call {
+func (_c *MockSecretsRepository_GetCapabilities_Call) RunAndReturn(run func(ctx context.Context, secretPath string, userID int, roleIDs []int) ([]string, error)) *MockSecretsRepository_GetCapabilities_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListSecretsForUser provides a mock function for the type MockSecretsRepository
-func (_mock *MockSecretsRepository) ListSecretsForUser(ctx context.Context, userID int) ([]repository.Secret, error) {
-	ret := _mock.Called(ctx, userID)
+// GetReadableSecretsByPaths provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) GetReadableSecretsByPaths(ctx context.Context, keyManager *crypto.KeyManager, paths []string, userID int, roleIDs []int) (map[string]*repository.DecryptedSecret, error) {
+	ret := _mock.Called(ctx, keyManager, paths, userID, roleIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListSecretsForUser")
+		panic("no return value specified for GetReadableSecretsByPaths")
 	}
 
-	var r0 []repository.Secret
+	var r0 map[string]*repository.DecryptedSecret
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]repository.Secret, error)); ok {
-		return returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, []string, int, []int) (map[string]*repository.DecryptedSecret, error)); ok {
+		return returnFunc(ctx, keyManager, paths, userID, roleIDs)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []repository.Secret); ok {
-		r0 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, []string, int, []int) map[string]*repository.DecryptedSecret); ok {
+		r0 = returnFunc(ctx, keyManager, paths, userID, roleIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]repository.Secret)
+			r0 = ret.Get(0).(map[string]*repository.DecryptedSecret)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = returnFunc(ctx, userID)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, []string, int, []int) error); ok {
+		r1 = returnFunc(ctx, keyManager, paths, userID, roleIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockSecretsRepository_ListSecretsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretsForUser'
-type MockSecretsRepository_ListSecretsForUser_Call struct {
+// MockSecretsRepository_GetReadableSecretsByPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReadableSecretsByPaths'
+type MockSecretsRepository_GetReadableSecretsByPaths_Call struct {
 	*mock.Call
 }
 
-// ListSecretsForUser is a helper method to define mock.On call
+// GetReadableSecretsByPaths is a helper method to define mock.On call
 //   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - paths []string
 //   - userID int
-func (_e *MockSecretsRepository_Expecter) ListSecretsForUser(ctx interface{}, userID interface{}) *MockSecretsRepository_ListSecretsForUser_Call {
-	return &MockSecretsRepository_ListSecretsForUser_Call{Call: _e.mock.On("ListSecretsForUser", ctx, userID)}
+//   - roleIDs []int
+func (_e *MockSecretsRepository_Expecter) GetReadableSecretsByPaths(ctx interface{}, keyManager interface{}, paths interface{}, userID interface{}, roleIDs interface{}) *MockSecretsRepository_GetReadableSecretsByPaths_Call {
+	return &MockSecretsRepository_GetReadableSecretsByPaths_Call{Call: _e.mock.On("GetReadableSecretsByPaths", ctx, keyManager, paths, userID, roleIDs)}
 }
 
-func (_c *MockSecretsRepository_ListSecretsForUser_Call) Run(run func(ctx context.Context, userID int)) *MockSecretsRepository_ListSecretsForUser_Call {
+func (_c *MockSecretsRepository_GetReadableSecretsByPaths_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, paths []string, userID int, roleIDs []int)) *MockSecretsRepository_GetReadableSecretsByPaths_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 *crypto.KeyManager
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []int
+		if args[4] != nil {
+			arg4 = args[4].([]int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSecretsRepository_ListSecretsForUser_Call) Return(secrets []repository.Secret, err error) *MockSecretsRepository_ListSecretsForUser_Call {
-	_c.Call.Return(secrets, err)
+func (_c *MockSecretsRepository_GetReadableSecretsByPaths_Call) Return(stringToDecryptedSecret map[string]*repository.DecryptedSecret, err error) *MockSecretsRepository_GetReadableSecretsByPaths_Call {
+	_c.Call.Return(stringToDecryptedSecret, err)
 	return _c
 }
 
-func (_c *MockSecretsRepository_ListSecretsForUser_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]repository.Secret, error)) *MockSecretsRepository_ListSecretsForUser_Call {
+func (_c *MockSecretsRepository_GetReadableSecretsByPaths_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, paths []string, userID int, roleIDs []int) (map[string]*repository.DecryptedSecret, error)) *MockSecretsRepository_GetReadableSecretsByPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretByPath provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) GetSecretByPath(ctx context.Context, keyManager *crypto.KeyManager, path string) (*repository.DecryptedSecret, error) {
+	ret := _mock.Called(ctx, keyManager, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretByPath")
+	}
+
+	var r0 *repository.DecryptedSecret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string) (*repository.DecryptedSecret, error)); ok {
+		return returnFunc(ctx, keyManager, path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string) *repository.DecryptedSecret); ok {
+		r0 = returnFunc(ctx, keyManager, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.DecryptedSecret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, string) error); ok {
+		r1 = returnFunc(ctx, keyManager, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_GetSecretByPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretByPath'
+type MockSecretsRepository_GetSecretByPath_Call struct {
+	*mock.Call
+}
+
+// GetSecretByPath is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - path string
+func (_e *MockSecretsRepository_Expecter) GetSecretByPath(ctx interface{}, keyManager interface{}, path interface{}) *MockSecretsRepository_GetSecretByPath_Call {
+	return &MockSecretsRepository_GetSecretByPath_Call{Call: _e.mock.On("GetSecretByPath", ctx, keyManager, path)}
+}
+
+func (_c *MockSecretsRepository_GetSecretByPath_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string)) *MockSecretsRepository_GetSecretByPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretByPath_Call) Return(decryptedSecret *repository.DecryptedSecret, err error) *MockSecretsRepository_GetSecretByPath_Call {
+	_c.Call.Return(decryptedSecret, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretByPath_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string) (*repository.DecryptedSecret, error)) *MockSecretsRepository_GetSecretByPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretOwner provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) GetSecretOwner(ctx context.Context, secretPath string) (*repository.SecretOwner, error) {
+	ret := _mock.Called(ctx, secretPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretOwner")
+	}
+
+	var r0 *repository.SecretOwner
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*repository.SecretOwner, error)); ok {
+		return returnFunc(ctx, secretPath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *repository.SecretOwner); ok {
+		r0 = returnFunc(ctx, secretPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.SecretOwner)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, secretPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_GetSecretOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretOwner'
+type MockSecretsRepository_GetSecretOwner_Call struct {
+	*mock.Call
+}
+
+// GetSecretOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secretPath string
+func (_e *MockSecretsRepository_Expecter) GetSecretOwner(ctx interface{}, secretPath interface{}) *MockSecretsRepository_GetSecretOwner_Call {
+	return &MockSecretsRepository_GetSecretOwner_Call{Call: _e.mock.On("GetSecretOwner", ctx, secretPath)}
+}
+
+func (_c *MockSecretsRepository_GetSecretOwner_Call) Run(run func(ctx context.Context, secretPath string)) *MockSecretsRepository_GetSecretOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretOwner_Call) Return(secretOwner *repository.SecretOwner, err error) *MockSecretsRepository_GetSecretOwner_Call {
+	_c.Call.Return(secretOwner, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretOwner_Call) RunAndReturn(run func(ctx context.Context, secretPath string) (*repository.SecretOwner, error)) *MockSecretsRepository_GetSecretOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSecretPathsByPrefix provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) GetSecretPathsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	ret := _mock.Called(ctx, prefix)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSecretPathsByPrefix")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, prefix)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_GetSecretPathsByPrefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSecretPathsByPrefix'
+type MockSecretsRepository_GetSecretPathsByPrefix_Call struct {
+	*mock.Call
+}
+
+// GetSecretPathsByPrefix is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+func (_e *MockSecretsRepository_Expecter) GetSecretPathsByPrefix(ctx interface{}, prefix interface{}) *MockSecretsRepository_GetSecretPathsByPrefix_Call {
+	return &MockSecretsRepository_GetSecretPathsByPrefix_Call{Call: _e.mock.On("GetSecretPathsByPrefix", ctx, prefix)}
+}
+
+func (_c *MockSecretsRepository_GetSecretPathsByPrefix_Call) Run(run func(ctx context.Context, prefix string)) *MockSecretsRepository_GetSecretPathsByPrefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretPathsByPrefix_Call) Return(strings []string, err error) *MockSecretsRepository_GetSecretPathsByPrefix_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_GetSecretPathsByPrefix_Call) RunAndReturn(run func(ctx context.Context, prefix string) ([]string, error)) *MockSecretsRepository_GetSecretPathsByPrefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasAccess provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int, capability string) (bool, error) {
+	ret := _mock.Called(ctx, secretPath, userID, roleIDs, capability)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasAccess")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *int, []int, string) (bool, error)); ok {
+		return returnFunc(ctx, secretPath, userID, roleIDs, capability)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *int, []int, string) bool); ok {
+		r0 = returnFunc(ctx, secretPath, userID, roleIDs, capability)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *int, []int, string) error); ok {
+		r1 = returnFunc(ctx, secretPath, userID, roleIDs, capability)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_HasAccess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasAccess'
+type MockSecretsRepository_HasAccess_Call struct {
+	*mock.Call
+}
+
+// HasAccess is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secretPath string
+//   - userID *int
+//   - roleIDs []int
+//   - capability string
+func (_e *MockSecretsRepository_Expecter) HasAccess(ctx interface{}, secretPath interface{}, userID interface{}, roleIDs interface{}, capability interface{}) *MockSecretsRepository_HasAccess_Call {
+	return &MockSecretsRepository_HasAccess_Call{Call: _e.mock.On("HasAccess", ctx, secretPath, userID, roleIDs, capability)}
+}
+
+func (_c *MockSecretsRepository_HasAccess_Call) Run(run func(ctx context.Context, secretPath string, userID *int, roleIDs []int, capability string)) *MockSecretsRepository_HasAccess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *int
+		if args[2] != nil {
+			arg2 = args[2].(*int)
+		}
+		var arg3 []int
+		if args[3] != nil {
+			arg3 = args[3].([]int)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_HasAccess_Call) Return(b bool, err error) *MockSecretsRepository_HasAccess_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_HasAccess_Call) RunAndReturn(run func(ctx context.Context, secretPath string, userID *int, roleIDs []int, capability string) (bool, error)) *MockSecretsRepository_HasAccess_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportSecrets provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) ImportSecrets(ctx context.Context, keyManager *crypto.KeyManager, ownerUserID int, imports []repository.SecretImport, overwrite bool) ([]string, error) {
+	ret := _mock.Called(ctx, keyManager, ownerUserID, imports, overwrite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, int, []repository.SecretImport, bool) ([]string, error)); ok {
+		return returnFunc(ctx, keyManager, ownerUserID, imports, overwrite)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, int, []repository.SecretImport, bool) []string); ok {
+		r0 = returnFunc(ctx, keyManager, ownerUserID, imports, overwrite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, int, []repository.SecretImport, bool) error); ok {
+		r1 = returnFunc(ctx, keyManager, ownerUserID, imports, overwrite)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_ImportSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportSecrets'
+type MockSecretsRepository_ImportSecrets_Call struct {
+	*mock.Call
+}
+
+// ImportSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - ownerUserID int
+//   - imports []repository.SecretImport
+//   - overwrite bool
+func (_e *MockSecretsRepository_Expecter) ImportSecrets(ctx interface{}, keyManager interface{}, ownerUserID interface{}, imports interface{}, overwrite interface{}) *MockSecretsRepository_ImportSecrets_Call {
+	return &MockSecretsRepository_ImportSecrets_Call{Call: _e.mock.On("ImportSecrets", ctx, keyManager, ownerUserID, imports, overwrite)}
+}
+
+func (_c *MockSecretsRepository_ImportSecrets_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, ownerUserID int, imports []repository.SecretImport, overwrite bool)) *MockSecretsRepository_ImportSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 []repository.SecretImport
+		if args[3] != nil {
+			arg3 = args[3].([]repository.SecretImport)
+		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_ImportSecrets_Call) Return(strings []string, err error) *MockSecretsRepository_ImportSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_ImportSecrets_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, ownerUserID int, imports []repository.SecretImport, overwrite bool) ([]string, error)) *MockSecretsRepository_ImportSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCanarySecretPaths provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) ListCanarySecretPaths(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCanarySecretPaths")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_ListCanarySecretPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCanarySecretPaths'
+type MockSecretsRepository_ListCanarySecretPaths_Call struct {
+	*mock.Call
+}
+
+// ListCanarySecretPaths is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSecretsRepository_Expecter) ListCanarySecretPaths(ctx interface{}) *MockSecretsRepository_ListCanarySecretPaths_Call {
+	return &MockSecretsRepository_ListCanarySecretPaths_Call{Call: _e.mock.On("ListCanarySecretPaths", ctx)}
+}
+
+func (_c *MockSecretsRepository_ListCanarySecretPaths_Call) Run(run func(ctx context.Context)) *MockSecretsRepository_ListCanarySecretPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListCanarySecretPaths_Call) Return(strings []string, err error) *MockSecretsRepository_ListCanarySecretPaths_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListCanarySecretPaths_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockSecretsRepository_ListCanarySecretPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretEntries provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) ListSecretEntries(ctx context.Context, prefix string, recursive bool, userID int, roleIDs []int, after string, limit int) ([]repository.SecretEntry, error) {
+	ret := _mock.Called(ctx, prefix, recursive, userID, roleIDs, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretEntries")
+	}
+
+	var r0 []repository.SecretEntry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, int, []int, string, int) ([]repository.SecretEntry, error)); ok {
+		return returnFunc(ctx, prefix, recursive, userID, roleIDs, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool, int, []int, string, int) []repository.SecretEntry); ok {
+		r0 = returnFunc(ctx, prefix, recursive, userID, roleIDs, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SecretEntry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, bool, int, []int, string, int) error); ok {
+		r1 = returnFunc(ctx, prefix, recursive, userID, roleIDs, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_ListSecretEntries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretEntries'
+type MockSecretsRepository_ListSecretEntries_Call struct {
+	*mock.Call
+}
+
+// ListSecretEntries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - recursive bool
+//   - userID int
+//   - roleIDs []int
+//   - after string
+//   - limit int
+func (_e *MockSecretsRepository_Expecter) ListSecretEntries(ctx interface{}, prefix interface{}, recursive interface{}, userID interface{}, roleIDs interface{}, after interface{}, limit interface{}) *MockSecretsRepository_ListSecretEntries_Call {
+	return &MockSecretsRepository_ListSecretEntries_Call{Call: _e.mock.On("ListSecretEntries", ctx, prefix, recursive, userID, roleIDs, after, limit)}
+}
+
+func (_c *MockSecretsRepository_ListSecretEntries_Call) Run(run func(ctx context.Context, prefix string, recursive bool, userID int, roleIDs []int, after string, limit int)) *MockSecretsRepository_ListSecretEntries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		var arg4 []int
+		if args[4] != nil {
+			arg4 = args[4].([]int)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		var arg6 int
+		if args[6] != nil {
+			arg6 = args[6].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+			arg6,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretEntries_Call) Return(secretEntrys []repository.SecretEntry, err error) *MockSecretsRepository_ListSecretEntries_Call {
+	_c.Call.Return(secretEntrys, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretEntries_Call) RunAndReturn(run func(ctx context.Context, prefix string, recursive bool, userID int, roleIDs []int, after string, limit int) ([]repository.SecretEntry, error)) *MockSecretsRepository_ListSecretEntries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretRotations provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) ListSecretRotations(ctx context.Context, path string, limit int) ([]repository.SecretRotation, error) {
+	ret := _mock.Called(ctx, path, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretRotations")
+	}
+
+	var r0 []repository.SecretRotation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) ([]repository.SecretRotation, error)); ok {
+		return returnFunc(ctx, path, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) []repository.SecretRotation); ok {
+		r0 = returnFunc(ctx, path, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.SecretRotation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, path, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_ListSecretRotations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretRotations'
+type MockSecretsRepository_ListSecretRotations_Call struct {
+	*mock.Call
+}
+
+// ListSecretRotations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - limit int
+func (_e *MockSecretsRepository_Expecter) ListSecretRotations(ctx interface{}, path interface{}, limit interface{}) *MockSecretsRepository_ListSecretRotations_Call {
+	return &MockSecretsRepository_ListSecretRotations_Call{Call: _e.mock.On("ListSecretRotations", ctx, path, limit)}
+}
+
+func (_c *MockSecretsRepository_ListSecretRotations_Call) Run(run func(ctx context.Context, path string, limit int)) *MockSecretsRepository_ListSecretRotations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretRotations_Call) Return(secretRotations []repository.SecretRotation, err error) *MockSecretsRepository_ListSecretRotations_Call {
+	_c.Call.Return(secretRotations, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretRotations_Call) RunAndReturn(run func(ctx context.Context, path string, limit int) ([]repository.SecretRotation, error)) *MockSecretsRepository_ListSecretRotations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSecretsForUser provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) ListSecretsForUser(ctx context.Context, userID int) ([]repository.Secret, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSecretsForUser")
+	}
+
+	var r0 []repository.Secret
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]repository.Secret, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []repository.Secret); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repository.Secret)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_ListSecretsForUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSecretsForUser'
+type MockSecretsRepository_ListSecretsForUser_Call struct {
+	*mock.Call
+}
+
+// ListSecretsForUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockSecretsRepository_Expecter) ListSecretsForUser(ctx interface{}, userID interface{}) *MockSecretsRepository_ListSecretsForUser_Call {
+	return &MockSecretsRepository_ListSecretsForUser_Call{Call: _e.mock.On("ListSecretsForUser", ctx, userID)}
+}
+
+func (_c *MockSecretsRepository_ListSecretsForUser_Call) Run(run func(ctx context.Context, userID int)) *MockSecretsRepository_ListSecretsForUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretsForUser_Call) Return(secrets []repository.Secret, err error) *MockSecretsRepository_ListSecretsForUser_Call {
+	_c.Call.Return(secrets, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_ListSecretsForUser_Call) RunAndReturn(run func(ctx context.Context, userID int) ([]repository.Secret, error)) *MockSecretsRepository_ListSecretsForUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveSecrets provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) MoveSecrets(ctx context.Context, moves []repository.SecretMove, folder string, overwrite bool) error {
+	ret := _mock.Called(ctx, moves, folder, overwrite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []repository.SecretMove, string, bool) error); ok {
+		r0 = returnFunc(ctx, moves, folder, overwrite)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_MoveSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveSecrets'
+type MockSecretsRepository_MoveSecrets_Call struct {
+	*mock.Call
+}
+
+// MoveSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - moves []repository.SecretMove
+//   - folder string
+//   - overwrite bool
+func (_e *MockSecretsRepository_Expecter) MoveSecrets(ctx interface{}, moves interface{}, folder interface{}, overwrite interface{}) *MockSecretsRepository_MoveSecrets_Call {
+	return &MockSecretsRepository_MoveSecrets_Call{Call: _e.mock.On("MoveSecrets", ctx, moves, folder, overwrite)}
+}
+
+func (_c *MockSecretsRepository_MoveSecrets_Call) Run(run func(ctx context.Context, moves []repository.SecretMove, folder string, overwrite bool)) *MockSecretsRepository_MoveSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []repository.SecretMove
+		if args[1] != nil {
+			arg1 = args[1].([]repository.SecretMove)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_MoveSecrets_Call) Return(err error) *MockSecretsRepository_MoveSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_MoveSecrets_Call) RunAndReturn(run func(ctx context.Context, moves []repository.SecretMove, folder string, overwrite bool) error) *MockSecretsRepository_MoveSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteSecrets provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) PromoteSecrets(ctx context.Context, keyManager *crypto.KeyManager, promotions []repository.SecretPromotion, overwrite bool) ([]string, error) {
+	ret := _mock.Called(ctx, keyManager, promotions, overwrite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, []repository.SecretPromotion, bool) ([]string, error)); ok {
+		return returnFunc(ctx, keyManager, promotions, overwrite)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, []repository.SecretPromotion, bool) []string); ok {
+		r0 = returnFunc(ctx, keyManager, promotions, overwrite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, []repository.SecretPromotion, bool) error); ok {
+		r1 = returnFunc(ctx, keyManager, promotions, overwrite)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_PromoteSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteSecrets'
+type MockSecretsRepository_PromoteSecrets_Call struct {
+	*mock.Call
+}
+
+// PromoteSecrets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - promotions []repository.SecretPromotion
+//   - overwrite bool
+func (_e *MockSecretsRepository_Expecter) PromoteSecrets(ctx interface{}, keyManager interface{}, promotions interface{}, overwrite interface{}) *MockSecretsRepository_PromoteSecrets_Call {
+	return &MockSecretsRepository_PromoteSecrets_Call{Call: _e.mock.On("PromoteSecrets", ctx, keyManager, promotions, overwrite)}
+}
+
+func (_c *MockSecretsRepository_PromoteSecrets_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, promotions []repository.SecretPromotion, overwrite bool)) *MockSecretsRepository_PromoteSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 []repository.SecretPromotion
+		if args[2] != nil {
+			arg2 = args[2].([]repository.SecretPromotion)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_PromoteSecrets_Call) Return(strings []string, err error) *MockSecretsRepository_PromoteSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_PromoteSecrets_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, promotions []repository.SecretPromotion, overwrite bool) ([]string, error)) *MockSecretsRepository_PromoteSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegenerateSecret provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) RegenerateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, value string, fields map[string]string, policyID int) (int, error) {
+	ret := _mock.Called(ctx, keyManager, path, value, fields, policyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegenerateSecret")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, string, map[string]string, int) (int, error)); ok {
+		return returnFunc(ctx, keyManager, path, value, fields, policyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, string, map[string]string, int) int); ok {
+		r0 = returnFunc(ctx, keyManager, path, value, fields, policyID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, string, string, map[string]string, int) error); ok {
+		r1 = returnFunc(ctx, keyManager, path, value, fields, policyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_RegenerateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateSecret'
+type MockSecretsRepository_RegenerateSecret_Call struct {
+	*mock.Call
+}
+
+// RegenerateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - path string
+//   - value string
+//   - fields map[string]string
+//   - policyID int
+func (_e *MockSecretsRepository_Expecter) RegenerateSecret(ctx interface{}, keyManager interface{}, path interface{}, value interface{}, fields interface{}, policyID interface{}) *MockSecretsRepository_RegenerateSecret_Call {
+	return &MockSecretsRepository_RegenerateSecret_Call{Call: _e.mock.On("RegenerateSecret", ctx, keyManager, path, value, fields, policyID)}
+}
+
+func (_c *MockSecretsRepository_RegenerateSecret_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, value string, fields map[string]string, policyID int)) *MockSecretsRepository_RegenerateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 map[string]string
+		if args[4] != nil {
+			arg4 = args[4].(map[string]string)
+		}
+		var arg5 int
+		if args[5] != nil {
+			arg5 = args[5].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_RegenerateSecret_Call) Return(n int, err error) *MockSecretsRepository_RegenerateSecret_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_RegenerateSecret_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, value string, fields map[string]string, policyID int) (int, error)) *MockSecretsRepository_RegenerateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateDueSecret provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) RotateDueSecret(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, skipped []int, check func(ctx context.Context, path string, value string, fields map[string]string) error) (*repository.SecretRotation, error) {
+	ret := _mock.Called(ctx, keyManager, now, skipped, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateDueSecret")
+	}
+
+	var r0 *repository.SecretRotation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, time.Time, []int, func(ctx context.Context, path string, value string, fields map[string]string) error) (*repository.SecretRotation, error)); ok {
+		return returnFunc(ctx, keyManager, now, skipped, check)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, time.Time, []int, func(ctx context.Context, path string, value string, fields map[string]string) error) *repository.SecretRotation); ok {
+		r0 = returnFunc(ctx, keyManager, now, skipped, check)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repository.SecretRotation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *crypto.KeyManager, time.Time, []int, func(ctx context.Context, path string, value string, fields map[string]string) error) error); ok {
+		r1 = returnFunc(ctx, keyManager, now, skipped, check)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_RotateDueSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateDueSecret'
+type MockSecretsRepository_RotateDueSecret_Call struct {
+	*mock.Call
+}
+
+// RotateDueSecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - now time.Time
+//   - skipped []int
+//   - check func(ctx context.Context, path string, value string, fields map[string]string) error
+func (_e *MockSecretsRepository_Expecter) RotateDueSecret(ctx interface{}, keyManager interface{}, now interface{}, skipped interface{}, check interface{}) *MockSecretsRepository_RotateDueSecret_Call {
+	return &MockSecretsRepository_RotateDueSecret_Call{Call: _e.mock.On("RotateDueSecret", ctx, keyManager, now, skipped, check)}
+}
+
+func (_c *MockSecretsRepository_RotateDueSecret_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, skipped []int, check func(ctx context.Context, path string, value string, fields map[string]string) error)) *MockSecretsRepository_RotateDueSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 []int
+		if args[3] != nil {
+			arg3 = args[3].([]int)
+		}
+		var arg4 func(ctx context.Context, path string, value string, fields map[string]string) error
+		if args[4] != nil {
+			arg4 = args[4].(func(ctx context.Context, path string, value string, fields map[string]string) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_RotateDueSecret_Call) Return(secretRotation *repository.SecretRotation, err error) *MockSecretsRepository_RotateDueSecret_Call {
+	_c.Call.Return(secretRotation, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_RotateDueSecret_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, skipped []int, check func(ctx context.Context, path string, value string, fields map[string]string) error) (*repository.SecretRotation, error)) *MockSecretsRepository_RotateDueSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRotationSchedule provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) SetRotationSchedule(ctx context.Context, path string, rotationSchedule *string, nextRotationAt *time.Time) error {
+	ret := _mock.Called(ctx, path, rotationSchedule, nextRotationAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRotationSchedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string, *time.Time) error); ok {
+		r0 = returnFunc(ctx, path, rotationSchedule, nextRotationAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_SetRotationSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRotationSchedule'
+type MockSecretsRepository_SetRotationSchedule_Call struct {
+	*mock.Call
+}
+
+// SetRotationSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - rotationSchedule *string
+//   - nextRotationAt *time.Time
+func (_e *MockSecretsRepository_Expecter) SetRotationSchedule(ctx interface{}, path interface{}, rotationSchedule interface{}, nextRotationAt interface{}) *MockSecretsRepository_SetRotationSchedule_Call {
+	return &MockSecretsRepository_SetRotationSchedule_Call{Call: _e.mock.On("SetRotationSchedule", ctx, path, rotationSchedule, nextRotationAt)}
+}
+
+func (_c *MockSecretsRepository_SetRotationSchedule_Call) Run(run func(ctx context.Context, path string, rotationSchedule *string, nextRotationAt *time.Time)) *MockSecretsRepository_SetRotationSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *string
+		if args[2] != nil {
+			arg2 = args[2].(*string)
+		}
+		var arg3 *time.Time
+		if args[3] != nil {
+			arg3 = args[3].(*time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetRotationSchedule_Call) Return(err error) *MockSecretsRepository_SetRotationSchedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetRotationSchedule_Call) RunAndReturn(run func(ctx context.Context, path string, rotationSchedule *string, nextRotationAt *time.Time) error) *MockSecretsRepository_SetRotationSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSecretCanary provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) SetSecretCanary(ctx context.Context, path string, canary bool) error {
+	ret := _mock.Called(ctx, path, canary)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSecretCanary")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, path, canary)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_SetSecretCanary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSecretCanary'
+type MockSecretsRepository_SetSecretCanary_Call struct {
+	*mock.Call
+}
+
+// SetSecretCanary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - canary bool
+func (_e *MockSecretsRepository_Expecter) SetSecretCanary(ctx interface{}, path interface{}, canary interface{}) *MockSecretsRepository_SetSecretCanary_Call {
+	return &MockSecretsRepository_SetSecretCanary_Call{Call: _e.mock.On("SetSecretCanary", ctx, path, canary)}
+}
+
+func (_c *MockSecretsRepository_SetSecretCanary_Call) Run(run func(ctx context.Context, path string, canary bool)) *MockSecretsRepository_SetSecretCanary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetSecretCanary_Call) Return(err error) *MockSecretsRepository_SetSecretCanary_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetSecretCanary_Call) RunAndReturn(run func(ctx context.Context, path string, canary bool) error) *MockSecretsRepository_SetSecretCanary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSecretFields provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) SetSecretFields(ctx context.Context, keyManager *crypto.KeyManager, path string, fields map[string]string, removedFields []string) error {
+	ret := _mock.Called(ctx, keyManager, path, fields, removedFields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSecretFields")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, map[string]string, []string) error); ok {
+		r0 = returnFunc(ctx, keyManager, path, fields, removedFields)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_SetSecretFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSecretFields'
+type MockSecretsRepository_SetSecretFields_Call struct {
+	*mock.Call
+}
+
+// SetSecretFields is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - path string
+//   - fields map[string]string
+//   - removedFields []string
+func (_e *MockSecretsRepository_Expecter) SetSecretFields(ctx interface{}, keyManager interface{}, path interface{}, fields interface{}, removedFields interface{}) *MockSecretsRepository_SetSecretFields_Call {
+	return &MockSecretsRepository_SetSecretFields_Call{Call: _e.mock.On("SetSecretFields", ctx, keyManager, path, fields, removedFields)}
+}
+
+func (_c *MockSecretsRepository_SetSecretFields_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, fields map[string]string, removedFields []string)) *MockSecretsRepository_SetSecretFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		var arg4 []string
+		if args[4] != nil {
+			arg4 = args[4].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetSecretFields_Call) Return(err error) *MockSecretsRepository_SetSecretFields_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_SetSecretFields_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, fields map[string]string, removedFields []string) error) *MockSecretsRepository_SetSecretFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferSecretOwnership provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) TransferSecretOwnership(ctx context.Context, secretPath string, owner repository.SecretOwner) error {
+	ret := _mock.Called(ctx, secretPath, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferSecretOwnership")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, repository.SecretOwner) error); ok {
+		r0 = returnFunc(ctx, secretPath, owner)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_TransferSecretOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferSecretOwnership'
+type MockSecretsRepository_TransferSecretOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferSecretOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secretPath string
+//   - owner repository.SecretOwner
+func (_e *MockSecretsRepository_Expecter) TransferSecretOwnership(ctx interface{}, secretPath interface{}, owner interface{}) *MockSecretsRepository_TransferSecretOwnership_Call {
+	return &MockSecretsRepository_TransferSecretOwnership_Call{Call: _e.mock.On("TransferSecretOwnership", ctx, secretPath, owner)}
+}
+
+func (_c *MockSecretsRepository_TransferSecretOwnership_Call) Run(run func(ctx context.Context, secretPath string, owner repository.SecretOwner)) *MockSecretsRepository_TransferSecretOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 repository.SecretOwner
+		if args[2] != nil {
+			arg2 = args[2].(repository.SecretOwner)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_TransferSecretOwnership_Call) Return(err error) *MockSecretsRepository_TransferSecretOwnership_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_TransferSecretOwnership_Call) RunAndReturn(run func(ctx context.Context, secretPath string, owner repository.SecretOwner) error) *MockSecretsRepository_TransferSecretOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferUserSecretsOwnership provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) TransferUserSecretsOwnership(ctx context.Context, fromUserID int, owner repository.SecretOwner) ([]string, error) {
+	ret := _mock.Called(ctx, fromUserID, owner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferUserSecretsOwnership")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, repository.SecretOwner) ([]string, error)); ok {
+		return returnFunc(ctx, fromUserID, owner)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, repository.SecretOwner) []string); ok {
+		r0 = returnFunc(ctx, fromUserID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int, repository.SecretOwner) error); ok {
+		r1 = returnFunc(ctx, fromUserID, owner)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSecretsRepository_TransferUserSecretsOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferUserSecretsOwnership'
+type MockSecretsRepository_TransferUserSecretsOwnership_Call struct {
+	*mock.Call
+}
+
+// TransferUserSecretsOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fromUserID int
+//   - owner repository.SecretOwner
+func (_e *MockSecretsRepository_Expecter) TransferUserSecretsOwnership(ctx interface{}, fromUserID interface{}, owner interface{}) *MockSecretsRepository_TransferUserSecretsOwnership_Call {
+	return &MockSecretsRepository_TransferUserSecretsOwnership_Call{Call: _e.mock.On("TransferUserSecretsOwnership", ctx, fromUserID, owner)}
+}
+
+func (_c *MockSecretsRepository_TransferUserSecretsOwnership_Call) Run(run func(ctx context.Context, fromUserID int, owner repository.SecretOwner)) *MockSecretsRepository_TransferUserSecretsOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 repository.SecretOwner
+		if args[2] != nil {
+			arg2 = args[2].(repository.SecretOwner)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_TransferUserSecretsOwnership_Call) Return(strings []string, err error) *MockSecretsRepository_TransferUserSecretsOwnership_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_TransferUserSecretsOwnership_Call) RunAndReturn(run func(ctx context.Context, fromUserID int, owner repository.SecretOwner) ([]string, error)) *MockSecretsRepository_TransferUserSecretsOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBinarySecret provides a mock function for the type MockSecretsRepository
+func (_mock *MockSecretsRepository) UpdateBinarySecret(ctx context.Context, keyManager *crypto.KeyManager, path string, content []byte, contentType string, filename string) error {
+	ret := _mock.Called(ctx, keyManager, path, content, contentType, filename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBinarySecret")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *crypto.KeyManager, string, []byte, string, string) error); ok {
+		r0 = returnFunc(ctx, keyManager, path, content, contentType, filename)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSecretsRepository_UpdateBinarySecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBinarySecret'
+type MockSecretsRepository_UpdateBinarySecret_Call struct {
+	*mock.Call
+}
+
+// UpdateBinarySecret is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyManager *crypto.KeyManager
+//   - path string
+//   - content []byte
+//   - contentType string
+//   - filename string
+func (_e *MockSecretsRepository_Expecter) UpdateBinarySecret(ctx interface{}, keyManager interface{}, path interface{}, content interface{}, contentType interface{}, filename interface{}) *MockSecretsRepository_UpdateBinarySecret_Call {
+	return &MockSecretsRepository_UpdateBinarySecret_Call{Call: _e.mock.On("UpdateBinarySecret", ctx, keyManager, path, content, contentType, filename)}
+}
+
+func (_c *MockSecretsRepository_UpdateBinarySecret_Call) Run(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, content []byte, contentType string, filename string)) *MockSecretsRepository_UpdateBinarySecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *crypto.KeyManager
+		if args[1] != nil {
+			arg1 = args[1].(*crypto.KeyManager)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		var arg5 string
+		if args[5] != nil {
+			arg5 = args[5].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSecretsRepository_UpdateBinarySecret_Call) Return(err error) *MockSecretsRepository_UpdateBinarySecret_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSecretsRepository_UpdateBinarySecret_Call) RunAndReturn(run func(ctx context.Context, keyManager *crypto.KeyManager, path string, content []byte, contentType string, filename string) error) *MockSecretsRepository_UpdateBinarySecret_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,163 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies client
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package policies
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "policies" service client.
+type Client struct {
+	ListPoliciesEndpoint goa.Endpoint
+	GetPolicyEndpoint    goa.Endpoint
+	CreatePolicyEndpoint goa.Endpoint
+	UpdatePolicyEndpoint goa.Endpoint
+	DeletePolicyEndpoint goa.Endpoint
+	AttachPolicyEndpoint goa.Endpoint
+	DetachPolicyEndpoint goa.Endpoint
+	ExplainEndpoint      goa.Endpoint
+}
+
+// NewClient initializes a "policies" service client given the endpoints.
+func NewClient(listPolicies, getPolicy, createPolicy, updatePolicy, deletePolicy, attachPolicy, detachPolicy, explain goa.Endpoint) *Client {
+	return &Client{
+		ListPoliciesEndpoint: listPolicies,
+		GetPolicyEndpoint:    getPolicy,
+		CreatePolicyEndpoint: createPolicy,
+		UpdatePolicyEndpoint: updatePolicy,
+		DeletePolicyEndpoint: deletePolicy,
+		AttachPolicyEndpoint: attachPolicy,
+		DetachPolicyEndpoint: detachPolicy,
+		ExplainEndpoint:      explain,
+	}
+}
+
+// ListPolicies calls the "list policies" endpoint of the "policies" service.
+// ListPolicies may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListPolicies(ctx context.Context) (res []*Policy, err error) {
+	var ires any
+	ires, err = c.ListPoliciesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*Policy), nil
+}
+
+// GetPolicy calls the "get policy" endpoint of the "policies" service.
+// GetPolicy may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): Policy not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) GetPolicy(ctx context.Context, p *GetPolicyPayload) (res *Policy, err error) {
+	var ires any
+	ires, err = c.GetPolicyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Policy), nil
+}
+
+// CreatePolicy calls the "create policy" endpoint of the "policies" service.
+// CreatePolicy may return the following errors:
+//   - "policy_taken" (type *goa.ServiceError): Policy name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreatePolicy(ctx context.Context, p *CreatePolicyPayload) (res *Policy, err error) {
+	var ires any
+	ires, err = c.CreatePolicyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Policy), nil
+}
+
+// UpdatePolicy calls the "update policy" endpoint of the "policies" service.
+// UpdatePolicy may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): Policy not found
+//   - "policy_taken" (type *goa.ServiceError): Policy name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) UpdatePolicy(ctx context.Context, p *UpdatePolicyPayload) (res *Policy, err error) {
+	var ires any
+	ires, err = c.UpdatePolicyEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Policy), nil
+}
+
+// DeletePolicy calls the "delete policy" endpoint of the "policies" service.
+// DeletePolicy may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): Policy not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeletePolicy(ctx context.Context, p *DeletePolicyPayload) (err error) {
+	_, err = c.DeletePolicyEndpoint(ctx, p)
+	return
+}
+
+// AttachPolicy calls the "attach policy" endpoint of the "policies" service.
+// AttachPolicy may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): Policy not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) AttachPolicy(ctx context.Context, p *AttachPolicyPayload) (err error) {
+	_, err = c.AttachPolicyEndpoint(ctx, p)
+	return
+}
+
+// DetachPolicy calls the "detach policy" endpoint of the "policies" service.
+// DetachPolicy may return the following errors:
+//   - "policy_not_found" (type *goa.ServiceError): Policy not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DetachPolicy(ctx context.Context, p *DetachPolicyPayload) (err error) {
+	_, err = c.DetachPolicyEndpoint(ctx, p)
+	return
+}
+
+// Explain calls the "explain" endpoint of the "policies" service.
+// Explain may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) Explain(ctx context.Context, p *ExplainPayload) (res *ExplainResult, err error) {
+	var ires any
+	ires, err = c.ExplainEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExplainResult), nil
+}
@@ -0,0 +1,132 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies endpoints
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package policies
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "policies" service endpoints.
+type Endpoints struct {
+	ListPolicies goa.Endpoint
+	GetPolicy    goa.Endpoint
+	CreatePolicy goa.Endpoint
+	UpdatePolicy goa.Endpoint
+	DeletePolicy goa.Endpoint
+	AttachPolicy goa.Endpoint
+	DetachPolicy goa.Endpoint
+	Explain      goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "policies" service with endpoints.
+func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
+	endpoints := &Endpoints{
+		ListPolicies: NewListPoliciesEndpoint(s),
+		GetPolicy:    NewGetPolicyEndpoint(s),
+		CreatePolicy: NewCreatePolicyEndpoint(s),
+		UpdatePolicy: NewUpdatePolicyEndpoint(s),
+		DeletePolicy: NewDeletePolicyEndpoint(s),
+		AttachPolicy: NewAttachPolicyEndpoint(s),
+		DetachPolicy: NewDetachPolicyEndpoint(s),
+		Explain:      NewExplainEndpoint(s),
+	}
+	endpoints.ListPolicies = WrapListPoliciesEndpoint(endpoints.ListPolicies, si)
+	endpoints.GetPolicy = WrapGetPolicyEndpoint(endpoints.GetPolicy, si)
+	endpoints.CreatePolicy = WrapCreatePolicyEndpoint(endpoints.CreatePolicy, si)
+	endpoints.UpdatePolicy = WrapUpdatePolicyEndpoint(endpoints.UpdatePolicy, si)
+	endpoints.DeletePolicy = WrapDeletePolicyEndpoint(endpoints.DeletePolicy, si)
+	endpoints.AttachPolicy = WrapAttachPolicyEndpoint(endpoints.AttachPolicy, si)
+	endpoints.DetachPolicy = WrapDetachPolicyEndpoint(endpoints.DetachPolicy, si)
+	endpoints.Explain = WrapExplainEndpoint(endpoints.Explain, si)
+	return endpoints
+}
+
+// Use applies the given middleware to all the "policies" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.ListPolicies = m(e.ListPolicies)
+	e.GetPolicy = m(e.GetPolicy)
+	e.CreatePolicy = m(e.CreatePolicy)
+	e.UpdatePolicy = m(e.UpdatePolicy)
+	e.DeletePolicy = m(e.DeletePolicy)
+	e.AttachPolicy = m(e.AttachPolicy)
+	e.DetachPolicy = m(e.DetachPolicy)
+	e.Explain = m(e.Explain)
+}
+
+// NewListPoliciesEndpoint returns an endpoint function that calls the method
+// "list policies" of service "policies".
+func NewListPoliciesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListPolicies(ctx)
+	}
+}
+
+// NewGetPolicyEndpoint returns an endpoint function that calls the method "get
+// policy" of service "policies".
+func NewGetPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPolicyPayload)
+		return s.GetPolicy(ctx, p)
+	}
+}
+
+// NewCreatePolicyEndpoint returns an endpoint function that calls the method
+// "create policy" of service "policies".
+func NewCreatePolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreatePolicyPayload)
+		return s.CreatePolicy(ctx, p)
+	}
+}
+
+// NewUpdatePolicyEndpoint returns an endpoint function that calls the method
+// "update policy" of service "policies".
+func NewUpdatePolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdatePolicyPayload)
+		return s.UpdatePolicy(ctx, p)
+	}
+}
+
+// NewDeletePolicyEndpoint returns an endpoint function that calls the method
+// "delete policy" of service "policies".
+func NewDeletePolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeletePolicyPayload)
+		return nil, s.DeletePolicy(ctx, p)
+	}
+}
+
+// NewAttachPolicyEndpoint returns an endpoint function that calls the method
+// "attach policy" of service "policies".
+func NewAttachPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AttachPolicyPayload)
+		return nil, s.AttachPolicy(ctx, p)
+	}
+}
+
+// NewDetachPolicyEndpoint returns an endpoint function that calls the method
+// "detach policy" of service "policies".
+func NewDetachPolicyEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DetachPolicyPayload)
+		return nil, s.DetachPolicy(ctx, p)
+	}
+}
+
+// NewExplainEndpoint returns an endpoint function that calls the method
+// "explain" of service "policies".
+func NewExplainEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExplainPayload)
+		return s.Explain(ctx, p)
+	}
+}
@@ -0,0 +1,118 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// Interceptor wrappers
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package policies
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// wrapIsAdminListPolicies applies the IsAdmin server interceptor to endpoints.
+func wrapListPoliciesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "ListPolicies",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminGetPolicy applies the IsAdmin server interceptor to endpoints.
+func wrapGetPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "GetPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminCreatePolicy applies the IsAdmin server interceptor to endpoints.
+func wrapCreatePolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "CreatePolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminUpdatePolicy applies the IsAdmin server interceptor to endpoints.
+func wrapUpdatePolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "UpdatePolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDeletePolicy applies the IsAdmin server interceptor to endpoints.
+func wrapDeletePolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "DeletePolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminAttachPolicy applies the IsAdmin server interceptor to endpoints.
+func wrapAttachPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "AttachPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDetachPolicy applies the IsAdmin server interceptor to endpoints.
+func wrapDetachPolicyIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "DetachPolicy",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminExplain applies the IsAdmin server interceptor to endpoints.
+func wrapExplainIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "policies",
+			method:     "Explain",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policies service
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package policies
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Policies service manages path-pattern policy documents attached to users and
+// roles
+type Service interface {
+	// List all policies
+	ListPolicies(context.Context) (res []*Policy, err error)
+	// Get a policy by id
+	GetPolicy(context.Context, *GetPolicyPayload) (res *Policy, err error)
+	// Create a policy from a HCL or YAML document
+	CreatePolicy(context.Context, *CreatePolicyPayload) (res *Policy, err error)
+	// Replace the name and document of a policy
+	UpdatePolicy(context.Context, *UpdatePolicyPayload) (res *Policy, err error)
+	// Delete a policy by id
+	DeletePolicy(context.Context, *DeletePolicyPayload) (err error)
+	// Attach a policy to a user or a role
+	AttachPolicy(context.Context, *AttachPolicyPayload) (err error)
+	// Detach a policy from a user or a role
+	DetachPolicy(context.Context, *DetachPolicyPayload) (err error)
+	// Dry-run an access check and show which rules allowed or denied it
+	Explain(context.Context, *ExplainPayload) (res *ExplainResult, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "fishykeys"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "1.0"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "policies"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [8]string{"list policies", "get policy", "create policy", "update policy", "delete policy", "attach policy", "detach policy", "explain"}
+
+// AttachPolicyPayload is the payload type of the policies service attach
+// policy method.
+type AttachPolicyPayload struct {
+	// ID of the policy
+	ID int
+	// ID of the user to attach the policy to
+	UserID *int
+	// ID of the role to attach the policy to
+	RoleID *int
+}
+
+// CreatePolicyPayload is the payload type of the policies service create
+// policy method.
+type CreatePolicyPayload struct {
+	// Name of the policy
+	Name string
+	// Format of the policy document
+	Format string
+	// The policy document
+	Document string
+}
+
+// DeletePolicyPayload is the payload type of the policies service delete
+// policy method.
+type DeletePolicyPayload struct {
+	// ID of the policy
+	ID int
+}
+
+// DetachPolicyPayload is the payload type of the policies service detach
+// policy method.
+type DetachPolicyPayload struct {
+	// ID of the policy
+	ID int
+	// ID of the user to detach the policy from
+	UserID *int
+	// ID of the role to detach the policy from
+	RoleID *int
+}
+
+type ExplainMatch struct {
+	// What the match comes from
+	Source string
+	// Whether the match allows or denies the capability
+	Effect string
+	// The secret path, folder prefix or policy path glob that matched
+	Path string
+	// Name of the policy the rule belongs to, for policy matches
+	Policy *string
+}
+
+// ExplainPayload is the payload type of the policies service explain method.
+type ExplainPayload struct {
+	// ID of the user performing the request
+	UserID int
+	// Base64 encoded secret's path, the secret does not need to exist
+	Path string
+	// The capability to check
+	Capability string
+}
+
+// ExplainResult is the result type of the policies service explain method.
+type ExplainResult struct {
+	// Whether the request would be allowed
+	Allowed bool
+	// Explanation of the decision
+	Decision string
+	// Every grant and rule giving or denying the capability on the path
+	Matches []*ExplainMatch
+}
+
+// GetPolicyPayload is the payload type of the policies service get policy
+// method.
+type GetPolicyPayload struct {
+	// ID of the policy
+	ID int
+}
+
+// Policy is the result type of the policies service get policy method.
+type Policy struct {
+	// Unique identifier for the policy
+	ID int
+	// Name of the policy
+	Name string
+	// Format of the policy document
+	Format string
+	// The policy document
+	Document string
+	// Rules parsed from the policy document
+	Rules []*PolicyRule
+	// IDs of the users the policy is attached to
+	Users []int
+	// IDs of the roles the policy is attached to
+	Roles []int
+	// Policy creation timestamp
+	CreatedAt string
+	// Policy last update timestamp
+	UpdatedAt string
+}
+
+type PolicyRule struct {
+	// Glob of the secret paths the rule applies to
+	Path string
+	// Whether the rule allows or denies the capabilities
+	Effect string
+	// Capabilities allowed or denied by the rule
+	Capabilities []string
+}
+
+// UpdatePolicyPayload is the payload type of the policies service update
+// policy method.
+type UpdatePolicyPayload struct {
+	// ID of the policy
+	ID int
+	// Name of the policy
+	Name string
+	// Format of the policy document
+	Format string
+	// The policy document
+	Document string
+}
+
+// MakeInvalidParameters builds a goa.ServiceError from an error.
+func MakeInvalidParameters(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_parameters", false, false, false)
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeInternalError builds a goa.ServiceError from an error.
+func MakeInternalError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "internal_error", false, false, false)
+}
+
+// MakePolicyNotFound builds a goa.ServiceError from an error.
+func MakePolicyNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "policy_not_found", false, false, false)
+}
+
+// MakePolicyTaken builds a goa.ServiceError from an error.
+func MakePolicyTaken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "policy_taken", false, false, false)
+}
@@ -0,0 +1,113 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// policiesServer Interceptors
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package policies
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ServerInterceptors defines the interface for all server-side interceptors.
+// Server interceptors execute after the request is decoded and before the
+// payload is sent to the service. The implementation is responsible for calling
+// next to complete the request.
+type ServerInterceptors interface {
+	// Server-side interceptor that checks if the user has admin privileges
+	IsAdmin(ctx context.Context, info *IsAdminInfo, next goa.Endpoint) (any, error)
+}
+
+// Access interfaces for interceptor payloads and results
+type (
+	// IsAdminInfo provides metadata about the current interception.
+	// It includes service name, method name, and access to the endpoint.
+	IsAdminInfo struct {
+		service    string
+		method     string
+		callType   goa.InterceptorCallType
+		rawPayload any
+	}
+)
+
+// WrapListPoliciesEndpoint wraps the list policies endpoint with the
+// server-side interceptors defined in the design.
+func WrapListPoliciesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListPoliciesIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapGetPolicyEndpoint wraps the get policy endpoint with the server-side
+// interceptors defined in the design.
+func WrapGetPolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapGetPolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapCreatePolicyEndpoint wraps the create policy endpoint with the
+// server-side interceptors defined in the design.
+func WrapCreatePolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapCreatePolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapUpdatePolicyEndpoint wraps the update policy endpoint with the
+// server-side interceptors defined in the design.
+func WrapUpdatePolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapUpdatePolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapDeletePolicyEndpoint wraps the delete policy endpoint with the
+// server-side interceptors defined in the design.
+func WrapDeletePolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDeletePolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapAttachPolicyEndpoint wraps the attach policy endpoint with the
+// server-side interceptors defined in the design.
+func WrapAttachPolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapAttachPolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapDetachPolicyEndpoint wraps the detach policy endpoint with the
+// server-side interceptors defined in the design.
+func WrapDetachPolicyEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDetachPolicyIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapExplainEndpoint wraps the explain endpoint with the server-side
+// interceptors defined in the design.
+func WrapExplainEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapExplainIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// Public accessor methods for Info types
+
+// Service returns the name of the service handling the request.
+func (info *IsAdminInfo) Service() string {
+	return info.service
+}
+
+// Method returns the name of the method handling the request.
+func (info *IsAdminInfo) Method() string {
+	return info.method
+}
+
+// CallType returns the type of call the interceptor is handling.
+func (info *IsAdminInfo) CallType() goa.InterceptorCallType {
+	return info.callType
+}
+
+// RawPayload returns the raw payload of the request.
+func (info *IsAdminInfo) RawPayload() any {
+	return info.rawPayload
+}
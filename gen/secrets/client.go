@@ -9,29 +9,102 @@ package secrets
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "secrets" service client.
 type Client struct {
-	ListSecretsEndpoint            goa.Endpoint
-	GetSecretValueEndpoint         goa.Endpoint
-	OperatorGetSecretValueEndpoint goa.Endpoint
-	GetSecretEndpoint              goa.Endpoint
-	CreateSecretEndpoint           goa.Endpoint
-	UpdateSecretEndpoint           goa.Endpoint
+	ListSecretsEndpoint             goa.Endpoint
+	BrowseSecretsEndpoint           goa.Endpoint
+	GetSecretValueEndpoint          goa.Endpoint
+	OperatorGetSecretValueEndpoint  goa.Endpoint
+	BatchGetSecretValuesEndpoint    goa.Endpoint
+	RenderTemplateEndpoint          goa.Endpoint
+	WatchSecretsEndpoint            goa.Endpoint
+	UploadSecretFileEndpoint        goa.Endpoint
+	DownloadSecretFileEndpoint      goa.Endpoint
+	ExportSecretsEndpoint           goa.Endpoint
+	ImportSecretsEndpoint           goa.Endpoint
+	GetSecretEndpoint               goa.Endpoint
+	CreateSecretEndpoint            goa.Endpoint
+	UpdateSecretEndpoint            goa.Endpoint
+	RegenerateSecretEndpoint        goa.Endpoint
+	SetSecretRotationEndpoint       goa.Endpoint
+	ListSecretRotationsEndpoint     goa.Endpoint
+	DeleteSecretEndpoint            goa.Endpoint
+	MoveSecretsEndpoint             goa.Endpoint
+	DiffSecretsEndpoint             goa.Endpoint
+	PromoteSecretsEndpoint          goa.Endpoint
+	TransferSecretOwnershipEndpoint goa.Endpoint
+	ListStaleSecretsEndpoint        goa.Endpoint
+	SetSecretCanaryEndpoint         goa.Endpoint
+	ListCanarySecretsEndpoint       goa.Endpoint
+	WrapSecretEndpoint              goa.Endpoint
+	UnwrapSecretEndpoint            goa.Endpoint
+	RequestAccessEndpoint           goa.Endpoint
+	ListAccessRequestsEndpoint      goa.Endpoint
+	ApproveAccessRequestEndpoint    goa.Endpoint
+	DenyAccessRequestEndpoint       goa.Endpoint
+	ListValidationRulesEndpoint     goa.Endpoint
+	CreateValidationRuleEndpoint    goa.Endpoint
+	DeleteValidationRuleEndpoint    goa.Endpoint
+	ListBreakGlassRulesEndpoint     goa.Endpoint
+	CreateBreakGlassRuleEndpoint    goa.Endpoint
+	DeleteBreakGlassRuleEndpoint    goa.Endpoint
+	ListBreakGlassAccessesEndpoint  goa.Endpoint
+	ReviewBreakGlassAccessEndpoint  goa.Endpoint
+	ListFolderAccessesEndpoint      goa.Endpoint
+	GrantFolderAccessEndpoint       goa.Endpoint
+	RevokeFolderAccessEndpoint      goa.Endpoint
 }
 
 // NewClient initializes a "secrets" service client given the endpoints.
-func NewClient(listSecrets, getSecretValue, operatorGetSecretValue, getSecret, createSecret, updateSecret goa.Endpoint) *Client {
+func NewClient(listSecrets, browseSecrets, getSecretValue, operatorGetSecretValue, batchGetSecretValues, renderTemplate, watchSecrets, uploadSecretFile, downloadSecretFile, exportSecrets, importSecrets, getSecret, createSecret, updateSecret, regenerateSecret, setSecretRotation, listSecretRotations, deleteSecret, moveSecrets, diffSecrets, promoteSecrets, transferSecretOwnership, listStaleSecrets, setSecretCanary, listCanarySecrets, wrapSecret, unwrapSecret, requestAccess, listAccessRequests, approveAccessRequest, denyAccessRequest, listValidationRules, createValidationRule, deleteValidationRule, listBreakGlassRules, createBreakGlassRule, deleteBreakGlassRule, listBreakGlassAccesses, reviewBreakGlassAccess, listFolderAccesses, grantFolderAccess, revokeFolderAccess goa.Endpoint) *Client {
 	return &Client{
-		ListSecretsEndpoint:            listSecrets,
-		GetSecretValueEndpoint:         getSecretValue,
-		OperatorGetSecretValueEndpoint: operatorGetSecretValue,
-		GetSecretEndpoint:              getSecret,
-		CreateSecretEndpoint:           createSecret,
-		UpdateSecretEndpoint:           updateSecret,
+		ListSecretsEndpoint:             listSecrets,
+		BrowseSecretsEndpoint:           browseSecrets,
+		GetSecretValueEndpoint:          getSecretValue,
+		OperatorGetSecretValueEndpoint:  operatorGetSecretValue,
+		BatchGetSecretValuesEndpoint:    batchGetSecretValues,
+		RenderTemplateEndpoint:          renderTemplate,
+		WatchSecretsEndpoint:            watchSecrets,
+		UploadSecretFileEndpoint:        uploadSecretFile,
+		DownloadSecretFileEndpoint:      downloadSecretFile,
+		ExportSecretsEndpoint:           exportSecrets,
+		ImportSecretsEndpoint:           importSecrets,
+		GetSecretEndpoint:               getSecret,
+		CreateSecretEndpoint:            createSecret,
+		UpdateSecretEndpoint:            updateSecret,
+		RegenerateSecretEndpoint:        regenerateSecret,
+		SetSecretRotationEndpoint:       setSecretRotation,
+		ListSecretRotationsEndpoint:     listSecretRotations,
+		DeleteSecretEndpoint:            deleteSecret,
+		MoveSecretsEndpoint:             moveSecrets,
+		DiffSecretsEndpoint:             diffSecrets,
+		PromoteSecretsEndpoint:          promoteSecrets,
+		TransferSecretOwnershipEndpoint: transferSecretOwnership,
+		ListStaleSecretsEndpoint:        listStaleSecrets,
+		SetSecretCanaryEndpoint:         setSecretCanary,
+		ListCanarySecretsEndpoint:       listCanarySecrets,
+		WrapSecretEndpoint:              wrapSecret,
+		UnwrapSecretEndpoint:            unwrapSecret,
+		RequestAccessEndpoint:           requestAccess,
+		ListAccessRequestsEndpoint:      listAccessRequests,
+		ApproveAccessRequestEndpoint:    approveAccessRequest,
+		DenyAccessRequestEndpoint:       denyAccessRequest,
+		ListValidationRulesEndpoint:     listValidationRules,
+		CreateValidationRuleEndpoint:    createValidationRule,
+		DeleteValidationRuleEndpoint:    deleteValidationRule,
+		ListBreakGlassRulesEndpoint:     listBreakGlassRules,
+		CreateBreakGlassRuleEndpoint:    createBreakGlassRule,
+		DeleteBreakGlassRuleEndpoint:    deleteBreakGlassRule,
+		ListBreakGlassAccessesEndpoint:  listBreakGlassAccesses,
+		ReviewBreakGlassAccessEndpoint:  reviewBreakGlassAccess,
+		ListFolderAccessesEndpoint:      listFolderAccesses,
+		GrantFolderAccessEndpoint:       grantFolderAccess,
+		RevokeFolderAccessEndpoint:      revokeFolderAccess,
 	}
 }
 
@@ -52,10 +125,27 @@ func (c *Client) ListSecrets(ctx context.Context) (res []*SecretInfoSummary, err
 	return ires.([]*SecretInfoSummary), nil
 }
 
+// BrowseSecrets calls the "browse secrets" endpoint of the "secrets" service.
+// BrowseSecrets may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) BrowseSecrets(ctx context.Context, p *BrowseSecretsPayload) (res *BrowseSecretsResult, err error) {
+	var ires any
+	ires, err = c.BrowseSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BrowseSecretsResult), nil
+}
+
 // GetSecretValue calls the "get secret value" endpoint of the "secrets"
 // service.
 // GetSecretValue may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
 //   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
 //   - "unauthorized" (type *goa.ServiceError): Unauthorized access
 //   - "forbidden" (type *goa.ServiceError): Forbidden access
@@ -74,6 +164,7 @@ func (c *Client) GetSecretValue(ctx context.Context, p *GetSecretValuePayload) (
 // "secrets" service.
 // OperatorGetSecretValue may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
 //   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
 //   - "unauthorized" (type *goa.ServiceError): Unauthorized access
 //   - "forbidden" (type *goa.ServiceError): Forbidden access
@@ -88,6 +179,128 @@ func (c *Client) OperatorGetSecretValue(ctx context.Context, p *OperatorGetSecre
 	return ires.(*OperatorGetSecretValueResult), nil
 }
 
+// BatchGetSecretValues calls the "batch get secret values" endpoint of the
+// "secrets" service.
+// BatchGetSecretValues may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) BatchGetSecretValues(ctx context.Context, p *BatchGetSecretValuesPayload) (res *BatchGetSecretValuesResult, err error) {
+	var ires any
+	ires, err = c.BatchGetSecretValuesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BatchGetSecretValuesResult), nil
+}
+
+// RenderTemplate calls the "render template" endpoint of the "secrets" service.
+// RenderTemplate may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RenderTemplate(ctx context.Context, p *RenderTemplatePayload) (res *RenderTemplateResult, err error) {
+	var ires any
+	ires, err = c.RenderTemplateEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RenderTemplateResult), nil
+}
+
+// WatchSecrets calls the "watch secrets" endpoint of the "secrets" service.
+// WatchSecrets may return the following errors:
+//   - "resume_token_expired" (type *goa.ServiceError): The events following the resume token are no longer available
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) WatchSecrets(ctx context.Context, p *WatchSecretsPayload) (res WatchSecretsClientStream, err error) {
+	var ires any
+	ires, err = c.WatchSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(WatchSecretsClientStream), nil
+}
+
+// UploadSecretFile calls the "upload secret file" endpoint of the "secrets"
+// service.
+// UploadSecretFile may return the following errors:
+//   - "secret_too_large" (type *goa.ServiceError): The secret exceeds the maximum size
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) UploadSecretFile(ctx context.Context, p *UploadSecretFilePayload, req io.ReadCloser) (err error) {
+	_, err = c.UploadSecretFileEndpoint(ctx, &UploadSecretFileRequestData{Payload: p, Body: req})
+	return
+}
+
+// DownloadSecretFile calls the "download secret file" endpoint of the
+// "secrets" service.
+// DownloadSecretFile may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DownloadSecretFile(ctx context.Context, p *DownloadSecretFilePayload) (res *DownloadSecretFileResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadSecretFileEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadSecretFileResponseData)
+	return o.Result, o.Body, nil
+}
+
+// ExportSecrets calls the "export secrets" endpoint of the "secrets" service.
+// ExportSecrets may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ExportSecrets(ctx context.Context, p *ExportSecretsPayload) (res *ExportSecretsResult, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.ExportSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*ExportSecretsResponseData)
+	return o.Result, o.Body, nil
+}
+
+// ImportSecrets calls the "import secrets" endpoint of the "secrets" service.
+// ImportSecrets may return the following errors:
+//   - "secret_already_exists" (type *goa.ServiceError): A secret already exists at an imported path
+//   - "secret_too_large" (type *goa.ServiceError): The secret exceeds the maximum size
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ImportSecrets(ctx context.Context, p *ImportSecretsPayload, req io.ReadCloser) (res []*ImportedSecret, err error) {
+	var ires any
+	ires, err = c.ImportSecretsEndpoint(ctx, &ImportSecretsRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.([]*ImportedSecret), nil
+}
+
 // GetSecret calls the "get secret" endpoint of the "secrets" service.
 // GetSecret may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): Secret not found
@@ -107,6 +320,7 @@ func (c *Client) GetSecret(ctx context.Context, p *GetSecretPayload) (res *Secre
 
 // CreateSecret calls the "create secret" endpoint of the "secrets" service.
 // CreateSecret may return the following errors:
+//   - "secret_too_large" (type *goa.ServiceError): The secret exceeds the maximum size
 //   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
 //   - "unauthorized" (type *goa.ServiceError): Unauthorized access
 //   - "forbidden" (type *goa.ServiceError): Forbidden access
@@ -120,6 +334,8 @@ func (c *Client) CreateSecret(ctx context.Context, p *CreateSecretPayload) (err
 // UpdateSecret calls the "update secret" endpoint of the "secrets" service.
 // UpdateSecret may return the following errors:
 //   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
+//   - "secret_too_large" (type *goa.ServiceError): The secret exceeds the maximum size
 //   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
 //   - "unauthorized" (type *goa.ServiceError): Unauthorized access
 //   - "forbidden" (type *goa.ServiceError): Forbidden access
@@ -129,3 +345,481 @@ func (c *Client) UpdateSecret(ctx context.Context, p *UpdateSecretPayload) (err
 	_, err = c.UpdateSecretEndpoint(ctx, p)
 	return
 }
+
+// RegenerateSecret calls the "regenerate secret" endpoint of the "secrets"
+// service.
+// RegenerateSecret may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RegenerateSecret(ctx context.Context, p *RegenerateSecretPayload) (res *RegenerateSecretResult, err error) {
+	var ires any
+	ires, err = c.RegenerateSecretEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RegenerateSecretResult), nil
+}
+
+// SetSecretRotation calls the "set secret rotation" endpoint of the "secrets"
+// service.
+// SetSecretRotation may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) SetSecretRotation(ctx context.Context, p *SetSecretRotationPayload) (res *SetSecretRotationResult, err error) {
+	var ires any
+	ires, err = c.SetSecretRotationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SetSecretRotationResult), nil
+}
+
+// ListSecretRotations calls the "list secret rotations" endpoint of the
+// "secrets" service.
+// ListSecretRotations may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListSecretRotations(ctx context.Context, p *ListSecretRotationsPayload) (res []*SecretRotation, err error) {
+	var ires any
+	ires, err = c.ListSecretRotationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*SecretRotation), nil
+}
+
+// DeleteSecret calls the "delete secret" endpoint of the "secrets" service.
+// DeleteSecret may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeleteSecret(ctx context.Context, p *DeleteSecretPayload) (err error) {
+	_, err = c.DeleteSecretEndpoint(ctx, p)
+	return
+}
+
+// MoveSecrets calls the "move secrets" endpoint of the "secrets" service.
+// MoveSecrets may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "secret_already_exists" (type *goa.ServiceError): A secret already exists at a destination path
+//   - "folder_changed" (type *goa.ServiceError): Secrets were added under the moved folder during the move, which can be retried
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) MoveSecrets(ctx context.Context, p *MoveSecretsPayload) (res int, err error) {
+	var ires any
+	ires, err = c.MoveSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// DiffSecrets calls the "diff secrets" endpoint of the "secrets" service.
+// DiffSecrets may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DiffSecrets(ctx context.Context, p *DiffSecretsPayload) (res []*SecretDiff, err error) {
+	var ires any
+	ires, err = c.DiffSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*SecretDiff), nil
+}
+
+// PromoteSecrets calls the "promote secrets" endpoint of the "secrets" service.
+// PromoteSecrets may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "secret_already_exists" (type *goa.ServiceError): A different secret already exists at a destination path
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) PromoteSecrets(ctx context.Context, p *PromoteSecretsPayload) (res int, err error) {
+	var ires any
+	ires, err = c.PromoteSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// TransferSecretOwnership calls the "transfer secret ownership" endpoint of
+// the "secrets" service.
+// TransferSecretOwnership may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) TransferSecretOwnership(ctx context.Context, p *TransferSecretOwnershipPayload) (res int, err error) {
+	var ires any
+	ires, err = c.TransferSecretOwnershipEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// ListStaleSecrets calls the "list stale secrets" endpoint of the "secrets"
+// service.
+// ListStaleSecrets may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListStaleSecrets(ctx context.Context, p *ListStaleSecretsPayload) (res []*StaleSecret, err error) {
+	var ires any
+	ires, err = c.ListStaleSecretsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*StaleSecret), nil
+}
+
+// SetSecretCanary calls the "set secret canary" endpoint of the "secrets"
+// service.
+// SetSecretCanary may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) SetSecretCanary(ctx context.Context, p *SetSecretCanaryPayload) (err error) {
+	_, err = c.SetSecretCanaryEndpoint(ctx, p)
+	return
+}
+
+// ListCanarySecrets calls the "list canary secrets" endpoint of the "secrets"
+// service.
+// ListCanarySecrets may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListCanarySecrets(ctx context.Context) (res []string, err error) {
+	var ires any
+	ires, err = c.ListCanarySecretsEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]string), nil
+}
+
+// WrapSecret calls the "wrap secret" endpoint of the "secrets" service.
+// WrapSecret may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "field_not_found" (type *goa.ServiceError): Field not found in the secret
+//   - "secret_too_large" (type *goa.ServiceError): The secret exceeds the maximum size
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) WrapSecret(ctx context.Context, p *WrapSecretPayload) (res *WrapSecretResult, err error) {
+	var ires any
+	ires, err = c.WrapSecretEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WrapSecretResult), nil
+}
+
+// UnwrapSecret calls the "unwrap secret" endpoint of the "secrets" service.
+// UnwrapSecret may return the following errors:
+//   - "token_not_found" (type *goa.ServiceError): Unknown token
+//   - "token_expired" (type *goa.ServiceError): The token expired before being unwrapped
+//   - "token_already_used" (type *goa.ServiceError): The token was already unwrapped
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) UnwrapSecret(ctx context.Context, p *UnwrapSecretPayload) (res *UnwrapSecretResult, err error) {
+	var ires any
+	ires, err = c.UnwrapSecretEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UnwrapSecretResult), nil
+}
+
+// RequestAccess calls the "request access" endpoint of the "secrets" service.
+// RequestAccess may return the following errors:
+//   - "secret_not_found" (type *goa.ServiceError): Secret not found
+//   - "access_request_pending" (type *goa.ServiceError): A request of yours on the secret is already pending
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RequestAccess(ctx context.Context, p *RequestAccessPayload) (res *AccessRequest, err error) {
+	var ires any
+	ires, err = c.RequestAccessEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AccessRequest), nil
+}
+
+// ListAccessRequests calls the "list access requests" endpoint of the
+// "secrets" service.
+// ListAccessRequests may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListAccessRequests(ctx context.Context, p *ListAccessRequestsPayload) (res []*AccessRequest, err error) {
+	var ires any
+	ires, err = c.ListAccessRequestsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*AccessRequest), nil
+}
+
+// ApproveAccessRequest calls the "approve access request" endpoint of the
+// "secrets" service.
+// ApproveAccessRequest may return the following errors:
+//   - "access_request_not_found" (type *goa.ServiceError): Access request not found
+//   - "access_request_decided" (type *goa.ServiceError): The access request was already approved or denied
+//   - "secret_not_found" (type *goa.ServiceError): The secret of the request was deleted
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ApproveAccessRequest(ctx context.Context, p *ApproveAccessRequestPayload) (res *AccessRequest, err error) {
+	var ires any
+	ires, err = c.ApproveAccessRequestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AccessRequest), nil
+}
+
+// DenyAccessRequest calls the "deny access request" endpoint of the "secrets"
+// service.
+// DenyAccessRequest may return the following errors:
+//   - "access_request_not_found" (type *goa.ServiceError): Access request not found
+//   - "access_request_decided" (type *goa.ServiceError): The access request was already approved or denied
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DenyAccessRequest(ctx context.Context, p *DenyAccessRequestPayload) (res *AccessRequest, err error) {
+	var ires any
+	ires, err = c.DenyAccessRequestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AccessRequest), nil
+}
+
+// ListValidationRules calls the "list validation rules" endpoint of the
+// "secrets" service.
+// ListValidationRules may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListValidationRules(ctx context.Context) (res []*ValidationRule, err error) {
+	var ires any
+	ires, err = c.ListValidationRulesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*ValidationRule), nil
+}
+
+// CreateValidationRule calls the "create validation rule" endpoint of the
+// "secrets" service.
+// CreateValidationRule may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreateValidationRule(ctx context.Context, p *CreateValidationRulePayload) (res int, err error) {
+	var ires any
+	ires, err = c.CreateValidationRuleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// DeleteValidationRule calls the "delete validation rule" endpoint of the
+// "secrets" service.
+// DeleteValidationRule may return the following errors:
+//   - "validation_rule_not_found" (type *goa.ServiceError): Validation rule not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeleteValidationRule(ctx context.Context, p *DeleteValidationRulePayload) (err error) {
+	_, err = c.DeleteValidationRuleEndpoint(ctx, p)
+	return
+}
+
+// ListBreakGlassRules calls the "list break glass rules" endpoint of the
+// "secrets" service.
+// ListBreakGlassRules may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListBreakGlassRules(ctx context.Context) (res []*BreakGlassRule, err error) {
+	var ires any
+	ires, err = c.ListBreakGlassRulesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*BreakGlassRule), nil
+}
+
+// CreateBreakGlassRule calls the "create break glass rule" endpoint of the
+// "secrets" service.
+// CreateBreakGlassRule may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreateBreakGlassRule(ctx context.Context, p *CreateBreakGlassRulePayload) (res int, err error) {
+	var ires any
+	ires, err = c.CreateBreakGlassRuleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// DeleteBreakGlassRule calls the "delete break glass rule" endpoint of the
+// "secrets" service.
+// DeleteBreakGlassRule may return the following errors:
+//   - "break_glass_rule_not_found" (type *goa.ServiceError): Break-glass rule not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeleteBreakGlassRule(ctx context.Context, p *DeleteBreakGlassRulePayload) (err error) {
+	_, err = c.DeleteBreakGlassRuleEndpoint(ctx, p)
+	return
+}
+
+// ListBreakGlassAccesses calls the "list break glass accesses" endpoint of the
+// "secrets" service.
+// ListBreakGlassAccesses may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListBreakGlassAccesses(ctx context.Context, p *ListBreakGlassAccessesPayload) (res []*BreakGlassAccess, err error) {
+	var ires any
+	ires, err = c.ListBreakGlassAccessesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*BreakGlassAccess), nil
+}
+
+// ReviewBreakGlassAccess calls the "review break glass access" endpoint of the
+// "secrets" service.
+// ReviewBreakGlassAccess may return the following errors:
+//   - "break_glass_access_not_found" (type *goa.ServiceError): Emergency access not found
+//   - "break_glass_access_reviewed" (type *goa.ServiceError): The emergency access was already reviewed
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ReviewBreakGlassAccess(ctx context.Context, p *ReviewBreakGlassAccessPayload) (res *BreakGlassAccess, err error) {
+	var ires any
+	ires, err = c.ReviewBreakGlassAccessEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BreakGlassAccess), nil
+}
+
+// ListFolderAccesses calls the "list folder accesses" endpoint of the
+// "secrets" service.
+// ListFolderAccesses may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListFolderAccesses(ctx context.Context) (res []*FolderAccess, err error) {
+	var ires any
+	ires, err = c.ListFolderAccessesEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*FolderAccess), nil
+}
+
+// GrantFolderAccess calls the "grant folder access" endpoint of the "secrets"
+// service.
+// GrantFolderAccess may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) GrantFolderAccess(ctx context.Context, p *GrantFolderAccessPayload) (res int, err error) {
+	var ires any
+	ires, err = c.GrantFolderAccessEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(int), nil
+}
+
+// RevokeFolderAccess calls the "revoke folder access" endpoint of the
+// "secrets" service.
+// RevokeFolderAccess may return the following errors:
+//   - "folder_access_not_found" (type *goa.ServiceError): Folder access not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid token path
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) RevokeFolderAccess(ctx context.Context, p *RevokeFolderAccessPayload) (err error) {
+	_, err = c.RevokeFolderAccessEndpoint(ctx, p)
+	return
+}
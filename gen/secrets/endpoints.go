@@ -9,46 +9,234 @@ package secrets
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Endpoints wraps the "secrets" service endpoints.
 type Endpoints struct {
-	ListSecrets            goa.Endpoint
-	GetSecretValue         goa.Endpoint
-	OperatorGetSecretValue goa.Endpoint
-	GetSecret              goa.Endpoint
-	CreateSecret           goa.Endpoint
-	UpdateSecret           goa.Endpoint
+	ListSecrets             goa.Endpoint
+	BrowseSecrets           goa.Endpoint
+	GetSecretValue          goa.Endpoint
+	OperatorGetSecretValue  goa.Endpoint
+	BatchGetSecretValues    goa.Endpoint
+	RenderTemplate          goa.Endpoint
+	WatchSecrets            goa.Endpoint
+	UploadSecretFile        goa.Endpoint
+	DownloadSecretFile      goa.Endpoint
+	ExportSecrets           goa.Endpoint
+	ImportSecrets           goa.Endpoint
+	GetSecret               goa.Endpoint
+	CreateSecret            goa.Endpoint
+	UpdateSecret            goa.Endpoint
+	RegenerateSecret        goa.Endpoint
+	SetSecretRotation       goa.Endpoint
+	ListSecretRotations     goa.Endpoint
+	DeleteSecret            goa.Endpoint
+	MoveSecrets             goa.Endpoint
+	DiffSecrets             goa.Endpoint
+	PromoteSecrets          goa.Endpoint
+	TransferSecretOwnership goa.Endpoint
+	ListStaleSecrets        goa.Endpoint
+	SetSecretCanary         goa.Endpoint
+	ListCanarySecrets       goa.Endpoint
+	WrapSecret              goa.Endpoint
+	UnwrapSecret            goa.Endpoint
+	RequestAccess           goa.Endpoint
+	ListAccessRequests      goa.Endpoint
+	ApproveAccessRequest    goa.Endpoint
+	DenyAccessRequest       goa.Endpoint
+	ListValidationRules     goa.Endpoint
+	CreateValidationRule    goa.Endpoint
+	DeleteValidationRule    goa.Endpoint
+	ListBreakGlassRules     goa.Endpoint
+	CreateBreakGlassRule    goa.Endpoint
+	DeleteBreakGlassRule    goa.Endpoint
+	ListBreakGlassAccesses  goa.Endpoint
+	ReviewBreakGlassAccess  goa.Endpoint
+	ListFolderAccesses      goa.Endpoint
+	GrantFolderAccess       goa.Endpoint
+	RevokeFolderAccess      goa.Endpoint
+}
+
+// WatchSecretsEndpointInput holds both the payload and the server stream of
+// the "watch secrets" method.
+type WatchSecretsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *WatchSecretsPayload
+	// Stream is the server stream used by the "watch secrets" method to send data.
+	Stream WatchSecretsServerStream
+}
+
+// UploadSecretFileRequestData holds both the payload and the HTTP request body
+// reader of the "upload secret file" method.
+type UploadSecretFileRequestData struct {
+	// Payload is the method payload.
+	Payload *UploadSecretFilePayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// DownloadSecretFileResponseData holds both the result and the HTTP response
+// body reader of the "download secret file" method.
+type DownloadSecretFileResponseData struct {
+	// Result is the method result.
+	Result *DownloadSecretFileResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// ExportSecretsResponseData holds both the result and the HTTP response body
+// reader of the "export secrets" method.
+type ExportSecretsResponseData struct {
+	// Result is the method result.
+	Result *ExportSecretsResult
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// ImportSecretsRequestData holds both the payload and the HTTP request body
+// reader of the "import secrets" method.
+type ImportSecretsRequestData struct {
+	// Payload is the method payload.
+	Payload *ImportSecretsPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
 }
 
 // NewEndpoints wraps the methods of the "secrets" service with endpoints.
 func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
 	endpoints := &Endpoints{
-		ListSecrets:            NewListSecretsEndpoint(s),
-		GetSecretValue:         NewGetSecretValueEndpoint(s),
-		OperatorGetSecretValue: NewOperatorGetSecretValueEndpoint(s),
-		GetSecret:              NewGetSecretEndpoint(s),
-		CreateSecret:           NewCreateSecretEndpoint(s),
-		UpdateSecret:           NewUpdateSecretEndpoint(s),
+		ListSecrets:             NewListSecretsEndpoint(s),
+		BrowseSecrets:           NewBrowseSecretsEndpoint(s),
+		GetSecretValue:          NewGetSecretValueEndpoint(s),
+		OperatorGetSecretValue:  NewOperatorGetSecretValueEndpoint(s),
+		BatchGetSecretValues:    NewBatchGetSecretValuesEndpoint(s),
+		RenderTemplate:          NewRenderTemplateEndpoint(s),
+		WatchSecrets:            NewWatchSecretsEndpoint(s),
+		UploadSecretFile:        NewUploadSecretFileEndpoint(s),
+		DownloadSecretFile:      NewDownloadSecretFileEndpoint(s),
+		ExportSecrets:           NewExportSecretsEndpoint(s),
+		ImportSecrets:           NewImportSecretsEndpoint(s),
+		GetSecret:               NewGetSecretEndpoint(s),
+		CreateSecret:            NewCreateSecretEndpoint(s),
+		UpdateSecret:            NewUpdateSecretEndpoint(s),
+		RegenerateSecret:        NewRegenerateSecretEndpoint(s),
+		SetSecretRotation:       NewSetSecretRotationEndpoint(s),
+		ListSecretRotations:     NewListSecretRotationsEndpoint(s),
+		DeleteSecret:            NewDeleteSecretEndpoint(s),
+		MoveSecrets:             NewMoveSecretsEndpoint(s),
+		DiffSecrets:             NewDiffSecretsEndpoint(s),
+		PromoteSecrets:          NewPromoteSecretsEndpoint(s),
+		TransferSecretOwnership: NewTransferSecretOwnershipEndpoint(s),
+		ListStaleSecrets:        NewListStaleSecretsEndpoint(s),
+		SetSecretCanary:         NewSetSecretCanaryEndpoint(s),
+		ListCanarySecrets:       NewListCanarySecretsEndpoint(s),
+		WrapSecret:              NewWrapSecretEndpoint(s),
+		UnwrapSecret:            NewUnwrapSecretEndpoint(s),
+		RequestAccess:           NewRequestAccessEndpoint(s),
+		ListAccessRequests:      NewListAccessRequestsEndpoint(s),
+		ApproveAccessRequest:    NewApproveAccessRequestEndpoint(s),
+		DenyAccessRequest:       NewDenyAccessRequestEndpoint(s),
+		ListValidationRules:     NewListValidationRulesEndpoint(s),
+		CreateValidationRule:    NewCreateValidationRuleEndpoint(s),
+		DeleteValidationRule:    NewDeleteValidationRuleEndpoint(s),
+		ListBreakGlassRules:     NewListBreakGlassRulesEndpoint(s),
+		CreateBreakGlassRule:    NewCreateBreakGlassRuleEndpoint(s),
+		DeleteBreakGlassRule:    NewDeleteBreakGlassRuleEndpoint(s),
+		ListBreakGlassAccesses:  NewListBreakGlassAccessesEndpoint(s),
+		ReviewBreakGlassAccess:  NewReviewBreakGlassAccessEndpoint(s),
+		ListFolderAccesses:      NewListFolderAccessesEndpoint(s),
+		GrantFolderAccess:       NewGrantFolderAccessEndpoint(s),
+		RevokeFolderAccess:      NewRevokeFolderAccessEndpoint(s),
 	}
 	endpoints.ListSecrets = WrapListSecretsEndpoint(endpoints.ListSecrets, si)
+	endpoints.BrowseSecrets = WrapBrowseSecretsEndpoint(endpoints.BrowseSecrets, si)
 	endpoints.GetSecretValue = WrapGetSecretValueEndpoint(endpoints.GetSecretValue, si)
+	endpoints.BatchGetSecretValues = WrapBatchGetSecretValuesEndpoint(endpoints.BatchGetSecretValues, si)
+	endpoints.RenderTemplate = WrapRenderTemplateEndpoint(endpoints.RenderTemplate, si)
+	endpoints.UploadSecretFile = WrapUploadSecretFileEndpoint(endpoints.UploadSecretFile, si)
+	endpoints.DownloadSecretFile = WrapDownloadSecretFileEndpoint(endpoints.DownloadSecretFile, si)
+	endpoints.ExportSecrets = WrapExportSecretsEndpoint(endpoints.ExportSecrets, si)
+	endpoints.ImportSecrets = WrapImportSecretsEndpoint(endpoints.ImportSecrets, si)
 	endpoints.GetSecret = WrapGetSecretEndpoint(endpoints.GetSecret, si)
 	endpoints.CreateSecret = WrapCreateSecretEndpoint(endpoints.CreateSecret, si)
 	endpoints.UpdateSecret = WrapUpdateSecretEndpoint(endpoints.UpdateSecret, si)
+	endpoints.RegenerateSecret = WrapRegenerateSecretEndpoint(endpoints.RegenerateSecret, si)
+	endpoints.SetSecretRotation = WrapSetSecretRotationEndpoint(endpoints.SetSecretRotation, si)
+	endpoints.ListSecretRotations = WrapListSecretRotationsEndpoint(endpoints.ListSecretRotations, si)
+	endpoints.DeleteSecret = WrapDeleteSecretEndpoint(endpoints.DeleteSecret, si)
+	endpoints.MoveSecrets = WrapMoveSecretsEndpoint(endpoints.MoveSecrets, si)
+	endpoints.DiffSecrets = WrapDiffSecretsEndpoint(endpoints.DiffSecrets, si)
+	endpoints.PromoteSecrets = WrapPromoteSecretsEndpoint(endpoints.PromoteSecrets, si)
+	endpoints.TransferSecretOwnership = WrapTransferSecretOwnershipEndpoint(endpoints.TransferSecretOwnership, si)
+	endpoints.ListStaleSecrets = WrapListStaleSecretsEndpoint(endpoints.ListStaleSecrets, si)
+	endpoints.SetSecretCanary = WrapSetSecretCanaryEndpoint(endpoints.SetSecretCanary, si)
+	endpoints.ListCanarySecrets = WrapListCanarySecretsEndpoint(endpoints.ListCanarySecrets, si)
+	endpoints.WrapSecret = WrapWrapSecretEndpoint(endpoints.WrapSecret, si)
+	endpoints.RequestAccess = WrapRequestAccessEndpoint(endpoints.RequestAccess, si)
+	endpoints.ListAccessRequests = WrapListAccessRequestsEndpoint(endpoints.ListAccessRequests, si)
+	endpoints.ApproveAccessRequest = WrapApproveAccessRequestEndpoint(endpoints.ApproveAccessRequest, si)
+	endpoints.DenyAccessRequest = WrapDenyAccessRequestEndpoint(endpoints.DenyAccessRequest, si)
+	endpoints.ListValidationRules = WrapListValidationRulesEndpoint(endpoints.ListValidationRules, si)
+	endpoints.CreateValidationRule = WrapCreateValidationRuleEndpoint(endpoints.CreateValidationRule, si)
+	endpoints.DeleteValidationRule = WrapDeleteValidationRuleEndpoint(endpoints.DeleteValidationRule, si)
+	endpoints.ListBreakGlassRules = WrapListBreakGlassRulesEndpoint(endpoints.ListBreakGlassRules, si)
+	endpoints.CreateBreakGlassRule = WrapCreateBreakGlassRuleEndpoint(endpoints.CreateBreakGlassRule, si)
+	endpoints.DeleteBreakGlassRule = WrapDeleteBreakGlassRuleEndpoint(endpoints.DeleteBreakGlassRule, si)
+	endpoints.ListBreakGlassAccesses = WrapListBreakGlassAccessesEndpoint(endpoints.ListBreakGlassAccesses, si)
+	endpoints.ReviewBreakGlassAccess = WrapReviewBreakGlassAccessEndpoint(endpoints.ReviewBreakGlassAccess, si)
+	endpoints.ListFolderAccesses = WrapListFolderAccessesEndpoint(endpoints.ListFolderAccesses, si)
+	endpoints.GrantFolderAccess = WrapGrantFolderAccessEndpoint(endpoints.GrantFolderAccess, si)
+	endpoints.RevokeFolderAccess = WrapRevokeFolderAccessEndpoint(endpoints.RevokeFolderAccess, si)
 	return endpoints
 }
 
 // Use applies the given middleware to all the "secrets" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.ListSecrets = m(e.ListSecrets)
+	e.BrowseSecrets = m(e.BrowseSecrets)
 	e.GetSecretValue = m(e.GetSecretValue)
 	e.OperatorGetSecretValue = m(e.OperatorGetSecretValue)
+	e.BatchGetSecretValues = m(e.BatchGetSecretValues)
+	e.RenderTemplate = m(e.RenderTemplate)
+	e.WatchSecrets = m(e.WatchSecrets)
+	e.UploadSecretFile = m(e.UploadSecretFile)
+	e.DownloadSecretFile = m(e.DownloadSecretFile)
+	e.ExportSecrets = m(e.ExportSecrets)
+	e.ImportSecrets = m(e.ImportSecrets)
 	e.GetSecret = m(e.GetSecret)
 	e.CreateSecret = m(e.CreateSecret)
 	e.UpdateSecret = m(e.UpdateSecret)
+	e.RegenerateSecret = m(e.RegenerateSecret)
+	e.SetSecretRotation = m(e.SetSecretRotation)
+	e.ListSecretRotations = m(e.ListSecretRotations)
+	e.DeleteSecret = m(e.DeleteSecret)
+	e.MoveSecrets = m(e.MoveSecrets)
+	e.DiffSecrets = m(e.DiffSecrets)
+	e.PromoteSecrets = m(e.PromoteSecrets)
+	e.TransferSecretOwnership = m(e.TransferSecretOwnership)
+	e.ListStaleSecrets = m(e.ListStaleSecrets)
+	e.SetSecretCanary = m(e.SetSecretCanary)
+	e.ListCanarySecrets = m(e.ListCanarySecrets)
+	e.WrapSecret = m(e.WrapSecret)
+	e.UnwrapSecret = m(e.UnwrapSecret)
+	e.RequestAccess = m(e.RequestAccess)
+	e.ListAccessRequests = m(e.ListAccessRequests)
+	e.ApproveAccessRequest = m(e.ApproveAccessRequest)
+	e.DenyAccessRequest = m(e.DenyAccessRequest)
+	e.ListValidationRules = m(e.ListValidationRules)
+	e.CreateValidationRule = m(e.CreateValidationRule)
+	e.DeleteValidationRule = m(e.DeleteValidationRule)
+	e.ListBreakGlassRules = m(e.ListBreakGlassRules)
+	e.CreateBreakGlassRule = m(e.CreateBreakGlassRule)
+	e.DeleteBreakGlassRule = m(e.DeleteBreakGlassRule)
+	e.ListBreakGlassAccesses = m(e.ListBreakGlassAccesses)
+	e.ReviewBreakGlassAccess = m(e.ReviewBreakGlassAccess)
+	e.ListFolderAccesses = m(e.ListFolderAccesses)
+	e.GrantFolderAccess = m(e.GrantFolderAccess)
+	e.RevokeFolderAccess = m(e.RevokeFolderAccess)
 }
 
 // NewListSecretsEndpoint returns an endpoint function that calls the method
@@ -59,6 +247,15 @@ func NewListSecretsEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewBrowseSecretsEndpoint returns an endpoint function that calls the method
+// "browse secrets" of service "secrets".
+func NewBrowseSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BrowseSecretsPayload)
+		return s.BrowseSecrets(ctx, p)
+	}
+}
+
 // NewGetSecretValueEndpoint returns an endpoint function that calls the method
 // "get secret value" of service "secrets".
 func NewGetSecretValueEndpoint(s Service) goa.Endpoint {
@@ -77,6 +274,77 @@ func NewOperatorGetSecretValueEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewBatchGetSecretValuesEndpoint returns an endpoint function that calls the
+// method "batch get secret values" of service "secrets".
+func NewBatchGetSecretValuesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BatchGetSecretValuesPayload)
+		return s.BatchGetSecretValues(ctx, p)
+	}
+}
+
+// NewRenderTemplateEndpoint returns an endpoint function that calls the method
+// "render template" of service "secrets".
+func NewRenderTemplateEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RenderTemplatePayload)
+		return s.RenderTemplate(ctx, p)
+	}
+}
+
+// NewWatchSecretsEndpoint returns an endpoint function that calls the method
+// "watch secrets" of service "secrets".
+func NewWatchSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*WatchSecretsEndpointInput)
+		return nil, s.WatchSecrets(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewUploadSecretFileEndpoint returns an endpoint function that calls the
+// method "upload secret file" of service "secrets".
+func NewUploadSecretFileEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadSecretFileRequestData)
+		return nil, s.UploadSecretFile(ctx, ep.Payload, ep.Body)
+	}
+}
+
+// NewDownloadSecretFileEndpoint returns an endpoint function that calls the
+// method "download secret file" of service "secrets".
+func NewDownloadSecretFileEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DownloadSecretFilePayload)
+		res, body, err := s.DownloadSecretFile(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadSecretFileResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewExportSecretsEndpoint returns an endpoint function that calls the method
+// "export secrets" of service "secrets".
+func NewExportSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportSecretsPayload)
+		res, body, err := s.ExportSecrets(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportSecretsResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewImportSecretsEndpoint returns an endpoint function that calls the method
+// "import secrets" of service "secrets".
+func NewImportSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ImportSecretsRequestData)
+		return s.ImportSecrets(ctx, ep.Payload, ep.Body)
+	}
+}
+
 // NewGetSecretEndpoint returns an endpoint function that calls the method "get
 // secret" of service "secrets".
 func NewGetSecretEndpoint(s Service) goa.Endpoint {
@@ -103,3 +371,251 @@ func NewUpdateSecretEndpoint(s Service) goa.Endpoint {
 		return nil, s.UpdateSecret(ctx, p)
 	}
 }
+
+// NewRegenerateSecretEndpoint returns an endpoint function that calls the
+// method "regenerate secret" of service "secrets".
+func NewRegenerateSecretEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RegenerateSecretPayload)
+		return s.RegenerateSecret(ctx, p)
+	}
+}
+
+// NewSetSecretRotationEndpoint returns an endpoint function that calls the
+// method "set secret rotation" of service "secrets".
+func NewSetSecretRotationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetSecretRotationPayload)
+		return s.SetSecretRotation(ctx, p)
+	}
+}
+
+// NewListSecretRotationsEndpoint returns an endpoint function that calls the
+// method "list secret rotations" of service "secrets".
+func NewListSecretRotationsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListSecretRotationsPayload)
+		return s.ListSecretRotations(ctx, p)
+	}
+}
+
+// NewDeleteSecretEndpoint returns an endpoint function that calls the method
+// "delete secret" of service "secrets".
+func NewDeleteSecretEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteSecretPayload)
+		return nil, s.DeleteSecret(ctx, p)
+	}
+}
+
+// NewMoveSecretsEndpoint returns an endpoint function that calls the method
+// "move secrets" of service "secrets".
+func NewMoveSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*MoveSecretsPayload)
+		return s.MoveSecrets(ctx, p)
+	}
+}
+
+// NewDiffSecretsEndpoint returns an endpoint function that calls the method
+// "diff secrets" of service "secrets".
+func NewDiffSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DiffSecretsPayload)
+		return s.DiffSecrets(ctx, p)
+	}
+}
+
+// NewPromoteSecretsEndpoint returns an endpoint function that calls the method
+// "promote secrets" of service "secrets".
+func NewPromoteSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PromoteSecretsPayload)
+		return s.PromoteSecrets(ctx, p)
+	}
+}
+
+// NewTransferSecretOwnershipEndpoint returns an endpoint function that calls
+// the method "transfer secret ownership" of service "secrets".
+func NewTransferSecretOwnershipEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TransferSecretOwnershipPayload)
+		return s.TransferSecretOwnership(ctx, p)
+	}
+}
+
+// NewListStaleSecretsEndpoint returns an endpoint function that calls the
+// method "list stale secrets" of service "secrets".
+func NewListStaleSecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListStaleSecretsPayload)
+		return s.ListStaleSecrets(ctx, p)
+	}
+}
+
+// NewSetSecretCanaryEndpoint returns an endpoint function that calls the
+// method "set secret canary" of service "secrets".
+func NewSetSecretCanaryEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetSecretCanaryPayload)
+		return nil, s.SetSecretCanary(ctx, p)
+	}
+}
+
+// NewListCanarySecretsEndpoint returns an endpoint function that calls the
+// method "list canary secrets" of service "secrets".
+func NewListCanarySecretsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListCanarySecrets(ctx)
+	}
+}
+
+// NewWrapSecretEndpoint returns an endpoint function that calls the method
+// "wrap secret" of service "secrets".
+func NewWrapSecretEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*WrapSecretPayload)
+		return s.WrapSecret(ctx, p)
+	}
+}
+
+// NewUnwrapSecretEndpoint returns an endpoint function that calls the method
+// "unwrap secret" of service "secrets".
+func NewUnwrapSecretEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnwrapSecretPayload)
+		return s.UnwrapSecret(ctx, p)
+	}
+}
+
+// NewRequestAccessEndpoint returns an endpoint function that calls the method
+// "request access" of service "secrets".
+func NewRequestAccessEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RequestAccessPayload)
+		return s.RequestAccess(ctx, p)
+	}
+}
+
+// NewListAccessRequestsEndpoint returns an endpoint function that calls the
+// method "list access requests" of service "secrets".
+func NewListAccessRequestsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAccessRequestsPayload)
+		return s.ListAccessRequests(ctx, p)
+	}
+}
+
+// NewApproveAccessRequestEndpoint returns an endpoint function that calls the
+// method "approve access request" of service "secrets".
+func NewApproveAccessRequestEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ApproveAccessRequestPayload)
+		return s.ApproveAccessRequest(ctx, p)
+	}
+}
+
+// NewDenyAccessRequestEndpoint returns an endpoint function that calls the
+// method "deny access request" of service "secrets".
+func NewDenyAccessRequestEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DenyAccessRequestPayload)
+		return s.DenyAccessRequest(ctx, p)
+	}
+}
+
+// NewListValidationRulesEndpoint returns an endpoint function that calls the
+// method "list validation rules" of service "secrets".
+func NewListValidationRulesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListValidationRules(ctx)
+	}
+}
+
+// NewCreateValidationRuleEndpoint returns an endpoint function that calls the
+// method "create validation rule" of service "secrets".
+func NewCreateValidationRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateValidationRulePayload)
+		return s.CreateValidationRule(ctx, p)
+	}
+}
+
+// NewDeleteValidationRuleEndpoint returns an endpoint function that calls the
+// method "delete validation rule" of service "secrets".
+func NewDeleteValidationRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteValidationRulePayload)
+		return nil, s.DeleteValidationRule(ctx, p)
+	}
+}
+
+// NewListBreakGlassRulesEndpoint returns an endpoint function that calls the
+// method "list break glass rules" of service "secrets".
+func NewListBreakGlassRulesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListBreakGlassRules(ctx)
+	}
+}
+
+// NewCreateBreakGlassRuleEndpoint returns an endpoint function that calls the
+// method "create break glass rule" of service "secrets".
+func NewCreateBreakGlassRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateBreakGlassRulePayload)
+		return s.CreateBreakGlassRule(ctx, p)
+	}
+}
+
+// NewDeleteBreakGlassRuleEndpoint returns an endpoint function that calls the
+// method "delete break glass rule" of service "secrets".
+func NewDeleteBreakGlassRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteBreakGlassRulePayload)
+		return nil, s.DeleteBreakGlassRule(ctx, p)
+	}
+}
+
+// NewListBreakGlassAccessesEndpoint returns an endpoint function that calls
+// the method "list break glass accesses" of service "secrets".
+func NewListBreakGlassAccessesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListBreakGlassAccessesPayload)
+		return s.ListBreakGlassAccesses(ctx, p)
+	}
+}
+
+// NewReviewBreakGlassAccessEndpoint returns an endpoint function that calls
+// the method "review break glass access" of service "secrets".
+func NewReviewBreakGlassAccessEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReviewBreakGlassAccessPayload)
+		return s.ReviewBreakGlassAccess(ctx, p)
+	}
+}
+
+// NewListFolderAccessesEndpoint returns an endpoint function that calls the
+// method "list folder accesses" of service "secrets".
+func NewListFolderAccessesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListFolderAccesses(ctx)
+	}
+}
+
+// NewGrantFolderAccessEndpoint returns an endpoint function that calls the
+// method "grant folder access" of service "secrets".
+func NewGrantFolderAccessEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GrantFolderAccessPayload)
+		return s.GrantFolderAccess(ctx, p)
+	}
+}
+
+// NewRevokeFolderAccessEndpoint returns an endpoint function that calls the
+// method "revoke folder access" of service "secrets".
+func NewRevokeFolderAccessEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeFolderAccessPayload)
+		return nil, s.RevokeFolderAccess(ctx, p)
+	}
+}
@@ -27,6 +27,20 @@ func wrapListSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) go
 	}
 }
 
+// wrapAuthentifiedBrowseSecrets applies the Authentified server interceptor to
+// endpoints.
+func wrapBrowseSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "BrowseSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
 // wrapAuthentifiedGetSecretValue applies the Authentified server interceptor
 // to endpoints.
 func wrapGetSecretValueAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -41,6 +55,90 @@ func wrapGetSecretValueAuthentified(endpoint goa.Endpoint, i ServerInterceptors)
 	}
 }
 
+// wrapAuthentifiedBatchGetSecretValues applies the Authentified server
+// interceptor to endpoints.
+func wrapBatchGetSecretValuesAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "BatchGetSecretValues",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedRenderTemplate applies the Authentified server interceptor
+// to endpoints.
+func wrapRenderTemplateAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "RenderTemplate",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedUploadSecretFile applies the Authentified server interceptor
+// to endpoints.
+func wrapUploadSecretFileAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "UploadSecretFile",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedDownloadSecretFile applies the Authentified server
+// interceptor to endpoints.
+func wrapDownloadSecretFileAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "DownloadSecretFile",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedExportSecrets applies the Authentified server interceptor to
+// endpoints.
+func wrapExportSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ExportSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedImportSecrets applies the Authentified server interceptor to
+// endpoints.
+func wrapImportSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ImportSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
 // wrapAuthentifiedGetSecret applies the Authentified server interceptor to
 // endpoints.
 func wrapGetSecretAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -82,3 +180,381 @@ func wrapUpdateSecretAuthentified(endpoint goa.Endpoint, i ServerInterceptors) g
 		return i.Authentified(ctx, info, endpoint)
 	}
 }
+
+// wrapAuthentifiedRegenerateSecret applies the Authentified server interceptor
+// to endpoints.
+func wrapRegenerateSecretAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "RegenerateSecret",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedSetSecretRotation applies the Authentified server
+// interceptor to endpoints.
+func wrapSetSecretRotationAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "SetSecretRotation",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedListSecretRotations applies the Authentified server
+// interceptor to endpoints.
+func wrapListSecretRotationsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ListSecretRotations",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedDeleteSecret applies the Authentified server interceptor to
+// endpoints.
+func wrapDeleteSecretAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "DeleteSecret",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedMoveSecrets applies the Authentified server interceptor to
+// endpoints.
+func wrapMoveSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "MoveSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedDiffSecrets applies the Authentified server interceptor to
+// endpoints.
+func wrapDiffSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "DiffSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedPromoteSecrets applies the Authentified server interceptor
+// to endpoints.
+func wrapPromoteSecretsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "PromoteSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedWrapSecret applies the Authentified server interceptor to
+// endpoints.
+func wrapWrapSecretAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "WrapSecret",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedRequestAccess applies the Authentified server interceptor to
+// endpoints.
+func wrapRequestAccessAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "RequestAccess",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedListAccessRequests applies the Authentified server
+// interceptor to endpoints.
+func wrapListAccessRequestsAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ListAccessRequests",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedApproveAccessRequest applies the Authentified server
+// interceptor to endpoints.
+func wrapApproveAccessRequestAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ApproveAccessRequest",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedDenyAccessRequest applies the Authentified server
+// interceptor to endpoints.
+func wrapDenyAccessRequestAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "DenyAccessRequest",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedListBreakGlassAccesses applies the Authentified server
+// interceptor to endpoints.
+func wrapListBreakGlassAccessesAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ListBreakGlassAccesses",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapAuthentifiedReviewBreakGlassAccess applies the Authentified server
+// interceptor to endpoints.
+func wrapReviewBreakGlassAccessAuthentified(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &AuthentifiedInfo{
+			service:    "secrets",
+			method:     "ReviewBreakGlassAccess",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.Authentified(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminTransferSecretOwnership applies the IsAdmin server interceptor to
+// endpoints.
+func wrapTransferSecretOwnershipIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "TransferSecretOwnership",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListStaleSecrets applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListStaleSecretsIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "ListStaleSecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminSetSecretCanary applies the IsAdmin server interceptor to
+// endpoints.
+func wrapSetSecretCanaryIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "SetSecretCanary",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListCanarySecrets applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListCanarySecretsIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "ListCanarySecrets",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListValidationRules applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListValidationRulesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "ListValidationRules",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminCreateValidationRule applies the IsAdmin server interceptor to
+// endpoints.
+func wrapCreateValidationRuleIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "CreateValidationRule",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDeleteValidationRule applies the IsAdmin server interceptor to
+// endpoints.
+func wrapDeleteValidationRuleIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "DeleteValidationRule",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListBreakGlassRules applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListBreakGlassRulesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "ListBreakGlassRules",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminCreateBreakGlassRule applies the IsAdmin server interceptor to
+// endpoints.
+func wrapCreateBreakGlassRuleIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "CreateBreakGlassRule",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDeleteBreakGlassRule applies the IsAdmin server interceptor to
+// endpoints.
+func wrapDeleteBreakGlassRuleIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "DeleteBreakGlassRule",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListFolderAccesses applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListFolderAccessesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "ListFolderAccesses",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminGrantFolderAccess applies the IsAdmin server interceptor to
+// endpoints.
+func wrapGrantFolderAccessIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "GrantFolderAccess",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminRevokeFolderAccess applies the IsAdmin server interceptor to
+// endpoints.
+func wrapRevokeFolderAccessIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "secrets",
+			method:     "RevokeFolderAccess",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
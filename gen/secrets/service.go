@@ -9,6 +9,7 @@ package secrets
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
@@ -17,16 +18,141 @@ import (
 type Service interface {
 	// Retrieve all secrets you have access to
 	ListSecrets(context.Context) (res []*SecretInfoSummary, err error)
-	// Retrieve a secret value
+	// List the folders and secrets you have access to under a path prefix
+	BrowseSecrets(context.Context, *BrowseSecretsPayload) (res *BrowseSecretsResult, err error)
+	// Retrieve a secret value, or the fields of a structured secret, with the
+	// references they hold to other secrets resolved
 	GetSecretValue(context.Context, *GetSecretValuePayload) (res *GetSecretValueResult, err error)
-	// Retrieve a secret value using GRPC
+	// Retrieve a secret value using GRPC, or the fields of a structured secret
 	OperatorGetSecretValue(context.Context, *OperatorGetSecretValuePayload) (res *OperatorGetSecretValueResult, err error)
+	// Retrieve the values of several secrets at once. Each secret is returned with
+	// its value or the error preventing its read, in the order of the request
+	BatchGetSecretValues(context.Context, *BatchGetSecretValuesPayload) (res *BatchGetSecretValuesResult, err error)
+	// Render a Go text/template document reading secrets, given inline or stored
+	// in a secret. Templates read secrets with secret "/path", field "/path"
+	// "name" and fields "/path", and format values with b64enc, b64dec, json and
+	// env "NAME". You need read access to the template and to every secret it reads
+	RenderTemplate(context.Context, *RenderTemplatePayload) (res *RenderTemplateResult, err error)
+	// Stream the changes of the secrets at the given paths or under the given
+	// prefix which you can list. Reconnecting with the resume token of the last
+	// event received delivers every event since
+	WatchSecrets(context.Context, *WatchSecretsPayload, WatchSecretsServerStream) (err error)
+	// Create a binary secret from the request body, or replace the content of an
+	// existing binary secret which requires the update capability
+	UploadSecretFile(context.Context, *UploadSecretFilePayload, io.ReadCloser) (err error)
+	// Download the value of a secret as a file, with the content type and filename
+	// of binary secrets
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadSecretFile(context.Context, *DownloadSecretFilePayload) (res *DownloadSecretFileResult, body io.ReadCloser, err error)
+	// Download the secrets you can read under a folder as a dotenv, JSON or YAML
+	// file. Binary secrets are left out, the fields of structured secrets are
+	// exported as objects, or as keys of their own in dotenv files
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	ExportSecrets(context.Context, *ExportSecretsPayload) (res *ExportSecretsResult, body io.ReadCloser, err error)
+	// Create the secrets of a dotenv, JSON or YAML file sent as the request body
+	// under a folder. Nothing is written unless every secret can be imported,
+	// overwriting a secret requires the update capability on it
+	ImportSecrets(context.Context, *ImportSecretsPayload, io.ReadCloser) (res []*ImportedSecret, err error)
 	// Retrieve a secret's information
 	GetSecret(context.Context, *GetSecretPayload) (res *SecretInfo, err error)
-	// Create a secret
+	// Create a secret holding either a single value, named fields or a value
+	// generated from a generation policy
 	CreateSecret(context.Context, *CreateSecretPayload) (err error)
-	// Update a secret
+	// Update a secret. Changing the value or the fields requires the update
+	// capability, changing the grants requires the manage_access capability
 	UpdateSecret(context.Context, *UpdateSecretPayload) (err error)
+	// Replace a secret's value with a newly generated one, producing a new version
+	// of the secret
+	RegenerateSecret(context.Context, *RegenerateSecretPayload) (res *RegenerateSecretResult, err error)
+	// Set or remove the rotation schedule of a generated secret
+	SetSecretRotation(context.Context, *SetSecretRotationPayload) (res *SetSecretRotationResult, err error)
+	// List the latest rotations of a secret, most recent first
+	ListSecretRotations(context.Context, *ListSecretRotationsPayload) (res []*SecretRotation, err error)
+	// Delete a secret
+	DeleteSecret(context.Context, *DeleteSecretPayload) (err error)
+	// Move or rename a secret, or every secret under a folder when both paths end
+	// with a '/'. Values, owners, grants and timestamps are kept, folder accesses
+	// stay on their folder. Requires the update and delete capabilities on the
+	// moved secrets, and the delete capability on the overwritten ones
+	MoveSecrets(context.Context, *MoveSecretsPayload) (res int, err error)
+	// Compare the secrets you can read under two folders, such as /staging/ and
+	// /prod/, by the hashes of their values. Values and hashes are never returned
+	DiffSecrets(context.Context, *DiffSecretsPayload) (res []*SecretDiff, err error)
+	// Copy secrets from a folder to the same keys under another folder, in a
+	// single transaction. Created secrets get the owner and the grants of their
+	// source, overwritten ones keep their own. Requires read access to the
+	// promoted secrets and the update capability on the overwritten ones,
+	// identical secrets are skipped
+	PromoteSecrets(context.Context, *PromoteSecretsPayload) (res int, err error)
+	// Give the ownership of a secret, or of every secret owned by a user, to
+	// another user or to a role
+	TransferSecretOwnership(context.Context, *TransferSecretOwnershipPayload) (res int, err error)
+	// List the secrets nobody read the value of for a number of days, least
+	// recently read first. Secrets never read are listed once they are that old
+	ListStaleSecrets(context.Context, *ListStaleSecretsPayload) (res []*StaleSecret, err error)
+	// Mark a secret as a canary, or back as a normal secret. Reading the value of
+	// a canary raises a secret.canary_accessed alert, the caller sees a normal
+	// secret
+	SetSecretCanary(context.Context, *SetSecretCanaryPayload) (err error)
+	// List the paths of the canary secrets
+	ListCanarySecrets(context.Context) (res []string, err error)
+	// Store a value, or a copy of a secret you can read, under a random single-use
+	// token expiring after the TTL. Only the token is returned, to be handed to
+	// someone who unwraps it
+	WrapSecret(context.Context, *WrapSecretPayload) (res *WrapSecretResult, err error)
+	// Return the value wrapped under a token and destroy it. No authentication is
+	// needed, the token can only be unwrapped once: a token reported as already
+	// used was unwrapped by someone else
+	UnwrapSecret(context.Context, *UnwrapSecretPayload) (res *UnwrapSecretResult, err error)
+	// File a request for more capabilities on a secret one can list, to be
+	// approved or denied by an admin or a user who can manage its access
+	RequestAccess(context.Context, *RequestAccessPayload) (res *AccessRequest, err error)
+	// List the access requests you filed and the ones you can decide on, most
+	// recent first. Admins see every request
+	ListAccessRequests(context.Context, *ListAccessRequestsPayload) (res []*AccessRequest, err error)
+	// Approve a pending access request, granting the requested capabilities to the
+	// requester for the requested duration, never shortening an existing grant of
+	// the requester. Only admins and users who can manage the access of the secret
+	// can approve, and not their own requests
+	ApproveAccessRequest(context.Context, *ApproveAccessRequestPayload) (res *AccessRequest, err error)
+	// Deny a pending access request. Only admins and users who can manage the
+	// access of the secret can deny, and not their own requests
+	DenyAccessRequest(context.Context, *DenyAccessRequestPayload) (res *AccessRequest, err error)
+	// List the rules the values of secrets are validated against
+	ListValidationRules(context.Context) (res []*ValidationRule, err error)
+	// Make the values of the secrets under a folder follow a rule when they are
+	// created or updated. Existing values are not checked
+	CreateValidationRule(context.Context, *CreateValidationRulePayload) (res int, err error)
+	// Delete a validation rule
+	DeleteValidationRule(context.Context, *DeleteValidationRulePayload) (err error)
+	// List the folders whose secrets are eligible to break-glass access
+	ListBreakGlassRules(context.Context) (res []*BreakGlassRule, err error)
+	// Make the secrets under a folder eligible to break-glass access, by anyone or
+	// only by the members of a role
+	CreateBreakGlassRule(context.Context, *CreateBreakGlassRulePayload) (res int, err error)
+	// Delete a break-glass rule. The emergency accesses already given last until
+	// they expire
+	DeleteBreakGlassRule(context.Context, *DeleteBreakGlassRulePayload) (err error)
+	// List the emergency accesses on the secrets you can manage the access of,
+	// most recent first. Admins see every emergency access
+	ListBreakGlassAccesses(context.Context, *ListBreakGlassAccessesPayload) (res []*BreakGlassAccess, err error)
+	// Acknowledge an emergency access after reviewing it. Only admins and users
+	// who can manage the access of the secret can review, and not their own
+	// emergency accesses
+	ReviewBreakGlassAccess(context.Context, *ReviewBreakGlassAccessPayload) (res *BreakGlassAccess, err error)
+	// List the accesses granted on folders
+	ListFolderAccesses(context.Context) (res []*FolderAccess, err error)
+	// Grant a user or a role access to every secret under a folder, including
+	// future ones
+	GrantFolderAccess(context.Context, *GrantFolderAccessPayload) (res int, err error)
+	// Revoke an access granted on a folder
+	RevokeFolderAccess(context.Context, *RevokeFolderAccessPayload) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -43,7 +169,146 @@ const ServiceName = "secrets"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"list secrets", "get secret value", "operator get secret value", "get secret", "create secret", "update secret"}
+var MethodNames = [42]string{"list secrets", "browse secrets", "get secret value", "operator get secret value", "batch get secret values", "render template", "watch secrets", "upload secret file", "download secret file", "export secrets", "import secrets", "get secret", "create secret", "update secret", "regenerate secret", "set secret rotation", "list secret rotations", "delete secret", "move secrets", "diff secrets", "promote secrets", "transfer secret ownership", "list stale secrets", "set secret canary", "list canary secrets", "wrap secret", "unwrap secret", "request access", "list access requests", "approve access request", "deny access request", "list validation rules", "create validation rule", "delete validation rule", "list break glass rules", "create break glass rule", "delete break glass rule", "list break glass accesses", "review break glass access", "list folder accesses", "grant folder access", "revoke folder access"}
+
+// WatchSecretsServerStream is the interface a "watch secrets" endpoint server
+// stream must satisfy.
+type WatchSecretsServerStream interface {
+	// Send streams instances of "SecretEvent".
+	Send(*SecretEvent) error
+	// SendWithContext streams instances of "SecretEvent" with context.
+	SendWithContext(context.Context, *SecretEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// WatchSecretsClientStream is the interface a "watch secrets" endpoint client
+// stream must satisfy.
+type WatchSecretsClientStream interface {
+	// Recv reads instances of "SecretEvent" from the stream.
+	Recv() (*SecretEvent, error)
+	// RecvWithContext reads instances of "SecretEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*SecretEvent, error)
+}
+
+// AccessRequest is the result type of the secrets service request access
+// method.
+type AccessRequest struct {
+	// Unique identifier of the access request
+	ID int
+	// The original path of the secret
+	Path string
+	// The user who filed the request
+	Requester *User
+	// Capabilities requested on the secret
+	Capabilities []string
+	// Why the access is needed
+	Justification string
+	// Seconds the access is requested for, it is permanent when absent
+	Duration *int
+	// Status of the request
+	Status string
+	// The user who approved or denied the request
+	DecidedBy *User
+	// Comment left with the decision
+	Comment *string
+	// Time of the decision
+	DecidedAt *string
+	// Time the grant created by the approval lapses at
+	GrantExpiresAt *string
+	// Time the request was filed
+	CreatedAt string
+}
+
+// ApproveAccessRequestPayload is the payload type of the secrets service
+// approve access request method.
+type ApproveAccessRequestPayload struct {
+	// ID of the access request
+	ID int
+	// Comment kept with the decision
+	Comment *string
+}
+
+// BatchGetSecretValuesPayload is the payload type of the secrets service batch
+// get secret values method.
+type BatchGetSecretValuesPayload struct {
+	// The secrets to read
+	Secrets []*SecretValueRequest
+}
+
+// BatchGetSecretValuesResult is the result type of the secrets service batch
+// get secret values method.
+type BatchGetSecretValuesResult struct {
+	// The values of the secrets, in the order of the request
+	Secrets []*SecretValue
+}
+
+// BreakGlassAccess is the result type of the secrets service review break
+// glass access method.
+type BreakGlassAccess struct {
+	// Unique identifier of the emergency access
+	ID int
+	// The original path of the secret
+	Path string
+	// The user who broke the glass
+	User *User
+	// Why the emergency access was needed
+	Justification string
+	// Time the read access given by breaking the glass lapses at
+	ExpiresAt string
+	// The user who acknowledged the emergency access
+	ReviewedBy *User
+	// Time of the acknowledgement
+	ReviewedAt *string
+	// Comment left with the acknowledgement
+	ReviewComment *string
+	// Time the glass was broken
+	CreatedAt string
+}
+
+type BreakGlassRule struct {
+	// Unique identifier of the rule
+	ID int
+	// The folder path whose secrets are eligible to break-glass access, ends with
+	// a '/'
+	PathPrefix string
+	// The only role whose members can break the glass, anyone can when absent
+	Role *Role
+	// Creation timestamp of the rule
+	CreatedAt string
+}
+
+// BrowseSecretsPayload is the payload type of the secrets service browse
+// secrets method.
+type BrowseSecretsPayload struct {
+	// Base64 encoded folder path
+	Prefix string
+	// List every secret below the prefix instead of its immediate children
+	Recursive bool
+	// Cursor returned by a previous call to fetch the next page
+	Cursor *string
+	// Maximum number of entries to return
+	Limit int
+}
+
+// BrowseSecretsResult is the result type of the secrets service browse secrets
+// method.
+type BrowseSecretsResult struct {
+	// The folders and secrets found under the prefix
+	Entries []*SecretEntry
+	// Cursor to fetch the next page, absent on the last page
+	NextCursor *string
+}
+
+// CreateBreakGlassRulePayload is the payload type of the secrets service
+// create break glass rule method.
+type CreateBreakGlassRulePayload struct {
+	// Base64 encoded folder path
+	PathPrefix string
+	// ID of the only role whose members can break the glass
+	RoleID *int
+}
 
 // CreateSecretPayload is the payload type of the secrets service create secret
 // method.
@@ -51,11 +316,139 @@ type CreateSecretPayload struct {
 	// Base64 encoded secret's path
 	Path string
 	// The secret value
-	Value string
+	Value *string
+	// The fields of a structured secret, instead of a value
+	Fields map[string]string
+	// Name of the generation policy generating the value, instead of a value. Key
+	// pairs are stored as private_key and public_key fields
+	GenerationPolicy *string
+	// Duration or cron expression to regenerate the value on, requires a
+	// generation policy
+	RotationSchedule *string
 	// Users IDs authorized to access the secret
 	AuthorizedUsers []int
 	// Role IDs authorized to access the secret
 	AuthorizedRoles []int
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*Grant
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*Grant
+}
+
+// CreateValidationRulePayload is the payload type of the secrets service
+// create validation rule method.
+type CreateValidationRulePayload struct {
+	// Base64 encoded folder path
+	PathPrefix string
+	// What the rule checks
+	Kind string
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, omitted for x509_certificate rules
+	Parameter *string
+	// The only field of structured secrets the rule applies to
+	Field *string
+}
+
+// DeleteBreakGlassRulePayload is the payload type of the secrets service
+// delete break glass rule method.
+type DeleteBreakGlassRulePayload struct {
+	// ID of the rule
+	ID int
+}
+
+// DeleteSecretPayload is the payload type of the secrets service delete secret
+// method.
+type DeleteSecretPayload struct {
+	// Base64 encoded secret's path
+	Path string
+}
+
+// DeleteValidationRulePayload is the payload type of the secrets service
+// delete validation rule method.
+type DeleteValidationRulePayload struct {
+	// ID of the rule
+	ID int
+}
+
+// DenyAccessRequestPayload is the payload type of the secrets service deny
+// access request method.
+type DenyAccessRequestPayload struct {
+	// ID of the access request
+	ID int
+	// Comment kept with the decision
+	Comment *string
+}
+
+// DiffSecretsPayload is the payload type of the secrets service diff secrets
+// method.
+type DiffSecretsPayload struct {
+	// Base64 encoded path of the source folder
+	Source string
+	// Base64 encoded path of the destination folder
+	Destination string
+}
+
+// DownloadSecretFilePayload is the payload type of the secrets service
+// download secret file method.
+type DownloadSecretFilePayload struct {
+	// Base64 encoded secret's path
+	Path string
+}
+
+// DownloadSecretFileResult is the result type of the secrets service download
+// secret file method.
+type DownloadSecretFileResult struct {
+	// Content type of the file
+	ContentType string
+	// Attachment disposition holding the original filename
+	ContentDisposition string
+	// Size of the file in bytes
+	ContentLength int64
+}
+
+// ExportSecretsPayload is the payload type of the secrets service export
+// secrets method.
+type ExportSecretsPayload struct {
+	// Base64 encoded path of the folder
+	Prefix string
+	// Format of the file
+	Format string
+	// How secrets are named: path after their path relative to the folder
+	// (db/password), segment after the last segment of their path (password), env
+	// after it as an environment variable (PASSWORD). Defaults to env for dotenv
+	// files and to path otherwise
+	KeyNaming *string
+	// Export the values as stored, without resolving the references they hold to
+	// other secrets
+	Raw bool
+}
+
+// ExportSecretsResult is the result type of the secrets service export secrets
+// method.
+type ExportSecretsResult struct {
+	// Content type of the file
+	ContentType string
+	// Attachment disposition holding the filename
+	ContentDisposition string
+	// Size of the file in bytes
+	ContentLength int64
+}
+
+type FolderAccess struct {
+	// Unique identifier of the folder access
+	ID int
+	// The folder path the access applies to, ends with a '/'
+	PathPrefix string
+	// The user granted access to the folder
+	User *User
+	// The role granted access to the folder
+	Role *Role
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string
+	// Creation timestamp of the folder access
+	CreatedAt string
 }
 
 // GetSecretPayload is the payload type of the secrets service get secret
@@ -70,15 +463,121 @@ type GetSecretPayload struct {
 type GetSecretValuePayload struct {
 	// Base64 encoded secret's path
 	Path string
+	// Name of the field to retrieve from a structured secret
+	Field *string
+	// Why an emergency access is needed. When you cannot read the secret and it is
+	// eligible to break-glass access, you are given read access to it for a while,
+	// and its owners and the admins are notified
+	BreakGlassJustification *string
+	// Return the value as stored, without resolving the ${ref:/path} references it
+	// holds to other secrets
+	Raw bool
 }
 
 // GetSecretValueResult is the result type of the secrets service get secret
 // value method.
 type GetSecretValueResult struct {
-	// The secret value
+	// The secret value, or the value of the requested field
 	Value *string
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string
 	// The original path of the secret
 	Path *string
+	// Time your emergency access to the secret lapses at, when the secret was read
+	// by breaking the glass
+	BreakGlassExpiresAt *string
+}
+
+type Grant struct {
+	// ID of the user or role the capabilities are granted to
+	ID int
+	// Capabilities granted on the secret
+	Capabilities []string
+	// Time the grant lapses at, it never does when absent
+	ExpiresAt *string
+	// Seconds left before the grant lapses, only set in responses
+	ExpiresIn *int
+}
+
+// GrantFolderAccessPayload is the payload type of the secrets service grant
+// folder access method.
+type GrantFolderAccessPayload struct {
+	// Base64 encoded folder path
+	PathPrefix string
+	// ID of the user to grant access to
+	UserID *int
+	// ID of the role to grant access to
+	RoleID *int
+	// Capabilities granted on the secrets under the folder
+	Capabilities []string
+}
+
+// ImportSecretsPayload is the payload type of the secrets service import
+// secrets method.
+type ImportSecretsPayload struct {
+	// Base64 encoded path of the folder
+	Prefix string
+	// Format of the file
+	Format string
+	// How keys are turned into paths: path uses them as paths relative to the
+	// folder, segment as the last segment of the path, env lowercases them.
+	// Defaults to env for dotenv files and to path otherwise
+	KeyNaming *string
+	// What to do with the secrets already existing: fail the import, skip them or
+	// overwrite them
+	OnExisting string
+	// Check the import and return what it would do without writing anything
+	DryRun bool
+}
+
+type ImportedSecret struct {
+	// Key of the secret in the imported file
+	Key string
+	// Path the secret is imported to
+	Path string
+	// What the import does to the secret
+	Action string
+}
+
+// ListAccessRequestsPayload is the payload type of the secrets service list
+// access requests method.
+type ListAccessRequestsPayload struct {
+	// Only list the requests with this status
+	Status *string
+}
+
+// ListBreakGlassAccessesPayload is the payload type of the secrets service
+// list break glass accesses method.
+type ListBreakGlassAccessesPayload struct {
+	// Only list the emergency accesses awaiting a review
+	Unreviewed bool
+}
+
+// ListSecretRotationsPayload is the payload type of the secrets service list
+// secret rotations method.
+type ListSecretRotationsPayload struct {
+	// Base64 encoded secret's path
+	Path string
+	// Maximum number of rotations to return
+	Limit int
+}
+
+// ListStaleSecretsPayload is the payload type of the secrets service list
+// stale secrets method.
+type ListStaleSecretsPayload struct {
+	// Number of days without a read
+	Days int
+}
+
+// MoveSecretsPayload is the payload type of the secrets service move secrets
+// method.
+type MoveSecretsPayload struct {
+	// Base64 encoded path of the secret or folder to move
+	Source string
+	// Base64 encoded new path of the secret or folder
+	Destination string
+	// Replace the secrets already existing at the destination paths
+	Overwrite bool
 }
 
 // OperatorGetSecretValuePayload is the payload type of the secrets service
@@ -86,15 +585,101 @@ type GetSecretValueResult struct {
 type OperatorGetSecretValuePayload struct {
 	// Base64 encoded secret's path
 	Path string
+	// Name of the field to retrieve from a structured secret
+	Field *string
 }
 
 // OperatorGetSecretValueResult is the result type of the secrets service
 // operator get secret value method.
 type OperatorGetSecretValueResult struct {
-	// The secret value
+	// The secret value, or the value of the requested field
 	Value *string
 	// The original path of the secret
 	Path *string
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte
+	// Content type of a binary secret
+	ContentType *string
+	// Original filename of a binary secret
+	Filename *string
+}
+
+// PromoteSecretsPayload is the payload type of the secrets service promote
+// secrets method.
+type PromoteSecretsPayload struct {
+	// Base64 encoded path of the source folder
+	Source string
+	// Base64 encoded path of the destination folder
+	Destination string
+	// Paths of the secrets to promote, relative to the folders
+	Keys []string
+	// Replace the values of the secrets which differ at the destination
+	Overwrite bool
+}
+
+// RegenerateSecretPayload is the payload type of the secrets service
+// regenerate secret method.
+type RegenerateSecretPayload struct {
+	// Base64 encoded secret's path
+	Path string
+	// Name of the generation policy to use, defaults to the one the secret was
+	// generated with
+	GenerationPolicy *string
+}
+
+// RegenerateSecretResult is the result type of the secrets service regenerate
+// secret method.
+type RegenerateSecretResult struct {
+	// The new version of the secret
+	Version int
+}
+
+// RenderTemplatePayload is the payload type of the secrets service render
+// template method.
+type RenderTemplatePayload struct {
+	// The template to render
+	Template *string
+	// Base64 encoded path of a secret holding the template to render
+	TemplatePath *string
+}
+
+// RenderTemplateResult is the result type of the secrets service render
+// template method.
+type RenderTemplateResult struct {
+	// The rendered document
+	Rendered string
+}
+
+// RequestAccessPayload is the payload type of the secrets service request
+// access method.
+type RequestAccessPayload struct {
+	// Base64 encoded path of the secret
+	Path string
+	// Capabilities requested on the secret
+	Capabilities []string
+	// Why the access is needed
+	Justification string
+	// Seconds the access is needed for, it is permanent when absent
+	Duration *int
+}
+
+// ReviewBreakGlassAccessPayload is the payload type of the secrets service
+// review break glass access method.
+type ReviewBreakGlassAccessPayload struct {
+	// ID of the emergency access
+	ID int
+	// Comment kept with the acknowledgement
+	Comment *string
+}
+
+// RevokeFolderAccessPayload is the payload type of the secrets service revoke
+// folder access method.
+type RevokeFolderAccessPayload struct {
+	// ID of the folder access to revoke
+	ID int
 }
 
 type Role struct {
@@ -112,16 +697,80 @@ type Role struct {
 	UpdatedAt string
 }
 
+type SecretDiff struct {
+	// Path of the secret relative to the compared folders
+	Key string
+	// missing when the secret is only in the source folder, extra when it is only
+	// in the destination folder, different or identical when the hashes of their
+	// values differ or not, inaccessible when you cannot read one of them
+	Status string
+}
+
+type SecretEntry struct {
+	// The full path of the folder or secret, folders end with a '/'
+	Path string
+	// The last segment of the path
+	Name string
+	// Whether the entry is a folder
+	Folder bool
+	// Creation timestamp of the secret, absent for folders
+	CreatedAt *string
+	// Last update timestamp of the secret, absent for folders
+	UpdatedAt *string
+}
+
+// SecretEvent is the result type of the secrets service watch secrets method.
+type SecretEvent struct {
+	// Type of the event. A bookmark is sent first, with the position the watch
+	// starts from
+	Type string
+	// The original path of the secret
+	Path *string
+	// Version of the secret's value when the event occurred
+	Version *int
+	// Token to resume the watch right after this event
+	ResumeToken string
+	// Time of the event
+	OccurredAt *string
+}
+
 // The secret's information
 type SecretInfo struct {
 	// The original path of the secret
 	Path string
 	// The owner of the secret
 	Owner *User
+	// The role owning the secret, in place of a user
+	OwnerRole *Role
 	// Members authorized to access the secret
 	AuthorizedUsers []*User
 	// Roles authorized to access the secret
 	AuthorizedRoles []*Role
+	// Capabilities granted to each authorized user
+	UserGrants []*Grant
+	// Capabilities granted to each authorized role
+	RoleGrants []*Grant
+	// Capabilities you hold on the secret
+	Capabilities []string
+	// Whether the secret holds named fields instead of a single value
+	Structured bool
+	// Names of the fields of a structured secret
+	Fields []string
+	// Version of the secret's value, incremented on every change
+	Version int
+	// Name of the generation policy used to regenerate the secret
+	GenerationPolicy *string
+	// Duration or cron expression the secret is regenerated on
+	RotationSchedule *string
+	// Time of the next scheduled rotation
+	NextRotationAt *string
+	// Time the value of the secret was last read, recorded with a delay of a few
+	// seconds
+	LastReadAt *string
+	// The user who last read the value of the secret
+	LastReader *User
+	// Number of reads of the value of the secret over the last 30 days
+	RecentReads int
 	// Creation timestamp of the secret
 	CreatedAt string
 	// Last update timestamp of the secret
@@ -133,6 +782,8 @@ type SecretInfoSummary struct {
 	Path string
 	// The owner of the secret
 	Owner *User
+	// The role owning the secret, in place of a user
+	OwnerRole *Role
 	// Creation timestamp of the secret
 	CreatedAt string
 	// Last update timestamp of the secret
@@ -143,17 +794,147 @@ type SecretInfoSummary struct {
 	Roles []*Role
 }
 
+type SecretRotation struct {
+	// Version of the secret after the rotation, unchanged when it failed
+	Version int
+	// Whether the rotation was scheduled, or requested by a user
+	Scheduled bool
+	// Why the rotation failed
+	Error *string
+	// Time of the rotation
+	RotatedAt string
+}
+
+type SecretValue struct {
+	// Base64 encoded secret's path, as requested
+	Path string
+	// The secret value, or the value of the requested field
+	Value *string
+	// The fields of a structured secret, when no field is requested
+	Fields map[string]string
+	// The secret value, or the value of the requested field, as raw bytes. Binary
+	// secrets only have this value
+	RawValue []byte
+	// Content type of a binary secret
+	ContentType *string
+	// Original filename of a binary secret
+	Filename *string
+	// Why the value could not be read, the other fields are absent when set
+	Error *string
+	// Description of the error
+	ErrorMessage *string
+}
+
+type SecretValueRequest struct {
+	// Base64 encoded secret's path
+	Path string
+	// Name of the field to retrieve from a structured secret
+	Field *string
+}
+
+// SetSecretCanaryPayload is the payload type of the secrets service set secret
+// canary method.
+type SetSecretCanaryPayload struct {
+	// Base64 encoded secret's path
+	Path string
+	// Whether the secret is a canary
+	Canary bool
+}
+
+// SetSecretRotationPayload is the payload type of the secrets service set
+// secret rotation method.
+type SetSecretRotationPayload struct {
+	// Base64 encoded secret's path
+	Path string
+	// Duration such as 720h or cron expression such as '0 3 * * 1', the schedule
+	// is removed if absent
+	Schedule *string
+}
+
+// SetSecretRotationResult is the result type of the secrets service set secret
+// rotation method.
+type SetSecretRotationResult struct {
+	// Time of the next scheduled rotation
+	NextRotationAt *string
+}
+
+type StaleSecret struct {
+	// The original path of the secret
+	Path string
+	// Time the value of the secret was last read, unset when it was never read
+	LastReadAt *string
+	// The user who last read the value of the secret
+	LastReader *User
+	// Creation timestamp of the secret
+	CreatedAt string
+}
+
+// TransferSecretOwnershipPayload is the payload type of the secrets service
+// transfer secret ownership method.
+type TransferSecretOwnershipPayload struct {
+	// Base64 encoded path of the secret to transfer
+	Path *string
+	// ID of the user whose secrets are all transferred, in place of a path
+	FromUserID *int
+	// ID of the new owner
+	ToUserID *int
+	// ID of the role becoming the owner, in place of a user
+	ToRoleID *int
+}
+
+// UnwrapSecretPayload is the payload type of the secrets service unwrap secret
+// method.
+type UnwrapSecretPayload struct {
+	// Token returned when wrapping the value
+	Token string
+}
+
+// UnwrapSecretResult is the result type of the secrets service unwrap secret
+// method.
+type UnwrapSecretResult struct {
+	// The wrapped value
+	Value *string
+	// The fields of a wrapped structured secret
+	Fields map[string]string
+	// Path of the secret the value was copied from
+	Path *string
+	// Time the value was wrapped
+	WrappedAt string
+}
+
 // UpdateSecretPayload is the payload type of the secrets service update secret
 // method.
 type UpdateSecretPayload struct {
 	// Base64 encoded secret's path
 	Path string
-	// The secret value
-	Value string
-	// Users IDs authorized to access the secret
+	// The new secret value, unchanged if absent
+	Value *string
+	// Fields to add or replace in a structured secret
+	Fields map[string]string
+	// Names of the fields to remove from a structured secret
+	RemoveFields []string
+	// Users IDs authorized to access the secret, grants are unchanged if no users
+	// nor roles are given
 	AuthorizedUsers []int
 	// Role IDs authorized to access the secret
 	AuthorizedRoles []int
+	// Capabilities of users, overriding the read and list capabilities given to
+	// authorized_users
+	UserGrants []*Grant
+	// Capabilities of roles, overriding the read and list capabilities given to
+	// authorized_roles
+	RoleGrants []*Grant
+}
+
+// UploadSecretFilePayload is the payload type of the secrets service upload
+// secret file method.
+type UploadSecretFilePayload struct {
+	// Base64 encoded secret's path
+	Path string
+	// Content type of the file
+	ContentType string
+	// Original filename, defaults to the last segment of the path
+	Filename *string
 }
 
 type User struct {
@@ -169,6 +950,56 @@ type User struct {
 	Roles []*Role
 }
 
+type ValidationRule struct {
+	// Unique identifier of the rule
+	ID int
+	// The folder path whose secrets the rule applies to, ends with a '/'
+	PathPrefix string
+	// What the rule checks
+	Kind string
+	// The JSON Schema, the regular expression, the maximum length or the PEM block
+	// type, empty for x509_certificate rules
+	Parameter string
+	// The only field of structured secrets the rule applies to, it applies to
+	// every field when absent
+	Field *string
+	// Creation timestamp of the rule
+	CreatedAt string
+}
+
+// WatchSecretsPayload is the payload type of the secrets service watch secrets
+// method.
+type WatchSecretsPayload struct {
+	// Base64 encoded paths of the secrets to watch
+	Paths []string
+	// Base64 encoded prefix of the paths of the secrets to watch
+	Prefix *string
+	// Resume token of the last event received
+	ResumeToken *string
+}
+
+// WrapSecretPayload is the payload type of the secrets service wrap secret
+// method.
+type WrapSecretPayload struct {
+	// The value to wrap
+	Value *string
+	// Base64 encoded path of the secret to wrap a copy of, instead of a value
+	Path *string
+	// Name of the only field to wrap from a structured secret
+	Field *string
+	// Seconds the token can be unwrapped for
+	TTL int
+}
+
+// WrapSecretResult is the result type of the secrets service wrap secret
+// method.
+type WrapSecretResult struct {
+	// Single-use token unwrapping the value
+	Token string
+	// Expiration time of the token
+	ExpiresAt string
+}
+
 // MakeInvalidParameters builds a goa.ServiceError from an error.
 func MakeInvalidParameters(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "invalid_parameters", false, false, false)
@@ -193,3 +1024,83 @@ func MakeInternalError(err error) *goa.ServiceError {
 func MakeSecretNotFound(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "secret_not_found", false, false, false)
 }
+
+// MakeFieldNotFound builds a goa.ServiceError from an error.
+func MakeFieldNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "field_not_found", false, false, false)
+}
+
+// MakeResumeTokenExpired builds a goa.ServiceError from an error.
+func MakeResumeTokenExpired(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "resume_token_expired", false, false, false)
+}
+
+// MakeSecretTooLarge builds a goa.ServiceError from an error.
+func MakeSecretTooLarge(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "secret_too_large", false, false, false)
+}
+
+// MakeSecretAlreadyExists builds a goa.ServiceError from an error.
+func MakeSecretAlreadyExists(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "secret_already_exists", false, false, false)
+}
+
+// MakeFolderChanged builds a goa.ServiceError from an error.
+func MakeFolderChanged(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "folder_changed", false, false, false)
+}
+
+// MakeTokenNotFound builds a goa.ServiceError from an error.
+func MakeTokenNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "token_not_found", false, false, false)
+}
+
+// MakeTokenExpired builds a goa.ServiceError from an error.
+func MakeTokenExpired(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "token_expired", false, false, false)
+}
+
+// MakeTokenAlreadyUsed builds a goa.ServiceError from an error.
+func MakeTokenAlreadyUsed(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "token_already_used", false, false, false)
+}
+
+// MakeAccessRequestPending builds a goa.ServiceError from an error.
+func MakeAccessRequestPending(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "access_request_pending", false, false, false)
+}
+
+// MakeAccessRequestNotFound builds a goa.ServiceError from an error.
+func MakeAccessRequestNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "access_request_not_found", false, false, false)
+}
+
+// MakeAccessRequestDecided builds a goa.ServiceError from an error.
+func MakeAccessRequestDecided(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "access_request_decided", false, false, false)
+}
+
+// MakeValidationRuleNotFound builds a goa.ServiceError from an error.
+func MakeValidationRuleNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "validation_rule_not_found", false, false, false)
+}
+
+// MakeBreakGlassRuleNotFound builds a goa.ServiceError from an error.
+func MakeBreakGlassRuleNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "break_glass_rule_not_found", false, false, false)
+}
+
+// MakeBreakGlassAccessNotFound builds a goa.ServiceError from an error.
+func MakeBreakGlassAccessNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "break_glass_access_not_found", false, false, false)
+}
+
+// MakeBreakGlassAccessReviewed builds a goa.ServiceError from an error.
+func MakeBreakGlassAccessReviewed(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "break_glass_access_reviewed", false, false, false)
+}
+
+// MakeFolderAccessNotFound builds a goa.ServiceError from an error.
+func MakeFolderAccessNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "folder_access_not_found", false, false, false)
+}
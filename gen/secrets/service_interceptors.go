@@ -20,6 +20,8 @@ import (
 type ServerInterceptors interface {
 	// Server-side interceptor that validates JWT token for HTTP services
 	Authentified(ctx context.Context, info *AuthentifiedInfo, next goa.Endpoint) (any, error)
+	// Server-side interceptor that checks if the user has admin privileges
+	IsAdmin(ctx context.Context, info *IsAdminInfo, next goa.Endpoint) (any, error)
 }
 
 // Access interfaces for interceptor payloads and results
@@ -32,6 +34,14 @@ type (
 		callType   goa.InterceptorCallType
 		rawPayload any
 	}
+	// IsAdminInfo provides metadata about the current interception.
+	// It includes service name, method name, and access to the endpoint.
+	IsAdminInfo struct {
+		service    string
+		method     string
+		callType   goa.InterceptorCallType
+		rawPayload any
+	}
 )
 
 // WrapListSecretsEndpoint wraps the list secrets endpoint with the server-side
@@ -41,6 +51,13 @@ func WrapListSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.En
 	return endpoint
 }
 
+// WrapBrowseSecretsEndpoint wraps the browse secrets endpoint with the
+// server-side interceptors defined in the design.
+func WrapBrowseSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapBrowseSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
 // WrapGetSecretValueEndpoint wraps the get secret value endpoint with the
 // server-side interceptors defined in the design.
 func WrapGetSecretValueEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -48,6 +65,48 @@ func WrapGetSecretValueEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa
 	return endpoint
 }
 
+// WrapBatchGetSecretValuesEndpoint wraps the batch get secret values endpoint
+// with the server-side interceptors defined in the design.
+func WrapBatchGetSecretValuesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapBatchGetSecretValuesAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapRenderTemplateEndpoint wraps the render template endpoint with the
+// server-side interceptors defined in the design.
+func WrapRenderTemplateEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapRenderTemplateAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapUploadSecretFileEndpoint wraps the upload secret file endpoint with the
+// server-side interceptors defined in the design.
+func WrapUploadSecretFileEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapUploadSecretFileAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapDownloadSecretFileEndpoint wraps the download secret file endpoint with
+// the server-side interceptors defined in the design.
+func WrapDownloadSecretFileEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDownloadSecretFileAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapExportSecretsEndpoint wraps the export secrets endpoint with the
+// server-side interceptors defined in the design.
+func WrapExportSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapExportSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapImportSecretsEndpoint wraps the import secrets endpoint with the
+// server-side interceptors defined in the design.
+func WrapImportSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapImportSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
 // WrapGetSecretEndpoint wraps the get secret endpoint with the server-side
 // interceptors defined in the design.
 func WrapGetSecretEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
@@ -69,6 +128,195 @@ func WrapUpdateSecretEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.E
 	return endpoint
 }
 
+// WrapRegenerateSecretEndpoint wraps the regenerate secret endpoint with the
+// server-side interceptors defined in the design.
+func WrapRegenerateSecretEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapRegenerateSecretAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapSetSecretRotationEndpoint wraps the set secret rotation endpoint with
+// the server-side interceptors defined in the design.
+func WrapSetSecretRotationEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapSetSecretRotationAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapListSecretRotationsEndpoint wraps the list secret rotations endpoint
+// with the server-side interceptors defined in the design.
+func WrapListSecretRotationsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListSecretRotationsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapDeleteSecretEndpoint wraps the delete secret endpoint with the
+// server-side interceptors defined in the design.
+func WrapDeleteSecretEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDeleteSecretAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapMoveSecretsEndpoint wraps the move secrets endpoint with the server-side
+// interceptors defined in the design.
+func WrapMoveSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapMoveSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapDiffSecretsEndpoint wraps the diff secrets endpoint with the server-side
+// interceptors defined in the design.
+func WrapDiffSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDiffSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapPromoteSecretsEndpoint wraps the promote secrets endpoint with the
+// server-side interceptors defined in the design.
+func WrapPromoteSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapPromoteSecretsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapTransferSecretOwnershipEndpoint wraps the transfer secret ownership
+// endpoint with the server-side interceptors defined in the design.
+func WrapTransferSecretOwnershipEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapTransferSecretOwnershipIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapListStaleSecretsEndpoint wraps the list stale secrets endpoint with the
+// server-side interceptors defined in the design.
+func WrapListStaleSecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListStaleSecretsIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapSetSecretCanaryEndpoint wraps the set secret canary endpoint with the
+// server-side interceptors defined in the design.
+func WrapSetSecretCanaryEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapSetSecretCanaryIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapListCanarySecretsEndpoint wraps the list canary secrets endpoint with
+// the server-side interceptors defined in the design.
+func WrapListCanarySecretsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListCanarySecretsIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapWrapSecretEndpoint wraps the wrap secret endpoint with the server-side
+// interceptors defined in the design.
+func WrapWrapSecretEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapWrapSecretAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapRequestAccessEndpoint wraps the request access endpoint with the
+// server-side interceptors defined in the design.
+func WrapRequestAccessEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapRequestAccessAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapListAccessRequestsEndpoint wraps the list access requests endpoint with
+// the server-side interceptors defined in the design.
+func WrapListAccessRequestsEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListAccessRequestsAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapApproveAccessRequestEndpoint wraps the approve access request endpoint
+// with the server-side interceptors defined in the design.
+func WrapApproveAccessRequestEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapApproveAccessRequestAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapDenyAccessRequestEndpoint wraps the deny access request endpoint with
+// the server-side interceptors defined in the design.
+func WrapDenyAccessRequestEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDenyAccessRequestAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapListValidationRulesEndpoint wraps the list validation rules endpoint
+// with the server-side interceptors defined in the design.
+func WrapListValidationRulesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListValidationRulesIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapCreateValidationRuleEndpoint wraps the create validation rule endpoint
+// with the server-side interceptors defined in the design.
+func WrapCreateValidationRuleEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapCreateValidationRuleIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapDeleteValidationRuleEndpoint wraps the delete validation rule endpoint
+// with the server-side interceptors defined in the design.
+func WrapDeleteValidationRuleEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDeleteValidationRuleIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapListBreakGlassRulesEndpoint wraps the list break glass rules endpoint
+// with the server-side interceptors defined in the design.
+func WrapListBreakGlassRulesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListBreakGlassRulesIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapCreateBreakGlassRuleEndpoint wraps the create break glass rule endpoint
+// with the server-side interceptors defined in the design.
+func WrapCreateBreakGlassRuleEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapCreateBreakGlassRuleIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapDeleteBreakGlassRuleEndpoint wraps the delete break glass rule endpoint
+// with the server-side interceptors defined in the design.
+func WrapDeleteBreakGlassRuleEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDeleteBreakGlassRuleIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapListBreakGlassAccessesEndpoint wraps the list break glass accesses
+// endpoint with the server-side interceptors defined in the design.
+func WrapListBreakGlassAccessesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListBreakGlassAccessesAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapReviewBreakGlassAccessEndpoint wraps the review break glass access
+// endpoint with the server-side interceptors defined in the design.
+func WrapReviewBreakGlassAccessEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapReviewBreakGlassAccessAuthentified(endpoint, i)
+	return endpoint
+}
+
+// WrapListFolderAccessesEndpoint wraps the list folder accesses endpoint with
+// the server-side interceptors defined in the design.
+func WrapListFolderAccessesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListFolderAccessesIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapGrantFolderAccessEndpoint wraps the grant folder access endpoint with
+// the server-side interceptors defined in the design.
+func WrapGrantFolderAccessEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapGrantFolderAccessIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapRevokeFolderAccessEndpoint wraps the revoke folder access endpoint with
+// the server-side interceptors defined in the design.
+func WrapRevokeFolderAccessEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapRevokeFolderAccessIsAdmin(endpoint, i)
+	return endpoint
+}
+
 // Public accessor methods for Info types
 
 // Service returns the name of the service handling the request.
@@ -90,3 +338,23 @@ func (info *AuthentifiedInfo) CallType() goa.InterceptorCallType {
 func (info *AuthentifiedInfo) RawPayload() any {
 	return info.rawPayload
 }
+
+// Service returns the name of the service handling the request.
+func (info *IsAdminInfo) Service() string {
+	return info.service
+}
+
+// Method returns the name of the method handling the request.
+func (info *IsAdminInfo) Method() string {
+	return info.method
+}
+
+// CallType returns the type of call the interceptor is handling.
+func (info *IsAdminInfo) CallType() goa.InterceptorCallType {
+	return info.callType
+}
+
+// RawPayload returns the raw payload of the request.
+func (info *IsAdminInfo) RawPayload() any {
+	return info.rawPayload
+}
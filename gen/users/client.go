@@ -65,6 +65,7 @@ func (c *Client) ListUsers(ctx context.Context) (res []*User, err error) {
 // DeleteUser calls the "delete user" endpoint of the "users" service.
 // DeleteUser may return the following errors:
 //   - "user_not_found" (type *goa.ServiceError): User not found
+//   - "user_owns_secrets" (type *goa.ServiceError): The user owns secrets and no user to reassign them to was given
 //   - "invalid_parameters" (type *goa.ServiceError): Invalid input
 //   - "internal_error" (type *goa.ServiceError): Internal server error
 //   - "forbidden" (type *goa.ServiceError): Forbidden access
@@ -82,6 +82,9 @@ type CreateUserResult struct {
 type DeleteUserPayload struct {
 	// Username of the user to delete
 	Username string
+	// Username of the user the secrets owned by the deleted user are given to.
+	// Required when the user owns secrets
+	ReassignTo *string
 }
 
 // GetOperatorTokenResult is the result type of the users service get operator
@@ -146,6 +149,11 @@ func MakeUserNotFound(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "user_not_found", false, false, false)
 }
 
+// MakeUserOwnsSecrets builds a goa.ServiceError from an error.
+func MakeUserOwnsSecrets(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "user_owns_secrets", false, false, false)
+}
+
 // MakeForbidden builds a goa.ServiceError from an error.
 func MakeForbidden(err error) *goa.ServiceError {
 	return goa.NewServiceError(err, "forbidden", false, false, false)
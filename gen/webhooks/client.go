@@ -0,0 +1,137 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks client
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package webhooks
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Client is the "webhooks" service client.
+type Client struct {
+	ListWebhooksEndpoint          goa.Endpoint
+	CreateWebhookEndpoint         goa.Endpoint
+	UpdateWebhookEndpoint         goa.Endpoint
+	DeleteWebhookEndpoint         goa.Endpoint
+	ListWebhookDeliveriesEndpoint goa.Endpoint
+	ReplayWebhookDeliveryEndpoint goa.Endpoint
+}
+
+// NewClient initializes a "webhooks" service client given the endpoints.
+func NewClient(listWebhooks, createWebhook, updateWebhook, deleteWebhook, listWebhookDeliveries, replayWebhookDelivery goa.Endpoint) *Client {
+	return &Client{
+		ListWebhooksEndpoint:          listWebhooks,
+		CreateWebhookEndpoint:         createWebhook,
+		UpdateWebhookEndpoint:         updateWebhook,
+		DeleteWebhookEndpoint:         deleteWebhook,
+		ListWebhookDeliveriesEndpoint: listWebhookDeliveries,
+		ReplayWebhookDeliveryEndpoint: replayWebhookDelivery,
+	}
+}
+
+// ListWebhooks calls the "list webhooks" endpoint of the "webhooks" service.
+// ListWebhooks may return the following errors:
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context) (res []*Webhook, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*Webhook), nil
+}
+
+// CreateWebhook calls the "create webhook" endpoint of the "webhooks" service.
+// CreateWebhook may return the following errors:
+//   - "webhook_taken" (type *goa.ServiceError): Webhook name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *WebhookParameters) (res *CreateWebhookResult, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*CreateWebhookResult), nil
+}
+
+// UpdateWebhook calls the "update webhook" endpoint of the "webhooks" service.
+// UpdateWebhook may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): Webhook not found
+//   - "webhook_taken" (type *goa.ServiceError): Webhook name already exists
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) UpdateWebhook(ctx context.Context, p *UpdateWebhookPayload) (res *Webhook, err error) {
+	var ires any
+	ires, err = c.UpdateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Webhook), nil
+}
+
+// DeleteWebhook calls the "delete webhook" endpoint of the "webhooks" service.
+// DeleteWebhook may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): Webhook not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "list webhook deliveries" endpoint of the
+// "webhooks" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): Webhook not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*WebhookDelivery), nil
+}
+
+// ReplayWebhookDelivery calls the "replay webhook delivery" endpoint of the
+// "webhooks" service.
+// ReplayWebhookDelivery may return the following errors:
+//   - "webhook_not_found" (type *goa.ServiceError): Webhook not found
+//   - "webhook_delivery_not_found" (type *goa.ServiceError): Webhook delivery not found
+//   - "invalid_parameters" (type *goa.ServiceError): Invalid input
+//   - "unauthorized" (type *goa.ServiceError): Unauthorized access
+//   - "forbidden" (type *goa.ServiceError): Forbidden access
+//   - "internal_error" (type *goa.ServiceError): Internal server error
+//   - error: internal error
+func (c *Client) ReplayWebhookDelivery(ctx context.Context, p *ReplayWebhookDeliveryPayload) (res *WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.ReplayWebhookDeliveryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDelivery), nil
+}
@@ -0,0 +1,106 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks endpoints
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package webhooks
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Endpoints wraps the "webhooks" service endpoints.
+type Endpoints struct {
+	ListWebhooks          goa.Endpoint
+	CreateWebhook         goa.Endpoint
+	UpdateWebhook         goa.Endpoint
+	DeleteWebhook         goa.Endpoint
+	ListWebhookDeliveries goa.Endpoint
+	ReplayWebhookDelivery goa.Endpoint
+}
+
+// NewEndpoints wraps the methods of the "webhooks" service with endpoints.
+func NewEndpoints(s Service, si ServerInterceptors) *Endpoints {
+	endpoints := &Endpoints{
+		ListWebhooks:          NewListWebhooksEndpoint(s),
+		CreateWebhook:         NewCreateWebhookEndpoint(s),
+		UpdateWebhook:         NewUpdateWebhookEndpoint(s),
+		DeleteWebhook:         NewDeleteWebhookEndpoint(s),
+		ListWebhookDeliveries: NewListWebhookDeliveriesEndpoint(s),
+		ReplayWebhookDelivery: NewReplayWebhookDeliveryEndpoint(s),
+	}
+	endpoints.ListWebhooks = WrapListWebhooksEndpoint(endpoints.ListWebhooks, si)
+	endpoints.CreateWebhook = WrapCreateWebhookEndpoint(endpoints.CreateWebhook, si)
+	endpoints.UpdateWebhook = WrapUpdateWebhookEndpoint(endpoints.UpdateWebhook, si)
+	endpoints.DeleteWebhook = WrapDeleteWebhookEndpoint(endpoints.DeleteWebhook, si)
+	endpoints.ListWebhookDeliveries = WrapListWebhookDeliveriesEndpoint(endpoints.ListWebhookDeliveries, si)
+	endpoints.ReplayWebhookDelivery = WrapReplayWebhookDeliveryEndpoint(endpoints.ReplayWebhookDelivery, si)
+	return endpoints
+}
+
+// Use applies the given middleware to all the "webhooks" service endpoints.
+func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.UpdateWebhook = m(e.UpdateWebhook)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
+	e.ReplayWebhookDelivery = m(e.ReplayWebhookDelivery)
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "list webhooks" of service "webhooks".
+func NewListWebhooksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListWebhooks(ctx)
+	}
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "create webhook" of service "webhooks".
+func NewCreateWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*WebhookParameters)
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewUpdateWebhookEndpoint returns an endpoint function that calls the method
+// "update webhook" of service "webhooks".
+func NewUpdateWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateWebhookPayload)
+		return s.UpdateWebhook(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "delete webhook" of service "webhooks".
+func NewDeleteWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "list webhook deliveries" of service "webhooks".
+func NewListWebhookDeliveriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		return s.ListWebhookDeliveries(ctx, p)
+	}
+}
+
+// NewReplayWebhookDeliveryEndpoint returns an endpoint function that calls the
+// method "replay webhook delivery" of service "webhooks".
+func NewReplayWebhookDeliveryEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ReplayWebhookDeliveryPayload)
+		return s.ReplayWebhookDelivery(ctx, p)
+	}
+}
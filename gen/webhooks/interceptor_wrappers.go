@@ -0,0 +1,94 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// Interceptor wrappers
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package webhooks
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// wrapIsAdminListWebhooks applies the IsAdmin server interceptor to endpoints.
+func wrapListWebhooksIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "ListWebhooks",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminCreateWebhook applies the IsAdmin server interceptor to endpoints.
+func wrapCreateWebhookIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "CreateWebhook",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminUpdateWebhook applies the IsAdmin server interceptor to endpoints.
+func wrapUpdateWebhookIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "UpdateWebhook",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminDeleteWebhook applies the IsAdmin server interceptor to endpoints.
+func wrapDeleteWebhookIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "DeleteWebhook",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminListWebhookDeliveries applies the IsAdmin server interceptor to
+// endpoints.
+func wrapListWebhookDeliveriesIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "ListWebhookDeliveries",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
+
+// wrapIsAdminReplayWebhookDelivery applies the IsAdmin server interceptor to
+// endpoints.
+func wrapReplayWebhookDeliveryIsAdmin(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		info := &IsAdminInfo{
+			service:    "webhooks",
+			method:     "ReplayWebhookDelivery",
+			callType:   goa.InterceptorUnary,
+			rawPayload: req,
+		}
+		return i.IsAdmin(ctx, info, endpoint)
+	}
+}
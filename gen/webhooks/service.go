@@ -0,0 +1,217 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooks service
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package webhooks
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// Webhooks service manages the endpoints notified of the events of the server
+type Service interface {
+	// List all webhooks
+	ListWebhooks(context.Context) (res []*Webhook, err error)
+	// Register a webhook. The returned secret signs its deliveries and is not
+	// shown again
+	CreateWebhook(context.Context, *WebhookParameters) (res *CreateWebhookResult, err error)
+	// Replace the parameters of a webhook, its secret is kept
+	UpdateWebhook(context.Context, *UpdateWebhookPayload) (res *Webhook, err error)
+	// Delete a webhook along with its deliveries
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// List the latest deliveries of a webhook
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res []*WebhookDelivery, err error)
+	// Deliver the event of a failed delivery again, as a new delivery
+	ReplayWebhookDelivery(context.Context, *ReplayWebhookDeliveryPayload) (res *WebhookDelivery, err error)
+}
+
+// APIName is the name of the API as defined in the design.
+const APIName = "fishykeys"
+
+// APIVersion is the version of the API as defined in the design.
+const APIVersion = "1.0"
+
+// ServiceName is the name of the service as defined in the design. This is the
+// same value that is set in the endpoint request contexts under the ServiceKey
+// key.
+const ServiceName = "webhooks"
+
+// MethodNames lists the service method names as defined in the design. These
+// are the same values that are set in the endpoint request contexts under the
+// MethodKey key.
+var MethodNames = [6]string{"list webhooks", "create webhook", "update webhook", "delete webhook", "list webhook deliveries", "replay webhook delivery"}
+
+// CreateWebhookResult is the result type of the webhooks service create
+// webhook method.
+type CreateWebhookResult struct {
+	// Secret signing the deliveries with HMAC-SHA256
+	Secret string
+	// Unique identifier for the webhook
+	ID int
+	// Webhook creation timestamp
+	CreatedAt string
+	// Webhook last update timestamp
+	UpdatedAt string
+	// Name of the webhook
+	Name string
+	// HTTP or HTTPS URL the events are posted to
+	URL string
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool
+}
+
+// DeleteWebhookPayload is the payload type of the webhooks service delete
+// webhook method.
+type DeleteWebhookPayload struct {
+	// ID of the webhook
+	ID int
+}
+
+// ListWebhookDeliveriesPayload is the payload type of the webhooks service
+// list webhook deliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// ID of the webhook
+	ID int
+	// Only list the deliveries with this status
+	Status *string
+	// Maximum number of deliveries returned
+	Limit int
+}
+
+// ReplayWebhookDeliveryPayload is the payload type of the webhooks service
+// replay webhook delivery method.
+type ReplayWebhookDeliveryPayload struct {
+	// ID of the webhook
+	ID int
+	// ID of the failed delivery
+	DeliveryID int
+}
+
+// UpdateWebhookPayload is the payload type of the webhooks service update
+// webhook method.
+type UpdateWebhookPayload struct {
+	// ID of the webhook
+	ID int
+	// Name of the webhook
+	Name string
+	// HTTP or HTTPS URL the events are posted to
+	URL string
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool
+}
+
+// Webhook is the result type of the webhooks service update webhook method.
+type Webhook struct {
+	// Unique identifier for the webhook
+	ID int
+	// Webhook creation timestamp
+	CreatedAt string
+	// Webhook last update timestamp
+	UpdatedAt string
+	// Name of the webhook
+	Name string
+	// HTTP or HTTPS URL the events are posted to
+	URL string
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool
+}
+
+// WebhookDelivery is the result type of the webhooks service replay webhook
+// delivery method.
+type WebhookDelivery struct {
+	// Unique identifier for the delivery
+	ID int
+	// Identifier of the delivered event, shared by its deliveries to every webhook
+	EventID string
+	// Type of the delivered event
+	EventType string
+	// JSON body posted to the webhook
+	Payload string
+	// Status of the delivery
+	Status string
+	// Number of attempts made
+	Attempts int
+	// Time of the next attempt of a pending delivery
+	NextAttemptAt *string
+	// Time of the last attempt
+	LastAttemptAt *string
+	// HTTP status returned by the webhook on the last attempt
+	ResponseStatus *int
+	// Why the last attempt failed
+	Error *string
+	// ID of the delivery this one replays
+	ReplayOf *int
+	// Delivery creation timestamp
+	CreatedAt string
+}
+
+// WebhookParameters is the payload type of the webhooks service create webhook
+// method.
+type WebhookParameters struct {
+	// Name of the webhook
+	Name string
+	// HTTP or HTTPS URL the events are posted to
+	URL string
+	// Event types delivered, a category such as 'secret.*', or '*' for every event
+	Events []string
+	// Only deliver the events about a path under this prefix
+	PathPrefix *string
+	// Whether events are delivered. Disabled webhooks get no new deliveries and
+	// their pending ones wait
+	Enabled bool
+}
+
+// MakeInvalidParameters builds a goa.ServiceError from an error.
+func MakeInvalidParameters(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "invalid_parameters", false, false, false)
+}
+
+// MakeUnauthorized builds a goa.ServiceError from an error.
+func MakeUnauthorized(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "unauthorized", false, false, false)
+}
+
+// MakeForbidden builds a goa.ServiceError from an error.
+func MakeForbidden(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "forbidden", false, false, false)
+}
+
+// MakeInternalError builds a goa.ServiceError from an error.
+func MakeInternalError(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "internal_error", false, false, false)
+}
+
+// MakeWebhookTaken builds a goa.ServiceError from an error.
+func MakeWebhookTaken(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "webhook_taken", false, false, false)
+}
+
+// MakeWebhookNotFound builds a goa.ServiceError from an error.
+func MakeWebhookNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "webhook_not_found", false, false, false)
+}
+
+// MakeWebhookDeliveryNotFound builds a goa.ServiceError from an error.
+func MakeWebhookDeliveryNotFound(err error) *goa.ServiceError {
+	return goa.NewServiceError(err, "webhook_delivery_not_found", false, false, false)
+}
@@ -0,0 +1,99 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// webhooksServer Interceptors
+//
+// Command:
+// $ goa gen github.com/Vidalee/FishyKeys/design
+
+package webhooks
+
+import (
+	"context"
+
+	goa "goa.design/goa/v3/pkg"
+)
+
+// ServerInterceptors defines the interface for all server-side interceptors.
+// Server interceptors execute after the request is decoded and before the
+// payload is sent to the service. The implementation is responsible for calling
+// next to complete the request.
+type ServerInterceptors interface {
+	// Server-side interceptor that checks if the user has admin privileges
+	IsAdmin(ctx context.Context, info *IsAdminInfo, next goa.Endpoint) (any, error)
+}
+
+// Access interfaces for interceptor payloads and results
+type (
+	// IsAdminInfo provides metadata about the current interception.
+	// It includes service name, method name, and access to the endpoint.
+	IsAdminInfo struct {
+		service    string
+		method     string
+		callType   goa.InterceptorCallType
+		rawPayload any
+	}
+)
+
+// WrapListWebhooksEndpoint wraps the list webhooks endpoint with the
+// server-side interceptors defined in the design.
+func WrapListWebhooksEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListWebhooksIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapCreateWebhookEndpoint wraps the create webhook endpoint with the
+// server-side interceptors defined in the design.
+func WrapCreateWebhookEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapCreateWebhookIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapUpdateWebhookEndpoint wraps the update webhook endpoint with the
+// server-side interceptors defined in the design.
+func WrapUpdateWebhookEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapUpdateWebhookIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapDeleteWebhookEndpoint wraps the delete webhook endpoint with the
+// server-side interceptors defined in the design.
+func WrapDeleteWebhookEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapDeleteWebhookIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapListWebhookDeliveriesEndpoint wraps the list webhook deliveries endpoint
+// with the server-side interceptors defined in the design.
+func WrapListWebhookDeliveriesEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapListWebhookDeliveriesIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// WrapReplayWebhookDeliveryEndpoint wraps the replay webhook delivery endpoint
+// with the server-side interceptors defined in the design.
+func WrapReplayWebhookDeliveryEndpoint(endpoint goa.Endpoint, i ServerInterceptors) goa.Endpoint {
+	endpoint = wrapReplayWebhookDeliveryIsAdmin(endpoint, i)
+	return endpoint
+}
+
+// Public accessor methods for Info types
+
+// Service returns the name of the service handling the request.
+func (info *IsAdminInfo) Service() string {
+	return info.service
+}
+
+// Method returns the name of the method handling the request.
+func (info *IsAdminInfo) Method() string {
+	return info.method
+}
+
+// CallType returns the type of call the interceptor is handling.
+func (info *IsAdminInfo) CallType() goa.InterceptorCallType {
+	return info.callType
+}
+
+// RawPayload returns the raw payload of the request.
+func (info *IsAdminInfo) RawPayload() any {
+	return info.rawPayload
+}
@@ -0,0 +1,199 @@
+// Package backup reads and writes the encrypted archives the database is backed up to.
+//
+// An archive is laid out as:
+//
+//	magic       8 bytes, "FKBACKUP"
+//	version     1 byte, FormatVersion
+//	key kind    1 byte, MasterKey or BackupKey
+//	salt        16 bytes
+//	nonce       12 bytes
+//	ciphertext  AES-256-GCM of the gzipped JSON Archive, authenticated along with the header
+//
+// The encryption key is derived from the master key with HKDF-SHA256, or from the backup key with scrypt. Secret
+// values keep the encryption of the database, so restoring an archive still requires the master key to read them.
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// FormatVersion is the version of the archives written, the only one read
+const FormatVersion = 1
+
+// KeyKind tells which key an archive is encrypted under
+type KeyKind byte
+
+const (
+	MasterKey KeyKind = 1
+	BackupKey KeyKind = 2
+)
+
+func (k KeyKind) String() string {
+	switch k {
+	case MasterKey:
+		return "master key"
+	case BackupKey:
+		return "backup key"
+	default:
+		return fmt.Sprintf("unknown key kind %d", k)
+	}
+}
+
+var (
+	ErrInvalidArchive = errors.New("invalid backup archive")
+	ErrWrongKey       = errors.New("wrong key or corrupted backup archive")
+)
+
+var magic = []byte("FKBACKUP")
+
+const (
+	saltSize   = 16
+	nonceSize  = 12
+	headerSize = 8 + 1 + 1 + saltSize + nonceSize
+)
+
+// Archive is the content of a backup
+type Archive struct {
+	// SchemaVersion is the version of the database migrations the rows were dumped with
+	SchemaVersion uint      `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Tables        []Table   `json:"tables"`
+}
+
+// Table holds the rows of a table as JSON objects keyed by column name
+type Table struct {
+	Name string            `json:"name"`
+	Rows []json.RawMessage `json:"rows"`
+}
+
+// RowCount returns the number of rows of the table in the archive
+func (a *Archive) RowCount(name string) int {
+	for _, table := range a.Tables {
+		if table.Name == name {
+			return len(table.Rows)
+		}
+	}
+	return 0
+}
+
+// Seal encrypts the archive under the master key or the backup key
+func Seal(archive *Archive, kind KeyKind, key []byte) ([]byte, error) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	if err := json.NewEncoder(writer).Encode(archive); err != nil {
+		return nil, fmt.Errorf("error encoding archive: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing archive: %w", err)
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, magic)
+	header[8] = FormatVersion
+	header[9] = byte(kind)
+	if _, err := io.ReadFull(rand.Reader, header[10:]); err != nil {
+		return nil, fmt.Errorf("error generating salt and nonce: %w", err)
+	}
+	aead, err := newAEAD(kind, key, header[10:10+saltSize])
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(header, header[10+saltSize:], compressed.Bytes(), header), nil
+}
+
+// ReadKeyKind returns the key the archive is encrypted under, so that the right one can be asked for
+func ReadKeyKind(data []byte) (KeyKind, error) {
+	if len(data) < headerSize || !bytes.Equal(data[:8], magic) {
+		return 0, fmt.Errorf("%w: not a FishyKeys backup", ErrInvalidArchive)
+	}
+	if data[8] != FormatVersion {
+		return 0, fmt.Errorf("%w: unsupported format version %d", ErrInvalidArchive, data[8])
+	}
+	kind := KeyKind(data[9])
+	if kind != MasterKey && kind != BackupKey {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidArchive, kind)
+	}
+	return kind, nil
+}
+
+// Open decrypts and decodes the archive, checking it was not altered
+func Open(data []byte, key []byte) (*Archive, error) {
+	kind, err := ReadKeyKind(data)
+	if err != nil {
+		return nil, err
+	}
+	header := data[:headerSize]
+	aead, err := newAEAD(kind, key, header[10:10+saltSize])
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := aead.Open(nil, header[10+saltSize:], data[headerSize:], header)
+	if err != nil {
+		return nil, ErrWrongKey
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	var archive Archive
+	if err := decoder.Decode(&archive); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArchive, err)
+	}
+
+	seen := make(map[string]bool, len(archive.Tables))
+	for _, table := range archive.Tables {
+		if table.Name == "" || seen[table.Name] {
+			return nil, fmt.Errorf("%w: missing or duplicate table name %q", ErrInvalidArchive, table.Name)
+		}
+		seen[table.Name] = true
+		for _, row := range table.Rows {
+			if len(row) == 0 || row[0] != '{' {
+				return nil, fmt.Errorf("%w: a row of table %s is not an object", ErrInvalidArchive, table.Name)
+			}
+		}
+	}
+	return &archive, nil
+}
+
+// newAEAD derives the encryption key of an archive
+func newAEAD(kind KeyKind, key []byte, salt []byte) (cipher.AEAD, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("the %s must not be empty", kind)
+	}
+
+	var derived []byte
+	var err error
+	switch kind {
+	case MasterKey:
+		derived, err = hkdf.Key(sha256.New, key, salt, "fishykeys backup", 32)
+	case BackupKey:
+		derived, err = scrypt.Key(key, salt, 1<<15, 8, 1, 32)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error deriving the archive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,111 @@
+package backup
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testArchive() *Archive {
+	return &Archive{
+		SchemaVersion: 21,
+		CreatedAt:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+		Tables: []Table{
+			{Name: "roles", Rows: []json.RawMessage{json.RawMessage(`{"id":1,"name":"admin","admin":true}`)}},
+			{Name: "secrets", Rows: []json.RawMessage{}},
+		},
+	}
+}
+
+func TestSealOpen(t *testing.T) {
+	masterKey := make([]byte, 32)
+	for i := range masterKey {
+		masterKey[i] = byte(i)
+	}
+
+	tests := []struct {
+		name string
+		kind KeyKind
+		key  []byte
+	}{
+		{name: "master key", kind: MasterKey, key: masterKey},
+		{name: "backup key", kind: BackupKey, key: []byte("correct horse battery staple")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sealed, err := Seal(testArchive(), tt.kind, tt.key)
+			require.NoError(t, err)
+
+			kind, err := ReadKeyKind(sealed)
+			require.NoError(t, err)
+			assert.Equal(t, tt.kind, kind)
+
+			archive, err := Open(sealed, tt.key)
+			require.NoError(t, err)
+			assert.Equal(t, testArchive(), archive)
+			assert.Equal(t, 1, archive.RowCount("roles"))
+			assert.Equal(t, 0, archive.RowCount("users"))
+
+			_, err = Open(sealed, []byte("another key"))
+			assert.ErrorIs(t, err, ErrWrongKey)
+		})
+	}
+}
+
+func TestOpenAlteredArchive(t *testing.T) {
+	key := []byte("correct horse battery staple")
+	sealed, err := Seal(testArchive(), BackupKey, key)
+	require.NoError(t, err)
+
+	t.Run("altered ciphertext", func(t *testing.T) {
+		altered := append([]byte(nil), sealed...)
+		altered[len(altered)-1] ^= 1
+		_, err := Open(altered, key)
+		assert.ErrorIs(t, err, ErrWrongKey)
+	})
+
+	t.Run("altered key kind", func(t *testing.T) {
+		altered := append([]byte(nil), sealed...)
+		altered[9] = byte(MasterKey)
+		_, err := Open(altered, key)
+		assert.ErrorIs(t, err, ErrWrongKey)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		altered := append([]byte(nil), sealed...)
+		altered[8] = FormatVersion + 1
+		_, err := Open(altered, key)
+		require.ErrorIs(t, err, ErrInvalidArchive)
+		assert.Equal(t, "invalid backup archive: unsupported format version 2", err.Error())
+	})
+
+	t.Run("not a backup", func(t *testing.T) {
+		_, err := Open([]byte("PGDMP"), key)
+		require.ErrorIs(t, err, ErrInvalidArchive)
+		assert.Equal(t, "invalid backup archive: not a FishyKeys backup", err.Error())
+	})
+}
+
+func TestOpenInvalidTables(t *testing.T) {
+	key := []byte("correct horse battery staple")
+
+	archive := testArchive()
+	archive.Tables = append(archive.Tables, Table{Name: "roles"})
+	sealed, err := Seal(archive, BackupKey, key)
+	require.NoError(t, err)
+	_, err = Open(sealed, key)
+	require.ErrorIs(t, err, ErrInvalidArchive)
+	assert.Equal(t, `invalid backup archive: missing or duplicate table name "roles"`, err.Error())
+
+	archive = testArchive()
+	archive.Tables[1].Rows = []json.RawMessage{json.RawMessage(`[1]`)}
+	sealed, err = Seal(archive, BackupKey, key)
+	require.NoError(t, err)
+	_, err = Open(sealed, key)
+	require.ErrorIs(t, err, ErrInvalidArchive)
+	assert.Equal(t, "invalid backup archive: a row of table secrets is not an object", err.Error())
+}
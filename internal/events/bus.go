@@ -0,0 +1,126 @@
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Types of the events published by the services
+const (
+	SecretCreated            = "secret.created"
+	SecretUpdated            = "secret.updated"
+	SecretAccessChanged      = "secret.access_changed"
+	SecretRotated            = "secret.rotated"
+	SecretMoved              = "secret.moved"
+	SecretDeleted            = "secret.deleted"
+	SecretBreakGlass         = "secret.break_glass"
+	SecretBreakGlassReviewed = "secret.break_glass_reviewed"
+	SecretCanaryAccessed     = "secret.canary_accessed"
+	FolderAccessGranted      = "folder.access_granted"
+	FolderAccessRevoked      = "folder.access_revoked"
+	AccessRequestCreated     = "access_request.created"
+	AccessRequestApproved    = "access_request.approved"
+	AccessRequestDenied      = "access_request.denied"
+	UserCreated              = "user.created"
+	UserDeleted              = "user.deleted"
+	RoleCreated              = "role.created"
+	RoleDeleted              = "role.deleted"
+	RoleAssigned             = "role.assigned"
+	RoleUnassigned           = "role.unassigned"
+	MasterKeyCreated         = "key.created"
+	MasterKeyUnlocked        = "key.unlocked"
+	BackupCreated            = "backup.created"
+)
+
+var Types = []string{
+	SecretCreated, SecretUpdated, SecretAccessChanged, SecretRotated, SecretMoved, SecretDeleted,
+	SecretBreakGlass, SecretBreakGlassReviewed, SecretCanaryAccessed,
+	FolderAccessGranted, FolderAccessRevoked,
+	AccessRequestCreated, AccessRequestApproved, AccessRequestDenied,
+	UserCreated, UserDeleted,
+	RoleCreated, RoleDeleted, RoleAssigned, RoleUnassigned,
+	MasterKeyCreated, MasterKeyUnlocked,
+	BackupCreated,
+}
+
+// Event is something that happened on the server. Data never holds secret values.
+type Event struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Actor      string         `json:"actor,omitempty"`
+	Data       map[string]any `json:"data"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+type Handler func(ctx context.Context, event Event)
+
+// Bus dispatches the published events to its subscribers, synchronously and in the order they subscribed
+type Bus struct {
+	mu       sync.RWMutex
+	handlers []Handler
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+func (b *Bus) Subscribe(handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+// Publish builds the event and hands it to every subscriber. The actor is the username of the user who caused the
+// event, empty when the server did on its own. Handlers keep running if the request that caused the event is
+// cancelled.
+func (b *Bus) Publish(ctx context.Context, eventType string, actor string, data map[string]any) {
+	event := Event{
+		ID:         newEventID(),
+		Type:       eventType,
+		Actor:      actor,
+		Data:       data,
+		OccurredAt: time.Now().UTC(),
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	ctx = context.WithoutCancel(ctx)
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+}
+
+func newEventID() string {
+	id := make([]byte, 16)
+	// crypto/rand never fails on supported platforms
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// Matches tells whether the event type matches the filter, which is either an event type, a category such as
+// "secret.*" or "*" for every event
+func Matches(filter string, eventType string) bool {
+	if filter == "*" {
+		return true
+	}
+	if category, ok := strings.CutSuffix(filter, ".*"); ok {
+		return strings.HasPrefix(eventType, category+".")
+	}
+	return filter == eventType
+}
+
+// ValidFilter tells whether the filter matches at least one event type
+func ValidFilter(filter string) bool {
+	for _, eventType := range Types {
+		if Matches(filter, eventType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBus_Publish(t *testing.T) {
+	bus := NewBus()
+	var received []Event
+	bus.Subscribe(func(ctx context.Context, event Event) {
+		assert.NoError(t, ctx.Err())
+		received = append(received, event)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bus.Publish(ctx, SecretCreated, "alice", map[string]any{"path": "/app/key"})
+	bus.Publish(ctx, UserDeleted, "", map[string]any{"username": "bob"})
+
+	require.Len(t, received, 2)
+	assert.Equal(t, SecretCreated, received[0].Type)
+	assert.Equal(t, "alice", received[0].Actor)
+	assert.Equal(t, "/app/key", received[0].Data["path"])
+	assert.Len(t, received[0].ID, 32)
+	assert.NotEqual(t, received[0].ID, received[1].ID)
+	assert.False(t, received[0].OccurredAt.IsZero())
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		filter    string
+		eventType string
+		expected  bool
+	}{
+		{"*", SecretCreated, true},
+		{"secret.*", SecretCreated, true},
+		{"secret.*", SecretAccessChanged, true},
+		{"secret.*", RoleCreated, false},
+		{"secrets.*", SecretCreated, false},
+		{SecretCreated, SecretCreated, true},
+		{SecretCreated, SecretUpdated, false},
+		{"secret", SecretCreated, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter+" "+tt.eventType, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Matches(tt.filter, tt.eventType))
+		})
+	}
+}
+
+func TestValidFilter(t *testing.T) {
+	assert.True(t, ValidFilter("*"))
+	assert.True(t, ValidFilter("key.*"))
+	assert.True(t, ValidFilter(RoleAssigned))
+	assert.False(t, ValidFilter("secret.read"))
+	assert.False(t, ValidFilter("vault.*"))
+}
@@ -0,0 +1,258 @@
+// Package generator generates secret values from generation policies.
+//
+// A policy has a kind and the parameters of that kind:
+//
+//	password    length, lowercase, uppercase, digits, symbols
+//	passphrase  words, separator
+//	bytes       length, encoding (hex or base64)
+//	uuid
+//	rsa         bits (2048, 3072 or 4096)
+//	ed25519
+//
+// Key pairs are generated as PEM encoded private_key and public_key fields, every other kind as a single value.
+package generator
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	_ "embed"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+const (
+	KindPassword   = "password"
+	KindPassphrase = "passphrase"
+	KindBytes      = "bytes"
+	KindUUID       = "uuid"
+	KindRSA        = "rsa"
+	KindEd25519    = "ed25519"
+
+	EncodingHex    = "hex"
+	EncodingBase64 = "base64"
+
+	FieldPrivateKey = "private_key"
+	FieldPublicKey  = "public_key"
+)
+
+var Kinds = []string{KindPassword, KindPassphrase, KindBytes, KindUUID, KindRSA, KindEd25519}
+
+var ErrInvalidSpec = errors.New("invalid generation policy")
+
+const (
+	lowercaseChars = "abcdefghijklmnopqrstuvwxyz"
+	uppercaseChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars     = "0123456789"
+	symbolChars    = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+)
+
+//go:embed wordlist.txt
+var wordlistFile string
+
+var wordlist = strings.Fields(wordlistFile)
+
+// Spec holds the parameters of a generation policy, only the ones of its kind are used
+type Spec struct {
+	Kind      string `json:"-"`
+	Length    int    `json:"length,omitempty"`
+	Lowercase bool   `json:"lowercase,omitempty"`
+	Uppercase bool   `json:"uppercase,omitempty"`
+	Digits    bool   `json:"digits,omitempty"`
+	Symbols   bool   `json:"symbols,omitempty"`
+	Words     int    `json:"words,omitempty"`
+	Separator string `json:"separator,omitempty"`
+	Encoding  string `json:"encoding,omitempty"`
+	Bits      int    `json:"bits,omitempty"`
+}
+
+// Generated is either a single value or the fields of a key pair
+type Generated struct {
+	Value  string
+	Fields map[string]string
+}
+
+// Validate checks the parameters of the spec are consistent with its kind
+func (s Spec) Validate() error {
+	switch s.Kind {
+	case KindPassword:
+		if s.Length < 8 || s.Length > 1024 {
+			return fmt.Errorf("%w: password length must be between 8 and 1024", ErrInvalidSpec)
+		}
+		classes := s.classes()
+		if len(classes) == 0 {
+			return fmt.Errorf("%w: password needs at least one character class", ErrInvalidSpec)
+		}
+		if s.Length < len(classes) {
+			return fmt.Errorf("%w: password length is shorter than its number of character classes", ErrInvalidSpec)
+		}
+	case KindPassphrase:
+		if s.Words < 3 || s.Words > 64 {
+			return fmt.Errorf("%w: passphrase must have between 3 and 64 words", ErrInvalidSpec)
+		}
+	case KindBytes:
+		if s.Length < 1 || s.Length > 8192 {
+			return fmt.Errorf("%w: bytes length must be between 1 and 8192", ErrInvalidSpec)
+		}
+		if s.Encoding != EncodingHex && s.Encoding != EncodingBase64 {
+			return fmt.Errorf("%w: bytes encoding must be %s or %s", ErrInvalidSpec, EncodingHex, EncodingBase64)
+		}
+	case KindRSA:
+		if !slices.Contains([]int{2048, 3072, 4096}, s.Bits) {
+			return fmt.Errorf("%w: rsa bits must be 2048, 3072 or 4096", ErrInvalidSpec)
+		}
+	case KindUUID, KindEd25519:
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidSpec, s.Kind)
+	}
+	return nil
+}
+
+func (s Spec) classes() []string {
+	var classes []string
+	if s.Lowercase {
+		classes = append(classes, lowercaseChars)
+	}
+	if s.Uppercase {
+		classes = append(classes, uppercaseChars)
+	}
+	if s.Digits {
+		classes = append(classes, digitChars)
+	}
+	if s.Symbols {
+		classes = append(classes, symbolChars)
+	}
+	return classes
+}
+
+// Generate generates a new value from the spec
+func Generate(spec Spec) (*Generated, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch spec.Kind {
+	case KindPassword:
+		value, err := generatePassword(spec.Length, spec.classes())
+		return &Generated{Value: value}, err
+	case KindPassphrase:
+		value, err := generatePassphrase(spec.Words, spec.Separator)
+		return &Generated{Value: value}, err
+	case KindBytes:
+		value, err := generateBytes(spec.Length, spec.Encoding)
+		return &Generated{Value: value}, err
+	case KindUUID:
+		value, err := generateUUID()
+		return &Generated{Value: value}, err
+	case KindRSA:
+		privateKey, err := rsa.GenerateKey(rand.Reader, spec.Bits)
+		if err != nil {
+			return nil, err
+		}
+		return encodeKeyPair(privateKey, &privateKey.PublicKey)
+	default:
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return encodeKeyPair(privateKey, publicKey)
+	}
+}
+
+// randomIndex returns a uniformly distributed index in [0, n)
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(index.Int64()), nil
+}
+
+// generatePassword picks a character of every class first so that each class is represented, then fills the
+// password from all classes and shuffles it
+func generatePassword(length int, classes []string) (string, error) {
+	all := strings.Join(classes, "")
+	password := make([]byte, 0, length)
+	for _, class := range classes {
+		i, err := randomIndex(len(class))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, class[i])
+	}
+	for len(password) < length {
+		i, err := randomIndex(len(all))
+		if err != nil {
+			return "", err
+		}
+		password = append(password, all[i])
+	}
+
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+func generatePassphrase(words int, separator string) (string, error) {
+	if separator == "" {
+		separator = "-"
+	}
+	picked := make([]string, words)
+	for i := range picked {
+		j, err := randomIndex(len(wordlist))
+		if err != nil {
+			return "", err
+		}
+		picked[i] = wordlist[j]
+	}
+	return strings.Join(picked, separator), nil
+}
+
+func generateBytes(length int, encoding string) (string, error) {
+	data := make([]byte, length)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	if encoding == EncodingHex {
+		return hex.EncodeToString(data), nil
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// generateUUID generates a random version 4 UUID
+func generateUUID() (string, error) {
+	data := make([]byte, 16)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	data[6] = (data[6] & 0x0f) | 0x40
+	data[8] = (data[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", data[0:4], data[4:6], data[6:8], data[8:10], data[10:16]), nil
+}
+
+func encodeKeyPair(privateKey any, publicKey any) (*Generated, error) {
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Generated{Fields: map[string]string{
+		FieldPrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		FieldPublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+	}}, nil
+}
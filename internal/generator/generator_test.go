@@ -0,0 +1,168 @@
+package generator
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name              string
+		spec              Spec
+		expectedErrorText string
+	}{
+		{name: "password", spec: Spec{Kind: KindPassword, Length: 16, Lowercase: true}},
+		{name: "passphrase", spec: Spec{Kind: KindPassphrase, Words: 6}},
+		{name: "bytes", spec: Spec{Kind: KindBytes, Length: 32, Encoding: EncodingHex}},
+		{name: "uuid", spec: Spec{Kind: KindUUID}},
+		{name: "rsa", spec: Spec{Kind: KindRSA, Bits: 2048}},
+		{name: "ed25519", spec: Spec{Kind: KindEd25519}},
+		{
+			name:              "short password",
+			spec:              Spec{Kind: KindPassword, Length: 4, Lowercase: true},
+			expectedErrorText: "invalid generation policy: password length must be between 8 and 1024",
+		},
+		{
+			name:              "password without character class",
+			spec:              Spec{Kind: KindPassword, Length: 16},
+			expectedErrorText: "invalid generation policy: password needs at least one character class",
+		},
+		{
+			name:              "passphrase with too few words",
+			spec:              Spec{Kind: KindPassphrase, Words: 2},
+			expectedErrorText: "invalid generation policy: passphrase must have between 3 and 64 words",
+		},
+		{
+			name:              "bytes without encoding",
+			spec:              Spec{Kind: KindBytes, Length: 32},
+			expectedErrorText: "invalid generation policy: bytes encoding must be hex or base64",
+		},
+		{
+			name:              "rsa with weak key",
+			spec:              Spec{Kind: KindRSA, Bits: 1024},
+			expectedErrorText: "invalid generation policy: rsa bits must be 2048, 3072 or 4096",
+		},
+		{
+			name:              "unknown kind",
+			spec:              Spec{Kind: "pin"},
+			expectedErrorText: `invalid generation policy: unknown kind "pin"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.expectedErrorText == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidSpec)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Run("password uses every character class", func(t *testing.T) {
+		spec := Spec{Kind: KindPassword, Length: 12, Lowercase: true, Uppercase: true, Digits: true, Symbols: true}
+		for range 50 {
+			generated, err := Generate(spec)
+			require.NoError(t, err)
+			assert.Len(t, generated.Value, 12)
+			assert.True(t, strings.ContainsAny(generated.Value, lowercaseChars))
+			assert.True(t, strings.ContainsAny(generated.Value, uppercaseChars))
+			assert.True(t, strings.ContainsAny(generated.Value, digitChars))
+			assert.True(t, strings.ContainsAny(generated.Value, symbolChars))
+		}
+	})
+
+	t.Run("password only uses the enabled classes", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindPassword, Length: 64, Digits: true})
+		require.NoError(t, err)
+		assert.Regexp(t, `^[0-9]{64}$`, generated.Value)
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindPassphrase, Words: 5, Separator: "."})
+		require.NoError(t, err)
+		words := strings.Split(generated.Value, ".")
+		require.Len(t, words, 5)
+		for _, word := range words {
+			assert.Contains(t, wordlist, word)
+		}
+
+		generated, err = Generate(Spec{Kind: KindPassphrase, Words: 3})
+		require.NoError(t, err)
+		assert.Len(t, strings.Split(generated.Value, "-"), 3)
+	})
+
+	t.Run("bytes", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindBytes, Length: 32, Encoding: EncodingHex})
+		require.NoError(t, err)
+		decoded, err := hex.DecodeString(generated.Value)
+		require.NoError(t, err)
+		assert.Len(t, decoded, 32)
+
+		generated, err = Generate(Spec{Kind: KindBytes, Length: 32, Encoding: EncodingBase64})
+		require.NoError(t, err)
+		decoded, err = base64.StdEncoding.DecodeString(generated.Value)
+		require.NoError(t, err)
+		assert.Len(t, decoded, 32)
+	})
+
+	t.Run("uuid", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindUUID})
+		require.NoError(t, err)
+		assert.Regexp(t, regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`), generated.Value)
+	})
+
+	t.Run("rsa key pair", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindRSA, Bits: 2048})
+		require.NoError(t, err)
+		assert.Empty(t, generated.Value)
+
+		privateKey := parsePrivateKey(t, generated.Fields[FieldPrivateKey])
+		rsaKey, ok := privateKey.(*rsa.PrivateKey)
+		require.True(t, ok)
+		assert.Equal(t, 2048, rsaKey.N.BitLen())
+		assert.Equal(t, &rsaKey.PublicKey, parsePublicKey(t, generated.Fields[FieldPublicKey]))
+	})
+
+	t.Run("ed25519 key pair", func(t *testing.T) {
+		generated, err := Generate(Spec{Kind: KindEd25519})
+		require.NoError(t, err)
+
+		privateKey := parsePrivateKey(t, generated.Fields[FieldPrivateKey])
+		edKey, ok := privateKey.(ed25519.PrivateKey)
+		require.True(t, ok)
+		assert.Equal(t, edKey.Public(), parsePublicKey(t, generated.Fields[FieldPublicKey]))
+	})
+}
+
+func parsePrivateKey(t *testing.T, encoded string) any {
+	block, _ := pem.Decode([]byte(encoded))
+	require.NotNil(t, block)
+	assert.Equal(t, "PRIVATE KEY", block.Type)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	return key
+}
+
+func parsePublicKey(t *testing.T, encoded string) any {
+	block, _ := pem.Decode([]byte(encoded))
+	require.NotNil(t, block)
+	assert.Equal(t, "PUBLIC KEY", block.Type)
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+	return key
+}
@@ -17,18 +17,63 @@ import (
 
 // RunMigrations runs all pending migrations
 func RunMigrations(pool *pgxpool.Pool) error {
+	m, db, err := newMigrate(pool)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	_, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return fmt.Errorf("could not check migration version: %w", err)
+	}
+
+	if errors.Is(err, migrate.ErrNilVersion) || dirty {
+		log.Println("Detected migrations to be applied")
+	}
+
+	if err := m.Up(); err != nil {
+		if errors.Is(err, migrate.ErrNoChange) {
+			return nil
+		}
+		return fmt.Errorf("could not run migrations: %w", err)
+	}
+
+	log.Println("Database migrations completed successfully")
+	return nil
+}
+
+// MigrateTo migrates the database up or down to the version, which a backup is restored at
+func MigrateTo(pool *pgxpool.Pool, version uint) error {
+	m, db, err := newMigrate(pool)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err := m.Migrate(version); err != nil {
+		if errors.Is(err, migrate.ErrNoChange) {
+			return nil
+		}
+		return fmt.Errorf("could not migrate to version %d: %w", version, err)
+	}
+	return nil
+}
+
+// newMigrate returns a migration instance for the database, along with the connection to close once done
+func newMigrate(pool *pgxpool.Pool) (*migrate.Migrate, *sql.DB, error) {
 	config := pool.Config()
 	connString := config.ConnString()
 
 	db, err := sql.Open("pgx", connString)
 	if err != nil {
-		return fmt.Errorf("could not create sql.DB: %w", err)
+		return nil, nil, fmt.Errorf("could not create sql.DB: %w", err)
 	}
-	defer db.Close()
 
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		return fmt.Errorf("could not create migration driver: %w", err)
+		db.Close()
+		return nil, nil, fmt.Errorf("could not create migration driver: %w", err)
 	}
 
 	// Not very cool hotfix for not having to change the working directory
@@ -46,25 +91,8 @@ func RunMigrations(pool *pgxpool.Pool) error {
 		driver,
 	)
 	if err != nil {
-		return fmt.Errorf("could not create migration instance: %w", err)
+		db.Close()
+		return nil, nil, fmt.Errorf("could not create migration instance: %w", err)
 	}
-
-	_, dirty, err := m.Version()
-	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
-		return fmt.Errorf("could not check migration version: %w", err)
-	}
-
-	if errors.Is(err, migrate.ErrNilVersion) || dirty {
-		log.Println("Detected migrations to be applied")
-	}
-
-	if err := m.Up(); err != nil {
-		if errors.Is(err, migrate.ErrNoChange) {
-			return nil
-		}
-		return fmt.Errorf("could not run migrations: %w", err)
-	}
-
-	log.Println("Database migrations completed successfully")
-	return nil
+	return m, db, nil
 }
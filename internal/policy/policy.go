@@ -0,0 +1,168 @@
+// Package policy parses policy documents, which allow or deny capabilities on the secrets matching path globs.
+//
+// A policy document is written in HCL or YAML, in the style of Vault policies:
+//
+//	path "/apps/*/db/*" {
+//	  capabilities = ["read", "list"]
+//	}
+//
+//	path "/apps/prod/**" {
+//	  deny = ["update", "delete"]
+//	}
+//
+// In a path glob, "*" matches any characters within a path segment and "**" matches any characters across segments.
+// Denying the special "deny" capability, or allowing it as in Vault, denies every capability.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatHCL  = "hcl"
+	FormatYAML = "yaml"
+
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+
+	denyAll = "deny"
+)
+
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// Rule allows or denies capabilities on the secrets whose path matches PathGlob
+type Rule struct {
+	PathGlob     string
+	Effect       string
+	Capabilities []string
+}
+
+type document struct {
+	Path map[string]*pathRules `hcl:"path" yaml:"path"`
+}
+
+type pathRules struct {
+	Capabilities []string `hcl:"capabilities" yaml:"capabilities"`
+	Deny         []string `hcl:"deny" yaml:"deny"`
+}
+
+// Parse parses a policy document into its rules, ordered by path glob with the deny rule before the allow rule of
+// a same glob. Capabilities are checked against validCapabilities and returned in the same order.
+func Parse(format string, content string, validCapabilities []string) ([]Rule, error) {
+	var doc document
+	switch format {
+	case FormatHCL:
+		if err := hcl.Decode(&doc, content); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+		}
+	case FormatYAML:
+		decoder := yaml.NewDecoder(strings.NewReader(content))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPolicy, err)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidPolicy, format)
+	}
+
+	if len(doc.Path) == 0 {
+		return nil, fmt.Errorf("%w: no path rule", ErrInvalidPolicy)
+	}
+
+	globs := make([]string, 0, len(doc.Path))
+	for glob := range doc.Path {
+		globs = append(globs, glob)
+	}
+	sort.Strings(globs)
+
+	var rules []Rule
+	for _, glob := range globs {
+		if !strings.HasPrefix(glob, "/") {
+			return nil, fmt.Errorf("%w: path %q must start with '/'", ErrInvalidPolicy, glob)
+		}
+		if doc.Path[glob] == nil {
+			return nil, fmt.Errorf("%w: path %q has no capabilities", ErrInvalidPolicy, glob)
+		}
+
+		allowed := doc.Path[glob].Capabilities
+		denied := doc.Path[glob].Deny
+		// Vault denies everything with a "deny" capability
+		if slices.Contains(allowed, denyAll) {
+			allowed = slices.DeleteFunc(slices.Clone(allowed), func(c string) bool { return c == denyAll })
+			denied = append(slices.Clone(denied), denyAll)
+		}
+		if slices.Contains(denied, denyAll) {
+			denied = validCapabilities
+		}
+
+		allowed, err := checkCapabilities(glob, allowed, validCapabilities)
+		if err != nil {
+			return nil, err
+		}
+		denied, err = checkCapabilities(glob, denied, validCapabilities)
+		if err != nil {
+			return nil, err
+		}
+		if len(allowed) == 0 && len(denied) == 0 {
+			return nil, fmt.Errorf("%w: path %q has no capabilities", ErrInvalidPolicy, glob)
+		}
+
+		if len(denied) > 0 {
+			rules = append(rules, Rule{PathGlob: glob, Effect: EffectDeny, Capabilities: denied})
+		}
+		if len(allowed) > 0 {
+			rules = append(rules, Rule{PathGlob: glob, Effect: EffectAllow, Capabilities: allowed})
+		}
+	}
+	return rules, nil
+}
+
+func checkCapabilities(glob string, capabilities []string, validCapabilities []string) ([]string, error) {
+	for _, capability := range capabilities {
+		if !slices.Contains(validCapabilities, capability) {
+			return nil, fmt.Errorf("%w: unknown capability %q on path %q", ErrInvalidPolicy, capability, glob)
+		}
+	}
+
+	var result []string
+	for _, capability := range validCapabilities {
+		if slices.Contains(capabilities, capability) {
+			result = append(result, capability)
+		}
+	}
+	return result, nil
+}
+
+// Pattern returns the anchored regular expression matching the paths of the glob. It is understood by both Go and
+// PostgreSQL, so that rules can be evaluated by the database.
+func Pattern(glob string) string {
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		if glob[i] != '*' {
+			pattern.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+			continue
+		}
+		if i+1 < len(glob) && glob[i+1] == '*' {
+			pattern.WriteString(".*")
+			i++
+		} else {
+			pattern.WriteString("[^/]*")
+		}
+	}
+	pattern.WriteString("$")
+	return pattern.String()
+}
+
+// Matches tells whether the secret path matches the glob
+func Matches(glob string, path string) bool {
+	return regexp.MustCompile(Pattern(glob)).MatchString(path)
+}
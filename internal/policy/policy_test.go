@@ -0,0 +1,148 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var capabilities = []string{"read", "list", "update", "delete", "manage_access"}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name              string
+		format            string
+		document          string
+		expectedRules     []Rule
+		expectedError     bool
+		expectedErrorText string
+	}{
+		{
+			name:   "hcl document",
+			format: FormatHCL,
+			document: `
+path "/apps/*/db/*" {
+  capabilities = ["list", "read"]
+}
+
+path "/apps/prod/**" {
+  capabilities = ["read"]
+  deny = ["delete", "update"]
+}
+`,
+			expectedRules: []Rule{
+				{PathGlob: "/apps/*/db/*", Effect: EffectAllow, Capabilities: []string{"read", "list"}},
+				{PathGlob: "/apps/prod/**", Effect: EffectDeny, Capabilities: []string{"update", "delete"}},
+				{PathGlob: "/apps/prod/**", Effect: EffectAllow, Capabilities: []string{"read"}},
+			},
+		},
+		{
+			name:   "yaml document",
+			format: FormatYAML,
+			document: `
+path:
+  /apps/*/db/*:
+    capabilities: [read, list]
+  /apps/prod/**:
+    deny: [delete]
+`,
+			expectedRules: []Rule{
+				{PathGlob: "/apps/*/db/*", Effect: EffectAllow, Capabilities: []string{"read", "list"}},
+				{PathGlob: "/apps/prod/**", Effect: EffectDeny, Capabilities: []string{"delete"}},
+			},
+		},
+		{
+			name:   "vault deny capability denies everything",
+			format: FormatHCL,
+			document: `
+path "/root/**" {
+  capabilities = ["deny"]
+}
+`,
+			expectedRules: []Rule{
+				{PathGlob: "/root/**", Effect: EffectDeny, Capabilities: capabilities},
+			},
+		},
+		{
+			name:              "unknown capability",
+			format:            FormatYAML,
+			document:          "path:\n  /apps/*:\n    capabilities: [sudo]\n",
+			expectedError:     true,
+			expectedErrorText: `invalid policy: unknown capability "sudo" on path "/apps/*"`,
+		},
+		{
+			name:              "relative path",
+			format:            FormatHCL,
+			document:          `path "apps/*" { capabilities = ["read"] }`,
+			expectedError:     true,
+			expectedErrorText: `invalid policy: path "apps/*" must start with '/'`,
+		},
+		{
+			name:              "path without capabilities",
+			format:            FormatHCL,
+			document:          `path "/apps/*" { capabilities = [] }`,
+			expectedError:     true,
+			expectedErrorText: `invalid policy: path "/apps/*" has no capabilities`,
+		},
+		{
+			name:              "empty document",
+			format:            FormatYAML,
+			document:          "path: {}\n",
+			expectedError:     true,
+			expectedErrorText: "invalid policy: no path rule",
+		},
+		{
+			name:          "unknown yaml field",
+			format:        FormatYAML,
+			document:      "paths:\n  /apps/*:\n    capabilities: [read]\n",
+			expectedError: true,
+		},
+		{
+			name:              "unknown format",
+			format:            "json",
+			document:          "{}",
+			expectedError:     true,
+			expectedErrorText: `invalid policy: unknown format "json"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := Parse(tt.format, tt.document, capabilities)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidPolicy)
+				if tt.expectedErrorText != "" {
+					assert.Equal(t, tt.expectedErrorText, err.Error())
+				}
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedRules, rules)
+			}
+		})
+	}
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		glob     string
+		path     string
+		expected bool
+	}{
+		{glob: "/apps/*/db/*", path: "/apps/payments/db/password", expected: true},
+		{glob: "/apps/*/db/*", path: "/apps/payments/eu/db/password", expected: false},
+		{glob: "/apps/*/db/*", path: "/apps/payments/db/", expected: true},
+		{glob: "/apps/**", path: "/apps/payments/eu/db/password", expected: true},
+		{glob: "/apps/**", path: "/applications/key", expected: false},
+		{glob: "/apps/db.password", path: "/apps/db.password", expected: true},
+		{glob: "/apps/db.password", path: "/apps/dbXpassword", expected: false},
+		{glob: "/apps/key", path: "/apps/key/other", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.glob+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Matches(tt.glob, tt.path))
+		})
+	}
+}
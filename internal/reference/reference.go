@@ -0,0 +1,150 @@
+// Package reference resolves the references to other secrets held by secret values.
+//
+// A reference is written ${ref:/path} for the value of a secret, or ${ref:/path#field} for a field of a structured
+// secret. Referenced values may themselves hold references, they are resolved recursively up to MaxDepth levels.
+// $${ref:/path} is kept as the literal ${ref:/path}. Resolved values are limited in size, since a value referencing
+// the same secret several times at every level grows exponentially with the depth.
+package reference
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// MaxDepth is the number of levels of references resolved before giving up
+const MaxDepth = 5
+
+var (
+	ErrCycle    = errors.New("reference cycle")
+	ErrTooDeep  = errors.New("references nested too deep")
+	ErrTooLarge = errors.New("resolved value too large")
+)
+
+var referencePattern = regexp.MustCompile(`\$?\$\{ref:(/[^}#]*)(?:#([^}]+))?\}`)
+
+// Reference is the path of a secret, along with one of its fields when it is structured
+type Reference struct {
+	Path  string
+	Field string
+}
+
+func (r Reference) String() string {
+	if r.Field == "" {
+		return r.Path
+	}
+	return r.Path + "#" + r.Field
+}
+
+// Lookup returns the unresolved value of the referenced secret, or of its field
+type Lookup func(ref Reference) (string, error)
+
+// Contains tells whether the value holds references
+func Contains(value string) bool {
+	for _, match := range referencePattern.FindAllString(value, -1) {
+		if !strings.HasPrefix(match, "$$") {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolver resolves references, looking every referenced secret up once
+type Resolver struct {
+	lookup   Lookup
+	maxSize  int
+	resolved map[Reference]resolvedValue
+}
+
+// resolvedValue is a resolved value along with the number of levels of references it was resolved from
+type resolvedValue struct {
+	value string
+	depth int
+}
+
+// NewResolver returns a resolver failing with ErrTooLarge when a value, or a value it references, resolves to more
+// than maxSize bytes
+func NewResolver(lookup Lookup, maxSize int) *Resolver {
+	return &Resolver{lookup: lookup, maxSize: maxSize, resolved: make(map[Reference]resolvedValue)}
+}
+
+// Resolve returns the value of the secret at origin with its references replaced by the values they reference. The
+// errors of the lookup are returned as is.
+func (r *Resolver) Resolve(origin Reference, value string) (string, error) {
+	resolved, err := r.resolve([]Reference{origin}, value)
+	if err != nil {
+		return "", err
+	}
+	return resolved.value, nil
+}
+
+func (r *Resolver) resolve(chain []Reference, value string) (resolvedValue, error) {
+	var resolveErr error
+	depth := 0
+	// The size is tracked as references are replaced, so that no value larger than the limit is ever built
+	size := len(value)
+	resolved := referencePattern.ReplaceAllStringFunc(value, func(match string) string {
+		if resolveErr != nil {
+			return ""
+		}
+		if strings.HasPrefix(match, "$$") {
+			return match[1:]
+		}
+		submatches := referencePattern.FindStringSubmatch(match)
+		referenced, err := r.resolveReference(chain, Reference{Path: submatches[1], Field: submatches[2]})
+		if err != nil {
+			resolveErr = err
+			return ""
+		}
+		depth = max(depth, referenced.depth+1)
+		size += len(referenced.value) - len(match)
+		if size > r.maxSize {
+			resolveErr = fmt.Errorf("%w: %s resolves to more than %d bytes", ErrTooLarge, chain[0], r.maxSize)
+			return ""
+		}
+		return referenced.value
+	})
+	if resolveErr != nil {
+		return resolvedValue{}, resolveErr
+	}
+	return resolvedValue{value: resolved, depth: depth}, nil
+}
+
+func (r *Resolver) resolveReference(chain []Reference, ref Reference) (resolvedValue, error) {
+	if slices.Contains(chain, ref) {
+		links := make([]string, 0, len(chain)+1)
+		for _, link := range chain {
+			links = append(links, link.String())
+		}
+		links = append(links, ref.String())
+		return resolvedValue{}, fmt.Errorf("%w: %s", ErrCycle, strings.Join(links, " -> "))
+	}
+	if len(chain) > MaxDepth {
+		return resolvedValue{}, tooDeep(chain)
+	}
+	// Values being resolved are not cached yet, so cycles are still detected. A cached value counts the levels of
+	// references it was resolved from.
+	if value, ok := r.resolved[ref]; ok {
+		if len(chain)+value.depth > MaxDepth {
+			return resolvedValue{}, tooDeep(chain)
+		}
+		return value, nil
+	}
+
+	raw, err := r.lookup(ref)
+	if err != nil {
+		return resolvedValue{}, err
+	}
+	value, err := r.resolve(append(slices.Clone(chain), ref), raw)
+	if err != nil {
+		return resolvedValue{}, err
+	}
+	r.resolved[ref] = value
+	return value, nil
+}
+
+func tooDeep(chain []Reference) error {
+	return fmt.Errorf("%w: %s references secrets more than %d levels deep", ErrTooDeep, chain[0], MaxDepth)
+}
@@ -0,0 +1,231 @@
+// Package render renders configuration documents written as Go text/template templates from the values of secrets.
+//
+// Templates read secrets with:
+//
+//	secret "/path"          the value of a secret
+//	field "/path" "name"    a field of a structured secret
+//	fields "/path"          the fields of a structured secret, to range over
+//
+// and format values with:
+//
+//	b64enc value            base64 encodes the value
+//	b64dec value            decodes the base64 encoded value
+//	json value              encodes the value, a string or the fields of a secret, as JSON
+//	env "NAME" value        formats NAME="value" for a dotenv file, escaping the value so that nothing in it is expanded
+package render
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	"github.com/Vidalee/FishyKeys/internal/secretfile"
+)
+
+var ErrInvalidTemplate = errors.New("invalid template")
+
+// MaxSteps is the number of loop iterations and template calls a rendering may take, since loops which write nothing
+// are not bounded by the size of the document
+const MaxSteps = 100_000
+
+// Secret is the value of a secret, or its fields when it is structured
+type Secret struct {
+	Value      string
+	Structured bool
+	Fields     map[string]string
+}
+
+// Lookup returns the secret at the path
+type Lookup func(path string) (*Secret, error)
+
+// lookupError carries the errors of the lookup through the execution of the template
+type lookupError struct {
+	err error
+}
+
+func (e *lookupError) Error() string {
+	return e.err.Error()
+}
+
+// Render executes the template, looking every secret it reads up once. Rendering fails with ErrInvalidTemplate when
+// the template is invalid, cannot be executed, renders more than maxSize bytes, takes more than MaxSteps steps or
+// outlives the context. The errors of the lookup are returned as is.
+func Render(ctx context.Context, document string, lookup Lookup, maxSize int) (string, error) {
+	secrets := make(map[string]*Secret)
+	readSecret := func(path string) (*Secret, error) {
+		if secret, ok := secrets[path]; ok {
+			return secret, nil
+		}
+		secret, err := lookup(path)
+		if err != nil {
+			return nil, &lookupError{err: err}
+		}
+		secrets[path] = secret
+		return secret, nil
+	}
+
+	steps := 0
+	tmpl, err := template.New("template").Option("missingkey=error").Funcs(template.FuncMap{
+		stepFunc: func() (string, error) {
+			steps++
+			if steps > MaxSteps {
+				return "", errTooManySteps
+			}
+			if err := ctx.Err(); err != nil {
+				return "", &stoppedError{err: err}
+			}
+			return "", nil
+		},
+		"secret": func(path string) (string, error) {
+			secret, err := readSecret(path)
+			if err != nil {
+				return "", err
+			}
+			if secret.Structured {
+				return "", fmt.Errorf("secret at path %s is structured, read one of its fields with field or fields", path)
+			}
+			return secret.Value, nil
+		},
+		"field": func(path string, name string) (string, error) {
+			secret, err := readSecret(path)
+			if err != nil {
+				return "", err
+			}
+			if !secret.Structured {
+				return "", fmt.Errorf("secret at path %s is not structured", path)
+			}
+			value, ok := secret.Fields[name]
+			if !ok {
+				return "", fmt.Errorf("field %s not found in secret at path: %s", name, path)
+			}
+			return value, nil
+		},
+		"fields": func(path string) (map[string]string, error) {
+			secret, err := readSecret(path)
+			if err != nil {
+				return nil, err
+			}
+			if !secret.Structured {
+				return nil, fmt.Errorf("secret at path %s is not structured", path)
+			}
+			return secret.Fields, nil
+		},
+		"b64enc": func(value string) string {
+			return base64.StdEncoding.EncodeToString([]byte(value))
+		},
+		"b64dec": func(value string) (string, error) {
+			decoded, err := base64.StdEncoding.DecodeString(value)
+			if err != nil {
+				return "", err
+			}
+			return string(decoded), nil
+		},
+		"json": func(value any) (string, error) {
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return "", err
+			}
+			return string(encoded), nil
+		},
+		"env": func(name string, value string) (string, error) {
+			if !secretfile.ValidEnvName(name) {
+				return "", fmt.Errorf("%q is not a valid environment variable name", name)
+			}
+			return secretfile.DotenvLine(name, value), nil
+		},
+	}).Parse(document)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil {
+			countSteps(t.Tree.Root)
+		}
+	}
+
+	output := &limitedWriter{remaining: maxSize}
+	if err := tmpl.Execute(output, nil); err != nil {
+		var lookupErr *lookupError
+		if errors.As(err, &lookupErr) {
+			return "", lookupErr.err
+		}
+		if errors.Is(err, errTooLarge) {
+			return "", fmt.Errorf("%w: the rendered document is larger than %d bytes", ErrInvalidTemplate, maxSize)
+		}
+		if errors.Is(err, errTooManySteps) {
+			return "", fmt.Errorf("%w: rendering takes more than %d steps", ErrInvalidTemplate, MaxSteps)
+		}
+		var stoppedErr *stoppedError
+		if errors.As(err, &stoppedErr) {
+			return "", fmt.Errorf("%w: rendering stopped: %v", ErrInvalidTemplate, stoppedErr.err)
+		}
+		return "", fmt.Errorf("%w: %v", ErrInvalidTemplate, err)
+	}
+	return output.String(), nil
+}
+
+var errTooLarge = errors.New("rendered document too large")
+
+// limitedWriter fails once more than remaining bytes are written, so that loops cannot render unbounded documents
+type limitedWriter struct {
+	strings.Builder
+	remaining int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.remaining {
+		return 0, errTooLarge
+	}
+	w.remaining -= len(p)
+	return w.Builder.Write(p)
+}
+
+// stepFunc is called at the start of every template and loop iteration
+const stepFunc = "_step"
+
+var errTooManySteps = errors.New("too many rendering steps")
+
+// stoppedError carries the error of the context through the execution of the template
+type stoppedError struct {
+	err error
+}
+
+func (e *stoppedError) Error() string {
+	return e.err.Error()
+}
+
+// countSteps calls stepFunc at the start of the list and of every list nested in it, among which the bodies of loops
+func countSteps(list *parse.ListNode) {
+	if list == nil {
+		return
+	}
+	for _, node := range list.Nodes {
+		switch node := node.(type) {
+		case *parse.IfNode:
+			countSteps(node.List)
+			countSteps(node.ElseList)
+		case *parse.WithNode:
+			countSteps(node.List)
+			countSteps(node.ElseList)
+		case *parse.RangeNode:
+			countSteps(node.List)
+			countSteps(node.ElseList)
+		}
+	}
+	step := &parse.ActionNode{
+		NodeType: parse.NodeAction,
+		Pipe: &parse.PipeNode{
+			NodeType: parse.NodePipe,
+			Cmds: []*parse.CommandNode{{
+				NodeType: parse.NodeCommand,
+				Args:     []parse.Node{parse.NewIdentifier(stepFunc)},
+			}},
+		},
+	}
+	list.Nodes = append([]parse.Node{step}, list.Nodes...)
+}
@@ -0,0 +1,161 @@
+package render
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	secrets := map[string]*Secret{
+		"/app/api_key": {Value: "sk_live_abcd"},
+		"/app/motd":    {Value: "say \"hi\"\nto C:\\"},
+		"/app/db":      {Structured: true, Fields: map[string]string{"host": "db.internal", "password": "hunter2"}},
+		"/app/cert":    {Value: "Y2VydGlmaWNhdGU="},
+		"/app/dsn":     {Value: "postgres://app:pa$$word@db/${DB_NAME}"},
+	}
+	errForbidden := errors.New("forbidden")
+
+	tests := []struct {
+		name              string
+		document          string
+		maxSize           int
+		expected          string
+		expectedErr       error
+		expectedErrorText string
+	}{
+		{
+			name:     "values and fields",
+			document: "api_key: {{ secret \"/app/api_key\" }}\ndb: {{ field \"/app/db\" \"host\" }}",
+			expected: "api_key: sk_live_abcd\ndb: db.internal",
+		},
+		{
+			name:     "range over fields",
+			document: `{{ range $name, $value := fields "/app/db" }}{{ $name }}={{ $value }};{{ end }}`,
+			expected: "host=db.internal;password=hunter2;",
+		},
+		{
+			name:     "base64",
+			document: `{{ secret "/app/api_key" | b64enc }} {{ secret "/app/cert" | b64dec }}`,
+			expected: "c2tfbGl2ZV9hYmNk certificate",
+		},
+		{
+			name:     "JSON",
+			document: `{"motd": {{ secret "/app/motd" | json }}, "db": {{ fields "/app/db" | json }}}`,
+			expected: `{"motd": "say \"hi\"\nto C:\\", "db": {"host":"db.internal","password":"hunter2"}}`,
+		},
+		{
+			name:     "env",
+			document: `{{ env "MOTD" (secret "/app/motd") }}`,
+			expected: `MOTD="say \"hi\"\nto C:\\"`,
+		},
+		{
+			name:     "env values are not expanded",
+			document: `{{ env "DSN" (secret "/app/dsn") }}`,
+			expected: `DSN="postgres://app:pa\$\$word@db/\${DB_NAME}"`,
+		},
+		{
+			name:              "invalid env name",
+			document:          `{{ env "API-KEY" (secret "/app/api_key") }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: `invalid template: template: template:1:3: executing "template" at <env "API-KEY" (secret "/app/api_key")>: error calling env: "API-KEY" is not a valid environment variable name`,
+		},
+		{
+			name:              "syntax error",
+			document:          `{{ secret "/app/api_key" }`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: `invalid template: template: template:1: unexpected "}" in operand`,
+		},
+		{
+			name:              "value of a structured secret",
+			document:          `{{ secret "/app/db" }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: `invalid template: template: template:1:3: executing "template" at <secret "/app/db">: error calling secret: secret at path /app/db is structured, read one of its fields with field or fields`,
+		},
+		{
+			name:              "missing field",
+			document:          `{{ field "/app/db" "port" }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: `invalid template: template: template:1:3: executing "template" at <field "/app/db" "port">: error calling field: field port not found in secret at path: /app/db`,
+		},
+		{
+			name:        "lookup error",
+			document:    `before {{ secret "/private/key" }} after`,
+			expectedErr: errForbidden,
+		},
+		{
+			name:              "document too large",
+			document:          `{{ range $i, $_ := fields "/app/db" }}{{ secret "/app/api_key" }}{{ end }}`,
+			maxSize:           20,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: "invalid template: the rendered document is larger than 20 bytes",
+		},
+		{
+			name:              "empty loop too long",
+			document:          `{{ range 300000000 }}{{ end }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: "invalid template: rendering takes more than 100000 steps",
+		},
+		{
+			name:              "nested loops too long",
+			document:          `{{ range 100 }}{{ range 100 }}{{ range 100 }}{{ end }}{{ end }}{{ end }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: "invalid template: rendering takes more than 100000 steps",
+		},
+		{
+			name:              "recursive template too long",
+			document:          `{{ define "loop" }}{{ range 2 }}{{ template "loop" }}{{ end }}{{ end }}{{ template "loop" }}`,
+			expectedErr:       ErrInvalidTemplate,
+			expectedErrorText: "invalid template: rendering takes more than 100000 steps",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			maxSize := tt.maxSize
+			if maxSize == 0 {
+				maxSize = 1 << 20
+			}
+			rendered, err := Render(context.Background(), tt.document, func(path string) (*Secret, error) {
+				secret, ok := secrets[path]
+				if !ok {
+					return nil, errForbidden
+				}
+				return secret, nil
+			}, maxSize)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				if tt.expectedErrorText != "" {
+					assert.Equal(t, tt.expectedErrorText, err.Error())
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rendered)
+		})
+	}
+
+	t.Run("secrets are looked up once", func(t *testing.T) {
+		var lookups int
+		rendered, err := Render(context.Background(), `{{ field "/app/db" "host" }}:{{ field "/app/db" "password" }}`, func(path string) (*Secret, error) {
+			lookups++
+			return secrets[path], nil
+		}, 1<<20)
+		require.NoError(t, err)
+		assert.Equal(t, "db.internal:hunter2", rendered)
+		assert.Equal(t, 1, lookups)
+	})
+
+	t.Run("rendering stops with the context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := Render(ctx, `{{ range 10 }}{{ end }}`, func(path string) (*Secret, error) {
+			return secrets[path], nil
+		}, 1<<20)
+		require.ErrorIs(t, err, ErrInvalidTemplate)
+		assert.Equal(t, "invalid template: rendering stopped: context canceled", err.Error())
+	})
+}
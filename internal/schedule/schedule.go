@@ -0,0 +1,41 @@
+// Package schedule parses the rotation schedules of secrets, either a duration such as "720h" or a standard five
+// fields cron expression such as "0 3 * * 1".
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinInterval is the shortest interval between two rotations
+const MinInterval = time.Minute
+
+var ErrInvalidSchedule = errors.New("invalid rotation schedule")
+
+type Schedule interface {
+	// Next returns the next activation time, later than the given time
+	Next(time.Time) time.Time
+}
+
+// Parse parses a duration or a cron expression
+func Parse(expression string) (Schedule, error) {
+	if interval, err := time.ParseDuration(expression); err == nil {
+		if interval < MinInterval {
+			return nil, fmt.Errorf("%w: interval must be at least %s", ErrInvalidSchedule, MinInterval)
+		}
+		return cron.Every(interval), nil
+	}
+
+	s, err := cron.ParseStandard(expression)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q is neither a duration nor a cron expression", ErrInvalidSchedule, expression)
+	}
+	// Next returns the zero time when no date matches within five years, such as on February 30th
+	if s.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("%w: %q never matches", ErrInvalidSchedule, expression)
+	}
+	return s, nil
+}
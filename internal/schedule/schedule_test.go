@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	from := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		expression        string
+		expectedNext      time.Time
+		expectedErrorText string
+	}{
+		{
+			name:         "duration",
+			expression:   "720h",
+			expectedNext: from.Add(720 * time.Hour),
+		},
+		{
+			name:         "cron expression",
+			expression:   "0 3 * * 1",
+			expectedNext: time.Date(2025, 7, 7, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "cron descriptor",
+			expression:   "@daily",
+			expectedNext: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:              "interval too short",
+			expression:        "30s",
+			expectedErrorText: "invalid rotation schedule: interval must be at least 1m0s",
+		},
+		{
+			name:              "never matching cron expression",
+			expression:        "0 0 30 2 *",
+			expectedErrorText: `invalid rotation schedule: "0 0 30 2 *" never matches`,
+		},
+		{
+			name:              "garbage",
+			expression:        "every monday",
+			expectedErrorText: `invalid rotation schedule: "every monday" is neither a duration nor a cron expression`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expression)
+			if tt.expectedErrorText != "" {
+				assert.ErrorIs(t, err, ErrInvalidSchedule)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNext, s.Next(from))
+		})
+	}
+}
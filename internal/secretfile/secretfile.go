@@ -0,0 +1,400 @@
+// Package secretfile encodes and decodes the files the secrets of a folder are exported to and imported from, in the
+// dotenv, JSON and YAML formats, and names their keys after the paths of the secrets.
+//
+// JSON and YAML files map keys to values, or to objects holding the fields of structured secrets. Dotenv files only
+// hold values, the fields of structured secrets are flattened into keys of their own.
+package secretfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatDotenv = "dotenv"
+	FormatJSON   = "json"
+	FormatYAML   = "yaml"
+)
+
+// Key namings, telling how secrets are named in files
+const (
+	// KeyNamingPath names secrets after their path relative to the folder, such as db/password
+	KeyNamingPath = "path"
+	// KeyNamingSegment names secrets after the last segment of their path, such as password
+	KeyNamingSegment = "segment"
+	// KeyNamingEnv names secrets after the last segment of their path as an environment variable, such as PASSWORD.
+	// Imported keys are lowercased.
+	KeyNamingEnv = "env"
+)
+
+var ErrInvalidFile = errors.New("invalid file")
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Dollar signs are escaped too, dotenv loaders expand variables in double quoted values
+var dotenvValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, `$`, `\$`)
+
+// Entry is a value, or the fields of a structured secret when Fields is not nil, under a key
+type Entry struct {
+	Key    string
+	Value  string
+	Fields map[string]string
+}
+
+// ContentType returns the content type of the files of the format
+func ContentType(format string) string {
+	switch format {
+	case FormatJSON:
+		return "application/json"
+	case FormatYAML:
+		return "application/yaml"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// Extension returns the extension of the files of the format
+func Extension(format string) string {
+	switch format {
+	case FormatJSON:
+		return ".json"
+	case FormatYAML:
+		return ".yaml"
+	default:
+		return ".env"
+	}
+}
+
+// ValidEnvName tells whether the name is a valid environment variable name
+func ValidEnvName(name string) bool {
+	return envNamePattern.MatchString(name)
+}
+
+// DotenvLine formats NAME="value" for a dotenv file, escaping the value
+func DotenvLine(name string, value string) string {
+	return name + `="` + dotenvValueReplacer.Replace(value) + `"`
+}
+
+// ExportKey returns the key the secret at the path relative to the folder is exported under, or one of its fields
+// when field is not empty
+func ExportKey(keyNaming string, relativePath string, field string) string {
+	switch keyNaming {
+	case KeyNamingSegment, KeyNamingEnv:
+		name := path.Base(relativePath)
+		if field != "" {
+			name += "_" + field
+		}
+		if keyNaming == KeyNamingSegment {
+			return name
+		}
+		return envName(name)
+	default:
+		if field != "" {
+			return relativePath + "_" + field
+		}
+		return relativePath
+	}
+}
+
+func envName(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if i == 0 && unicode.IsDigit(r) {
+			builder.WriteRune('_')
+		}
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			builder.WriteRune(unicode.ToUpper(r))
+		} else {
+			builder.WriteRune('_')
+		}
+	}
+	return builder.String()
+}
+
+// ImportPath returns the path relative to the folder the key is imported to
+func ImportPath(keyNaming string, key string) (string, error) {
+	switch keyNaming {
+	case KeyNamingSegment, KeyNamingEnv:
+		if key == "" || strings.Contains(key, "/") {
+			return "", fmt.Errorf("%w: key %q must be a single path segment", ErrInvalidFile, key)
+		}
+		if keyNaming == KeyNamingEnv {
+			return strings.ToLower(key), nil
+		}
+		return key, nil
+	default:
+		if key == "" || strings.HasPrefix(key, "/") || strings.HasSuffix(key, "/") || strings.Contains(key, "//") {
+			return "", fmt.Errorf("%w: key %q is not a relative path", ErrInvalidFile, key)
+		}
+		return key, nil
+	}
+}
+
+// Encode encodes the entries in the format. Dotenv files cannot hold structured secrets and require keys to be
+// valid environment variable names.
+func Encode(format string, entries []Entry) ([]byte, error) {
+	if format == FormatDotenv {
+		var buffer bytes.Buffer
+		for _, entry := range entries {
+			if entry.Fields != nil {
+				return nil, fmt.Errorf("%w: dotenv files cannot hold the structured secret %s", ErrInvalidFile, entry.Key)
+			}
+			if !ValidEnvName(entry.Key) {
+				return nil, fmt.Errorf("%w: %q is not a valid environment variable name, choose another key naming", ErrInvalidFile, entry.Key)
+			}
+			buffer.WriteString(DotenvLine(entry.Key, entry.Value))
+			buffer.WriteByte('\n')
+		}
+		return buffer.Bytes(), nil
+	}
+
+	document := make(map[string]any, len(entries))
+	for _, entry := range entries {
+		if entry.Fields != nil {
+			document[entry.Key] = entry.Fields
+		} else {
+			document[entry.Key] = entry.Value
+		}
+	}
+	switch format {
+	case FormatJSON:
+		var buffer bytes.Buffer
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(document); err != nil {
+			return nil, err
+		}
+		return buffer.Bytes(), nil
+	case FormatYAML:
+		return yaml.Marshal(document)
+	default:
+		return nil, fmt.Errorf("%w: unknown format %q", ErrInvalidFile, format)
+	}
+}
+
+// Decode decodes the entries of a file in the format, sorted by key
+func Decode(format string, data []byte) ([]Entry, error) {
+	var entries []Entry
+	var err error
+	switch format {
+	case FormatDotenv:
+		entries, err = decodeDotenv(string(data))
+	case FormatJSON:
+		entries, err = decodeJSON(data)
+	case FormatYAML:
+		entries, err = decodeYAML(data)
+	default:
+		err = fmt.Errorf("%w: unknown format %q", ErrInvalidFile, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return entries, nil
+}
+
+func decodeDotenv(data string) ([]Entry, error) {
+	var entries []Entry
+	defined := make(map[string]bool)
+	for i, line := range strings.Split(data, "\n") {
+		lineNumber := i + 1
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		name, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: line %d: expected NAME=value", ErrInvalidFile, lineNumber)
+		}
+		name = strings.TrimSpace(name)
+		if !ValidEnvName(name) {
+			return nil, fmt.Errorf("%w: line %d: %q is not a valid environment variable name", ErrInvalidFile, lineNumber, name)
+		}
+		if defined[name] {
+			return nil, fmt.Errorf("%w: line %d: %s is defined twice", ErrInvalidFile, lineNumber, name)
+		}
+		defined[name] = true
+
+		value, err := dotenvValue(strings.TrimSpace(rawValue))
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidFile, lineNumber, err)
+		}
+		entries = append(entries, Entry{Key: name, Value: value})
+	}
+	return entries, nil
+}
+
+// dotenvValue unquotes a value, which is either double quoted with escapes, single quoted as is, or unquoted up to a
+// comment
+func dotenvValue(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+
+	var value strings.Builder
+	var rest string
+	switch raw[0] {
+	case '"':
+		closed := false
+		for i := 1; i < len(raw); i++ {
+			c := raw[i]
+			if c == '"' {
+				rest = raw[i+1:]
+				closed = true
+				break
+			}
+			if c != '\\' {
+				value.WriteByte(c)
+				continue
+			}
+			if i+1 == len(raw) {
+				break
+			}
+			i++
+			switch raw[i] {
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case '\\', '"', '$':
+				value.WriteByte(raw[i])
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(raw[i])
+			}
+		}
+		if !closed {
+			return "", errors.New("unterminated quoted value")
+		}
+	case '\'':
+		end := strings.IndexByte(raw[1:], '\'')
+		if end < 0 {
+			return "", errors.New("unterminated quoted value")
+		}
+		value.WriteString(raw[1 : end+1])
+		rest = raw[end+2:]
+	default:
+		unquoted, _, _ := strings.Cut(raw, " #")
+		return strings.TrimSpace(unquoted), nil
+	}
+
+	rest = strings.TrimSpace(rest)
+	if rest != "" && rest[0] != '#' {
+		return "", fmt.Errorf("unexpected %q after the quoted value", rest)
+	}
+	return value.String(), nil
+}
+
+func decodeJSON(data []byte) ([]Entry, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document map[string]any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("%w: unexpected data after the document", ErrInvalidFile)
+	}
+
+	entries := make([]Entry, 0, len(document))
+	for key, rawValue := range document {
+		entry := Entry{Key: key}
+		if object, ok := rawValue.(map[string]any); ok {
+			entry.Fields = make(map[string]string, len(object))
+			for field, rawFieldValue := range object {
+				value, ok := jsonScalar(rawFieldValue)
+				if !ok {
+					return nil, fmt.Errorf("%w: field %s of %s must be a string, a number or a boolean", ErrInvalidFile, field, key)
+				}
+				entry.Fields[field] = value
+			}
+		} else {
+			value, ok := jsonScalar(rawValue)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s must be a string, a number, a boolean or an object of them", ErrInvalidFile, key)
+			}
+			entry.Value = value
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func jsonScalar(value any) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	case bool:
+		return strconv.FormatBool(value), true
+	default:
+		return "", false
+	}
+}
+
+func decodeYAML(data []byte) ([]Entry, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%w: the document must be a mapping", ErrInvalidFile)
+	}
+
+	entries := make([]Entry, 0, len(mapping.Content)/2)
+	defined := make(map[string]bool)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i].Value
+		if defined[key] {
+			return nil, fmt.Errorf("%w: line %d: %s is defined twice", ErrInvalidFile, mapping.Content[i].Line, key)
+		}
+		defined[key] = true
+
+		entry := Entry{Key: key}
+		node := mapping.Content[i+1]
+		if node.Kind == yaml.MappingNode {
+			entry.Fields = make(map[string]string, len(node.Content)/2)
+			for j := 0; j+1 < len(node.Content); j += 2 {
+				field := node.Content[j].Value
+				if !yamlScalar(node.Content[j+1]) {
+					return nil, fmt.Errorf("%w: line %d: field %s of %s must be a scalar", ErrInvalidFile, node.Content[j+1].Line, field, key)
+				}
+				entry.Fields[field] = node.Content[j+1].Value
+			}
+		} else {
+			if !yamlScalar(node) {
+				return nil, fmt.Errorf("%w: line %d: %s must be a scalar or a mapping of scalars", ErrInvalidFile, node.Line, key)
+			}
+			entry.Value = node.Value
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// yamlScalar tells whether the node is a scalar, other than null, whose value is kept as written
+func yamlScalar(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag != "!!null"
+}
@@ -0,0 +1,219 @@
+package secretfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyNaming(t *testing.T) {
+	tests := []struct {
+		keyNaming    string
+		relativePath string
+		field        string
+		expected     string
+	}{
+		{keyNaming: KeyNamingPath, relativePath: "db/password", expected: "db/password"},
+		{keyNaming: KeyNamingPath, relativePath: "db/credentials", field: "user", expected: "db/credentials_user"},
+		{keyNaming: KeyNamingSegment, relativePath: "db/password", expected: "password"},
+		{keyNaming: KeyNamingSegment, relativePath: "db/credentials", field: "user", expected: "credentials_user"},
+		{keyNaming: KeyNamingEnv, relativePath: "stripe/api-key", expected: "API_KEY"},
+		{keyNaming: KeyNamingEnv, relativePath: "db/credentials", field: "user", expected: "CREDENTIALS_USER"},
+		{keyNaming: KeyNamingEnv, relativePath: "auth/2fa.seed", expected: "_2FA_SEED"},
+		{keyNaming: KeyNamingEnv, relativePath: "clé", expected: "CL_"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyNaming+" "+tt.relativePath, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExportKey(tt.keyNaming, tt.relativePath, tt.field))
+		})
+	}
+
+	t.Run("import paths", func(t *testing.T) {
+		importPath, err := ImportPath(KeyNamingEnv, "API_KEY")
+		require.NoError(t, err)
+		assert.Equal(t, "api_key", importPath)
+
+		importPath, err = ImportPath(KeyNamingPath, "db/password")
+		require.NoError(t, err)
+		assert.Equal(t, "db/password", importPath)
+
+		_, err = ImportPath(KeyNamingSegment, "db/password")
+		require.ErrorIs(t, err, ErrInvalidFile)
+		assert.Equal(t, `invalid file: key "db/password" must be a single path segment`, err.Error())
+
+		_, err = ImportPath(KeyNamingPath, "/db//password")
+		require.ErrorIs(t, err, ErrInvalidFile)
+		assert.Equal(t, `invalid file: key "/db//password" is not a relative path`, err.Error())
+	})
+}
+
+func TestEncode(t *testing.T) {
+	entries := []Entry{
+		{Key: "API_KEY", Value: "sk_live_$abcd"},
+		{Key: "MOTD", Value: "say \"hi\"\nto C:\\"},
+	}
+	structured := []Entry{
+		{Key: "db", Fields: map[string]string{"user": "app", "password": "<hunter2>"}},
+		{Key: "port", Value: "5432"},
+	}
+
+	tests := []struct {
+		name              string
+		format            string
+		entries           []Entry
+		expected          string
+		expectedErrorText string
+	}{
+		{
+			name:     "dotenv",
+			format:   FormatDotenv,
+			entries:  entries,
+			expected: "API_KEY=\"sk_live_\\$abcd\"\nMOTD=\"say \\\"hi\\\"\\nto C:\\\\\"\n",
+		},
+		{
+			name:              "dotenv with a structured secret",
+			format:            FormatDotenv,
+			entries:           structured,
+			expectedErrorText: "invalid file: dotenv files cannot hold the structured secret db",
+		},
+		{
+			name:              "dotenv with an invalid name",
+			format:            FormatDotenv,
+			entries:           []Entry{{Key: "db/password", Value: "hunter2"}},
+			expectedErrorText: `invalid file: "db/password" is not a valid environment variable name, choose another key naming`,
+		},
+		{
+			name:     "JSON",
+			format:   FormatJSON,
+			entries:  structured,
+			expected: "{\n  \"db\": {\n    \"password\": \"<hunter2>\",\n    \"user\": \"app\"\n  },\n  \"port\": \"5432\"\n}\n",
+		},
+		{
+			name:     "YAML",
+			format:   FormatYAML,
+			entries:  structured,
+			expected: "db:\n    password: <hunter2>\n    user: app\nport: \"5432\"\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := Encode(tt.format, tt.entries)
+			if tt.expectedErrorText != "" {
+				require.ErrorIs(t, err, ErrInvalidFile)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(encoded))
+
+			decoded, err := Decode(tt.format, encoded)
+			require.NoError(t, err)
+			assert.Equal(t, tt.entries, decoded, "encoded entries should decode back")
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name              string
+		format            string
+		data              string
+		expected          []Entry
+		expectedErrorText string
+	}{
+		{
+			name:   "dotenv",
+			format: FormatDotenv,
+			data: "# database\r\n" +
+				"export DB_HOST=db.internal # primary\r\n" +
+				"DB_PASSWORD = 'hunter2 # not a comment'\n" +
+				"\n" +
+				"MOTD=\"line\\tone\\nline two\" # comment\n" +
+				"EMPTY=\n",
+			expected: []Entry{
+				{Key: "DB_HOST", Value: "db.internal"},
+				{Key: "DB_PASSWORD", Value: "hunter2 # not a comment"},
+				{Key: "EMPTY", Value: ""},
+				{Key: "MOTD", Value: "line\tone\nline two"},
+			},
+		},
+		{
+			name:              "dotenv without value",
+			format:            FormatDotenv,
+			data:              "DB_HOST=db.internal\nDB_PASSWORD\n",
+			expectedErrorText: "invalid file: line 2: expected NAME=value",
+		},
+		{
+			name:              "dotenv with an unterminated value",
+			format:            FormatDotenv,
+			data:              "MOTD=\"hello\n",
+			expectedErrorText: "invalid file: line 1: unterminated quoted value",
+		},
+		{
+			name:              "dotenv with text after a quoted value",
+			format:            FormatDotenv,
+			data:              "MOTD='hello' world\n",
+			expectedErrorText: `invalid file: line 1: unexpected "world" after the quoted value`,
+		},
+		{
+			name:              "dotenv with a duplicate name",
+			format:            FormatDotenv,
+			data:              "A=1\nA=2\n",
+			expectedErrorText: "invalid file: line 2: A is defined twice",
+		},
+		{
+			name:   "JSON with numbers and booleans",
+			format: FormatJSON,
+			data:   `{"port": 5432, "tls": true, "db": {"user": "app", "pool": 10}}`,
+			expected: []Entry{
+				{Key: "db", Fields: map[string]string{"user": "app", "pool": "10"}},
+				{Key: "port", Value: "5432"},
+				{Key: "tls", Value: "true"},
+			},
+		},
+		{
+			name:              "JSON with an array",
+			format:            FormatJSON,
+			data:              `{"hosts": ["a", "b"]}`,
+			expectedErrorText: "invalid file: hosts must be a string, a number, a boolean or an object of them",
+		},
+		{
+			name:   "YAML keeps scalars as written",
+			format: FormatYAML,
+			data:   "port: 05432\nratio: 1.50\ndb:\n  user: app\n",
+			expected: []Entry{
+				{Key: "db", Fields: map[string]string{"user": "app"}},
+				{Key: "port", Value: "05432"},
+				{Key: "ratio", Value: "1.50"},
+			},
+		},
+		{
+			name:              "YAML with a null",
+			format:            FormatYAML,
+			data:              "port: 5432\npassword:\n",
+			expectedErrorText: "invalid file: line 2: password must be a scalar or a mapping of scalars",
+		},
+		{
+			name:              "YAML list",
+			format:            FormatYAML,
+			data:              "- a\n- b\n",
+			expectedErrorText: "invalid file: the document must be a mapping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := Decode(tt.format, []byte(tt.data))
+			if tt.expectedErrorText != "" {
+				require.ErrorIs(t, err, ErrInvalidFile)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, entries)
+		})
+	}
+}
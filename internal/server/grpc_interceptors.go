@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"strings"
 )
@@ -23,6 +24,34 @@ type GrpcServerInterceptors struct {
 
 // GrpcAuthentifiedInterceptor is the JwtAuth middleware combined with the Authentified interceptor for gRPC services.
 func (i *GrpcServerInterceptors) GrpcAuthentifiedInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// GrpcAuthentifiedStreamInterceptor is GrpcAuthentifiedInterceptor for streaming methods
+func (i *GrpcServerInterceptors) GrpcAuthentifiedStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authentifiedServerStream{ServerStream: ss, ctx: ctx})
+}
+
+// authentifiedServerStream carries the token claims in the context of the stream
+type authentifiedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authentifiedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate returns the context with the claims of the token found in the metadata
+func (i *GrpcServerInterceptors) authenticate(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		md = metadata.New(nil)
@@ -73,6 +102,8 @@ func (i *GrpcServerInterceptors) GrpcAuthentifiedInterceptor(ctx context.Context
 	}
 
 	ctx = context.WithValue(ctx, "token", claims)
-
-	return handler(ctx, req)
+	if p, ok := peer.FromContext(ctx); ok {
+		ctx = context.WithValue(ctx, "remote_addr", p.Addr.String())
+	}
+	return ctx, nil
 }
@@ -193,3 +193,69 @@ func TestGrpcAuthentifiedInterceptor_ValidToken(t *testing.T) {
 	assert.Equal(t, "success", resp)
 	mockRepo.AssertExpectations(t)
 }
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGrpcAuthentifiedStreamInterceptor_NoAuthHeader(t *testing.T) {
+	mockRepo := repositorymocks.NewMockSecretsRepository(t)
+	keyManager := crypto.GetDefaultKeyManager()
+
+	interceptor := &GrpcServerInterceptors{
+		secretsRepository: mockRepo,
+		keyManager:        keyManager,
+	}
+
+	stream := &testServerStream{ctx: context.Background()}
+	err := interceptor.GrpcAuthentifiedStreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+		t.Fatal("handler should not be called without token")
+		return nil
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestGrpcAuthentifiedStreamInterceptor_ValidToken(t *testing.T) {
+	mockRepo := repositorymocks.NewMockSecretsRepository(t)
+	keyManager := crypto.GetDefaultKeyManager()
+	_ = keyManager.SetNewMasterKey([]byte("masterkey"), 1, 1)
+
+	interceptor := &GrpcServerInterceptors{
+		secretsRepository: mockRepo,
+		keyManager:        keyManager,
+	}
+
+	mockRepo.On("GetSecretByPath", mock.Anything, mock.Anything, "internal/jwt_signing_key").
+		Return(&repository.DecryptedSecret{
+			DecryptedValue: base64.StdEncoding.EncodeToString([]byte(jwtTestSecret)),
+		}, nil)
+
+	tokenString, err := createTestToken([]byte(jwtTestSecret), &service.JwtClaims{
+		Username: "valid_user",
+		UserID:   1,
+	})
+	assert.NoError(t, err)
+
+	md := metadata.Pairs("authorization", "Bearer "+tokenString)
+	stream := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+
+	handlerCalled := false
+	err = interceptor.GrpcAuthentifiedStreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+		handlerCalled = true
+		claims := stream.Context().Value("token").(*service.JwtClaims)
+		assert.Equal(t, "valid_user", claims.Username)
+		assert.Equal(t, 1, claims.UserID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, handlerCalled)
+	mockRepo.AssertExpectations(t)
+}
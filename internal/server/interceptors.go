@@ -3,9 +3,13 @@ package server
 import (
 	"context"
 	"fmt"
+	"github.com/Vidalee/FishyKeys/gen/backup"
+	"github.com/Vidalee/FishyKeys/gen/generation"
+	"github.com/Vidalee/FishyKeys/gen/policies"
 	"github.com/Vidalee/FishyKeys/gen/roles"
 	"github.com/Vidalee/FishyKeys/gen/secrets"
 	"github.com/Vidalee/FishyKeys/gen/users"
+	"github.com/Vidalee/FishyKeys/gen/webhooks"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/Vidalee/FishyKeys/service"
 	goa "goa.design/goa/v3/pkg"
@@ -56,7 +60,10 @@ func (i *ServerUsersInterceptors) IsAdmin(ctx context.Context, info *users.IsAdm
 	return nil, users.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
 }
 
-type ServerSecretsInterceptors struct{}
+type ServerSecretsInterceptors struct {
+	rolesRepository     repository.RolesRepository
+	userRolesRepository repository.UserRolesRepository
+}
 
 func (i *ServerSecretsInterceptors) Authentified(ctx context.Context, info *secrets.AuthentifiedInfo, next goa.Endpoint) (any, error) {
 	token := ctx.Value("token")
@@ -66,6 +73,31 @@ func (i *ServerSecretsInterceptors) Authentified(ctx context.Context, info *secr
 	return next(ctx, info.RawPayload())
 }
 
+func (i *ServerSecretsInterceptors) IsAdmin(ctx context.Context, info *secrets.IsAdminInfo, next goa.Endpoint) (any, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, secrets.MakeUnauthorized(fmt.Errorf("you need to be authenticated to access this endpoint"))
+	}
+	jwtClaims := token.(*service.JwtClaims)
+
+	roleIds, err := i.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, secrets.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	rolesList, err := i.rolesRepository.GetRolesByIDs(ctx, roleIds)
+	if err != nil {
+		return nil, secrets.MakeInternalError(fmt.Errorf("could not retrieve roles: %w", err))
+	}
+	for _, role := range rolesList {
+		if role.Admin {
+			return next(ctx, info.RawPayload())
+		}
+	}
+
+	return nil, secrets.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
+}
+
 type ServerRolesInterceptors struct {
 	rolesRepository     repository.RolesRepository
 	userRolesRepository repository.UserRolesRepository
@@ -108,3 +140,123 @@ func (i *ServerRolesInterceptors) IsAdmin(ctx context.Context, info *roles.IsAdm
 
 	return nil, roles.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
 }
+
+type ServerPoliciesInterceptors struct {
+	rolesRepository     repository.RolesRepository
+	userRolesRepository repository.UserRolesRepository
+}
+
+func (i *ServerPoliciesInterceptors) IsAdmin(ctx context.Context, info *policies.IsAdminInfo, next goa.Endpoint) (any, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, policies.MakeUnauthorized(fmt.Errorf("you need to be authenticated to access this endpoint"))
+	}
+	jwtClaims := token.(*service.JwtClaims)
+
+	roleIds, err := i.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, policies.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	rolesList, err := i.rolesRepository.GetRolesByIDs(ctx, roleIds)
+	if err != nil {
+		return nil, policies.MakeInternalError(fmt.Errorf("could not retrieve roles: %w", err))
+	}
+	for _, role := range rolesList {
+		if role.Admin {
+			return next(ctx, info.RawPayload())
+		}
+	}
+
+	return nil, policies.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
+}
+
+type ServerGenerationInterceptors struct {
+	rolesRepository     repository.RolesRepository
+	userRolesRepository repository.UserRolesRepository
+}
+
+func (i *ServerGenerationInterceptors) IsAdmin(ctx context.Context, info *generation.IsAdminInfo, next goa.Endpoint) (any, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, generation.MakeUnauthorized(fmt.Errorf("you need to be authenticated to access this endpoint"))
+	}
+	jwtClaims := token.(*service.JwtClaims)
+
+	roleIds, err := i.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, generation.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	rolesList, err := i.rolesRepository.GetRolesByIDs(ctx, roleIds)
+	if err != nil {
+		return nil, generation.MakeInternalError(fmt.Errorf("could not retrieve roles: %w", err))
+	}
+	for _, role := range rolesList {
+		if role.Admin {
+			return next(ctx, info.RawPayload())
+		}
+	}
+
+	return nil, generation.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
+}
+
+type ServerWebhooksInterceptors struct {
+	rolesRepository     repository.RolesRepository
+	userRolesRepository repository.UserRolesRepository
+}
+
+func (i *ServerWebhooksInterceptors) IsAdmin(ctx context.Context, info *webhooks.IsAdminInfo, next goa.Endpoint) (any, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, webhooks.MakeUnauthorized(fmt.Errorf("you need to be authenticated to access this endpoint"))
+	}
+	jwtClaims := token.(*service.JwtClaims)
+
+	roleIds, err := i.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, webhooks.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	rolesList, err := i.rolesRepository.GetRolesByIDs(ctx, roleIds)
+	if err != nil {
+		return nil, webhooks.MakeInternalError(fmt.Errorf("could not retrieve roles: %w", err))
+	}
+	for _, role := range rolesList {
+		if role.Admin {
+			return next(ctx, info.RawPayload())
+		}
+	}
+
+	return nil, webhooks.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
+}
+
+type ServerBackupInterceptors struct {
+	rolesRepository     repository.RolesRepository
+	userRolesRepository repository.UserRolesRepository
+}
+
+func (i *ServerBackupInterceptors) IsAdmin(ctx context.Context, info *backup.IsAdminInfo, next goa.Endpoint) (any, error) {
+	token := ctx.Value("token")
+	if token == nil {
+		return nil, backup.MakeUnauthorized(fmt.Errorf("you need to be authenticated to access this endpoint"))
+	}
+	jwtClaims := token.(*service.JwtClaims)
+
+	roleIds, err := i.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, backup.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	rolesList, err := i.rolesRepository.GetRolesByIDs(ctx, roleIds)
+	if err != nil {
+		return nil, backup.MakeInternalError(fmt.Errorf("could not retrieve roles: %w", err))
+	}
+	for _, role := range rolesList {
+		if role.Admin {
+			return next(ctx, info.RawPayload())
+		}
+	}
+
+	return nil, backup.MakeForbidden(fmt.Errorf("you need to be an admin to access this endpoint"))
+}
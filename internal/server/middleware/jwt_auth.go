@@ -61,6 +61,7 @@ func JWTMiddleware(secretsRepository repository.SecretsRepository, keyManager *c
 			}
 
 			ctx := context.WithValue(r.Context(), "token", token.Claims.(*service.JwtClaims))
+			ctx = context.WithValue(ctx, "remote_addr", r.RemoteAddr)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
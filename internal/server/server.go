@@ -1,17 +1,27 @@
 package server
 
 import (
+	"context"
+	"github.com/Vidalee/FishyKeys/gen/backup"
+	"github.com/Vidalee/FishyKeys/gen/generation"
 	gensecretspb "github.com/Vidalee/FishyKeys/gen/grpc/secrets/pb"
 	gensecretsserver "github.com/Vidalee/FishyKeys/gen/grpc/secrets/server"
+	backupsvvr "github.com/Vidalee/FishyKeys/gen/http/backup/server"
+	generationsvvr "github.com/Vidalee/FishyKeys/gen/http/generation/server"
 	keysvvr "github.com/Vidalee/FishyKeys/gen/http/key_management/server"
+	policiessvvr "github.com/Vidalee/FishyKeys/gen/http/policies/server"
 	rolessvvr "github.com/Vidalee/FishyKeys/gen/http/roles/server"
 	secretssvvr "github.com/Vidalee/FishyKeys/gen/http/secrets/server"
 	userssvvr "github.com/Vidalee/FishyKeys/gen/http/users/server"
+	webhookssvvr "github.com/Vidalee/FishyKeys/gen/http/webhooks/server"
 	"github.com/Vidalee/FishyKeys/gen/key_management"
+	"github.com/Vidalee/FishyKeys/gen/policies"
 	"github.com/Vidalee/FishyKeys/gen/roles"
 	"github.com/Vidalee/FishyKeys/gen/secrets"
 	"github.com/Vidalee/FishyKeys/gen/users"
+	"github.com/Vidalee/FishyKeys/gen/webhooks"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/internal/server/middleware"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/Vidalee/FishyKeys/service"
@@ -20,9 +30,15 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"net/http"
+	"time"
 )
 
-func NewServers(pool *pgxpool.Pool) (http.Handler, *grpc.Server) {
+// Worker is a background job run until the server shuts down
+type Worker interface {
+	Run(ctx context.Context)
+}
+
+func NewServers(pool *pgxpool.Pool, maxSecretSize int64, rotationInterval time.Duration, eventsRetention time.Duration) (http.Handler, *grpc.Server, []Worker) {
 	keyManager := crypto.GetDefaultKeyManager()
 
 	globalSettingsRepo := repository.NewGlobalSettingsRepository(pool)
@@ -31,22 +47,66 @@ func NewServers(pool *pgxpool.Pool) (http.Handler, *grpc.Server) {
 	userRolesRepo := repository.NewUserRolesRepository(pool)
 	secretsRepo := repository.NewSecretsRepository(pool)
 	secretsAccessRepository := repository.NewSecretsAccessRepository(pool)
+	folderAccessRepository := repository.NewFolderAccessRepository(pool)
+	policiesRepository := repository.NewPoliciesRepository(pool)
+	generationPoliciesRepository := repository.NewGenerationPoliciesRepository(pool)
+	secretEventsRepository := repository.NewSecretEventsRepository(pool)
+	webhooksRepository := repository.NewWebhooksRepository(pool)
+	wrappedSecretsRepository := repository.NewWrappedSecretsRepository(pool)
+	accessRequestsRepository := repository.NewAccessRequestsRepository(pool)
+	breakGlassRepository := repository.NewBreakGlassRepository(pool)
+	accessStatsRepository := repository.NewAccessStatsRepository(pool)
+	validationRulesRepository := repository.NewValidationRulesRepository(pool)
+	backupRepository := repository.NewBackupRepository(pool)
+
+	eventBus := events.NewBus()
+	webhookDispatcher := service.NewWebhookDispatcher(keyManager, webhooksRepository)
+	eventBus.Subscribe(webhookDispatcher.Handle)
 
-	keyService := service.NewKeyManagementService(keyManager, globalSettingsRepo, usersRepo, rolesRepo, userRolesRepo, secretsRepo)
-	usersService := service.NewUsersService(keyManager, usersRepo, globalSettingsRepo, secretsRepo, rolesRepo, userRolesRepo)
-	secretsService := service.NewSecretsService(keyManager, usersRepo, rolesRepo, userRolesRepo, globalSettingsRepo, secretsRepo, secretsAccessRepository)
-	rolesService := service.NewRolesService(rolesRepo, usersRepo, userRolesRepo)
+	keyService := service.NewKeyManagementService(keyManager, globalSettingsRepo, usersRepo, rolesRepo, userRolesRepo, secretsRepo, eventBus)
+	usersService := service.NewUsersService(keyManager, usersRepo, globalSettingsRepo, secretsRepo, rolesRepo, userRolesRepo, eventBus)
+	secretWatcher := service.NewSecretWatcher(secretEventsRepository, eventsRetention)
+	accessStatsRecorder := service.NewAccessStatsRecorder(accessStatsRepository)
+	secretsService := service.NewSecretsService(keyManager, usersRepo, rolesRepo, userRolesRepo, globalSettingsRepo, secretsRepo, secretsAccessRepository, folderAccessRepository, generationPoliciesRepository, wrappedSecretsRepository, accessRequestsRepository, breakGlassRepository, accessStatsRepository, validationRulesRepository, secretWatcher, accessStatsRecorder, eventBus, maxSecretSize)
+	rolesService := service.NewRolesService(rolesRepo, usersRepo, userRolesRepo, eventBus)
+	policiesService := service.NewPoliciesService(policiesRepository, usersRepo, rolesRepo, userRolesRepo, secretsRepo, secretsAccessRepository, folderAccessRepository)
+	generationService := service.NewGenerationService(generationPoliciesRepository)
+	webhooksService := service.NewWebhooksService(keyManager, webhooksRepository)
+	backupService := service.NewBackupService(keyManager, backupRepository, eventBus)
+	rotationScheduler := service.NewRotationScheduler(keyManager, secretsRepo, validationRulesRepository, eventBus, maxSecretSize, rotationInterval)
+	wrappedSecretsPurger := service.NewWrappedSecretsPurger(wrappedSecretsRepository)
+	expiredGrantsPurger := service.NewExpiredGrantsPurger(secretsAccessRepository, eventBus)
 
 	keyManagementEndpoints := keymanagement.NewEndpoints(keyService)
 	usersEndpoints := users.NewEndpoints(usersService, &ServerUsersInterceptors{
 		userRolesRepository: userRolesRepo,
 		rolesRepository:     rolesRepo,
 	})
-	secretsEndpoints := secrets.NewEndpoints(secretsService, &ServerSecretsInterceptors{})
+	secretsEndpoints := secrets.NewEndpoints(secretsService, &ServerSecretsInterceptors{
+		userRolesRepository: userRolesRepo,
+		rolesRepository:     rolesRepo,
+	})
 	rolesEndpoints := roles.NewEndpoints(rolesService, &ServerRolesInterceptors{
 		userRolesRepository: userRolesRepo,
 		rolesRepository:     rolesRepo,
 	})
+	policiesEndpoints := policies.NewEndpoints(policiesService, &ServerPoliciesInterceptors{
+		userRolesRepository: userRolesRepo,
+		rolesRepository:     rolesRepo,
+	})
+
+	generationEndpoints := generation.NewEndpoints(generationService, &ServerGenerationInterceptors{
+		userRolesRepository: userRolesRepo,
+		rolesRepository:     rolesRepo,
+	})
+	webhooksEndpoints := webhooks.NewEndpoints(webhooksService, &ServerWebhooksInterceptors{
+		userRolesRepository: userRolesRepo,
+		rolesRepository:     rolesRepo,
+	})
+	backupEndpoints := backup.NewEndpoints(backupService, &ServerBackupInterceptors{
+		userRolesRepository: userRolesRepo,
+		rolesRepository:     rolesRepo,
+	})
 
 	mux := goahttp.NewMuxer()
 	requestDecoder := goahttp.RequestDecoder
@@ -56,6 +116,10 @@ func NewServers(pool *pgxpool.Pool) (http.Handler, *grpc.Server) {
 	usersHandler := userssvvr.New(usersEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
 	secretsHandler := secretssvvr.New(secretsEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
 	rolesHandler := rolessvvr.New(rolesEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
+	policiesHandler := policiessvvr.New(policiesEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
+	generationHandler := generationsvvr.New(generationEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
+	webhooksHandler := webhookssvvr.New(webhooksEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
+	backupHandler := backupsvvr.New(backupEndpoints, mux, requestDecoder, responseEncoder, nil, nil)
 
 	mux.Use(middleware.JWTMiddleware(secretsRepo, keyManager))
 
@@ -63,16 +127,22 @@ func NewServers(pool *pgxpool.Pool) (http.Handler, *grpc.Server) {
 	userssvvr.Mount(mux, usersHandler)
 	secretssvvr.Mount(mux, secretsHandler)
 	rolessvvr.Mount(mux, rolesHandler)
+	policiessvvr.Mount(mux, policiesHandler)
+	generationsvvr.Mount(mux, generationHandler)
+	webhookssvvr.Mount(mux, webhooksHandler)
+	backupsvvr.Mount(mux, backupHandler)
 
-	grpcSrv := grpc.NewServer(grpc.UnaryInterceptor(
-		(&GrpcServerInterceptors{
-			usersRepository:   usersRepo,
-			secretsRepository: secretsRepo,
-			keyManager:        keyManager,
-		}).GrpcAuthentifiedInterceptor),
+	grpcInterceptors := &GrpcServerInterceptors{
+		usersRepository:   usersRepo,
+		secretsRepository: secretsRepo,
+		keyManager:        keyManager,
+	}
+	grpcSrv := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcInterceptors.GrpcAuthentifiedInterceptor),
+		grpc.StreamInterceptor(grpcInterceptors.GrpcAuthentifiedStreamInterceptor),
 	)
-	gensecretspb.RegisterSecretsServer(grpcSrv, gensecretsserver.New(secretsEndpoints, nil))
+	gensecretspb.RegisterSecretsServer(grpcSrv, gensecretsserver.New(secretsEndpoints, nil, nil))
 	reflection.Register(grpcSrv)
 
-	return mux, grpcSrv
+	return mux, grpcSrv, []Worker{rotationScheduler, secretWatcher, webhookDispatcher, wrappedSecretsPurger, expiredGrantsPurger, accessStatsRecorder}
 }
@@ -0,0 +1,170 @@
+// Package validation checks the values of secrets against the rules admins attach to path prefixes: a JSON Schema, a
+// regular expression, a maximum length, a PEM block type, or a valid X.509 certificate.
+package validation
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// Kinds of validation rules, along with the parameter each one takes
+const (
+	// KindJSONSchema takes a JSON Schema the value must be a JSON document valid against
+	KindJSONSchema = "json_schema"
+	// KindRegex takes a regular expression the value must match
+	KindRegex = "regex"
+	// KindMaxLength takes the maximum number of characters of the value
+	KindMaxLength = "max_length"
+	// KindPEMType takes the type of the PEM blocks the value must be made of, such as "CERTIFICATE"
+	KindPEMType = "pem_type"
+	// KindX509Certificate takes no parameter, the value must be a PEM encoded X.509 certificate currently valid
+	KindX509Certificate = "x509_certificate"
+)
+
+var Kinds = []string{KindJSONSchema, KindRegex, KindMaxLength, KindPEMType, KindX509Certificate}
+
+var ErrInvalidRule = errors.New("invalid validation rule")
+
+// Validator checks a value, returning why it is invalid
+type Validator func(value string) error
+
+// Compile returns the validator of a rule of the kind, failing with ErrInvalidRule when the parameter does not suit
+// the kind
+func Compile(kind string, parameter string) (Validator, error) {
+	switch kind {
+	case KindJSONSchema:
+		return compileJSONSchema(parameter)
+	case KindRegex:
+		pattern, err := regexp.Compile(parameter)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+		}
+		return func(value string) error {
+			if !pattern.MatchString(value) {
+				return fmt.Errorf("does not match the regular expression %s", parameter)
+			}
+			return nil
+		}, nil
+	case KindMaxLength:
+		maxLength, err := strconv.Atoi(parameter)
+		if err != nil || maxLength < 1 {
+			return nil, fmt.Errorf("%w: maximum length must be a positive integer", ErrInvalidRule)
+		}
+		return func(value string) error {
+			if length := utf8.RuneCountInString(value); length > maxLength {
+				return fmt.Errorf("is %d characters long, the maximum is %d", length, maxLength)
+			}
+			return nil
+		}, nil
+	case KindPEMType:
+		if parameter == "" {
+			return nil, fmt.Errorf("%w: PEM block type must not be empty", ErrInvalidRule)
+		}
+		return func(value string) error {
+			_, err := decodePEM(value, parameter)
+			return err
+		}, nil
+	case KindX509Certificate:
+		if parameter != "" {
+			return nil, fmt.Errorf("%w: %s rules take no parameter", ErrInvalidRule, KindX509Certificate)
+		}
+		return validateCertificate, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalidRule, kind)
+	}
+}
+
+func compileJSONSchema(parameter string) (Validator, error) {
+	compiler := jsonschema.NewCompiler()
+	// Schemas are self-contained, referencing files or URLs would let them read the filesystem of the server
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("external reference %s is not allowed", url)
+	}
+	if err := compiler.AddResource("rule.json", strings.NewReader(parameter)); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+	schema, err := compiler.Compile("rule.json")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRule, err)
+	}
+
+	return func(value string) error {
+		decoder := json.NewDecoder(strings.NewReader(value))
+		decoder.UseNumber()
+		var document any
+		if err := decoder.Decode(&document); err != nil {
+			return fmt.Errorf("is not valid JSON: %v", err)
+		}
+		if decoder.More() {
+			return errors.New("is not valid JSON: unexpected data after the document")
+		}
+
+		err := schema.Validate(document)
+		var validationErr *jsonschema.ValidationError
+		if errors.As(err, &validationErr) {
+			// The first leaf is the most precise reason
+			for len(validationErr.Causes) > 0 {
+				validationErr = validationErr.Causes[0]
+			}
+			location := validationErr.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			return fmt.Errorf("does not match the JSON schema at %s: %s", location, validationErr.Message)
+		}
+		return err
+	}, nil
+}
+
+// decodePEM returns the PEM blocks the value is made of, all of them having the block type
+func decodePEM(value string, blockType string) ([]*pem.Block, error) {
+	var blocks []*pem.Block
+	rest := []byte(strings.TrimSpace(value))
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("is not PEM encoded")
+		}
+		if block.Type != blockType {
+			return nil, fmt.Errorf("holds a PEM block of type %s instead of %s", block.Type, blockType)
+		}
+		blocks = append(blocks, block)
+		rest = []byte(strings.TrimSpace(string(rest)))
+	}
+	if len(blocks) == 0 {
+		return nil, errors.New("is not PEM encoded")
+	}
+	return blocks, nil
+}
+
+func validateCertificate(value string) error {
+	blocks, err := decodePEM(value, "CERTIFICATE")
+	if err != nil {
+		return err
+	}
+	certificate, err := x509.ParseCertificate(blocks[0].Bytes)
+	if err != nil {
+		return fmt.Errorf("is not a valid X.509 certificate: %v", err)
+	}
+
+	now := time.Now()
+	if now.After(certificate.NotAfter) {
+		return fmt.Errorf("is a certificate expired since %s", certificate.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if now.Before(certificate.NotBefore) {
+		return fmt.Errorf("is a certificate not valid before %s", certificate.NotBefore.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
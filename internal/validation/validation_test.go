@@ -0,0 +1,216 @@
+package validation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func certificatePEM(t *testing.T, notBefore time.Time, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fishykeys.test"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name              string
+		kind              string
+		parameter         string
+		expectedErrorText string
+	}{
+		{
+			name:              "unknown kind",
+			kind:              "checksum",
+			expectedErrorText: `invalid validation rule: unknown kind "checksum"`,
+		},
+		{
+			name:              "invalid regex",
+			kind:              KindRegex,
+			parameter:         "^(sk_",
+			expectedErrorText: "invalid validation rule: error parsing regexp: missing closing ): `^(sk_`",
+		},
+		{
+			name:              "negative maximum length",
+			kind:              KindMaxLength,
+			parameter:         "-3",
+			expectedErrorText: "invalid validation rule: maximum length must be a positive integer",
+		},
+		{
+			name:              "empty PEM type",
+			kind:              KindPEMType,
+			expectedErrorText: "invalid validation rule: PEM block type must not be empty",
+		},
+		{
+			name:              "certificate rule with a parameter",
+			kind:              KindX509Certificate,
+			parameter:         "CERTIFICATE",
+			expectedErrorText: "invalid validation rule: x509_certificate rules take no parameter",
+		},
+		{
+			name:      "valid JSON schema",
+			kind:      KindJSONSchema,
+			parameter: `{"type": "object"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.kind, tt.parameter)
+			if tt.expectedErrorText != "" {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	t.Run("JSON schemas cannot reference external documents", func(t *testing.T) {
+		_, err := Compile(KindJSONSchema, `{"$ref": "file:///etc/passwd"}`)
+		assert.ErrorIs(t, err, ErrInvalidRule)
+	})
+}
+
+func TestValidator(t *testing.T) {
+	now := time.Now()
+	validCertificate := certificatePEM(t, now.Add(-time.Hour), now.Add(24*time.Hour))
+	expiredCertificate := certificatePEM(t, now.Add(-48*time.Hour), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	schema := `{
+		"type": "object",
+		"properties": {"host": {"type": "string"}, "port": {"type": "integer"}},
+		"required": ["host", "port"]
+	}`
+
+	tests := []struct {
+		name              string
+		kind              string
+		parameter         string
+		value             string
+		expectedErrorText string
+	}{
+		{
+			name:      "JSON matching the schema",
+			kind:      KindJSONSchema,
+			parameter: schema,
+			value:     `{"host": "db.internal", "port": 5432}`,
+		},
+		{
+			name:              "JSON with a property of the wrong type",
+			kind:              KindJSONSchema,
+			parameter:         schema,
+			value:             `{"host": "db.internal", "port": "5432"}`,
+			expectedErrorText: "does not match the JSON schema at /port: expected integer, but got string",
+		},
+		{
+			name:              "JSON missing a property",
+			kind:              KindJSONSchema,
+			parameter:         schema,
+			value:             `{"host": "db.internal"}`,
+			expectedErrorText: "does not match the JSON schema at /: missing properties: 'port'",
+		},
+		{
+			name:              "truncated JSON",
+			kind:              KindJSONSchema,
+			parameter:         schema,
+			value:             `{"host": "db.internal", "port": 54`,
+			expectedErrorText: "is not valid JSON: unexpected EOF",
+		},
+		{
+			name:      "matching regex",
+			kind:      KindRegex,
+			parameter: "^sk_live_[a-zA-Z0-9]{8}$",
+			value:     "sk_live_abcd1234",
+		},
+		{
+			name:              "regex not matching",
+			kind:              KindRegex,
+			parameter:         "^sk_live_[a-zA-Z0-9]{8}$",
+			value:             "sk_test_abcd1234",
+			expectedErrorText: "does not match the regular expression ^sk_live_[a-zA-Z0-9]{8}$",
+		},
+		{
+			name:      "value at the maximum length",
+			kind:      KindMaxLength,
+			parameter: "5",
+			value:     "héllo",
+		},
+		{
+			name:              "value too long",
+			kind:              KindMaxLength,
+			parameter:         "5",
+			value:             "hello!",
+			expectedErrorText: "is 6 characters long, the maximum is 5",
+		},
+		{
+			name:      "PEM of the type",
+			kind:      KindPEMType,
+			parameter: "CERTIFICATE",
+			value:     validCertificate + validCertificate,
+		},
+		{
+			name:              "PEM of another type",
+			kind:              KindPEMType,
+			parameter:         "PRIVATE KEY",
+			value:             validCertificate,
+			expectedErrorText: "holds a PEM block of type CERTIFICATE instead of PRIVATE KEY",
+		},
+		{
+			name:              "truncated PEM",
+			kind:              KindPEMType,
+			parameter:         "CERTIFICATE",
+			value:             validCertificate[:len(validCertificate)/2],
+			expectedErrorText: "is not PEM encoded",
+		},
+		{
+			name:  "valid certificate",
+			kind:  KindX509Certificate,
+			value: validCertificate,
+		},
+		{
+			name:              "expired certificate",
+			kind:              KindX509Certificate,
+			value:             expiredCertificate,
+			expectedErrorText: "is a certificate expired since 2020-01-01T00:00:00Z",
+		},
+		{
+			name:              "garbage certificate",
+			kind:              KindX509Certificate,
+			value:             "-----BEGIN CERTIFICATE-----\nZmlzaHk=\n-----END CERTIFICATE-----\n",
+			expectedErrorText: "is not a valid X.509 certificate: x509: malformed certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validator, err := Compile(tt.kind, tt.parameter)
+			require.NoError(t, err)
+
+			err = validator(tt.value)
+			if tt.expectedErrorText != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
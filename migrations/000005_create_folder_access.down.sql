@@ -0,0 +1 @@
+DROP TABLE IF EXISTS folder_access;
\ No newline at end of file
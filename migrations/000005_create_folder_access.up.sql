@@ -0,0 +1,15 @@
+CREATE TABLE folder_access
+(
+    id          SERIAL PRIMARY KEY,
+    path_prefix VARCHAR(255) NOT NULL,
+    user_id     INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    role_id     INTEGER REFERENCES roles (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    CHECK (
+        (user_id IS NOT NULL AND role_id IS NULL) OR
+        (user_id IS NULL AND role_id IS NOT NULL)
+        )
+);
+
+CREATE UNIQUE INDEX folder_access_user_idx ON folder_access (path_prefix, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX folder_access_role_idx ON folder_access (path_prefix, role_id) WHERE role_id IS NOT NULL;
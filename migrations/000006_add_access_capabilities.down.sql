@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS secrets_access_role_idx;
+DROP INDEX IF EXISTS secrets_access_user_idx;
+
+ALTER TABLE folder_access
+    DROP COLUMN IF EXISTS capabilities;
+ALTER TABLE secrets_access
+    DROP COLUMN IF EXISTS capabilities;
\ No newline at end of file
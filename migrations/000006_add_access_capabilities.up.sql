@@ -0,0 +1,19 @@
+ALTER TABLE secrets_access
+    ADD COLUMN capabilities TEXT[] NOT NULL DEFAULT ARRAY ['read', 'list'];
+ALTER TABLE folder_access
+    ADD COLUMN capabilities TEXT[] NOT NULL DEFAULT ARRAY ['read', 'list'];
+
+-- Admins could always update the secrets they were granted
+UPDATE secrets_access
+SET capabilities = ARRAY ['read', 'list', 'update', 'delete', 'manage_access']
+WHERE role_id IN (SELECT id FROM roles WHERE admin);
+
+-- Grants are now updated in place, so there must be at most one per grantee
+DELETE
+FROM secrets_access a USING secrets_access b
+WHERE a.id > b.id
+  AND a.secret_id = b.secret_id
+  AND (a.user_id = b.user_id OR a.role_id = b.role_id);
+
+CREATE UNIQUE INDEX secrets_access_user_idx ON secrets_access (secret_id, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX secrets_access_role_idx ON secrets_access (secret_id, role_id) WHERE role_id IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS policy_attachments;
+DROP TABLE IF EXISTS policy_rules;
+DROP TABLE IF EXISTS policies;
\ No newline at end of file
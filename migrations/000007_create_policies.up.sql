@@ -0,0 +1,37 @@
+CREATE TABLE policies
+(
+    id         SERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL UNIQUE,
+    format     VARCHAR(16)  NOT NULL,
+    document   TEXT         NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Rules parsed from the policy documents, so that access checks can be evaluated by the database
+CREATE TABLE policy_rules
+(
+    id           SERIAL PRIMARY KEY,
+    policy_id    INTEGER      NOT NULL REFERENCES policies (id) ON DELETE CASCADE,
+    path_glob    VARCHAR(255) NOT NULL,
+    pattern      TEXT         NOT NULL,
+    effect       VARCHAR(8)   NOT NULL CHECK (effect IN ('allow', 'deny')),
+    capabilities TEXT[]       NOT NULL
+);
+
+CREATE INDEX policy_rules_policy_idx ON policy_rules (policy_id);
+
+CREATE TABLE policy_attachments
+(
+    id        SERIAL PRIMARY KEY,
+    policy_id INTEGER NOT NULL REFERENCES policies (id) ON DELETE CASCADE,
+    user_id   INTEGER REFERENCES users (id) ON DELETE CASCADE,
+    role_id   INTEGER REFERENCES roles (id) ON DELETE CASCADE,
+    CHECK (
+        (user_id IS NOT NULL AND role_id IS NULL) OR
+        (user_id IS NULL AND role_id IS NOT NULL)
+        )
+);
+
+CREATE UNIQUE INDEX policy_attachments_user_idx ON policy_attachments (policy_id, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX policy_attachments_role_idx ON policy_attachments (policy_id, role_id) WHERE role_id IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS secret_fields;
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS structured;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- Structured secrets hold named fields instead of a single value, each field is encrypted with the key of the secret
+ALTER TABLE secrets
+    ADD COLUMN structured BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE secret_fields
+(
+    secret_id       INTEGER      NOT NULL REFERENCES secrets (id) ON DELETE CASCADE,
+    name            VARCHAR(255) NOT NULL,
+    encrypted_value TEXT         NOT NULL,
+    PRIMARY KEY (secret_id, name)
+);
@@ -0,0 +1,3 @@
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS content_type,
+    DROP COLUMN IF EXISTS filename;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- Binary secrets have a content type and the name of the file they were uploaded from
+ALTER TABLE secrets
+    ADD COLUMN content_type VARCHAR(255),
+    ADD COLUMN filename     VARCHAR(255);
@@ -0,0 +1,5 @@
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS generation_policy_id,
+    DROP COLUMN IF EXISTS version;
+
+DROP TABLE IF EXISTS generation_policies;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE generation_policies
+(
+    id         SERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL UNIQUE,
+    kind       VARCHAR(16)  NOT NULL CHECK (kind IN ('password', 'passphrase', 'bytes', 'uuid', 'rsa', 'ed25519')),
+    parameters JSONB        NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every change of a secret's value increments its version, generated secrets remember the policy used to regenerate them
+ALTER TABLE secrets
+    ADD COLUMN version              INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN generation_policy_id INTEGER REFERENCES generation_policies (id) ON DELETE SET NULL;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS secret_rotations;
+
+DROP INDEX IF EXISTS secrets_next_rotation_at_idx;
+
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS next_rotation_at,
+    DROP COLUMN IF EXISTS rotation_schedule;
\ No newline at end of file
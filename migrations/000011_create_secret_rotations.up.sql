@@ -0,0 +1,19 @@
+-- Generated secrets can be rotated on a schedule, next_rotation_at is the time the next rotation is due
+ALTER TABLE secrets
+    ADD COLUMN rotation_schedule VARCHAR(255),
+    ADD COLUMN next_rotation_at  TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX secrets_next_rotation_at_idx ON secrets (next_rotation_at) WHERE next_rotation_at IS NOT NULL;
+
+-- History of the regenerations of secrets, scheduled or requested by a user
+CREATE TABLE secret_rotations
+(
+    id         SERIAL PRIMARY KEY,
+    secret_id  INTEGER NOT NULL REFERENCES secrets (id) ON DELETE CASCADE,
+    version    INTEGER NOT NULL,
+    scheduled  BOOLEAN NOT NULL,
+    error      TEXT,
+    rotated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX secret_rotations_secret_idx ON secret_rotations (secret_id, rotated_at);
@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS folder_access_events ON folder_access;
+DROP TRIGGER IF EXISTS secrets_access_events ON secrets_access;
+DROP TRIGGER IF EXISTS secrets_events ON secrets;
+
+DROP FUNCTION IF EXISTS folder_access_event_trigger();
+DROP FUNCTION IF EXISTS secrets_access_event_trigger();
+DROP FUNCTION IF EXISTS secrets_event_trigger();
+DROP FUNCTION IF EXISTS record_secret_event(TEXT, secrets);
+
+DROP TABLE IF EXISTS secret_events;
\ No newline at end of file
@@ -0,0 +1,112 @@
+-- Log of the changes of secrets, read by watchers from the id they resume after. Rows are kept after the secret is
+-- deleted so its deletion can still be delivered.
+CREATE TABLE secret_events
+(
+    id            BIGSERIAL PRIMARY KEY,
+    type          VARCHAR(32)  NOT NULL,
+    secret_id     INTEGER      NOT NULL,
+    path          VARCHAR(255) NOT NULL,
+    owner_user_id INTEGER,
+    version       INTEGER      NOT NULL,
+    created_at    TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX secret_events_secret_idx ON secret_events (secret_id);
+CREATE INDEX secret_events_created_at_idx ON secret_events (created_at);
+
+-- Events are recorded by deferred triggers, so when the transaction commits, and under a lock held until the end of
+-- the transaction: ids become visible in increasing order and a watcher never skips an event committed after the one
+-- it resumes from. The lock is the last one taken by the transaction, so it can't be part of a deadlock.
+CREATE FUNCTION record_secret_event(event_type TEXT, secret secrets) RETURNS VOID AS
+$$
+DECLARE
+    event_id BIGINT;
+BEGIN
+    -- Secrets are moved through temporary paths
+    IF NOT starts_with(secret.path, '/') THEN
+        RETURN;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('secret_events'));
+
+    -- Access changes made along with another change of the secret are part of that event
+    IF event_type = 'secret.access_changed' AND EXISTS (
+        SELECT 1 FROM secret_events WHERE secret_id = secret.id AND xmin = pg_current_xact_id()::xid
+    ) THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO secret_events (type, secret_id, path, owner_user_id, version)
+    VALUES (event_type, secret.id, secret.path, secret.owner_user_id, secret.version)
+    RETURNING id INTO event_id;
+    PERFORM pg_notify('secret_events', event_id::TEXT);
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION secrets_event_trigger() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM record_secret_event('secret.created', NEW);
+    ELSIF TG_OP = 'DELETE' THEN
+        PERFORM record_secret_event('secret.deleted', OLD);
+    ELSIF OLD.path <> NEW.path THEN
+        PERFORM record_secret_event('secret.deleted', OLD);
+        PERFORM record_secret_event('secret.created', NEW);
+    ELSIF OLD.version <> NEW.version THEN
+        PERFORM record_secret_event('secret.updated', NEW);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION secrets_access_event_trigger() RETURNS TRIGGER AS
+$$
+DECLARE
+    secret secrets;
+BEGIN
+    -- Grants are deleted along with their secret
+    SELECT * INTO secret FROM secrets WHERE id = COALESCE(NEW.secret_id, OLD.secret_id);
+    IF FOUND THEN
+        PERFORM record_secret_event('secret.access_changed', secret);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION folder_access_event_trigger() RETURNS TRIGGER AS
+$$
+DECLARE
+    secret secrets;
+BEGIN
+    FOR secret IN
+        SELECT * FROM secrets
+        WHERE starts_with(path, NEW.path_prefix) OR starts_with(path, OLD.path_prefix)
+        ORDER BY id
+    LOOP
+        PERFORM record_secret_event('secret.access_changed', secret);
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER secrets_events
+    AFTER INSERT OR UPDATE OR DELETE
+    ON secrets
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION secrets_event_trigger();
+
+CREATE CONSTRAINT TRIGGER secrets_access_events
+    AFTER INSERT OR UPDATE OR DELETE
+    ON secrets_access
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION secrets_access_event_trigger();
+
+CREATE CONSTRAINT TRIGGER folder_access_events
+    AFTER INSERT OR UPDATE OR DELETE
+    ON folder_access
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION folder_access_event_trigger();
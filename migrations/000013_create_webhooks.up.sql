@@ -0,0 +1,34 @@
+-- Endpoints notified of the events matching their filters, the signing secret is encrypted with the master key
+CREATE TABLE webhooks
+(
+    id               SERIAL PRIMARY KEY,
+    name             VARCHAR(255) NOT NULL UNIQUE,
+    url              TEXT         NOT NULL,
+    events           TEXT[]       NOT NULL,
+    path_prefix      VARCHAR(255),
+    encrypted_secret TEXT         NOT NULL,
+    enabled          BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at       TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Delivery log, pending deliveries are attempted once next_attempt_at is reached
+CREATE TABLE webhook_deliveries
+(
+    id              SERIAL PRIMARY KEY,
+    webhook_id      INTEGER     NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event_id        VARCHAR(32) NOT NULL,
+    event_type      VARCHAR(64) NOT NULL,
+    payload         TEXT        NOT NULL,
+    status          VARCHAR(16) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'failed')),
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    last_attempt_at TIMESTAMP WITH TIME ZONE,
+    response_status INTEGER,
+    error           TEXT,
+    replay_of       INTEGER REFERENCES webhook_deliveries (id) ON DELETE SET NULL,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, id);
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
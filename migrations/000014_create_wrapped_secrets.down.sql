@@ -0,0 +1 @@
+DROP TABLE IF EXISTS wrapped_secrets;
\ No newline at end of file
@@ -0,0 +1,16 @@
+-- Values handed off with a single-use token. Only the hash of the token is stored, the value is destroyed once
+-- unwrapped or expired, and the row is kept a while longer to report a reused token.
+CREATE TABLE wrapped_secrets
+(
+    id              SERIAL PRIMARY KEY,
+    token_hash      VARCHAR(64)              NOT NULL UNIQUE,
+    encrypted_value TEXT,
+    structured      BOOLEAN                  NOT NULL DEFAULT FALSE,
+    source_path     VARCHAR(255),
+    created_by      INTEGER                  REFERENCES users (id) ON DELETE SET NULL,
+    expires_at      TIMESTAMP WITH TIME ZONE NOT NULL,
+    unwrapped_at    TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX wrapped_secrets_expires_at_idx ON wrapped_secrets (expires_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS secrets_access_expires_at_idx;
+
+ALTER TABLE secrets_access
+    DROP COLUMN IF EXISTS expires_at;
\ No newline at end of file
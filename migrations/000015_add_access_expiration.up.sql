@@ -0,0 +1,5 @@
+-- Grants without an expiration last until revoked, expired ones are ignored until the cleanup job deletes them
+ALTER TABLE secrets_access
+    ADD COLUMN expires_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX secrets_access_expires_at_idx ON secrets_access (expires_at) WHERE expires_at IS NOT NULL;
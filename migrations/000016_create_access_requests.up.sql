@@ -0,0 +1,22 @@
+-- Requests of users for capabilities on a secret, kept once decided for audit. The path is kept in case the secret is
+-- deleted.
+CREATE TABLE access_requests
+(
+    id               SERIAL PRIMARY KEY,
+    secret_id        INTEGER                  REFERENCES secrets (id) ON DELETE SET NULL,
+    path             VARCHAR(255)             NOT NULL,
+    requester_id     INTEGER                  REFERENCES users (id) ON DELETE SET NULL,
+    capabilities     TEXT[]                   NOT NULL,
+    justification    TEXT                     NOT NULL,
+    duration_seconds INTEGER,
+    status           VARCHAR(16)              NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    decided_by       INTEGER                  REFERENCES users (id) ON DELETE SET NULL,
+    decision_comment TEXT,
+    decided_at       TIMESTAMP WITH TIME ZONE,
+    grant_expires_at TIMESTAMP WITH TIME ZONE,
+    created_at       TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- A user has at most one pending request per secret
+CREATE UNIQUE INDEX access_requests_pending_idx ON access_requests (secret_id, requester_id) WHERE status = 'pending';
+CREATE INDEX access_requests_status_idx ON access_requests (status, id);
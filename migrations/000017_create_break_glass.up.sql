@@ -0,0 +1,29 @@
+-- Folders whose secrets can be read in an emergency by anyone, or only by the members of the role when set
+CREATE TABLE break_glass_rules
+(
+    id          SERIAL PRIMARY KEY,
+    path_prefix VARCHAR(255) NOT NULL,
+    role_id     INTEGER REFERENCES roles (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX break_glass_rules_role_idx ON break_glass_rules (path_prefix, role_id) WHERE role_id IS NOT NULL;
+CREATE UNIQUE INDEX break_glass_rules_anyone_idx ON break_glass_rules (path_prefix) WHERE role_id IS NULL;
+
+-- Emergency accesses, each giving the user read access to the secret until expires_at and awaiting a review
+CREATE TABLE break_glass_accesses
+(
+    id             SERIAL PRIMARY KEY,
+    secret_id      INTEGER                  REFERENCES secrets (id) ON DELETE SET NULL,
+    path           VARCHAR(255)             NOT NULL,
+    user_id        INTEGER                  REFERENCES users (id) ON DELETE SET NULL,
+    justification  TEXT                     NOT NULL,
+    expires_at     TIMESTAMP WITH TIME ZONE NOT NULL,
+    reviewed_by    INTEGER                  REFERENCES users (id) ON DELETE SET NULL,
+    reviewed_at    TIMESTAMP WITH TIME ZONE,
+    review_comment TEXT,
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX break_glass_accesses_user_idx ON break_glass_accesses (user_id, secret_id, expires_at);
+CREATE INDEX break_glass_accesses_unreviewed_idx ON break_glass_accesses (id) WHERE reviewed_at IS NULL;
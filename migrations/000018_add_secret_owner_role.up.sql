@@ -0,0 +1,56 @@
+-- Secrets can be owned by a role, whose members then hold every capability on them, instead of a user
+ALTER TABLE secrets
+    ADD COLUMN owner_role_id INTEGER REFERENCES roles (id);
+ALTER TABLE secrets
+    ADD CONSTRAINT secrets_single_owner CHECK (owner_user_id IS NULL OR owner_role_id IS NULL);
+CREATE INDEX secrets_owner_user_id_idx ON secrets (owner_user_id);
+CREATE INDEX secrets_owner_role_id_idx ON secrets (owner_role_id);
+
+ALTER TABLE secret_events
+    ADD COLUMN owner_role_id INTEGER;
+
+CREATE OR REPLACE FUNCTION record_secret_event(event_type TEXT, secret secrets) RETURNS VOID AS
+$$
+DECLARE
+    event_id BIGINT;
+BEGIN
+    -- Secrets are moved through temporary paths
+    IF NOT starts_with(secret.path, '/') THEN
+        RETURN;
+    END IF;
+
+    PERFORM pg_advisory_xact_lock(hashtext('secret_events'));
+
+    -- Access changes made along with another change of the secret are part of that event
+    IF event_type = 'secret.access_changed' AND EXISTS (
+        SELECT 1 FROM secret_events WHERE secret_id = secret.id AND xmin = pg_current_xact_id()::xid
+    ) THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO secret_events (type, secret_id, path, owner_user_id, owner_role_id, version)
+    VALUES (event_type, secret.id, secret.path, secret.owner_user_id, secret.owner_role_id, secret.version)
+    RETURNING id INTO event_id;
+    PERFORM pg_notify('secret_events', event_id::TEXT);
+END;
+$$ LANGUAGE plpgsql;
+
+-- A change of owner is a change of access
+CREATE OR REPLACE FUNCTION secrets_event_trigger() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM record_secret_event('secret.created', NEW);
+    ELSIF TG_OP = 'DELETE' THEN
+        PERFORM record_secret_event('secret.deleted', OLD);
+    ELSIF OLD.path <> NEW.path THEN
+        PERFORM record_secret_event('secret.deleted', OLD);
+        PERFORM record_secret_event('secret.created', NEW);
+    ELSIF OLD.version <> NEW.version THEN
+        PERFORM record_secret_event('secret.updated', NEW);
+    ELSIF OLD.owner_user_id IS DISTINCT FROM NEW.owner_user_id OR OLD.owner_role_id IS DISTINCT FROM NEW.owner_role_id THEN
+        PERFORM record_secret_event('secret.access_changed', NEW);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS secret_daily_reads;
+DROP TABLE IF EXISTS secret_access_stats;
\ No newline at end of file
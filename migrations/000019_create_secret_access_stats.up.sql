@@ -0,0 +1,18 @@
+-- Last read of each secret, rows are written in batches by the server
+CREATE TABLE secret_access_stats
+(
+    secret_id      INTEGER PRIMARY KEY REFERENCES secrets (id) ON DELETE CASCADE,
+    last_read_at   TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_reader_id INTEGER                  REFERENCES users (id) ON DELETE SET NULL
+);
+
+-- Number of reads of each secret per day, summed over the rolling window and purged past it
+CREATE TABLE secret_daily_reads
+(
+    secret_id  INTEGER NOT NULL REFERENCES secrets (id) ON DELETE CASCADE,
+    day        DATE    NOT NULL,
+    read_count INTEGER NOT NULL,
+    PRIMARY KEY (secret_id, day)
+);
+
+CREATE INDEX secret_daily_reads_day_idx ON secret_daily_reads (day);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS secrets_canary_idx;
+ALTER TABLE secrets
+    DROP COLUMN IF EXISTS canary;
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- Canary secrets are planted for no legitimate workload to read, reading one raises an alert
+ALTER TABLE secrets
+    ADD COLUMN canary BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX secrets_canary_idx ON secrets (path) WHERE canary;
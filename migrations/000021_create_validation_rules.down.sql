@@ -0,0 +1 @@
+DROP TABLE IF EXISTS validation_rules;
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- Rules the values of the secrets under a folder must follow, applied to every field of structured secrets or only
+-- to the field when set
+CREATE TABLE validation_rules
+(
+    id          SERIAL PRIMARY KEY,
+    path_prefix VARCHAR(255) NOT NULL,
+    kind        VARCHAR(32)  NOT NULL CHECK (kind IN ('json_schema', 'regex', 'max_length', 'pem_type', 'x509_certificate')),
+    parameter   TEXT         NOT NULL DEFAULT '',
+    field       VARCHAR(255),
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX validation_rules_path_prefix_idx ON validation_rules (path_prefix);
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS policy_attachments_events ON policy_attachments;
+DROP TRIGGER IF EXISTS policy_rules_events ON policy_rules;
+
+DROP FUNCTION IF EXISTS policy_attachments_event_trigger();
+DROP FUNCTION IF EXISTS policy_rules_event_trigger();
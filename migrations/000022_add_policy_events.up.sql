@@ -0,0 +1,51 @@
+-- Policy rules and attachments change the access to the secrets matched by the rules, watchers get an access change
+-- event for each of them
+CREATE FUNCTION policy_rules_event_trigger() RETURNS TRIGGER AS
+$$
+DECLARE
+    secret secrets;
+BEGIN
+    FOR secret IN
+        SELECT * FROM secrets
+        WHERE path ~ NEW.pattern OR path ~ OLD.pattern
+        ORDER BY id
+    LOOP
+        PERFORM record_secret_event('secret.access_changed', secret);
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+-- The rules of a deleted policy are deleted along with its attachments, they record the events themselves
+CREATE FUNCTION policy_attachments_event_trigger() RETURNS TRIGGER AS
+$$
+DECLARE
+    secret secrets;
+BEGIN
+    FOR secret IN
+        SELECT * FROM secrets s
+        WHERE EXISTS (
+            SELECT 1 FROM policy_rules pr
+            WHERE pr.policy_id IN (NEW.policy_id, OLD.policy_id) AND s.path ~ pr.pattern
+        )
+        ORDER BY id
+    LOOP
+        PERFORM record_secret_event('secret.access_changed', secret);
+    END LOOP;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER policy_rules_events
+    AFTER INSERT OR UPDATE OR DELETE
+    ON policy_rules
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION policy_rules_event_trigger();
+
+CREATE CONSTRAINT TRIGGER policy_attachments_events
+    AFTER INSERT OR UPDATE OR DELETE
+    ON policy_attachments
+    DEFERRABLE INITIALLY DEFERRED
+    FOR EACH ROW
+EXECUTE FUNCTION policy_attachments_event_trigger();
@@ -45,6 +45,10 @@ type SecretKeyMapping struct {
 	// SecretPath is the path in the secret manager
 	SecretPath string `json:"secretPath"`
 
+	// SecretField is the field to fetch when the secret is structured
+	// +optional
+	SecretField string `json:"secretField,omitempty"`
+
 	// SecretKeyName is the field name in the resulting K8s Secret
 	SecretKeyName string `json:"secretKeyName"`
 }
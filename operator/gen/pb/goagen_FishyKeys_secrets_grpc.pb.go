@@ -26,7 +26,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	Secrets_BrowseSecrets_FullMethodName          = "/secrets.Secrets/BrowseSecrets"
 	Secrets_OperatorGetSecretValue_FullMethodName = "/secrets.Secrets/OperatorGetSecretValue"
+	Secrets_BatchGetSecretValues_FullMethodName   = "/secrets.Secrets/BatchGetSecretValues"
+	Secrets_RenderTemplate_FullMethodName         = "/secrets.Secrets/RenderTemplate"
+	Secrets_WatchSecrets_FullMethodName           = "/secrets.Secrets/WatchSecrets"
 )
 
 // SecretsClient is the client API for Secrets service.
@@ -35,8 +39,22 @@ const (
 //
 // User service manages user accounts and authentication
 type SecretsClient interface {
-	// Retrieve a secret value using GRPC
+	// List the folders and secrets you have access to under a path prefix
+	BrowseSecrets(ctx context.Context, in *BrowseSecretsRequest, opts ...grpc.CallOption) (*BrowseSecretsResponse, error)
+	// Retrieve a secret value using GRPC, or the fields of a structured secret
 	OperatorGetSecretValue(ctx context.Context, in *OperatorGetSecretValueRequest, opts ...grpc.CallOption) (*OperatorGetSecretValueResponse, error)
+	// Retrieve the values of several secrets at once. Each secret is returned with
+	// its value or the error preventing its read, in the order of the request
+	BatchGetSecretValues(ctx context.Context, in *BatchGetSecretValuesRequest, opts ...grpc.CallOption) (*BatchGetSecretValuesResponse, error)
+	// Render a Go text/template document reading secrets, given inline or stored
+	// in a secret. Templates read secrets with secret "/path", field "/path"
+	// "name" and fields "/path", and format values with b64enc, b64dec, json and
+	// env "NAME". You need read access to the template and to every secret it reads
+	RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error)
+	// Stream the changes of the secrets at the given paths or under the given
+	// prefix which you can list. Reconnecting with the resume token of the last
+	// event received delivers every event since
+	WatchSecrets(ctx context.Context, in *WatchSecretsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSecretsResponse], error)
 }
 
 type secretsClient struct {
@@ -47,6 +65,16 @@ func NewSecretsClient(cc grpc.ClientConnInterface) SecretsClient {
 	return &secretsClient{cc}
 }
 
+func (c *secretsClient) BrowseSecrets(ctx context.Context, in *BrowseSecretsRequest, opts ...grpc.CallOption) (*BrowseSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrowseSecretsResponse)
+	err := c.cc.Invoke(ctx, Secrets_BrowseSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *secretsClient) OperatorGetSecretValue(ctx context.Context, in *OperatorGetSecretValueRequest, opts ...grpc.CallOption) (*OperatorGetSecretValueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(OperatorGetSecretValueResponse)
@@ -57,14 +85,67 @@ func (c *secretsClient) OperatorGetSecretValue(ctx context.Context, in *Operator
 	return out, nil
 }
 
+func (c *secretsClient) BatchGetSecretValues(ctx context.Context, in *BatchGetSecretValuesRequest, opts ...grpc.CallOption) (*BatchGetSecretValuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetSecretValuesResponse)
+	err := c.cc.Invoke(ctx, Secrets_BatchGetSecretValues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RenderTemplate(ctx context.Context, in *RenderTemplateRequest, opts ...grpc.CallOption) (*RenderTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderTemplateResponse)
+	err := c.cc.Invoke(ctx, Secrets_RenderTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) WatchSecrets(ctx context.Context, in *WatchSecretsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchSecretsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Secrets_ServiceDesc.Streams[0], Secrets_WatchSecrets_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSecretsRequest, WatchSecretsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Secrets_WatchSecretsClient = grpc.ServerStreamingClient[WatchSecretsResponse]
+
 // SecretsServer is the server API for Secrets service.
 // All implementations must embed UnimplementedSecretsServer
 // for forward compatibility.
 //
 // User service manages user accounts and authentication
 type SecretsServer interface {
-	// Retrieve a secret value using GRPC
+	// List the folders and secrets you have access to under a path prefix
+	BrowseSecrets(context.Context, *BrowseSecretsRequest) (*BrowseSecretsResponse, error)
+	// Retrieve a secret value using GRPC, or the fields of a structured secret
 	OperatorGetSecretValue(context.Context, *OperatorGetSecretValueRequest) (*OperatorGetSecretValueResponse, error)
+	// Retrieve the values of several secrets at once. Each secret is returned with
+	// its value or the error preventing its read, in the order of the request
+	BatchGetSecretValues(context.Context, *BatchGetSecretValuesRequest) (*BatchGetSecretValuesResponse, error)
+	// Render a Go text/template document reading secrets, given inline or stored
+	// in a secret. Templates read secrets with secret "/path", field "/path"
+	// "name" and fields "/path", and format values with b64enc, b64dec, json and
+	// env "NAME". You need read access to the template and to every secret it reads
+	RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error)
+	// Stream the changes of the secrets at the given paths or under the given
+	// prefix which you can list. Reconnecting with the resume token of the last
+	// event received delivers every event since
+	WatchSecrets(*WatchSecretsRequest, grpc.ServerStreamingServer[WatchSecretsResponse]) error
 	mustEmbedUnimplementedSecretsServer()
 }
 
@@ -75,9 +156,21 @@ type SecretsServer interface {
 // pointer dereference when methods are called.
 type UnimplementedSecretsServer struct{}
 
+func (UnimplementedSecretsServer) BrowseSecrets(context.Context, *BrowseSecretsRequest) (*BrowseSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BrowseSecrets not implemented")
+}
 func (UnimplementedSecretsServer) OperatorGetSecretValue(context.Context, *OperatorGetSecretValueRequest) (*OperatorGetSecretValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OperatorGetSecretValue not implemented")
 }
+func (UnimplementedSecretsServer) BatchGetSecretValues(context.Context, *BatchGetSecretValuesRequest) (*BatchGetSecretValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSecretValues not implemented")
+}
+func (UnimplementedSecretsServer) RenderTemplate(context.Context, *RenderTemplateRequest) (*RenderTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderTemplate not implemented")
+}
+func (UnimplementedSecretsServer) WatchSecrets(*WatchSecretsRequest, grpc.ServerStreamingServer[WatchSecretsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSecrets not implemented")
+}
 func (UnimplementedSecretsServer) mustEmbedUnimplementedSecretsServer() {}
 func (UnimplementedSecretsServer) testEmbeddedByValue()                 {}
 
@@ -99,6 +192,24 @@ func RegisterSecretsServer(s grpc.ServiceRegistrar, srv SecretsServer) {
 	s.RegisterService(&Secrets_ServiceDesc, srv)
 }
 
+func _Secrets_BrowseSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).BrowseSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_BrowseSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).BrowseSecrets(ctx, req.(*BrowseSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Secrets_OperatorGetSecretValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(OperatorGetSecretValueRequest)
 	if err := dec(in); err != nil {
@@ -117,6 +228,53 @@ func _Secrets_OperatorGetSecretValue_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_BatchGetSecretValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetSecretValuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).BatchGetSecretValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_BatchGetSecretValues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).BatchGetSecretValues(ctx, req.(*BatchGetSecretValuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RenderTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RenderTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Secrets_RenderTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RenderTemplate(ctx, req.(*RenderTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_WatchSecrets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSecretsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SecretsServer).WatchSecrets(m, &grpc.GenericServerStream[WatchSecretsRequest, WatchSecretsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Secrets_WatchSecretsServer = grpc.ServerStreamingServer[WatchSecretsResponse]
+
 // Secrets_ServiceDesc is the grpc.ServiceDesc for Secrets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -124,11 +282,29 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "secrets.Secrets",
 	HandlerType: (*SecretsServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BrowseSecrets",
+			Handler:    _Secrets_BrowseSecrets_Handler,
+		},
 		{
 			MethodName: "OperatorGetSecretValue",
 			Handler:    _Secrets_OperatorGetSecretValue_Handler,
 		},
+		{
+			MethodName: "BatchGetSecretValues",
+			Handler:    _Secrets_BatchGetSecretValues_Handler,
+		},
+		{
+			MethodName: "RenderTemplate",
+			Handler:    _Secrets_RenderTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSecrets",
+			Handler:       _Secrets_WatchSecrets_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "goagen_FishyKeys_secrets.proto",
 }
@@ -126,15 +126,15 @@ func (r *FishySecretReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	grpcCtx = metadata.NewOutgoingContext(grpcCtx, metadata.Pairs("authorization", token))
 	secretsClient := pb.NewSecretsClient(conn)
 
-	for _, mapping := range fishySecret.Spec.Data {
-		value, err := fetchSecretValue(grpcCtx, secretsClient, mapping.SecretPath)
-		if err != nil {
-			log.Error(err, "failed to fetch from secret manager", "path", mapping.SecretPath)
-			setStatusCondition(&fishySecret, "Ready", metav1.ConditionFalse, "FetchError", err.Error())
-			_ = r.Status().Update(ctx, &fishySecret)
-			return ctrl.Result{}, err
-		}
-		secretData[mapping.SecretKeyName] = []byte(value)
+	values, err := fetchSecretValues(grpcCtx, secretsClient, fishySecret.Spec.Data)
+	if err != nil {
+		log.Error(err, "failed to fetch from secret manager")
+		setStatusCondition(&fishySecret, "Ready", metav1.ConditionFalse, "FetchError", err.Error())
+		_ = r.Status().Update(ctx, &fishySecret)
+		return ctrl.Result{}, err
+	}
+	for i, mapping := range fishySecret.Spec.Data {
+		secretData[mapping.SecretKeyName] = values[i]
 	}
 
 	desiredSecret := &corev1.Secret{
@@ -224,17 +224,45 @@ func setStatusCondition(f *fishykeysv1alpha1.FishySecret, conditionType string,
 	})
 }
 
-func fetchSecretValue(ctx context.Context, secretsClient pb.SecretsClient, path string) (string, error) {
-	encodedPath := base64.StdEncoding.EncodeToString([]byte(path))
+// fetchSecretValues reads the values of every mapping in a single request, in the order of the mappings
+func fetchSecretValues(ctx context.Context, secretsClient pb.SecretsClient, mappings []fishykeysv1alpha1.SecretKeyMapping) ([][]byte, error) {
+	if len(mappings) == 0 {
+		return nil, nil
+	}
 
-	resp, err := secretsClient.OperatorGetSecretValue(ctx, &pb.OperatorGetSecretValueRequest{
-		Path: encodedPath,
-	})
+	request := &pb.BatchGetSecretValuesRequest{}
+	for _, mapping := range mappings {
+		secretRequest := &pb.SecretValueRequest{
+			Path: base64.StdEncoding.EncodeToString([]byte(mapping.SecretPath)),
+		}
+		if mapping.SecretField != "" {
+			field := mapping.SecretField
+			secretRequest.Field = &field
+		}
+		request.Secrets = append(request.Secrets, secretRequest)
+	}
+
+	resp, err := secretsClient.BatchGetSecretValues(ctx, request)
 	if err != nil {
-		return "", fmt.Errorf("failed to get secret value: %w", err)
+		return nil, fmt.Errorf("failed to get secret values: %w", err)
+	}
+	if len(resp.Secrets) != len(mappings) {
+		return nil, fmt.Errorf("expected %d secret values, got %d", len(mappings), len(resp.Secrets))
 	}
 
-	return resp.GetValue(), nil
+	values := make([][]byte, len(mappings))
+	for i, secret := range resp.Secrets {
+		if secret.Error != nil {
+			return nil, fmt.Errorf("failed to get secret value of %s: %s", mappings[i].SecretPath, secret.GetErrorMessage())
+		}
+		// Binary secrets are only returned as raw bytes
+		if secret.RawValue != nil {
+			values[i] = secret.RawValue
+		} else {
+			values[i] = []byte(secret.GetValue())
+		}
+	}
+	return values, nil
 }
 
 func getFishyKeysUrlAndToken(ctx context.Context, r *FishySecretReconciler) (string, string, error) {
@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrAccessRequestNotFound = errors.New("access request not found")
+	ErrAccessRequestPending  = errors.New("access request already pending")
+	ErrAccessRequestDecided  = errors.New("access request already decided")
+)
+
+// Statuses of an access request
+const (
+	AccessRequestPending  = "pending"
+	AccessRequestApproved = "approved"
+	AccessRequestDenied   = "denied"
+)
+
+// AccessRequest is the request of a user for capabilities on a secret, for Duration seconds when set. SecretID is nil
+// once the secret is deleted.
+type AccessRequest struct {
+	ID             int
+	SecretID       *int
+	Path           string
+	RequesterID    *int
+	Capabilities   []string
+	Justification  string
+	Duration       *int
+	Status         string
+	DecidedBy      *int
+	Comment        *string
+	DecidedAt      *time.Time
+	GrantExpiresAt *time.Time
+	CreatedAt      time.Time
+}
+
+type AccessRequestsRepository interface {
+	// CreateAccessRequest files a pending request on the secret at the path of the request, returning
+	// ErrAccessRequestPending when the requester already has one on the secret
+	CreateAccessRequest(ctx context.Context, request AccessRequest) (*AccessRequest, error)
+	GetAccessRequest(ctx context.Context, id int) (*AccessRequest, error)
+	// ListAccessRequests lists every request, most recent first, only the ones with the status when set
+	ListAccessRequests(ctx context.Context, status *string) ([]AccessRequest, error)
+	// ListAccessRequestsForUser lists the requests filed by the user along with the ones on the secrets the user or
+	// one of the roles can manage the access of
+	ListAccessRequestsForUser(ctx context.Context, userID int, roleIDs []int, status *string) ([]AccessRequest, error)
+	// ApproveAccessRequest approves a pending request and grants its capabilities to the requester, in addition to
+	// the ones of an existing grant, until now plus its duration. An active grant is never shortened: a permanent one
+	// stays permanent and a temporary one keeps the later expiration.
+	ApproveAccessRequest(ctx context.Context, id int, deciderID int, comment *string, now time.Time) (*AccessRequest, error)
+	DenyAccessRequest(ctx context.Context, id int, deciderID int, comment *string, now time.Time) (*AccessRequest, error)
+}
+
+type accessRequestsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAccessRequestsRepository(pool *pgxpool.Pool) AccessRequestsRepository {
+	return &accessRequestsRepository{pool: pool}
+}
+
+const accessRequestColumns = `ar.id, ar.secret_id, COALESCE(s.path, ar.path), ar.requester_id, ar.capabilities, ar.justification,
+    ar.duration_seconds, ar.status, ar.decided_by, ar.decision_comment, ar.decided_at, ar.grant_expires_at, ar.created_at`
+
+func scanAccessRequest(row pgx.Row) (*AccessRequest, error) {
+	var request AccessRequest
+	err := row.Scan(&request.ID, &request.SecretID, &request.Path, &request.RequesterID, &request.Capabilities,
+		&request.Justification, &request.Duration, &request.Status, &request.DecidedBy, &request.Comment,
+		&request.DecidedAt, &request.GrantExpiresAt, &request.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+func (r *accessRequestsRepository) CreateAccessRequest(ctx context.Context, request AccessRequest) (*AccessRequest, error) {
+	var secretID int
+	err := r.pool.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1`, request.Path).Scan(&secretID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, err
+	}
+
+	query := `
+INSERT INTO access_requests (secret_id, path, requester_id, capabilities, justification, duration_seconds)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (secret_id, requester_id) WHERE status = 'pending' DO NOTHING
+RETURNING id
+`
+	var id int
+	err = r.pool.QueryRow(ctx, query, secretID, request.Path, request.RequesterID, request.Capabilities,
+		request.Justification, request.Duration).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAccessRequestPending
+		}
+		return nil, err
+	}
+	return r.GetAccessRequest(ctx, id)
+}
+
+func (r *accessRequestsRepository) GetAccessRequest(ctx context.Context, id int) (*AccessRequest, error) {
+	query := `
+SELECT ` + accessRequestColumns + `
+FROM access_requests ar
+LEFT JOIN secrets s ON s.id = ar.secret_id
+WHERE ar.id = $1
+`
+	request, err := scanAccessRequest(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAccessRequestNotFound
+		}
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *accessRequestsRepository) ListAccessRequests(ctx context.Context, status *string) ([]AccessRequest, error) {
+	query := `
+SELECT ` + accessRequestColumns + `
+FROM access_requests ar
+LEFT JOIN secrets s ON s.id = ar.secret_id
+WHERE ($1::TEXT IS NULL OR ar.status = $1)
+ORDER BY ar.id DESC
+`
+	return r.listAccessRequests(ctx, query, status)
+}
+
+func (r *accessRequestsRepository) ListAccessRequestsForUser(ctx context.Context, userID int, roleIDs []int, status *string) ([]AccessRequest, error) {
+	query := `
+SELECT ` + accessRequestColumns + `
+FROM access_requests ar
+LEFT JOIN secrets s ON s.id = ar.secret_id
+WHERE ($1::TEXT IS NULL OR ar.status = $1)
+AND (ar.requester_id = $2 OR (s.id IS NOT NULL AND ` + secretAccessCondition("$2", "$3", "'"+CapabilityManageAccess+"'") + `))
+ORDER BY ar.id DESC
+`
+	return r.listAccessRequests(ctx, query, status, userID, roleIDs)
+}
+
+func (r *accessRequestsRepository) listAccessRequests(ctx context.Context, query string, args ...any) ([]AccessRequest, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var requests []AccessRequest
+	for rows.Next() {
+		request, err := scanAccessRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		requests = append(requests, *request)
+	}
+	return requests, rows.Err()
+}
+
+func (r *accessRequestsRepository) ApproveAccessRequest(ctx context.Context, id int, deciderID int, comment *string, now time.Time) (*AccessRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	request, err := lockPendingAccessRequest(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.SecretID == nil {
+		return nil, ErrSecretNotFound
+	}
+	if request.RequesterID == nil {
+		return nil, ErrUserNotFound
+	}
+
+	var expiresAt *time.Time
+	if request.Duration != nil {
+		expiration := now.Add(time.Duration(*request.Duration) * time.Second)
+		expiresAt = &expiration
+	}
+	err = tx.QueryRow(ctx, `
+		INSERT INTO secrets_access AS sa (secret_id, user_id, capabilities, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (secret_id, user_id) WHERE user_id IS NOT NULL
+		DO UPDATE SET capabilities = CASE
+		        WHEN `+activeGrantCondition+` THEN ARRAY(
+		            SELECT c FROM unnest(sa.capabilities || EXCLUDED.capabilities) c
+		            GROUP BY c ORDER BY array_position($5::TEXT[], c)
+		        )
+		        ELSE EXCLUDED.capabilities
+		    END,
+		    expires_at = CASE
+		        WHEN sa.expires_at IS NULL OR EXCLUDED.expires_at IS NULL THEN NULL
+		        WHEN `+activeGrantCondition+` THEN GREATEST(sa.expires_at, EXCLUDED.expires_at)
+		        ELSE EXCLUDED.expires_at
+		    END
+		RETURNING expires_at
+	`, *request.SecretID, *request.RequesterID, request.Capabilities, expiresAt, AllCapabilities).Scan(&expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = decideAccessRequest(ctx, tx, id, AccessRequestApproved, deciderID, comment, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return r.GetAccessRequest(ctx, id)
+}
+
+func (r *accessRequestsRepository) DenyAccessRequest(ctx context.Context, id int, deciderID int, comment *string, now time.Time) (*AccessRequest, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockPendingAccessRequest(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	err = decideAccessRequest(ctx, tx, id, AccessRequestDenied, deciderID, comment, now, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return r.GetAccessRequest(ctx, id)
+}
+
+// lockPendingAccessRequest locks the request until the end of the transaction, so that it is decided only once
+func lockPendingAccessRequest(ctx context.Context, tx pgx.Tx, id int) (*AccessRequest, error) {
+	query := `
+SELECT ` + accessRequestColumns + `
+FROM access_requests ar
+LEFT JOIN secrets s ON s.id = ar.secret_id
+WHERE ar.id = $1
+FOR UPDATE OF ar
+`
+	request, err := scanAccessRequest(tx.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAccessRequestNotFound
+		}
+		return nil, err
+	}
+	if request.Status != AccessRequestPending {
+		return request, ErrAccessRequestDecided
+	}
+	return request, nil
+}
+
+func decideAccessRequest(ctx context.Context, tx pgx.Tx, id int, status string, deciderID int, comment *string, now time.Time, grantExpiresAt *time.Time) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE access_requests
+		SET status = $2, decided_by = $3, decision_comment = $4, decided_at = $5, grant_expires_at = $6
+		WHERE id = $1
+	`, id, status, deciderID, comment, now, grantExpiresAt)
+	return err
+}
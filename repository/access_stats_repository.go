@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SecretReads are the reads of a secret during a day, the last one being by LastReaderID at LastReadAt
+type SecretReads struct {
+	SecretID     int
+	Day          time.Time
+	Count        int
+	LastReadAt   time.Time
+	LastReaderID int
+}
+
+// SecretAccessStats tell when and by whom a secret was last read, and how many times it was read since a time.
+// LastReadAt is nil when the secret was never read, LastReaderID when the last reader is deleted.
+type SecretAccessStats struct {
+	LastReadAt   *time.Time
+	LastReaderID *int
+	RecentReads  int
+}
+
+// StaleSecret is a secret nobody read since a time. LastReadAt is nil when it was never read.
+type StaleSecret struct {
+	Path         string
+	LastReadAt   *time.Time
+	LastReaderID *int
+	CreatedAt    time.Time
+}
+
+type AccessStatsRepository interface {
+	// RecordSecretReads adds the reads to the daily counts and updates the last read of the secrets. Reads of deleted
+	// secrets are ignored.
+	RecordSecretReads(ctx context.Context, reads []SecretReads) error
+	// GetSecretAccessStats returns the last read of the secret and its number of reads since the day of since
+	GetSecretAccessStats(ctx context.Context, secretID int, since time.Time) (*SecretAccessStats, error)
+	// ListStaleSecrets lists the secrets not read since before, the never read ones only when created before it,
+	// least recently read first
+	ListStaleSecrets(ctx context.Context, before time.Time) ([]StaleSecret, error)
+	// PurgeDailyReads deletes the daily counts of the days before the day of before
+	PurgeDailyReads(ctx context.Context, before time.Time) (int64, error)
+}
+
+type accessStatsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAccessStatsRepository(pool *pgxpool.Pool) AccessStatsRepository {
+	return &accessStatsRepository{pool: pool}
+}
+
+func (r *accessStatsRepository) RecordSecretReads(ctx context.Context, reads []SecretReads) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for _, read := range reads {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO secret_daily_reads (secret_id, day, read_count)
+			SELECT id, $2, $3 FROM secrets WHERE id = $1
+			ON CONFLICT (secret_id, day) DO UPDATE SET read_count = secret_daily_reads.read_count + EXCLUDED.read_count
+		`, read.SecretID, read.Day, read.Count)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO secret_access_stats AS sas (secret_id, last_read_at, last_reader_id)
+			SELECT s.id, $2, u.id FROM secrets s LEFT JOIN users u ON u.id = $3 WHERE s.id = $1
+			ON CONFLICT (secret_id) DO UPDATE SET last_read_at = EXCLUDED.last_read_at, last_reader_id = EXCLUDED.last_reader_id
+			WHERE sas.last_read_at < EXCLUDED.last_read_at
+		`, read.SecretID, read.LastReadAt, read.LastReaderID)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *accessStatsRepository) GetSecretAccessStats(ctx context.Context, secretID int, since time.Time) (*SecretAccessStats, error) {
+	var stats SecretAccessStats
+	err := r.pool.QueryRow(ctx, `
+		SELECT sas.last_read_at, sas.last_reader_id, (
+		    SELECT COALESCE(SUM(read_count), 0) FROM secret_daily_reads WHERE secret_id = $1 AND day >= $2::DATE
+		)
+		FROM (SELECT $1::INTEGER AS secret_id) s
+		LEFT JOIN secret_access_stats sas ON sas.secret_id = s.secret_id
+	`, secretID, since).Scan(&stats.LastReadAt, &stats.LastReaderID, &stats.RecentReads)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func (r *accessStatsRepository) ListStaleSecrets(ctx context.Context, before time.Time) ([]StaleSecret, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.path, sas.last_read_at, sas.last_reader_id, s.created_at
+		FROM secrets s
+		LEFT JOIN secret_access_stats sas ON sas.secret_id = s.id
+		WHERE starts_with(s.path, '/') AND COALESCE(sas.last_read_at, s.created_at) < $1
+		ORDER BY COALESCE(sas.last_read_at, s.created_at), s.path
+	`, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var secrets []StaleSecret
+	for rows.Next() {
+		var secret StaleSecret
+		if err := rows.Scan(&secret.Path, &secret.LastReadAt, &secret.LastReaderID, &secret.CreatedAt); err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, rows.Err()
+}
+
+func (r *accessStatsRepository) PurgeDailyReads(ctx context.Context, before time.Time) (int64, error) {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM secret_daily_reads WHERE day < $1::DATE`, before)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
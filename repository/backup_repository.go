@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/backup"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrDatabaseNotEmpty = errors.New("database is not empty")
+	ErrSchemaMismatch   = errors.New("backup was made with another version of the database schema")
+)
+
+type backupTable struct {
+	name    string
+	orderBy string
+	// serial tables have an id column whose sequence is moved past the restored rows
+	serial bool
+}
+
+// backupTables are the tables saved in a backup, in the order they are restored in. Logs and transient data are left
+// out: secret events, webhook deliveries, wrapped secrets, the history of rotations and read statistics.
+var backupTables = []backupTable{
+	{name: "global_settings", orderBy: "key"},
+	{name: "roles", orderBy: "id", serial: true},
+	{name: "users", orderBy: "id", serial: true},
+	{name: "user_roles", orderBy: "user_id, role_id"},
+	{name: "policies", orderBy: "id", serial: true},
+	{name: "policy_rules", orderBy: "id", serial: true},
+	{name: "policy_attachments", orderBy: "id", serial: true},
+	{name: "generation_policies", orderBy: "id", serial: true},
+	{name: "secrets", orderBy: "id", serial: true},
+	{name: "secret_fields", orderBy: "secret_id, name"},
+	{name: "secrets_access", orderBy: "id", serial: true},
+	{name: "folder_access", orderBy: "id", serial: true},
+	{name: "access_requests", orderBy: "id", serial: true},
+	{name: "break_glass_rules", orderBy: "id", serial: true},
+	{name: "break_glass_accesses", orderBy: "id", serial: true},
+	{name: "validation_rules", orderBy: "id", serial: true},
+	{name: "webhooks", orderBy: "id", serial: true},
+}
+
+// populatedQuery tells whether the database holds more than the rows created by the migrations, the admin role and
+// the system user
+const populatedQuery = `
+	SELECT EXISTS (SELECT 1 FROM global_settings)
+		OR EXISTS (SELECT 1 FROM secrets)
+		OR EXISTS (SELECT 1 FROM users WHERE username <> 'system')
+		OR EXISTS (SELECT 1 FROM roles WHERE name <> 'admin')
+`
+
+type BackupRepository interface {
+	// IsEmpty tells whether a backup can be restored into the database
+	IsEmpty(ctx context.Context) (bool, error)
+	// Dump reads the tables of a backup from a single snapshot of the database
+	Dump(ctx context.Context) (*backup.Archive, error)
+	// Restore replays the archive into a database holding nothing but the rows created by the migrations, all of it
+	// or nothing
+	Restore(ctx context.Context, archive *backup.Archive) error
+}
+
+type backupRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewBackupRepository(pool *pgxpool.Pool) BackupRepository {
+	return &backupRepository{pool: pool}
+}
+
+// schemaVersion returns the version of the migrations applied to the database
+func schemaVersion(ctx context.Context, tx pgx.Tx) (uint, error) {
+	var version int64
+	var dirty bool
+	err := tx.QueryRow(ctx, `SELECT version, dirty FROM schema_migrations`).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("error reading schema version: %w", err)
+	}
+	if dirty {
+		return 0, fmt.Errorf("the migration to version %d did not complete", version)
+	}
+	return uint(version), nil
+}
+
+func (r *backupRepository) IsEmpty(ctx context.Context) (bool, error) {
+	var populated bool
+	err := r.pool.QueryRow(ctx, populatedQuery).Scan(&populated)
+	return !populated, err
+}
+
+func (r *backupRepository) Dump(ctx context.Context) (*backup.Archive, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+	archive := &backup.Archive{
+		SchemaVersion: version,
+		CreatedAt:     time.Now().UTC(),
+		Tables:        make([]backup.Table, 0, len(backupTables)),
+	}
+
+	for _, table := range backupTables {
+		rows, err := tx.Query(ctx, fmt.Sprintf(`SELECT row_to_json(t)::TEXT FROM %s t ORDER BY %s`, table.name, table.orderBy))
+		if err != nil {
+			return nil, fmt.Errorf("error reading table %s: %w", table.name, err)
+		}
+		tableRows := []json.RawMessage{}
+		for rows.Next() {
+			var row string
+			if err := rows.Scan(&row); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			tableRows = append(tableRows, json.RawMessage(row))
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("error reading table %s: %w", table.name, err)
+		}
+		archive.Tables = append(archive.Tables, backup.Table{Name: table.name, Rows: tableRows})
+	}
+
+	return archive, nil
+}
+
+func (r *backupRepository) Restore(ctx context.Context, archive *backup.Archive) error {
+	knownTables := make(map[string]bool, len(backupTables))
+	for _, table := range backupTables {
+		knownTables[table.name] = true
+	}
+	archiveTables := make(map[string]backup.Table, len(archive.Tables))
+	for _, table := range archive.Tables {
+		if !knownTables[table.Name] {
+			return fmt.Errorf("%w: unknown table %s", backup.ErrInvalidArchive, table.Name)
+		}
+		archiveTables[table.Name] = table
+	}
+	for _, table := range backupTables {
+		if _, ok := archiveTables[table.name]; !ok {
+			return fmt.Errorf("%w: missing table %s", backup.ErrInvalidArchive, table.name)
+		}
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return err
+	}
+	if version != archive.SchemaVersion {
+		return fmt.Errorf("%w: the backup has version %d, the database %d", ErrSchemaMismatch, archive.SchemaVersion, version)
+	}
+
+	// The admin role and the system user are created by the migrations, and restored with the rest
+	var populated bool
+	if err := tx.QueryRow(ctx, populatedQuery).Scan(&populated); err != nil {
+		return err
+	}
+	if populated {
+		return ErrDatabaseNotEmpty
+	}
+	for i := len(backupTables) - 1; i >= 0; i-- {
+		if _, err := tx.Exec(ctx, fmt.Sprintf(`DELETE FROM %s`, backupTables[i].name)); err != nil {
+			return fmt.Errorf("error clearing table %s: %w", backupTables[i].name, err)
+		}
+	}
+
+	for _, table := range backupTables {
+		rows := archiveTables[table.name].Rows
+		if len(rows) > 0 {
+			encodedRows, err := json.Marshal(rows)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, fmt.Sprintf(`INSERT INTO %s SELECT * FROM json_populate_recordset(NULL::%s, $1::JSON)`, table.name, table.name), string(encodedRows))
+			if err != nil {
+				return fmt.Errorf("error restoring table %s: %w", table.name, err)
+			}
+		}
+		if table.serial {
+			_, err = tx.Exec(ctx, fmt.Sprintf(`SELECT setval(pg_get_serial_sequence($1, 'id'), COALESCE(MAX(id), 0) + 1, FALSE) FROM %s`, table.name), table.name)
+			if err != nil {
+				return fmt.Errorf("error resetting the sequence of table %s: %w", table.name, err)
+			}
+		}
+	}
+
+	return tx.Commit(ctx)
+}
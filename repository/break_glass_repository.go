@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrBreakGlassRuleNotFound   = errors.New("break glass rule not found")
+	ErrBreakGlassAccessNotFound = errors.New("break glass access not found")
+	ErrBreakGlassAccessReviewed = errors.New("break glass access already reviewed")
+)
+
+// BreakGlassRule makes the secrets under PathPrefix readable in an emergency, only by the members of the role when
+// RoleID is set
+type BreakGlassRule struct {
+	ID         int
+	PathPrefix string
+	RoleID     *int
+	CreatedAt  time.Time
+}
+
+// BreakGlassAccess is an emergency read of a secret, giving the user read access to it until ExpiresAt. SecretID is
+// nil once the secret is deleted.
+type BreakGlassAccess struct {
+	ID            int
+	SecretID      *int
+	Path          string
+	UserID        *int
+	Justification string
+	ExpiresAt     time.Time
+	ReviewedBy    *int
+	ReviewedAt    *time.Time
+	ReviewComment *string
+	CreatedAt     time.Time
+}
+
+type BreakGlassRepository interface {
+	// CreateBreakGlassRule creates the rule, or returns the ID of the identical one
+	CreateBreakGlassRule(ctx context.Context, pathPrefix string, roleID *int) (int, error)
+	DeleteBreakGlassRule(ctx context.Context, id int) error
+	ListBreakGlassRules(ctx context.Context) ([]BreakGlassRule, error)
+	// IsBreakGlassEligible tells whether a user with the roles can break the glass on the secret at the path
+	IsBreakGlassEligible(ctx context.Context, secretPath string, roleIDs []int) (bool, error)
+	CreateBreakGlassAccess(ctx context.Context, secretID int, userID int, justification string, expiresAt time.Time) (*BreakGlassAccess, error)
+	// HasActiveBreakGlassAccess tells whether the user broke the glass on the secret at the path and can still read it
+	HasActiveBreakGlassAccess(ctx context.Context, secretPath string, userID int, now time.Time) (bool, error)
+	GetBreakGlassAccess(ctx context.Context, id int) (*BreakGlassAccess, error)
+	// ListBreakGlassAccesses lists every emergency access, most recent first, only the ones awaiting a review when
+	// unreviewed is set
+	ListBreakGlassAccesses(ctx context.Context, unreviewed bool) ([]BreakGlassAccess, error)
+	// ListBreakGlassAccessesForUser lists the emergency accesses on the secrets the user or one of the roles can manage
+	// the access of
+	ListBreakGlassAccessesForUser(ctx context.Context, userID int, roleIDs []int, unreviewed bool) ([]BreakGlassAccess, error)
+	// ReviewBreakGlassAccess acknowledges the emergency access, returning ErrBreakGlassAccessReviewed when it already is
+	ReviewBreakGlassAccess(ctx context.Context, id int, reviewerID int, comment *string, now time.Time) (*BreakGlassAccess, error)
+}
+
+type breakGlassRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewBreakGlassRepository(pool *pgxpool.Pool) BreakGlassRepository {
+	return &breakGlassRepository{pool: pool}
+}
+
+func (r *breakGlassRepository) CreateBreakGlassRule(ctx context.Context, pathPrefix string, roleID *int) (int, error) {
+	if pathPrefix == "" {
+		return 0, errors.New("pathPrefix must not be empty")
+	}
+
+	var id int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO break_glass_rules (path_prefix, role_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+		RETURNING id
+	`, pathPrefix, roleID, time.Now().UTC()).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = r.pool.QueryRow(ctx, `
+			SELECT id FROM break_glass_rules WHERE path_prefix = $1 AND role_id IS NOT DISTINCT FROM $2
+		`, pathPrefix, roleID).Scan(&id)
+	}
+	return id, err
+}
+
+func (r *breakGlassRepository) DeleteBreakGlassRule(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM break_glass_rules WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrBreakGlassRuleNotFound
+	}
+	return nil
+}
+
+func (r *breakGlassRepository) ListBreakGlassRules(ctx context.Context) ([]BreakGlassRule, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, path_prefix, role_id, created_at FROM break_glass_rules ORDER BY path_prefix, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []BreakGlassRule
+	for rows.Next() {
+		var rule BreakGlassRule
+		if err := rows.Scan(&rule.ID, &rule.PathPrefix, &rule.RoleID, &rule.CreatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (r *breakGlassRepository) IsBreakGlassEligible(ctx context.Context, secretPath string, roleIDs []int) (bool, error) {
+	var eligible bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM break_glass_rules
+			WHERE starts_with($1, path_prefix) AND (role_id IS NULL OR role_id = ANY($2))
+		)
+	`, secretPath, roleIDs).Scan(&eligible)
+	return eligible, err
+}
+
+const breakGlassAccessColumns = `bga.id, bga.secret_id, COALESCE(s.path, bga.path), bga.user_id, bga.justification, bga.expires_at,
+    bga.reviewed_by, bga.reviewed_at, bga.review_comment, bga.created_at`
+
+func scanBreakGlassAccess(row pgx.Row) (*BreakGlassAccess, error) {
+	var access BreakGlassAccess
+	err := row.Scan(&access.ID, &access.SecretID, &access.Path, &access.UserID, &access.Justification, &access.ExpiresAt,
+		&access.ReviewedBy, &access.ReviewedAt, &access.ReviewComment, &access.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &access, nil
+}
+
+func (r *breakGlassRepository) CreateBreakGlassAccess(ctx context.Context, secretID int, userID int, justification string, expiresAt time.Time) (*BreakGlassAccess, error) {
+	var id int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO break_glass_accesses (secret_id, path, user_id, justification, expires_at)
+		SELECT id, path, $2, $3, $4 FROM secrets WHERE id = $1
+		RETURNING id
+	`, secretID, userID, justification, expiresAt).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, err
+	}
+	return r.GetBreakGlassAccess(ctx, id)
+}
+
+func (r *breakGlassRepository) HasActiveBreakGlassAccess(ctx context.Context, secretPath string, userID int, now time.Time) (bool, error) {
+	var active bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM break_glass_accesses bga
+			JOIN secrets s ON s.id = bga.secret_id
+			WHERE s.path = $1 AND bga.user_id = $2 AND bga.expires_at > $3
+		)
+	`, secretPath, userID, now).Scan(&active)
+	return active, err
+}
+
+func (r *breakGlassRepository) GetBreakGlassAccess(ctx context.Context, id int) (*BreakGlassAccess, error) {
+	query := `
+SELECT ` + breakGlassAccessColumns + `
+FROM break_glass_accesses bga
+LEFT JOIN secrets s ON s.id = bga.secret_id
+WHERE bga.id = $1
+`
+	access, err := scanBreakGlassAccess(r.pool.QueryRow(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBreakGlassAccessNotFound
+		}
+		return nil, err
+	}
+	return access, nil
+}
+
+func (r *breakGlassRepository) ListBreakGlassAccesses(ctx context.Context, unreviewed bool) ([]BreakGlassAccess, error) {
+	query := `
+SELECT ` + breakGlassAccessColumns + `
+FROM break_glass_accesses bga
+LEFT JOIN secrets s ON s.id = bga.secret_id
+WHERE (NOT $1 OR bga.reviewed_at IS NULL)
+ORDER BY bga.id DESC
+`
+	return r.listBreakGlassAccesses(ctx, query, unreviewed)
+}
+
+func (r *breakGlassRepository) ListBreakGlassAccessesForUser(ctx context.Context, userID int, roleIDs []int, unreviewed bool) ([]BreakGlassAccess, error) {
+	query := `
+SELECT ` + breakGlassAccessColumns + `
+FROM break_glass_accesses bga
+JOIN secrets s ON s.id = bga.secret_id
+WHERE (NOT $1 OR bga.reviewed_at IS NULL)
+AND ` + secretAccessCondition("$2", "$3", "'"+CapabilityManageAccess+"'") + `
+ORDER BY bga.id DESC
+`
+	return r.listBreakGlassAccesses(ctx, query, unreviewed, userID, roleIDs)
+}
+
+func (r *breakGlassRepository) listBreakGlassAccesses(ctx context.Context, query string, args ...any) ([]BreakGlassAccess, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accesses []BreakGlassAccess
+	for rows.Next() {
+		access, err := scanBreakGlassAccess(rows)
+		if err != nil {
+			return nil, err
+		}
+		accesses = append(accesses, *access)
+	}
+	return accesses, rows.Err()
+}
+
+func (r *breakGlassRepository) ReviewBreakGlassAccess(ctx context.Context, id int, reviewerID int, comment *string, now time.Time) (*BreakGlassAccess, error) {
+	cmd, err := r.pool.Exec(ctx, `
+		UPDATE break_glass_accesses
+		SET reviewed_by = $2, reviewed_at = $3, review_comment = $4
+		WHERE id = $1 AND reviewed_at IS NULL
+	`, id, reviewerID, now, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := r.GetBreakGlassAccess(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.RowsAffected() == 0 {
+		return access, ErrBreakGlassAccessReviewed
+	}
+	return access, nil
+}
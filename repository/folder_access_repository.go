@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrFolderAccessNotFound = errors.New("folder access not found")
+)
+
+// FolderAccess grants a user or a role access to every secret whose path starts with PathPrefix,
+// including the secrets created after the grant.
+type FolderAccess struct {
+	ID           int
+	PathPrefix   string
+	UserID       *int
+	RoleID       *int
+	Capabilities []string
+	CreatedAt    time.Time
+}
+
+type FolderAccessRepository interface {
+	// GrantUserFolderAccess creates or replaces the grant of the user on the folder
+	GrantUserFolderAccess(ctx context.Context, pathPrefix string, userID int, capabilities []string) (int, error)
+	// GrantRoleFolderAccess creates or replaces the grant of the role on the folder
+	GrantRoleFolderAccess(ctx context.Context, pathPrefix string, roleID int, capabilities []string) (int, error)
+	RevokeFolderAccess(ctx context.Context, id int) error
+	ListFolderAccesses(ctx context.Context) ([]FolderAccess, error)
+	// GetFolderAccessesForPath returns the folder grants inherited by the secret at secretPath
+	GetFolderAccessesForPath(ctx context.Context, secretPath string) ([]FolderAccess, error)
+}
+
+type folderAccessRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewFolderAccessRepository(pool *pgxpool.Pool) FolderAccessRepository {
+	return &folderAccessRepository{pool: pool}
+}
+
+func (r *folderAccessRepository) GrantUserFolderAccess(ctx context.Context, pathPrefix string, userID int, capabilities []string) (int, error) {
+	if pathPrefix == "" {
+		return 0, errors.New("pathPrefix must not be empty")
+	}
+
+	query := `
+		INSERT INTO folder_access (path_prefix, user_id, capabilities, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (path_prefix, user_id) WHERE user_id IS NOT NULL DO UPDATE SET capabilities = EXCLUDED.capabilities
+		RETURNING id
+	`
+
+	var id int
+	err := r.pool.QueryRow(ctx, query, pathPrefix, userID, capabilities, time.Now().UTC()).Scan(&id)
+	return id, err
+}
+
+func (r *folderAccessRepository) GrantRoleFolderAccess(ctx context.Context, pathPrefix string, roleID int, capabilities []string) (int, error) {
+	if pathPrefix == "" {
+		return 0, errors.New("pathPrefix must not be empty")
+	}
+
+	query := `
+		INSERT INTO folder_access (path_prefix, role_id, capabilities, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (path_prefix, role_id) WHERE role_id IS NOT NULL DO UPDATE SET capabilities = EXCLUDED.capabilities
+		RETURNING id
+	`
+
+	var id int
+	err := r.pool.QueryRow(ctx, query, pathPrefix, roleID, capabilities, time.Now().UTC()).Scan(&id)
+	return id, err
+}
+
+func (r *folderAccessRepository) RevokeFolderAccess(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM folder_access WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrFolderAccessNotFound
+	}
+	return nil
+}
+
+func (r *folderAccessRepository) ListFolderAccesses(ctx context.Context) ([]FolderAccess, error) {
+	query := `SELECT id, path_prefix, user_id, role_id, capabilities, created_at FROM folder_access ORDER BY path_prefix, id`
+	return r.queryFolderAccesses(ctx, query)
+}
+
+func (r *folderAccessRepository) GetFolderAccessesForPath(ctx context.Context, secretPath string) ([]FolderAccess, error) {
+	if secretPath == "" {
+		return nil, errors.New("secretPath must not be empty")
+	}
+
+	query := `
+		SELECT id, path_prefix, user_id, role_id, capabilities, created_at
+		FROM folder_access
+		WHERE starts_with($1, path_prefix)
+		ORDER BY path_prefix, id
+	`
+	return r.queryFolderAccesses(ctx, query, secretPath)
+}
+
+func (r *folderAccessRepository) queryFolderAccesses(ctx context.Context, query string, args ...any) ([]FolderAccess, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accesses []FolderAccess
+	for rows.Next() {
+		var access FolderAccess
+		if err := rows.Scan(&access.ID, &access.PathPrefix, &access.UserID, &access.RoleID, &access.Capabilities, &access.CreatedAt); err != nil {
+			return nil, err
+		}
+		accesses = append(accesses, access)
+	}
+	return accesses, rows.Err()
+}
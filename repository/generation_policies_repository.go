@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/generator"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrGenerationPolicyNotFound = errors.New("generation policy not found")
+)
+
+// GenerationPolicy is a named generator spec, its kind is stored in its own column and the other parameters as JSON
+type GenerationPolicy struct {
+	ID        int
+	Name      string
+	Spec      generator.Spec
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type GenerationPoliciesRepository interface {
+	CreateGenerationPolicy(ctx context.Context, name string, spec generator.Spec) (int, error)
+	UpdateGenerationPolicy(ctx context.Context, id int, name string, spec generator.Spec) error
+	DeleteGenerationPolicy(ctx context.Context, id int) error
+	GetGenerationPolicyByID(ctx context.Context, id int) (*GenerationPolicy, error)
+	GetGenerationPolicyByName(ctx context.Context, name string) (*GenerationPolicy, error)
+	ListGenerationPolicies(ctx context.Context) ([]GenerationPolicy, error)
+}
+
+type generationPoliciesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewGenerationPoliciesRepository(pool *pgxpool.Pool) GenerationPoliciesRepository {
+	return &generationPoliciesRepository{pool: pool}
+}
+
+func (r *generationPoliciesRepository) CreateGenerationPolicy(ctx context.Context, name string, spec generator.Spec) (int, error) {
+	parameters, err := json.Marshal(spec)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+INSERT INTO generation_policies (name, kind, parameters, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $4)
+RETURNING id
+`
+	var id int
+	err = r.pool.QueryRow(ctx, query, name, spec.Kind, parameters, time.Now().UTC()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *generationPoliciesRepository) UpdateGenerationPolicy(ctx context.Context, id int, name string, spec generator.Spec) error {
+	parameters, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+
+	query := `
+UPDATE generation_policies
+SET name = $1,
+    kind = $2,
+    parameters = $3,
+    updated_at = $4
+WHERE id = $5
+`
+	cmd, err := r.pool.Exec(ctx, query, name, spec.Kind, parameters, time.Now().UTC(), id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrGenerationPolicyNotFound
+	}
+	return nil
+}
+
+func (r *generationPoliciesRepository) DeleteGenerationPolicy(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM generation_policies WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrGenerationPolicyNotFound
+	}
+	return nil
+}
+
+func (r *generationPoliciesRepository) GetGenerationPolicyByID(ctx context.Context, id int) (*GenerationPolicy, error) {
+	return r.getGenerationPolicy(ctx, `id = $1`, id)
+}
+
+func (r *generationPoliciesRepository) GetGenerationPolicyByName(ctx context.Context, name string) (*GenerationPolicy, error) {
+	return r.getGenerationPolicy(ctx, `name = $1`, name)
+}
+
+func (r *generationPoliciesRepository) getGenerationPolicy(ctx context.Context, where string, arg any) (*GenerationPolicy, error) {
+	row := r.pool.QueryRow(ctx, `SELECT id, name, kind, parameters, created_at, updated_at FROM generation_policies WHERE `+where, arg)
+	policy, err := scanGenerationPolicy(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrGenerationPolicyNotFound
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (r *generationPoliciesRepository) ListGenerationPolicies(ctx context.Context) ([]GenerationPolicy, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, name, kind, parameters, created_at, updated_at FROM generation_policies ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []GenerationPolicy
+	for rows.Next() {
+		policy, err := scanGenerationPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+	return policies, rows.Err()
+}
+
+func scanGenerationPolicy(row pgx.Row) (*GenerationPolicy, error) {
+	var policy GenerationPolicy
+	var kind string
+	var parameters []byte
+	if err := row.Scan(&policy.ID, &policy.Name, &kind, &parameters, &policy.CreatedAt, &policy.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(parameters, &policy.Spec); err != nil {
+		return nil, err
+	}
+	policy.Spec.Kind = kind
+	return &policy, nil
+}
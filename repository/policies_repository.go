@@ -0,0 +1,280 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrPolicyNotFound = errors.New("policy not found")
+)
+
+// PolicyRule allows or denies capabilities on the secrets whose path matches Pattern, the regular expression
+// compiled from PathGlob
+type PolicyRule struct {
+	PathGlob     string
+	Pattern      string
+	Effect       string
+	Capabilities []string
+}
+
+type Policy struct {
+	ID        int
+	Name      string
+	Format    string
+	Document  string
+	Rules     []PolicyRule
+	UserIDs   []int
+	RoleIDs   []int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type PoliciesRepository interface {
+	CreatePolicy(ctx context.Context, name string, format string, document string, rules []PolicyRule) (int, error)
+	UpdatePolicy(ctx context.Context, id int, name string, format string, document string, rules []PolicyRule) error
+	DeletePolicy(ctx context.Context, id int) error
+	GetPolicyByID(ctx context.Context, id int) (*Policy, error)
+	GetPolicyByName(ctx context.Context, name string) (*Policy, error)
+	ListPolicies(ctx context.Context) ([]Policy, error)
+	// GetPoliciesForUser returns the policies attached to the user or to one of the roles
+	GetPoliciesForUser(ctx context.Context, userID int, roleIDs []int) ([]Policy, error)
+	AttachPolicyToUser(ctx context.Context, policyID int, userID int) error
+	AttachPolicyToRole(ctx context.Context, policyID int, roleID int) error
+	DetachPolicyFromUser(ctx context.Context, policyID int, userID int) error
+	DetachPolicyFromRole(ctx context.Context, policyID int, roleID int) error
+}
+
+type policiesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewPoliciesRepository(pool *pgxpool.Pool) PoliciesRepository {
+	return &policiesRepository{pool: pool}
+}
+
+func (r *policiesRepository) CreatePolicy(ctx context.Context, name string, format string, document string, rules []PolicyRule) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+INSERT INTO policies (name, format, document, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $4)
+RETURNING id
+`
+	var policyID int
+	err = tx.QueryRow(ctx, query, name, format, document, time.Now().UTC()).Scan(&policyID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := insertPolicyRules(ctx, tx, policyID, rules); err != nil {
+		return 0, err
+	}
+
+	return policyID, tx.Commit(ctx)
+}
+
+func (r *policiesRepository) UpdatePolicy(ctx context.Context, id int, name string, format string, document string, rules []PolicyRule) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+UPDATE policies
+SET name = $1,
+    format = $2,
+    document = $3,
+    updated_at = $4
+WHERE id = $5
+`
+	cmd, err := tx.Exec(ctx, query, name, format, document, time.Now().UTC(), id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrPolicyNotFound
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM policy_rules WHERE policy_id = $1`, id); err != nil {
+		return err
+	}
+	if err := insertPolicyRules(ctx, tx, id, rules); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertPolicyRules(ctx context.Context, tx pgx.Tx, policyID int, rules []PolicyRule) error {
+	for _, rule := range rules {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO policy_rules (policy_id, path_glob, pattern, effect, capabilities)
+			VALUES ($1, $2, $3, $4, $5)
+		`, policyID, rule.PathGlob, rule.Pattern, rule.Effect, rule.Capabilities)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *policiesRepository) DeletePolicy(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM policies WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrPolicyNotFound
+	}
+	return nil
+}
+
+func (r *policiesRepository) GetPolicyByID(ctx context.Context, id int) (*Policy, error) {
+	policies, err := r.queryPolicies(ctx, `WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, ErrPolicyNotFound
+	}
+	return &policies[0], nil
+}
+
+func (r *policiesRepository) GetPolicyByName(ctx context.Context, name string) (*Policy, error) {
+	policies, err := r.queryPolicies(ctx, `WHERE name = $1`, name)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, ErrPolicyNotFound
+	}
+	return &policies[0], nil
+}
+
+func (r *policiesRepository) ListPolicies(ctx context.Context) ([]Policy, error) {
+	return r.queryPolicies(ctx, ``)
+}
+
+func (r *policiesRepository) GetPoliciesForUser(ctx context.Context, userID int, roleIDs []int) ([]Policy, error) {
+	return r.queryPolicies(ctx, `
+WHERE id IN (
+    SELECT policy_id FROM policy_attachments
+    WHERE user_id = $1 OR role_id = ANY($2)
+)`, userID, roleIDs)
+}
+
+// queryPolicies loads the policies matching the where clause along with their rules and attachments
+func (r *policiesRepository) queryPolicies(ctx context.Context, where string, args ...any) ([]Policy, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, name, format, document, created_at, updated_at FROM policies `+where+` ORDER BY name`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []Policy
+	var policyIDs []int
+	for rows.Next() {
+		var policy Policy
+		if err := rows.Scan(&policy.ID, &policy.Name, &policy.Format, &policy.Document, &policy.CreatedAt, &policy.UpdatedAt); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+		policyIDs = append(policyIDs, policy.ID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	byID := make(map[int]*Policy, len(policies))
+	for i := range policies {
+		byID[policies[i].ID] = &policies[i]
+	}
+
+	ruleRows, err := r.pool.Query(ctx, `
+		SELECT policy_id, path_glob, pattern, effect, capabilities
+		FROM policy_rules
+		WHERE policy_id = ANY($1)
+		ORDER BY id
+	`, policyIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer ruleRows.Close()
+	for ruleRows.Next() {
+		var policyID int
+		var rule PolicyRule
+		if err := ruleRows.Scan(&policyID, &rule.PathGlob, &rule.Pattern, &rule.Effect, &rule.Capabilities); err != nil {
+			return nil, err
+		}
+		byID[policyID].Rules = append(byID[policyID].Rules, rule)
+	}
+	if err := ruleRows.Err(); err != nil {
+		return nil, err
+	}
+
+	attachmentRows, err := r.pool.Query(ctx, `
+		SELECT policy_id, user_id, role_id
+		FROM policy_attachments
+		WHERE policy_id = ANY($1)
+		ORDER BY id
+	`, policyIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer attachmentRows.Close()
+	for attachmentRows.Next() {
+		var policyID int
+		var userID, roleID *int
+		if err := attachmentRows.Scan(&policyID, &userID, &roleID); err != nil {
+			return nil, err
+		}
+		if userID != nil {
+			byID[policyID].UserIDs = append(byID[policyID].UserIDs, *userID)
+		}
+		if roleID != nil {
+			byID[policyID].RoleIDs = append(byID[policyID].RoleIDs, *roleID)
+		}
+	}
+	return policies, attachmentRows.Err()
+}
+
+func (r *policiesRepository) AttachPolicyToUser(ctx context.Context, policyID int, userID int) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO policy_attachments (policy_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, policyID, userID)
+	return err
+}
+
+func (r *policiesRepository) AttachPolicyToRole(ctx context.Context, policyID int, roleID int) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO policy_attachments (policy_id, role_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`, policyID, roleID)
+	return err
+}
+
+func (r *policiesRepository) DetachPolicyFromUser(ctx context.Context, policyID int, userID int) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM policy_attachments WHERE policy_id = $1 AND user_id = $2`, policyID, userID)
+	return err
+}
+
+func (r *policiesRepository) DetachPolicyFromRole(ctx context.Context, policyID int, roleID int) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM policy_attachments WHERE policy_id = $1 AND role_id = $2`, policyID, roleID)
+	return err
+}
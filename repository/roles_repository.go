@@ -9,7 +9,8 @@ import (
 )
 
 var (
-	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleNotFound    = errors.New("role not found")
+	ErrRoleOwnsSecrets = errors.New("role owns secrets")
 )
 
 type Role struct {
@@ -27,6 +28,7 @@ type RolesRepository interface {
 	GetRoleByName(ctx context.Context, name string) (*Role, error)
 	ListRoles(ctx context.Context) ([]Role, error)
 	GetRolesByIDs(ctx context.Context, ids []int) ([]Role, error)
+	// DeleteRole returns ErrRoleOwnsSecrets when the role still owns secrets
 	DeleteRole(ctx context.Context, id int) error
 }
 
@@ -117,11 +119,20 @@ func (r *rolesRepository) GetRolesByIDs(ctx context.Context, ids []int) ([]Role,
 }
 
 func (r *rolesRepository) DeleteRole(ctx context.Context, id int) error {
-	query := `DELETE FROM roles WHERE id = $1`
+	query := `DELETE FROM roles WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM secrets WHERE owner_role_id = $1)`
 	cmdTag, err := r.pool.Exec(ctx, query, id)
 	if err != nil {
 		return err
 	}
+	if cmdTag.RowsAffected() == 0 {
+		var exists bool
+		if err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM roles WHERE id = $1)`, id).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return ErrRoleOwnsSecrets
+		}
+	}
 	if cmdTag.RowsAffected() == 0 {
 		return ErrRoleNotFound
 	}
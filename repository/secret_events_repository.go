@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SecretEventsChannel is the channel the id of every recorded secret event is notified on
+const SecretEventsChannel = "secret_events"
+
+// Secret events are recorded by the triggers of migrations 000012 and 000022
+const (
+	SecretEventCreated       = "secret.created"
+	SecretEventUpdated       = "secret.updated"
+	SecretEventDeleted       = "secret.deleted"
+	SecretEventAccessChanged = "secret.access_changed"
+)
+
+type SecretEvent struct {
+	ID        int64
+	Type      string
+	Path      string
+	Version   int
+	CreatedAt time.Time
+}
+
+// SecretEventFilter matches the events of the given paths and of the secrets under the prefix, when not empty
+type SecretEventFilter struct {
+	Paths  []string
+	Prefix string
+}
+
+type SecretEventsRepository interface {
+	ListSecretEvents(ctx context.Context, after int64, filter SecretEventFilter, userID int, roleIDs []int, limit int) ([]SecretEvent, int64, error)
+	GetSecretEventsRange(ctx context.Context) (oldest int64, latest int64, err error)
+	DeleteSecretEventsBefore(ctx context.Context, before time.Time) (int64, error)
+	ListenSecretEvents(ctx context.Context, notify func()) error
+}
+
+type secretEventsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSecretEventsRepository(pool *pgxpool.Pool) SecretEventsRepository {
+	return &secretEventsRepository{pool: pool}
+}
+
+// ListSecretEvents scans up to limit events recorded after the given id and returns the ones matching the filter on
+// secrets the user can list, along with the id of the last event scanned. The access to deleted secrets is checked
+// on their path and last owner.
+func (r *secretEventsRepository) ListSecretEvents(ctx context.Context, after int64, filter SecretEventFilter, userID int, roleIDs []int, limit int) ([]SecretEvent, int64, error) {
+	query := fmt.Sprintf(`
+SELECT e.id, e.type, e.path, e.version, e.created_at,
+       (e.path = ANY($2) OR ($3 <> '' AND starts_with(e.path, $3))) AND %s
+FROM (SELECT * FROM secret_events WHERE id > $1 ORDER BY id LIMIT $6) e
+LEFT JOIN secrets existing ON existing.id = e.secret_id AND existing.path = e.path
+CROSS JOIN LATERAL (
+    SELECT COALESCE(existing.id, 0) AS id, e.path AS path,
+           CASE WHEN existing.id IS NULL THEN e.owner_user_id ELSE existing.owner_user_id END AS owner_user_id,
+           CASE WHEN existing.id IS NULL THEN e.owner_role_id ELSE existing.owner_role_id END AS owner_role_id
+) s
+ORDER BY e.id
+`, secretAccessCondition("$4", "$5", "'list'"))
+
+	paths := filter.Paths
+	if paths == nil {
+		paths = []string{}
+	}
+	rows, err := r.pool.Query(ctx, query, after, paths, filter.Prefix, userID, roleIDs, limit)
+	if err != nil {
+		return nil, after, err
+	}
+	defer rows.Close()
+
+	var events []SecretEvent
+	last := after
+	for rows.Next() {
+		var event SecretEvent
+		var visible bool
+		if err := rows.Scan(&event.ID, &event.Type, &event.Path, &event.Version, &event.CreatedAt, &visible); err != nil {
+			return nil, after, err
+		}
+		last = event.ID
+		if visible {
+			events = append(events, event)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, after, err
+	}
+	return events, last, nil
+}
+
+// GetSecretEventsRange returns the ids of the oldest and latest recorded events, or zeros when there is none
+func (r *secretEventsRepository) GetSecretEventsRange(ctx context.Context) (int64, int64, error) {
+	var oldest, latest int64
+	err := r.pool.QueryRow(ctx, `SELECT COALESCE(MIN(id), 0), COALESCE(MAX(id), 0) FROM secret_events`).Scan(&oldest, &latest)
+	if err != nil {
+		return 0, 0, err
+	}
+	return oldest, latest, nil
+}
+
+// DeleteSecretEventsBefore deletes the events recorded before the given time. The latest event is always kept so the
+// range of events tells which resume positions are still available.
+func (r *secretEventsRepository) DeleteSecretEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	cmd, err := r.pool.Exec(ctx, `
+		DELETE FROM secret_events
+		WHERE created_at < $1 AND id < (SELECT MAX(id) FROM secret_events)
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
+
+// ListenSecretEvents calls notify whenever events are recorded, by any server sharing the database, until the
+// context is cancelled or the connection fails. It also calls notify once listening, for the events recorded before.
+func (r *secretEventsRepository) ListenSecretEvents(ctx context.Context, notify func()) error {
+	conn, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection is dedicated to the listener and isn't returned to the pool
+	pgConn := conn.Hijack()
+	defer pgConn.Close(context.Background())
+
+	if _, err := pgConn.Exec(ctx, "LISTEN "+SecretEventsChannel); err != nil {
+		return err
+	}
+	notify()
+
+	for {
+		if _, err := pgConn.WaitForNotification(ctx); err != nil {
+			return err
+		}
+		notify()
+	}
+}
@@ -3,10 +3,41 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// Capabilities carried by grants on secrets and folders. The owner of a secret has all of them.
+const (
+	CapabilityRead         = "read"
+	CapabilityList         = "list"
+	CapabilityUpdate       = "update"
+	CapabilityDelete       = "delete"
+	CapabilityManageAccess = "manage_access"
+)
+
+// AllCapabilities lists every capability in their canonical order
+var AllCapabilities = []string{CapabilityRead, CapabilityList, CapabilityUpdate, CapabilityDelete, CapabilityManageAccess}
+
+// DefaultCapabilities are given to grants created without explicit capabilities
+var DefaultCapabilities = []string{CapabilityRead, CapabilityList}
+
+// SecretGrant is a grant of capabilities on a secret to either a user or a role, until ExpiresAt when set
+type SecretGrant struct {
+	UserID       *int
+	RoleID       *int
+	Capabilities []string
+	ExpiresAt    *time.Time
+}
+
+// activeGrantCondition filters out the expired grants of the secrets_access table aliased as sa, which stay until the
+// cleanup job deletes them
+const activeGrantCondition = `(sa.expires_at IS NULL OR sa.expires_at > NOW())`
+
 type SecretsAccessRepository interface {
+	// GrantUserAccess, GrantUsersAccess, GrantRoleAccess and GrantRolesAccess create grants with the
+	// DefaultCapabilities, leaving existing grants untouched
 	GrantUserAccess(ctx context.Context, secretPath string, userID int) error
 	GrantUsersAccess(ctx context.Context, secretPath string, userIDs []int) error
 	GrantRoleAccess(ctx context.Context, secretPath string, roleID int) error
@@ -14,6 +45,13 @@ type SecretsAccessRepository interface {
 	RevokeUserAccess(ctx context.Context, secretPath string, userID int) error
 	RevokeRoleAccess(ctx context.Context, secretPath string, roleID int) error
 	GetAccessesBySecretPath(ctx context.Context, secretPath string) (userIDs []int, roleIDs []int, err error)
+	// SetUserCapabilities creates or replaces the grant of the user on the secret, expiresAt is nil for a permanent grant
+	SetUserCapabilities(ctx context.Context, secretPath string, userID int, capabilities []string, expiresAt *time.Time) error
+	// SetRoleCapabilities creates or replaces the grant of the role on the secret, expiresAt is nil for a permanent grant
+	SetRoleCapabilities(ctx context.Context, secretPath string, roleID int, capabilities []string, expiresAt *time.Time) error
+	GetGrantsBySecretPath(ctx context.Context, secretPath string) ([]SecretGrant, error)
+	// DeleteExpiredGrants deletes the grants expired at the given time and returns the paths of their secrets
+	DeleteExpiredGrants(ctx context.Context, now time.Time) ([]string, error)
 }
 
 type secretsAccessRepository struct {
@@ -154,10 +192,9 @@ func (r *secretsAccessRepository) GetAccessesBySecretPath(ctx context.Context, s
 	}
 
 	rows, err := r.pool.Query(ctx, `
-		SELECT user_id, role_id
-		FROM secrets_access
-		WHERE secret_id = $1
-	`, secretID)
+		SELECT sa.user_id, sa.role_id
+		FROM secrets_access sa
+		WHERE sa.secret_id = $1 AND `+activeGrantCondition, secretID)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -177,3 +214,99 @@ func (r *secretsAccessRepository) GetAccessesBySecretPath(ctx context.Context, s
 	}
 	return userIDs, roleIDs, nil
 }
+
+func (r *secretsAccessRepository) SetUserCapabilities(ctx context.Context, secretPath string, userID int, capabilities []string, expiresAt *time.Time) error {
+	if secretPath == "" {
+		return errors.New("secretPath must not be empty")
+	}
+
+	var secretID int
+	err := r.pool.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1`, secretPath).Scan(&secretID)
+	if err != nil {
+		return ErrSecretNotFound
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO secrets_access (secret_id, user_id, capabilities, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (secret_id, user_id) WHERE user_id IS NOT NULL
+		DO UPDATE SET capabilities = EXCLUDED.capabilities, expires_at = EXCLUDED.expires_at
+	`, secretID, userID, capabilities, expiresAt)
+	return err
+}
+
+func (r *secretsAccessRepository) SetRoleCapabilities(ctx context.Context, secretPath string, roleID int, capabilities []string, expiresAt *time.Time) error {
+	if secretPath == "" {
+		return errors.New("secretPath must not be empty")
+	}
+
+	var secretID int
+	err := r.pool.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1`, secretPath).Scan(&secretID)
+	if err != nil {
+		return ErrSecretNotFound
+	}
+
+	_, err = r.pool.Exec(ctx, `
+		INSERT INTO secrets_access (secret_id, role_id, capabilities, expires_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (secret_id, role_id) WHERE role_id IS NOT NULL
+		DO UPDATE SET capabilities = EXCLUDED.capabilities, expires_at = EXCLUDED.expires_at
+	`, secretID, roleID, capabilities, expiresAt)
+	return err
+}
+
+func (r *secretsAccessRepository) GetGrantsBySecretPath(ctx context.Context, secretPath string) ([]SecretGrant, error) {
+	if secretPath == "" {
+		return nil, errors.New("secretPath must not be empty")
+	}
+
+	var secretID int
+	err := r.pool.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1`, secretPath).Scan(&secretID)
+	if err != nil {
+		return nil, ErrSecretNotFound
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT sa.user_id, sa.role_id, sa.capabilities, sa.expires_at
+		FROM secrets_access sa
+		WHERE sa.secret_id = $1 AND `+activeGrantCondition+`
+		ORDER BY sa.id
+	`, secretID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var grants []SecretGrant
+	for rows.Next() {
+		var grant SecretGrant
+		if err := rows.Scan(&grant.UserID, &grant.RoleID, &grant.Capabilities, &grant.ExpiresAt); err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	return grants, rows.Err()
+}
+
+func (r *secretsAccessRepository) DeleteExpiredGrants(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH deleted AS (
+			DELETE FROM secrets_access WHERE expires_at <= $1 RETURNING secret_id
+		)
+		SELECT DISTINCT s.path FROM deleted d JOIN secrets s ON s.id = d.secret_id ORDER BY s.path
+	`, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
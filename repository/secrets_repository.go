@@ -3,17 +3,26 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/generator"
+	"github.com/Vidalee/FishyKeys/internal/schedule"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ErrSecretNotFound = errors.New("secret not found")
+	ErrSecretNotFound      = errors.New("secret not found")
+	ErrSecretAlreadyExists = errors.New("secret already exists")
+	ErrSecretNotStructured = errors.New("secret is not structured")
+	ErrSecretFieldNotFound = errors.New("secret field not found")
+	ErrFolderChanged       = errors.New("secrets were added to or removed from the folder")
 )
 
 type Secret struct {
@@ -22,29 +31,157 @@ type Secret struct {
 	EncryptedEncryptionKey string
 	EncryptedValue         string
 	OwnerUserId            int
+	OwnerRoleID            *int
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
 
+// DecryptedSecret is a secret along with its accesses. OwnerUserId is 0 for the secrets owned by a role. Structured secrets hold their values in Fields and have an
+// empty DecryptedValue, binary secrets are the ones with a ContentType. Version is incremented on every value change.
 type DecryptedSecret struct {
 	ID                     int
 	Path                   string
 	DecryptedEncryptionKey string
 	DecryptedValue         string
+	Structured             bool
+	Fields                 map[string]string
+	ContentType            string
+	Filename               string
+	Version                int
+	GenerationPolicyID     *int
+	RotationSchedule       *string
+	NextRotationAt         *time.Time
 	OwnerUserId            int
-	AuthorizedUserIDs      []int
-	AuthorizedRoleIDs      []int
-	CreatedAt              time.Time
-	UpdatedAt              time.Time
+	OwnerRoleID            *int
+	// Canary secrets are planted for no legitimate workload to read, reading one raises an alert
+	Canary            bool
+	AuthorizedUserIDs []int
+	AuthorizedRoleIDs []int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// SecretEntry is either a secret or a folder found under a path prefix.
+// Folders paths end with a '/' and have zero timestamps.
+type SecretEntry struct {
+	Path      string
+	Folder    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// SecretRotation is a regeneration of a secret, either scheduled or requested by a user. Failed rotations have an
+// Error and keep the version of the secret.
+type SecretRotation struct {
+	ID        int
+	SecretID  int
+	Path      string
+	Version   int
+	Scheduled bool
+	Error     string
+	RotatedAt time.Time
+}
+
+// SecretOwner is either the user or the role owning a secret
+type SecretOwner struct {
+	UserID *int
+	RoleID *int
+}
+
+// SecretMove renames the secret at From to To
+type SecretMove struct {
+	From string
+	To   string
+}
+
+// SecretImport is a value, or the fields of a structured secret when Fields is not nil, imported at a path
+type SecretImport struct {
+	Path   string
+	Value  string
+	Fields map[string]string
+}
+
+// NewSecret is a secret created along with its grants, structured when Fields is not nil and binary when ContentType
+// is set. Generated secrets have a GenerationPolicyID, and a RotationSchedule when they are rotated.
+type NewSecret struct {
+	Path               string
+	OwnerUserID        int
+	Value              string
+	Fields             map[string]string
+	ContentType        string
+	Filename           string
+	GenerationPolicyID *int
+	RotationSchedule   *string
+	NextRotationAt     *time.Time
+	Grants             []SecretGrant
+}
+
+// SecretPromotion copies the value of the Source secret to the To path
+type SecretPromotion struct {
+	Source *DecryptedSecret
+	To     string
 }
 
 type SecretsRepository interface {
 	CreateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, value string) (int, error)
+	// CreateStructuredSecret creates a secret holding named fields, all encrypted with the same key
+	CreateStructuredSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, fields map[string]string) (int, error)
+	// CreateSecretWithGrants creates the secret, its generation settings and its grants in a single transaction,
+	// ErrSecretAlreadyExists is returned when the path is taken
+	CreateSecretWithGrants(ctx context.Context, keyManager *crypto.KeyManager, secret NewSecret) (int, error)
 	GetSecretByPath(ctx context.Context, keyManager *crypto.KeyManager, path string) (*DecryptedSecret, error)
+	// GetReadableSecretsByPaths returns, keyed by path, the secrets among paths the user holds the read capability
+	// on or broke the glass on. Accesses are not loaded.
+	GetReadableSecretsByPaths(ctx context.Context, keyManager *crypto.KeyManager, paths []string, userID int, roleIDs []int) (map[string]*DecryptedSecret, error)
+	// ListSecretsForUser lists the secrets the user holds the list capability on
 	ListSecretsForUser(ctx context.Context, userID int) ([]Secret, error)
+	// ListSecretEntries lists the entries visible to the user under prefix, ordered by path and starting after the
+	// given path. When recursive is false, secrets in sub folders are grouped into a single folder entry.
+	ListSecretEntries(ctx context.Context, prefix string, recursive bool, userID int, roleIDs []int, after string, limit int) ([]SecretEntry, error)
 	DeleteSecret(ctx context.Context, path string) error
-	HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int) (bool, error)
+	// GetSecretPathsByPrefix returns the path of every secret under prefix, regardless of their accesses
+	GetSecretPathsByPrefix(ctx context.Context, prefix string) ([]string, error)
+	// MoveSecrets applies every move in a single transaction. Secrets existing at a destination path and not moved
+	// themselves are deleted when overwrite is true, otherwise ErrSecretAlreadyExists is returned. When folder is not
+	// empty, ErrFolderChanged is returned unless the moves cover exactly the secrets under it.
+	MoveSecrets(ctx context.Context, moves []SecretMove, folder string, overwrite bool) error
+	// PromoteSecrets copies the values of the secrets in a single transaction and returns the paths of the secrets it
+	// created. Created secrets get the owner and the grants of their source, existing ones only get the new value
+	// when overwrite is true, otherwise ErrSecretAlreadyExists is returned.
+	PromoteSecrets(ctx context.Context, keyManager *crypto.KeyManager, promotions []SecretPromotion, overwrite bool) ([]string, error)
+	// ImportSecrets writes the imported values in a single transaction and returns the paths of the secrets it created,
+	// owned by the user. Existing secrets get the new value when overwrite is true, otherwise ErrSecretAlreadyExists
+	// is returned.
+	ImportSecrets(ctx context.Context, keyManager *crypto.KeyManager, ownerUserID int, imports []SecretImport, overwrite bool) ([]string, error)
+	HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int, capability string) (bool, error)
+	// GetCapabilities returns the capabilities held by the user on the secret, in their canonical order
+	GetCapabilities(ctx context.Context, secretPath string, userID int, roleIDs []int) ([]string, error)
+	GetSecretOwner(ctx context.Context, secretPath string) (*SecretOwner, error)
+	// TransferSecretOwnership gives the secret to the new owner
+	TransferSecretOwnership(ctx context.Context, secretPath string, owner SecretOwner) error
+	// TransferUserSecretsOwnership gives every secret owned by the user to the new owner and returns their paths
+	TransferUserSecretsOwnership(ctx context.Context, fromUserID int, owner SecretOwner) ([]string, error)
+	CountSecretsOwnedByUser(ctx context.Context, userID int) (int, error)
+	// SetSecretCanary marks the secret at the path as a canary, or as a normal secret
+	SetSecretCanary(ctx context.Context, path string, canary bool) error
+	ListCanarySecretPaths(ctx context.Context) ([]string, error)
 	UpdateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, newValue string) error
+	// UpdateBinarySecret replaces the content of a binary secret, along with its content type and filename
+	UpdateBinarySecret(ctx context.Context, keyManager *crypto.KeyManager, path string, content []byte, contentType string, filename string) error
+	// SetSecretFields sets and removes fields of a structured secret, keeping its encryption key
+	SetSecretFields(ctx context.Context, keyManager *crypto.KeyManager, path string, fields map[string]string, removedFields []string) error
+	// RegenerateSecret replaces the value or the fields of a secret with a new encryption key, records the generation
+	// policy used and the rotation, and returns the new version of the secret
+	RegenerateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, value string, fields map[string]string, policyID int) (int, error)
+	// SetRotationSchedule sets or, when rotationSchedule is nil, removes the rotation schedule of the secret
+	SetRotationSchedule(ctx context.Context, path string, rotationSchedule *string, nextRotationAt *time.Time) error
+	// RotateDueSecret regenerates one secret whose rotation is due from its generation policy and schedules its next
+	// rotation. Secrets being rotated by another server and the skipped secret IDs are left out. The generated value is
+	// stored only when check accepts it. It returns nil when no rotation is due, and a rotation with an Error when the
+	// secret could not be regenerated.
+	RotateDueSecret(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, skipped []int, check func(ctx context.Context, path string, value string, fields map[string]string) error) (*SecretRotation, error)
+	// ListSecretRotations lists the latest rotations of the secret, most recent first
+	ListSecretRotations(ctx context.Context, path string, limit int) ([]SecretRotation, error)
 }
 
 type secretsRepository struct {
@@ -55,6 +192,42 @@ func NewSecretsRepository(pool *pgxpool.Pool) SecretsRepository {
 	return &secretsRepository{pool: pool}
 }
 
+// secretAccessCondition returns the SQL condition telling whether the user identified by the userID parameter with
+// the roles of the roleIDs parameter holds the capability on the secret aliased "s". The owner, user or role, holds every capability,
+// other users get them through a direct grant on the secret, a grant on one of the folders containing it or an allow
+// rule of their policies, unless a deny rule of their policies matches the secret.
+func secretAccessCondition(userID string, roleIDs string, capability string) string {
+	return fmt.Sprintf(`(
+    s.owner_user_id = %[1]s OR s.owner_role_id = ANY(%[2]s)
+    OR (
+        (
+            EXISTS (
+                SELECT 1 FROM secrets_access sa
+                WHERE sa.secret_id = s.id AND (sa.user_id = %[1]s OR sa.role_id = ANY(%[2]s))
+                AND %[3]s = ANY(sa.capabilities) AND (sa.expires_at IS NULL OR sa.expires_at > NOW())
+            )
+            OR EXISTS (
+                SELECT 1 FROM folder_access fa
+                WHERE starts_with(s.path, fa.path_prefix) AND (fa.user_id = %[1]s OR fa.role_id = ANY(%[2]s))
+                AND %[3]s = ANY(fa.capabilities)
+            )
+            OR EXISTS (
+                SELECT 1 FROM policy_rules pr
+                JOIN policy_attachments pa ON pa.policy_id = pr.policy_id
+                WHERE (pa.user_id = %[1]s OR pa.role_id = ANY(%[2]s))
+                AND pr.effect = 'allow' AND %[3]s = ANY(pr.capabilities) AND s.path ~ pr.pattern
+            )
+        )
+        AND NOT EXISTS (
+            SELECT 1 FROM policy_rules pr
+            JOIN policy_attachments pa ON pa.policy_id = pr.policy_id
+            WHERE (pa.user_id = %[1]s OR pa.role_id = ANY(%[2]s))
+            AND pr.effect = 'deny' AND %[3]s = ANY(pr.capabilities) AND s.path ~ pr.pattern
+        )
+    )
+)`, userID, roleIDs, capability)
+}
+
 func (r *secretsRepository) CreateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, value string) (int, error) {
 	encryptionKey, err := crypto.GenerateSecret()
 	if err != nil {
@@ -86,15 +259,142 @@ RETURNING id
 	return secretID, nil
 }
 
+func (r *secretsRepository) CreateStructuredSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, ownerUserId int, fields map[string]string) (int, error) {
+	encryptionKey, err := crypto.GenerateSecret()
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedValue, err := crypto.EncryptWithKey(encryptionKey, []byte{})
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedEncryptionKey, err := crypto.Encrypt(keyManager, encryptionKey)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+INSERT INTO secrets (path, encrypted_encryption_key, encrypted_value, owner_user_id, structured, created_at, updated_at)
+VALUES ($1, $2, $3, $4, TRUE, $5, $5)
+RETURNING id
+`
+	var secretID int
+	now := time.Now().UTC()
+	err = tx.QueryRow(ctx, query, path, encryptedEncryptionKey, encryptedValue, ownerUserId, now).Scan(&secretID)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := upsertSecretFields(ctx, tx, secretID, encryptionKey, fields); err != nil {
+		return 0, err
+	}
+
+	return secretID, tx.Commit(ctx)
+}
+
+func (r *secretsRepository) CreateSecretWithGrants(ctx context.Context, keyManager *crypto.KeyManager, secret NewSecret) (int, error) {
+	value, err := encryptSecretValue(keyManager, secret.Value)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+INSERT INTO secrets (path, encrypted_encryption_key, encrypted_value, owner_user_id, structured, content_type, filename,
+                     generation_policy_id, rotation_schedule, next_rotation_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, $9, $10, $11, $11)
+ON CONFLICT (path) DO NOTHING
+RETURNING id
+`
+	var secretID int
+	now := time.Now().UTC()
+	err = tx.QueryRow(ctx, query, secret.Path, value.encryptedEncryptionKey, value.encryptedValue, secret.OwnerUserID,
+		secret.Fields != nil, secret.ContentType, secret.Filename, secret.GenerationPolicyID, secret.RotationSchedule,
+		secret.NextRotationAt, now).Scan(&secretID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("%w at path: %s", ErrSecretAlreadyExists, secret.Path)
+		}
+		return 0, err
+	}
+
+	if err := upsertSecretFields(ctx, tx, secretID, value.encryptionKey, secret.Fields); err != nil {
+		return 0, err
+	}
+	for _, grant := range secret.Grants {
+		_, err = tx.Exec(ctx, `
+			INSERT INTO secrets_access (secret_id, user_id, role_id, capabilities, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, secretID, grant.UserID, grant.RoleID, grant.Capabilities, grant.ExpiresAt)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return secretID, tx.Commit(ctx)
+}
+
+func upsertSecretFields(ctx context.Context, tx pgx.Tx, secretID int, encryptionKey []byte, fields map[string]string) error {
+	for name, value := range fields {
+		encryptedValue, err := crypto.EncryptWithKey(encryptionKey, []byte(value))
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+			INSERT INTO secret_fields (secret_id, name, encrypted_value)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (secret_id, name) DO UPDATE SET encrypted_value = EXCLUDED.encrypted_value
+		`, secretID, name, encryptedValue)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *secretsRepository) GetSecretByPath(ctx context.Context, keyManager *crypto.KeyManager, path string) (*DecryptedSecret, error) {
-	query := `SELECT id, path, encrypted_encryption_key, encrypted_value, owner_user_id, created_at, updated_at FROM secrets WHERE path = $1`
+	query := `
+SELECT id, path, encrypted_encryption_key, encrypted_value, COALESCE(owner_user_id, 0), owner_role_id, structured,
+       COALESCE(content_type, ''), COALESCE(filename, ''), version, generation_policy_id,
+       rotation_schedule, next_rotation_at, canary, created_at, updated_at
+FROM secrets
+WHERE path = $1
+`
 	var secret Secret
+	var structured, canary bool
+	var contentType, filename string
+	var version int
+	var generationPolicyID *int
+	var rotationSchedule *string
+	var nextRotationAt *time.Time
 	err := r.pool.QueryRow(ctx, query, path).Scan(
 		&secret.ID,
 		&secret.Path,
 		&secret.EncryptedEncryptionKey,
 		&secret.EncryptedValue,
 		&secret.OwnerUserId,
+		&secret.OwnerRoleID,
+		&structured,
+		&contentType,
+		&filename,
+		&version,
+		&generationPolicyID,
+		&rotationSchedule,
+		&nextRotationAt,
+		&canary,
 		&secret.CreatedAt,
 		&secret.UpdatedAt,
 	)
@@ -111,13 +411,20 @@ func (r *secretsRepository) GetSecretByPath(ctx context.Context, keyManager *cry
 		return nil, err
 	}
 
+	var fields map[string]string
+	if structured {
+		fields, err = r.getSecretFields(ctx, secret.ID, decryptedKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var authorizedUserIDs []int
 	var authorizedRoleIDs []int
 	accessQuery := `
-SELECT user_id, role_id
-FROM secrets_access
-WHERE secret_id = $1
-`
+SELECT sa.user_id, sa.role_id
+FROM secrets_access sa
+WHERE sa.secret_id = $1 AND ` + activeGrantCondition
 	rows, err := r.pool.Query(ctx, accessQuery, secret.ID)
 	if err != nil {
 		return nil, err
@@ -141,7 +448,17 @@ WHERE secret_id = $1
 		Path:                   secret.Path,
 		DecryptedEncryptionKey: string(decryptedKey),
 		DecryptedValue:         string(decryptedValue),
+		Structured:             structured,
+		Fields:                 fields,
+		ContentType:            contentType,
+		Filename:               filename,
+		Version:                version,
+		GenerationPolicyID:     generationPolicyID,
+		RotationSchedule:       rotationSchedule,
+		NextRotationAt:         nextRotationAt,
 		OwnerUserId:            secret.OwnerUserId,
+		OwnerRoleID:            secret.OwnerRoleID,
+		Canary:                 canary,
 		AuthorizedUserIDs:      authorizedUserIDs,
 		AuthorizedRoleIDs:      authorizedRoleIDs,
 		CreatedAt:              secret.CreatedAt,
@@ -149,6 +466,108 @@ WHERE secret_id = $1
 	}, nil
 }
 
+func (r *secretsRepository) getSecretFields(ctx context.Context, secretID int, encryptionKey []byte) (map[string]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT name, encrypted_value FROM secret_fields WHERE secret_id = $1`, secretID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	fields := make(map[string]string)
+	for rows.Next() {
+		var name, encryptedValue string
+		if err := rows.Scan(&name, &encryptedValue); err != nil {
+			return nil, err
+		}
+		value, err := crypto.DecryptWithKey(encryptionKey, encryptedValue)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = string(value)
+	}
+	return fields, rows.Err()
+}
+
+func (r *secretsRepository) GetReadableSecretsByPaths(ctx context.Context, keyManager *crypto.KeyManager, paths []string, userID int, roleIDs []int) (map[string]*DecryptedSecret, error) {
+	query := `
+SELECT s.id, s.path, s.encrypted_encryption_key, s.encrypted_value, COALESCE(s.owner_user_id, 0), s.owner_role_id, s.structured,
+       COALESCE(s.content_type, ''), COALESCE(s.filename, ''), s.version, s.canary, s.created_at, s.updated_at
+FROM secrets s
+WHERE s.path = ANY($1)
+AND (` + secretAccessCondition("$2", "$3", "'"+CapabilityRead+"'") + `
+     OR EXISTS (SELECT 1 FROM break_glass_accesses bga
+                WHERE bga.secret_id = s.id AND bga.user_id = $2 AND bga.expires_at > NOW()))`
+
+	rows, err := r.pool.Query(ctx, query, paths, userID, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	secrets := make(map[string]*DecryptedSecret)
+	keys := make(map[int][]byte)
+	var structuredIDs []int
+	for rows.Next() {
+		var secret DecryptedSecret
+		var encryptedEncryptionKey, encryptedValue string
+		err := rows.Scan(&secret.ID, &secret.Path, &encryptedEncryptionKey, &encryptedValue, &secret.OwnerUserId,
+			&secret.OwnerRoleID, &secret.Structured, &secret.ContentType, &secret.Filename, &secret.Version, &secret.Canary, &secret.CreatedAt, &secret.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		decryptedKey, err := crypto.Decrypt(keyManager, encryptedEncryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		decryptedValue, err := crypto.DecryptWithKey(decryptedKey, encryptedValue)
+		if err != nil {
+			return nil, err
+		}
+		secret.DecryptedEncryptionKey = string(decryptedKey)
+		secret.DecryptedValue = string(decryptedValue)
+		if secret.Structured {
+			secret.Fields = make(map[string]string)
+			keys[secret.ID] = decryptedKey
+			structuredIDs = append(structuredIDs, secret.ID)
+		}
+		secrets[secret.Path] = &secret
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(structuredIDs) == 0 {
+		return secrets, nil
+	}
+
+	byID := make(map[int]*DecryptedSecret, len(structuredIDs))
+	for _, secret := range secrets {
+		byID[secret.ID] = secret
+	}
+	fieldRows, err := r.pool.Query(ctx, `
+		SELECT secret_id, name, encrypted_value
+		FROM secret_fields
+		WHERE secret_id = ANY($1)
+	`, structuredIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer fieldRows.Close()
+	for fieldRows.Next() {
+		var secretID int
+		var name, encryptedValue string
+		if err := fieldRows.Scan(&secretID, &name, &encryptedValue); err != nil {
+			return nil, err
+		}
+		value, err := crypto.DecryptWithKey(keys[secretID], encryptedValue)
+		if err != nil {
+			return nil, err
+		}
+		byID[secretID].Fields[name] = string(value)
+	}
+	return secrets, fieldRows.Err()
+}
+
 func (r *secretsRepository) ListSecretsForUser(ctx context.Context, userID int) ([]Secret, error) {
 	// Get the user's role IDs
 	roleIDs := []int{}
@@ -166,10 +585,9 @@ func (r *secretsRepository) ListSecretsForUser(ctx context.Context, userID int)
 	}
 
 	query := `
-SELECT DISTINCT ON (s.id) s.id, s.path, s.encrypted_encryption_key, s.encrypted_value, s.owner_user_id, s.created_at, s.updated_at
+SELECT s.id, s.path, s.encrypted_encryption_key, s.encrypted_value, COALESCE(s.owner_user_id, 0), s.owner_role_id, s.created_at, s.updated_at
 FROM secrets s
-LEFT JOIN secrets_access sa ON sa.secret_id = s.id
-WHERE s.owner_user_id = $1 OR sa.user_id = $1 OR sa.role_id = ANY($2)
+WHERE ` + secretAccessCondition("$1", "$2", "'list'") + `
 ORDER BY s.id`
 
 	rows, err := r.pool.Query(ctx, query, userID, roleIDs)
@@ -187,6 +605,7 @@ ORDER BY s.id`
 			&secret.EncryptedEncryptionKey,
 			&secret.EncryptedValue,
 			&secret.OwnerUserId,
+			&secret.OwnerRoleID,
 			&secret.CreatedAt,
 			&secret.UpdatedAt,
 		); err != nil {
@@ -197,6 +616,52 @@ ORDER BY s.id`
 	return secrets, nil
 }
 
+func (r *secretsRepository) ListSecretEntries(ctx context.Context, prefix string, recursive bool, userID int, roleIDs []int, after string, limit int) ([]SecretEntry, error) {
+	query := `
+WITH visible AS (
+    SELECT s.path, s.created_at, s.updated_at
+    FROM secrets s
+    WHERE starts_with(s.path, $1)
+    AND ` + secretAccessCondition("$3", "$4", "'list'") + `
+), entries AS (
+    SELECT
+        CASE
+            WHEN NOT $2 AND strpos(substr(v.path, length($1) + 1), '/') > 0
+                THEN $1 || split_part(substr(v.path, length($1) + 1), '/', 1) || '/'
+            ELSE v.path
+        END AS entry_path,
+        v.path,
+        v.created_at,
+        v.updated_at
+    FROM visible v
+)
+SELECT DISTINCT ON (entry_path) entry_path, entry_path <> path AS folder, created_at, updated_at
+FROM entries
+WHERE entry_path > $5
+ORDER BY entry_path
+LIMIT $6`
+
+	rows, err := r.pool.Query(ctx, query, prefix, recursive, userID, roleIDs, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []SecretEntry
+	for rows.Next() {
+		var entry SecretEntry
+		if err := rows.Scan(&entry.Path, &entry.Folder, &entry.CreatedAt, &entry.UpdatedAt); err != nil {
+			return nil, err
+		}
+		if entry.Folder {
+			entry.CreatedAt = time.Time{}
+			entry.UpdatedAt = time.Time{}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
 func (r *secretsRepository) DeleteSecret(ctx context.Context, path string) error {
 	cmd, err := r.pool.Exec(ctx, `DELETE FROM secrets WHERE path = $1`, path)
 	if err != nil {
@@ -208,7 +673,270 @@ func (r *secretsRepository) DeleteSecret(ctx context.Context, path string) error
 	return nil
 }
 
-func (r *secretsRepository) HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int) (bool, error) {
+func (r *secretsRepository) GetSecretPathsByPrefix(ctx context.Context, prefix string) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT path FROM secrets WHERE starts_with(path, $1) ORDER BY path`, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
+
+func (r *secretsRepository) MoveSecrets(ctx context.Context, moves []SecretMove, folder string, overwrite bool) error {
+	sources := make([]string, len(moves))
+	destinations := make([]string, len(moves))
+	for i, move := range moves {
+		sources[i] = move.From
+		destinations[i] = move.To
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT id, path FROM secrets WHERE path = ANY($1) FOR UPDATE`, sources)
+	if err != nil {
+		return err
+	}
+	idsByPath := make(map[string]int, len(moves))
+	for rows.Next() {
+		var id int
+		var path string
+		if err := rows.Scan(&id, &path); err != nil {
+			rows.Close()
+			return err
+		}
+		idsByPath[path] = id
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(idsByPath) != len(moves) {
+		return ErrSecretNotFound
+	}
+	if folder != "" {
+		// The sources are locked, so only a secret created under the folder since it was listed is left to find
+		var added bool
+		query := `SELECT EXISTS (SELECT 1 FROM secrets WHERE starts_with(path, $1) AND NOT path = ANY($2))`
+		if err := tx.QueryRow(ctx, query, folder, sources).Scan(&added); err != nil {
+			return err
+		}
+		if added {
+			return ErrFolderChanged
+		}
+	}
+
+	if !overwrite {
+		query := `SELECT path FROM secrets WHERE path = ANY($1) AND NOT path = ANY($2) ORDER BY path LIMIT 1`
+		var existing string
+		err = tx.QueryRow(ctx, query, destinations, sources).Scan(&existing)
+		if err == nil {
+			return fmt.Errorf("%w at path: %s", ErrSecretAlreadyExists, existing)
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+	} else {
+		_, err = tx.Exec(ctx, `DELETE FROM secrets WHERE path = ANY($1) AND NOT path = ANY($2)`, destinations, sources)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Paths are unique and checked on every row update, so the secrets are first moved out of the way to avoid
+	// conflicts between the destination of a secret and the source of another one
+	ids := make([]int, len(moves))
+	for i, move := range moves {
+		ids[i] = idsByPath[move.From]
+	}
+	if _, err := tx.Exec(ctx, `UPDATE secrets SET path = 'moving:' || id WHERE id = ANY($1)`, ids); err != nil {
+		return err
+	}
+	query := `
+UPDATE secrets s
+SET path = m.path
+FROM unnest($1::INTEGER[], $2::TEXT[]) AS m(id, path)
+WHERE s.id = m.id
+`
+	if _, err := tx.Exec(ctx, query, ids, destinations); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *secretsRepository) PromoteSecrets(ctx context.Context, keyManager *crypto.KeyManager, promotions []SecretPromotion, overwrite bool) ([]string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var created []string
+	now := time.Now().UTC()
+	for _, promotion := range promotions {
+		value, err := encryptSecretValue(keyManager, promotion.Source.DecryptedValue)
+		if err != nil {
+			return nil, err
+		}
+
+		var secretID int
+		err = tx.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1 FOR UPDATE`, promotion.To).Scan(&secretID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			query := `
+INSERT INTO secrets (path, encrypted_encryption_key, encrypted_value, owner_user_id, owner_role_id, structured,
+                     content_type, filename, created_at, updated_at)
+SELECT $1, $2, $3, owner_user_id, owner_role_id, structured, content_type, filename, $4, $4
+FROM secrets
+WHERE id = $5
+RETURNING id
+`
+			err = tx.QueryRow(ctx, query, promotion.To, value.encryptedEncryptionKey, value.encryptedValue, now, promotion.Source.ID).Scan(&secretID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, fmt.Errorf("%w at path: %s", ErrSecretNotFound, promotion.Source.Path)
+			}
+			if err != nil {
+				return nil, err
+			}
+			_, err = tx.Exec(ctx, `
+				INSERT INTO secrets_access (secret_id, user_id, role_id, capabilities, expires_at)
+				SELECT $1, sa.user_id, sa.role_id, sa.capabilities, sa.expires_at
+				FROM secrets_access sa
+				WHERE sa.secret_id = $2 AND `+activeGrantCondition, secretID, promotion.Source.ID)
+			if err != nil {
+				return nil, err
+			}
+			created = append(created, promotion.To)
+		case err != nil:
+			return nil, err
+		case !overwrite:
+			return nil, fmt.Errorf("%w at path: %s", ErrSecretAlreadyExists, promotion.To)
+		default:
+			err = replaceSecretValue(ctx, tx, secretID, value, promotion.Source.Structured, promotion.Source.ContentType, promotion.Source.Filename, now)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := upsertSecretFields(ctx, tx, secretID, value.encryptionKey, promotion.Source.Fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+func (r *secretsRepository) ImportSecrets(ctx context.Context, keyManager *crypto.KeyManager, ownerUserID int, imports []SecretImport, overwrite bool) ([]string, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var created []string
+	now := time.Now().UTC()
+	for _, secretImport := range imports {
+		value, err := encryptSecretValue(keyManager, secretImport.Value)
+		if err != nil {
+			return nil, err
+		}
+		structured := secretImport.Fields != nil
+
+		var secretID int
+		err = tx.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1 FOR UPDATE`, secretImport.Path).Scan(&secretID)
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			query := `
+INSERT INTO secrets (path, encrypted_encryption_key, encrypted_value, owner_user_id, structured, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $6)
+RETURNING id
+`
+			err = tx.QueryRow(ctx, query, secretImport.Path, value.encryptedEncryptionKey, value.encryptedValue, ownerUserID, structured, now).Scan(&secretID)
+			if err != nil {
+				return nil, err
+			}
+			created = append(created, secretImport.Path)
+		case err != nil:
+			return nil, err
+		case !overwrite:
+			return nil, fmt.Errorf("%w at path: %s", ErrSecretAlreadyExists, secretImport.Path)
+		default:
+			if err := replaceSecretValue(ctx, tx, secretID, value, structured, "", "", now); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := upsertSecretFields(ctx, tx, secretID, value.encryptionKey, secretImport.Fields); err != nil {
+			return nil, err
+		}
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// encryptedSecretValue is a value encrypted with a new key, along with the key encrypted with the master key
+type encryptedSecretValue struct {
+	encryptionKey          []byte
+	encryptedEncryptionKey string
+	encryptedValue         string
+}
+
+func encryptSecretValue(keyManager *crypto.KeyManager, value string) (*encryptedSecretValue, error) {
+	encryptionKey, err := crypto.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	encryptedValue, err := crypto.EncryptWithKey(encryptionKey, []byte(value))
+	if err != nil {
+		return nil, err
+	}
+	encryptedEncryptionKey, err := crypto.Encrypt(keyManager, encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedSecretValue{
+		encryptionKey:          encryptionKey,
+		encryptedEncryptionKey: encryptedEncryptionKey,
+		encryptedValue:         encryptedValue,
+	}, nil
+}
+
+// replaceSecretValue replaces the value and the kind of the secret, deleting its fields which were encrypted with the
+// replaced key
+func replaceSecretValue(ctx context.Context, tx pgx.Tx, secretID int, value *encryptedSecretValue, structured bool, contentType string, filename string, now time.Time) error {
+	query := `
+UPDATE secrets
+SET encrypted_encryption_key = $1,
+    encrypted_value = $2,
+    structured = $3,
+    content_type = NULLIF($4, ''),
+    filename = NULLIF($5, ''),
+    version = version + 1,
+    updated_at = $6
+WHERE id = $7
+`
+	_, err := tx.Exec(ctx, query, value.encryptedEncryptionKey, value.encryptedValue, structured, contentType, filename, now, secretID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM secret_fields WHERE secret_id = $1`, secretID)
+	return err
+}
+
+func (r *secretsRepository) HasAccess(ctx context.Context, secretPath string, userID *int, roleIDs []int, capability string) (bool, error) {
 	if secretPath == "" {
 		return false, errors.New("secretPath must not be empty")
 	}
@@ -216,18 +944,11 @@ func (r *secretsRepository) HasAccess(ctx context.Context, secretPath string, us
 	query := `
 SELECT 1
 FROM secrets s
-LEFT JOIN secrets_access sa ON sa.secret_id = s.id
 WHERE s.path = $1
-AND (
-    s.owner_user_id = $2 OR
-    sa.user_id = $2 OR
-    sa.role_id = ANY($3)
-)
-LIMIT 1
-`
+AND ` + secretAccessCondition("$2", "$3", "$4")
 
 	var exists int
-	err := r.pool.QueryRow(ctx, query, secretPath, *userID, roleIDs).Scan(&exists)
+	err := r.pool.QueryRow(ctx, query, secretPath, *userID, roleIDs, capability).Scan(&exists)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return false, nil
@@ -237,6 +958,134 @@ LIMIT 1
 	return true, nil
 }
 
+func (r *secretsRepository) GetCapabilities(ctx context.Context, secretPath string, userID int, roleIDs []int) ([]string, error) {
+	if secretPath == "" {
+		return nil, errors.New("secretPath must not be empty")
+	}
+
+	query := `
+SELECT COALESCE(s.owner_user_id = $2 OR s.owner_role_id = ANY($3), FALSE), ARRAY(
+    SELECT DISTINCT unnest(g.capabilities)
+    FROM (
+        SELECT sa.capabilities FROM secrets_access sa
+        WHERE sa.secret_id = s.id AND (sa.user_id = $2 OR sa.role_id = ANY($3)) AND ` + activeGrantCondition + `
+        UNION ALL
+        SELECT fa.capabilities FROM folder_access fa
+        WHERE starts_with(s.path, fa.path_prefix) AND (fa.user_id = $2 OR fa.role_id = ANY($3))
+        UNION ALL
+        SELECT pr.capabilities FROM policy_rules pr
+        JOIN policy_attachments pa ON pa.policy_id = pr.policy_id
+        WHERE (pa.user_id = $2 OR pa.role_id = ANY($3)) AND pr.effect = 'allow' AND s.path ~ pr.pattern
+    ) g
+), ARRAY(
+    SELECT DISTINCT unnest(pr.capabilities)
+    FROM policy_rules pr
+    JOIN policy_attachments pa ON pa.policy_id = pr.policy_id
+    WHERE (pa.user_id = $2 OR pa.role_id = ANY($3)) AND pr.effect = 'deny' AND s.path ~ pr.pattern
+)
+FROM secrets s
+WHERE s.path = $1
+`
+	var isOwner bool
+	var granted, denied []string
+	err := r.pool.QueryRow(ctx, query, secretPath, userID, roleIDs).Scan(&isOwner, &granted, &denied)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, err
+	}
+
+	var capabilities []string
+	for _, capability := range AllCapabilities {
+		if isOwner || (slices.Contains(granted, capability) && !slices.Contains(denied, capability)) {
+			capabilities = append(capabilities, capability)
+		}
+	}
+	return capabilities, nil
+}
+
+func (r *secretsRepository) GetSecretOwner(ctx context.Context, secretPath string) (*SecretOwner, error) {
+	var owner SecretOwner
+	err := r.pool.QueryRow(ctx, `SELECT owner_user_id, owner_role_id FROM secrets WHERE path = $1`, secretPath).Scan(&owner.UserID, &owner.RoleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrSecretNotFound
+		}
+		return nil, err
+	}
+	return &owner, nil
+}
+
+func (r *secretsRepository) TransferSecretOwnership(ctx context.Context, secretPath string, owner SecretOwner) error {
+	cmd, err := r.pool.Exec(ctx, `
+		UPDATE secrets SET owner_user_id = $2, owner_role_id = $3 WHERE path = $1
+	`, secretPath, owner.UserID, owner.RoleID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func (r *secretsRepository) TransferUserSecretsOwnership(ctx context.Context, fromUserID int, owner SecretOwner) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		UPDATE secrets SET owner_user_id = $2, owner_role_id = $3 WHERE owner_user_id = $1
+		RETURNING path
+	`, fromUserID, owner.UserID, owner.RoleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
+
+func (r *secretsRepository) CountSecretsOwnedByUser(ctx context.Context, userID int) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM secrets WHERE owner_user_id = $1`, userID).Scan(&count)
+	return count, err
+}
+
+func (r *secretsRepository) SetSecretCanary(ctx context.Context, path string, canary bool) error {
+	cmd, err := r.pool.Exec(ctx, `UPDATE secrets SET canary = $2 WHERE path = $1`, path, canary)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func (r *secretsRepository) ListCanarySecretPaths(ctx context.Context) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `SELECT path FROM secrets WHERE canary ORDER BY path`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, rows.Err()
+}
+
 func (r *secretsRepository) UpdateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, newValue string) error {
 	encryptionKey, err := crypto.GenerateSecret()
 	if err != nil {
@@ -257,6 +1106,7 @@ func (r *secretsRepository) UpdateSecret(ctx context.Context, keyManager *crypto
 UPDATE secrets
 SET encrypted_encryption_key = $1,
     encrypted_value = $2,
+    version = version + 1,
     updated_at = $3
 WHERE path = $4
 `
@@ -269,3 +1119,307 @@ WHERE path = $4
 	}
 	return nil
 }
+
+func (r *secretsRepository) UpdateBinarySecret(ctx context.Context, keyManager *crypto.KeyManager, path string, content []byte, contentType string, filename string) error {
+	encryptionKey, err := crypto.GenerateSecret()
+	if err != nil {
+		return err
+	}
+
+	encryptedValue, err := crypto.EncryptWithKey(encryptionKey, content)
+	if err != nil {
+		return err
+	}
+
+	encryptedEncryptionKey, err := crypto.Encrypt(keyManager, encryptionKey)
+	if err != nil {
+		return err
+	}
+
+	query := `
+UPDATE secrets
+SET encrypted_encryption_key = $1,
+    encrypted_value = $2,
+    content_type = $3,
+    filename = $4,
+    version = version + 1,
+    updated_at = $5
+WHERE path = $6
+`
+	cmd, err := r.pool.Exec(ctx, query, encryptedEncryptionKey, encryptedValue, contentType, filename, time.Now().UTC(), path)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func (r *secretsRepository) SetSecretFields(ctx context.Context, keyManager *crypto.KeyManager, path string, fields map[string]string, removedFields []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var secretID int
+	var encryptedEncryptionKey string
+	var structured bool
+	query := `SELECT id, encrypted_encryption_key, structured FROM secrets WHERE path = $1 FOR UPDATE`
+	err = tx.QueryRow(ctx, query, path).Scan(&secretID, &encryptedEncryptionKey, &structured)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrSecretNotFound
+		}
+		return err
+	}
+	if !structured {
+		return ErrSecretNotStructured
+	}
+
+	encryptionKey, err := crypto.Decrypt(keyManager, encryptedEncryptionKey)
+	if err != nil {
+		return err
+	}
+	if err := upsertSecretFields(ctx, tx, secretID, encryptionKey, fields); err != nil {
+		return err
+	}
+
+	for _, name := range removedFields {
+		cmd, err := tx.Exec(ctx, `DELETE FROM secret_fields WHERE secret_id = $1 AND name = $2`, secretID, name)
+		if err != nil {
+			return err
+		}
+		if cmd.RowsAffected() == 0 {
+			return fmt.Errorf("%w: %s", ErrSecretFieldNotFound, name)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE secrets SET version = version + 1, updated_at = $1 WHERE id = $2`, time.Now().UTC(), secretID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *secretsRepository) RegenerateSecret(ctx context.Context, keyManager *crypto.KeyManager, path string, value string, fields map[string]string, policyID int) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var secretID int
+	err = tx.QueryRow(ctx, `SELECT id FROM secrets WHERE path = $1 FOR UPDATE`, path).Scan(&secretID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrSecretNotFound
+		}
+		return 0, err
+	}
+
+	now := time.Now().UTC()
+	version, err := regenerateSecret(ctx, tx, keyManager, secretID, value, fields, policyID, now)
+	if err != nil {
+		return 0, err
+	}
+	if err := recordRotation(ctx, tx, secretID, version, false, nil, now); err != nil {
+		return 0, err
+	}
+
+	return version, tx.Commit(ctx)
+}
+
+// regenerateSecret replaces the value or the fields of a locked secret with a new encryption key
+func regenerateSecret(ctx context.Context, tx pgx.Tx, keyManager *crypto.KeyManager, secretID int, value string, fields map[string]string, policyID int, now time.Time) (int, error) {
+	encryptionKey, err := crypto.GenerateSecret()
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedValue, err := crypto.EncryptWithKey(encryptionKey, []byte(value))
+	if err != nil {
+		return 0, err
+	}
+
+	encryptedEncryptionKey, err := crypto.Encrypt(keyManager, encryptionKey)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+UPDATE secrets
+SET encrypted_encryption_key = $1,
+    encrypted_value = $2,
+    structured = $3,
+    generation_policy_id = $4,
+    version = version + 1,
+    updated_at = $5
+WHERE id = $6
+RETURNING version
+`
+	var version int
+	err = tx.QueryRow(ctx, query, encryptedEncryptionKey, encryptedValue, fields != nil, policyID, now, secretID).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
+
+	// The previous fields were encrypted with the previous key, they are all replaced
+	if _, err := tx.Exec(ctx, `DELETE FROM secret_fields WHERE secret_id = $1`, secretID); err != nil {
+		return 0, err
+	}
+	if err := upsertSecretFields(ctx, tx, secretID, encryptionKey, fields); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// recordRotation adds the rotation to the history of the secret
+func recordRotation(ctx context.Context, tx pgx.Tx, secretID int, version int, scheduled bool, rotationErr error, now time.Time) error {
+	var errorText *string
+	if rotationErr != nil {
+		text := rotationErr.Error()
+		errorText = &text
+	}
+	_, err := tx.Exec(ctx, `
+		INSERT INTO secret_rotations (secret_id, version, scheduled, error, rotated_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, secretID, version, scheduled, errorText, now)
+	return err
+}
+
+func (r *secretsRepository) SetRotationSchedule(ctx context.Context, path string, rotationSchedule *string, nextRotationAt *time.Time) error {
+	cmd, err := r.pool.Exec(ctx, `
+		UPDATE secrets SET rotation_schedule = $1, next_rotation_at = $2 WHERE path = $3
+	`, rotationSchedule, nextRotationAt, path)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrSecretNotFound
+	}
+	return nil
+}
+
+func (r *secretsRepository) RotateDueSecret(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, skipped []int, check func(ctx context.Context, path string, value string, fields map[string]string) error) (*SecretRotation, error) {
+	if skipped == nil {
+		skipped = []int{}
+	}
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// SKIP LOCKED lets concurrent servers pick different secrets, and a rotated secret is no longer due once its
+	// transaction commits
+	query := `
+SELECT s.id, s.path, s.version, s.rotation_schedule, s.generation_policy_id, gp.kind, gp.parameters
+FROM secrets s
+LEFT JOIN generation_policies gp ON gp.id = s.generation_policy_id
+WHERE s.next_rotation_at <= $1 AND s.id <> ALL($2)
+ORDER BY s.next_rotation_at
+LIMIT 1
+FOR UPDATE OF s SKIP LOCKED
+`
+	var rotation SecretRotation
+	var rotationSchedule string
+	var policyID *int
+	var kind *string
+	var parameters []byte
+	err = tx.QueryRow(ctx, query, now, skipped).Scan(&rotation.SecretID, &rotation.Path, &rotation.Version, &rotationSchedule, &policyID, &kind, &parameters)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	rotation.Scheduled = true
+	rotation.RotatedAt = now
+
+	// A schedule which no longer parses or matches stops the rotations instead of keeping the secret due
+	var nextRotationAt *time.Time
+	parsedSchedule, err := schedule.Parse(rotationSchedule)
+	if err == nil {
+		if next := parsedSchedule.Next(now); next.After(now) {
+			nextRotationAt = &next
+		}
+	}
+	_, err = tx.Exec(ctx, `UPDATE secrets SET next_rotation_at = $1 WHERE id = $2`, nextRotationAt, rotation.SecretID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotationErr := func() error {
+		if policyID == nil {
+			return fmt.Errorf("secret has no generation policy")
+		}
+		var spec generator.Spec
+		if err := json.Unmarshal(parameters, &spec); err != nil {
+			return err
+		}
+		spec.Kind = *kind
+		generated, err := generator.Generate(spec)
+		if err != nil {
+			return err
+		}
+		if err := check(ctx, rotation.Path, generated.Value, generated.Fields); err != nil {
+			return err
+		}
+
+		// A failed statement aborts the whole transaction, the savepoint keeps the next rotation time and the record
+		// of the failure
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return err
+		}
+		version, err := regenerateSecret(ctx, savepoint, keyManager, rotation.SecretID, generated.Value, generated.Fields, *policyID, now)
+		if err != nil {
+			if rollbackErr := savepoint.Rollback(ctx); rollbackErr != nil {
+				return errors.Join(err, rollbackErr)
+			}
+			return err
+		}
+		if err := savepoint.Commit(ctx); err != nil {
+			return err
+		}
+		rotation.Version = version
+		return nil
+	}()
+	if rotationErr != nil {
+		rotation.Error = rotationErr.Error()
+	}
+
+	if err := recordRotation(ctx, tx, rotation.SecretID, rotation.Version, true, rotationErr, now); err != nil {
+		return nil, err
+	}
+	return &rotation, tx.Commit(ctx)
+}
+
+func (r *secretsRepository) ListSecretRotations(ctx context.Context, path string, limit int) ([]SecretRotation, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT sr.id, sr.secret_id, s.path, sr.version, sr.scheduled, COALESCE(sr.error, ''), sr.rotated_at
+		FROM secret_rotations sr
+		JOIN secrets s ON s.id = sr.secret_id
+		WHERE s.path = $1
+		ORDER BY sr.rotated_at DESC, sr.id DESC
+		LIMIT $2
+	`, path, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rotations []SecretRotation
+	for rows.Next() {
+		var rotation SecretRotation
+		err := rows.Scan(&rotation.ID, &rotation.SecretID, &rotation.Path, &rotation.Version, &rotation.Scheduled, &rotation.Error, &rotation.RotatedAt)
+		if err != nil {
+			return nil, err
+		}
+		rotations = append(rotations, rotation)
+	}
+	return rotations, rows.Err()
+}
@@ -5,11 +5,13 @@ import (
 	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 var (
-	ErrUserNotFound = errors.New("user not found")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrUserOwnsSecrets = errors.New("user owns secrets")
 )
 
 type User struct {
@@ -26,7 +28,9 @@ type UsersRepository interface {
 	GetUserByID(ctx context.Context, id int) (*User, error)
 	GetUsersByIDs(ctx context.Context, ids []int) ([]User, error)
 	ListUsers(ctx context.Context) ([]User, error)
-	DeleteUser(ctx context.Context, username string) error
+	// DeleteUser deletes the user after giving the secrets it owns to reassignTo. It returns ErrUserOwnsSecrets when
+	// the user owns secrets and reassignTo is nil.
+	DeleteUser(ctx context.Context, username string, reassignTo *SecretOwner) error
 }
 
 type usersRepository struct {
@@ -114,13 +118,42 @@ func (r *usersRepository) ListUsers(ctx context.Context) ([]User, error) {
 	return users, nil
 }
 
-func (r *usersRepository) DeleteUser(ctx context.Context, username string) error {
-	cmd, err := r.pool.Exec(ctx, `DELETE FROM users WHERE username = $1`, username)
+func (r *usersRepository) DeleteUser(ctx context.Context, username string, reassignTo *SecretOwner) error {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return err
 	}
-	if cmd.RowsAffected() == 0 {
-		return ErrUserNotFound
+	defer tx.Rollback(ctx)
+
+	var userID int
+	err = tx.QueryRow(ctx, `SELECT id FROM users WHERE username = $1 FOR UPDATE`, username).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	if reassignTo != nil {
+		_, err = tx.Exec(ctx, `
+			UPDATE secrets SET owner_user_id = $2, owner_role_id = $3 WHERE owner_user_id = $1
+		`, userID, reassignTo.UserID, reassignTo.RoleID)
+		if err != nil {
+			return err
+		}
+	} else {
+		var ownsSecrets bool
+		err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM secrets WHERE owner_user_id = $1)`, userID).Scan(&ownsSecrets)
+		if err != nil {
+			return err
+		}
+		if ownsSecrets {
+			return ErrUserOwnsSecrets
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM users WHERE id = $1`, userID); err != nil {
+		return err
 	}
-	return nil
+	return tx.Commit(ctx)
 }
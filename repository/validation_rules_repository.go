@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrValidationRuleNotFound = errors.New("validation rule not found")
+
+// ValidationRule constrains the values of the secrets under PathPrefix. It applies to the value of plain secrets and
+// to every field of structured secrets, or only to the field named Field when set.
+type ValidationRule struct {
+	ID         int
+	PathPrefix string
+	Kind       string
+	Parameter  string
+	Field      *string
+	CreatedAt  time.Time
+}
+
+type ValidationRulesRepository interface {
+	CreateValidationRule(ctx context.Context, rule ValidationRule) (int, error)
+	DeleteValidationRule(ctx context.Context, id int) error
+	ListValidationRules(ctx context.Context) ([]ValidationRule, error)
+	// ListValidationRulesForPath lists the rules of the folders containing the secret at the path
+	ListValidationRulesForPath(ctx context.Context, secretPath string) ([]ValidationRule, error)
+}
+
+type validationRulesRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewValidationRulesRepository(pool *pgxpool.Pool) ValidationRulesRepository {
+	return &validationRulesRepository{pool: pool}
+}
+
+func (r *validationRulesRepository) CreateValidationRule(ctx context.Context, rule ValidationRule) (int, error) {
+	if rule.PathPrefix == "" {
+		return 0, errors.New("pathPrefix must not be empty")
+	}
+
+	var id int
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO validation_rules (path_prefix, kind, parameter, field, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, rule.PathPrefix, rule.Kind, rule.Parameter, rule.Field, time.Now().UTC()).Scan(&id)
+	return id, err
+}
+
+func (r *validationRulesRepository) DeleteValidationRule(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM validation_rules WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrValidationRuleNotFound
+	}
+	return nil
+}
+
+func (r *validationRulesRepository) ListValidationRules(ctx context.Context) ([]ValidationRule, error) {
+	return r.listValidationRules(ctx, `
+		SELECT id, path_prefix, kind, parameter, field, created_at
+		FROM validation_rules
+		ORDER BY path_prefix, id
+	`)
+}
+
+func (r *validationRulesRepository) ListValidationRulesForPath(ctx context.Context, secretPath string) ([]ValidationRule, error) {
+	return r.listValidationRules(ctx, `
+		SELECT id, path_prefix, kind, parameter, field, created_at
+		FROM validation_rules
+		WHERE starts_with($1, path_prefix)
+		ORDER BY path_prefix, id
+	`, secretPath)
+}
+
+func (r *validationRulesRepository) listValidationRules(ctx context.Context, query string, args ...any) ([]ValidationRule, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []ValidationRule
+	for rows.Next() {
+		var rule ValidationRule
+		if err := rows.Scan(&rule.ID, &rule.PathPrefix, &rule.Kind, &rule.Parameter, &rule.Field, &rule.CreatedAt); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
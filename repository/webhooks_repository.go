@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook is an endpoint notified of the events matching one of its filters and, when it has a path prefix, about a
+// path under it. Its signing secret is only readable by the deliveries.
+type Webhook struct {
+	ID         int
+	Name       string
+	URL        string
+	Events     []string
+	PathPrefix *string
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int
+	WebhookID      int
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  *time.Time
+	LastAttemptAt  *time.Time
+	ResponseStatus *int
+	Error          *string
+	ReplayOf       *int
+	CreatedAt      time.Time
+}
+
+// DueWebhookDelivery is a delivery claimed for an attempt, along with where to send it and how to sign it
+type DueWebhookDelivery struct {
+	WebhookDelivery
+	URL    string
+	Secret string
+}
+
+type WebhooksRepository interface {
+	CreateWebhook(ctx context.Context, keyManager *crypto.KeyManager, webhook Webhook, secret string) (int, error)
+	UpdateWebhook(ctx context.Context, webhook Webhook) error
+	DeleteWebhook(ctx context.Context, id int) error
+	GetWebhookByID(ctx context.Context, id int) (*Webhook, error)
+	GetWebhookByName(ctx context.Context, name string) (*Webhook, error)
+	ListWebhooks(ctx context.Context) ([]Webhook, error)
+	CreateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) (int, error)
+	GetWebhookDelivery(ctx context.Context, webhookID int, id int) (*WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, webhookID int, status *string, limit int) ([]WebhookDelivery, error)
+	ClaimDueWebhookDelivery(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, lease time.Duration) (*DueWebhookDelivery, error)
+	RecordWebhookDeliveryAttempt(ctx context.Context, id int, status string, responseStatus *int, attemptErr *string, nextAttemptAt *time.Time) error
+}
+
+type webhooksRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWebhooksRepository(pool *pgxpool.Pool) WebhooksRepository {
+	return &webhooksRepository{pool: pool}
+}
+
+func (r *webhooksRepository) CreateWebhook(ctx context.Context, keyManager *crypto.KeyManager, webhook Webhook, secret string) (int, error) {
+	encryptedSecret, err := crypto.Encrypt(keyManager, []byte(secret))
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+INSERT INTO webhooks (name, url, events, path_prefix, encrypted_secret, enabled, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+RETURNING id
+`
+	var id int
+	err = r.pool.QueryRow(ctx, query, webhook.Name, webhook.URL, webhook.Events, webhook.PathPrefix, encryptedSecret,
+		webhook.Enabled, time.Now().UTC()).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (r *webhooksRepository) UpdateWebhook(ctx context.Context, webhook Webhook) error {
+	query := `
+UPDATE webhooks
+SET name = $1,
+    url = $2,
+    events = $3,
+    path_prefix = $4,
+    enabled = $5,
+    updated_at = $6
+WHERE id = $7
+`
+	cmd, err := r.pool.Exec(ctx, query, webhook.Name, webhook.URL, webhook.Events, webhook.PathPrefix, webhook.Enabled,
+		time.Now().UTC(), webhook.ID)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *webhooksRepository) DeleteWebhook(ctx context.Context, id int) error {
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *webhooksRepository) GetWebhookByID(ctx context.Context, id int) (*Webhook, error) {
+	return r.getWebhook(ctx, `id = $1`, id)
+}
+
+func (r *webhooksRepository) GetWebhookByName(ctx context.Context, name string) (*Webhook, error) {
+	return r.getWebhook(ctx, `name = $1`, name)
+}
+
+func (r *webhooksRepository) getWebhook(ctx context.Context, where string, arg any) (*Webhook, error) {
+	row := r.pool.QueryRow(ctx, `SELECT id, name, url, events, path_prefix, enabled, created_at, updated_at FROM webhooks WHERE `+where, arg)
+	webhook, err := scanWebhook(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookNotFound
+		}
+		return nil, err
+	}
+	return webhook, nil
+}
+
+func (r *webhooksRepository) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, name, url, events, path_prefix, enabled, created_at, updated_at FROM webhooks ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, *webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func scanWebhook(row pgx.Row) (*Webhook, error) {
+	var webhook Webhook
+	err := row.Scan(&webhook.ID, &webhook.Name, &webhook.URL, &webhook.Events, &webhook.PathPrefix, &webhook.Enabled,
+		&webhook.CreatedAt, &webhook.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook, nil
+}
+
+// CreateWebhookDelivery adds a pending delivery, attempted as soon as possible
+func (r *webhooksRepository) CreateWebhookDelivery(ctx context.Context, delivery WebhookDelivery) (int, error) {
+	query := `
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status, next_attempt_at, replay_of, created_at)
+VALUES ($1, $2, $3, $4, 'pending', $5, $6, $5)
+RETURNING id
+`
+	var id int
+	err := r.pool.QueryRow(ctx, query, delivery.WebhookID, delivery.EventID, delivery.EventType, delivery.Payload,
+		time.Now().UTC(), delivery.ReplayOf).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+const webhookDeliveryColumns = `d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at,
+       d.last_attempt_at, d.response_status, d.error, d.replay_of, d.created_at`
+
+func (r *webhooksRepository) GetWebhookDelivery(ctx context.Context, webhookID int, id int) (*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries d WHERE d.webhook_id = $1 AND d.id = $2`
+	delivery, err := scanWebhookDelivery(r.pool.QueryRow(ctx, query, webhookID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of the webhook, optionally only the ones with the given status
+func (r *webhooksRepository) ListWebhookDeliveries(ctx context.Context, webhookID int, status *string, limit int) ([]WebhookDelivery, error) {
+	query := `
+SELECT ` + webhookDeliveryColumns + `
+FROM webhook_deliveries d
+WHERE d.webhook_id = $1 AND ($2::TEXT IS NULL OR d.status = $2)
+ORDER BY d.id DESC
+LIMIT $3
+`
+	rows, err := r.pool.Query(ctx, query, webhookID, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []WebhookDelivery
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, *delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+func scanWebhookDelivery(row pgx.Row) (*WebhookDelivery, error) {
+	var delivery WebhookDelivery
+	err := row.Scan(&delivery.ID, &delivery.WebhookID, &delivery.EventID, &delivery.EventType, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastAttemptAt, &delivery.ResponseStatus,
+		&delivery.Error, &delivery.ReplayOf, &delivery.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// ClaimDueWebhookDelivery counts an attempt of a due pending delivery of an enabled webhook and postpones its next
+// attempt by the lease, so other servers don't attempt it meanwhile. If the attempt is never recorded, because the
+// server stopped, the delivery is attempted again once the lease expires. Returns nil when no delivery is due.
+func (r *webhooksRepository) ClaimDueWebhookDelivery(ctx context.Context, keyManager *crypto.KeyManager, now time.Time, lease time.Duration) (*DueWebhookDelivery, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+SELECT ` + webhookDeliveryColumns + `, w.url, w.encrypted_secret
+FROM webhook_deliveries d
+JOIN webhooks w ON w.id = d.webhook_id
+WHERE d.status = 'pending' AND d.next_attempt_at <= $1 AND w.enabled
+ORDER BY d.next_attempt_at
+LIMIT 1
+FOR UPDATE OF d SKIP LOCKED
+`
+	var due DueWebhookDelivery
+	var encryptedSecret string
+	err = tx.QueryRow(ctx, query, now).Scan(&due.ID, &due.WebhookID, &due.EventID, &due.EventType, &due.Payload,
+		&due.Status, &due.Attempts, &due.NextAttemptAt, &due.LastAttemptAt, &due.ResponseStatus, &due.Error,
+		&due.ReplayOf, &due.CreatedAt, &due.URL, &encryptedSecret)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	secret, err := crypto.Decrypt(keyManager, encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+	due.Secret = string(secret)
+
+	due.Attempts++
+	nextAttemptAt := now.Add(lease)
+	due.NextAttemptAt = &nextAttemptAt
+	due.LastAttemptAt = &now
+	_, err = tx.Exec(ctx, `
+		UPDATE webhook_deliveries SET attempts = $1, next_attempt_at = $2, last_attempt_at = $3 WHERE id = $4
+	`, due.Attempts, nextAttemptAt, now, due.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &due, nil
+}
+
+// RecordWebhookDeliveryAttempt stores the outcome of the last attempt, nextAttemptAt is nil unless the delivery is
+// still pending
+func (r *webhooksRepository) RecordWebhookDeliveryAttempt(ctx context.Context, id int, status string, responseStatus *int, attemptErr *string, nextAttemptAt *time.Time) error {
+	cmd, err := r.pool.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $1, response_status = $2, error = $3, next_attempt_at = $4
+		WHERE id = $5
+	`, status, responseStatus, attemptErr, nextAttemptAt, id)
+	if err != nil {
+		return err
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrWebhookDeliveryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	ErrWrappedSecretNotFound  = errors.New("wrapped secret not found")
+	ErrWrappedSecretExpired   = errors.New("wrapped secret expired")
+	ErrWrappedSecretUnwrapped = errors.New("wrapped secret already unwrapped")
+)
+
+// WrappedSecret is a value handed off with a single-use token. Value and Fields are only set when it is unwrapped.
+type WrappedSecret struct {
+	ID          int
+	Value       string
+	Fields      map[string]string
+	Structured  bool
+	SourcePath  *string
+	CreatedBy   *int
+	ExpiresAt   time.Time
+	UnwrappedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type WrappedSecretsRepository interface {
+	CreateWrappedSecret(ctx context.Context, keyManager *crypto.KeyManager, tokenHash string, wrapped WrappedSecret) (int, error)
+	UnwrapSecret(ctx context.Context, keyManager *crypto.KeyManager, tokenHash string, now time.Time) (*WrappedSecret, error)
+	PurgeWrappedSecrets(ctx context.Context, now time.Time, deleteBefore time.Time) (int64, error)
+}
+
+type wrappedSecretsRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewWrappedSecretsRepository(pool *pgxpool.Pool) WrappedSecretsRepository {
+	return &wrappedSecretsRepository{pool: pool}
+}
+
+// CreateWrappedSecret encrypts the value, or the fields of a structured wrapped secret, under the hash of its token
+func (r *wrappedSecretsRepository) CreateWrappedSecret(ctx context.Context, keyManager *crypto.KeyManager, tokenHash string, wrapped WrappedSecret) (int, error) {
+	plaintext := []byte(wrapped.Value)
+	if wrapped.Structured {
+		var err error
+		if plaintext, err = json.Marshal(wrapped.Fields); err != nil {
+			return 0, err
+		}
+	}
+	encryptedValue, err := crypto.Encrypt(keyManager, plaintext)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `
+INSERT INTO wrapped_secrets (token_hash, encrypted_value, structured, source_path, created_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+	var id int
+	err = r.pool.QueryRow(ctx, query, tokenHash, encryptedValue, wrapped.Structured, wrapped.SourcePath,
+		wrapped.CreatedBy, wrapped.ExpiresAt).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// UnwrapSecret returns the decrypted wrapped secret of the token and destroys its value. A token already unwrapped
+// returns ErrWrappedSecretUnwrapped along with the wrapped secret, telling when it was unwrapped.
+func (r *wrappedSecretsRepository) UnwrapSecret(ctx context.Context, keyManager *crypto.KeyManager, tokenHash string, now time.Time) (*WrappedSecret, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `
+SELECT id, encrypted_value, structured, source_path, created_by, expires_at, unwrapped_at, created_at
+FROM wrapped_secrets
+WHERE token_hash = $1
+FOR UPDATE
+`
+	var wrapped WrappedSecret
+	var encryptedValue *string
+	err = tx.QueryRow(ctx, query, tokenHash).Scan(&wrapped.ID, &encryptedValue, &wrapped.Structured, &wrapped.SourcePath,
+		&wrapped.CreatedBy, &wrapped.ExpiresAt, &wrapped.UnwrappedAt, &wrapped.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrWrappedSecretNotFound
+		}
+		return nil, err
+	}
+	if wrapped.UnwrappedAt != nil {
+		return &wrapped, ErrWrappedSecretUnwrapped
+	}
+	if encryptedValue == nil || !now.Before(wrapped.ExpiresAt) {
+		return &wrapped, ErrWrappedSecretExpired
+	}
+
+	// Decrypted before being destroyed, so that a failure leaves the token usable
+	plaintext, err := crypto.Decrypt(keyManager, *encryptedValue)
+	if err != nil {
+		return nil, err
+	}
+	if wrapped.Structured {
+		if err := json.Unmarshal(plaintext, &wrapped.Fields); err != nil {
+			return nil, err
+		}
+	} else {
+		wrapped.Value = string(plaintext)
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE wrapped_secrets SET encrypted_value = NULL, unwrapped_at = $1 WHERE id = $2`, now, wrapped.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	wrapped.UnwrappedAt = &now
+	return &wrapped, nil
+}
+
+// PurgeWrappedSecrets destroys the values of the expired wrapped secrets and deletes the ones expired before
+// deleteBefore, returning the number deleted
+func (r *wrappedSecretsRepository) PurgeWrappedSecrets(ctx context.Context, now time.Time, deleteBefore time.Time) (int64, error) {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE wrapped_secrets SET encrypted_value = NULL
+		WHERE expires_at <= $1 AND encrypted_value IS NOT NULL
+	`, now)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd, err := r.pool.Exec(ctx, `DELETE FROM wrapped_secrets WHERE expires_at < $1`, deleteBefore)
+	if err != nil {
+		return 0, err
+	}
+	return cmd.RowsAffected(), nil
+}
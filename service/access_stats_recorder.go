@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+const (
+	accessStatsFlushInterval = 10 * time.Second
+	accessStatsPurgeInterval = time.Hour
+	// accessStatsWindow is the rolling window the reads of a secret are counted over
+	accessStatsWindow = 30 * 24 * time.Hour
+	// maxPendingSecretDays bounds the memory held by the reads which could not be flushed while the database is down
+	maxPendingSecretDays = 100_000
+)
+
+type secretDay struct {
+	secretID int
+	day      time.Time
+}
+
+// AccessStatsRecorder keeps the reads of secrets in memory and writes them in batches, so that recording them does
+// not slow down the reads. Reads not flushed yet are lost if the server crashes, and the oldest of them are dropped
+// when too many pile up while the database is unreachable.
+type AccessStatsRecorder struct {
+	accessStatsRepository repository.AccessStatsRepository
+
+	mu      sync.Mutex
+	pending map[secretDay]*repository.SecretReads
+}
+
+func NewAccessStatsRecorder(accessStatsRepository repository.AccessStatsRepository) *AccessStatsRecorder {
+	return &AccessStatsRecorder{
+		accessStatsRepository: accessStatsRepository,
+		pending:               make(map[secretDay]*repository.SecretReads),
+	}
+}
+
+// RecordRead counts a read of the secret by the user, it is written on the next flush
+func (r *AccessStatsRecorder) RecordRead(secretID int, userID int, at time.Time) {
+	at = at.UTC()
+	key := secretDay{secretID: secretID, day: at.Truncate(24 * time.Hour)}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reads, ok := r.pending[key]
+	if !ok {
+		reads = &repository.SecretReads{SecretID: secretID, Day: key.day}
+		r.pending[key] = reads
+	}
+	reads.Count++
+	if !at.Before(reads.LastReadAt) {
+		reads.LastReadAt = at
+		reads.LastReaderID = userID
+	}
+}
+
+// Run flushes the pending reads every interval, and a last time when the context is cancelled
+func (r *AccessStatsRecorder) Run(ctx context.Context) {
+	flushTicker := time.NewTicker(accessStatsFlushInterval)
+	defer flushTicker.Stop()
+	purgeTicker := time.NewTicker(accessStatsPurgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := r.Flush(flushCtx); err != nil {
+				log.Printf("Access stats flush error: %v", err)
+			}
+			cancel()
+			return
+		case <-flushTicker.C:
+			if err := r.Flush(ctx); err != nil {
+				log.Printf("Access stats flush error: %v", err)
+			}
+		case <-purgeTicker.C:
+			if _, err := r.accessStatsRepository.PurgeDailyReads(ctx, time.Now().UTC().Add(-accessStatsWindow)); err != nil {
+				log.Printf("Access stats purge error: %v", err)
+			}
+		}
+	}
+}
+
+// Flush writes the pending reads. They are kept for the next flush when they cannot be written.
+func (r *AccessStatsRecorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[secretDay]*repository.SecretReads)
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+	reads := make([]repository.SecretReads, 0, len(pending))
+	for _, read := range pending {
+		reads = append(reads, *read)
+	}
+	// Writing in a stable order keeps concurrent flushes of several servers from deadlocking
+	slices.SortFunc(reads, func(a, b repository.SecretReads) int {
+		if a.SecretID != b.SecretID {
+			return a.SecretID - b.SecretID
+		}
+		return a.Day.Compare(b.Day)
+	})
+	if err := r.accessStatsRepository.RecordSecretReads(ctx, reads); err != nil {
+		r.restore(pending)
+		return err
+	}
+	return nil
+}
+
+// restore merges reads that could not be written back into the pending ones. Beyond maxPendingSecretDays, the least
+// recently read secret days are dropped.
+func (r *AccessStatsRecorder) restore(reads map[secretDay]*repository.SecretReads) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, read := range reads {
+		pending, ok := r.pending[key]
+		if !ok {
+			r.pending[key] = read
+			continue
+		}
+		pending.Count += read.Count
+		if read.LastReadAt.After(pending.LastReadAt) {
+			pending.LastReadAt = read.LastReadAt
+			pending.LastReaderID = read.LastReaderID
+		}
+	}
+
+	if len(r.pending) <= maxPendingSecretDays {
+		return
+	}
+	keys := slices.SortedFunc(maps.Keys(r.pending), func(a, b secretDay) int {
+		return r.pending[a].LastReadAt.Compare(r.pending[b].LastReadAt)
+	})
+	dropped := keys[:len(keys)-maxPendingSecretDays]
+	for _, key := range dropped {
+		delete(r.pending, key)
+	}
+	log.Printf("Dropped the unflushed reads of %d secret days, keeping the %d most recent", len(dropped), maxPendingSecretDays)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+
+	genbackup "github.com/Vidalee/FishyKeys/gen/backup"
+	"github.com/Vidalee/FishyKeys/internal/backup"
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+type BackupService struct {
+	keyManager       *crypto.KeyManager
+	backupRepository repository.BackupRepository
+	eventBus         *events.Bus
+}
+
+func NewBackupService(keyManager *crypto.KeyManager, backupRepository repository.BackupRepository, eventBus *events.Bus) *BackupService {
+	return &BackupService{
+		keyManager:       keyManager,
+		backupRepository: backupRepository,
+		eventBus:         eventBus,
+	}
+}
+
+// CreateBackup seals a snapshot of the database under the backup key when given, the master key otherwise
+func (s *BackupService) CreateBackup(ctx context.Context, payload *genbackup.CreateBackupPayload) (*genbackup.CreateBackupResult, io.ReadCloser, error) {
+	kind := backup.MasterKey
+	var key []byte
+	if payload.BackupKey != nil {
+		kind = backup.BackupKey
+		key = []byte(*payload.BackupKey)
+	} else {
+		masterKey, err := s.keyManager.GetMasterKey()
+		if err != nil {
+			return nil, nil, genbackup.MakeInternalError(fmt.Errorf("error retrieving master key: %w", err))
+		}
+		key = masterKey
+	}
+
+	archive, err := s.backupRepository.Dump(ctx)
+	if err != nil {
+		return nil, nil, genbackup.MakeInternalError(fmt.Errorf("error reading the database: %w", err))
+	}
+	sealed, err := backup.Seal(archive, kind, key)
+	if err != nil {
+		return nil, nil, genbackup.MakeInternalError(fmt.Errorf("error sealing the backup: %w", err))
+	}
+
+	publishEvent(ctx, s.eventBus, events.BackupCreated, map[string]any{"key": kind.String(), "schema_version": archive.SchemaVersion})
+	filename := fmt.Sprintf("fishykeys-%s.fkbackup", archive.CreatedAt.Format("20060102T150405Z"))
+	return &genbackup.CreateBackupResult{
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+		ContentLength:      int64(len(sealed)),
+	}, io.NopCloser(bytes.NewReader(sealed)), nil
+}
+
+// OpenBackup decrypts and validates a backup before it is restored. Archives sealed under the master key are opened
+// with the key recombined from the base64 encoded shares, archives sealed under a backup key with the backup key.
+func OpenBackup(data []byte, backupKey string, shares []string) (*backup.Archive, error) {
+	kind, err := backup.ReadKeyKind(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var key []byte
+	switch kind {
+	case backup.BackupKey:
+		if backupKey == "" {
+			return nil, errors.New("the backup is encrypted under a backup key, it must be given")
+		}
+		key = []byte(backupKey)
+	case backup.MasterKey:
+		if len(shares) == 0 {
+			return nil, errors.New("the backup is encrypted under the master key, enough shares to recombine it must be given")
+		}
+		decodedShares := make([][]byte, len(shares))
+		for i, share := range shares {
+			decodedShares[i], err = base64.StdEncoding.DecodeString(share)
+			if err != nil {
+				return nil, fmt.Errorf("error decoding share %d: %w", i+1, err)
+			}
+		}
+		key, err = crypto.CombineShares(decodedShares)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", crypto.ErrCouldNotRecombine, err)
+		}
+	}
+
+	archive, err := backup.Open(data, key)
+	if err != nil {
+		if errors.Is(err, backup.ErrWrongKey) && kind == backup.MasterKey {
+			return nil, fmt.Errorf("%w, or not enough shares", err)
+		}
+		return nil, err
+	}
+	return archive, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	genbackup "github.com/Vidalee/FishyKeys/gen/backup"
+	gensecrets "github.com/Vidalee/FishyKeys/gen/secrets"
+	"github.com/Vidalee/FishyKeys/internal/backup"
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupService_CreateAndRestoreBackup(t *testing.T) {
+	secretsService := setupSecretsTestService(t)
+	ctx := context.Background()
+	clearSecretsServiceTables(t, ctx)
+	backupRepository := repository.NewBackupRepository(testDB)
+	backupService := NewBackupService(secretsService.keyManager, backupRepository, events.NewBus())
+
+	ownerID, err := secretsService.usersRepository.CreateUser(ctx, "owner", "password")
+	require.NoError(t, err, "failed to create owner")
+	readerID, err := secretsService.usersRepository.CreateUser(ctx, "reader", "password")
+	require.NoError(t, err, "failed to create reader")
+	roleID, err := secretsService.rolesRepository.CreateRole(ctx, "ops", "#00FF00")
+	require.NoError(t, err, "failed to create role")
+	require.NoError(t, secretsService.userRolesRepository.AssignRoleToUser(ctx, readerID, roleID))
+	require.NoError(t, secretsService.globalSettingsRepository.StoreSetting(ctx, "min_shares", "2"))
+	withToken := func(userID int) context.Context {
+		return context.WithValue(ctx, "token", &JwtClaims{UserID: userID})
+	}
+	encode := func(secretPath string) string {
+		return base64.StdEncoding.EncodeToString([]byte(secretPath))
+	}
+
+	err = secretsService.CreateSecret(withToken(ownerID), &gensecrets.CreateSecretPayload{
+		Path:            encode("/app/db/password"),
+		Value:           ptr("s3cret"),
+		AuthorizedUsers: []int{readerID},
+		AuthorizedRoles: []int{},
+	})
+	require.NoError(t, err)
+	err = secretsService.CreateSecret(withToken(ownerID), &gensecrets.CreateSecretPayload{
+		Path:            encode("/app/stripe"),
+		Fields:          map[string]string{"api_key": "sk_live"},
+		AuthorizedUsers: []int{},
+		AuthorizedRoles: []int{roleID},
+	})
+	require.NoError(t, err)
+
+	masterKey, err := secretsService.keyManager.GetMasterKey()
+	require.NoError(t, err)
+	shares, err := crypto.SplitSecret(masterKey, 3, 2)
+	require.NoError(t, err)
+	encodedShares := make([]string, len(shares))
+	for i, share := range shares {
+		encodedShares[i] = base64.StdEncoding.EncodeToString(share)
+	}
+
+	download := func(t *testing.T, backupKey *string) []byte {
+		result, body, err := backupService.CreateBackup(withToken(ownerID), &genbackup.CreateBackupPayload{BackupKey: backupKey})
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(data)), result.ContentLength)
+		assert.Regexp(t, `^attachment; filename=fishykeys-\d{8}T\d{6}Z\.fkbackup$`, result.ContentDisposition)
+		return data
+	}
+	sealed := download(t, nil)
+
+	t.Run("open under the master key", func(t *testing.T) {
+		archive, err := OpenBackup(sealed, "", encodedShares[1:])
+		require.NoError(t, err)
+		assert.Equal(t, 2, archive.RowCount("secrets"))
+		assert.Equal(t, 1, archive.RowCount("secret_fields"))
+
+		_, err = OpenBackup(sealed, "", nil)
+		require.Error(t, err)
+		assert.Equal(t, "the backup is encrypted under the master key, enough shares to recombine it must be given", err.Error())
+	})
+
+	t.Run("open under a backup key", func(t *testing.T) {
+		withBackupKey := download(t, ptr("correct horse battery staple"))
+
+		_, err := OpenBackup(withBackupKey, "", encodedShares)
+		require.Error(t, err)
+		assert.Equal(t, "the backup is encrypted under a backup key, it must be given", err.Error())
+
+		_, err = OpenBackup(withBackupKey, "incorrect horse battery staple", nil)
+		assert.ErrorIs(t, err, backup.ErrWrongKey)
+
+		archive, err := OpenBackup(withBackupKey, "correct horse battery staple", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, archive.RowCount("secrets"))
+	})
+
+	t.Run("restore into a populated database", func(t *testing.T) {
+		archive, err := OpenBackup(sealed, "", encodedShares)
+		require.NoError(t, err)
+
+		empty, err := backupRepository.IsEmpty(ctx)
+		require.NoError(t, err)
+		assert.False(t, empty)
+		err = backupRepository.Restore(ctx, archive)
+		assert.ErrorIs(t, err, repository.ErrDatabaseNotEmpty)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		archive, err := OpenBackup(sealed, "", encodedShares)
+		require.NoError(t, err)
+		clearSecretsServiceTables(t, ctx)
+		empty, err := backupRepository.IsEmpty(ctx)
+		require.NoError(t, err)
+		require.True(t, empty)
+
+		require.NoError(t, backupRepository.Restore(ctx, archive))
+
+		value, err := secretsService.GetSecretValue(withToken(readerID), &gensecrets.GetSecretValuePayload{Path: encode("/app/db/password")})
+		require.NoError(t, err)
+		assert.Equal(t, "s3cret", *value.Value)
+		value, err = secretsService.GetSecretValue(withToken(readerID), &gensecrets.GetSecretValuePayload{Path: encode("/app/stripe")})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"api_key": "sk_live"}, value.Fields)
+
+		setting, err := secretsService.globalSettingsRepository.GetSetting(ctx, "min_shares")
+		require.NoError(t, err)
+		assert.Equal(t, "2", setting)
+
+		// Sequences are moved past the restored rows
+		newcomerID, err := secretsService.usersRepository.CreateUser(ctx, "newcomer", "password")
+		require.NoError(t, err)
+		assert.Greater(t, newcomerID, readerID)
+	})
+
+	t.Run("restore an archive of another schema version", func(t *testing.T) {
+		archive, err := OpenBackup(sealed, "", encodedShares)
+		require.NoError(t, err)
+		clearSecretsServiceTables(t, ctx)
+
+		archive.SchemaVersion--
+		err = backupRepository.Restore(ctx, archive)
+		assert.ErrorIs(t, err, repository.ErrSchemaMismatch)
+	})
+}
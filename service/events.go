@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Vidalee/FishyKeys/internal/events"
+)
+
+// publishEvent publishes the event on the bus with the user of the request, if any, as its actor
+func publishEvent(ctx context.Context, bus *events.Bus, eventType string, data map[string]any) {
+	actor := ""
+	if jwtClaims, ok := ctx.Value("token").(*JwtClaims); ok {
+		actor = jwtClaims.Username
+	}
+	bus.Publish(ctx, eventType, actor, data)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+const expiredGrantsPurgeInterval = time.Minute
+
+// ExpiredGrantsPurger deletes the expired grants. They are already ignored when checking access, deleting them keeps
+// the listings clean and notifies the access change.
+type ExpiredGrantsPurger struct {
+	secretsAccessRepository repository.SecretsAccessRepository
+	eventBus                *events.Bus
+}
+
+func NewExpiredGrantsPurger(secretsAccessRepository repository.SecretsAccessRepository, eventBus *events.Bus) *ExpiredGrantsPurger {
+	return &ExpiredGrantsPurger{
+		secretsAccessRepository: secretsAccessRepository,
+		eventBus:                eventBus,
+	}
+}
+
+// Run purges the expired grants every interval until the context is cancelled
+func (p *ExpiredGrantsPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(expiredGrantsPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := p.PurgeExpiredGrants(ctx); err != nil {
+				log.Printf("Expired grants purge error: %v", err)
+			}
+		}
+	}
+}
+
+// PurgeExpiredGrants deletes the grants expired at the current time and returns the paths of the secrets affected
+func (p *ExpiredGrantsPurger) PurgeExpiredGrants(ctx context.Context) ([]string, error) {
+	paths, err := p.secretsAccessRepository.DeleteExpiredGrants(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		p.eventBus.Publish(ctx, events.SecretAccessChanged, "", map[string]any{"path": path})
+	}
+	return paths, nil
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gengeneration "github.com/Vidalee/FishyKeys/gen/generation"
+	"github.com/Vidalee/FishyKeys/internal/generator"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+type GenerationService struct {
+	generationPoliciesRepository repository.GenerationPoliciesRepository
+}
+
+func NewGenerationService(generationPoliciesRepository repository.GenerationPoliciesRepository) *GenerationService {
+	return &GenerationService{
+		generationPoliciesRepository: generationPoliciesRepository,
+	}
+}
+
+func (s *GenerationService) ListGenerationPolicies(ctx context.Context) ([]*gengeneration.GenerationPolicy, error) {
+	policies, err := s.generationPoliciesRepository.ListGenerationPolicies(ctx)
+	if err != nil {
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not list generation policies: %w", err))
+	}
+
+	result := make([]*gengeneration.GenerationPolicy, 0, len(policies))
+	for _, p := range policies {
+		result = append(result, generationPolicyToResult(&p))
+	}
+	return result, nil
+}
+
+func (s *GenerationService) CreateGenerationPolicy(ctx context.Context, payload *gengeneration.CreateGenerationPolicyPayload) (*gengeneration.GenerationPolicy, error) {
+	_, err := s.generationPoliciesRepository.GetGenerationPolicyByName(ctx, payload.Name)
+	if err == nil {
+		return nil, gengeneration.MakeGenerationPolicyTaken(fmt.Errorf("generation policy with name %s already exists", payload.Name))
+	}
+	if !errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not check if generation policy already exists: %w", err))
+	}
+
+	spec := generationSpec(payload.Kind, payload.Length, payload.Lowercase, payload.Uppercase, payload.Digits,
+		payload.Symbols, payload.Words, payload.Separator, payload.Encoding, payload.Bits)
+	if err := spec.Validate(); err != nil {
+		return nil, gengeneration.MakeInvalidParameters(err)
+	}
+
+	id, err := s.generationPoliciesRepository.CreateGenerationPolicy(ctx, payload.Name, spec)
+	if err != nil {
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not create generation policy: %w", err))
+	}
+
+	p, err := s.getGenerationPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return generationPolicyToResult(p), nil
+}
+
+func (s *GenerationService) UpdateGenerationPolicy(ctx context.Context, payload *gengeneration.UpdateGenerationPolicyPayload) (*gengeneration.GenerationPolicy, error) {
+	if _, err := s.getGenerationPolicy(ctx, payload.ID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.generationPoliciesRepository.GetGenerationPolicyByName(ctx, payload.Name)
+	if err == nil && existing.ID != payload.ID {
+		return nil, gengeneration.MakeGenerationPolicyTaken(fmt.Errorf("generation policy with name %s already exists", payload.Name))
+	}
+	if err != nil && !errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not check if generation policy already exists: %w", err))
+	}
+
+	spec := generationSpec(payload.Kind, payload.Length, payload.Lowercase, payload.Uppercase, payload.Digits,
+		payload.Symbols, payload.Words, payload.Separator, payload.Encoding, payload.Bits)
+	if err := spec.Validate(); err != nil {
+		return nil, gengeneration.MakeInvalidParameters(err)
+	}
+
+	err = s.generationPoliciesRepository.UpdateGenerationPolicy(ctx, payload.ID, payload.Name, spec)
+	if err != nil {
+		if errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+			return nil, gengeneration.MakeGenerationPolicyNotFound(fmt.Errorf("generation policy with id %d not found", payload.ID))
+		}
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not update generation policy: %w", err))
+	}
+
+	p, err := s.getGenerationPolicy(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return generationPolicyToResult(p), nil
+}
+
+func (s *GenerationService) DeleteGenerationPolicy(ctx context.Context, payload *gengeneration.DeleteGenerationPolicyPayload) error {
+	err := s.generationPoliciesRepository.DeleteGenerationPolicy(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+			return gengeneration.MakeGenerationPolicyNotFound(fmt.Errorf("generation policy with id %d not found", payload.ID))
+		}
+		return gengeneration.MakeInternalError(fmt.Errorf("could not delete generation policy: %w", err))
+	}
+	return nil
+}
+
+func (s *GenerationService) getGenerationPolicy(ctx context.Context, id int) (*repository.GenerationPolicy, error) {
+	p, err := s.generationPoliciesRepository.GetGenerationPolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+			return nil, gengeneration.MakeGenerationPolicyNotFound(fmt.Errorf("generation policy with id %d not found", id))
+		}
+		return nil, gengeneration.MakeInternalError(fmt.Errorf("could not retrieve generation policy: %w", err))
+	}
+	return p, nil
+}
+
+// generationSpec builds the spec of a policy, keeping only the parameters used by its kind
+func generationSpec(kind string, length *int, lowercase, uppercase, digits, symbols *bool, words *int, separator, encoding *string, bits *int) generator.Spec {
+	spec := generator.Spec{Kind: kind}
+	switch kind {
+	case generator.KindPassword:
+		spec.Length = valueOrZero(length)
+		spec.Lowercase = valueOrZero(lowercase)
+		spec.Uppercase = valueOrZero(uppercase)
+		spec.Digits = valueOrZero(digits)
+		spec.Symbols = valueOrZero(symbols)
+	case generator.KindPassphrase:
+		spec.Words = valueOrZero(words)
+		spec.Separator = valueOrZero(separator)
+	case generator.KindBytes:
+		spec.Length = valueOrZero(length)
+		spec.Encoding = valueOrZero(encoding)
+	case generator.KindRSA:
+		spec.Bits = valueOrZero(bits)
+	}
+	return spec
+}
+
+func valueOrZero[T any](value *T) T {
+	var zero T
+	if value == nil {
+		return zero
+	}
+	return *value
+}
+
+func generationPolicyToResult(p *repository.GenerationPolicy) *gengeneration.GenerationPolicy {
+	result := &gengeneration.GenerationPolicy{
+		ID:        p.ID,
+		Name:      p.Name,
+		Kind:      p.Spec.Kind,
+		CreatedAt: p.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: p.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	switch p.Spec.Kind {
+	case generator.KindPassword:
+		result.Length = &p.Spec.Length
+		result.Lowercase = &p.Spec.Lowercase
+		result.Uppercase = &p.Spec.Uppercase
+		result.Digits = &p.Spec.Digits
+		result.Symbols = &p.Spec.Symbols
+	case generator.KindPassphrase:
+		result.Words = &p.Spec.Words
+		if p.Spec.Separator != "" {
+			result.Separator = &p.Spec.Separator
+		}
+	case generator.KindBytes:
+		result.Length = &p.Spec.Length
+		result.Encoding = &p.Spec.Encoding
+	case generator.KindRSA:
+		result.Bits = &p.Spec.Bits
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	gengeneration "github.com/Vidalee/FishyKeys/gen/generation"
+	"github.com/Vidalee/FishyKeys/internal/testutil"
+	"github.com/Vidalee/FishyKeys/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupGenerationTestService(t *testing.T) *GenerationService {
+	return NewGenerationService(repository.NewGenerationPoliciesRepository(testDB))
+}
+
+func clearGenerationServiceTables(t *testing.T, ctx context.Context) {
+	err := testutil.ClearTable(ctx, "generation_policies")
+	require.NoError(t, err)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
+
+func TestGenerationService_CreateGenerationPolicy(t *testing.T) {
+	service := setupGenerationTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		payload           *gengeneration.CreateGenerationPolicyPayload
+		doDuplicate       bool
+		expectedError     bool
+		expectedErrorText string
+		expectedResult    *gengeneration.GenerationPolicy
+	}{
+		{
+			name: "password policy",
+			payload: &gengeneration.CreateGenerationPolicyPayload{
+				Name: "strong-password", Kind: "password", Length: ptr(24),
+				Lowercase: ptr(true), Uppercase: ptr(true), Digits: ptr(true),
+				// Parameters of other kinds are dropped
+				Bits: ptr(4096),
+			},
+			expectedResult: &gengeneration.GenerationPolicy{
+				Name: "strong-password", Kind: "password", Length: ptr(24),
+				Lowercase: ptr(true), Uppercase: ptr(true), Digits: ptr(true), Symbols: ptr(false),
+			},
+		},
+		{
+			name:    "passphrase policy",
+			payload: &gengeneration.CreateGenerationPolicyPayload{Name: "words", Kind: "passphrase", Words: ptr(6)},
+			expectedResult: &gengeneration.GenerationPolicy{
+				Name: "words", Kind: "passphrase", Words: ptr(6),
+			},
+		},
+		{
+			name:           "key pair policy",
+			payload:        &gengeneration.CreateGenerationPolicyPayload{Name: "signing-key", Kind: "ed25519"},
+			expectedResult: &gengeneration.GenerationPolicy{Name: "signing-key", Kind: "ed25519"},
+		},
+		{
+			name:              "name already taken",
+			payload:           &gengeneration.CreateGenerationPolicyPayload{Name: "ids", Kind: "uuid"},
+			doDuplicate:       true,
+			expectedError:     true,
+			expectedErrorText: "generation policy with name ids already exists",
+		},
+		{
+			name:              "invalid parameters",
+			payload:           &gengeneration.CreateGenerationPolicyPayload{Name: "token", Kind: "bytes", Length: ptr(32)},
+			expectedError:     true,
+			expectedErrorText: "invalid generation policy: bytes encoding must be hex or base64",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearGenerationServiceTables(t, ctx)
+
+			result, err := service.CreateGenerationPolicy(ctx, tt.payload)
+			if tt.doDuplicate {
+				result, err = service.CreateGenerationPolicy(ctx, tt.payload)
+			}
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+			} else {
+				require.NoError(t, err)
+				tt.expectedResult.ID = result.ID
+				tt.expectedResult.CreatedAt = result.CreatedAt
+				tt.expectedResult.UpdatedAt = result.UpdatedAt
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestGenerationService_UpdateAndDeleteGenerationPolicy(t *testing.T) {
+	service := setupGenerationTestService(t)
+	ctx := context.Background()
+	clearGenerationServiceTables(t, ctx)
+
+	created, err := service.CreateGenerationPolicy(ctx, &gengeneration.CreateGenerationPolicyPayload{
+		Name: "token", Kind: "bytes", Length: ptr(32), Encoding: ptr("hex"),
+	})
+	require.NoError(t, err)
+	_, err = service.CreateGenerationPolicy(ctx, &gengeneration.CreateGenerationPolicyPayload{Name: "ids", Kind: "uuid"})
+	require.NoError(t, err)
+
+	_, err = service.UpdateGenerationPolicy(ctx, &gengeneration.UpdateGenerationPolicyPayload{ID: created.ID, Name: "ids", Kind: "uuid"})
+	assert.Error(t, err)
+	assert.Equal(t, "generation policy with name ids already exists", err.Error())
+
+	updated, err := service.UpdateGenerationPolicy(ctx, &gengeneration.UpdateGenerationPolicyPayload{
+		ID: created.ID, Name: "token", Kind: "bytes", Length: ptr(64), Encoding: ptr("base64"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 64, *updated.Length)
+	assert.Equal(t, "base64", *updated.Encoding)
+
+	policies, err := service.ListGenerationPolicies(ctx)
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+	assert.Equal(t, "ids", policies[0].Name)
+	assert.Equal(t, "token", policies[1].Name)
+
+	err = service.DeleteGenerationPolicy(ctx, &gengeneration.DeleteGenerationPolicyPayload{ID: created.ID})
+	require.NoError(t, err)
+	err = service.DeleteGenerationPolicy(ctx, &gengeneration.DeleteGenerationPolicyPayload{ID: created.ID})
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("generation policy with id %d not found", created.ID), err.Error())
+}
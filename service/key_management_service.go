@@ -14,6 +14,7 @@ import (
 
 	genkey "github.com/Vidalee/FishyKeys/gen/key_management"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
 )
 
 var (
@@ -34,6 +35,7 @@ type KeyManagementService struct {
 	rolesRepository     repository.RolesRepository
 	userRolesRepository repository.UserRolesRepository
 	secretsRepository   repository.SecretsRepository
+	eventBus            *events.Bus
 }
 
 func NewKeyManagementService(
@@ -43,6 +45,7 @@ func NewKeyManagementService(
 	rolesRepository repository.RolesRepository,
 	userRolesRepository repository.UserRolesRepository,
 	secretsRepository repository.SecretsRepository,
+	eventBus *events.Bus,
 ) *KeyManagementService {
 	keySettings, err := settingsRepository.GetSettings(context.Background(), columnMasterKeyChecksumColumn, columnTotalSharesColumn, columnMinSharesColumn)
 	if err != nil {
@@ -71,6 +74,7 @@ func NewKeyManagementService(
 		rolesRepository:     rolesRepository,
 		userRolesRepository: userRolesRepository,
 		secretsRepository:   secretsRepository,
+		eventBus:            eventBus,
 	}
 }
 
@@ -216,6 +220,7 @@ func (s *KeyManagementService) CreateMasterKey(ctx context.Context, payload *gen
 		return nil, genkey.MakeInternalError(fmt.Errorf("could not assign role to admin user: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.MasterKeyCreated, map[string]any{"total_shares": payload.TotalShares, "min_shares": payload.MinShares})
 	return &genkey.CreateMasterKeyResult{
 		Shares:        encodedShares,
 		AdminUsername: &payload.AdminUsername,
@@ -281,6 +286,7 @@ func (s *KeyManagementService) AddShare(ctx context.Context, payload *genkey.Add
 			s.keyManager.RollbackToLocked()
 			return nil, genkey.MakeWrongShares(fmt.Errorf("master key checksum does not match expected value"))
 		}
+		publishEvent(ctx, s.eventBus, events.MasterKeyUnlocked, map[string]any{})
 	}
 
 	return &genkey.AddShareResult{
@@ -8,6 +8,7 @@ import (
 
 	genkey "github.com/Vidalee/FishyKeys/gen/key_management"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/internal/testutil"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/stretchr/testify/assert"
@@ -21,7 +22,7 @@ func setupKeyTestService() *KeyManagementService {
 	rolesRepository := repository.NewRolesRepository(testDB)
 	userRolesRepository := repository.NewUserRolesRepository(testDB)
 	secretsRepository := repository.NewSecretsRepository(testDB)
-	return NewKeyManagementService(keyManager, settingsRepository, usersRepository, rolesRepository, userRolesRepository, secretsRepository)
+	return NewKeyManagementService(keyManager, settingsRepository, usersRepository, rolesRepository, userRolesRepository, secretsRepository, events.NewBus())
 }
 
 func setupKeyTestServiceWithKeyManager(keyManager *crypto.KeyManager) *KeyManagementService {
@@ -30,7 +31,7 @@ func setupKeyTestServiceWithKeyManager(keyManager *crypto.KeyManager) *KeyManage
 	rolesRepository := repository.NewRolesRepository(testDB)
 	userRolesRepository := repository.NewUserRolesRepository(testDB)
 	secretsRepository := repository.NewSecretsRepository(testDB)
-	return NewKeyManagementService(keyManager, settingsRepository, usersRepository, rolesRepository, userRolesRepository, secretsRepository)
+	return NewKeyManagementService(keyManager, settingsRepository, usersRepository, rolesRepository, userRolesRepository, secretsRepository, events.NewBus())
 }
 
 func clearKeyServiceTables(t *testing.T, ctx context.Context) {
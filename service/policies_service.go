@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+
+	genpolicies "github.com/Vidalee/FishyKeys/gen/policies"
+	"github.com/Vidalee/FishyKeys/internal/policy"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+type PoliciesService struct {
+	policiesRepository      repository.PoliciesRepository
+	usersRepository         repository.UsersRepository
+	rolesRepository         repository.RolesRepository
+	userRolesRepository     repository.UserRolesRepository
+	secretsRepository       repository.SecretsRepository
+	secretsAccessRepository repository.SecretsAccessRepository
+	folderAccessRepository  repository.FolderAccessRepository
+}
+
+func NewPoliciesService(
+	policiesRepository repository.PoliciesRepository,
+	usersRepository repository.UsersRepository,
+	rolesRepository repository.RolesRepository,
+	userRolesRepository repository.UserRolesRepository,
+	secretsRepository repository.SecretsRepository,
+	secretsAccessRepository repository.SecretsAccessRepository,
+	folderAccessRepository repository.FolderAccessRepository,
+) *PoliciesService {
+	return &PoliciesService{
+		policiesRepository:      policiesRepository,
+		usersRepository:         usersRepository,
+		rolesRepository:         rolesRepository,
+		userRolesRepository:     userRolesRepository,
+		secretsRepository:       secretsRepository,
+		secretsAccessRepository: secretsAccessRepository,
+		folderAccessRepository:  folderAccessRepository,
+	}
+}
+
+func (s *PoliciesService) ListPolicies(ctx context.Context) ([]*genpolicies.Policy, error) {
+	policies, err := s.policiesRepository.ListPolicies(ctx)
+	if err != nil {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not list policies: %w", err))
+	}
+
+	result := make([]*genpolicies.Policy, 0, len(policies))
+	for _, p := range policies {
+		result = append(result, policyToResult(&p))
+	}
+	return result, nil
+}
+
+func (s *PoliciesService) GetPolicy(ctx context.Context, payload *genpolicies.GetPolicyPayload) (*genpolicies.Policy, error) {
+	p, err := s.getPolicy(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return policyToResult(p), nil
+}
+
+func (s *PoliciesService) CreatePolicy(ctx context.Context, payload *genpolicies.CreatePolicyPayload) (*genpolicies.Policy, error) {
+	_, err := s.policiesRepository.GetPolicyByName(ctx, payload.Name)
+	if err == nil {
+		return nil, genpolicies.MakePolicyTaken(fmt.Errorf("policy with name %s already exists", payload.Name))
+	}
+	if !errors.Is(err, repository.ErrPolicyNotFound) {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not check if policy already exists: %w", err))
+	}
+
+	rules, err := parsePolicy(payload.Format, payload.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	policyID, err := s.policiesRepository.CreatePolicy(ctx, payload.Name, payload.Format, payload.Document, rules)
+	if err != nil {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not create policy: %w", err))
+	}
+
+	p, err := s.getPolicy(ctx, policyID)
+	if err != nil {
+		return nil, err
+	}
+	return policyToResult(p), nil
+}
+
+func (s *PoliciesService) UpdatePolicy(ctx context.Context, payload *genpolicies.UpdatePolicyPayload) (*genpolicies.Policy, error) {
+	if _, err := s.getPolicy(ctx, payload.ID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.policiesRepository.GetPolicyByName(ctx, payload.Name)
+	if err == nil && existing.ID != payload.ID {
+		return nil, genpolicies.MakePolicyTaken(fmt.Errorf("policy with name %s already exists", payload.Name))
+	}
+	if err != nil && !errors.Is(err, repository.ErrPolicyNotFound) {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not check if policy already exists: %w", err))
+	}
+
+	rules, err := parsePolicy(payload.Format, payload.Document)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.policiesRepository.UpdatePolicy(ctx, payload.ID, payload.Name, payload.Format, payload.Document, rules)
+	if err != nil {
+		if errors.Is(err, repository.ErrPolicyNotFound) {
+			return nil, genpolicies.MakePolicyNotFound(fmt.Errorf("policy with id %d not found", payload.ID))
+		}
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not update policy: %w", err))
+	}
+
+	p, err := s.getPolicy(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return policyToResult(p), nil
+}
+
+func (s *PoliciesService) DeletePolicy(ctx context.Context, payload *genpolicies.DeletePolicyPayload) error {
+	err := s.policiesRepository.DeletePolicy(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPolicyNotFound) {
+			return genpolicies.MakePolicyNotFound(fmt.Errorf("policy with id %d not found", payload.ID))
+		}
+		return genpolicies.MakeInternalError(fmt.Errorf("could not delete policy: %w", err))
+	}
+	return nil
+}
+
+func (s *PoliciesService) AttachPolicy(ctx context.Context, payload *genpolicies.AttachPolicyPayload) error {
+	if (payload.UserID == nil) == (payload.RoleID == nil) {
+		return genpolicies.MakeInvalidParameters(fmt.Errorf("exactly one of user_id or role_id must be provided"))
+	}
+	if _, err := s.getPolicy(ctx, payload.ID); err != nil {
+		return err
+	}
+
+	if payload.UserID != nil {
+		if _, err := s.usersRepository.GetUserByID(ctx, *payload.UserID); err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return genpolicies.MakeInvalidParameters(fmt.Errorf("user %d does not exist", *payload.UserID))
+			}
+			return genpolicies.MakeInternalError(fmt.Errorf("could not retrieve user: %w", err))
+		}
+		if err := s.policiesRepository.AttachPolicyToUser(ctx, payload.ID, *payload.UserID); err != nil {
+			return genpolicies.MakeInternalError(fmt.Errorf("could not attach policy: %w", err))
+		}
+		return nil
+	}
+
+	if _, err := s.rolesRepository.GetRoleByID(ctx, *payload.RoleID); err != nil {
+		if errors.Is(err, repository.ErrRoleNotFound) {
+			return genpolicies.MakeInvalidParameters(fmt.Errorf("role %d does not exist", *payload.RoleID))
+		}
+		return genpolicies.MakeInternalError(fmt.Errorf("could not retrieve role: %w", err))
+	}
+	if err := s.policiesRepository.AttachPolicyToRole(ctx, payload.ID, *payload.RoleID); err != nil {
+		return genpolicies.MakeInternalError(fmt.Errorf("could not attach policy: %w", err))
+	}
+	return nil
+}
+
+func (s *PoliciesService) DetachPolicy(ctx context.Context, payload *genpolicies.DetachPolicyPayload) error {
+	if (payload.UserID == nil) == (payload.RoleID == nil) {
+		return genpolicies.MakeInvalidParameters(fmt.Errorf("exactly one of user_id or role_id must be provided"))
+	}
+	if _, err := s.getPolicy(ctx, payload.ID); err != nil {
+		return err
+	}
+
+	var err error
+	if payload.UserID != nil {
+		err = s.policiesRepository.DetachPolicyFromUser(ctx, payload.ID, *payload.UserID)
+	} else {
+		err = s.policiesRepository.DetachPolicyFromRole(ctx, payload.ID, *payload.RoleID)
+	}
+	if err != nil {
+		return genpolicies.MakeInternalError(fmt.Errorf("could not detach policy: %w", err))
+	}
+	return nil
+}
+
+// Explain evaluates the access check in Go, the same way the SQL condition of the secrets repository does, while
+// keeping track of every grant and rule involved.
+func (s *PoliciesService) Explain(ctx context.Context, payload *genpolicies.ExplainPayload) (*genpolicies.ExplainResult, error) {
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return nil, genpolicies.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	secretPath := string(decodedPath)
+	if len(secretPath) == 0 || secretPath[0] != '/' {
+		return nil, genpolicies.MakeInvalidParameters(fmt.Errorf("path must start with '/'"))
+	}
+
+	if _, err := s.usersRepository.GetUserByID(ctx, payload.UserID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, genpolicies.MakeInvalidParameters(fmt.Errorf("user %d does not exist", payload.UserID))
+		}
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve user: %w", err))
+	}
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, payload.UserID)
+	if err != nil {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve user roles: %w", err))
+	}
+
+	var matches []*genpolicies.ExplainMatch
+	isOwner := false
+
+	// Ownership and direct grants only exist for secrets already created
+	owner, err := s.secretsRepository.GetSecretOwner(ctx, secretPath)
+	if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve secret: %w", err))
+	}
+	if err == nil {
+		if (owner.UserID != nil && *owner.UserID == payload.UserID) || (owner.RoleID != nil && slices.Contains(roleIDs, *owner.RoleID)) {
+			isOwner = true
+			matches = append(matches, &genpolicies.ExplainMatch{Source: "owner", Effect: policy.EffectAllow, Path: secretPath})
+		}
+
+		grants, err := s.secretsAccessRepository.GetGrantsBySecretPath(ctx, secretPath)
+		if err != nil {
+			return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve secret grants: %w", err))
+		}
+		for _, grant := range grants {
+			grantee := (grant.UserID != nil && *grant.UserID == payload.UserID) || (grant.RoleID != nil && slices.Contains(roleIDs, *grant.RoleID))
+			if grantee && slices.Contains(grant.Capabilities, payload.Capability) {
+				matches = append(matches, &genpolicies.ExplainMatch{Source: "grant", Effect: policy.EffectAllow, Path: secretPath})
+			}
+		}
+	}
+
+	folderAccesses, err := s.folderAccessRepository.GetFolderAccessesForPath(ctx, secretPath)
+	if err != nil {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve folder accesses: %w", err))
+	}
+	for _, access := range folderAccesses {
+		grantee := (access.UserID != nil && *access.UserID == payload.UserID) || (access.RoleID != nil && slices.Contains(roleIDs, *access.RoleID))
+		if grantee && slices.Contains(access.Capabilities, payload.Capability) {
+			matches = append(matches, &genpolicies.ExplainMatch{Source: "folder", Effect: policy.EffectAllow, Path: access.PathPrefix})
+		}
+	}
+
+	policies, err := s.policiesRepository.GetPoliciesForUser(ctx, payload.UserID, roleIDs)
+	if err != nil {
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve user policies: %w", err))
+	}
+	for _, p := range policies {
+		for _, rule := range p.Rules {
+			if slices.Contains(rule.Capabilities, payload.Capability) && policy.Matches(rule.PathGlob, secretPath) {
+				matches = append(matches, &genpolicies.ExplainMatch{Source: "policy", Effect: rule.Effect, Path: rule.PathGlob, Policy: &p.Name})
+			}
+		}
+	}
+
+	result := &genpolicies.ExplainResult{
+		Allowed:  false,
+		Decision: fmt.Sprintf("denied as no grant nor policy gives the %s capability", payload.Capability),
+		Matches:  matches,
+	}
+	if result.Matches == nil {
+		result.Matches = []*genpolicies.ExplainMatch{}
+	}
+
+	if isOwner {
+		result.Allowed = true
+		result.Decision = "allowed as the owner of the secret"
+		return result, nil
+	}
+	for _, match := range matches {
+		if match.Effect == policy.EffectDeny {
+			result.Decision = fmt.Sprintf("denied by rule %s of policy %s", match.Path, *match.Policy)
+			return result, nil
+		}
+	}
+	if len(matches) == 0 {
+		return result, nil
+	}
+
+	// Only allow matches are left, the first one is reported
+	result.Allowed = true
+	switch match := matches[0]; match.Source {
+	case "grant":
+		result.Decision = "allowed by a grant on the secret"
+	case "folder":
+		result.Decision = fmt.Sprintf("allowed by a grant on the folder %s", match.Path)
+	case "policy":
+		result.Decision = fmt.Sprintf("allowed by rule %s of policy %s", match.Path, *match.Policy)
+	}
+	return result, nil
+}
+
+func (s *PoliciesService) getPolicy(ctx context.Context, id int) (*repository.Policy, error) {
+	p, err := s.policiesRepository.GetPolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrPolicyNotFound) {
+			return nil, genpolicies.MakePolicyNotFound(fmt.Errorf("policy with id %d not found", id))
+		}
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not retrieve policy: %w", err))
+	}
+	return p, nil
+}
+
+func parsePolicy(format string, document string) ([]repository.PolicyRule, error) {
+	parsed, err := policy.Parse(format, document, repository.AllCapabilities)
+	if err != nil {
+		if errors.Is(err, policy.ErrInvalidPolicy) {
+			return nil, genpolicies.MakeInvalidParameters(err)
+		}
+		return nil, genpolicies.MakeInternalError(fmt.Errorf("could not parse policy: %w", err))
+	}
+
+	rules := make([]repository.PolicyRule, 0, len(parsed))
+	for _, rule := range parsed {
+		rules = append(rules, repository.PolicyRule{
+			PathGlob:     rule.PathGlob,
+			Pattern:      policy.Pattern(rule.PathGlob),
+			Effect:       rule.Effect,
+			Capabilities: rule.Capabilities,
+		})
+	}
+	return rules, nil
+}
+
+func policyToResult(p *repository.Policy) *genpolicies.Policy {
+	result := &genpolicies.Policy{
+		ID:        p.ID,
+		Name:      p.Name,
+		Format:    p.Format,
+		Document:  p.Document,
+		Rules:     make([]*genpolicies.PolicyRule, 0, len(p.Rules)),
+		Users:     []int{},
+		Roles:     []int{},
+		CreatedAt: p.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: p.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	for _, rule := range p.Rules {
+		result.Rules = append(result.Rules, &genpolicies.PolicyRule{
+			Path:         rule.PathGlob,
+			Effect:       rule.Effect,
+			Capabilities: rule.Capabilities,
+		})
+	}
+	result.Users = append(result.Users, p.UserIDs...)
+	result.Roles = append(result.Roles, p.RoleIDs...)
+	return result
+}
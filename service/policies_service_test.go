@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	genpolicies "github.com/Vidalee/FishyKeys/gen/policies"
+	gensecrets "github.com/Vidalee/FishyKeys/gen/secrets"
+	"github.com/Vidalee/FishyKeys/internal/testutil"
+	"github.com/Vidalee/FishyKeys/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupPoliciesTestService(t *testing.T) *PoliciesService {
+	return NewPoliciesService(
+		repository.NewPoliciesRepository(testDB),
+		repository.NewUsersRepository(testDB),
+		repository.NewRolesRepository(testDB),
+		repository.NewUserRolesRepository(testDB),
+		repository.NewSecretsRepository(testDB),
+		repository.NewSecretsAccessRepository(testDB),
+		repository.NewFolderAccessRepository(testDB),
+	)
+}
+
+func clearPoliciesServiceTables(t *testing.T, ctx context.Context) {
+	clearSecretsServiceTables(t, ctx)
+	err := testutil.ClearTable(ctx, "policies")
+	require.NoError(t, err)
+}
+
+const appsDbReadersPolicy = `
+path "/apps/*/db/*" {
+  capabilities = ["read", "list"]
+}
+
+path "/apps/prod/**" {
+  deny = ["read"]
+}
+`
+
+func TestPoliciesService_CreatePolicy(t *testing.T) {
+	service := setupPoliciesTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		policyName        string
+		format            string
+		document          string
+		doDuplicate       bool
+		expectedError     bool
+		expectedErrorText string
+		expectedRules     []*genpolicies.PolicyRule
+	}{
+		{
+			name:       "hcl policy",
+			policyName: "apps-db-readers",
+			format:     "hcl",
+			document:   appsDbReadersPolicy,
+			expectedRules: []*genpolicies.PolicyRule{
+				{Path: "/apps/*/db/*", Effect: "allow", Capabilities: []string{"read", "list"}},
+				{Path: "/apps/prod/**", Effect: "deny", Capabilities: []string{"read"}},
+			},
+		},
+		{
+			name:       "yaml policy",
+			policyName: "ci",
+			format:     "yaml",
+			document:   "path:\n  /ci/**:\n    capabilities: [read]\n",
+			expectedRules: []*genpolicies.PolicyRule{
+				{Path: "/ci/**", Effect: "allow", Capabilities: []string{"read"}},
+			},
+		},
+		{
+			name:              "name already taken",
+			policyName:        "apps-db-readers",
+			format:            "hcl",
+			document:          appsDbReadersPolicy,
+			doDuplicate:       true,
+			expectedError:     true,
+			expectedErrorText: "policy with name apps-db-readers already exists",
+		},
+		{
+			name:              "invalid document",
+			policyName:        "broken",
+			format:            "yaml",
+			document:          "path:\n  /ci/**:\n    capabilities: [sudo]\n",
+			expectedError:     true,
+			expectedErrorText: `invalid policy: unknown capability "sudo" on path "/ci/**"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearPoliciesServiceTables(t, ctx)
+
+			payload := &genpolicies.CreatePolicyPayload{
+				Name:     tt.policyName,
+				Format:   tt.format,
+				Document: tt.document,
+			}
+			result, err := service.CreatePolicy(ctx, payload)
+			if tt.doDuplicate {
+				result, err = service.CreatePolicy(ctx, payload)
+			}
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.policyName, result.Name)
+				assert.Equal(t, tt.document, result.Document)
+				assert.Equal(t, tt.expectedRules, result.Rules)
+			}
+		})
+	}
+}
+
+func TestPoliciesService_UpdateAndDeletePolicy(t *testing.T) {
+	service := setupPoliciesTestService(t)
+	ctx := context.Background()
+	clearPoliciesServiceTables(t, ctx)
+
+	first, err := service.CreatePolicy(ctx, &genpolicies.CreatePolicyPayload{Name: "first", Format: "hcl", Document: appsDbReadersPolicy})
+	require.NoError(t, err)
+	_, err = service.CreatePolicy(ctx, &genpolicies.CreatePolicyPayload{Name: "second", Format: "hcl", Document: appsDbReadersPolicy})
+	require.NoError(t, err)
+
+	updated, err := service.UpdatePolicy(ctx, &genpolicies.UpdatePolicyPayload{
+		ID:       first.ID,
+		Name:     "renamed",
+		Format:   "yaml",
+		Document: "path:\n  /ci/**:\n    capabilities: [list]\n",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", updated.Name)
+	assert.Equal(t, []*genpolicies.PolicyRule{{Path: "/ci/**", Effect: "allow", Capabilities: []string{"list"}}}, updated.Rules)
+
+	_, err = service.UpdatePolicy(ctx, &genpolicies.UpdatePolicyPayload{ID: first.ID, Name: "second", Format: "hcl", Document: appsDbReadersPolicy})
+	assert.Error(t, err)
+	assert.Equal(t, "policy with name second already exists", err.Error())
+
+	err = service.DeletePolicy(ctx, &genpolicies.DeletePolicyPayload{ID: first.ID})
+	require.NoError(t, err)
+
+	_, err = service.GetPolicy(ctx, &genpolicies.GetPolicyPayload{ID: first.ID})
+	assert.Error(t, err)
+
+	policies, err := service.ListPolicies(ctx)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "second", policies[0].Name)
+}
+
+func TestPoliciesService_Enforcement(t *testing.T) {
+	service := setupPoliciesTestService(t)
+	secretsService := setupSecretsTestService(t)
+	ctx := context.Background()
+	clearPoliciesServiceTables(t, ctx)
+
+	ownerID, err := service.usersRepository.CreateUser(ctx, "owner", "password")
+	require.NoError(t, err, "failed to create owner")
+	userID, err := service.usersRepository.CreateUser(ctx, "user", "password")
+	require.NoError(t, err, "failed to create user")
+	roleID, err := service.rolesRepository.CreateRole(ctx, "developers", "#FFFFFF")
+	require.NoError(t, err, "failed to create role")
+	err = service.userRolesRepository.AssignRoleToUser(ctx, userID, roleID)
+	require.NoError(t, err, "failed to assign role")
+
+	for _, secretPath := range []string{"/apps/payments/db/password", "/apps/prod/db/password", "/apps/payments/api_key"} {
+		_, err = secretsService.secretsRepository.CreateSecret(ctx, secretsService.keyManager, secretPath, ownerID, "value")
+		require.NoError(t, err, "failed to create secret %s", secretPath)
+	}
+	// The deny rule of the policy takes precedence over this grant
+	_, err = secretsService.folderAccessRepository.GrantUserFolderAccess(ctx, "/apps/prod/", userID, repository.DefaultCapabilities)
+	require.NoError(t, err, "failed to grant folder access")
+
+	created, err := service.CreatePolicy(ctx, &genpolicies.CreatePolicyPayload{Name: "apps-db-readers", Format: "hcl", Document: appsDbReadersPolicy})
+	require.NoError(t, err)
+	err = service.AttachPolicy(ctx, &genpolicies.AttachPolicyPayload{ID: created.ID, RoleID: &roleID})
+	require.NoError(t, err)
+
+	readValue := func(secretPath string) error {
+		ctxWithToken := context.WithValue(ctx, "token", &JwtClaims{UserID: userID})
+		_, err := secretsService.GetSecretValue(ctxWithToken, &gensecrets.GetSecretValuePayload{
+			Path: base64.StdEncoding.EncodeToString([]byte(secretPath)),
+		})
+		return err
+	}
+	explain := func(secretPath string, capability string) *genpolicies.ExplainResult {
+		result, err := service.Explain(ctx, &genpolicies.ExplainPayload{
+			UserID:     userID,
+			Path:       base64.StdEncoding.EncodeToString([]byte(secretPath)),
+			Capability: capability,
+		})
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("allow rules give access", func(t *testing.T) {
+		assert.NoError(t, readValue("/apps/payments/db/password"))
+
+		result := explain("/apps/payments/db/password", "read")
+		assert.True(t, result.Allowed)
+		assert.Equal(t, "allowed by rule /apps/*/db/* of policy apps-db-readers", result.Decision)
+	})
+
+	t.Run("secrets not matching any rule are not accessible", func(t *testing.T) {
+		err := readValue("/apps/payments/api_key")
+		assert.Error(t, err)
+		assert.Equal(t, "you do not have access to this secret", err.Error())
+
+		result := explain("/apps/payments/api_key", "read")
+		assert.False(t, result.Allowed)
+		assert.Empty(t, result.Matches)
+	})
+
+	t.Run("deny rules override grants", func(t *testing.T) {
+		err := readValue("/apps/prod/db/password")
+		assert.Error(t, err)
+
+		result := explain("/apps/prod/db/password", "read")
+		assert.False(t, result.Allowed)
+		assert.Equal(t, "denied by rule /apps/prod/** of policy apps-db-readers", result.Decision)
+		assert.Len(t, result.Matches, 3)
+
+		result = explain("/apps/prod/db/password", "list")
+		assert.True(t, result.Allowed)
+	})
+
+	t.Run("detached policies no longer apply", func(t *testing.T) {
+		err := service.DetachPolicy(ctx, &genpolicies.DetachPolicyPayload{ID: created.ID, RoleID: &roleID})
+		require.NoError(t, err)
+
+		assert.Error(t, readValue("/apps/payments/db/password"))
+	})
+}
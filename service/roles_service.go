@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	genroles "github.com/Vidalee/FishyKeys/gen/roles"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/repository"
 )
 
@@ -13,17 +14,20 @@ type RolesService struct {
 	rolesRepository     repository.RolesRepository
 	userRepository      repository.UsersRepository
 	userRolesRepository repository.UserRolesRepository
+	eventBus            *events.Bus
 }
 
 func NewRolesService(
 	rolesRepository repository.RolesRepository,
 	userRepository repository.UsersRepository,
 	userRolesRepository repository.UserRolesRepository,
+	eventBus *events.Bus,
 ) *RolesService {
 	return &RolesService{
 		rolesRepository:     rolesRepository,
 		userRepository:      userRepository,
 		userRolesRepository: userRolesRepository,
+		eventBus:            eventBus,
 	}
 }
 
@@ -63,6 +67,7 @@ func (s *RolesService) CreateRole(ctx context.Context, payload *genroles.CreateR
 		return nil, genroles.MakeInternalError(fmt.Errorf("could not create role: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.RoleCreated, map[string]any{"role_id": roleID, "name": payload.Name})
 	return &genroles.CreateRoleResult{
 		ID:    roleID,
 		Color: payload.Color,
@@ -80,8 +85,12 @@ func (s *RolesService) DeleteRole(ctx context.Context, payload *genroles.DeleteR
 		if errors.Is(err, repository.ErrRoleNotFound) {
 			return genroles.MakeRoleNotFound(fmt.Errorf("role not found"))
 		}
+		if errors.Is(err, repository.ErrRoleOwnsSecrets) {
+			return genroles.MakeForbidden(fmt.Errorf("role still owns secrets, transfer their ownership first"))
+		}
 		return genroles.MakeInternalError(fmt.Errorf("could not delete role: %w", err))
 	}
+	publishEvent(ctx, s.eventBus, events.RoleDeleted, map[string]any{"role_id": payload.ID})
 	return nil
 }
 
@@ -107,6 +116,7 @@ func (s *RolesService) AssignRoleToUser(ctx context.Context, payload *genroles.A
 		return genroles.MakeInternalError(fmt.Errorf("could not assign role to user: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.RoleAssigned, map[string]any{"role_id": payload.RoleID, "user_id": payload.UserID})
 	return nil
 }
 
@@ -148,5 +158,6 @@ func (s *RolesService) UnassignRoleToUser(ctx context.Context, payload *genroles
 		return genroles.MakeInternalError(fmt.Errorf("could not unassign role from user: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.RoleUnassigned, map[string]any{"role_id": payload.RoleID, "user_id": payload.UserID})
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	genroles "github.com/Vidalee/FishyKeys/gen/roles"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/internal/testutil"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/stretchr/testify/assert"
@@ -16,11 +17,13 @@ func setupRolesTestService(t *testing.T) *RolesService {
 	rolesRepo := repository.NewRolesRepository(testDB)
 	usersRepo := repository.NewUsersRepository(testDB)
 	userRolesRepo := repository.NewUserRolesRepository(testDB)
-	return NewRolesService(rolesRepo, usersRepo, userRolesRepo)
+	return NewRolesService(rolesRepo, usersRepo, userRolesRepo, events.NewBus())
 }
 
 func clearRolesServiceTables(t *testing.T, ctx context.Context) {
-	err := testutil.ClearTable(ctx, "user_roles")
+	err := testutil.ClearTable(ctx, "secrets")
+	require.NoError(t, err)
+	err = testutil.ClearTable(ctx, "user_roles")
 	require.NoError(t, err)
 	err = testutil.ClearTable(ctx, "users")
 	require.NoError(t, err)
@@ -139,6 +142,7 @@ func TestRolesService_DeleteRole(t *testing.T) {
 		roleName          string
 		roleColor         string
 		createRole        bool
+		ownsSecret        bool
 		roleID            int
 		expectedError     bool
 		expectedErrorText string
@@ -157,6 +161,15 @@ func TestRolesService_DeleteRole(t *testing.T) {
 			roleColor:     "#0000FF",
 			expectedError: false,
 		},
+		{
+			name:              "role owning secrets",
+			createRole:        true,
+			ownsSecret:        true,
+			roleName:          "owners",
+			roleColor:         "#00FF00",
+			expectedError:     true,
+			expectedErrorText: "role still owns secrets, transfer their ownership first",
+		},
 		{
 			name:              "delete admin role",
 			createRole:        false,
@@ -179,6 +192,14 @@ func TestRolesService_DeleteRole(t *testing.T) {
 				result, err := service.CreateRole(ctx, createPayload)
 				require.NoError(t, err, "failed to create role for delete test")
 				roleID = result.ID
+
+				if tt.ownsSecret {
+					_, err = testDB.Exec(ctx, `
+						INSERT INTO secrets (path, encrypted_encryption_key, encrypted_value, owner_role_id)
+						VALUES ('/owned/secret', 'key', 'value', $1)
+					`, roleID)
+					require.NoError(t, err, "failed to create secret owned by role")
+				}
 			} else {
 				roleID = tt.roleID
 			}
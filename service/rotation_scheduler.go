@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+// RotationScheduler regenerates the secrets whose rotation is due. Several servers sharing a database can run it
+// concurrently, each due secret is locked and rotated by a single one of them.
+type RotationScheduler struct {
+	keyManager                *crypto.KeyManager
+	secretsRepository         repository.SecretsRepository
+	validationRulesRepository repository.ValidationRulesRepository
+	eventBus                  *events.Bus
+	maxSecretSize             int64
+	interval                  time.Duration
+}
+
+func NewRotationScheduler(keyManager *crypto.KeyManager, secretsRepository repository.SecretsRepository, validationRulesRepository repository.ValidationRulesRepository, eventBus *events.Bus, maxSecretSize int64, interval time.Duration) *RotationScheduler {
+	return &RotationScheduler{
+		keyManager:                keyManager,
+		secretsRepository:         secretsRepository,
+		validationRulesRepository: validationRulesRepository,
+		eventBus:                  eventBus,
+		maxSecretSize:             maxSecretSize,
+		interval:                  interval,
+	}
+}
+
+// Run checks for due rotations every interval until the context is cancelled
+func (s *RotationScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RotateDueSecrets(ctx); err != nil {
+				log.Printf("Secret rotation error: %v", err)
+			}
+		}
+	}
+}
+
+// RotateDueSecrets rotates every secret due at the current time and returns the rotations done. Nothing is rotated
+// while the master key is locked, since values can't be encrypted.
+func (s *RotationScheduler) RotateDueSecrets(ctx context.Context) ([]repository.SecretRotation, error) {
+	if s.keyManager.GetState() != crypto.StateUnlocked {
+		return nil, nil
+	}
+
+	var rotations []repository.SecretRotation
+	// A secret is rotated at most once per pass, even when its next rotation is already due
+	var rotated []int
+	now := time.Now().UTC()
+	for {
+		rotation, err := s.secretsRepository.RotateDueSecret(ctx, s.keyManager, now, rotated, s.checkGenerated)
+		if err != nil {
+			return rotations, err
+		}
+		if rotation == nil {
+			return rotations, nil
+		}
+
+		if rotation.Error != "" {
+			log.Printf("Could not rotate secret %s: %s", rotation.Path, rotation.Error)
+		} else {
+			log.Printf("Rotated secret %s to version %d", rotation.Path, rotation.Version)
+			s.eventBus.Publish(ctx, events.SecretRotated, "", map[string]any{"path": rotation.Path, "version": rotation.Version})
+		}
+		rotated = append(rotated, rotation.SecretID)
+		rotations = append(rotations, *rotation)
+	}
+}
+
+// checkGenerated applies to a generated value the size limit and the validation rules of the values set by users
+func (s *RotationScheduler) checkGenerated(ctx context.Context, secretPath string, value string, fields map[string]string) error {
+	if fields != nil {
+		if int64(fieldsSize(fields)) > s.maxSecretSize {
+			return fmt.Errorf("secret exceeds the maximum size of %d bytes", s.maxSecretSize)
+		}
+		return checkValidationRules(ctx, s.validationRulesRepository, secretPath, nil, fields)
+	}
+	if int64(len(value)) > s.maxSecretSize {
+		return fmt.Errorf("secret exceeds the maximum size of %d bytes", s.maxSecretSize)
+	}
+	return checkValidationRules(ctx, s.validationRulesRepository, secretPath, &value, nil)
+}
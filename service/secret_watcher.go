@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+const (
+	// secretEventsBatchSize is the number of events scanned per query when catching up
+	secretEventsBatchSize = 100
+	// secretEventsPollInterval bounds the delay of an event whose notification was lost
+	secretEventsPollInterval = 30 * time.Second
+	// secretEventsRetryDelay is the delay before listening again after the listener connection failed
+	secretEventsRetryDelay = 5 * time.Second
+)
+
+// SecretWatcher listens to the secret events recorded by every server sharing the database and wakes up the watches
+// of this server, which then read the events from the database. It also deletes the events older than the retention.
+type SecretWatcher struct {
+	secretEventsRepository repository.SecretEventsRepository
+	retention              time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+func NewSecretWatcher(secretEventsRepository repository.SecretEventsRepository, retention time.Duration) *SecretWatcher {
+	return &SecretWatcher{
+		secretEventsRepository: secretEventsRepository,
+		retention:              retention,
+		subscribers:            make(map[chan struct{}]struct{}),
+	}
+}
+
+// Run listens to the secret events until the context is cancelled
+func (w *SecretWatcher) Run(ctx context.Context) {
+	go w.prune(ctx)
+
+	for {
+		err := w.secretEventsRepository.ListenSecretEvents(ctx, w.wake)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Secret events listener error: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(secretEventsRetryDelay):
+		}
+	}
+}
+
+func (w *SecretWatcher) prune(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := w.secretEventsRepository.DeleteSecretEventsBefore(ctx, time.Now().UTC().Add(-w.retention))
+			if err != nil {
+				log.Printf("Could not delete old secret events: %v", err)
+			} else if deleted > 0 {
+				log.Printf("Deleted %d old secret events", deleted)
+			}
+		}
+	}
+}
+
+func (w *SecretWatcher) wake() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for subscriber := range w.subscribers {
+		// A pending wake up already covers this one
+		select {
+		case subscriber <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (w *SecretWatcher) subscribe() (<-chan struct{}, func()) {
+	subscriber := make(chan struct{}, 1)
+	w.mu.Lock()
+	w.subscribers[subscriber] = struct{}{}
+	w.mu.Unlock()
+
+	return subscriber, func() {
+		w.mu.Lock()
+		delete(w.subscribers, subscriber)
+		w.mu.Unlock()
+	}
+}
+
+// Watch calls send with the events matching the filter recorded after the given id, then with the following ones as
+// they are recorded, until the context is cancelled or send fails. The roles of the user are resolved again on every
+// read so role changes apply to running watches.
+func (w *SecretWatcher) Watch(ctx context.Context, after int64, filter repository.SecretEventFilter, userID int, roleIDs func(ctx context.Context) ([]int, error), send func(event repository.SecretEvent) error) error {
+	wakeUp, unsubscribe := w.subscribe()
+	defer unsubscribe()
+
+	ticker := time.NewTicker(secretEventsPollInterval)
+	defer ticker.Stop()
+
+	for {
+		ids, err := roleIDs(ctx)
+		if err != nil {
+			return err
+		}
+		for {
+			events, last, err := w.secretEventsRepository.ListSecretEvents(ctx, after, filter, userID, ids, secretEventsBatchSize)
+			if err != nil {
+				return err
+			}
+			if last == after {
+				break
+			}
+			for _, event := range events {
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+			after = last
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wakeUp:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Position returns the id of the latest event, the position a watch without resume token starts from, and the id of
+// the oldest available event
+func (w *SecretWatcher) Position(ctx context.Context) (oldest int64, latest int64, err error) {
+	return w.secretEventsRepository.GetSecretEventsRange(ctx)
+}
@@ -1,25 +1,74 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"log"
+	"maps"
+	"mime"
+	"path"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	gensecrets "github.com/Vidalee/FishyKeys/gen/secrets"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/internal/generator"
+	"github.com/Vidalee/FishyKeys/internal/reference"
+	"github.com/Vidalee/FishyKeys/internal/render"
+	"github.com/Vidalee/FishyKeys/internal/schedule"
+	"github.com/Vidalee/FishyKeys/internal/secretfile"
+	"github.com/Vidalee/FishyKeys/internal/validation"
 	"github.com/Vidalee/FishyKeys/repository"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// maxSecretPathLength is the size of the path column of the secrets table
+const maxSecretPathLength = 255
+
+// breakGlassDuration is how long breaking the glass gives read access to the secret
+const breakGlassDuration = time.Hour
+
+// maxRenderedTemplateSize is the size of the largest document a template renders
+const maxRenderedTemplateSize = 1 << 20
+
+// adminRoleName is the name of the role created with the database, which uploaded files are granted to
+const adminRoleName = "admin"
+
+// renderTemplateTimeout is the longest a template may take to render, looking its secrets up included
+const renderTemplateTimeout = 10 * time.Second
+
+// maxImportFileSize is the size of the largest file secrets are imported from
+const maxImportFileSize = 1 << 20
+
 type SecretsService struct {
-	keyManager               *crypto.KeyManager
-	usersRepository          repository.UsersRepository
-	rolesRepository          repository.RolesRepository
-	userRolesRepository      repository.UserRolesRepository
-	globalSettingsRepository repository.GlobalSettingsRepository
-	secretsRepository        repository.SecretsRepository
-	secretsAccessRepository  repository.SecretsAccessRepository
+	keyManager                   *crypto.KeyManager
+	usersRepository              repository.UsersRepository
+	rolesRepository              repository.RolesRepository
+	userRolesRepository          repository.UserRolesRepository
+	globalSettingsRepository     repository.GlobalSettingsRepository
+	secretsRepository            repository.SecretsRepository
+	secretsAccessRepository      repository.SecretsAccessRepository
+	folderAccessRepository       repository.FolderAccessRepository
+	generationPoliciesRepository repository.GenerationPoliciesRepository
+	wrappedSecretsRepository     repository.WrappedSecretsRepository
+	accessRequestsRepository     repository.AccessRequestsRepository
+	breakGlassRepository         repository.BreakGlassRepository
+	accessStatsRepository        repository.AccessStatsRepository
+	validationRulesRepository    repository.ValidationRulesRepository
+	secretWatcher                *SecretWatcher
+	accessStatsRecorder          *AccessStatsRecorder
+	eventBus                     *events.Bus
+	maxSecretSize                int64
 }
 
 func NewSecretsService(
@@ -30,15 +79,37 @@ func NewSecretsService(
 	globalSettingsRepository repository.GlobalSettingsRepository,
 	secretsRepository repository.SecretsRepository,
 	secretsAccessRepository repository.SecretsAccessRepository,
+	folderAccessRepository repository.FolderAccessRepository,
+	generationPoliciesRepository repository.GenerationPoliciesRepository,
+	wrappedSecretsRepository repository.WrappedSecretsRepository,
+	accessRequestsRepository repository.AccessRequestsRepository,
+	breakGlassRepository repository.BreakGlassRepository,
+	accessStatsRepository repository.AccessStatsRepository,
+	validationRulesRepository repository.ValidationRulesRepository,
+	secretWatcher *SecretWatcher,
+	accessStatsRecorder *AccessStatsRecorder,
+	eventBus *events.Bus,
+	maxSecretSize int64,
 ) *SecretsService {
 	return &SecretsService{
-		keyManager:               keyManager,
-		usersRepository:          usersRepository,
-		rolesRepository:          rolesRepository,
-		userRolesRepository:      userRolesRepository,
-		globalSettingsRepository: globalSettingsRepository,
-		secretsRepository:        secretsRepository,
-		secretsAccessRepository:  secretsAccessRepository,
+		keyManager:                   keyManager,
+		usersRepository:              usersRepository,
+		rolesRepository:              rolesRepository,
+		userRolesRepository:          userRolesRepository,
+		globalSettingsRepository:     globalSettingsRepository,
+		secretsRepository:            secretsRepository,
+		secretsAccessRepository:      secretsAccessRepository,
+		folderAccessRepository:       folderAccessRepository,
+		generationPoliciesRepository: generationPoliciesRepository,
+		wrappedSecretsRepository:     wrappedSecretsRepository,
+		accessRequestsRepository:     accessRequestsRepository,
+		breakGlassRepository:         breakGlassRepository,
+		accessStatsRepository:        accessStatsRepository,
+		validationRulesRepository:    validationRulesRepository,
+		secretWatcher:                secretWatcher,
+		accessStatsRecorder:          accessStatsRecorder,
+		eventBus:                     eventBus,
+		maxSecretSize:                maxSecretSize,
 	}
 }
 
@@ -83,9 +154,9 @@ func (s *SecretsService) ListSecrets(ctx context.Context) ([]*gensecrets.SecretI
 
 	secretInfos := make([]*gensecrets.SecretInfoSummary, 0, len(secrets))
 	for _, secret := range secrets {
-		owner, err := s.usersRepository.GetUserByID(ctx, secret.OwnerUserId)
+		owner, ownerRole, err := s.secretOwnerToResult(ctx, secret.OwnerUserId, secret.OwnerRoleID)
 		if err != nil {
-			return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret owner: %w", err))
+			return nil, err
 		}
 
 		secretUserIds, roleUserIds, err := s.secretsAccessRepository.GetAccessesBySecretPath(ctx, secret.Path)
@@ -107,13 +178,9 @@ func (s *SecretsService) ListSecrets(ctx context.Context) ([]*gensecrets.SecretI
 		}
 
 		secretInfos = append(secretInfos, &gensecrets.SecretInfoSummary{
-			Path: secret.Path,
-			Owner: &gensecrets.User{
-				ID:        owner.ID,
-				Username:  owner.Username,
-				CreatedAt: owner.CreatedAt.Format("2006-01-02T15:04:05Z"),
-				UpdatedAt: owner.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-			},
+			Path:      secret.Path,
+			Owner:     owner,
+			OwnerRole: ownerRole,
 			CreatedAt: secret.CreatedAt.Format("2006-01-02T15:04:05Z"),
 			UpdatedAt: secret.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 			Roles:     secretRoles,
@@ -124,6 +191,98 @@ func (s *SecretsService) ListSecrets(ctx context.Context) ([]*gensecrets.SecretI
 	return secretInfos, nil
 }
 
+// secretOwnerToResult returns the user or the role owning a secret
+func (s *SecretsService) secretOwnerToResult(ctx context.Context, ownerUserID int, ownerRoleID *int) (*gensecrets.User, *gensecrets.Role, error) {
+	if ownerRoleID != nil {
+		role, err := s.rolesRepository.GetRoleByID(ctx, *ownerRoleID)
+		if err != nil {
+			return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret owner role: %w", err))
+		}
+		return nil, &gensecrets.Role{
+			ID:        role.ID,
+			Name:      role.Name,
+			Color:     role.Color,
+			Admin:     role.Admin,
+			CreatedAt: role.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: role.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}, nil
+	}
+
+	owner, err := s.usersRepository.GetUserByID(ctx, ownerUserID)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret owner: %w", err))
+	}
+	return &gensecrets.User{
+		ID:        owner.ID,
+		Username:  owner.Username,
+		CreatedAt: owner.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt: owner.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}, nil, nil
+}
+
+func (s *SecretsService) BrowseSecrets(ctx context.Context, payload *gensecrets.BrowseSecretsPayload) (*gensecrets.BrowseSecretsResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPrefix, err := base64.StdEncoding.DecodeString(payload.Prefix)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid prefix encoding: %w", err))
+	}
+	prefix := string(decodedPrefix)
+	if len(prefix) == 0 || prefix[0] != '/' {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("prefix must start with '/'"))
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	after := ""
+	if payload.Cursor != nil {
+		decodedCursor, err := base64.StdEncoding.DecodeString(*payload.Cursor)
+		if err != nil {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid cursor encoding: %w", err))
+		}
+		after = string(decodedCursor)
+	}
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	// Fetch one more entry than requested to know if there is a next page
+	entries, err := s.secretsRepository.ListSecretEntries(ctx, prefix, payload.Recursive, jwtClaims.UserID, roleIDs, after, payload.Limit+1)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+	}
+
+	result := &gensecrets.BrowseSecretsResult{
+		Entries: make([]*gensecrets.SecretEntry, 0, len(entries)),
+	}
+	if len(entries) > payload.Limit {
+		entries = entries[:payload.Limit]
+		nextCursor := base64.StdEncoding.EncodeToString([]byte(entries[len(entries)-1].Path))
+		result.NextCursor = &nextCursor
+	}
+
+	for _, entry := range entries {
+		secretEntry := &gensecrets.SecretEntry{
+			Path:   entry.Path,
+			Name:   path.Base(entry.Path),
+			Folder: entry.Folder,
+		}
+		if !entry.Folder {
+			createdAt := entry.CreatedAt.Format("2006-01-02T15:04:05Z")
+			updatedAt := entry.UpdatedAt.Format("2006-01-02T15:04:05Z")
+			secretEntry.CreatedAt = &createdAt
+			secretEntry.UpdatedAt = &updatedAt
+		}
+		result.Entries = append(result.Entries, secretEntry)
+	}
+
+	return result, nil
+}
+
 func (s *SecretsService) CreateSecret(ctx context.Context, payload *gensecrets.CreateSecretPayload) error {
 	// Guaranteed by the Authentified interceptor
 	jwtClaims := ctx.Value("token").(*JwtClaims)
@@ -146,21 +305,108 @@ func (s *SecretsService) CreateSecret(ctx context.Context, payload *gensecrets.C
 		return gensecrets.MakeInternalError(fmt.Errorf("error checking if secret already exists: %w", err))
 	}
 
-	_, err = s.secretsRepository.CreateSecret(ctx, s.keyManager, decodedPathStr, jwtClaims.UserID, payload.Value)
+	provided := 0
+	for _, isSet := range []bool{payload.Value != nil, payload.Fields != nil, payload.GenerationPolicy != nil} {
+		if isSet {
+			provided++
+		}
+	}
+	if provided != 1 {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of value, fields or generation_policy must be provided"))
+	}
+	userGrants, err := userGrantsFromPayload(payload.AuthorizedUsers, payload.UserGrants)
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error creating secret: %w", err))
+		return err
 	}
-
-	err = s.secretsAccessRepository.GrantUsersAccess(ctx, decodedPathStr, payload.AuthorizedUsers)
+	roleGrants, err := roleGrantsFromPayload(payload.AuthorizedRoles, payload.RoleGrants)
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized users: %w", err))
+		return err
+	}
+	var rotationSchedule schedule.Schedule
+	if payload.RotationSchedule != nil {
+		if payload.GenerationPolicy == nil {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("a rotation schedule requires a generation policy"))
+		}
+		rotationSchedule, err = schedule.Parse(*payload.RotationSchedule)
+		if err != nil {
+			return gensecrets.MakeInvalidParameters(err)
+		}
+	}
+	newSecret := repository.NewSecret{
+		Path:        decodedPathStr,
+		OwnerUserID: jwtClaims.UserID,
+		Grants:      make([]repository.SecretGrant, 0, len(userGrants)+len(roleGrants)),
+	}
+	if payload.GenerationPolicy != nil {
+		policy, generated, err := s.generate(ctx, *payload.GenerationPolicy)
+		if err != nil {
+			return err
+		}
+		if generated.Fields != nil {
+			err = s.checkSecretSize(fieldsSize(generated.Fields))
+		} else {
+			err = s.checkSecretSize(len(generated.Value))
+		}
+		if err != nil {
+			return err
+		}
+		if generated.Fields != nil {
+			err = s.validateSecretValue(ctx, decodedPathStr, nil, generated.Fields)
+		} else {
+			err = s.validateSecretValue(ctx, decodedPathStr, &generated.Value, nil)
+		}
+		if err != nil {
+			return err
+		}
+		newSecret.Value = generated.Value
+		newSecret.Fields = generated.Fields
+		newSecret.GenerationPolicyID = &policy.ID
+		if rotationSchedule != nil {
+			nextRotationAt := rotationSchedule.Next(time.Now().UTC())
+			newSecret.RotationSchedule = payload.RotationSchedule
+			newSecret.NextRotationAt = &nextRotationAt
+		}
+	} else if payload.Fields != nil {
+		if len(payload.Fields) == 0 {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("a structured secret must have at least one field"))
+		}
+		if err := validateFieldNames(payload.Fields); err != nil {
+			return err
+		}
+		if err := s.checkSecretSize(fieldsSize(payload.Fields)); err != nil {
+			return err
+		}
+		if err := s.validateSecretValue(ctx, decodedPathStr, nil, payload.Fields); err != nil {
+			return err
+		}
+		newSecret.Fields = payload.Fields
+	} else {
+		if err := s.checkSecretSize(len(*payload.Value)); err != nil {
+			return err
+		}
+		if err := s.validateSecretValue(ctx, decodedPathStr, payload.Value, nil); err != nil {
+			return err
+		}
+		newSecret.Value = *payload.Value
 	}
 
-	err = s.secretsAccessRepository.GrantRolesAccess(ctx, decodedPathStr, payload.AuthorizedRoles)
+	for userID, grant := range userGrants {
+		newSecret.Grants = append(newSecret.Grants, repository.SecretGrant{UserID: &userID, Capabilities: grant.capabilities, ExpiresAt: grant.expiresAt})
+	}
+	for roleID, grant := range roleGrants {
+		newSecret.Grants = append(newSecret.Grants, repository.SecretGrant{RoleID: &roleID, Capabilities: grant.capabilities, ExpiresAt: grant.expiresAt})
+	}
+
+	// The secret is created along with its generation settings and grants, so that it is never seen without them
+	_, err = s.secretsRepository.CreateSecretWithGrants(ctx, s.keyManager, newSecret)
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized roles: %w", err))
+		if errors.Is(err, repository.ErrSecretAlreadyExists) {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret already exists at path: %s", decodedPathStr))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error creating secret: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.SecretCreated, map[string]any{"path": decodedPathStr})
 	return nil
 }
 
@@ -168,24 +414,18 @@ func (s *SecretsService) GetSecret(ctx context.Context, payload *gensecrets.GetS
 	// Guaranteed by the Authentified interceptor
 	jwtClaims := ctx.Value("token").(*JwtClaims)
 
-	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
-	if err != nil {
-		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
-	}
-
 	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
 	if err != nil {
 		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
 	}
 	decodedPathStr := string(decodedPath)
 
-	hasAccess, err := s.secretsRepository.HasAccess(ctx, decodedPathStr, &jwtClaims.UserID, roleIDs)
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
 	if err != nil {
-		return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+		return nil, err
 	}
-
-	if !hasAccess {
-		return nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+	if !slices.Contains(capabilities, repository.CapabilityList) {
+		return nil, missingCapability(repository.CapabilityList)
 	}
 
 	decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
@@ -196,9 +436,9 @@ func (s *SecretsService) GetSecret(ctx context.Context, payload *gensecrets.GetS
 		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
 	}
 
-	owner, err := s.usersRepository.GetUserByID(ctx, decryptedSecret.OwnerUserId)
+	owner, ownerRole, err := s.secretOwnerToResult(ctx, decryptedSecret.OwnerUserId, decryptedSecret.OwnerRoleID)
 	if err != nil {
-		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret owner: %w", err))
+		return nil, err
 	}
 
 	authorizedUsers, err := s.usersRepository.GetUsersByIDs(ctx, decryptedSecret.AuthorizedUserIDs)
@@ -231,18 +471,84 @@ func (s *SecretsService) GetSecret(ctx context.Context, payload *gensecrets.GetS
 		})
 	}
 
+	grants, err := s.secretsAccessRepository.GetGrantsBySecretPath(ctx, decodedPathStr)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret grants: %w", err))
+	}
+	userGrants := []*gensecrets.Grant{}
+	roleGrants := []*gensecrets.Grant{}
+	for _, grant := range grants {
+		if grant.UserID != nil {
+			userGrants = append(userGrants, grantToResult(*grant.UserID, grant))
+		}
+		if grant.RoleID != nil {
+			roleGrants = append(roleGrants, grantToResult(*grant.RoleID, grant))
+		}
+	}
+
+	var fieldNames []string
+	if decryptedSecret.Structured {
+		fieldNames = slices.Sorted(maps.Keys(decryptedSecret.Fields))
+	}
+
+	var generationPolicy *string
+	if decryptedSecret.GenerationPolicyID != nil {
+		policy, err := s.generationPoliciesRepository.GetGenerationPolicyByID(ctx, *decryptedSecret.GenerationPolicyID)
+		if err != nil {
+			return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving generation policy: %w", err))
+		}
+		generationPolicy = &policy.Name
+	}
+
+	var nextRotationAt *string
+	if decryptedSecret.NextRotationAt != nil {
+		formatted := decryptedSecret.NextRotationAt.UTC().Format("2006-01-02T15:04:05Z")
+		nextRotationAt = &formatted
+	}
+
+	stats, err := s.accessStatsRepository.GetSecretAccessStats(ctx, decryptedSecret.ID, time.Now().UTC().Add(-accessStatsWindow))
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving access stats: %w", err))
+	}
+	var lastReadAt *string
+	if stats.LastReadAt != nil {
+		formatted := stats.LastReadAt.UTC().Format("2006-01-02T15:04:05Z")
+		lastReadAt = &formatted
+	}
+	var lastReader *gensecrets.User
+	if stats.LastReaderID != nil {
+		reader, err := s.usersRepository.GetUserByID(ctx, *stats.LastReaderID)
+		if err != nil {
+			return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving last reader: %w", err))
+		}
+		lastReader = &gensecrets.User{
+			ID:        reader.ID,
+			Username:  reader.Username,
+			CreatedAt: reader.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: reader.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
 	return &gensecrets.SecretInfo{
-		Path: decryptedSecret.Path,
-		Owner: &gensecrets.User{
-			ID:        owner.ID,
-			Username:  owner.Username,
-			CreatedAt: owner.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt: owner.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-		},
-		AuthorizedUsers: authorizedUsersPayload,
-		AuthorizedRoles: authorizedRolesPayload,
-		CreatedAt:       decryptedSecret.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		UpdatedAt:       decryptedSecret.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		Path:             decryptedSecret.Path,
+		Owner:            owner,
+		OwnerRole:        ownerRole,
+		AuthorizedUsers:  authorizedUsersPayload,
+		AuthorizedRoles:  authorizedRolesPayload,
+		UserGrants:       userGrants,
+		RoleGrants:       roleGrants,
+		Capabilities:     capabilities,
+		Structured:       decryptedSecret.Structured,
+		Fields:           fieldNames,
+		Version:          decryptedSecret.Version,
+		GenerationPolicy: generationPolicy,
+		RotationSchedule: decryptedSecret.RotationSchedule,
+		NextRotationAt:   nextRotationAt,
+		LastReadAt:       lastReadAt,
+		LastReader:       lastReader,
+		RecentReads:      stats.RecentReads,
+		CreatedAt:        decryptedSecret.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:        decryptedSecret.UpdatedAt.Format("2006-01-02T15:04:05Z"),
 	}, nil
 }
 
@@ -250,147 +556,2646 @@ func (s *SecretsService) GetSecretValue(ctx context.Context, payload *gensecrets
 	// Guaranteed by the Authentified interceptor
 	jwtClaims := ctx.Value("token").(*JwtClaims)
 
-	decryptedValue, decodedPath, err := s.getSecretValue(ctx, jwtClaims.UserID, payload.Path)
+	var decryptedSecret *repository.DecryptedSecret
+	var breakGlassAccess *repository.BreakGlassAccess
+	if payload.BreakGlassJustification != nil {
+		decryptedSecret, breakGlassAccess, err = s.breakGlass(ctx, jwtClaims.UserID, payload.Path, *payload.BreakGlassJustification)
+	} else {
+		decryptedSecret, err = s.getReadableSecret(ctx, jwtClaims.UserID, payload.Path)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if decryptedSecret.ContentType != "" {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is binary, download it instead", decryptedSecret.Path))
+	}
 
-	return &gensecrets.GetSecretValueResult{
-		Value: &decryptedValue,
-		Path:  &decodedPath,
-	}, nil
-}
-
-// grpcurl -plaintext -H metadata:token -d '{"path": "/folder/secret"}' 172.19.32.1:8090 secrets.Secrets/OperatorGetSecretValue
-func (s *SecretsService) OperatorGetSecretValue(ctx context.Context, payload *gensecrets.OperatorGetSecretValuePayload) (*gensecrets.OperatorGetSecretValueResult, error) {
-	// Guaranteed by the Authentified interceptor
-	jwtClaims := ctx.Value("token").(*JwtClaims)
-
-	decryptedValue, decodedPath, err := s.getSecretValue(ctx, jwtClaims.UserID, payload.Path)
+	var value *string
+	var fields map[string]string
+	if payload.Raw {
+		value, fields, err = secretFieldValue(decryptedSecret, payload.Field)
+	} else {
+		value, fields, err = resolvedFieldValue(s.referenceResolver(ctx, jwtClaims.UserID), decryptedSecret, payload.Field)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	return &gensecrets.OperatorGetSecretValueResult{
-		Value: &decryptedValue,
-		Path:  &decodedPath,
-	}, nil
+	result := &gensecrets.GetSecretValueResult{
+		Value:  value,
+		Fields: fields,
+		Path:   &decryptedSecret.Path,
+	}
+	if breakGlassAccess != nil {
+		expiresAt := breakGlassAccess.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z")
+		result.BreakGlassExpiresAt = &expiresAt
+	}
+	return result, nil
 }
 
-func (s *SecretsService) getSecretValue(ctx context.Context, userID int, path string) (string, string, error) {
+// breakGlass returns the secret when the user can read it, otherwise gives the user an emergency access to it when
+// it is eligible, which is recorded and notified. The emergency access is nil when the user could already read it.
+func (s *SecretsService) breakGlass(ctx context.Context, userID int, encodedPath string, justification string) (*repository.DecryptedSecret, *repository.BreakGlassAccess, error) {
 	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, userID)
 	if err != nil {
-		return "", "", gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
 	}
 
-	decodedPath, err := base64.StdEncoding.DecodeString(path)
+	decodedPath, err := base64.StdEncoding.DecodeString(encodedPath)
 	if err != nil {
-		return "", "", gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+		return nil, nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
 	}
 	decodedPathStr := string(decodedPath)
 
-	hasAccess, err := s.secretsRepository.HasAccess(ctx, decodedPathStr, &userID, roleIDs)
+	hasAccess, err := s.secretsRepository.HasAccess(ctx, decodedPathStr, &userID, roleIDs, repository.CapabilityRead)
 	if err != nil {
-		return "", "", gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+	}
+	if hasAccess {
+		decryptedSecret, err := s.getReadableSecret(ctx, userID, encodedPath)
+		return decryptedSecret, nil, err
 	}
 
-	if !hasAccess {
-		return "", "", gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+	eligible, err := s.breakGlassRepository.IsBreakGlassEligible(ctx, decodedPathStr, roleIDs)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error checking break-glass eligibility: %w", err))
+	}
+	if !eligible {
+		return nil, nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret and it is not eligible to break-glass access"))
 	}
 
 	decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
 	if err != nil {
 		if errors.Is(err, repository.ErrSecretNotFound) {
-			return "", "", gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+			return nil, nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
 		}
-		return "", "", gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
 	}
 
-	return decryptedSecret.DecryptedValue, decodedPathStr, nil
+	access, err := s.breakGlassRepository.CreateBreakGlassAccess(ctx, decryptedSecret.ID, userID, justification, time.Now().UTC().Add(breakGlassDuration))
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error recording emergency access: %w", err))
+	}
+
+	s.recordRead(ctx, decryptedSecret, userID)
+	log.Printf("BREAK-GLASS: user %d broke the glass on secret %s (emergency access %d): %s", userID, decodedPathStr, access.ID, justification)
+	publishEvent(ctx, s.eventBus, events.SecretBreakGlass, map[string]any{
+		"id":            access.ID,
+		"path":          decodedPathStr,
+		"owner_user_id": decryptedSecret.OwnerUserId,
+		"justification": justification,
+		"expires_at":    access.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z"),
+	})
+	return decryptedSecret, access, nil
 }
 
-func (s *SecretsService) UpdateSecret(ctx context.Context, payload *gensecrets.UpdateSecretPayload) error {
+// grpcurl -plaintext -H metadata:token -d '{"path": "/folder/secret"}' 172.19.32.1:8090 secrets.Secrets/OperatorGetSecretValue
+func (s *SecretsService) OperatorGetSecretValue(ctx context.Context, payload *gensecrets.OperatorGetSecretValuePayload) (*gensecrets.OperatorGetSecretValueResult, error) {
 	// Guaranteed by the Authentified interceptor
 	jwtClaims := ctx.Value("token").(*JwtClaims)
 
-	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	decryptedSecret, err := s.getReadableSecret(ctx, jwtClaims.UserID, payload.Path)
 	if err != nil {
-		return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+		return nil, err
 	}
-	decodedPathStr := string(decodedPath)
 
-	secrets, err := s.secretsRepository.ListSecretsForUser(ctx, jwtClaims.UserID)
+	// Binary values are not valid protobuf strings, they are only returned as raw bytes
+	if decryptedSecret.ContentType != "" {
+		return &gensecrets.OperatorGetSecretValueResult{
+			RawValue:    []byte(decryptedSecret.DecryptedValue),
+			Path:        &decryptedSecret.Path,
+			ContentType: &decryptedSecret.ContentType,
+			Filename:    &decryptedSecret.Filename,
+		}, nil
+	}
+
+	value, fields, err := resolvedFieldValue(s.referenceResolver(ctx, jwtClaims.UserID), decryptedSecret, payload.Field)
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+		return nil, err
 	}
 
-	var secretToUpdate *repository.Secret
-	for i := range secrets {
-		if secrets[i].Path == decodedPathStr {
-			secretToUpdate = &secrets[i]
-			break
-		}
+	result := &gensecrets.OperatorGetSecretValueResult{
+		Value:  value,
+		Fields: fields,
+		Path:   &decryptedSecret.Path,
 	}
-	if secretToUpdate == nil {
-		return gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+	if value != nil {
+		result.RawValue = []byte(*value)
 	}
+	return result, nil
+}
 
-	userRoles, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
-	if secretToUpdate.OwnerUserId != jwtClaims.UserID || !slices.Contains(userRoles, 1) {
-		return gensecrets.MakeForbidden(fmt.Errorf("only the owner or an admin can update the secret"))
+// BatchGetSecretValues resolves the roles of the user once and reads every requested secret in a single query.
+// Errors specific to a secret are returned along with it instead of failing the whole request.
+func (s *SecretsService) BatchGetSecretValues(ctx context.Context, payload *gensecrets.BatchGetSecretValuesPayload) (*gensecrets.BatchGetSecretValuesResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
 	}
 
-	if !slices.Contains(payload.AuthorizedRoles, 1) {
-		return gensecrets.MakeInvalidParameters(fmt.Errorf("admin role (ID 1) must always have access to the secret"))
+	decodedPaths := make([]string, len(payload.Secrets))
+	for i, request := range payload.Secrets {
+		decodedPath, err := base64.StdEncoding.DecodeString(request.Path)
+		if err == nil {
+			decodedPaths[i] = string(decodedPath)
+		}
 	}
 
-	err = s.secretsRepository.UpdateSecret(ctx, s.keyManager, decodedPathStr, payload.Value)
+	secrets, err := s.secretsRepository.GetReadableSecretsByPaths(ctx, s.keyManager, decodedPaths, jwtClaims.UserID, roleIDs)
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error updating secret: %w", err))
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secrets: %w", err))
+	}
+
+	resolver := s.referenceResolver(ctx, jwtClaims.UserID)
+	result := &gensecrets.BatchGetSecretValuesResult{Secrets: make([]*gensecrets.SecretValue, 0, len(payload.Secrets))}
+	for i, request := range payload.Secrets {
+		entry := &gensecrets.SecretValue{Path: request.Path}
+		result.Secrets = append(result.Secrets, entry)
+
+		if decodedPaths[i] == "" {
+			setSecretValueError(entry, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding")))
+			continue
+		}
+		decryptedSecret, ok := secrets[decodedPaths[i]]
+		if !ok {
+			setSecretValueError(entry, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret")))
+			continue
+		}
+		s.recordRead(ctx, decryptedSecret, jwtClaims.UserID)
+
+		// Binary values are not valid protobuf strings, they are only returned as raw bytes
+		if decryptedSecret.ContentType != "" {
+			entry.RawValue = []byte(decryptedSecret.DecryptedValue)
+			entry.ContentType = &decryptedSecret.ContentType
+			entry.Filename = &decryptedSecret.Filename
+			continue
+		}
+
+		value, fields, err := resolvedFieldValue(resolver, decryptedSecret, request.Field)
+		if err != nil {
+			setSecretValueError(entry, err)
+			continue
+		}
+		entry.Value = value
+		entry.Fields = fields
+		if value != nil {
+			entry.RawValue = []byte(*value)
+		}
+	}
+	return result, nil
+}
+
+// RenderTemplate renders the template, reading secrets on behalf of the user. Nothing is returned unless the user
+// can read every secret the template reads.
+func (s *SecretsService) RenderTemplate(ctx context.Context, payload *gensecrets.RenderTemplatePayload) (*gensecrets.RenderTemplateResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	if (payload.Template == nil) == (payload.TemplatePath == nil) {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of template or template_path must be provided"))
+	}
+
+	var document string
+	if payload.Template != nil {
+		document = *payload.Template
+	} else {
+		templateSecret, err := s.getReadableSecret(ctx, jwtClaims.UserID, *payload.TemplatePath)
+		if err != nil {
+			return nil, err
+		}
+		if templateSecret.ContentType != "" || templateSecret.Structured {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s does not hold a template", templateSecret.Path))
+		}
+		document = templateSecret.DecryptedValue
 	}
 
-	authorizedUsersIds, authorizedRolesIds, err := s.secretsAccessRepository.GetAccessesBySecretPath(ctx, decodedPathStr)
+	ctx, cancel := context.WithTimeout(ctx, renderTemplateTimeout)
+	defer cancel()
+	resolver := s.referenceResolver(ctx, jwtClaims.UserID)
+	rendered, err := render.Render(ctx, document, func(secretPath string) (*render.Secret, error) {
+		secret, err := s.getReferencedSecret(ctx, jwtClaims.UserID, secretPath)
+		if err != nil {
+			return nil, err
+		}
+		value, fields, err := resolvedFieldValue(resolver, secret, nil)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			return &render.Secret{Value: *value}, nil
+		}
+		return &render.Secret{Structured: true, Fields: fields}, nil
+	}, maxRenderedTemplateSize)
+	if errors.Is(err, render.ErrInvalidTemplate) {
+		return nil, gensecrets.MakeInvalidParameters(err)
+	}
 	if err != nil {
-		return gensecrets.MakeInternalError(fmt.Errorf("error retrieving current secret accesses: %w", err))
+		return nil, err
 	}
+	return &gensecrets.RenderTemplateResult{Rendered: rendered}, nil
+}
 
-	for _, newUserId := range payload.AuthorizedUsers {
-		found := slices.Contains(authorizedUsersIds, newUserId)
-		if !found {
-			err = s.secretsAccessRepository.GrantUsersAccess(ctx, decodedPathStr, []int{newUserId})
-			if err != nil {
-				return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized user %d: %w", newUserId, err))
-			}
+func setSecretValueError(entry *gensecrets.SecretValue, err error) {
+	name := "internal_error"
+	var serviceErr *goa.ServiceError
+	if errors.As(err, &serviceErr) {
+		name = serviceErr.Name
+	}
+	message := err.Error()
+	entry.Error = &name
+	entry.ErrorMessage = &message
+}
+
+// WatchSecrets streams the events of the watched secrets, starting with a bookmark holding the position the watch
+// starts from. Without resume token the watch starts after the latest event.
+func (s *SecretsService) WatchSecrets(ctx context.Context, payload *gensecrets.WatchSecretsPayload, stream gensecrets.WatchSecretsServerStream) error {
+	// Guaranteed by the gRPC Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	if len(payload.Paths) == 0 && payload.Prefix == nil {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("paths or a prefix must be provided"))
+	}
+
+	var filter repository.SecretEventFilter
+	for _, encodedPath := range payload.Paths {
+		decodedPath, err := base64.StdEncoding.DecodeString(encodedPath)
+		if err != nil {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
 		}
+		filter.Paths = append(filter.Paths, string(decodedPath))
 	}
-	for _, existingUserId := range authorizedUsersIds {
-		found := slices.Contains(payload.AuthorizedUsers, existingUserId)
-		if !found {
-			err = s.secretsAccessRepository.RevokeUserAccess(ctx, decodedPathStr, existingUserId)
-			if err != nil {
-				return gensecrets.MakeInternalError(fmt.Errorf("error removing authorized user %d: %w", existingUserId, err))
-			}
+	if payload.Prefix != nil {
+		decodedPrefix, err := base64.StdEncoding.DecodeString(*payload.Prefix)
+		if err != nil {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid prefix encoding: %w", err))
+		}
+		filter.Prefix = string(decodedPrefix)
+		if len(filter.Prefix) == 0 || filter.Prefix[0] != '/' {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("prefix must start with '/'"))
 		}
 	}
 
-	for _, newRoleId := range payload.AuthorizedRoles {
-		found := slices.Contains(authorizedRolesIds, newRoleId)
-		if !found {
-			err = s.secretsAccessRepository.GrantRolesAccess(ctx, decodedPathStr, []int{newRoleId})
-			if err != nil {
-				return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized role %d: %w", newRoleId, err))
-			}
+	oldest, latest, err := s.secretWatcher.Position(ctx)
+	if err != nil {
+		return gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret events: %w", err))
+	}
+	after := latest
+	if payload.ResumeToken != nil {
+		after, err = strconv.ParseInt(*payload.ResumeToken, 10, 64)
+		if err != nil || after < 0 || after > latest {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid resume token"))
+		}
+		// Pruned events all precede the oldest one left
+		if after < oldest-1 {
+			return gensecrets.MakeResumeTokenExpired(fmt.Errorf("the events following the resume token are no longer available, watch again without a resume token"))
 		}
 	}
-	for _, existingRoleId := range authorizedRolesIds {
-		found := slices.Contains(payload.AuthorizedRoles, existingRoleId)
-		if !found {
-			err = s.secretsAccessRepository.RevokeRoleAccess(ctx, decodedPathStr, existingRoleId)
-			if err != nil {
-				return gensecrets.MakeInternalError(fmt.Errorf("error removing authorized role %d: %w", existingRoleId, err))
-			}
+
+	err = stream.SendWithContext(ctx, &gensecrets.SecretEvent{Type: "bookmark", ResumeToken: strconv.FormatInt(after, 10)})
+	if err != nil {
+		return err
+	}
+
+	roleIDs := func(ctx context.Context) ([]int, error) {
+		return s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	}
+	err = s.secretWatcher.Watch(ctx, after, filter, jwtClaims.UserID, roleIDs, func(event repository.SecretEvent) error {
+		return stream.SendWithContext(ctx, secretEventToResult(event))
+	})
+	if ctx.Err() != nil {
+		// The client went away
+		return nil
+	}
+	if err != nil {
+		return gensecrets.MakeInternalError(fmt.Errorf("error watching secrets: %w", err))
+	}
+	return nil
+}
+
+func secretEventToResult(event repository.SecretEvent) *gensecrets.SecretEvent {
+	occurredAt := event.CreatedAt.Format("2006-01-02T15:04:05Z")
+	return &gensecrets.SecretEvent{
+		Type:        event.Type,
+		Path:        &event.Path,
+		Version:     &event.Version,
+		ResumeToken: strconv.FormatInt(event.ID, 10),
+		OccurredAt:  &occurredAt,
+	}
+}
+
+func (s *SecretsService) UploadSecretFile(ctx context.Context, payload *gensecrets.UploadSecretFilePayload, body io.ReadCloser) error {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+	defer body.Close()
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	if len(decodedPathStr) == 0 || decodedPathStr[0] != '/' {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("path must start with '/'"))
+	}
+
+	filename := path.Base(decodedPathStr)
+	if payload.Filename != nil {
+		filename = *payload.Filename
+	}
+
+	_, err = s.secretsRepository.GetSecretOwner(ctx, decodedPathStr)
+	exists := err == nil
+	if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+		return gensecrets.MakeInternalError(fmt.Errorf("error checking if secret already exists: %w", err))
+	}
+	if exists {
+		capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(capabilities, repository.CapabilityUpdate) {
+			return missingCapability(repository.CapabilityUpdate)
+		}
+
+		decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
+		if err != nil {
+			return gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+		}
+		if decryptedSecret.ContentType == "" {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is not binary", decodedPathStr))
+		}
+	}
+
+	// Read one more byte than allowed to detect oversized uploads without buffering them entirely
+	content, err := io.ReadAll(io.LimitReader(body, s.maxSecretSize+1))
+	if err != nil {
+		return gensecrets.MakeInternalError(fmt.Errorf("error reading file: %w", err))
+	}
+	if err := s.checkSecretSize(len(content)); err != nil {
+		return err
+	}
+
+	if exists {
+		err = s.secretsRepository.UpdateBinarySecret(ctx, s.keyManager, decodedPathStr, content, payload.ContentType, filename)
+		if err != nil {
+			return gensecrets.MakeInternalError(fmt.Errorf("error updating secret: %w", err))
 		}
+		publishEvent(ctx, s.eventBus, events.SecretUpdated, map[string]any{"path": decodedPathStr})
+		return nil
 	}
 
+	adminRole, err := s.rolesRepository.GetRoleByName(ctx, adminRoleName)
+	if err != nil {
+		return gensecrets.MakeInternalError(fmt.Errorf("error retrieving the %s role: %w", adminRoleName, err))
+	}
+	// Uploaded files are only granted to their owner and to the admin role
+	_, err = s.secretsRepository.CreateSecretWithGrants(ctx, s.keyManager, repository.NewSecret{
+		Path:        decodedPathStr,
+		OwnerUserID: jwtClaims.UserID,
+		Value:       string(content),
+		ContentType: payload.ContentType,
+		Filename:    filename,
+		Grants:      []repository.SecretGrant{{RoleID: &adminRole.ID, Capabilities: repository.AllCapabilities}},
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretAlreadyExists) {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret already exists at path: %s", decodedPathStr))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error creating secret: %w", err))
+	}
+
+	publishEvent(ctx, s.eventBus, events.SecretCreated, map[string]any{"path": decodedPathStr})
+	return nil
+}
+
+func (s *SecretsService) DownloadSecretFile(ctx context.Context, payload *gensecrets.DownloadSecretFilePayload) (*gensecrets.DownloadSecretFileResult, io.ReadCloser, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decryptedSecret, err := s.getReadableSecret(ctx, jwtClaims.UserID, payload.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if decryptedSecret.Structured {
+		return nil, nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is structured, read its fields instead", decryptedSecret.Path))
+	}
+
+	contentType := decryptedSecret.ContentType
+	filename := decryptedSecret.Filename
+	if contentType == "" {
+		contentType = "text/plain; charset=utf-8"
+		filename = path.Base(decryptedSecret.Path)
+	}
+
+	contentDisposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+	if contentDisposition == "" {
+		contentDisposition = "attachment"
+	}
+
+	return &gensecrets.DownloadSecretFileResult{
+		ContentType:        contentType,
+		ContentDisposition: contentDisposition,
+		ContentLength:      int64(len(decryptedSecret.DecryptedValue)),
+	}, io.NopCloser(strings.NewReader(decryptedSecret.DecryptedValue)), nil
+}
+
+// getReadableSecret returns the secret after checking the user holds the read capability on it, and records the read
+func (s *SecretsService) getReadableSecret(ctx context.Context, userID int, encodedPath string) (*repository.DecryptedSecret, error) {
+	decodedPath, err := base64.StdEncoding.DecodeString(encodedPath)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	return s.getReadableSecretByPath(ctx, userID, string(decodedPath))
+}
+
+func (s *SecretsService) getReadableSecretByPath(ctx context.Context, userID int, decodedPathStr string) (*repository.DecryptedSecret, error) {
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, userID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	hasAccess, err := s.secretsRepository.HasAccess(ctx, decodedPathStr, &userID, roleIDs, repository.CapabilityRead)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+	}
+	// Breaking the glass gives read access to the secret until the emergency access lapses
+	if !hasAccess {
+		hasAccess, err = s.breakGlassRepository.HasActiveBreakGlassAccess(ctx, decodedPathStr, userID, time.Now().UTC())
+		if err != nil {
+			return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking emergency access to secret: %w", err))
+		}
+	}
+
+	if !hasAccess {
+		return nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+	}
+
+	decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+	}
+
+	s.recordRead(ctx, decryptedSecret, userID)
+	return decryptedSecret, nil
+}
+
+// recordRead counts the read of the value of the secret by the user, and raises an alert when the secret is a canary.
+// Nothing tells the caller the secret is a canary.
+func (s *SecretsService) recordRead(ctx context.Context, secret *repository.DecryptedSecret, userID int) {
+	s.accessStatsRecorder.RecordRead(secret.ID, userID, time.Now())
+	if !secret.Canary {
+		return
+	}
+
+	data := map[string]any{
+		"path":     secret.Path,
+		"priority": "high",
+		"user_id":  userID,
+	}
+	if jwtClaims, ok := ctx.Value("token").(*JwtClaims); ok {
+		data["username"] = jwtClaims.Username
+		data["token_id"] = jwtClaims.ID
+	}
+	if remoteAddr, ok := ctx.Value("remote_addr").(string); ok {
+		data["remote_addr"] = remoteAddr
+	}
+	if method, ok := ctx.Value(goa.MethodKey).(string); ok {
+		data["method"] = method
+	}
+	log.Printf("CANARY: secret %s was read by user %d (token %v, from %v, via %v)", secret.Path, userID, data["token_id"], data["remote_addr"], data["method"])
+	publishEvent(ctx, s.eventBus, events.SecretCanaryAccessed, data)
+}
+
+// referenceResolver resolves the references to other secrets, which the user must be able to read. Reading a
+// referenced secret is recorded like any other read. Resolved values are limited to the maximum size of a secret.
+func (s *SecretsService) referenceResolver(ctx context.Context, userID int) *reference.Resolver {
+	return reference.NewResolver(func(ref reference.Reference) (string, error) {
+		referenced, err := s.getReferencedSecret(ctx, userID, ref.Path)
+		if err != nil {
+			return "", err
+		}
+		if referenced.Structured && ref.Field == "" {
+			return "", gensecrets.MakeInvalidParameters(fmt.Errorf("referenced secret at path %s is structured, reference one of its fields as ${ref:%s#field}", ref.Path, ref.Path))
+		}
+
+		var field *string
+		if ref.Field != "" {
+			field = &ref.Field
+		}
+		value, _, err := secretFieldValue(referenced, field)
+		if err != nil {
+			return "", err
+		}
+		return *value, nil
+	}, int(s.maxSecretSize))
+}
+
+// getReferencedSecret returns the text secret at the path, read on behalf of the user by a reference or a template
+func (s *SecretsService) getReferencedSecret(ctx context.Context, userID int, secretPath string) (*repository.DecryptedSecret, error) {
+	referenced, err := s.getReadableSecretByPath(ctx, userID, secretPath)
+	var serviceErr *goa.ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.Name == "forbidden" {
+		return nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to the referenced secret %s", secretPath))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if referenced.ContentType != "" {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("referenced secret at path %s is binary", secretPath))
+	}
+	return referenced, nil
+}
+
+// resolvedFieldValue is secretFieldValue with the references held by the value, or the fields, resolved
+func resolvedFieldValue(resolver *reference.Resolver, secret *repository.DecryptedSecret, field *string) (*string, map[string]string, error) {
+	value, fields, err := secretFieldValue(secret, field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if value != nil {
+		origin := reference.Reference{Path: secret.Path}
+		if field != nil {
+			origin.Field = *field
+		}
+		resolved, err := resolveReferences(resolver, origin, *value)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &resolved, nil, nil
+	}
+
+	resolvedFields := make(map[string]string, len(fields))
+	for name, fieldValue := range fields {
+		resolved, err := resolveReferences(resolver, reference.Reference{Path: secret.Path, Field: name}, fieldValue)
+		if err != nil {
+			return nil, nil, err
+		}
+		resolvedFields[name] = resolved
+	}
+	return nil, resolvedFields, nil
+}
+
+func resolveReferences(resolver *reference.Resolver, origin reference.Reference, value string) (string, error) {
+	if !reference.Contains(value) {
+		return value, nil
+	}
+	resolved, err := resolver.Resolve(origin, value)
+	if errors.Is(err, reference.ErrCycle) || errors.Is(err, reference.ErrTooDeep) || errors.Is(err, reference.ErrTooLarge) {
+		return "", gensecrets.MakeInvalidParameters(err)
+	}
+	return resolved, err
+}
+
+// validateSecretValue checks the value, or the fields, of the secret at the path against the validation rules of its
+// folders
+func (s *SecretsService) validateSecretValue(ctx context.Context, secretPath string, value *string, fields map[string]string) error {
+	return checkValidationRules(ctx, s.validationRulesRepository, secretPath, value, fields)
+}
+
+// checkValidationRules checks the value, or the fields, of the secret at the path against the validation rules of
+// its folders
+func checkValidationRules(ctx context.Context, validationRulesRepository repository.ValidationRulesRepository, secretPath string, value *string, fields map[string]string) error {
+	rules, err := validationRulesRepository.ListValidationRulesForPath(ctx, secretPath)
+	if err != nil {
+		return gensecrets.MakeInternalError(fmt.Errorf("error retrieving validation rules: %w", err))
+	}
+
+	for _, rule := range rules {
+		validator, err := validation.Compile(rule.Kind, rule.Parameter)
+		if err != nil {
+			return gensecrets.MakeInternalError(fmt.Errorf("error compiling validation rule %d: %w", rule.ID, err))
+		}
+		if value != nil && rule.Field == nil {
+			if err := validator(*value); err != nil {
+				return gensecrets.MakeInvalidParameters(fmt.Errorf("value %v (validation rule %d on %s)", err, rule.ID, rule.PathPrefix))
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(fields)) {
+			if rule.Field != nil && *rule.Field != name {
+				continue
+			}
+			if err := validator(fields[name]); err != nil {
+				return gensecrets.MakeInvalidParameters(fmt.Errorf("field %s %v (validation rule %d on %s)", name, err, rule.ID, rule.PathPrefix))
+			}
+		}
+	}
+	return nil
+}
+
+// secretFieldValue returns the value of a secret, or the fields of a structured secret when no field is requested
+func secretFieldValue(secret *repository.DecryptedSecret, field *string) (*string, map[string]string, error) {
+	if !secret.Structured {
+		if field != nil {
+			return nil, nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is not structured", secret.Path))
+		}
+		return &secret.DecryptedValue, nil, nil
+	}
+
+	if field == nil {
+		return nil, secret.Fields, nil
+	}
+	value, ok := secret.Fields[*field]
+	if !ok {
+		return nil, nil, gensecrets.MakeFieldNotFound(fmt.Errorf("field %s not found in secret at path: %s", *field, secret.Path))
+	}
+	return &value, nil, nil
+}
+
+func (s *SecretsService) UpdateSecret(ctx context.Context, payload *gensecrets.UpdateSecretPayload) error {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+	if err != nil {
+		return err
+	}
+
+	updateFields := payload.Fields != nil || payload.RemoveFields != nil
+	if (payload.Value != nil || updateFields) && !slices.Contains(capabilities, repository.CapabilityUpdate) {
+		return missingCapability(repository.CapabilityUpdate)
+	}
+
+	if payload.Value != nil || updateFields {
+		if payload.Value != nil && updateFields {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("value and fields cannot be updated at the same time"))
+		}
+		if err := validateFieldNames(payload.Fields); err != nil {
+			return err
+		}
+
+		decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
+		if err != nil {
+			if errors.Is(err, repository.ErrSecretNotFound) {
+				return gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+			}
+			return gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+		}
+		if decryptedSecret.ContentType != "" {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is binary, upload its content instead", decodedPathStr))
+		}
+		if decryptedSecret.Structured && payload.Value != nil {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is structured, update its fields instead", decodedPathStr))
+		}
+		if !decryptedSecret.Structured && updateFields {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is not structured, update its value instead", decodedPathStr))
+		}
+		for _, name := range payload.RemoveFields {
+			if _, ok := decryptedSecret.Fields[name]; !ok {
+				return gensecrets.MakeFieldNotFound(fmt.Errorf("field %s not found in secret at path: %s", name, decodedPathStr))
+			}
+		}
+
+		if payload.Value != nil {
+			err = s.checkSecretSize(len(*payload.Value))
+		} else {
+			fields := maps.Clone(decryptedSecret.Fields)
+			maps.Copy(fields, payload.Fields)
+			for _, name := range payload.RemoveFields {
+				delete(fields, name)
+			}
+			err = s.checkSecretSize(fieldsSize(fields))
+		}
+		if err != nil {
+			return err
+		}
+		// Only the new values are validated, the fields left untouched may predate the rules
+		if err := s.validateSecretValue(ctx, decodedPathStr, payload.Value, payload.Fields); err != nil {
+			return err
+		}
+	}
+
+	// Grants are left untouched when none are provided
+	updateGrants := payload.AuthorizedUsers != nil || payload.AuthorizedRoles != nil || payload.UserGrants != nil || payload.RoleGrants != nil
+	currentUsers := make(map[int]secretGrant)
+	currentRoles := make(map[int]secretGrant)
+	newUsers, err := userGrantsFromPayload(payload.AuthorizedUsers, payload.UserGrants)
+	if err != nil {
+		return err
+	}
+	newRoles, err := roleGrantsFromPayload(payload.AuthorizedRoles, payload.RoleGrants)
+	if err != nil {
+		return err
+	}
+	grantsChanged := false
+	if updateGrants {
+		if _, ok := newRoles[1]; !ok {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("admin role (ID 1) must always have access to the secret"))
+		}
+
+		grants, err := s.secretsAccessRepository.GetGrantsBySecretPath(ctx, decodedPathStr)
+		if err != nil {
+			return gensecrets.MakeInternalError(fmt.Errorf("error retrieving current secret accesses: %w", err))
+		}
+		for _, grant := range grants {
+			if grant.UserID != nil {
+				currentUsers[*grant.UserID] = secretGrant{capabilities: grant.Capabilities, expiresAt: grant.ExpiresAt}
+			}
+			if grant.RoleID != nil {
+				currentRoles[*grant.RoleID] = secretGrant{capabilities: grant.Capabilities, expiresAt: grant.ExpiresAt}
+			}
+		}
+
+		grantsChanged = !maps.EqualFunc(currentUsers, newUsers, secretGrant.equal) || !maps.EqualFunc(currentRoles, newRoles, secretGrant.equal)
+		if grantsChanged && !slices.Contains(capabilities, repository.CapabilityManageAccess) {
+			return missingCapability(repository.CapabilityManageAccess)
+		}
+	}
+
+	if payload.Value != nil {
+		err = s.secretsRepository.UpdateSecret(ctx, s.keyManager, decodedPathStr, *payload.Value)
+		if err != nil {
+			return gensecrets.MakeInternalError(fmt.Errorf("error updating secret: %w", err))
+		}
+	}
+
+	if updateFields {
+		err = s.secretsRepository.SetSecretFields(ctx, s.keyManager, decodedPathStr, payload.Fields, payload.RemoveFields)
+		if err != nil {
+			if errors.Is(err, repository.ErrSecretFieldNotFound) {
+				return gensecrets.MakeFieldNotFound(fmt.Errorf("error updating secret fields: %w", err))
+			}
+			return gensecrets.MakeInternalError(fmt.Errorf("error updating secret fields: %w", err))
+		}
+	}
+
+	if payload.Value != nil || updateFields {
+		publishEvent(ctx, s.eventBus, events.SecretUpdated, map[string]any{"path": decodedPathStr})
+	}
+
+	if !grantsChanged {
+		return nil
+	}
+
+	for userID, newGrant := range newUsers {
+		if !currentUsers[userID].equal(newGrant) {
+			err = s.secretsAccessRepository.SetUserCapabilities(ctx, decodedPathStr, userID, newGrant.capabilities, newGrant.expiresAt)
+			if err != nil {
+				return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized user %d: %w", userID, err))
+			}
+		}
+	}
+	for userID := range currentUsers {
+		if _, ok := newUsers[userID]; !ok {
+			err = s.secretsAccessRepository.RevokeUserAccess(ctx, decodedPathStr, userID)
+			if err != nil {
+				return gensecrets.MakeInternalError(fmt.Errorf("error removing authorized user %d: %w", userID, err))
+			}
+		}
+	}
+
+	for roleID, newGrant := range newRoles {
+		if !currentRoles[roleID].equal(newGrant) {
+			err = s.secretsAccessRepository.SetRoleCapabilities(ctx, decodedPathStr, roleID, newGrant.capabilities, newGrant.expiresAt)
+			if err != nil {
+				return gensecrets.MakeInternalError(fmt.Errorf("error adding authorized role %d: %w", roleID, err))
+			}
+		}
+	}
+	for roleID := range currentRoles {
+		if _, ok := newRoles[roleID]; !ok {
+			err = s.secretsAccessRepository.RevokeRoleAccess(ctx, decodedPathStr, roleID)
+			if err != nil {
+				return gensecrets.MakeInternalError(fmt.Errorf("error removing authorized role %d: %w", roleID, err))
+			}
+		}
+	}
+
+	publishEvent(ctx, s.eventBus, events.SecretAccessChanged, map[string]any{"path": decodedPathStr})
+	return nil
+}
+
+func (s *SecretsService) RegenerateSecret(ctx context.Context, payload *gensecrets.RegenerateSecretPayload) (*gensecrets.RegenerateSecretResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(capabilities, repository.CapabilityUpdate) {
+		return nil, missingCapability(repository.CapabilityUpdate)
+	}
+
+	decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+	}
+	if decryptedSecret.ContentType != "" {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is binary and cannot be regenerated", decodedPathStr))
+	}
+
+	var policyName string
+	if payload.GenerationPolicy != nil {
+		policyName = *payload.GenerationPolicy
+	} else {
+		if decryptedSecret.GenerationPolicyID == nil {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s was not generated, a generation policy must be provided", decodedPathStr))
+		}
+		policy, err := s.generationPoliciesRepository.GetGenerationPolicyByID(ctx, *decryptedSecret.GenerationPolicyID)
+		if err != nil {
+			return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving generation policy: %w", err))
+		}
+		policyName = policy.Name
+	}
+
+	policy, generated, err := s.generate(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.secretsRepository.RegenerateSecret(ctx, s.keyManager, decodedPathStr, generated.Value, generated.Fields, policy.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error regenerating secret: %w", err))
+	}
+
+	publishEvent(ctx, s.eventBus, events.SecretRotated, map[string]any{"path": decodedPathStr, "version": version})
+	return &gensecrets.RegenerateSecretResult{Version: version}, nil
+}
+
+func (s *SecretsService) SetSecretRotation(ctx context.Context, payload *gensecrets.SetSecretRotationPayload) (*gensecrets.SetSecretRotationResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(capabilities, repository.CapabilityUpdate) {
+		return nil, missingCapability(repository.CapabilityUpdate)
+	}
+
+	decryptedSecret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, decodedPathStr)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+	}
+
+	result := &gensecrets.SetSecretRotationResult{}
+	var nextRotationAt *time.Time
+	if payload.Schedule != nil {
+		if decryptedSecret.GenerationPolicyID == nil {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s was not generated and cannot be rotated", decodedPathStr))
+		}
+		rotationSchedule, err := schedule.Parse(*payload.Schedule)
+		if err != nil {
+			return nil, gensecrets.MakeInvalidParameters(err)
+		}
+		next := rotationSchedule.Next(time.Now().UTC())
+		nextRotationAt = &next
+		formatted := next.Format("2006-01-02T15:04:05Z")
+		result.NextRotationAt = &formatted
+	}
+
+	err = s.secretsRepository.SetRotationSchedule(ctx, decodedPathStr, payload.Schedule, nextRotationAt)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error setting rotation schedule: %w", err))
+	}
+	return result, nil
+}
+
+func (s *SecretsService) ListSecretRotations(ctx context.Context, payload *gensecrets.ListSecretRotationsPayload) ([]*gensecrets.SecretRotation, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(capabilities, repository.CapabilityList) {
+		return nil, missingCapability(repository.CapabilityList)
+	}
+
+	rotations, err := s.secretsRepository.ListSecretRotations(ctx, decodedPathStr, payload.Limit)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing rotations: %w", err))
+	}
+
+	result := make([]*gensecrets.SecretRotation, 0, len(rotations))
+	for _, rotation := range rotations {
+		item := &gensecrets.SecretRotation{
+			Version:   rotation.Version,
+			Scheduled: rotation.Scheduled,
+			RotatedAt: rotation.RotatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+		if rotation.Error != "" {
+			item.Error = &rotation.Error
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// generate generates a value from the named generation policy
+func (s *SecretsService) generate(ctx context.Context, policyName string) (*repository.GenerationPolicy, *generator.Generated, error) {
+	policy, err := s.generationPoliciesRepository.GetGenerationPolicyByName(ctx, policyName)
+	if err != nil {
+		if errors.Is(err, repository.ErrGenerationPolicyNotFound) {
+			return nil, nil, gensecrets.MakeInvalidParameters(fmt.Errorf("generation policy %s does not exist", policyName))
+		}
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving generation policy: %w", err))
+	}
+
+	generated, err := generator.Generate(policy.Spec)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error generating secret value: %w", err))
+	}
+	size := len(generated.Value)
+	if generated.Fields != nil {
+		size = fieldsSize(generated.Fields)
+	}
+	if err := s.checkSecretSize(size); err != nil {
+		return nil, nil, err
+	}
+	return policy, generated, nil
+}
+
+func (s *SecretsService) DeleteSecret(ctx context.Context, payload *gensecrets.DeleteSecretPayload) error {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	capabilities, err := s.secretCapabilities(ctx, jwtClaims.UserID, decodedPathStr)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(capabilities, repository.CapabilityDelete) {
+		return missingCapability(repository.CapabilityDelete)
+	}
+
+	err = s.secretsRepository.DeleteSecret(ctx, decodedPathStr)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error deleting secret: %w", err))
+	}
+
+	publishEvent(ctx, s.eventBus, events.SecretDeleted, map[string]any{"path": decodedPathStr})
+	return nil
+}
+
+func (s *SecretsService) MoveSecrets(ctx context.Context, payload *gensecrets.MoveSecretsPayload) (int, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedSource, err := base64.StdEncoding.DecodeString(payload.Source)
+	if err != nil {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid source encoding: %w", err))
+	}
+	source := string(decodedSource)
+	decodedDestination, err := base64.StdEncoding.DecodeString(payload.Destination)
+	if err != nil {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid destination encoding: %w", err))
+	}
+	destination := string(decodedDestination)
+
+	if len(source) == 0 || source[0] != '/' || len(destination) == 0 || destination[0] != '/' {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("source and destination must start with '/'"))
+	}
+	folder := strings.HasSuffix(source, "/")
+	if folder != strings.HasSuffix(destination, "/") {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("source and destination must both be folders or both be secrets"))
+	}
+	if source == destination {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("source and destination must be different"))
+	}
+
+	paths := []string{source}
+	if folder {
+		paths, err = s.secretsRepository.GetSecretPathsByPrefix(ctx, source)
+		if err != nil {
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+		}
+		if len(paths) == 0 {
+			return 0, gensecrets.MakeSecretNotFound(fmt.Errorf("no secret found under folder: %s", source))
+		}
+	}
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	moves := make([]repository.SecretMove, len(paths))
+	for i, secretPath := range paths {
+		moves[i] = repository.SecretMove{From: secretPath, To: destination + strings.TrimPrefix(secretPath, source)}
+		if len(moves[i].To) > maxSecretPathLength {
+			return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("destination path is longer than %d characters: %s", maxSecretPathLength, moves[i].To))
+		}
+
+		capabilities, err := s.secretsRepository.GetCapabilities(ctx, secretPath, jwtClaims.UserID, roleIDs)
+		if err != nil {
+			if errors.Is(err, repository.ErrSecretNotFound) {
+				return 0, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+		}
+		for _, capability := range []string{repository.CapabilityUpdate, repository.CapabilityDelete} {
+			if !slices.Contains(capabilities, capability) {
+				return 0, gensecrets.MakeForbidden(fmt.Errorf("you do not have the %s capability on the secret %s", capability, secretPath))
+			}
+		}
+
+		// Moved secrets must pass the validation rules of their destination
+		secret, err := s.secretsRepository.GetSecretByPath(ctx, s.keyManager, secretPath)
+		if err != nil {
+			if errors.Is(err, repository.ErrSecretNotFound) {
+				return 0, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", secretPath))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secret: %w", err))
+		}
+		if secret.ContentType == "" {
+			if secret.Structured {
+				err = s.validateSecretValue(ctx, moves[i].To, nil, secret.Fields)
+			} else {
+				err = s.validateSecretValue(ctx, moves[i].To, &secret.DecryptedValue, nil)
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	for _, move := range moves {
+		// Secrets of a folder moved into itself are moved out of the way before being overwritten
+		if folder && strings.HasPrefix(move.To, source) {
+			continue
+		}
+		capabilities, err := s.secretsRepository.GetCapabilities(ctx, move.To, jwtClaims.UserID, roleIDs)
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+		}
+		if !payload.Overwrite {
+			return 0, gensecrets.MakeSecretAlreadyExists(fmt.Errorf("secret already exists at path: %s", move.To))
+		}
+		if !slices.Contains(capabilities, repository.CapabilityDelete) {
+			return 0, gensecrets.MakeForbidden(fmt.Errorf("you do not have the %s capability on the secret %s", repository.CapabilityDelete, move.To))
+		}
+	}
+
+	// The secrets of a folder are listed and checked above, the repository makes sure they are still all of them
+	sourceFolder := ""
+	if folder {
+		sourceFolder = source
+	}
+	err = s.secretsRepository.MoveSecrets(ctx, moves, sourceFolder, payload.Overwrite)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return 0, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", source))
+		}
+		if errors.Is(err, repository.ErrFolderChanged) {
+			return 0, gensecrets.MakeFolderChanged(fmt.Errorf("secrets were added under %s during the move, retry it", source))
+		}
+		if errors.Is(err, repository.ErrSecretAlreadyExists) {
+			return 0, gensecrets.MakeSecretAlreadyExists(err)
+		}
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error moving secrets: %w", err))
+	}
+
+	for _, move := range moves {
+		publishEvent(ctx, s.eventBus, events.SecretMoved, map[string]any{"path": move.To, "from": move.From})
+	}
+	return len(moves), nil
+}
+
+// Statuses of the secrets compared by a diff
+const (
+	secretDiffMissing      = "missing"
+	secretDiffExtra        = "extra"
+	secretDiffDifferent    = "different"
+	secretDiffIdentical    = "identical"
+	secretDiffInaccessible = "inaccessible"
+)
+
+// secretComparison compares a secret of the source folder to the secret at the same key of the destination folder.
+// Either of them is nil when it does not exist or the user cannot read it.
+type secretComparison struct {
+	key         string
+	source      *repository.DecryptedSecret
+	destination *repository.DecryptedSecret
+	status      string
+}
+
+// decodeFolders returns the source and destination folders of a diff or a promotion
+func decodeFolders(encodedSource string, encodedDestination string) (string, string, error) {
+	folders := make([]string, 2)
+	for i, encoded := range []string{encodedSource, encodedDestination} {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", "", gensecrets.MakeInvalidParameters(fmt.Errorf("invalid folder encoding: %w", err))
+		}
+		folder := string(decoded)
+		if len(folder) == 0 || folder[0] != '/' {
+			return "", "", gensecrets.MakeInvalidParameters(fmt.Errorf("source and destination must start with '/'"))
+		}
+		if !strings.HasSuffix(folder, "/") {
+			folder += "/"
+		}
+		folders[i] = folder
+	}
+	if strings.HasPrefix(folders[0], folders[1]) || strings.HasPrefix(folders[1], folders[0]) {
+		return "", "", gensecrets.MakeInvalidParameters(fmt.Errorf("source and destination must not contain each other"))
+	}
+	return folders[0], folders[1], nil
+}
+
+// compareFolders compares the secrets under the source and destination folders by the hashes of their values, sorted
+// by key. Keys with no secret the user can read are left out.
+func (s *SecretsService) compareFolders(ctx context.Context, userID int, roleIDs []int, source string, destination string) ([]*secretComparison, error) {
+	sourcePaths, err := s.secretsRepository.GetSecretPathsByPrefix(ctx, source)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+	}
+	destinationPaths, err := s.secretsRepository.GetSecretPathsByPrefix(ctx, destination)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+	}
+	readable, err := s.secretsRepository.GetReadableSecretsByPaths(ctx, s.keyManager, slices.Concat(sourcePaths, destinationPaths), userID, roleIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secrets: %w", err))
+	}
+
+	sourceKeys := make(map[string]bool, len(sourcePaths))
+	for _, secretPath := range sourcePaths {
+		sourceKeys[strings.TrimPrefix(secretPath, source)] = true
+	}
+	destinationKeys := make(map[string]bool, len(destinationPaths))
+	for _, secretPath := range destinationPaths {
+		destinationKeys[strings.TrimPrefix(secretPath, destination)] = true
+	}
+
+	var comparisons []*secretComparison
+	for _, key := range slices.Sorted(maps.Keys(sourceKeys)) {
+		comparison := &secretComparison{key: key, source: readable[source+key], destination: readable[destination+key]}
+		switch {
+		case comparison.source == nil && comparison.destination == nil:
+			continue
+		case comparison.source == nil || (comparison.destination == nil && destinationKeys[key]):
+			comparison.status = secretDiffInaccessible
+		case comparison.destination == nil:
+			comparison.status = secretDiffMissing
+		case secretHash(comparison.source) == secretHash(comparison.destination):
+			comparison.status = secretDiffIdentical
+		default:
+			comparison.status = secretDiffDifferent
+		}
+		comparisons = append(comparisons, comparison)
+	}
+	for key := range destinationKeys {
+		if !sourceKeys[key] && readable[destination+key] != nil {
+			comparisons = append(comparisons, &secretComparison{key: key, destination: readable[destination+key], status: secretDiffExtra})
+		}
+	}
+	slices.SortFunc(comparisons, func(a, b *secretComparison) int {
+		return strings.Compare(a.key, b.key)
+	})
+	return comparisons, nil
+}
+
+// secretHash is the hash secrets are compared by, which tells apart the kinds of secrets
+func secretHash(secret *repository.DecryptedSecret) [sha256.Size]byte {
+	switch {
+	case secret.ContentType != "":
+		return sha256.Sum256([]byte("binary\x00" + secret.ContentType + "\x00" + secret.DecryptedValue))
+	case secret.Structured:
+		// Maps are encoded with sorted keys
+		fields, _ := json.Marshal(secret.Fields)
+		return sha256.Sum256(append([]byte("structured\x00"), fields...))
+	default:
+		return sha256.Sum256([]byte("value\x00" + secret.DecryptedValue))
+	}
+}
+
+func (s *SecretsService) DiffSecrets(ctx context.Context, payload *gensecrets.DiffSecretsPayload) ([]*gensecrets.SecretDiff, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	source, destination, err := decodeFolders(payload.Source, payload.Destination)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	comparisons, err := s.compareFolders(ctx, jwtClaims.UserID, roleIDs, source, destination)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*gensecrets.SecretDiff, 0, len(comparisons))
+	for _, comparison := range comparisons {
+		result = append(result, &gensecrets.SecretDiff{Key: comparison.key, Status: comparison.status})
+	}
+	return result, nil
+}
+
+// PromoteSecrets copies the selected secrets of the source folder which are missing or different in the destination
+// folder, all of them or none
+func (s *SecretsService) PromoteSecrets(ctx context.Context, payload *gensecrets.PromoteSecretsPayload) (int, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	source, destination, err := decodeFolders(payload.Source, payload.Destination)
+	if err != nil {
+		return 0, err
+	}
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	comparisons, err := s.compareFolders(ctx, jwtClaims.UserID, roleIDs, source, destination)
+	if err != nil {
+		return 0, err
+	}
+	comparisonsByKey := make(map[string]*secretComparison, len(comparisons))
+	for _, comparison := range comparisons {
+		comparisonsByKey[comparison.key] = comparison
+	}
+
+	var promotions []repository.SecretPromotion
+	promoted := make(map[string]bool, len(payload.Keys))
+	for _, key := range payload.Keys {
+		if promoted[key] {
+			continue
+		}
+		promoted[key] = true
+
+		comparison, ok := comparisonsByKey[key]
+		if !ok || comparison.source == nil {
+			return 0, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to the secret %s", source+key))
+		}
+		destinationPath := destination + key
+		if len(destinationPath) > maxSecretPathLength {
+			return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("destination path is longer than %d characters: %s", maxSecretPathLength, destinationPath))
+		}
+
+		switch comparison.status {
+		case secretDiffIdentical:
+			continue
+		case secretDiffDifferent, secretDiffInaccessible:
+			if !payload.Overwrite {
+				return 0, gensecrets.MakeSecretAlreadyExists(fmt.Errorf("secret already exists at path: %s", destinationPath))
+			}
+			capabilities, err := s.secretsRepository.GetCapabilities(ctx, destinationPath, jwtClaims.UserID, roleIDs)
+			if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+				return 0, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+			}
+			if !slices.Contains(capabilities, repository.CapabilityUpdate) {
+				return 0, gensecrets.MakeForbidden(fmt.Errorf("you do not have the %s capability on the secret %s", repository.CapabilityUpdate, destinationPath))
+			}
+		}
+
+		if comparison.source.ContentType == "" {
+			if comparison.source.Structured {
+				err = s.validateSecretValue(ctx, destinationPath, nil, comparison.source.Fields)
+			} else {
+				err = s.validateSecretValue(ctx, destinationPath, &comparison.source.DecryptedValue, nil)
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		promotions = append(promotions, repository.SecretPromotion{Source: comparison.source, To: destinationPath})
+	}
+	if len(promotions) == 0 {
+		return 0, nil
+	}
+
+	created, err := s.secretsRepository.PromoteSecrets(ctx, s.keyManager, promotions, payload.Overwrite)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretAlreadyExists) {
+			return 0, gensecrets.MakeSecretAlreadyExists(err)
+		}
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return 0, gensecrets.MakeSecretNotFound(err)
+		}
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error promoting secrets: %w", err))
+	}
+
+	for _, promotion := range promotions {
+		s.recordRead(ctx, promotion.Source, jwtClaims.UserID)
+		eventType := events.SecretUpdated
+		if slices.Contains(created, promotion.To) {
+			eventType = events.SecretCreated
+		}
+		publishEvent(ctx, s.eventBus, eventType, map[string]any{"path": promotion.To, "promoted_from": promotion.Source.Path})
+	}
+	return len(promotions), nil
+}
+
+// decodeFolder returns the folder of an export or an import
+func decodeFolder(encodedPrefix string) (string, error) {
+	decodedPrefix, err := base64.StdEncoding.DecodeString(encodedPrefix)
+	if err != nil {
+		return "", gensecrets.MakeInvalidParameters(fmt.Errorf("invalid prefix encoding: %w", err))
+	}
+	prefix := string(decodedPrefix)
+	if len(prefix) == 0 || prefix[0] != '/' {
+		return "", gensecrets.MakeInvalidParameters(fmt.Errorf("prefix must start with '/'"))
+	}
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return prefix, nil
+}
+
+// fileKeyNaming returns the key naming of an export or an import, env for dotenv files and path otherwise by default
+func fileKeyNaming(format string, keyNaming *string) string {
+	if keyNaming != nil {
+		return *keyNaming
+	}
+	if format == secretfile.FormatDotenv {
+		return secretfile.KeyNamingEnv
+	}
+	return secretfile.KeyNamingPath
+}
+
+// ExportSecrets exports the text secrets the user can read under the folder, and records their reads
+func (s *SecretsService) ExportSecrets(ctx context.Context, payload *gensecrets.ExportSecretsPayload) (*gensecrets.ExportSecretsResult, io.ReadCloser, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	prefix, err := decodeFolder(payload.Prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyNaming := fileKeyNaming(payload.Format, payload.KeyNaming)
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+	paths, err := s.secretsRepository.GetSecretPathsByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error listing secrets: %w", err))
+	}
+	secrets, err := s.secretsRepository.GetReadableSecretsByPaths(ctx, s.keyManager, paths, jwtClaims.UserID, roleIDs)
+	if err != nil {
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving secrets: %w", err))
+	}
+
+	var entries []secretfile.Entry
+	exportedPaths := make(map[string]string)
+	addEntry := func(entry secretfile.Entry, secretPath string) error {
+		if otherPath, ok := exportedPaths[entry.Key]; ok {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("secrets %s and %s are both exported as %s, choose another key naming", otherPath, secretPath, entry.Key))
+		}
+		exportedPaths[entry.Key] = secretPath
+		entries = append(entries, entry)
+		return nil
+	}
+
+	resolver := s.referenceResolver(ctx, jwtClaims.UserID)
+	var exported []*repository.DecryptedSecret
+	for _, secretPath := range paths {
+		secret, ok := secrets[secretPath]
+		if !ok || secret.ContentType != "" {
+			continue
+		}
+		var value *string
+		var fields map[string]string
+		if payload.Raw {
+			value, fields, err = secretFieldValue(secret, nil)
+		} else {
+			value, fields, err = resolvedFieldValue(resolver, secret, nil)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		relativePath := strings.TrimPrefix(secretPath, prefix)
+		switch {
+		case value != nil:
+			err = addEntry(secretfile.Entry{Key: secretfile.ExportKey(keyNaming, relativePath, ""), Value: *value}, secretPath)
+		case payload.Format == secretfile.FormatDotenv:
+			for _, name := range slices.Sorted(maps.Keys(fields)) {
+				err = addEntry(secretfile.Entry{Key: secretfile.ExportKey(keyNaming, relativePath, name), Value: fields[name]}, secretPath)
+				if err != nil {
+					break
+				}
+			}
+		default:
+			err = addEntry(secretfile.Entry{Key: secretfile.ExportKey(keyNaming, relativePath, ""), Fields: fields}, secretPath)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		exported = append(exported, secret)
+	}
+
+	content, err := secretfile.Encode(payload.Format, entries)
+	if err != nil {
+		if errors.Is(err, secretfile.ErrInvalidFile) {
+			return nil, nil, gensecrets.MakeInvalidParameters(err)
+		}
+		return nil, nil, gensecrets.MakeInternalError(fmt.Errorf("error encoding secrets: %w", err))
+	}
+	for _, secret := range exported {
+		s.recordRead(ctx, secret, jwtClaims.UserID)
+	}
+
+	filename := "secrets"
+	if prefix != "/" {
+		filename = path.Base(prefix)
+	}
+	contentDisposition := mime.FormatMediaType("attachment", map[string]string{"filename": filename + secretfile.Extension(payload.Format)})
+	if contentDisposition == "" {
+		contentDisposition = "attachment"
+	}
+
+	return &gensecrets.ExportSecretsResult{
+		ContentType:        secretfile.ContentType(payload.Format),
+		ContentDisposition: contentDisposition,
+		ContentLength:      int64(len(content)),
+	}, io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// Actions of an import on the imported secrets
+const (
+	importCreate    = "create"
+	importOverwrite = "overwrite"
+	importSkip      = "skip"
+)
+
+// ImportSecrets imports the secrets of the file under the folder, all of them or none
+func (s *SecretsService) ImportSecrets(ctx context.Context, payload *gensecrets.ImportSecretsPayload, body io.ReadCloser) ([]*gensecrets.ImportedSecret, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+	defer body.Close()
+
+	prefix, err := decodeFolder(payload.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	keyNaming := fileKeyNaming(payload.Format, payload.KeyNaming)
+
+	// Read one more byte than allowed to detect oversized files without buffering them entirely
+	content, err := io.ReadAll(io.LimitReader(body, maxImportFileSize+1))
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error reading file: %w", err))
+	}
+	if len(content) > maxImportFileSize {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("the file is larger than %d bytes", maxImportFileSize))
+	}
+	entries, err := secretfile.Decode(payload.Format, content)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(err)
+	}
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	result := make([]*gensecrets.ImportedSecret, 0, len(entries))
+	var imports []repository.SecretImport
+	importedKeys := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		relativePath, err := secretfile.ImportPath(keyNaming, entry.Key)
+		if err != nil {
+			return nil, gensecrets.MakeInvalidParameters(err)
+		}
+		secretPath := prefix + relativePath
+		if otherKey, ok := importedKeys[secretPath]; ok {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("keys %s and %s are both imported to %s", otherKey, entry.Key, secretPath))
+		}
+		importedKeys[secretPath] = entry.Key
+		if len(secretPath) > maxSecretPathLength {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("path is longer than %d characters: %s", maxSecretPathLength, secretPath))
+		}
+
+		action := importCreate
+		capabilities, err := s.secretsRepository.GetCapabilities(ctx, secretPath, jwtClaims.UserID, roleIDs)
+		if err == nil {
+			switch payload.OnExisting {
+			case importSkip:
+				action = importSkip
+			case importOverwrite:
+				if !slices.Contains(capabilities, repository.CapabilityUpdate) {
+					return nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have the %s capability on the secret %s", repository.CapabilityUpdate, secretPath))
+				}
+				action = importOverwrite
+			default:
+				return nil, gensecrets.MakeSecretAlreadyExists(fmt.Errorf("secret already exists at path: %s", secretPath))
+			}
+		} else if !errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+		}
+		result = append(result, &gensecrets.ImportedSecret{Key: entry.Key, Path: secretPath, Action: action})
+		if action == importSkip {
+			continue
+		}
+
+		if entry.Fields != nil {
+			if len(entry.Fields) == 0 {
+				return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("structured secret %s must have at least one field", entry.Key))
+			}
+			if err := validateFieldNames(entry.Fields); err != nil {
+				return nil, err
+			}
+			if err := s.checkSecretSize(fieldsSize(entry.Fields)); err != nil {
+				return nil, err
+			}
+			err = s.validateSecretValue(ctx, secretPath, nil, entry.Fields)
+		} else {
+			if err := s.checkSecretSize(len(entry.Value)); err != nil {
+				return nil, err
+			}
+			err = s.validateSecretValue(ctx, secretPath, &entry.Value, nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, repository.SecretImport{Path: secretPath, Value: entry.Value, Fields: entry.Fields})
+	}
+	if payload.DryRun || len(imports) == 0 {
+		return result, nil
+	}
+
+	created, err := s.secretsRepository.ImportSecrets(ctx, s.keyManager, jwtClaims.UserID, imports, payload.OnExisting == importOverwrite)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretAlreadyExists) {
+			return nil, gensecrets.MakeSecretAlreadyExists(err)
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error importing secrets: %w", err))
+	}
+	for _, secretImport := range imports {
+		eventType := events.SecretUpdated
+		if slices.Contains(created, secretImport.Path) {
+			eventType = events.SecretCreated
+		}
+		publishEvent(ctx, s.eventBus, eventType, map[string]any{"path": secretImport.Path})
+	}
+	return result, nil
+}
+
+// TransferSecretOwnership gives the ownership of a secret, or of every secret owned by a user, to a user or a role
+func (s *SecretsService) TransferSecretOwnership(ctx context.Context, payload *gensecrets.TransferSecretOwnershipPayload) (int, error) {
+	if (payload.Path == nil) == (payload.FromUserID == nil) {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of path or from_user_id must be provided"))
+	}
+	if (payload.ToUserID == nil) == (payload.ToRoleID == nil) {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of to_user_id or to_role_id must be provided"))
+	}
+
+	owner := repository.SecretOwner{UserID: payload.ToUserID, RoleID: payload.ToRoleID}
+	if payload.ToUserID != nil {
+		if _, err := s.usersRepository.GetUserByID(ctx, *payload.ToUserID); err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("user %d not found", *payload.ToUserID))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user: %w", err))
+		}
+	} else {
+		if _, err := s.rolesRepository.GetRoleByID(ctx, *payload.ToRoleID); err != nil {
+			if errors.Is(err, repository.ErrRoleNotFound) {
+				return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("role %d not found", *payload.ToRoleID))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving role: %w", err))
+		}
+	}
+
+	var paths []string
+	if payload.Path != nil {
+		decodedPath, err := base64.StdEncoding.DecodeString(*payload.Path)
+		if err != nil {
+			return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+		}
+		err = s.secretsRepository.TransferSecretOwnership(ctx, string(decodedPath), owner)
+		if err != nil {
+			if errors.Is(err, repository.ErrSecretNotFound) {
+				return 0, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPath))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error transferring secret ownership: %w", err))
+		}
+		paths = []string{string(decodedPath)}
+	} else {
+		if payload.ToUserID != nil && *payload.ToUserID == *payload.FromUserID {
+			return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("the secrets already belong to user %d", *payload.FromUserID))
+		}
+		var err error
+		paths, err = s.secretsRepository.TransferUserSecretsOwnership(ctx, *payload.FromUserID, owner)
+		if err != nil {
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error transferring secrets ownership: %w", err))
+		}
+	}
+
+	for _, secretPath := range paths {
+		publishEvent(ctx, s.eventBus, events.SecretAccessChanged, map[string]any{"path": secretPath})
+	}
+	return len(paths), nil
+}
+
+// ListStaleSecrets lists the secrets whose value was not read for the number of days
+func (s *SecretsService) ListStaleSecrets(ctx context.Context, payload *gensecrets.ListStaleSecretsPayload) ([]*gensecrets.StaleSecret, error) {
+	before := time.Now().UTC().AddDate(0, 0, -payload.Days)
+	secrets, err := s.accessStatsRepository.ListStaleSecrets(ctx, before)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing stale secrets: %w", err))
+	}
+
+	var userIDs []int
+	for _, secret := range secrets {
+		if secret.LastReaderID != nil {
+			userIDs = append(userIDs, *secret.LastReaderID)
+		}
+	}
+	users, err := s.usersRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving users: %w", err))
+	}
+	userMap := make(map[int]*gensecrets.User)
+	for _, user := range users {
+		userMap[user.ID] = &gensecrets.User{
+			ID:        user.ID,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	result := make([]*gensecrets.StaleSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		staleSecret := &gensecrets.StaleSecret{
+			Path:      secret.Path,
+			CreatedAt: secret.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+		if secret.LastReadAt != nil {
+			lastReadAt := secret.LastReadAt.UTC().Format("2006-01-02T15:04:05Z")
+			staleSecret.LastReadAt = &lastReadAt
+		}
+		if secret.LastReaderID != nil {
+			staleSecret.LastReader = userMap[*secret.LastReaderID]
+		}
+		result = append(result, staleSecret)
+	}
+	return result, nil
+}
+
+// SetSecretCanary marks a secret as a canary, whose reads raise an alert, or back as a normal secret
+func (s *SecretsService) SetSecretCanary(ctx context.Context, payload *gensecrets.SetSecretCanaryPayload) error {
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	err = s.secretsRepository.SetSecretCanary(ctx, decodedPathStr, payload.Canary)
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error setting canary: %w", err))
+	}
+	return nil
+}
+
+func (s *SecretsService) ListCanarySecrets(ctx context.Context) ([]string, error) {
+	paths, err := s.secretsRepository.ListCanarySecretPaths(ctx)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing canary secrets: %w", err))
+	}
+	if paths == nil {
+		paths = []string{}
+	}
+	return paths, nil
+}
+
+func (s *SecretsService) WrapSecret(ctx context.Context, payload *gensecrets.WrapSecretPayload) (*gensecrets.WrapSecretResult, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	if (payload.Value == nil) == (payload.Path == nil) {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of value or path must be provided"))
+	}
+	if payload.Field != nil && payload.Path == nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("a field can only be wrapped from a secret"))
+	}
+
+	wrapped := repository.WrappedSecret{CreatedBy: &jwtClaims.UserID}
+	if payload.Value != nil {
+		if err := s.checkSecretSize(len(*payload.Value)); err != nil {
+			return nil, err
+		}
+		wrapped.Value = *payload.Value
+	} else {
+		decryptedSecret, err := s.getReadableSecret(ctx, jwtClaims.UserID, *payload.Path)
+		if err != nil {
+			return nil, err
+		}
+		if decryptedSecret.ContentType != "" {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("secret at path %s is binary and cannot be wrapped", decryptedSecret.Path))
+		}
+		// The recipient cannot resolve the references, they are wrapped resolved
+		value, fields, err := resolvedFieldValue(s.referenceResolver(ctx, jwtClaims.UserID), decryptedSecret, payload.Field)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			wrapped.Value = *value
+		} else {
+			wrapped.Structured = true
+			wrapped.Fields = fields
+		}
+		wrapped.SourcePath = &decryptedSecret.Path
+	}
+
+	token, err := crypto.GenerateSecret()
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error generating token: %w", err))
+	}
+	encodedToken := hex.EncodeToString(token)
+	wrapped.ExpiresAt = time.Now().UTC().Add(time.Duration(payload.TTL) * time.Second)
+
+	_, err = s.wrappedSecretsRepository.CreateWrappedSecret(ctx, s.keyManager, hashWrapToken(encodedToken), wrapped)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error wrapping secret: %w", err))
+	}
+
+	return &gensecrets.WrapSecretResult{
+		Token:     encodedToken,
+		ExpiresAt: wrapped.ExpiresAt.Format("2006-01-02T15:04:05Z"),
+	}, nil
+}
+
+// UnwrapSecret is not authenticated, holding the token is enough. Whoever unwraps it first destroys the value, so a
+// recipient finding the token already used knows it was intercepted.
+func (s *SecretsService) UnwrapSecret(ctx context.Context, payload *gensecrets.UnwrapSecretPayload) (*gensecrets.UnwrapSecretResult, error) {
+	if s.keyManager.GetState() != crypto.StateUnlocked {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("the master key is locked, the token can be unwrapped once it is unlocked"))
+	}
+
+	wrapped, err := s.wrappedSecretsRepository.UnwrapSecret(ctx, s.keyManager, hashWrapToken(payload.Token), time.Now().UTC())
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrWrappedSecretNotFound):
+			return nil, gensecrets.MakeTokenNotFound(fmt.Errorf("token not found, it is invalid or expired long ago"))
+		case errors.Is(err, repository.ErrWrappedSecretUnwrapped):
+			return nil, gensecrets.MakeTokenAlreadyUsed(fmt.Errorf("token was already used at %s, if you did not unwrap it yourself it was intercepted",
+				wrapped.UnwrappedAt.UTC().Format("2006-01-02T15:04:05Z")))
+		case errors.Is(err, repository.ErrWrappedSecretExpired):
+			return nil, gensecrets.MakeTokenExpired(fmt.Errorf("token expired at %s without being unwrapped",
+				wrapped.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z")))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error unwrapping secret: %w", err))
+	}
+
+	result := &gensecrets.UnwrapSecretResult{
+		Path:      wrapped.SourcePath,
+		WrappedAt: wrapped.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	if wrapped.Structured {
+		result.Fields = wrapped.Fields
+	} else {
+		result.Value = &wrapped.Value
+	}
+	return result, nil
+}
+
+// hashWrapToken is the only form of a wrap token stored, so that reading the database doesn't give usable tokens
+func hashWrapToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// secretCapabilities returns the capabilities of the user on the secret. Unknown secrets are reported the same way
+// as secrets the user has no capability on, so that their existence is not disclosed.
+func (s *SecretsService) secretCapabilities(ctx context.Context, userID int, secretPath string) ([]string, error) {
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, userID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+
+	capabilities, err := s.secretsRepository.GetCapabilities(ctx, secretPath, userID, roleIDs)
+	if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+	}
+	if len(capabilities) == 0 {
+		return nil, gensecrets.MakeForbidden(fmt.Errorf("you do not have access to this secret"))
+	}
+	return capabilities, nil
+}
+
+func (s *SecretsService) checkSecretSize(size int) error {
+	if int64(size) > s.maxSecretSize {
+		return gensecrets.MakeSecretTooLarge(fmt.Errorf("secret exceeds the maximum size of %d bytes", s.maxSecretSize))
+	}
+	return nil
+}
+
+// fieldsSize returns the size of the fields of a structured secret, names included
+func fieldsSize(fields map[string]string) int {
+	size := 0
+	for name, value := range fields {
+		size += len(name) + len(value)
+	}
+	return size
+}
+
+// validateFieldNames checks the names of the fields of a structured secret fit in the name column of the secret_fields
+// table
+func validateFieldNames(fields map[string]string) error {
+	for name := range fields {
+		if len(name) == 0 || len(name) > 255 {
+			return gensecrets.MakeInvalidParameters(fmt.Errorf("field names must be between 1 and 255 characters long"))
+		}
+	}
+	return nil
+}
+
+func missingCapability(capability string) error {
+	return gensecrets.MakeForbidden(fmt.Errorf("you do not have the %s capability on this secret", capability))
+}
+
+// normalizeCapabilities sorts the capabilities in their canonical order and removes duplicates
+func normalizeCapabilities(capabilities []string) []string {
+	normalized := []string{}
+	for _, capability := range repository.AllCapabilities {
+		if slices.Contains(capabilities, capability) {
+			normalized = append(normalized, capability)
+		}
+	}
+	return normalized
+}
+
+// secretGrant is the capabilities granted to a user or a role on a secret, until expiresAt when set
+type secretGrant struct {
+	capabilities []string
+	expiresAt    *time.Time
+}
+
+func (g secretGrant) equal(other secretGrant) bool {
+	if !slices.Equal(g.capabilities, other.capabilities) {
+		return false
+	}
+	if g.expiresAt == nil || other.expiresAt == nil {
+		return g.expiresAt == nil && other.expiresAt == nil
+	}
+	return g.expiresAt.Equal(*other.expiresAt)
+}
+
+// userGrantsFromPayload merges the authorized users, given the default capabilities, with the explicit grants
+func userGrantsFromPayload(authorizedUsers []int, grants []*gensecrets.Grant) (map[int]secretGrant, error) {
+	result := make(map[int]secretGrant)
+	for _, userID := range authorizedUsers {
+		result[userID] = secretGrant{capabilities: repository.DefaultCapabilities}
+	}
+	for _, grant := range grants {
+		capabilities := normalizeCapabilities(grant.Capabilities)
+		if len(capabilities) == 0 {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("at least one capability must be granted to %d", grant.ID))
+		}
+		expiresAt, err := grantExpiration(grant)
+		if err != nil {
+			return nil, err
+		}
+		result[grant.ID] = secretGrant{capabilities: capabilities, expiresAt: expiresAt}
+	}
+	return result, nil
+}
+
+// roleGrantsFromPayload merges the authorized roles, given the default capabilities, with the explicit grants.
+// The admin role (ID 1), when authorized, is given every capability, permanently.
+func roleGrantsFromPayload(authorizedRoles []int, grants []*gensecrets.Grant) (map[int]secretGrant, error) {
+	result := make(map[int]secretGrant)
+	for _, roleID := range authorizedRoles {
+		result[roleID] = secretGrant{capabilities: repository.DefaultCapabilities}
+	}
+	for _, grant := range grants {
+		capabilities := normalizeCapabilities(grant.Capabilities)
+		if len(capabilities) == 0 {
+			return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("at least one capability must be granted to %d", grant.ID))
+		}
+		expiresAt, err := grantExpiration(grant)
+		if err != nil {
+			return nil, err
+		}
+		result[grant.ID] = secretGrant{capabilities: capabilities, expiresAt: expiresAt}
+	}
+	if _, ok := result[1]; ok {
+		result[1] = secretGrant{capabilities: repository.AllCapabilities}
+	}
+	return result, nil
+}
+
+// grantExpiration parses the expiration of the grant, truncated to the second as it is displayed so that grants read
+// back compare equal
+func grantExpiration(grant *gensecrets.Grant) (*time.Time, error) {
+	if grant.ExpiresAt == nil {
+		return nil, nil
+	}
+	expiresAt, err := time.Parse(time.RFC3339, *grant.ExpiresAt)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid expiration of the grant of %d: %w", grant.ID, err))
+	}
+	expiresAt = expiresAt.UTC().Truncate(time.Second)
+	if !expiresAt.After(time.Now()) {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("the grant of %d expires in the past", grant.ID))
+	}
+	return &expiresAt, nil
+}
+
+func grantToResult(id int, grant repository.SecretGrant) *gensecrets.Grant {
+	result := &gensecrets.Grant{ID: id, Capabilities: grant.Capabilities}
+	if grant.ExpiresAt != nil {
+		expiresAt := grant.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z")
+		expiresIn := max(int(time.Until(*grant.ExpiresAt).Seconds()), 0)
+		result.ExpiresAt = &expiresAt
+		result.ExpiresIn = &expiresIn
+	}
+	return result
+}
+
+func (s *SecretsService) RequestAccess(ctx context.Context, payload *gensecrets.RequestAccessPayload) (*gensecrets.AccessRequest, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	decodedPath, err := base64.StdEncoding.DecodeString(payload.Path)
+	if err != nil {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path encoding: %w", err))
+	}
+	decodedPathStr := string(decodedPath)
+
+	requested := normalizeCapabilities(payload.Capabilities)
+	if len(requested) == 0 {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("at least one capability must be requested"))
+	}
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+	held, err := s.secretsRepository.GetCapabilities(ctx, decodedPathStr, jwtClaims.UserID, roleIDs)
+	if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+	}
+	// Access is requested to the secrets one can list, the others are answered as unknown so that requests don't tell
+	// which secrets exist
+	if !slices.Contains(held, repository.CapabilityList) {
+		return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+	}
+	if !slices.ContainsFunc(requested, func(capability string) bool { return !slices.Contains(held, capability) }) {
+		return nil, gensecrets.MakeInvalidParameters(fmt.Errorf("you already hold the requested capabilities on this secret"))
+	}
+
+	request, err := s.accessRequestsRepository.CreateAccessRequest(ctx, repository.AccessRequest{
+		Path:          decodedPathStr,
+		RequesterID:   &jwtClaims.UserID,
+		Capabilities:  requested,
+		Justification: payload.Justification,
+		Duration:      payload.Duration,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrSecretNotFound) {
+			return nil, gensecrets.MakeSecretNotFound(fmt.Errorf("secret not found at path: %s", decodedPathStr))
+		}
+		if errors.Is(err, repository.ErrAccessRequestPending) {
+			return nil, gensecrets.MakeAccessRequestPending(fmt.Errorf("you already have a pending access request on this secret"))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error creating access request: %w", err))
+	}
+
+	data := map[string]any{"id": request.ID, "path": request.Path, "capabilities": request.Capabilities}
+	if request.Duration != nil {
+		data["duration"] = *request.Duration
+	}
+	publishEvent(ctx, s.eventBus, events.AccessRequestCreated, data)
+
+	results, err := s.accessRequestsToResult(ctx, []repository.AccessRequest{*request})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func (s *SecretsService) ListAccessRequests(ctx context.Context, payload *gensecrets.ListAccessRequestsPayload) ([]*gensecrets.AccessRequest, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+	admin, err := s.hasAdminRole(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []repository.AccessRequest
+	if admin {
+		requests, err = s.accessRequestsRepository.ListAccessRequests(ctx, payload.Status)
+	} else {
+		requests, err = s.accessRequestsRepository.ListAccessRequestsForUser(ctx, jwtClaims.UserID, roleIDs, payload.Status)
+	}
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing access requests: %w", err))
+	}
+	return s.accessRequestsToResult(ctx, requests)
+}
+
+func (s *SecretsService) ApproveAccessRequest(ctx context.Context, payload *gensecrets.ApproveAccessRequestPayload) (*gensecrets.AccessRequest, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	if err := s.checkAccessRequestDecider(ctx, jwtClaims.UserID, payload.ID); err != nil {
+		return nil, err
+	}
+
+	request, err := s.accessRequestsRepository.ApproveAccessRequest(ctx, payload.ID, jwtClaims.UserID, payload.Comment, time.Now().UTC().Truncate(time.Second))
+	if err != nil {
+		return nil, accessRequestDecisionError(payload.ID, err)
+	}
+
+	publishEvent(ctx, s.eventBus, events.AccessRequestApproved, map[string]any{"id": request.ID, "path": request.Path})
+	publishEvent(ctx, s.eventBus, events.SecretAccessChanged, map[string]any{"path": request.Path})
+
+	results, err := s.accessRequestsToResult(ctx, []repository.AccessRequest{*request})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func (s *SecretsService) DenyAccessRequest(ctx context.Context, payload *gensecrets.DenyAccessRequestPayload) (*gensecrets.AccessRequest, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	if err := s.checkAccessRequestDecider(ctx, jwtClaims.UserID, payload.ID); err != nil {
+		return nil, err
+	}
+
+	request, err := s.accessRequestsRepository.DenyAccessRequest(ctx, payload.ID, jwtClaims.UserID, payload.Comment, time.Now().UTC())
+	if err != nil {
+		return nil, accessRequestDecisionError(payload.ID, err)
+	}
+
+	publishEvent(ctx, s.eventBus, events.AccessRequestDenied, map[string]any{"id": request.ID, "path": request.Path})
+
+	results, err := s.accessRequestsToResult(ctx, []repository.AccessRequest{*request})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+// checkAccessRequestDecider checks that the user is an admin or can manage the access of the secret of the request,
+// and did not file it
+func (s *SecretsService) checkAccessRequestDecider(ctx context.Context, userID int, requestID int) error {
+	request, err := s.accessRequestsRepository.GetAccessRequest(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAccessRequestNotFound) {
+			return gensecrets.MakeAccessRequestNotFound(fmt.Errorf("access request %d not found", requestID))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error retrieving access request: %w", err))
+	}
+	if request.RequesterID != nil && *request.RequesterID == userID {
+		return gensecrets.MakeForbidden(fmt.Errorf("you cannot decide on your own access request"))
+	}
+
+	allowed, err := s.isAdminOrAccessManager(ctx, userID, request.Path)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return gensecrets.MakeForbidden(fmt.Errorf("you need to be an admin or to hold the manage_access capability on the secret to decide on this request"))
+	}
+	return nil
+}
+
+// isAdminOrAccessManager tells whether the user is an admin or holds the manage_access capability on the secret
+func (s *SecretsService) isAdminOrAccessManager(ctx context.Context, userID int, secretPath string) (bool, error) {
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, userID)
+	if err != nil {
+		return false, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+	admin, err := s.hasAdminRole(ctx, roleIDs)
+	if err != nil || admin {
+		return admin, err
+	}
+	capabilities, err := s.secretsRepository.GetCapabilities(ctx, secretPath, userID, roleIDs)
+	if err != nil && !errors.Is(err, repository.ErrSecretNotFound) {
+		return false, gensecrets.MakeInternalError(fmt.Errorf("error checking access to secret: %w", err))
+	}
+	return slices.Contains(capabilities, repository.CapabilityManageAccess), nil
+}
+
+func accessRequestDecisionError(requestID int, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrAccessRequestNotFound):
+		return gensecrets.MakeAccessRequestNotFound(fmt.Errorf("access request %d not found", requestID))
+	case errors.Is(err, repository.ErrAccessRequestDecided):
+		return gensecrets.MakeAccessRequestDecided(fmt.Errorf("access request %d was already decided", requestID))
+	case errors.Is(err, repository.ErrSecretNotFound):
+		return gensecrets.MakeSecretNotFound(fmt.Errorf("the secret of access request %d was deleted", requestID))
+	case errors.Is(err, repository.ErrUserNotFound):
+		return gensecrets.MakeInvalidParameters(fmt.Errorf("the requester of access request %d was deleted", requestID))
+	}
+	return gensecrets.MakeInternalError(fmt.Errorf("error deciding on access request: %w", err))
+}
+
+func (s *SecretsService) hasAdminRole(ctx context.Context, roleIDs []int) (bool, error) {
+	roles, err := s.rolesRepository.GetRolesByIDs(ctx, roleIDs)
+	if err != nil {
+		return false, gensecrets.MakeInternalError(fmt.Errorf("error retrieving roles: %w", err))
+	}
+	return slices.ContainsFunc(roles, func(role repository.Role) bool { return role.Admin }), nil
+}
+
+func (s *SecretsService) accessRequestsToResult(ctx context.Context, requests []repository.AccessRequest) ([]*gensecrets.AccessRequest, error) {
+	var userIDs []int
+	for _, request := range requests {
+		if request.RequesterID != nil {
+			userIDs = append(userIDs, *request.RequesterID)
+		}
+		if request.DecidedBy != nil {
+			userIDs = append(userIDs, *request.DecidedBy)
+		}
+	}
+	users, err := s.usersRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving users: %w", err))
+	}
+	userMap := make(map[int]*gensecrets.User)
+	for _, user := range users {
+		userMap[user.ID] = &gensecrets.User{
+			ID:        user.ID,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	result := make([]*gensecrets.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		accessRequest := &gensecrets.AccessRequest{
+			ID:            request.ID,
+			Path:          request.Path,
+			Capabilities:  request.Capabilities,
+			Justification: request.Justification,
+			Duration:      request.Duration,
+			Status:        request.Status,
+			Comment:       request.Comment,
+			CreatedAt:     request.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if request.RequesterID != nil {
+			accessRequest.Requester = userMap[*request.RequesterID]
+		}
+		if request.DecidedBy != nil {
+			accessRequest.DecidedBy = userMap[*request.DecidedBy]
+		}
+		if request.DecidedAt != nil {
+			decidedAt := request.DecidedAt.UTC().Format("2006-01-02T15:04:05Z")
+			accessRequest.DecidedAt = &decidedAt
+		}
+		if request.GrantExpiresAt != nil {
+			grantExpiresAt := request.GrantExpiresAt.UTC().Format("2006-01-02T15:04:05Z")
+			accessRequest.GrantExpiresAt = &grantExpiresAt
+		}
+		result = append(result, accessRequest)
+	}
+	return result, nil
+}
+
+func (s *SecretsService) ListValidationRules(ctx context.Context) ([]*gensecrets.ValidationRule, error) {
+	rules, err := s.validationRulesRepository.ListValidationRules(ctx)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing validation rules: %w", err))
+	}
+
+	result := make([]*gensecrets.ValidationRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, &gensecrets.ValidationRule{
+			ID:         rule.ID,
+			PathPrefix: rule.PathPrefix,
+			Kind:       rule.Kind,
+			Parameter:  rule.Parameter,
+			Field:      rule.Field,
+			CreatedAt:  rule.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		})
+	}
+	return result, nil
+}
+
+func (s *SecretsService) CreateValidationRule(ctx context.Context, payload *gensecrets.CreateValidationRulePayload) (int, error) {
+	decodedPrefix, err := base64.StdEncoding.DecodeString(payload.PathPrefix)
+	if err != nil {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path prefix encoding: %w", err))
+	}
+	prefix := string(decodedPrefix)
+	if len(prefix) == 0 || prefix[0] != '/' {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("path prefix must start with '/'"))
+	}
+	// Rules apply to whole folders, so that "/prod" does not match "/production/..."
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	rule := repository.ValidationRule{PathPrefix: prefix, Kind: payload.Kind, Field: payload.Field}
+	if payload.Parameter != nil {
+		rule.Parameter = *payload.Parameter
+	}
+	if _, err := validation.Compile(rule.Kind, rule.Parameter); err != nil {
+		return 0, gensecrets.MakeInvalidParameters(err)
+	}
+
+	id, err := s.validationRulesRepository.CreateValidationRule(ctx, rule)
+	if err != nil {
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error creating validation rule: %w", err))
+	}
+	return id, nil
+}
+
+func (s *SecretsService) DeleteValidationRule(ctx context.Context, payload *gensecrets.DeleteValidationRulePayload) error {
+	err := s.validationRulesRepository.DeleteValidationRule(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrValidationRuleNotFound) {
+			return gensecrets.MakeValidationRuleNotFound(fmt.Errorf("validation rule %d not found", payload.ID))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error deleting validation rule: %w", err))
+	}
+	return nil
+}
+
+func (s *SecretsService) ListBreakGlassRules(ctx context.Context) ([]*gensecrets.BreakGlassRule, error) {
+	rules, err := s.breakGlassRepository.ListBreakGlassRules(ctx)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing break-glass rules: %w", err))
+	}
+
+	var roleIDs []int
+	for _, rule := range rules {
+		if rule.RoleID != nil {
+			roleIDs = append(roleIDs, *rule.RoleID)
+		}
+	}
+	roles, err := s.rolesRepository.GetRolesByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving roles: %w", err))
+	}
+	roleMap := make(map[int]*gensecrets.Role)
+	for _, role := range roles {
+		roleMap[role.ID] = &gensecrets.Role{
+			ID:        role.ID,
+			Name:      role.Name,
+			Color:     role.Color,
+			Admin:     role.Admin,
+			CreatedAt: role.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: role.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	result := make([]*gensecrets.BreakGlassRule, 0, len(rules))
+	for _, rule := range rules {
+		breakGlassRule := &gensecrets.BreakGlassRule{
+			ID:         rule.ID,
+			PathPrefix: rule.PathPrefix,
+			CreatedAt:  rule.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		if rule.RoleID != nil {
+			breakGlassRule.Role = roleMap[*rule.RoleID]
+		}
+		result = append(result, breakGlassRule)
+	}
+	return result, nil
+}
+
+func (s *SecretsService) CreateBreakGlassRule(ctx context.Context, payload *gensecrets.CreateBreakGlassRulePayload) (int, error) {
+	decodedPrefix, err := base64.StdEncoding.DecodeString(payload.PathPrefix)
+	if err != nil {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path prefix encoding: %w", err))
+	}
+	prefix := string(decodedPrefix)
+	if len(prefix) == 0 || prefix[0] != '/' {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("path prefix must start with '/'"))
+	}
+	// Rules apply to whole folders, so that "/prod" does not match "/production/..."
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	if payload.RoleID != nil {
+		if _, err := s.rolesRepository.GetRoleByID(ctx, *payload.RoleID); err != nil {
+			if errors.Is(err, repository.ErrRoleNotFound) {
+				return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("role %d does not exist", *payload.RoleID))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving role: %w", err))
+		}
+	}
+
+	id, err := s.breakGlassRepository.CreateBreakGlassRule(ctx, prefix, payload.RoleID)
+	if err != nil {
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error creating break-glass rule: %w", err))
+	}
+	return id, nil
+}
+
+func (s *SecretsService) DeleteBreakGlassRule(ctx context.Context, payload *gensecrets.DeleteBreakGlassRulePayload) error {
+	err := s.breakGlassRepository.DeleteBreakGlassRule(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBreakGlassRuleNotFound) {
+			return gensecrets.MakeBreakGlassRuleNotFound(fmt.Errorf("break-glass rule %d not found", payload.ID))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error deleting break-glass rule: %w", err))
+	}
+	return nil
+}
+
+func (s *SecretsService) ListBreakGlassAccesses(ctx context.Context, payload *gensecrets.ListBreakGlassAccessesPayload) ([]*gensecrets.BreakGlassAccess, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	roleIDs, err := s.userRolesRepository.GetUserRoleIDs(ctx, jwtClaims.UserID)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user roles: %w", err))
+	}
+	admin, err := s.hasAdminRole(ctx, roleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var accesses []repository.BreakGlassAccess
+	if admin {
+		accesses, err = s.breakGlassRepository.ListBreakGlassAccesses(ctx, payload.Unreviewed)
+	} else {
+		accesses, err = s.breakGlassRepository.ListBreakGlassAccessesForUser(ctx, jwtClaims.UserID, roleIDs, payload.Unreviewed)
+	}
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing emergency accesses: %w", err))
+	}
+	return s.breakGlassAccessesToResult(ctx, accesses)
+}
+
+func (s *SecretsService) ReviewBreakGlassAccess(ctx context.Context, payload *gensecrets.ReviewBreakGlassAccessPayload) (*gensecrets.BreakGlassAccess, error) {
+	// Guaranteed by the Authentified interceptor
+	jwtClaims := ctx.Value("token").(*JwtClaims)
+
+	access, err := s.breakGlassRepository.GetBreakGlassAccess(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBreakGlassAccessNotFound) {
+			return nil, gensecrets.MakeBreakGlassAccessNotFound(fmt.Errorf("emergency access %d not found", payload.ID))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving emergency access: %w", err))
+	}
+	if access.UserID != nil && *access.UserID == jwtClaims.UserID {
+		return nil, gensecrets.MakeForbidden(fmt.Errorf("you cannot review your own emergency access"))
+	}
+	allowed, err := s.isAdminOrAccessManager(ctx, jwtClaims.UserID, access.Path)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, gensecrets.MakeForbidden(fmt.Errorf("you need to be an admin or to hold the manage_access capability on the secret to review this emergency access"))
+	}
+
+	access, err = s.breakGlassRepository.ReviewBreakGlassAccess(ctx, payload.ID, jwtClaims.UserID, payload.Comment, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, repository.ErrBreakGlassAccessReviewed) {
+			return nil, gensecrets.MakeBreakGlassAccessReviewed(fmt.Errorf("emergency access %d was already reviewed", payload.ID))
+		}
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error reviewing emergency access: %w", err))
+	}
+
+	publishEvent(ctx, s.eventBus, events.SecretBreakGlassReviewed, map[string]any{"id": access.ID, "path": access.Path})
+
+	results, err := s.breakGlassAccessesToResult(ctx, []repository.BreakGlassAccess{*access})
+	if err != nil {
+		return nil, err
+	}
+	return results[0], nil
+}
+
+func (s *SecretsService) breakGlassAccessesToResult(ctx context.Context, accesses []repository.BreakGlassAccess) ([]*gensecrets.BreakGlassAccess, error) {
+	var userIDs []int
+	for _, access := range accesses {
+		if access.UserID != nil {
+			userIDs = append(userIDs, *access.UserID)
+		}
+		if access.ReviewedBy != nil {
+			userIDs = append(userIDs, *access.ReviewedBy)
+		}
+	}
+	users, err := s.usersRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving users: %w", err))
+	}
+	userMap := make(map[int]*gensecrets.User)
+	for _, user := range users {
+		userMap[user.ID] = &gensecrets.User{
+			ID:        user.ID,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	result := make([]*gensecrets.BreakGlassAccess, 0, len(accesses))
+	for _, access := range accesses {
+		breakGlassAccess := &gensecrets.BreakGlassAccess{
+			ID:            access.ID,
+			Path:          access.Path,
+			Justification: access.Justification,
+			ExpiresAt:     access.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z"),
+			ReviewComment: access.ReviewComment,
+			CreatedAt:     access.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+		if access.UserID != nil {
+			breakGlassAccess.User = userMap[*access.UserID]
+		}
+		if access.ReviewedBy != nil {
+			breakGlassAccess.ReviewedBy = userMap[*access.ReviewedBy]
+		}
+		if access.ReviewedAt != nil {
+			reviewedAt := access.ReviewedAt.UTC().Format("2006-01-02T15:04:05Z")
+			breakGlassAccess.ReviewedAt = &reviewedAt
+		}
+		result = append(result, breakGlassAccess)
+	}
+	return result, nil
+}
+
+func (s *SecretsService) ListFolderAccesses(ctx context.Context) ([]*gensecrets.FolderAccess, error) {
+	accesses, err := s.folderAccessRepository.ListFolderAccesses(ctx)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error listing folder accesses: %w", err))
+	}
+
+	var userIDs, roleIDs []int
+	for _, access := range accesses {
+		if access.UserID != nil {
+			userIDs = append(userIDs, *access.UserID)
+		}
+		if access.RoleID != nil {
+			roleIDs = append(roleIDs, *access.RoleID)
+		}
+	}
+
+	users, err := s.usersRepository.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving users: %w", err))
+	}
+	userMap := make(map[int]*gensecrets.User)
+	for _, user := range users {
+		userMap[user.ID] = &gensecrets.User{
+			ID:        user.ID,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	roles, err := s.rolesRepository.GetRolesByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, gensecrets.MakeInternalError(fmt.Errorf("error retrieving roles: %w", err))
+	}
+	roleMap := make(map[int]*gensecrets.Role)
+	for _, role := range roles {
+		roleMap[role.ID] = &gensecrets.Role{
+			ID:        role.ID,
+			Name:      role.Name,
+			Color:     role.Color,
+			Admin:     role.Admin,
+			CreatedAt: role.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			UpdatedAt: role.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+	}
+
+	result := make([]*gensecrets.FolderAccess, 0, len(accesses))
+	for _, access := range accesses {
+		folderAccess := &gensecrets.FolderAccess{
+			ID:         access.ID,
+			PathPrefix: access.PathPrefix,
+			CreatedAt:  access.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		}
+		folderAccess.Capabilities = access.Capabilities
+		if access.UserID != nil {
+			folderAccess.User = userMap[*access.UserID]
+		}
+		if access.RoleID != nil {
+			folderAccess.Role = roleMap[*access.RoleID]
+		}
+		result = append(result, folderAccess)
+	}
+
+	return result, nil
+}
+
+func (s *SecretsService) GrantFolderAccess(ctx context.Context, payload *gensecrets.GrantFolderAccessPayload) (int, error) {
+	decodedPrefix, err := base64.StdEncoding.DecodeString(payload.PathPrefix)
+	if err != nil {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("invalid path prefix encoding: %w", err))
+	}
+	prefix := string(decodedPrefix)
+	if len(prefix) == 0 || prefix[0] != '/' {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("path prefix must start with '/'"))
+	}
+	// Grants apply to whole folders, so that "/pay" does not match "/payments/..."
+	if !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	if (payload.UserID == nil) == (payload.RoleID == nil) {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("exactly one of user_id or role_id must be provided"))
+	}
+
+	capabilities := repository.DefaultCapabilities
+	if payload.Capabilities != nil {
+		capabilities = normalizeCapabilities(payload.Capabilities)
+	}
+	if len(capabilities) == 0 {
+		return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("at least one capability must be granted"))
+	}
+
+	var id int
+	if payload.UserID != nil {
+		if _, err := s.usersRepository.GetUserByID(ctx, *payload.UserID); err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("user %d does not exist", *payload.UserID))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving user: %w", err))
+		}
+		id, err = s.folderAccessRepository.GrantUserFolderAccess(ctx, prefix, *payload.UserID, capabilities)
+	} else {
+		if _, err := s.rolesRepository.GetRoleByID(ctx, *payload.RoleID); err != nil {
+			if errors.Is(err, repository.ErrRoleNotFound) {
+				return 0, gensecrets.MakeInvalidParameters(fmt.Errorf("role %d does not exist", *payload.RoleID))
+			}
+			return 0, gensecrets.MakeInternalError(fmt.Errorf("error retrieving role: %w", err))
+		}
+		id, err = s.folderAccessRepository.GrantRoleFolderAccess(ctx, prefix, *payload.RoleID, capabilities)
+	}
+	if err != nil {
+		return 0, gensecrets.MakeInternalError(fmt.Errorf("error granting folder access: %w", err))
+	}
+
+	data := map[string]any{"id": id, "path_prefix": prefix, "capabilities": capabilities}
+	if payload.UserID != nil {
+		data["user_id"] = *payload.UserID
+	} else {
+		data["role_id"] = *payload.RoleID
+	}
+	publishEvent(ctx, s.eventBus, events.FolderAccessGranted, data)
+	return id, nil
+}
+
+func (s *SecretsService) RevokeFolderAccess(ctx context.Context, payload *gensecrets.RevokeFolderAccessPayload) error {
+	err := s.folderAccessRepository.RevokeFolderAccess(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrFolderAccessNotFound) {
+			return gensecrets.MakeFolderAccessNotFound(fmt.Errorf("folder access %d not found", payload.ID))
+		}
+		return gensecrets.MakeInternalError(fmt.Errorf("error revoking folder access: %w", err))
+	}
+	publishEvent(ctx, s.eventBus, events.FolderAccessRevoked, map[string]any{"id": payload.ID})
 	return nil
 }
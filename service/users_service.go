@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	genusers "github.com/Vidalee/FishyKeys/gen/users"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
@@ -26,6 +29,7 @@ type UsersService struct {
 	secretsRepository        repository.SecretsRepository
 	rolesRepository          repository.RolesRepository
 	userRolesRepository      repository.UserRolesRepository
+	eventBus                 *events.Bus
 }
 
 func NewUsersService(
@@ -35,6 +39,7 @@ func NewUsersService(
 	secretsRepository repository.SecretsRepository,
 	rolesRepository repository.RolesRepository,
 	userRolesRepository repository.UserRolesRepository,
+	eventBus *events.Bus,
 ) *UsersService {
 	return &UsersService{
 		keyManager:               keyManager,
@@ -43,6 +48,7 @@ func NewUsersService(
 		secretsRepository:        secretsRepository,
 		rolesRepository:          rolesRepository,
 		userRolesRepository:      userRolesRepository,
+		eventBus:                 eventBus,
 	}
 }
 
@@ -72,6 +78,7 @@ func (s *UsersService) CreateUser(ctx context.Context, payload *genusers.CreateU
 		return nil, genusers.MakeInternalError(fmt.Errorf("could not create user: %w", err))
 	}
 
+	publishEvent(ctx, s.eventBus, events.UserCreated, map[string]any{"user_id": userId, "username": payload.Username})
 	return &genusers.CreateUserResult{Username: payload.Username, ID: userId}, nil
 }
 
@@ -113,6 +120,7 @@ func (s *UsersService) AuthUser(ctx context.Context, payload *genusers.AuthUserP
 		"username": user.Username,
 		"exp":      jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 		"userid":   user.ID,
+		"jti":      newTokenID(),
 	})
 
 	tokenString, err := token.SignedString(decodedSecret)
@@ -146,6 +154,7 @@ func (s *UsersService) GetOperatorToken(ctx context.Context) (*genusers.GetOpera
 		"username": jwtClaims.Username,
 		"exp":      jwt.NewNumericDate(time.Now().Add(10 * 365 * 24 * time.Hour)), // Not the best idea but will suffice for now
 		"userid":   jwtClaims.UserID,
+		"jti":      newTokenID(),
 	})
 
 	tokenString, err := token.SignedString(decodedSecret)
@@ -217,13 +226,57 @@ func (s *UsersService) DeleteUser(ctx context.Context, payload *genusers.DeleteU
 		return genusers.MakeForbidden(fmt.Errorf("cannot delete system user"))
 	}
 
-	err := s.usersRepository.DeleteUser(ctx, payload.Username)
+	var reassignTo *repository.SecretOwner
+	if payload.ReassignTo != nil {
+		if *payload.ReassignTo == payload.Username {
+			return genusers.MakeInvalidParameters(fmt.Errorf("cannot reassign the secrets to the deleted user"))
+		}
+		newOwner, err := s.usersRepository.GetUserByUsername(ctx, *payload.ReassignTo)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return genusers.MakeInvalidParameters(fmt.Errorf("user to reassign the secrets to not found"))
+			}
+			return genusers.MakeInternalError(fmt.Errorf("could not get user to reassign the secrets to: %w", err))
+		}
+		reassignTo = &repository.SecretOwner{UserID: &newOwner.ID}
+	}
+
+	err := s.usersRepository.DeleteUser(ctx, payload.Username, reassignTo)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
 			return genusers.MakeUserNotFound(fmt.Errorf("user not found"))
 		}
+		if errors.Is(err, repository.ErrUserOwnsSecrets) {
+			return s.userOwnsSecretsError(ctx, payload.Username)
+		}
 		return genusers.MakeInternalError(fmt.Errorf("could not delete user: %w", err))
 	}
 
+	data := map[string]any{"username": payload.Username}
+	if payload.ReassignTo != nil {
+		data["reassigned_to"] = *payload.ReassignTo
+	}
+	publishEvent(ctx, s.eventBus, events.UserDeleted, data)
 	return nil
 }
+
+// userOwnsSecretsError tells how many secrets the user still owns
+func (s *UsersService) userOwnsSecretsError(ctx context.Context, username string) error {
+	user, err := s.usersRepository.GetUserByUsername(ctx, username)
+	if err != nil {
+		return genusers.MakeInternalError(fmt.Errorf("could not get user: %w", err))
+	}
+	count, err := s.secretsRepository.CountSecretsOwnedByUser(ctx, user.ID)
+	if err != nil {
+		return genusers.MakeInternalError(fmt.Errorf("could not count secrets owned by user: %w", err))
+	}
+	return genusers.MakeUserOwnsSecrets(fmt.Errorf("user %s still owns %d secrets, give reassign_to to transfer them", username, count))
+}
+
+// newTokenID returns a random identifier for a token, so that the accesses made with it can be traced back to it
+func newTokenID() string {
+	id := make([]byte, 16)
+	// crypto/rand never fails on supported platforms
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -11,6 +11,7 @@ import (
 
 	genusers "github.com/Vidalee/FishyKeys/gen/users"
 	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
 	"github.com/Vidalee/FishyKeys/internal/testutil"
 	"github.com/Vidalee/FishyKeys/repository"
 	"github.com/stretchr/testify/assert"
@@ -28,7 +29,7 @@ func setupUsersTestService(t *testing.T) *UsersService {
 	secretsRepo := repository.NewSecretsRepository(testDB)
 	userRolesRepo := repository.NewUserRolesRepository(testDB)
 	rolesRepo := repository.NewRolesRepository(testDB)
-	return NewUsersService(keyManager, usersRepo, globalSettingsRepo, secretsRepo, rolesRepo, userRolesRepo)
+	return NewUsersService(keyManager, usersRepo, globalSettingsRepo, secretsRepo, rolesRepo, userRolesRepo, events.NewBus())
 }
 
 func clearUsersServiceTables(t *testing.T, ctx context.Context) {
@@ -344,6 +345,8 @@ func TestUsersService_DeleteUser(t *testing.T) {
 		name              string
 		username          string
 		createUser        bool
+		ownsSecret        bool
+		reassignTo        *string
 		expectedError     bool
 		expectedErrorText string
 	}{
@@ -367,6 +370,39 @@ func TestUsersService_DeleteUser(t *testing.T) {
 			createUser:    true,
 			expectedError: false,
 		},
+		{
+			name:              "user owning secrets without reassignment",
+			username:          "username",
+			createUser:        true,
+			ownsSecret:        true,
+			expectedError:     true,
+			expectedErrorText: "user username still owns 1 secrets, give reassign_to to transfer them",
+		},
+		{
+			name:          "user owning secrets with reassignment",
+			username:      "username",
+			createUser:    true,
+			ownsSecret:    true,
+			reassignTo:    ptr("heir"),
+			expectedError: false,
+		},
+		{
+			name:              "reassignment to unknown user",
+			username:          "username",
+			createUser:        true,
+			ownsSecret:        true,
+			reassignTo:        ptr("nobody"),
+			expectedError:     true,
+			expectedErrorText: "user to reassign the secrets to not found",
+		},
+		{
+			name:              "reassignment to deleted user",
+			username:          "username",
+			createUser:        true,
+			reassignTo:        ptr("username"),
+			expectedError:     true,
+			expectedErrorText: "cannot reassign the secrets to the deleted user",
+		},
 		{
 			name:              "delete self",
 			username:          callerUsername,
@@ -392,12 +428,20 @@ func TestUsersService_DeleteUser(t *testing.T) {
 					Username: tt.username,
 					Password: "password",
 				}
-				_, err := service.CreateUser(ctx, createPayload)
+				created, err := service.CreateUser(ctx, createPayload)
 				require.NoError(t, err, "failed to create user for auth test")
+
+				if tt.ownsSecret {
+					_, err = service.secretsRepository.CreateSecret(ctx, service.keyManager, "/owned/secret", created.ID, "value")
+					require.NoError(t, err, "failed to create owned secret")
+				}
 			}
+			heir, err := service.CreateUser(ctx, &genusers.CreateUserPayload{Username: "heir", Password: "password"})
+			require.NoError(t, err, "failed to create heir user")
 
 			payload := &genusers.DeleteUserPayload{
-				Username: tt.username,
+				Username:   tt.username,
+				ReassignTo: tt.reassignTo,
 			}
 
 			token := jwt.NewWithClaims(jwt.SigningMethodHS256, &JwtClaims{
@@ -406,7 +450,7 @@ func TestUsersService_DeleteUser(t *testing.T) {
 			})
 
 			ctx = context.WithValue(ctx, "token", token.Claims.(*JwtClaims))
-			err := service.DeleteUser(ctx, payload)
+			err = service.DeleteUser(ctx, payload)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -417,6 +461,13 @@ func TestUsersService_DeleteUser(t *testing.T) {
 				_, err := service.usersRepository.GetUserByUsername(ctx, tt.username)
 				assert.Error(t, err)
 				assert.Equal(t, repository.ErrUserNotFound, err, "expected user to be deleted")
+
+				if tt.ownsSecret {
+					owner, err := service.secretsRepository.GetSecretOwner(ctx, "/owned/secret")
+					require.NoError(t, err)
+					require.NotNil(t, owner.UserID)
+					assert.Equal(t, heir.ID, *owner.UserID, "expected the secret to be reassigned")
+				}
 			}
 		})
 	}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+const (
+	// webhookMaxAttempts is the number of attempts after which a delivery is failed, about an hour after the first one
+	webhookMaxAttempts = 8
+	webhookRetryDelay  = 30 * time.Second
+	webhookMaxDelay    = time.Hour
+	// webhookLease is how long a delivery being attempted is hidden from the other servers, longer than the timeout
+	webhookLease        = time.Minute
+	webhookTimeout      = 10 * time.Second
+	webhookPollInterval = 5 * time.Second
+)
+
+// WebhookDispatcher turns the events of the bus into deliveries of the webhooks they match, and attempts the due
+// deliveries. Several servers sharing a database can run it, each delivery is attempted by a single one of them.
+type WebhookDispatcher struct {
+	keyManager         *crypto.KeyManager
+	webhooksRepository repository.WebhooksRepository
+	client             *http.Client
+	wakeUp             chan struct{}
+}
+
+func NewWebhookDispatcher(keyManager *crypto.KeyManager, webhooksRepository repository.WebhooksRepository) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		keyManager:         keyManager,
+		webhooksRepository: webhooksRepository,
+		client:             &http.Client{Timeout: webhookTimeout},
+		wakeUp:             make(chan struct{}, 1),
+	}
+}
+
+// Handle records a delivery of the event for every enabled webhook it matches. It is subscribed to the event bus.
+func (d *WebhookDispatcher) Handle(ctx context.Context, event events.Event) {
+	webhooks, err := d.webhooksRepository.ListWebhooks(ctx)
+	if err != nil {
+		log.Printf("Could not list webhooks for event %s: %v", event.Type, err)
+		return
+	}
+
+	var payload []byte
+	for _, webhook := range webhooks {
+		if !webhookMatches(&webhook, event) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				log.Printf("Could not encode event %s: %v", event.Type, err)
+				return
+			}
+		}
+
+		_, err := d.webhooksRepository.CreateWebhookDelivery(ctx, repository.WebhookDelivery{
+			WebhookID: webhook.ID,
+			EventID:   event.ID,
+			EventType: event.Type,
+			Payload:   string(payload),
+		})
+		if err != nil {
+			log.Printf("Could not record delivery of event %s to webhook %s: %v", event.Type, webhook.Name, err)
+		}
+	}
+
+	select {
+	case d.wakeUp <- struct{}{}:
+	default:
+	}
+}
+
+func webhookMatches(webhook *repository.Webhook, event events.Event) bool {
+	if !webhook.Enabled {
+		return false
+	}
+	if !slices.ContainsFunc(webhook.Events, func(filter string) bool { return events.Matches(filter, event.Type) }) {
+		return false
+	}
+	if webhook.PathPrefix == nil {
+		return true
+	}
+	eventPath, ok := event.Data["path"].(string)
+	if !ok {
+		eventPath, ok = event.Data["path_prefix"].(string)
+	}
+	return ok && strings.HasPrefix(eventPath, *webhook.PathPrefix)
+}
+
+// Run attempts the due deliveries as they are recorded and every poll interval until the context is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wakeUp:
+		}
+		if _, err := d.DeliverDue(ctx); err != nil {
+			log.Printf("Webhook delivery error: %v", err)
+		}
+	}
+}
+
+// DeliverDue attempts every due delivery and returns the number of attempts made. Nothing is delivered while the
+// master key is locked, since the webhook secrets can't be decrypted.
+func (d *WebhookDispatcher) DeliverDue(ctx context.Context) (int, error) {
+	if d.keyManager.GetState() != crypto.StateUnlocked {
+		return 0, nil
+	}
+
+	attempts := 0
+	for {
+		now := time.Now().UTC()
+		due, err := d.webhooksRepository.ClaimDueWebhookDelivery(ctx, d.keyManager, now, webhookLease)
+		if err != nil {
+			return attempts, err
+		}
+		if due == nil {
+			return attempts, nil
+		}
+		attempts++
+
+		responseStatus, attemptErr := d.send(ctx, due, now)
+		status := repository.WebhookDeliverySucceeded
+		var errorText *string
+		var nextAttemptAt *time.Time
+		if attemptErr != nil {
+			text := attemptErr.Error()
+			errorText = &text
+			status = repository.WebhookDeliveryFailed
+			if due.Attempts < webhookMaxAttempts {
+				status = repository.WebhookDeliveryPending
+				next := now.Add(webhookBackoff(due.Attempts))
+				nextAttemptAt = &next
+			}
+			log.Printf("Delivery %d of event %s failed on attempt %d: %s", due.ID, due.EventType, due.Attempts, text)
+		}
+
+		err = d.webhooksRepository.RecordWebhookDeliveryAttempt(ctx, due.ID, status, responseStatus, errorText, nextAttemptAt)
+		if err != nil {
+			return attempts, err
+		}
+	}
+}
+
+// webhookBackoff is the delay before the attempt following the given one, doubled on every attempt
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookRetryDelay
+	for i := 1; i < attempts && delay < webhookMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, webhookMaxDelay)
+}
+
+// send posts the payload of the delivery, signed with the secret of its webhook. Any response other than a 2xx is a
+// failure.
+func (d *WebhookDispatcher) send(ctx context.Context, due *repository.DueWebhookDelivery, now time.Time) (*int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, due.URL, bytes.NewReader([]byte(due.Payload)))
+	if err != nil {
+		return nil, fmt.Errorf("could not build request: %w", err)
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "FishyKeys-Webhook")
+	request.Header.Set("X-FishyKeys-Event", due.EventType)
+	request.Header.Set("X-FishyKeys-Delivery", strconv.Itoa(due.ID))
+	request.Header.Set("X-FishyKeys-Timestamp", timestamp)
+	request.Header.Set("X-FishyKeys-Signature", "sha256="+signWebhookPayload(due.Secret, timestamp, due.Payload))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &response.StatusCode, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return &response.StatusCode, nil
+}
+
+// signWebhookPayload is the hex encoded HMAC-SHA256 of the timestamp and the payload separated by a dot, the timestamp
+// lets receivers reject replayed requests
+func signWebhookPayload(secret string, timestamp string, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+
+	genwebhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+type WebhooksService struct {
+	keyManager         *crypto.KeyManager
+	webhooksRepository repository.WebhooksRepository
+}
+
+func NewWebhooksService(keyManager *crypto.KeyManager, webhooksRepository repository.WebhooksRepository) *WebhooksService {
+	return &WebhooksService{
+		keyManager:         keyManager,
+		webhooksRepository: webhooksRepository,
+	}
+}
+
+func (s *WebhooksService) ListWebhooks(ctx context.Context) ([]*genwebhooks.Webhook, error) {
+	webhooks, err := s.webhooksRepository.ListWebhooks(ctx)
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not list webhooks: %w", err))
+	}
+
+	result := make([]*genwebhooks.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		result = append(result, webhookToResult(&w))
+	}
+	return result, nil
+}
+
+func (s *WebhooksService) CreateWebhook(ctx context.Context, payload *genwebhooks.WebhookParameters) (*genwebhooks.CreateWebhookResult, error) {
+	if err := validateWebhook(payload.URL, payload.Events, payload.PathPrefix); err != nil {
+		return nil, err
+	}
+
+	_, err := s.webhooksRepository.GetWebhookByName(ctx, payload.Name)
+	if err == nil {
+		return nil, genwebhooks.MakeWebhookTaken(fmt.Errorf("webhook with name %s already exists", payload.Name))
+	}
+	if !errors.Is(err, repository.ErrWebhookNotFound) {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not check if webhook already exists: %w", err))
+	}
+
+	secret, err := crypto.GenerateSecret()
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not generate webhook secret: %w", err))
+	}
+	encodedSecret := hex.EncodeToString(secret)
+
+	id, err := s.webhooksRepository.CreateWebhook(ctx, s.keyManager, repository.Webhook{
+		Name:       payload.Name,
+		URL:        payload.URL,
+		Events:     payload.Events,
+		PathPrefix: payload.PathPrefix,
+		Enabled:    payload.Enabled,
+	}, encodedSecret)
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not create webhook: %w", err))
+	}
+
+	w, err := s.getWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	result := webhookToResult(w)
+	return &genwebhooks.CreateWebhookResult{
+		Secret:     encodedSecret,
+		ID:         result.ID,
+		CreatedAt:  result.CreatedAt,
+		UpdatedAt:  result.UpdatedAt,
+		Name:       result.Name,
+		URL:        result.URL,
+		Events:     result.Events,
+		PathPrefix: result.PathPrefix,
+		Enabled:    result.Enabled,
+	}, nil
+}
+
+func (s *WebhooksService) UpdateWebhook(ctx context.Context, payload *genwebhooks.UpdateWebhookPayload) (*genwebhooks.Webhook, error) {
+	if _, err := s.getWebhook(ctx, payload.ID); err != nil {
+		return nil, err
+	}
+	if err := validateWebhook(payload.URL, payload.Events, payload.PathPrefix); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.webhooksRepository.GetWebhookByName(ctx, payload.Name)
+	if err == nil && existing.ID != payload.ID {
+		return nil, genwebhooks.MakeWebhookTaken(fmt.Errorf("webhook with name %s already exists", payload.Name))
+	}
+	if err != nil && !errors.Is(err, repository.ErrWebhookNotFound) {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not check if webhook already exists: %w", err))
+	}
+
+	err = s.webhooksRepository.UpdateWebhook(ctx, repository.Webhook{
+		ID:         payload.ID,
+		Name:       payload.Name,
+		URL:        payload.URL,
+		Events:     payload.Events,
+		PathPrefix: payload.PathPrefix,
+		Enabled:    payload.Enabled,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return nil, genwebhooks.MakeWebhookNotFound(fmt.Errorf("webhook with id %d not found", payload.ID))
+		}
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not update webhook: %w", err))
+	}
+
+	w, err := s.getWebhook(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return webhookToResult(w), nil
+}
+
+func (s *WebhooksService) DeleteWebhook(ctx context.Context, payload *genwebhooks.DeleteWebhookPayload) error {
+	err := s.webhooksRepository.DeleteWebhook(ctx, payload.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return genwebhooks.MakeWebhookNotFound(fmt.Errorf("webhook with id %d not found", payload.ID))
+		}
+		return genwebhooks.MakeInternalError(fmt.Errorf("could not delete webhook: %w", err))
+	}
+	return nil
+}
+
+func (s *WebhooksService) ListWebhookDeliveries(ctx context.Context, payload *genwebhooks.ListWebhookDeliveriesPayload) ([]*genwebhooks.WebhookDelivery, error) {
+	if _, err := s.getWebhook(ctx, payload.ID); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.webhooksRepository.ListWebhookDeliveries(ctx, payload.ID, payload.Status, payload.Limit)
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not list webhook deliveries: %w", err))
+	}
+
+	result := make([]*genwebhooks.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		result = append(result, webhookDeliveryToResult(&d))
+	}
+	return result, nil
+}
+
+func (s *WebhooksService) ReplayWebhookDelivery(ctx context.Context, payload *genwebhooks.ReplayWebhookDeliveryPayload) (*genwebhooks.WebhookDelivery, error) {
+	if _, err := s.getWebhook(ctx, payload.ID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.webhooksRepository.GetWebhookDelivery(ctx, payload.ID, payload.DeliveryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookDeliveryNotFound) {
+			return nil, genwebhooks.MakeWebhookDeliveryNotFound(fmt.Errorf("delivery with id %d not found", payload.DeliveryID))
+		}
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not retrieve webhook delivery: %w", err))
+	}
+	if delivery.Status != repository.WebhookDeliveryFailed {
+		return nil, genwebhooks.MakeInvalidParameters(fmt.Errorf("only failed deliveries can be replayed, delivery %d is %s", delivery.ID, delivery.Status))
+	}
+
+	id, err := s.webhooksRepository.CreateWebhookDelivery(ctx, repository.WebhookDelivery{
+		WebhookID: delivery.WebhookID,
+		EventID:   delivery.EventID,
+		EventType: delivery.EventType,
+		Payload:   delivery.Payload,
+		ReplayOf:  &delivery.ID,
+	})
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not replay webhook delivery: %w", err))
+	}
+
+	replay, err := s.webhooksRepository.GetWebhookDelivery(ctx, payload.ID, id)
+	if err != nil {
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not retrieve webhook delivery: %w", err))
+	}
+	return webhookDeliveryToResult(replay), nil
+}
+
+func (s *WebhooksService) getWebhook(ctx context.Context, id int) (*repository.Webhook, error) {
+	w, err := s.webhooksRepository.GetWebhookByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrWebhookNotFound) {
+			return nil, genwebhooks.MakeWebhookNotFound(fmt.Errorf("webhook with id %d not found", id))
+		}
+		return nil, genwebhooks.MakeInternalError(fmt.Errorf("could not retrieve webhook: %w", err))
+	}
+	return w, nil
+}
+
+func validateWebhook(rawURL string, eventFilters []string, pathPrefix *string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return genwebhooks.MakeInvalidParameters(fmt.Errorf("url must be an absolute http or https URL"))
+	}
+	for _, filter := range eventFilters {
+		if !events.ValidFilter(filter) {
+			return genwebhooks.MakeInvalidParameters(fmt.Errorf("event filter %s matches no event type", filter))
+		}
+	}
+	if pathPrefix != nil && (len(*pathPrefix) == 0 || (*pathPrefix)[0] != '/') {
+		return genwebhooks.MakeInvalidParameters(fmt.Errorf("path prefix must start with '/'"))
+	}
+	return nil
+}
+
+func webhookToResult(w *repository.Webhook) *genwebhooks.Webhook {
+	return &genwebhooks.Webhook{
+		ID:         w.ID,
+		Name:       w.Name,
+		URL:        w.URL,
+		Events:     w.Events,
+		PathPrefix: w.PathPrefix,
+		Enabled:    w.Enabled,
+		CreatedAt:  w.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		UpdatedAt:  w.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func webhookDeliveryToResult(d *repository.WebhookDelivery) *genwebhooks.WebhookDelivery {
+	result := &genwebhooks.WebhookDelivery{
+		ID:             d.ID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		ReplayOf:       d.ReplayOf,
+		CreatedAt:      d.CreatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+	if d.NextAttemptAt != nil {
+		nextAttemptAt := d.NextAttemptAt.Format("2006-01-02T15:04:05Z")
+		result.NextAttemptAt = &nextAttemptAt
+	}
+	if d.LastAttemptAt != nil {
+		lastAttemptAt := d.LastAttemptAt.Format("2006-01-02T15:04:05Z")
+		result.LastAttemptAt = &lastAttemptAt
+	}
+	return result
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	genwebhooks "github.com/Vidalee/FishyKeys/gen/webhooks"
+	"github.com/Vidalee/FishyKeys/internal/crypto"
+	"github.com/Vidalee/FishyKeys/internal/events"
+	"github.com/Vidalee/FishyKeys/internal/testutil"
+	"github.com/Vidalee/FishyKeys/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWebhooksTestService(t *testing.T) (*WebhooksService, *WebhookDispatcher, *events.Bus) {
+	keyManager := crypto.GetDefaultKeyManager()
+	masterKey, err := crypto.GenerateSecret()
+	require.NoError(t, err, "failed to generate master key")
+	err = keyManager.SetNewMasterKey(masterKey, 1, 1)
+	require.NoError(t, err, "failed to set new master key")
+
+	webhooksRepo := repository.NewWebhooksRepository(testDB)
+	dispatcher := NewWebhookDispatcher(keyManager, webhooksRepo)
+	bus := events.NewBus()
+	bus.Subscribe(dispatcher.Handle)
+	return NewWebhooksService(keyManager, webhooksRepo), dispatcher, bus
+}
+
+func clearWebhooksServiceTables(t *testing.T, ctx context.Context) {
+	err := testutil.ClearTable(ctx, "webhook_deliveries")
+	require.NoError(t, err)
+	err = testutil.ClearTable(ctx, "webhooks")
+	require.NoError(t, err)
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   string
+}
+
+// webhookReceiver records the requests it receives and answers them with the given status
+func webhookReceiver(t *testing.T, status int) (*httptest.Server, func() []receivedWebhook) {
+	var mu sync.Mutex
+	var received []receivedWebhook
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		received = append(received, receivedWebhook{header: r.Header.Clone(), body: string(body)})
+		mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, func() []receivedWebhook {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]receivedWebhook(nil), received...)
+	}
+}
+
+func TestWebhooksService_CreateWebhook(t *testing.T) {
+	service, _, _ := setupWebhooksTestService(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name              string
+		payload           *genwebhooks.WebhookParameters
+		doDuplicate       bool
+		expectedError     bool
+		expectedErrorText string
+	}{
+		{
+			name:    "valid webhook",
+			payload: &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com/deploy", Events: []string{"secret.*", "key.unlocked"}, Enabled: true},
+		},
+		{
+			name:    "valid webhook with path prefix",
+			payload: &genwebhooks.WebhookParameters{Name: "customers", URL: "http://localhost:8080", Events: []string{"*"}, PathPrefix: ptr("/customers/"), Enabled: true},
+		},
+		{
+			name:              "name already taken",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com/deploy", Events: []string{"*"}, Enabled: true},
+			doDuplicate:       true,
+			expectedError:     true,
+			expectedErrorText: "webhook with name deploy already exists",
+		},
+		{
+			name:              "relative url",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "/deploy", Events: []string{"*"}, Enabled: true},
+			expectedError:     true,
+			expectedErrorText: "url must be an absolute http or https URL",
+		},
+		{
+			name:              "unsupported scheme",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "ftp://hooks.example.com", Events: []string{"*"}, Enabled: true},
+			expectedError:     true,
+			expectedErrorText: "url must be an absolute http or https URL",
+		},
+		{
+			name:              "unknown event type",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com", Events: []string{"secret.read"}, Enabled: true},
+			expectedError:     true,
+			expectedErrorText: "event filter secret.read matches no event type",
+		},
+		{
+			name:              "unknown category",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com", Events: []string{"policy.*"}, Enabled: true},
+			expectedError:     true,
+			expectedErrorText: "event filter policy.* matches no event type",
+		},
+		{
+			name:              "relative path prefix",
+			payload:           &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com", Events: []string{"*"}, PathPrefix: ptr("customers/"), Enabled: true},
+			expectedError:     true,
+			expectedErrorText: "path prefix must start with '/'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearWebhooksServiceTables(t, ctx)
+
+			result, err := service.CreateWebhook(ctx, tt.payload)
+			if tt.doDuplicate {
+				result, err = service.CreateWebhook(ctx, tt.payload)
+			}
+
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedErrorText, err.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.payload.Name, result.Name)
+				assert.Equal(t, tt.payload.URL, result.URL)
+				assert.Equal(t, tt.payload.Events, result.Events)
+				assert.Equal(t, tt.payload.PathPrefix, result.PathPrefix)
+				assert.True(t, result.Enabled)
+				assert.Len(t, result.Secret, 64, "secret should be 32 hex encoded bytes")
+
+				webhooks, err := service.ListWebhooks(ctx)
+				require.NoError(t, err)
+				require.Len(t, webhooks, 1)
+				assert.Equal(t, result.ID, webhooks[0].ID)
+			}
+		})
+	}
+}
+
+func TestWebhooksService_UpdateAndDeleteWebhook(t *testing.T) {
+	service, _, _ := setupWebhooksTestService(t)
+	ctx := context.Background()
+	clearWebhooksServiceTables(t, ctx)
+
+	created, err := service.CreateWebhook(ctx, &genwebhooks.WebhookParameters{Name: "deploy", URL: "https://hooks.example.com", Events: []string{"*"}, Enabled: true})
+	require.NoError(t, err)
+	_, err = service.CreateWebhook(ctx, &genwebhooks.WebhookParameters{Name: "audit", URL: "https://audit.example.com", Events: []string{"*"}, Enabled: true})
+	require.NoError(t, err)
+
+	_, err = service.UpdateWebhook(ctx, &genwebhooks.UpdateWebhookPayload{ID: created.ID, Name: "audit", URL: "https://hooks.example.com", Events: []string{"*"}})
+	assert.Error(t, err)
+	assert.Equal(t, "webhook with name audit already exists", err.Error())
+
+	_, err = service.UpdateWebhook(ctx, &genwebhooks.UpdateWebhookPayload{ID: created.ID + 100, Name: "deploy", URL: "https://hooks.example.com", Events: []string{"*"}})
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("webhook with id %d not found", created.ID+100), err.Error())
+
+	updated, err := service.UpdateWebhook(ctx, &genwebhooks.UpdateWebhookPayload{
+		ID: created.ID, Name: "deploy", URL: "https://hooks.example.com/v2", Events: []string{"secret.rotated"}, Enabled: false,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://hooks.example.com/v2", updated.URL)
+	assert.Equal(t, []string{"secret.rotated"}, updated.Events)
+	assert.False(t, updated.Enabled)
+
+	err = service.DeleteWebhook(ctx, &genwebhooks.DeleteWebhookPayload{ID: created.ID})
+	require.NoError(t, err)
+	err = service.DeleteWebhook(ctx, &genwebhooks.DeleteWebhookPayload{ID: created.ID})
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("webhook with id %d not found", created.ID), err.Error())
+
+	webhooks, err := service.ListWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "audit", webhooks[0].Name)
+}
+
+func TestWebhookDispatcher_DeliverDue(t *testing.T) {
+	service, dispatcher, bus := setupWebhooksTestService(t)
+	ctx := context.Background()
+	clearWebhooksServiceTables(t, ctx)
+
+	receiver, received := webhookReceiver(t, http.StatusNoContent)
+	matching, err := service.CreateWebhook(ctx, &genwebhooks.WebhookParameters{
+		Name: "customers", URL: receiver.URL, Events: []string{"secret.*"}, PathPrefix: ptr("/customers/"), Enabled: true,
+	})
+	require.NoError(t, err)
+	disabled, err := service.CreateWebhook(ctx, &genwebhooks.WebhookParameters{Name: "disabled", URL: receiver.URL, Events: []string{"*"}, Enabled: false})
+	require.NoError(t, err)
+
+	bus.Publish(ctx, events.SecretUpdated, "alice", map[string]any{"path": "/customers/google/api_key"})
+	// Filtered out by the event types and by the path prefix
+	bus.Publish(ctx, events.UserCreated, "alice", map[string]any{"user_id": 3, "username": "bob"})
+	bus.Publish(ctx, events.SecretUpdated, "alice", map[string]any{"path": "/internal/db_password"})
+
+	attempts, err := dispatcher.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+
+	requests := received()
+	require.Len(t, requests, 1)
+	request := requests[0]
+	assert.Equal(t, "secret.updated", request.header.Get("X-FishyKeys-Event"))
+	timestamp := request.header.Get("X-FishyKeys-Timestamp")
+	assert.Equal(t, "sha256="+signWebhookPayload(matching.Secret, timestamp, request.body), request.header.Get("X-FishyKeys-Signature"))
+
+	var event events.Event
+	require.NoError(t, json.Unmarshal([]byte(request.body), &event))
+	assert.Equal(t, events.SecretUpdated, event.Type)
+	assert.Equal(t, "alice", event.Actor)
+	assert.Equal(t, map[string]any{"path": "/customers/google/api_key"}, event.Data)
+
+	deliveries, err := service.ListWebhookDeliveries(ctx, &genwebhooks.ListWebhookDeliveriesPayload{ID: matching.ID, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, repository.WebhookDeliverySucceeded, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusNoContent, *deliveries[0].ResponseStatus)
+	assert.Equal(t, event.ID, deliveries[0].EventID)
+	assert.Equal(t, request.header.Get("X-FishyKeys-Delivery"), fmt.Sprint(deliveries[0].ID))
+
+	deliveries, err = service.ListWebhookDeliveries(ctx, &genwebhooks.ListWebhookDeliveriesPayload{ID: disabled.ID, Limit: 50})
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+}
+
+func TestWebhookDispatcher_RetryAndReplay(t *testing.T) {
+	service, dispatcher, bus := setupWebhooksTestService(t)
+	ctx := context.Background()
+	clearWebhooksServiceTables(t, ctx)
+
+	receiver, received := webhookReceiver(t, http.StatusServiceUnavailable)
+	webhook, err := service.CreateWebhook(ctx, &genwebhooks.WebhookParameters{Name: "flaky", URL: receiver.URL, Events: []string{"*"}, Enabled: true})
+	require.NoError(t, err)
+
+	bus.Publish(ctx, events.RoleCreated, "alice", map[string]any{"role_id": 2, "name": "ops"})
+
+	attempts, err := dispatcher.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	// The failed delivery is retried later, not right away
+	attempts, err = dispatcher.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempts)
+	assert.Len(t, received(), 1)
+
+	deliveries, err := service.ListWebhookDeliveries(ctx, &genwebhooks.ListWebhookDeliveriesPayload{ID: webhook.ID, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	delivery := deliveries[0]
+	assert.Equal(t, repository.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, "unexpected status 503", *delivery.Error)
+	assert.Equal(t, http.StatusServiceUnavailable, *delivery.ResponseStatus)
+	require.NotNil(t, delivery.NextAttemptAt)
+	assert.Greater(t, *delivery.NextAttemptAt, *delivery.LastAttemptAt)
+
+	_, err = service.ReplayWebhookDelivery(ctx, &genwebhooks.ReplayWebhookDeliveryPayload{ID: webhook.ID, DeliveryID: delivery.ID})
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("only failed deliveries can be replayed, delivery %d is pending", delivery.ID), err.Error())
+
+	_, err = service.ReplayWebhookDelivery(ctx, &genwebhooks.ReplayWebhookDeliveryPayload{ID: webhook.ID, DeliveryID: delivery.ID + 100})
+	assert.Error(t, err)
+	assert.Equal(t, fmt.Sprintf("delivery with id %d not found", delivery.ID+100), err.Error())
+
+	// Give up on the delivery as the dispatcher does after its last attempt
+	lastError := "unexpected status 503"
+	err = repository.NewWebhooksRepository(testDB).RecordWebhookDeliveryAttempt(ctx, delivery.ID, repository.WebhookDeliveryFailed, delivery.ResponseStatus, &lastError, nil)
+	require.NoError(t, err)
+
+	replay, err := service.ReplayWebhookDelivery(ctx, &genwebhooks.ReplayWebhookDeliveryPayload{ID: webhook.ID, DeliveryID: delivery.ID})
+	require.NoError(t, err)
+	assert.Equal(t, repository.WebhookDeliveryPending, replay.Status)
+	assert.Equal(t, delivery.ID, *replay.ReplayOf)
+	assert.Equal(t, delivery.EventID, replay.EventID)
+	assert.Equal(t, delivery.Payload, replay.Payload)
+
+	attempts, err = dispatcher.DeliverDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempts)
+	requests := received()
+	require.Len(t, requests, 2)
+	assert.Equal(t, requests[0].body, requests[1].body)
+
+	failed := repository.WebhookDeliveryFailed
+	deliveries, err = service.ListWebhookDeliveries(ctx, &genwebhooks.ListWebhookDeliveriesPayload{ID: webhook.ID, Status: &failed, Limit: 50})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, delivery.ID, deliveries[0].ID)
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, webhookRetryDelay, webhookBackoff(1))
+	assert.Equal(t, 2*webhookRetryDelay, webhookBackoff(2))
+	assert.Equal(t, 8*webhookRetryDelay, webhookBackoff(4))
+	assert.Equal(t, webhookMaxDelay, webhookBackoff(20))
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Vidalee/FishyKeys/repository"
+)
+
+const (
+	wrappedSecretsPurgeInterval = time.Minute
+	// wrappedSecretsRetention is how long a used or expired token is still reported as such instead of unknown
+	wrappedSecretsRetention = 7 * 24 * time.Hour
+)
+
+// WrappedSecretsPurger destroys the values of the expired wrapped secrets, and forgets their tokens after the
+// retention
+type WrappedSecretsPurger struct {
+	wrappedSecretsRepository repository.WrappedSecretsRepository
+}
+
+func NewWrappedSecretsPurger(wrappedSecretsRepository repository.WrappedSecretsRepository) *WrappedSecretsPurger {
+	return &WrappedSecretsPurger{wrappedSecretsRepository: wrappedSecretsRepository}
+}
+
+// Run purges the wrapped secrets every interval until the context is cancelled
+func (p *WrappedSecretsPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(wrappedSecretsPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now().UTC()
+			if _, err := p.wrappedSecretsRepository.PurgeWrappedSecrets(ctx, now, now.Add(-wrappedSecretsRetention)); err != nil {
+				log.Printf("Wrapped secrets purge error: %v", err)
+			}
+		}
+	}
+}